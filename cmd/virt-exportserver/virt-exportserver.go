@@ -60,13 +60,18 @@ func getVolumeInfo() []exportServer.VolumeInfo {
 		envPrefix := strings.TrimSuffix(kv[0], "_EXPORT_PATH")
 		if envPrefix != kv[0] {
 			vi := exportServer.VolumeInfo{
-				Path:       kv[1],
-				ArchiveURI: os.Getenv(envPrefix + "_EXPORT_ARCHIVE_URI"),
-				DirURI:     os.Getenv(envPrefix + "_EXPORT_DIR_URI"),
-				RawURI:     os.Getenv(envPrefix + "_EXPORT_RAW_URI"),
-				RawGzURI:   os.Getenv(envPrefix + "_EXPORT_RAW_GZIP_URI"),
-				VMURI:      os.Getenv("EXPORT_VM_DEF_URI"),
-				SecretURI:  os.Getenv("EXPORT_SECRET_DEF_URI"),
+				Path:           kv[1],
+				ArchiveURI:     os.Getenv(envPrefix + "_EXPORT_ARCHIVE_URI"),
+				DirURI:         os.Getenv(envPrefix + "_EXPORT_DIR_URI"),
+				RawURI:         os.Getenv(envPrefix + "_EXPORT_RAW_URI"),
+				RawGzURI:       os.Getenv(envPrefix + "_EXPORT_RAW_GZIP_URI"),
+				Qcow2URI:       os.Getenv(envPrefix + "_EXPORT_QCOW2_URI"),
+				BackupQcow2URI: os.Getenv(envPrefix + "_EXPORT_BACKUP_QCOW2_URI"),
+				VMURI:          os.Getenv("EXPORT_VM_DEF_URI"),
+				SecretURI:      os.Getenv("EXPORT_SECRET_DEF_URI"),
+				OVFURI:         os.Getenv("EXPORT_OVF_DEF_URI"),
+				OVAURI:         os.Getenv("EXPORT_OVA_URI"),
+				ChecksumsURI:   os.Getenv("EXPORT_CHECKSUMS_URI"),
 			}
 			result = append(result, vi)
 		}
@@ -40,6 +40,7 @@ It has these top-level messages:
 	SEVInfoResponse
 	LaunchMeasurementResponse
 	InjectLaunchSecretRequest
+	BackupRequest
 */
 package v1
 
@@ -907,6 +908,62 @@ func (m *InjectLaunchSecretRequest) GetOptions() []byte {
 	return nil
 }
 
+type BackupRequest struct {
+	Vmi             *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	BackupName      string `protobuf:"bytes,2,opt,name=backupName" json:"backupName,omitempty"`
+	CheckpointName  string `protobuf:"bytes,3,opt,name=checkpointName" json:"checkpointName,omitempty"`
+	IncrementalFrom string `protobuf:"bytes,4,opt,name=incrementalFrom" json:"incrementalFrom,omitempty"`
+	TargetPath      string `protobuf:"bytes,5,opt,name=targetPath" json:"targetPath,omitempty"`
+	Pull            bool   `protobuf:"varint,6,opt,name=pull" json:"pull,omitempty"`
+}
+
+func (m *BackupRequest) Reset()                    { *m = BackupRequest{} }
+func (m *BackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()               {}
+func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *BackupRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *BackupRequest) GetBackupName() string {
+	if m != nil {
+		return m.BackupName
+	}
+	return ""
+}
+
+func (m *BackupRequest) GetCheckpointName() string {
+	if m != nil {
+		return m.CheckpointName
+	}
+	return ""
+}
+
+func (m *BackupRequest) GetIncrementalFrom() string {
+	if m != nil {
+		return m.IncrementalFrom
+	}
+	return ""
+}
+
+func (m *BackupRequest) GetTargetPath() string {
+	if m != nil {
+		return m.TargetPath
+	}
+	return ""
+}
+
+func (m *BackupRequest) GetPull() bool {
+	if m != nil {
+		return m.Pull
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -938,6 +995,7 @@ func init() {
 	proto.RegisterType((*SEVInfoResponse)(nil), "kubevirt.cmd.v1.SEVInfoResponse")
 	proto.RegisterType((*LaunchMeasurementResponse)(nil), "kubevirt.cmd.v1.LaunchMeasurementResponse")
 	proto.RegisterType((*InjectLaunchSecretRequest)(nil), "kubevirt.cmd.v1.InjectLaunchSecretRequest")
+	proto.RegisterType((*BackupRequest)(nil), "kubevirt.cmd.v1.BackupRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -981,6 +1039,9 @@ type CmdClient interface {
 	GetSEVInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*SEVInfoResponse, error)
 	GetLaunchMeasurement(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecretRequest, opts ...grpc.CallOption) (*Response, error)
+	MigrateVirtualMachineVolumes(ctx context.Context, in *MigrationRequest, opts ...grpc.CallOption) (*Response, error)
+	BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
+	FinishBackup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type cmdClient struct {
@@ -1261,6 +1322,33 @@ func (c *cmdClient) InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecr
 	return out, nil
 }
 
+func (c *cmdClient) MigrateVirtualMachineVolumes(ctx context.Context, in *MigrationRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/MigrateVirtualMachineVolumes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/BackupVirtualMachine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) FinishBackup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/FinishBackup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1294,6 +1382,9 @@ type CmdServer interface {
 	GetSEVInfo(context.Context, *EmptyRequest) (*SEVInfoResponse, error)
 	GetLaunchMeasurement(context.Context, *VMIRequest) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(context.Context, *InjectLaunchSecretRequest) (*Response, error)
+	MigrateVirtualMachineVolumes(context.Context, *MigrationRequest) (*Response, error)
+	BackupVirtualMachine(context.Context, *BackupRequest) (*Response, error)
+	FinishBackup(context.Context, *BackupRequest) (*Response, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -1840,6 +1931,60 @@ func _Cmd_InjectLaunchSecret_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_MigrateVirtualMachineVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).MigrateVirtualMachineVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/MigrateVirtualMachineVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).MigrateVirtualMachineVolumes(ctx, req.(*MigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_BackupVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).BackupVirtualMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/BackupVirtualMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).BackupVirtualMachine(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_FinishBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).FinishBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/FinishBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).FinishBackup(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -1964,6 +2109,18 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InjectLaunchSecret",
 			Handler:    _Cmd_InjectLaunchSecret_Handler,
 		},
+		{
+			MethodName: "MigrateVirtualMachineVolumes",
+			Handler:    _Cmd_MigrateVirtualMachineVolumes_Handler,
+		},
+		{
+			MethodName: "BackupVirtualMachine",
+			Handler:    _Cmd_BackupVirtualMachine_Handler,
+		},
+		{
+			MethodName: "FinishBackup",
+			Handler:    _Cmd_FinishBackup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -1972,114 +2129,121 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x6f, 0x1b, 0xc7,
-	0x11, 0x17, 0x45, 0x4a, 0x26, 0x47, 0x7f, 0x62, 0xaf, 0xfe, 0xf4, 0xa4, 0xd6, 0xb6, 0xba, 0x28,
-	0x0c, 0xa5, 0x48, 0xa4, 0xda, 0x75, 0x82, 0xc2, 0x28, 0x8a, 0x44, 0x14, 0xa5, 0x28, 0x31, 0x6d,
-	0xe6, 0x28, 0xc9, 0x68, 0xda, 0x20, 0x58, 0xdd, 0xad, 0xa8, 0xad, 0xee, 0x76, 0xd9, 0xdb, 0x3d,
-	0xd6, 0xcc, 0x53, 0x01, 0x17, 0x7d, 0x28, 0xd0, 0xcf, 0xd7, 0x4f, 0x52, 0xa0, 0x40, 0x5f, 0x82,
-	0xdd, 0xdb, 0xa3, 0x8e, 0xbc, 0x3b, 0xc9, 0x06, 0xf9, 0xa4, 0xdb, 0x9d, 0x99, 0xdf, 0xcc, 0xce,
-	0xcd, 0xcc, 0xfe, 0x78, 0x82, 0x8f, 0xfb, 0xd7, 0xbd, 0xfd, 0x2b, 0xc2, 0xfd, 0x80, 0x46, 0x9f,
-	0x06, 0x24, 0xe6, 0xde, 0x15, 0x8d, 0x3e, 0xf5, 0x44, 0xb8, 0xef, 0x85, 0xfe, 0xfe, 0xe0, 0xa9,
-	0xfe, 0xb3, 0xd7, 0x8f, 0x84, 0x12, 0xe8, 0xa3, 0xeb, 0xf8, 0x82, 0x0e, 0x58, 0xa4, 0xf6, 0xf4,
-	0xde, 0xe0, 0x29, 0xbe, 0x84, 0xb5, 0x6f, 0x69, 0x18, 0x9f, 0xd3, 0x48, 0x32, 0xc1, 0x5d, 0x2a,
-	0xfb, 0x82, 0x4b, 0x8a, 0x3e, 0x83, 0x7a, 0x64, 0x9f, 0x9d, 0xca, 0x4e, 0x65, 0x77, 0xe9, 0xd9,
-	0xd6, 0xde, 0x84, 0xe9, 0x5e, 0xaa, 0xec, 0x8e, 0x54, 0x91, 0x03, 0xf7, 0x06, 0x09, 0x92, 0x33,
-	0xbf, 0x53, 0xd9, 0x6d, 0xb8, 0xe9, 0x12, 0x3f, 0x86, 0xea, 0x79, 0xfb, 0xc4, 0x28, 0x84, 0xec,
-	0x6b, 0x29, 0xb8, 0x81, 0x5d, 0x76, 0xd3, 0x25, 0x7e, 0x0a, 0xd5, 0x66, 0xe7, 0x0c, 0xad, 0xc2,
-	0x3c, 0xf3, 0x8d, 0x6c, 0xc5, 0x9d, 0x67, 0x3e, 0xda, 0x86, 0xba, 0x64, 0x17, 0x01, 0xe3, 0x3d,
-	0xe9, 0xcc, 0xef, 0x54, 0x77, 0x57, 0xdc, 0xd1, 0x1a, 0xef, 0xc3, 0xbd, 0x6e, 0xf2, 0x9c, 0x33,
-	0x5b, 0x87, 0x85, 0x01, 0x09, 0x62, 0x6a, 0xc2, 0xa8, 0xb9, 0xc9, 0x02, 0xb7, 0x60, 0xa1, 0x43,
-	0x7a, 0x54, 0x6a, 0xb1, 0x27, 0x62, 0xae, 0x8c, 0x45, 0xcd, 0x4d, 0x16, 0x08, 0x41, 0x2d, 0xe6,
-	0x4c, 0xd9, 0xd0, 0xcd, 0xb3, 0xde, 0x93, 0xec, 0x47, 0xea, 0x54, 0x0d, 0xb4, 0x79, 0xc6, 0xcf,
-	0x61, 0xb1, 0x4d, 0x43, 0x11, 0x0d, 0xd1, 0x26, 0x2c, 0x92, 0x30, 0x03, 0x64, 0x57, 0x45, 0x48,
-	0xf8, 0x3f, 0x15, 0xa8, 0x35, 0x69, 0x10, 0xe4, 0x62, 0xdd, 0x87, 0xc5, 0xd0, 0xc0, 0x19, 0xf5,
-	0xa5, 0x67, 0x3f, 0xcb, 0x65, 0x3a, 0xf1, 0xe6, 0x5a, 0x35, 0xf4, 0x09, 0x2c, 0xf4, 0xf5, 0x31,
-	0x9c, 0xea, 0x4e, 0x75, 0x77, 0xe9, 0xd9, 0x66, 0x4e, 0xdf, 0x1c, 0xd2, 0x4d, 0x94, 0xd0, 0xe7,
-	0xd0, 0xf0, 0x99, 0x54, 0x84, 0x7b, 0x54, 0x3a, 0x35, 0x63, 0xe1, 0xe4, 0x2c, 0x6c, 0x1e, 0xdd,
-	0x1b, 0x55, 0xb4, 0x0b, 0x35, 0xaf, 0x1f, 0x4b, 0x67, 0xc1, 0x98, 0xac, 0xe7, 0x4c, 0x9a, 0x9d,
-	0x33, 0xd7, 0x68, 0xe0, 0x2f, 0xa0, 0x7e, 0x2a, 0xfa, 0x22, 0x10, 0xbd, 0x21, 0x7a, 0x0e, 0xc0,
-	0xe3, 0x90, 0xfc, 0xe0, 0xd1, 0x20, 0x90, 0x4e, 0xc5, 0xd8, 0x6e, 0xe4, 0x6d, 0x69, 0x10, 0xb8,
-	0x0d, 0xad, 0xa8, 0x9f, 0x24, 0xfe, 0x57, 0x05, 0x16, 0xbb, 0xed, 0x03, 0x26, 0x24, 0xc2, 0xb0,
-	0x1c, 0x12, 0x1e, 0x5f, 0x12, 0x4f, 0xc5, 0x11, 0x8d, 0x4c, 0x9e, 0x1a, 0xee, 0xd8, 0x9e, 0xae,
-	0xa2, 0x7e, 0x24, 0xfc, 0xd8, 0x4b, 0x33, 0x9c, 0x2e, 0xb3, 0x05, 0x58, 0x1d, 0x2b, 0x40, 0x74,
-	0x1f, 0xaa, 0xf2, 0x3a, 0x76, 0x6a, 0x66, 0x57, 0x3f, 0xea, 0x97, 0x77, 0x49, 0x42, 0x16, 0x0c,
-	0x9d, 0x05, 0xb3, 0x69, 0x57, 0xf8, 0x9f, 0x15, 0xa8, 0x1f, 0x32, 0x79, 0x7d, 0xc2, 0x2f, 0x85,
-	0x51, 0x12, 0x51, 0x48, 0x94, 0x0d, 0xc4, 0xae, 0xd0, 0x0e, 0x2c, 0x5d, 0x10, 0xef, 0x9a, 0xf1,
-	0xde, 0x11, 0x0b, 0xa8, 0x0d, 0x23, 0xbb, 0x85, 0x1e, 0x01, 0xe8, 0x78, 0x49, 0xd0, 0x4d, 0xeb,
-	0xa7, 0xe6, 0x66, 0x76, 0x34, 0x82, 0x4e, 0x49, 0xaa, 0x50, 0x33, 0x0a, 0xd9, 0x2d, 0xfc, 0xdf,
-	0x0a, 0xac, 0x34, 0x83, 0x58, 0x2a, 0x1a, 0x35, 0x05, 0xbf, 0x64, 0x3d, 0xb4, 0x07, 0xa8, 0xf5,
-	0xb6, 0x4f, 0xb8, 0xaf, 0xe3, 0x93, 0x2d, 0x4e, 0x2e, 0x02, 0x9a, 0x94, 0x52, 0xdd, 0x2d, 0x90,
-	0xa0, 0xdf, 0xc3, 0xd6, 0x51, 0x44, 0xa9, 0xae, 0x07, 0x97, 0xf6, 0x45, 0xa4, 0x18, 0xef, 0x1d,
-	0x32, 0x99, 0x98, 0xcd, 0x1b, 0xb3, 0x72, 0x05, 0xf4, 0x02, 0x9c, 0x03, 0xe1, 0x5d, 0xc9, 0x43,
-	0x26, 0xfb, 0x01, 0x19, 0x1e, 0x89, 0xa8, 0x75, 0x74, 0x72, 0x1c, 0x53, 0xa9, 0xa4, 0x39, 0x4f,
-	0xdd, 0x2d, 0x95, 0x6b, 0xdb, 0x2e, 0x8d, 0x18, 0x09, 0x9a, 0x82, 0x4b, 0x11, 0xd0, 0x97, 0xe2,
-	0xc6, 0x71, 0x2d, 0xb1, 0x2d, 0x93, 0xe3, 0xff, 0x2f, 0xc0, 0xc6, 0x79, 0x92, 0x87, 0x36, 0xf1,
-	0xae, 0x18, 0xa7, 0xaf, 0xfb, 0x8a, 0x09, 0x2e, 0xd1, 0x37, 0xb0, 0x3e, 0x2e, 0x48, 0x8a, 0xc6,
-	0x8e, 0xa8, 0x7c, 0xe3, 0x24, 0x62, 0xb7, 0xd0, 0x08, 0x3d, 0x87, 0x8d, 0x36, 0x0d, 0x0f, 0x48,
-	0x10, 0x08, 0xc1, 0xbb, 0x8a, 0x28, 0xd9, 0xa1, 0x11, 0x13, 0x49, 0x62, 0x56, 0xdc, 0x62, 0x21,
-	0xfa, 0x0d, 0xac, 0x75, 0x22, 0xaa, 0xf7, 0x3d, 0xa2, 0xa8, 0x7f, 0x2e, 0x82, 0x38, 0xb4, 0xad,
-	0xd8, 0x70, 0x8b, 0x44, 0x7a, 0x96, 0x2a, 0xdb, 0x1e, 0xe6, 0xe8, 0x45, 0xb3, 0x34, 0xed, 0x1f,
-	0x77, 0xa4, 0x8a, 0xba, 0xd0, 0x30, 0xef, 0x52, 0x97, 0xa1, 0x6d, 0xc2, 0xcf, 0x72, 0x76, 0x85,
-	0x69, 0xda, 0x1b, 0xd9, 0xb5, 0xb8, 0x8a, 0x86, 0xee, 0x0d, 0x4e, 0x49, 0x01, 0x2d, 0x96, 0x16,
-	0xd0, 0x21, 0xac, 0x78, 0xd9, 0x0a, 0x74, 0xee, 0x99, 0x03, 0x3c, 0xca, 0x77, 0x74, 0x56, 0xcb,
-	0x1d, 0x37, 0x42, 0xef, 0x2a, 0xb0, 0xc5, 0xb8, 0xa2, 0xd1, 0x25, 0xf1, 0xe8, 0xa1, 0x08, 0x09,
-	0xe3, 0x5f, 0x2a, 0x45, 0xbc, 0xab, 0x90, 0x72, 0xe5, 0xd4, 0xcd, 0xd9, 0x5a, 0xef, 0x79, 0xb6,
-	0x93, 0x32, 0x9c, 0xe4, 0xac, 0xe5, 0x7e, 0xb6, 0xdf, 0xc0, 0xea, 0x78, 0x62, 0xf4, 0x4c, 0xb8,
-	0xa6, 0x43, 0xdb, 0xd9, 0xfa, 0x11, 0xed, 0x67, 0xef, 0x8d, 0xa2, 0x17, 0x95, 0x0e, 0x06, 0x7b,
-	0xa5, 0xbc, 0x98, 0xff, 0x5d, 0x65, 0xfb, 0x25, 0x3c, 0xba, 0x3d, 0xaa, 0x02, 0x47, 0x63, 0x17,
-	0x54, 0x23, 0x83, 0x86, 0x07, 0x00, 0xe7, 0xed, 0x13, 0x97, 0xfe, 0x55, 0x37, 0x12, 0x7a, 0x02,
-	0xd5, 0x41, 0xc8, 0x6c, 0x81, 0xe7, 0x87, 0xb0, 0xd6, 0xd4, 0x0a, 0xe8, 0x0b, 0xb8, 0x27, 0x92,
-	0x0c, 0xd9, 0xd0, 0x9f, 0xbc, 0x5f, 0x3e, 0xdd, 0xd4, 0x0c, 0x9f, 0xc2, 0xfd, 0x36, 0xeb, 0x45,
-	0x44, 0x19, 0x1e, 0xf0, 0x61, 0xde, 0x9d, 0x71, 0xef, 0xcb, 0x37, 0xa8, 0xef, 0x2a, 0xb0, 0xd4,
-	0x7a, 0x4b, 0xbd, 0x14, 0xf1, 0x11, 0x80, 0x6f, 0x52, 0xf4, 0x8a, 0x84, 0xd4, 0x26, 0x24, 0xb3,
-	0xa3, 0x91, 0x9a, 0x22, 0x0c, 0x09, 0xf7, 0xd3, 0xd1, 0x6e, 0x97, 0xfa, 0x4e, 0xfd, 0x32, 0xea,
-	0xa5, 0x9d, 0x66, 0x9e, 0xd1, 0x13, 0x58, 0x55, 0x2c, 0xa4, 0x22, 0x56, 0x5d, 0xea, 0x09, 0xee,
-	0x4b, 0xd3, 0x60, 0x0b, 0xee, 0xc4, 0x2e, 0x5e, 0x85, 0xe5, 0x56, 0xd8, 0x57, 0x43, 0x1b, 0x05,
-	0xfe, 0x03, 0xd4, 0xdd, 0x0c, 0x67, 0x91, 0xb1, 0xe7, 0x51, 0x29, 0xed, 0x20, 0x4d, 0x97, 0x5a,
-	0x12, 0x52, 0x29, 0x49, 0x2f, 0x7d, 0x4b, 0xe9, 0x12, 0xff, 0x00, 0xab, 0xc9, 0x8b, 0x9e, 0x96,
-	0x30, 0x6d, 0xc2, 0x62, 0x72, 0x78, 0xeb, 0xc1, 0xae, 0x30, 0x87, 0xb5, 0xc4, 0x81, 0x19, 0x3d,
-	0xd3, 0x7a, 0xd9, 0x81, 0x25, 0xff, 0x06, 0x2d, 0xbd, 0xac, 0x32, 0x5b, 0xf8, 0x2d, 0x3c, 0x30,
-	0x83, 0xdb, 0x94, 0xf6, 0x94, 0xde, 0x3e, 0x81, 0x07, 0xbd, 0x49, 0x2c, 0xeb, 0x33, 0x2f, 0xc0,
-	0xff, 0xa8, 0xc0, 0x86, 0x71, 0x7d, 0x26, 0x69, 0xf4, 0x92, 0x49, 0x35, 0xad, 0xfb, 0xe7, 0xb0,
-	0xd1, 0x2b, 0xc2, 0xb3, 0x21, 0x14, 0x0b, 0xf1, 0xbf, 0x2b, 0xe0, 0x98, 0x30, 0xf4, 0xdd, 0x2d,
-	0x87, 0x52, 0xd1, 0x70, 0xea, 0xb4, 0xbf, 0x00, 0xa7, 0x57, 0x02, 0x69, 0x83, 0x29, 0x95, 0xe3,
-	0x21, 0x2c, 0x27, 0x6d, 0x33, 0x5d, 0x08, 0xdb, 0x50, 0xa7, 0x6f, 0x99, 0x6a, 0x0a, 0x3f, 0x71,
-	0xb9, 0xe0, 0x8e, 0xd6, 0xba, 0xf6, 0xa4, 0xf2, 0x5f, 0xc7, 0xca, 0x52, 0x25, 0xbb, 0xc2, 0xdf,
-	0xc1, 0x7d, 0x93, 0x89, 0x8e, 0x26, 0x84, 0xef, 0xd9, 0xb6, 0xf9, 0x46, 0x9c, 0x2f, 0x6c, 0xc4,
-	0xaf, 0x6d, 0x9d, 0x25, 0xd8, 0x53, 0x9d, 0x0d, 0x0b, 0x58, 0xd1, 0xdc, 0xe5, 0x47, 0xfa, 0xa1,
-	0xd3, 0xea, 0x73, 0xd8, 0x8c, 0xf9, 0xa5, 0x31, 0x3d, 0x2d, 0x0a, 0xba, 0x44, 0x8a, 0xdf, 0xc0,
-	0x83, 0x84, 0x89, 0x1f, 0xc6, 0x61, 0xff, 0x43, 0x9d, 0x6e, 0x43, 0xdd, 0x8f, 0xc3, 0x7e, 0x87,
-	0xa8, 0x2b, 0xfb, 0xf2, 0x47, 0x6b, 0x7c, 0x01, 0x1f, 0x75, 0x5b, 0xe7, 0xb3, 0xe8, 0x3d, 0x3d,
-	0xcc, 0xe8, 0xc0, 0x50, 0x06, 0x3b, 0x88, 0xed, 0x12, 0xff, 0xbd, 0x02, 0x5b, 0x2f, 0xcd, 0x6f,
-	0xc3, 0x36, 0x25, 0x32, 0x8e, 0xa8, 0xbe, 0x9d, 0x66, 0xd0, 0xea, 0xc1, 0x24, 0xa6, 0x75, 0x9c,
-	0x17, 0xe0, 0xef, 0x61, 0xeb, 0x84, 0xff, 0x85, 0x7a, 0x2a, 0x89, 0xa3, 0x4b, 0xbd, 0x88, 0xaa,
-	0x99, 0x5d, 0x35, 0xcf, 0xfe, 0xb7, 0x06, 0xd5, 0x66, 0xe8, 0xa3, 0x57, 0x80, 0xba, 0x43, 0xee,
-	0x8d, 0x5f, 0x77, 0xe8, 0xe7, 0x85, 0x90, 0x89, 0xf3, 0xed, 0xf2, 0xc3, 0xe2, 0x39, 0xf4, 0x1a,
-	0xd6, 0x3a, 0x24, 0x96, 0x74, 0x66, 0x80, 0xdf, 0xc2, 0xc6, 0x19, 0xef, 0xcf, 0x14, 0xb2, 0x0b,
-	0xeb, 0x49, 0x2f, 0x4c, 0x20, 0xe6, 0x89, 0xda, 0x58, 0xcb, 0xdc, 0x0e, 0xea, 0xc2, 0xe6, 0x99,
-	0xed, 0x84, 0x99, 0x05, 0x7a, 0x0a, 0x4e, 0x57, 0x5c, 0x2a, 0x97, 0x5e, 0x08, 0xa1, 0x66, 0x86,
-	0xea, 0xc2, 0x66, 0xf7, 0x2a, 0x56, 0xbe, 0xf8, 0x1b, 0x9f, 0x19, 0xe6, 0x2b, 0x40, 0xdf, 0xb0,
-	0x20, 0x98, 0x19, 0x5e, 0x07, 0xd6, 0x0f, 0x69, 0x40, 0xd5, 0xec, 0x72, 0xf9, 0x06, 0x36, 0x12,
-	0xc6, 0x36, 0x09, 0xf9, 0xcb, 0xfc, 0x17, 0x84, 0x09, 0x66, 0x77, 0x67, 0xc5, 0xeb, 0x0e, 0x1a,
-	0x19, 0x9d, 0x92, 0xa8, 0x47, 0xd5, 0x14, 0x91, 0xfe, 0x11, 0x1e, 0x36, 0x09, 0xf7, 0xe8, 0x44,
-	0x36, 0x47, 0x0e, 0xa6, 0x7c, 0xf5, 0xac, 0xc7, 0x49, 0x90, 0x04, 0xd9, 0x11, 0x7e, 0x33, 0xa0,
-	0x84, 0xc7, 0xfd, 0x29, 0x30, 0xff, 0x04, 0x8f, 0x8f, 0x18, 0x27, 0x01, 0x9b, 0x2c, 0xfc, 0x59,
-	0x04, 0xfc, 0x0a, 0xd0, 0x57, 0x42, 0xf5, 0x83, 0xb8, 0xf7, 0x95, 0x90, 0xea, 0x90, 0x0e, 0x98,
-	0x47, 0xe5, 0x14, 0x78, 0x6d, 0x68, 0x1c, 0x53, 0x95, 0xb0, 0x45, 0xf4, 0x30, 0xa7, 0x99, 0xe5,
-	0xbd, 0xdb, 0x8f, 0xf3, 0xbf, 0x67, 0xc6, 0x68, 0xac, 0x29, 0xaa, 0xd5, 0x11, 0x9c, 0xe1, 0x86,
-	0x77, 0x61, 0xfe, 0xaa, 0x04, 0x73, 0x8c, 0xb9, 0x9a, 0x11, 0xb5, 0x7c, 0x4c, 0xd5, 0x88, 0x65,
-	0xde, 0x05, 0x8b, 0x73, 0xe2, 0x1c, 0x41, 0x35, 0xa0, 0xf5, 0x63, 0x6a, 0xd8, 0xdc, 0x9d, 0x71,
-	0x3e, 0x29, 0x06, 0xcc, 0x31, 0xc1, 0x39, 0xf4, 0x67, 0x93, 0x82, 0x0c, 0x2b, 0xbb, 0x0b, 0xfa,
-	0xe3, 0x62, 0xe8, 0x22, 0x5e, 0x37, 0x87, 0x0e, 0xa0, 0xa6, 0xd9, 0xcf, 0x5d, 0x98, 0xb7, 0xbe,
-	0xf3, 0x16, 0xd4, 0x34, 0x3b, 0x44, 0xbf, 0xc8, 0x63, 0xdc, 0xfc, 0xd6, 0xda, 0x7e, 0x58, 0x22,
-	0xcd, 0x0c, 0xe3, 0xc6, 0x88, 0x8d, 0x15, 0x0c, 0x8d, 0x49, 0x16, 0x58, 0xf6, 0x4e, 0xb2, 0x64,
-	0xce, 0x74, 0x8f, 0x33, 0xd1, 0x35, 0x23, 0xd2, 0x84, 0x70, 0xc9, 0xb7, 0xcd, 0x0c, 0xa3, 0xba,
-	0x6b, 0xe6, 0xe9, 0x77, 0x93, 0xf9, 0x64, 0xfd, 0xe1, 0xe5, 0x59, 0xf0, 0xbd, 0xdb, 0xce, 0x91,
-	0x1c, 0x6b, 0x68, 0x76, 0xce, 0xe4, 0x94, 0x97, 0x5d, 0x0e, 0xd3, 0x7e, 0x3a, 0x9e, 0x86, 0x8f,
-	0xc0, 0x31, 0x55, 0x96, 0x30, 0xde, 0x75, 0xfc, 0x9d, 0xfc, 0x37, 0xb1, 0x71, 0xa6, 0x89, 0xe7,
-	0x10, 0x81, 0xf5, 0x63, 0xaa, 0x72, 0xe4, 0xf0, 0xf6, 0x10, 0x7f, 0x9d, 0x13, 0x96, 0xb2, 0x4b,
-	0x3c, 0x87, 0xbe, 0x07, 0x94, 0xa7, 0x7e, 0x28, 0x8f, 0x51, 0xca, 0x0f, 0x6f, 0x4d, 0xc9, 0x41,
-	0xed, 0xbb, 0xf9, 0xc1, 0xd3, 0x8b, 0x45, 0xf3, 0x3f, 0x8e, 0xdf, 0xfe, 0x14, 0x00, 0x00, 0xff,
-	0xff, 0xbf, 0xe8, 0xe7, 0x81, 0x10, 0x19, 0x00, 0x00,
+	// 1842 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x73, 0xdb, 0xb8,
+	0x11, 0xb7, 0x2c, 0xd9, 0x91, 0xd6, 0x7f, 0x92, 0x20, 0xb6, 0x4b, 0xab, 0x17, 0xc7, 0xe5, 0x74,
+	0x32, 0xbe, 0xce, 0x9d, 0xdd, 0xa4, 0xb9, 0x9b, 0x4e, 0xa6, 0xd3, 0xb9, 0xb3, 0x2c, 0xfb, 0x7c,
+	0x89, 0x12, 0x1d, 0x65, 0x3b, 0xd3, 0x6b, 0xaf, 0x37, 0x30, 0x09, 0x49, 0xa8, 0x49, 0x80, 0x25,
+	0x40, 0xd5, 0xca, 0x53, 0x67, 0xae, 0xd3, 0x87, 0xce, 0xf4, 0xf3, 0xf5, 0xa9, 0x1f, 0xa3, 0x2f,
+	0xed, 0x4b, 0x07, 0x20, 0x29, 0x53, 0x22, 0x69, 0xc5, 0x27, 0x3d, 0x19, 0xc0, 0xee, 0xfe, 0xb0,
+	0x58, 0xec, 0x2e, 0x7e, 0xa2, 0xe1, 0x63, 0xff, 0xaa, 0x77, 0xd0, 0xc7, 0xcc, 0x71, 0x49, 0xf0,
+	0xa9, 0x8b, 0x43, 0x66, 0xf7, 0x49, 0xf0, 0xa9, 0xcd, 0xbd, 0x03, 0xdb, 0x73, 0x0e, 0x06, 0xcf,
+	0xd4, 0x9f, 0x7d, 0x3f, 0xe0, 0x92, 0xa3, 0xfb, 0x57, 0xe1, 0x25, 0x19, 0xd0, 0x40, 0xee, 0xab,
+	0xb5, 0xc1, 0x33, 0xb3, 0x0b, 0x8f, 0xbe, 0x21, 0x5e, 0x78, 0x41, 0x02, 0x41, 0x39, 0xb3, 0x88,
+	0xf0, 0x39, 0x13, 0x04, 0x7d, 0x06, 0xd5, 0x20, 0x1e, 0x1b, 0xa5, 0xdd, 0xd2, 0xde, 0xca, 0xf3,
+	0xed, 0xfd, 0x09, 0xd3, 0xfd, 0x44, 0xd9, 0x1a, 0xa9, 0x22, 0x03, 0xee, 0x0d, 0x22, 0x24, 0x63,
+	0x71, 0xb7, 0xb4, 0x57, 0xb3, 0x92, 0xa9, 0xf9, 0x04, 0xca, 0x17, 0xad, 0x53, 0xad, 0xe0, 0xd1,
+	0xaf, 0x05, 0x67, 0x1a, 0x76, 0xd5, 0x4a, 0xa6, 0xe6, 0x33, 0x28, 0x37, 0xda, 0xe7, 0x68, 0x1d,
+	0x16, 0xa9, 0xa3, 0x65, 0x6b, 0xd6, 0x22, 0x75, 0x50, 0x1d, 0xaa, 0x82, 0x5e, 0xba, 0x94, 0xf5,
+	0x84, 0xb1, 0xb8, 0x5b, 0xde, 0x5b, 0xb3, 0x46, 0x73, 0xf3, 0x00, 0xee, 0x75, 0xa2, 0x71, 0xc6,
+	0x6c, 0x03, 0x96, 0x06, 0xd8, 0x0d, 0x89, 0x76, 0xa3, 0x62, 0x45, 0x13, 0xb3, 0x09, 0x4b, 0x6d,
+	0xdc, 0x23, 0x42, 0x89, 0x6d, 0x1e, 0x32, 0xa9, 0x2d, 0x2a, 0x56, 0x34, 0x41, 0x08, 0x2a, 0x21,
+	0xa3, 0x32, 0x76, 0x5d, 0x8f, 0xd5, 0x9a, 0xa0, 0xef, 0x89, 0x51, 0xd6, 0xd0, 0x7a, 0x6c, 0xbe,
+	0x80, 0xe5, 0x16, 0xf1, 0x78, 0x30, 0x44, 0x5b, 0xb0, 0x8c, 0xbd, 0x14, 0x50, 0x3c, 0xcb, 0x43,
+	0x32, 0xff, 0x55, 0x82, 0x4a, 0x83, 0xb8, 0x6e, 0xc6, 0xd7, 0x03, 0x58, 0xf6, 0x34, 0x9c, 0x56,
+	0x5f, 0x79, 0xfe, 0x93, 0x4c, 0xa4, 0xa3, 0xdd, 0xac, 0x58, 0x0d, 0x7d, 0x02, 0x4b, 0xbe, 0x3a,
+	0x86, 0x51, 0xde, 0x2d, 0xef, 0xad, 0x3c, 0xdf, 0xca, 0xe8, 0xeb, 0x43, 0x5a, 0x91, 0x12, 0xfa,
+	0x1c, 0x6a, 0x0e, 0x15, 0x12, 0x33, 0x9b, 0x08, 0xa3, 0xa2, 0x2d, 0x8c, 0x8c, 0x45, 0x1c, 0x47,
+	0xeb, 0x46, 0x15, 0xed, 0x41, 0xc5, 0xf6, 0x43, 0x61, 0x2c, 0x69, 0x93, 0x8d, 0x8c, 0x49, 0xa3,
+	0x7d, 0x6e, 0x69, 0x0d, 0xf3, 0x0b, 0xa8, 0x9e, 0x71, 0x9f, 0xbb, 0xbc, 0x37, 0x44, 0x2f, 0x00,
+	0x58, 0xe8, 0xe1, 0xef, 0x6d, 0xe2, 0xba, 0xc2, 0x28, 0x69, 0xdb, 0xcd, 0xac, 0x2d, 0x71, 0x5d,
+	0xab, 0xa6, 0x14, 0xd5, 0x48, 0x98, 0xff, 0x28, 0xc1, 0x72, 0xa7, 0x75, 0x48, 0xb9, 0x40, 0x26,
+	0xac, 0x7a, 0x98, 0x85, 0x5d, 0x6c, 0xcb, 0x30, 0x20, 0x81, 0x8e, 0x53, 0xcd, 0x1a, 0x5b, 0x53,
+	0x59, 0xe4, 0x07, 0xdc, 0x09, 0xed, 0x24, 0xc2, 0xc9, 0x34, 0x9d, 0x80, 0xe5, 0xb1, 0x04, 0x44,
+	0x0f, 0xa0, 0x2c, 0xae, 0x42, 0xa3, 0xa2, 0x57, 0xd5, 0x50, 0x5d, 0x5e, 0x17, 0x7b, 0xd4, 0x1d,
+	0x1a, 0x4b, 0x7a, 0x31, 0x9e, 0x99, 0x7f, 0x2f, 0x41, 0xf5, 0x88, 0x8a, 0xab, 0x53, 0xd6, 0xe5,
+	0x5a, 0x89, 0x07, 0x1e, 0x96, 0xb1, 0x23, 0xf1, 0x0c, 0xed, 0xc2, 0xca, 0x25, 0xb6, 0xaf, 0x28,
+	0xeb, 0x1d, 0x53, 0x97, 0xc4, 0x6e, 0xa4, 0x97, 0xd0, 0x0e, 0x80, 0xf2, 0x17, 0xbb, 0x9d, 0x24,
+	0x7f, 0x2a, 0x56, 0x6a, 0x45, 0x21, 0xa8, 0x90, 0x24, 0x0a, 0x15, 0xad, 0x90, 0x5e, 0x32, 0xff,
+	0x53, 0x82, 0xb5, 0x86, 0x1b, 0x0a, 0x49, 0x82, 0x06, 0x67, 0x5d, 0xda, 0x43, 0xfb, 0x80, 0x9a,
+	0xd7, 0x3e, 0x66, 0x8e, 0xf2, 0x4f, 0x34, 0x19, 0xbe, 0x74, 0x49, 0x94, 0x4a, 0x55, 0x2b, 0x47,
+	0x82, 0x7e, 0x03, 0xdb, 0xc7, 0x01, 0x21, 0x2a, 0x1f, 0x2c, 0xe2, 0xf3, 0x40, 0x52, 0xd6, 0x3b,
+	0xa2, 0x22, 0x32, 0x5b, 0xd4, 0x66, 0xc5, 0x0a, 0xe8, 0x25, 0x18, 0x87, 0xdc, 0xee, 0x8b, 0x23,
+	0x2a, 0x7c, 0x17, 0x0f, 0x8f, 0x79, 0xd0, 0x3c, 0x3e, 0x3d, 0x09, 0x89, 0x90, 0x42, 0x9f, 0xa7,
+	0x6a, 0x15, 0xca, 0x95, 0x6d, 0x87, 0x04, 0x14, 0xbb, 0x0d, 0xce, 0x04, 0x77, 0xc9, 0x6b, 0x7e,
+	0xb3, 0x71, 0x25, 0xb2, 0x2d, 0x92, 0x9b, 0xff, 0x5b, 0x82, 0xcd, 0x8b, 0x28, 0x0e, 0x2d, 0x6c,
+	0xf7, 0x29, 0x23, 0x6f, 0x7d, 0x49, 0x39, 0x13, 0xe8, 0x15, 0x6c, 0x8c, 0x0b, 0xa2, 0xa4, 0x31,
+	0x4a, 0x05, 0x85, 0x13, 0x89, 0xad, 0x5c, 0x23, 0xf4, 0x02, 0x36, 0x5b, 0xc4, 0x3b, 0xc4, 0xae,
+	0xcb, 0x39, 0xeb, 0x48, 0x2c, 0x45, 0x9b, 0x04, 0x94, 0x47, 0x81, 0x59, 0xb3, 0xf2, 0x85, 0xe8,
+	0x97, 0xf0, 0xa8, 0x1d, 0x10, 0xb5, 0x6e, 0x63, 0x49, 0x9c, 0x0b, 0xee, 0x86, 0x5e, 0x5c, 0x8a,
+	0x35, 0x2b, 0x4f, 0xa4, 0x7a, 0xa9, 0x8c, 0xcb, 0xc3, 0xa8, 0x14, 0xf4, 0xd2, 0xa4, 0x7e, 0xac,
+	0x91, 0x2a, 0xea, 0x40, 0x4d, 0xdf, 0xa5, 0x4a, 0xc3, 0xb8, 0x08, 0x3f, 0xcb, 0xd8, 0xe5, 0x86,
+	0x69, 0x7f, 0x64, 0xd7, 0x64, 0x32, 0x18, 0x5a, 0x37, 0x38, 0x05, 0x09, 0xb4, 0x5c, 0x98, 0x40,
+	0x47, 0xb0, 0x66, 0xa7, 0x33, 0xd0, 0xb8, 0xa7, 0x0f, 0xb0, 0x93, 0xad, 0xe8, 0xb4, 0x96, 0x35,
+	0x6e, 0x84, 0x7e, 0x28, 0xc1, 0x36, 0x65, 0x92, 0x04, 0x5d, 0x6c, 0x93, 0x23, 0xee, 0x61, 0xca,
+	0xbe, 0x94, 0x12, 0xdb, 0x7d, 0x8f, 0x30, 0x69, 0x54, 0xf5, 0xd9, 0x9a, 0x1f, 0x78, 0xb6, 0xd3,
+	0x22, 0x9c, 0xe8, 0xac, 0xc5, 0xfb, 0xd4, 0xdf, 0xc1, 0xfa, 0x78, 0x60, 0x54, 0x4f, 0xb8, 0x22,
+	0xc3, 0xb8, 0xb2, 0xd5, 0x10, 0x1d, 0xa4, 0xdf, 0x8d, 0xbc, 0x8b, 0x4a, 0x1a, 0x43, 0xfc, 0xa4,
+	0xbc, 0x5c, 0xfc, 0x75, 0xa9, 0xfe, 0x1a, 0x76, 0x6e, 0xf7, 0x2a, 0x67, 0xa3, 0xb1, 0x07, 0xaa,
+	0x96, 0x42, 0x33, 0x07, 0x00, 0x17, 0xad, 0x53, 0x8b, 0xfc, 0x59, 0x15, 0x12, 0x7a, 0x0a, 0xe5,
+	0x81, 0x47, 0xe3, 0x04, 0xcf, 0x36, 0x61, 0xa5, 0xa9, 0x14, 0xd0, 0x17, 0x70, 0x8f, 0x47, 0x11,
+	0x8a, 0x5d, 0x7f, 0xfa, 0x61, 0xf1, 0xb4, 0x12, 0x33, 0xf3, 0x0c, 0x1e, 0xb4, 0x68, 0x2f, 0xc0,
+	0x52, 0xf3, 0x80, 0xbb, 0xed, 0x6e, 0x8c, 0xef, 0xbe, 0x7a, 0x83, 0xfa, 0x43, 0x09, 0x56, 0x9a,
+	0xd7, 0xc4, 0x4e, 0x10, 0x77, 0x00, 0x1c, 0x1d, 0xa2, 0x37, 0xd8, 0x23, 0x71, 0x40, 0x52, 0x2b,
+	0x0a, 0xa9, 0xc1, 0x3d, 0x0f, 0x33, 0x27, 0x69, 0xed, 0xf1, 0x54, 0xbd, 0xa9, 0x5f, 0x06, 0xbd,
+	0xa4, 0xd2, 0xf4, 0x18, 0x3d, 0x85, 0x75, 0x49, 0x3d, 0xc2, 0x43, 0xd9, 0x21, 0x36, 0x67, 0x8e,
+	0xd0, 0x05, 0xb6, 0x64, 0x4d, 0xac, 0x9a, 0xeb, 0xb0, 0xda, 0xf4, 0x7c, 0x39, 0x8c, 0xbd, 0x30,
+	0x7f, 0x0b, 0x55, 0x2b, 0xc5, 0x59, 0x44, 0x68, 0xdb, 0x44, 0x88, 0xb8, 0x91, 0x26, 0x53, 0x25,
+	0xf1, 0x88, 0x10, 0xb8, 0x97, 0xdc, 0x52, 0x32, 0x35, 0xbf, 0x87, 0xf5, 0xe8, 0xa2, 0x67, 0x25,
+	0x4c, 0x5b, 0xb0, 0x1c, 0x1d, 0x3e, 0xde, 0x21, 0x9e, 0x99, 0x0c, 0x1e, 0x45, 0x1b, 0xe8, 0xd6,
+	0x33, 0xeb, 0x2e, 0xbb, 0xb0, 0xe2, 0xdc, 0xa0, 0x25, 0x8f, 0x55, 0x6a, 0xc9, 0xbc, 0x86, 0x87,
+	0xba, 0x71, 0xeb, 0xd4, 0x9e, 0x71, 0xb7, 0x4f, 0xe0, 0x61, 0x6f, 0x12, 0x2b, 0xde, 0x33, 0x2b,
+	0x30, 0xff, 0x56, 0x82, 0x4d, 0xbd, 0xf5, 0xb9, 0x20, 0xc1, 0x6b, 0x2a, 0xe4, 0xac, 0xdb, 0xbf,
+	0x80, 0xcd, 0x5e, 0x1e, 0x5e, 0xec, 0x42, 0xbe, 0xd0, 0xfc, 0x67, 0x09, 0x0c, 0xed, 0x86, 0x7a,
+	0xbb, 0xc5, 0x50, 0x48, 0xe2, 0xcd, 0x1c, 0xf6, 0x97, 0x60, 0xf4, 0x0a, 0x20, 0x63, 0x67, 0x0a,
+	0xe5, 0xe6, 0x10, 0x56, 0xa3, 0xb2, 0x99, 0xcd, 0x85, 0x3a, 0x54, 0xc9, 0x35, 0x95, 0x0d, 0xee,
+	0x44, 0x5b, 0x2e, 0x59, 0xa3, 0xb9, 0xca, 0x3d, 0x21, 0x9d, 0xb7, 0xa1, 0x8c, 0xa9, 0x52, 0x3c,
+	0x33, 0xbf, 0x85, 0x07, 0x3a, 0x12, 0x6d, 0x45, 0x08, 0x3f, 0xb0, 0x6c, 0xb3, 0x85, 0xb8, 0x98,
+	0x5b, 0x88, 0x5f, 0xc3, 0xc3, 0x14, 0xf6, 0x4c, 0x67, 0x33, 0x39, 0xac, 0x29, 0xee, 0xf2, 0x9e,
+	0xdc, 0xb5, 0x5b, 0x7d, 0x0e, 0x5b, 0x21, 0xeb, 0x6a, 0xd3, 0xb3, 0x3c, 0xa7, 0x0b, 0xa4, 0xe6,
+	0x3b, 0x78, 0x18, 0x31, 0xf1, 0xa3, 0xd0, 0xf3, 0xef, 0xba, 0x69, 0x1d, 0xaa, 0x4e, 0xe8, 0xf9,
+	0x6d, 0x2c, 0xfb, 0xf1, 0xe5, 0x8f, 0xe6, 0xe6, 0x25, 0xdc, 0xef, 0x34, 0x2f, 0xe6, 0x51, 0x7b,
+	0xaa, 0x99, 0x91, 0x81, 0xa6, 0x0c, 0x71, 0x23, 0x8e, 0xa7, 0xe6, 0x5f, 0x4b, 0xb0, 0xfd, 0x5a,
+	0xff, 0x36, 0x6c, 0x11, 0x2c, 0xc2, 0x80, 0xa8, 0xd7, 0x69, 0x0e, 0xa5, 0xee, 0x4e, 0x62, 0xc6,
+	0x1b, 0x67, 0x05, 0xe6, 0x77, 0xb0, 0x7d, 0xca, 0xfe, 0x44, 0x6c, 0x19, 0xf9, 0xd1, 0x21, 0x76,
+	0x40, 0xe4, 0xfc, 0x9e, 0x9a, 0x7f, 0x97, 0x60, 0xed, 0x10, 0xdb, 0x57, 0xe1, 0x9d, 0xef, 0x66,
+	0x07, 0xe0, 0x52, 0x1b, 0xea, 0xec, 0x8e, 0x6e, 0x27, 0xb5, 0xa2, 0xb2, 0xdb, 0xee, 0x13, 0xfb,
+	0xca, 0xe7, 0x94, 0x49, 0xad, 0x13, 0x55, 0xcc, 0xc4, 0x2a, 0xda, 0x83, 0xfb, 0x94, 0xd9, 0xd1,
+	0x69, 0xb1, 0x7b, 0x1c, 0x70, 0x2f, 0xfe, 0xbd, 0x31, 0xb9, 0xac, 0x76, 0x94, 0x38, 0xe8, 0x11,
+	0xa9, 0xf3, 0x21, 0xfa, 0xfd, 0x91, 0x5a, 0x51, 0x8f, 0x9d, 0x1f, 0xba, 0x6e, 0xcc, 0xcc, 0xf4,
+	0xf8, 0xf9, 0x7f, 0x37, 0xa1, 0xdc, 0xf0, 0x1c, 0xf4, 0x06, 0x50, 0x67, 0xc8, 0xec, 0xf1, 0xe7,
+	0x1c, 0xfd, 0x34, 0xf7, 0x78, 0x51, 0x20, 0xea, 0xc5, 0x97, 0x69, 0x2e, 0xa0, 0xb7, 0xf0, 0xa8,
+	0x8d, 0x43, 0x41, 0xe6, 0x06, 0xf8, 0x0d, 0x6c, 0x9e, 0x33, 0x7f, 0xae, 0x90, 0x1d, 0xd8, 0x88,
+	0x6a, 0x7d, 0x02, 0x31, 0x4b, 0x44, 0xc7, 0x5a, 0xc2, 0xed, 0xa0, 0x16, 0x6c, 0x9d, 0xb3, 0x6e,
+	0x1e, 0xec, 0x8f, 0x77, 0xf4, 0x0c, 0x8c, 0x0e, 0xef, 0x4a, 0x8b, 0x5c, 0x72, 0x2e, 0xe7, 0x86,
+	0x6a, 0xc1, 0x56, 0xa7, 0x1f, 0x4a, 0x87, 0xff, 0x85, 0xcd, 0x0d, 0xf3, 0x0d, 0xa0, 0x57, 0xd4,
+	0x75, 0xe7, 0x86, 0xd7, 0x86, 0x8d, 0x23, 0xe2, 0x12, 0x39, 0xbf, 0x58, 0xbe, 0x83, 0xcd, 0x88,
+	0x91, 0x4e, 0x42, 0xfe, 0x2c, 0x63, 0x35, 0xc9, 0x5c, 0xa7, 0x66, 0xbc, 0xaa, 0xa0, 0x91, 0xd1,
+	0x99, 0x2e, 0xbc, 0x19, 0x3c, 0xfd, 0x1d, 0x3c, 0x6e, 0xa8, 0xaf, 0x26, 0x13, 0xd1, 0x1c, 0x6d,
+	0x30, 0xe3, 0xd5, 0xd3, 0x1e, 0xc3, 0x6e, 0xe4, 0x64, 0x9b, 0x3b, 0x0d, 0x97, 0x60, 0x16, 0xfa,
+	0x33, 0x60, 0xfe, 0x1e, 0x9e, 0x1c, 0x53, 0x86, 0x5d, 0xfa, 0x9e, 0xcc, 0xdf, 0xe1, 0x37, 0x80,
+	0xbe, 0xe2, 0xd2, 0x77, 0xc3, 0xde, 0x57, 0x5c, 0xc8, 0x23, 0x32, 0xa0, 0x36, 0x11, 0x33, 0xe0,
+	0xb5, 0xa0, 0x76, 0x42, 0x64, 0xc4, 0x86, 0xd1, 0xe3, 0x8c, 0x66, 0x9a, 0xd7, 0xd7, 0x9f, 0x64,
+	0x7f, 0xaf, 0x8d, 0xd1, 0x74, 0x9d, 0x54, 0xeb, 0x23, 0x38, 0xcd, 0x7d, 0xa7, 0x61, 0xfe, 0xbc,
+	0x00, 0x73, 0x8c, 0x99, 0xeb, 0x16, 0xb5, 0x7a, 0x42, 0xe4, 0x88, 0x45, 0x4f, 0x83, 0x35, 0x33,
+	0xe2, 0x0c, 0x01, 0xd7, 0xa0, 0xd5, 0x13, 0xa2, 0xd9, 0xea, 0x54, 0x3f, 0x9f, 0xe6, 0x03, 0x66,
+	0x98, 0xee, 0x02, 0xfa, 0x83, 0x0e, 0x41, 0x8a, 0x75, 0x4e, 0x83, 0xfe, 0x38, 0x1f, 0x3a, 0x8f,
+	0xb7, 0x2e, 0xa0, 0x43, 0xa8, 0x28, 0x76, 0x37, 0x0d, 0xf3, 0xd6, 0x3b, 0x6f, 0x42, 0x45, 0xb1,
+	0x5f, 0xf4, 0x51, 0x16, 0xe3, 0xe6, 0xb7, 0x64, 0xfd, 0x71, 0x81, 0x34, 0xd5, 0x8c, 0x6b, 0x23,
+	0xb6, 0x99, 0xd3, 0x34, 0x26, 0x59, 0x6e, 0xdd, 0xbc, 0x4d, 0x25, 0x55, 0x3d, 0xc6, 0x44, 0xd5,
+	0x8c, 0x48, 0x21, 0x32, 0x0b, 0xbe, 0xdd, 0xa6, 0x18, 0xe3, 0xb4, 0x9e, 0xa7, 0xee, 0x26, 0xf5,
+	0x49, 0xfe, 0xee, 0xe9, 0x99, 0xf3, 0x3d, 0x3f, 0xee, 0x23, 0x19, 0xd6, 0xd0, 0x68, 0x9f, 0x8b,
+	0x19, 0x1f, 0xbb, 0x0c, 0x66, 0x74, 0xe0, 0x99, 0xf8, 0x08, 0x9c, 0x10, 0x19, 0x13, 0xe2, 0x69,
+	0xc7, 0xdf, 0xcd, 0x88, 0x27, 0x98, 0xb4, 0xb9, 0x80, 0x30, 0x6c, 0x9c, 0x10, 0x99, 0x21, 0xbf,
+	0xb7, 0xbb, 0xf8, 0x8b, 0x8c, 0xb0, 0x90, 0x3d, 0x9b, 0x0b, 0xe8, 0x3b, 0x40, 0x59, 0x6a, 0x8b,
+	0xb2, 0x18, 0x85, 0xfc, 0xf7, 0xf6, 0x90, 0xfc, 0x11, 0x3e, 0xca, 0x7d, 0x09, 0x93, 0x4f, 0x8c,
+	0xb3, 0x3e, 0x88, 0x1d, 0xd8, 0x88, 0x98, 0xf3, 0x54, 0x7a, 0x35, 0x46, 0xb0, 0x6f, 0x07, 0x7d,
+	0x05, 0xab, 0xc7, 0x94, 0x51, 0xd1, 0x8f, 0x6c, 0x66, 0x02, 0x3b, 0xac, 0x7c, 0xbb, 0x38, 0x78,
+	0x76, 0xb9, 0xac, 0xff, 0x8b, 0xf5, 0xab, 0xff, 0x0f, 0x00, 0xf9, 0x35, 0x96, 0x01, 0xf2, 0x1a,
+	0x00, 0x00,
 }
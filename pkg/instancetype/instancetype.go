@@ -444,6 +444,8 @@ func (m *InstancetypeMethods) ApplyToVmi(field *k8sfield.Path, instancetypeSpec
 		conflicts = append(conflicts, applyCPU(field, instancetypeSpec, preferenceSpec, vmiSpec)...)
 		conflicts = append(conflicts, applyMemory(field, instancetypeSpec, vmiSpec)...)
 		conflicts = append(conflicts, applyIOThreadPolicy(field, instancetypeSpec, vmiSpec)...)
+		conflicts = append(conflicts, applyDiskIOTune(field, instancetypeSpec, vmiSpec)...)
+		conflicts = append(conflicts, applyInterfaceBandwidth(field, instancetypeSpec, vmiSpec)...)
 		conflicts = append(conflicts, applyLaunchSecurity(field, instancetypeSpec, vmiSpec)...)
 		conflicts = append(conflicts, applyGPUs(field, instancetypeSpec, vmiSpec)...)
 		conflicts = append(conflicts, applyHostDevices(field, instancetypeSpec, vmiSpec)...)
@@ -1155,6 +1157,49 @@ func applyIOThreadPolicy(field *k8sfield.Path, instancetypeSpec *instancetypev1b
 	return nil
 }
 
+func applyDiskIOTune(field *k8sfield.Path, instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) Conflicts {
+	if instancetypeSpec.DiskIOTune == nil {
+		return nil
+	}
+
+	var conflicts Conflicts
+	for i := range vmiSpec.Domain.Devices.Disks {
+		disk := &vmiSpec.Domain.Devices.Disks[i]
+		if disk.CDRom != nil {
+			continue
+		}
+		if disk.IOTune != nil {
+			conflicts = append(conflicts, field.Child("domain", "devices", "disks").Index(i).Child("ioTune"))
+			continue
+		}
+		disk.IOTune = instancetypeSpec.DiskIOTune.DeepCopy()
+	}
+
+	return conflicts
+}
+
+func applyInterfaceBandwidth(field *k8sfield.Path, instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) Conflicts {
+	if instancetypeSpec.InterfaceBandwidth == nil {
+		return nil
+	}
+
+	var conflicts Conflicts
+	for i := range vmiSpec.Domain.Devices.Interfaces {
+		iface := &vmiSpec.Domain.Devices.Interfaces[i]
+		// Only the bridge and masquerade bindings support bandwidth limits
+		if iface.Bridge == nil && iface.Masquerade == nil {
+			continue
+		}
+		if iface.Bandwidth != nil {
+			conflicts = append(conflicts, field.Child("domain", "devices", "interfaces").Index(i).Child("bandwidth"))
+			continue
+		}
+		iface.Bandwidth = instancetypeSpec.InterfaceBandwidth.DeepCopy()
+	}
+
+	return conflicts
+}
+
 func applyLaunchSecurity(field *k8sfield.Path, instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) Conflicts {
 	if instancetypeSpec.LaunchSecurity == nil {
 		return nil
@@ -1312,6 +1312,78 @@ var _ = Describe("Instancetype and Preferences", func() {
 			})
 		})
 
+		Context("instancetype.Spec.DiskIOTune", func() {
+
+			BeforeEach(func() {
+				instancetypeSpec = &instancetypev1beta1.VirtualMachineInstancetypeSpec{
+					DiskIOTune: &v1.DiskIOTune{
+						TotalIOPSSec: ptr.To(uint64(100)),
+					},
+				}
+				vmi.Spec.Domain.Devices.Disks = []v1.Disk{{
+					Name:       "disk",
+					DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}},
+				}, {
+					Name:       "cdrom",
+					DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{}},
+				}}
+			})
+
+			It("should apply to the disks of the VMI", func() {
+				conflicts := instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)
+				Expect(conflicts).To(BeEmpty())
+
+				Expect(vmi.Spec.Domain.Devices.Disks[0].IOTune).To(Equal(instancetypeSpec.DiskIOTune))
+				Expect(vmi.Spec.Domain.Devices.Disks[1].IOTune).To(BeNil())
+			})
+
+			It("should detect DiskIOTune conflict", func() {
+				vmi.Spec.Domain.Devices.Disks[0].IOTune = &v1.DiskIOTune{}
+
+				conflicts := instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)
+				Expect(conflicts).To(HaveLen(1))
+				Expect(conflicts[0].String()).To(Equal("spec.template.spec.domain.devices.disks[0].ioTune"))
+			})
+		})
+
+		Context("instancetype.Spec.InterfaceBandwidth", func() {
+
+			BeforeEach(func() {
+				instancetypeSpec = &instancetypev1beta1.VirtualMachineInstancetypeSpec{
+					InterfaceBandwidth: &v1.InterfaceBandwidth{
+						Inbound: &v1.InterfaceBandwidthLimit{Average: 1000},
+					},
+				}
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+					Name:                   "default",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				}, {
+					Name:                   "sriov",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+				}, {
+					Name:                   "passt",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Passt: &v1.InterfacePasst{}},
+				}}
+			})
+
+			It("should apply to the interfaces of the VMI", func() {
+				conflicts := instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)
+				Expect(conflicts).To(BeEmpty())
+
+				Expect(vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth).To(Equal(instancetypeSpec.InterfaceBandwidth))
+				Expect(vmi.Spec.Domain.Devices.Interfaces[1].Bandwidth).To(BeNil())
+				Expect(vmi.Spec.Domain.Devices.Interfaces[2].Bandwidth).To(BeNil())
+			})
+
+			It("should detect InterfaceBandwidth conflict", func() {
+				vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{}
+
+				conflicts := instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)
+				Expect(conflicts).To(HaveLen(1))
+				Expect(conflicts[0].String()).To(Equal("spec.template.spec.domain.devices.interfaces[0].bandwidth"))
+			})
+		})
+
 		Context("instancetype.Spec.LaunchSecurity", func() {
 
 			BeforeEach(func() {
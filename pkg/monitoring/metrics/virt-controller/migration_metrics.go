@@ -20,6 +20,7 @@ package virt_controller
 
 import (
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"github.com/prometheus/client_golang/prometheus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
@@ -35,6 +36,10 @@ const (
 var (
 	migrationMetrics = []operatormetrics.Metric{
 		vmiMigrationPhaseTransitionTimeFromCreation,
+		vmiMigrationEscalations,
+		vmiMigrationDuration,
+		vmiMigrationDataTransferred,
+		vmiMigrationDowntime,
 	}
 
 	vmiMigrationPhaseTransitionTimeFromCreation = operatormetrics.NewHistogramVec(
@@ -50,18 +55,81 @@ var (
 			"phase",
 		},
 	)
+
+	vmiMigrationEscalations = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_escalations_total",
+			Help: "The total number of escalation stages entered by finished VMI migrations.",
+		},
+		[]string{
+			// escalation stage the migration entered
+			"stage",
+		},
+	)
+
+	vmiMigrationDuration = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_duration_seconds",
+			Help: "Histogram of the duration of finished VMI migrations in seconds.",
+		},
+		operatormetrics.HistogramOpts{
+			Buckets: phaseTransitionTimeBuckets(),
+		},
+		[]string{
+			"namespace", "name",
+			// final phase of the vmi migration
+			"phase",
+		},
+	)
+
+	vmiMigrationDataTransferred = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_data_transferred_bytes",
+			Help: "Histogram of the data sent to the target node by finished VMI migrations in bytes.",
+		},
+		operatormetrics.HistogramOpts{
+			Buckets: dataTransferredBuckets(),
+		},
+		[]string{
+			"namespace", "name",
+			// final phase of the vmi migration
+			"phase",
+		},
+	)
+
+	vmiMigrationDowntime = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_downtime_seconds",
+			Help: "Histogram of the time VMIs were paused to switch over to the target node of a migration in seconds.",
+		},
+		operatormetrics.HistogramOpts{
+			Buckets: []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		},
+		[]string{"namespace", "name"},
+	)
 )
 
 func CreateVMIMigrationHandler(informer cache.SharedIndexInformer) error {
 	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		UpdateFunc: func(oldVMIMigration, newVMIMigration interface{}) {
 			updateVMIMigrationPhaseTransitionTimeFromCreationTime(oldVMIMigration.(*v1.VirtualMachineInstanceMigration), newVMIMigration.(*v1.VirtualMachineInstanceMigration))
+			updateVMIMigrationEscalations(oldVMIMigration.(*v1.VirtualMachineInstanceMigration), newVMIMigration.(*v1.VirtualMachineInstanceMigration))
+			updateVMIMigrationOutcome(oldVMIMigration.(*v1.VirtualMachineInstanceMigration), newVMIMigration.(*v1.VirtualMachineInstanceMigration))
 		},
 	})
 
 	return err
 }
 
+// CreateVMIMigrationOutcomeCleanupHandler deletes the per-VMI migration outcome series once the VMI is deleted
+func CreateVMIMigrationOutcomeCleanupHandler(informer cache.SharedIndexInformer) error {
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: deleteVMIMigrationOutcome,
+	})
+
+	return err
+}
+
 func updateVMIMigrationPhaseTransitionTimeFromCreationTime(oldVMIMigration *v1.VirtualMachineInstanceMigration, newVMIMigration *v1.VirtualMachineInstanceMigration) {
 	if oldVMIMigration == nil || oldVMIMigration.Status.Phase == newVMIMigration.Status.Phase {
 		return
@@ -98,3 +166,78 @@ func getVMIMigrationTransitionTimeSeconds(newVMIMigration *v1.VirtualMachineInst
 
 	return getTransitionTimeSeconds(oldTime, newTime)
 }
+
+// updateVMIMigrationEscalations counts the escalation stages which were added to the migration state
+func updateVMIMigrationEscalations(oldVMIMigration *v1.VirtualMachineInstanceMigration, newVMIMigration *v1.VirtualMachineInstanceMigration) {
+	newTransitions := escalationTransitions(newVMIMigration)
+	oldCount := len(escalationTransitions(oldVMIMigration))
+	if len(newTransitions) <= oldCount {
+		return
+	}
+
+	for _, transition := range newTransitions[oldCount:] {
+		vmiMigrationEscalations.WithLabelValues(string(transition.Stage)).Inc()
+	}
+}
+
+func escalationTransitions(migration *v1.VirtualMachineInstanceMigration) []v1.MigrationEscalationTransition {
+	if migration == nil || migration.Status.MigrationState == nil || migration.Status.MigrationState.Escalation == nil {
+		return nil
+	}
+	return migration.Status.MigrationState.Escalation.Transitions
+}
+
+// updateVMIMigrationOutcome observes the duration, the transferred data and the downtime of a
+// migration once the final migration state was stored in the migration object
+func updateVMIMigrationOutcome(oldVMIMigration *v1.VirtualMachineInstanceMigration, newVMIMigration *v1.VirtualMachineInstanceMigration) {
+	state := finalMigrationState(newVMIMigration)
+	if state == nil || finalMigrationState(oldVMIMigration) != nil {
+		return
+	}
+
+	labels := []string{newVMIMigration.Namespace, newVMIMigration.Spec.VMIName, string(newVMIMigration.Status.Phase)}
+	if diffSeconds, err := getTransitionTimeSeconds(state.StartTimestamp, state.EndTimestamp); err == nil {
+		vmiMigrationDuration.WithLabelValues(labels...).Observe(diffSeconds)
+	}
+	if state.DataTransferred > 0 {
+		vmiMigrationDataTransferred.WithLabelValues(labels...).Observe(float64(state.DataTransferred))
+	}
+	if state.Downtime != nil {
+		vmiMigrationDowntime.WithLabelValues(newVMIMigration.Namespace, newVMIMigration.Spec.VMIName).Observe(state.Downtime.Seconds())
+	}
+}
+
+func deleteVMIMigrationOutcome(obj interface{}) {
+	vmi, ok := obj.(*v1.VirtualMachineInstance)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if vmi, ok = tombstone.Obj.(*v1.VirtualMachineInstance); !ok {
+			return
+		}
+	}
+
+	labels := prometheus.Labels{"namespace": vmi.Namespace, "name": vmi.Name}
+	vmiMigrationDuration.DeletePartialMatch(labels)
+	vmiMigrationDataTransferred.DeletePartialMatch(labels)
+	vmiMigrationDowntime.DeletePartialMatch(labels)
+}
+
+func finalMigrationState(migration *v1.VirtualMachineInstanceMigration) *v1.VirtualMachineInstanceMigrationState {
+	if migration == nil || !migration.IsFinal() || migration.Status.MigrationState == nil ||
+		migration.Status.MigrationState.MigrationUID != migration.UID {
+		return nil
+	}
+	return migration.Status.MigrationState
+}
+
+func dataTransferredBuckets() []float64 {
+	const mib = 1024 * 1024
+	var buckets []float64
+	for size := float64(64 * mib); size <= 256*1024*mib; size *= 4 {
+		buckets = append(buckets, size)
+	}
+	return buckets
+}
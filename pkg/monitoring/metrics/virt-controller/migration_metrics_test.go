@@ -23,9 +23,13 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
 
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	io_prometheus_client "github.com/prometheus/client_model/go"
 
 	v1 "kubevirt.io/api/core/v1"
 )
@@ -55,6 +59,120 @@ var _ = Describe("VMI migration phase transition time histogram", func() {
 	})
 })
 
+var _ = Describe("VMI migration escalations", func() {
+	escalationCount := func(stage v1.MigrationEscalationStage) float64 {
+		dto := &io_prometheus_client.Metric{}
+		Expect(vmiMigrationEscalations.WithLabelValues(string(stage)).Write(dto)).To(Succeed())
+		return dto.Counter.GetValue()
+	}
+
+	withEscalation := func(stages ...v1.MigrationEscalationStage) *v1.VirtualMachineInstanceMigration {
+		migration := &v1.VirtualMachineInstanceMigration{}
+		migration.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			Escalation: &v1.MigrationEscalationStatus{},
+		}
+		for _, stage := range stages {
+			migration.Status.MigrationState.Escalation.Stage = stage
+			migration.Status.MigrationState.Escalation.Transitions = append(migration.Status.MigrationState.Escalation.Transitions, v1.MigrationEscalationTransition{Stage: stage})
+		}
+		return migration
+	}
+
+	It("should count the newly entered escalation stages", func() {
+		preCopy := escalationCount(v1.MigrationEscalationPreCopy)
+		postCopy := escalationCount(v1.MigrationEscalationPostCopy)
+
+		updateVMIMigrationEscalations(&v1.VirtualMachineInstanceMigration{}, withEscalation(v1.MigrationEscalationPreCopy, v1.MigrationEscalationPostCopy))
+		Expect(escalationCount(v1.MigrationEscalationPreCopy)).To(Equal(preCopy + 1))
+		Expect(escalationCount(v1.MigrationEscalationPostCopy)).To(Equal(postCopy + 1))
+	})
+
+	It("should not count escalation stages twice", func() {
+		preCopy := escalationCount(v1.MigrationEscalationPreCopy)
+		postCopy := escalationCount(v1.MigrationEscalationPostCopy)
+
+		updateVMIMigrationEscalations(withEscalation(v1.MigrationEscalationPreCopy), withEscalation(v1.MigrationEscalationPreCopy, v1.MigrationEscalationPostCopy))
+		Expect(escalationCount(v1.MigrationEscalationPreCopy)).To(Equal(preCopy))
+		Expect(escalationCount(v1.MigrationEscalationPostCopy)).To(Equal(postCopy + 1))
+	})
+})
+
+var _ = Describe("VMI migration outcome", func() {
+	histogramCount := func(histogram *operatormetrics.HistogramVec, phase v1.VirtualMachineInstanceMigrationPhase) uint64 {
+		dto := &io_prometheus_client.Metric{}
+		Expect(histogram.WithLabelValues("test-ns", "testvmi", string(phase)).(prometheus.Histogram).Write(dto)).To(Succeed())
+		return dto.Histogram.GetSampleCount()
+	}
+
+	finishedMigration := func(phase v1.VirtualMachineInstanceMigrationPhase) *v1.VirtualMachineInstanceMigration {
+		start := metav1.NewTime(time.Now().Add(-time.Minute))
+		end := metav1.Now()
+		migration := &v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testmigration", UID: "testmigration"},
+			Spec:       v1.VirtualMachineInstanceMigrationSpec{VMIName: "testvmi"},
+			Status:     v1.VirtualMachineInstanceMigrationStatus{Phase: phase},
+		}
+		migration.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			MigrationUID:    migration.UID,
+			StartTimestamp:  &start,
+			EndTimestamp:    &end,
+			DataTransferred: 1024 * 1024 * 1024,
+			Downtime:        &metav1.Duration{Duration: 50 * time.Millisecond},
+		}
+		return migration
+	}
+
+	downtimeSum := func() float64 {
+		dto := &io_prometheus_client.Metric{}
+		Expect(vmiMigrationDowntime.WithLabelValues("test-ns", "testvmi").(prometheus.Histogram).Write(dto)).To(Succeed())
+		return dto.Histogram.GetSampleSum()
+	}
+
+	It("should observe a migration once its final migration state is stored", func() {
+		duration := histogramCount(vmiMigrationDuration, v1.MigrationSucceeded)
+		data := histogramCount(vmiMigrationDataTransferred, v1.MigrationSucceeded)
+		downtime := downtimeSum()
+
+		migration := finishedMigration(v1.MigrationSucceeded)
+		oldMigration := migration.DeepCopy()
+		oldMigration.Status.MigrationState = nil
+
+		updateVMIMigrationOutcome(oldMigration, migration)
+		Expect(histogramCount(vmiMigrationDuration, v1.MigrationSucceeded)).To(Equal(duration + 1))
+		Expect(histogramCount(vmiMigrationDataTransferred, v1.MigrationSucceeded)).To(Equal(data + 1))
+		Expect(downtimeSum()).To(BeNumerically("~", downtime+0.05))
+
+		updateVMIMigrationOutcome(migration, migration.DeepCopy())
+		Expect(histogramCount(vmiMigrationDuration, v1.MigrationSucceeded)).To(Equal(duration + 1))
+	})
+
+	seriesCount := func(histogram *operatormetrics.HistogramVec) int {
+		ch := make(chan prometheus.Metric, 10)
+		histogram.Collect(ch)
+		close(ch)
+		return len(ch)
+	}
+
+	It("should delete the series of a VMI once it is deleted", func() {
+		updateVMIMigrationOutcome(&v1.VirtualMachineInstanceMigration{}, finishedMigration(v1.MigrationFailed))
+		Expect(seriesCount(vmiMigrationDuration)).To(BeNumerically(">", 0))
+		Expect(seriesCount(vmiMigrationDowntime)).To(BeNumerically(">", 0))
+
+		vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testvmi"}}
+		deleteVMIMigrationOutcome(cache.DeletedFinalStateUnknown{Key: "test-ns/testvmi", Obj: vmi})
+		Expect(seriesCount(vmiMigrationDuration)).To(BeZero())
+		Expect(seriesCount(vmiMigrationDataTransferred)).To(BeZero())
+		Expect(seriesCount(vmiMigrationDowntime)).To(BeZero())
+	})
+
+	It("should not observe a migration which is not finished", func() {
+		duration := histogramCount(vmiMigrationDuration, v1.MigrationRunning)
+
+		updateVMIMigrationOutcome(&v1.VirtualMachineInstanceMigration{}, finishedMigration(v1.MigrationRunning))
+		Expect(histogramCount(vmiMigrationDuration, v1.MigrationRunning)).To(Equal(duration))
+	})
+})
+
 func createVMIMigrationSForPhaseTransitionTime(phase v1.VirtualMachineInstanceMigrationPhase, offset float64) *v1.VirtualMachineInstanceMigration {
 	now := metav1.NewTime(time.Now())
 	old := metav1.NewTime(now.Time.Add(-time.Duration(int64(offset)) * time.Millisecond))
@@ -0,0 +1,329 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package nft
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+// NFTNetlink programs nftables through netlink, without executing the nft binary.
+//
+// The tables, chains and rules are queued and sent to the kernel as a single
+// netlink batch by Commit. The kernel applies a batch as one transaction:
+// when any of its operations fails, none of them takes effect.
+// Deleting and adding back a table in the same transaction replaces its content atomically.
+type NFTNetlink struct {
+	mu       sync.Mutex
+	messages [][]byte
+	setID    uint32
+}
+
+func NewNFTNetlink() *NFTNetlink {
+	return &NFTNetlink{}
+}
+
+func (n *NFTNetlink) AddTable(family IPFamily, name string) error {
+	nfFamily, err := netfilterFamily(family)
+	if err != nil {
+		return err
+	}
+	n.queue(newMessage(unix.NFT_MSG_NEWTABLE, unix.NLM_F_CREATE, nfFamily,
+		stringAttr(unix.NFTA_TABLE_NAME, name),
+		uint32Attr(unix.NFTA_TABLE_FLAGS, 0),
+	))
+	return nil
+}
+
+func (n *NFTNetlink) DeleteTable(family IPFamily, name string) error {
+	nfFamily, err := netfilterFamily(family)
+	if err != nil {
+		return err
+	}
+	n.queue(newMessage(unix.NFT_MSG_DELTABLE, 0, nfFamily,
+		stringAttr(unix.NFTA_TABLE_NAME, name),
+	))
+	return nil
+}
+
+func (n *NFTNetlink) AddChain(family IPFamily, table, name string, chainspec ...string) error {
+	nfFamily, err := netfilterFamily(family)
+	if err != nil {
+		return err
+	}
+	attrs := []attr{
+		stringAttr(unix.NFTA_CHAIN_TABLE, table),
+		stringAttr(unix.NFTA_CHAIN_NAME, name),
+	}
+	baseChainAttrs, err := parseChainSpec(chainspec)
+	if err != nil {
+		return fmt.Errorf("failed to add chain %s to %s table %s: %v", name, family, table, err)
+	}
+	attrs = append(attrs, baseChainAttrs...)
+	n.queue(newMessage(unix.NFT_MSG_NEWCHAIN, unix.NLM_F_CREATE, nfFamily, attrs...))
+	return nil
+}
+
+func (n *NFTNetlink) AddRule(family IPFamily, table, chain string, rulespec ...string) error {
+	nfFamily, err := netfilterFamily(family)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	r := ruleParser{family: family, table: table, nextSetID: func() uint32 {
+		n.setID++
+		return n.setID
+	}}
+	if err := r.parse(rulespec); err != nil {
+		return fmt.Errorf("failed to add rule %v to %s table %s chain %s: %v", rulespec, family, table, chain, err)
+	}
+
+	for _, set := range r.sets {
+		n.messages = append(n.messages, set.messages(nfFamily, table)...)
+	}
+	n.messages = append(n.messages, newMessage(unix.NFT_MSG_NEWRULE, unix.NLM_F_CREATE|unix.NLM_F_APPEND, nfFamily,
+		stringAttr(unix.NFTA_RULE_TABLE, table),
+		stringAttr(unix.NFTA_RULE_CHAIN, chain),
+		nestedAttr(unix.NFTA_RULE_EXPRESSIONS, r.exprs...),
+	))
+	return nil
+}
+
+// Commit sends all the queued operations to the kernel in a single transaction.
+// The queue is emptied, regardless of the result.
+func (n *NFTNetlink) Commit() error {
+	n.mu.Lock()
+	messages := n.messages
+	n.messages = nil
+	n.setID = 0
+	n.mu.Unlock()
+
+	if len(messages) == 0 {
+		return nil
+	}
+	return sendBatch(messages)
+}
+
+func (n *NFTNetlink) queue(message []byte) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.messages = append(n.messages, message)
+}
+
+func netfilterFamily(family IPFamily) (uint8, error) {
+	switch family {
+	case IPv4:
+		return unix.NFPROTO_IPV4, nil
+	case IPv6:
+		return unix.NFPROTO_IPV6, nil
+	}
+	return 0, fmt.Errorf("unsupported family %q", family)
+}
+
+func sendBatch(messages [][]byte) error {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_NETFILTER)
+	if err != nil {
+		return fmt.Errorf("failed to open netfilter netlink socket: %v", err)
+	}
+	defer unix.Close(fd)
+	if err := unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return fmt.Errorf("failed to bind netfilter netlink socket: %v", err)
+	}
+
+	batch := newBatchMessage(unix.NFNL_MSG_BATCH_BEGIN)
+	for _, message := range messages {
+		batch = append(batch, message...)
+	}
+	batch = append(batch, newBatchMessage(unix.NFNL_MSG_BATCH_END)...)
+
+	// Every message is numbered, the kernel reports the result of each one
+	// and the failing sequence number identifies the rejected operation.
+	const firstSeq = 1
+	setSequenceNumbers(batch, firstSeq)
+
+	if err := unix.Sendto(fd, batch, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return fmt.Errorf("failed to send nftables batch: %v", err)
+	}
+	return receiveAcks(fd, firstSeq+1, len(messages))
+}
+
+// receiveAcks waits for the acknowledgment of each operation of the batch,
+// and returns the first error reported by the kernel.
+func receiveAcks(fd int, firstSeq uint32, count int) error {
+	var errs []error
+	acked := 0
+	buf := make([]byte, unix.Getpagesize()*8)
+	for acked < count {
+		// The batch is processed synchronously when sent, after a failure
+		// the kernel may stop acknowledging the remaining operations.
+		flags := 0
+		if len(errs) > 0 {
+			flags = unix.MSG_DONTWAIT
+		}
+		n, _, err := unix.Recvfrom(fd, buf, flags)
+		if errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EWOULDBLOCK) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to receive nftables batch acknowledgment: %v", err)
+		}
+		replies, err := parseAcks(buf[:n])
+		if err != nil {
+			return err
+		}
+		for _, reply := range replies {
+			acked++
+			if reply.errno != 0 {
+				errs = append(errs, fmt.Errorf("nftables operation %d rejected: %v", reply.seq-firstSeq+1, reply.errno))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("nftables transaction aborted: %v", errs[0])
+	}
+	return nil
+}
+
+type ack struct {
+	seq   uint32
+	errno unix.Errno
+}
+
+func parseAcks(data []byte) ([]ack, error) {
+	var acks []ack
+	for len(data) >= unix.NLMSG_HDRLEN {
+		length := binary.NativeEndian.Uint32(data[0:4])
+		if length < unix.NLMSG_HDRLEN || int(length) > len(data) {
+			return nil, fmt.Errorf("malformed netlink message")
+		}
+		msgType := binary.NativeEndian.Uint16(data[4:6])
+		seq := binary.NativeEndian.Uint32(data[8:12])
+		if msgType == unix.NLMSG_ERROR {
+			if length < unix.NLMSG_HDRLEN+4 {
+				return nil, fmt.Errorf("malformed netlink error message")
+			}
+			code := int32(binary.NativeEndian.Uint32(data[unix.NLMSG_HDRLEN : unix.NLMSG_HDRLEN+4]))
+			acks = append(acks, ack{seq: seq, errno: unix.Errno(-code)})
+		}
+		data = data[nlmsgAlign(int(length)):]
+	}
+	return acks, nil
+}
+
+func setSequenceNumbers(batch []byte, firstSeq uint32) {
+	seq := firstSeq
+	for len(batch) >= unix.NLMSG_HDRLEN {
+		length := binary.NativeEndian.Uint32(batch[0:4])
+		binary.NativeEndian.PutUint32(batch[8:12], seq)
+		seq++
+		batch = batch[nlmsgAlign(int(length)):]
+	}
+}
+
+func newBatchMessage(msgType uint16) []byte {
+	return newNetlinkMessage(msgType, unix.NLM_F_REQUEST, unix.AF_UNSPEC, unix.NFNL_SUBSYS_NFTABLES)
+}
+
+// newMessage creates an nftables message, requesting its acknowledgment.
+func newMessage(nftMsgType int, flags uint16, family uint8, attrs ...attr) []byte {
+	msgType := uint16(unix.NFNL_SUBSYS_NFTABLES<<8 | nftMsgType)
+	message := newNetlinkMessage(msgType, unix.NLM_F_REQUEST|unix.NLM_F_ACK|flags, family, 0)
+	for _, a := range attrs {
+		message = append(message, a.encode()...)
+	}
+	binary.NativeEndian.PutUint32(message[0:4], uint32(len(message)))
+	return message
+}
+
+// newNetlinkMessage creates a netlink message header, followed by the netfilter generic message header.
+func newNetlinkMessage(msgType, flags uint16, family uint8, resID uint16) []byte {
+	const nfgenmsgLen = 4
+	message := make([]byte, unix.NLMSG_HDRLEN+nfgenmsgLen)
+	binary.NativeEndian.PutUint32(message[0:4], uint32(len(message)))
+	binary.NativeEndian.PutUint16(message[4:6], msgType)
+	binary.NativeEndian.PutUint16(message[6:8], flags)
+	message[unix.NLMSG_HDRLEN] = family
+	message[unix.NLMSG_HDRLEN+1] = unix.NFNETLINK_V0
+	binary.BigEndian.PutUint16(message[unix.NLMSG_HDRLEN+2:], resID)
+	return message
+}
+
+func nlmsgAlign(length int) int {
+	return (length + unix.NLMSG_ALIGNTO - 1) & ^(unix.NLMSG_ALIGNTO - 1)
+}
+
+// attr is a netlink attribute, holding either a value or nested attributes.
+type attr struct {
+	attrType uint16
+	value    []byte
+	nested   []attr
+}
+
+func (a attr) encode() []byte {
+	value := a.value
+	attrType := a.attrType
+	if a.nested != nil {
+		attrType |= unix.NLA_F_NESTED
+		value = nil
+		for _, nested := range a.nested {
+			value = append(value, nested.encode()...)
+		}
+	}
+	length := unix.SizeofNlAttr + len(value)
+	encoded := make([]byte, nlaAlign(length))
+	binary.NativeEndian.PutUint16(encoded[0:2], uint16(length))
+	binary.NativeEndian.PutUint16(encoded[2:4], attrType)
+	copy(encoded[unix.SizeofNlAttr:], value)
+	return encoded
+}
+
+func nlaAlign(length int) int {
+	return (length + unix.NLA_ALIGNTO - 1) & ^(unix.NLA_ALIGNTO - 1)
+}
+
+func stringAttr(attrType uint16, value string) attr {
+	return attr{attrType: attrType, value: append([]byte(value), 0)}
+}
+
+func uint32Attr(attrType uint16, value uint32) attr {
+	return attr{attrType: attrType, value: binary.BigEndian.AppendUint32(nil, value)}
+}
+
+func uint64Attr(attrType uint16, value uint64) attr {
+	return attr{attrType: attrType, value: binary.BigEndian.AppendUint64(nil, value)}
+}
+
+func bytesAttr(attrType uint16, value []byte) attr {
+	return attr{attrType: attrType, value: value}
+}
+
+func nestedAttr(attrType uint16, nested ...attr) attr {
+	if nested == nil {
+		nested = []attr{}
+	}
+	return attr{attrType: attrType, nested: nested}
+}
@@ -0,0 +1,162 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package nft_test
+
+import (
+	"flag"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+)
+
+var runIntegrationTests bool
+
+func init() {
+	flag.BoolVar(&runIntegrationTests, "run-integration-tests", false, "run integration tests")
+}
+
+var _ = Describe("NFT netlink", func() {
+	const testTable = "kubevirt_test"
+
+	It("commits nothing when no operation is queued", func() {
+		Expect(nft.NewNFTNetlink().Commit()).To(Succeed())
+	})
+
+	DescribeTable("rejects an unsupported rule", func(family nft.IPFamily, rulespec ...string) {
+		Expect(nft.NewNFTNetlink().AddRule(family, testTable, "input", rulespec...)).NotTo(Succeed())
+	},
+		Entry("with an unknown statement", nft.IPv4, "log", "accept"),
+		Entry("with an address of another family", nft.IPv4, "ip6", "saddr", "::1", "accept"),
+		Entry("with an invalid address", nft.IPv4, "ip", "saddr", "10.0.0", "accept"),
+		Entry("with an invalid port", nft.IPv4, "tcp", "dport", "http", "accept"),
+		Entry("with an unterminated set", nft.IPv6, "tcp", "dport", "{ 80, 443", "accept"),
+		Entry("with an unknown conntrack state", nft.IPv4, "ct", "state", "established,lost", "accept"),
+		Entry("with a jump without chain", nft.IPv4, "counter", "jump"),
+	)
+
+	DescribeTable("rejects an unsupported chain specification", func(chainspec string) {
+		Expect(nft.NewNFTNetlink().AddChain(nft.IPv4, testTable, "input", chainspec)).NotTo(Succeed())
+	},
+		Entry("with an unknown hook", "{ type filter hook egress priority 0; }"),
+		Entry("without a type", "{ hook input priority 0; }"),
+		Entry("with an invalid priority", "{ type filter hook input priority first; }"),
+		Entry("without braces", "type filter hook input priority 0;"),
+	)
+
+	Context("applied to the network namespace", integrationLabel, func() {
+		BeforeEach(func() {
+			if !runIntegrationTests {
+				Skip("integration tests are not set to run")
+			}
+		})
+
+		It("creates the masquerade binding NAT tables", func() {
+			nftable := nft.NewNFTNetlink()
+			for _, family := range []nft.IPFamily{nft.IPv4, nft.IPv6} {
+				guestIP, gatewayIP, loopback := "10.0.2.2", "10.0.2.1", "127.0.0.1"
+				if family == nft.IPv6 {
+					guestIP, gatewayIP, loopback = "fd10:0:2::2", "fd10:0:2::1", "::1"
+				}
+				Expect(nftable.AddTable(family, "nat")).To(Succeed())
+				Expect(nftable.AddChain(family, "nat", "prerouting", "{ type nat hook prerouting priority -100; }")).To(Succeed())
+				Expect(nftable.AddChain(family, "nat", "output", "{ type nat hook output priority -100; }")).To(Succeed())
+				Expect(nftable.AddChain(family, "nat", "postrouting", "{ type nat hook postrouting priority 100; }")).To(Succeed())
+				Expect(nftable.AddChain(family, "nat", "KUBEVIRT_PREINBOUND")).To(Succeed())
+				Expect(nftable.AddChain(family, "nat", "KUBEVIRT_POSTINBOUND")).To(Succeed())
+				Expect(nftable.AddRule(family, "nat", "postrouting", string(family), "saddr", guestIP, "counter", "masquerade")).To(Succeed())
+				Expect(nftable.AddRule(family, "nat", "prerouting", "iifname", "eth0", "counter", "jump", "KUBEVIRT_PREINBOUND")).To(Succeed())
+				Expect(nftable.AddRule(family, "nat", "postrouting", "oifname", "k6t-eth0", "counter", "jump", "KUBEVIRT_POSTINBOUND")).To(Succeed())
+				Expect(nftable.AddRule(family, "nat", "output", "tcp", "dport", "{ 49152, 49153 }", string(family), "saddr", loopback, "counter", "return")).To(Succeed())
+				Expect(nftable.AddRule(family, "nat", "KUBEVIRT_PREINBOUND", "tcp", "dport", "{ 80, 443 }", "counter", "dnat", "to", guestIP)).To(Succeed())
+				Expect(nftable.AddRule(family, "nat", "KUBEVIRT_POSTINBOUND", "udp", "dport", "53", string(family), "saddr", "{ "+loopback+" }", "counter", "snat", "to", gatewayIP)).To(Succeed())
+				Expect(nftable.AddRule(family, "nat", "output", string(family), "daddr", "{ "+loopback+" }", "counter", "dnat", "to", guestIP)).To(Succeed())
+			}
+			Expect(nftable.Commit()).To(Succeed())
+
+			DeferCleanup(func() {
+				Expect(nftable.DeleteTable(nft.IPv4, "nat")).To(Succeed())
+				Expect(nftable.DeleteTable(nft.IPv6, "nat")).To(Succeed())
+				Expect(nftable.Commit()).To(Succeed())
+			})
+		})
+
+		It("filters traffic and atomically replaces the rules", func() {
+			const port = 9753
+			receiver, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: port})
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(receiver.Close)
+
+			nftable := nft.NewNFTNetlink()
+			applyFilter := func(rules ...[]string) {
+				Expect(nftable.AddTable(nft.IPv4, testTable)).To(Succeed())
+				Expect(nftable.DeleteTable(nft.IPv4, testTable)).To(Succeed())
+				Expect(nftable.AddTable(nft.IPv4, testTable)).To(Succeed())
+				Expect(nftable.AddChain(nft.IPv4, testTable, "output", "{ type filter hook output priority 0; }")).To(Succeed())
+				Expect(nftable.AddChain(nft.IPv4, testTable, "egress")).To(Succeed())
+				Expect(nftable.AddRule(nft.IPv4, testTable, "output", "oifname", "lo", "jump", "egress")).To(Succeed())
+				Expect(nftable.AddRule(nft.IPv4, testTable, "egress", "ct", "state", "established,related", "accept")).To(Succeed())
+				for _, rule := range rules {
+					Expect(nftable.AddRule(nft.IPv4, testTable, "egress", rule...)).To(Succeed())
+				}
+				Expect(nftable.Commit()).To(Succeed())
+			}
+			DeferCleanup(func() {
+				Expect(nftable.DeleteTable(nft.IPv4, testTable)).To(Succeed())
+				Expect(nftable.Commit()).To(Succeed())
+			})
+
+			applyFilter([]string{"ip", "daddr", "127.0.0.0/8", "udp", "dport", "{ 9752, 9753 }", "counter", "drop"})
+			Expect(sendUDP(receiver)).To(BeFalse())
+
+			applyFilter([]string{"meta", "l4proto", "udp", "counter", "accept"}, []string{"counter", "drop"})
+			Expect(sendUDP(receiver)).To(BeTrue())
+		})
+
+		It("rolls back the transaction when an operation fails", func() {
+			nftable := nft.NewNFTNetlink()
+			Expect(nftable.AddTable(nft.IPv4, testTable)).To(Succeed())
+			Expect(nftable.AddChain(nft.IPv4, testTable, "input", "{ type filter hook input priority 0; }")).To(Succeed())
+			Expect(nftable.AddRule(nft.IPv4, testTable, "input", "jump", "missing")).To(Succeed())
+			Expect(nftable.Commit()).To(MatchError(ContainSubstring("nftables transaction aborted")))
+
+			Expect(nftable.DeleteTable(nft.IPv4, testTable)).To(Succeed())
+			Expect(nftable.Commit()).NotTo(Succeed(), "the table should not exist")
+		})
+	})
+})
+
+// sendUDP sends a datagram to the given receiver and reports whether it was received.
+func sendUDP(receiver *net.UDPConn) bool {
+	conn, err := net.DialUDP("udp4", nil, receiver.LocalAddr().(*net.UDPAddr))
+	Expect(err).NotTo(HaveOccurred())
+	defer conn.Close()
+	// The datagrams dropped in the output hook fail to be sent
+	if _, err = conn.Write([]byte("ping")); err != nil {
+		return false
+	}
+
+	Expect(receiver.SetReadDeadline(time.Now().Add(200 * time.Millisecond))).To(Succeed())
+	_, _, err = receiver.ReadFromUDP(make([]byte, 16))
+	return err == nil
+}
@@ -42,6 +42,11 @@ func (n NFTBin) AddTable(family IPFamily, name string) error {
 	return execute(cmd)
 }
 
+func (n NFTBin) DeleteTable(family IPFamily, name string) error {
+	cmd := exec.Command(nftBin, "delete", "table", string(family), name)
+	return execute(cmd)
+}
+
 func (n NFTBin) AddChain(family IPFamily, table, name string, chainspec ...string) error {
 	args := append([]string{"add", "chain", string(family), table, name}, chainspec...)
 	cmd := exec.Command(nftBin, args...)
@@ -54,6 +59,11 @@ func (n NFTBin) AddRule(family IPFamily, table, chain string, rulespec ...string
 	return execute(cmd)
 }
 
+// Commit does nothing, each operation is applied when requested.
+func (n NFTBin) Commit() error {
+	return nil
+}
+
 func execute(cmd *exec.Cmd) error {
 	if output, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("%s, error: %v", string(output), err)
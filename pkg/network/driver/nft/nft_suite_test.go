@@ -0,0 +1,36 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+// nft_test includes integration tests which program the nftables of the
+// network namespace they run in, a dedicated one is expected.
+package nft_test
+
+import (
+	"testing"
+
+	"github.com/onsi/ginkgo/v2"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+var integrationLabel = ginkgo.Label("integration")
+
+func TestNFT(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,592 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package nft
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// The chain and rule specifications use the nft syntax.
+// Only the subset of statements required by the network bindings is supported.
+
+const anonymousSetName = "__set%d"
+
+// Data types of the set keys, as defined by the nft userspace.
+const (
+	setKeyTypeIPv4Addr    = 7
+	setKeyTypeIPv6Addr    = 8
+	setKeyTypeInetService = 13
+	setKeyTypeICMPv6Type  = 29
+)
+
+// Bits of the connection tracking states, as defined by the kernel.
+var ctStates = map[string]uint32{
+	"invalid":     1,
+	"established": 1 << 1,
+	"related":     1 << 2,
+	"new":         1 << 3,
+	"untracked":   1 << 6,
+}
+
+// Verdicts of the netfilter hooks, as defined by the kernel.
+const (
+	verdictDrop   = 0
+	verdictAccept = 1
+)
+
+var icmpv6Types = map[string]byte{
+	"destination-unreachable": 1,
+	"packet-too-big":          2,
+	"time-exceeded":           3,
+	"parameter-problem":       4,
+	"echo-request":            128,
+	"echo-reply":              129,
+	"nd-router-solicit":       133,
+	"nd-router-advert":        134,
+	"nd-neighbor-solicit":     135,
+	"nd-neighbor-advert":      136,
+}
+
+var l4Protocols = map[string]byte{
+	"icmp":      unix.IPPROTO_ICMP,
+	"tcp":       unix.IPPROTO_TCP,
+	"udp":       unix.IPPROTO_UDP,
+	"ipv6-icmp": unix.IPPROTO_ICMPV6,
+}
+
+var hooks = map[string]uint32{
+	"prerouting":  unix.NF_INET_PRE_ROUTING,
+	"input":       unix.NF_INET_LOCAL_IN,
+	"forward":     unix.NF_INET_FORWARD,
+	"output":      unix.NF_INET_LOCAL_OUT,
+	"postrouting": unix.NF_INET_POST_ROUTING,
+}
+
+// parseChainSpec returns the attributes of a base chain, from a specification such as
+// "{ type nat hook prerouting priority -100; }". An empty specification defines a regular chain.
+func parseChainSpec(chainspec []string) ([]attr, error) {
+	tokens := tokenize(chainspec)
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	if tokens[0] != "{" || tokens[len(tokens)-1] != "}" {
+		return nil, fmt.Errorf("unsupported chain specification %v", chainspec)
+	}
+
+	var chainType, hook, priority string
+	tokens = tokens[1 : len(tokens)-1]
+	for len(tokens) > 0 {
+		if tokens[0] == ";" {
+			tokens = tokens[1:]
+			continue
+		}
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("unsupported chain specification %v", chainspec)
+		}
+		switch tokens[0] {
+		case "type":
+			chainType = tokens[1]
+		case "hook":
+			hook = tokens[1]
+		case "priority":
+			priority = tokens[1]
+		default:
+			return nil, fmt.Errorf("unsupported chain specification %v", chainspec)
+		}
+		tokens = tokens[2:]
+	}
+
+	hookNum, exists := hooks[hook]
+	if !exists || chainType == "" {
+		return nil, fmt.Errorf("unsupported chain specification %v", chainspec)
+	}
+	priorityValue, err := strconv.ParseInt(priority, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported chain priority %q", priority)
+	}
+	return []attr{
+		nestedAttr(unix.NFTA_CHAIN_HOOK,
+			uint32Attr(unix.NFTA_HOOK_HOOKNUM, hookNum),
+			uint32Attr(unix.NFTA_HOOK_PRIORITY, uint32(int32(priorityValue))),
+		),
+		stringAttr(unix.NFTA_CHAIN_TYPE, chainType),
+	}, nil
+}
+
+// tokenize splits the specification arguments into tokens,
+// the braces, commas and semicolons being tokens on their own.
+func tokenize(spec []string) []string {
+	replacer := strings.NewReplacer("{", " { ", "}", " } ", ",", " , ", ";", " ; ")
+	return strings.Fields(replacer.Replace(strings.Join(spec, " ")))
+}
+
+type anonymousSet struct {
+	id      uint32
+	keyType uint32
+	keyLen  uint32
+	keys    [][]byte
+}
+
+// messages returns the messages creating the set and its elements.
+func (s anonymousSet) messages(family uint8, table string) [][]byte {
+	var elements []attr
+	for _, key := range s.keys {
+		elements = append(elements, nestedAttr(unix.NFTA_LIST_ELEM,
+			nestedAttr(unix.NFTA_SET_ELEM_KEY, bytesAttr(unix.NFTA_DATA_VALUE, key)),
+		))
+	}
+	return [][]byte{
+		newMessage(unix.NFT_MSG_NEWSET, unix.NLM_F_CREATE, family,
+			stringAttr(unix.NFTA_SET_TABLE, table),
+			stringAttr(unix.NFTA_SET_NAME, anonymousSetName),
+			uint32Attr(unix.NFTA_SET_FLAGS, unix.NFT_SET_ANONYMOUS|unix.NFT_SET_CONSTANT),
+			uint32Attr(unix.NFTA_SET_KEY_TYPE, s.keyType),
+			uint32Attr(unix.NFTA_SET_KEY_LEN, s.keyLen),
+			uint32Attr(unix.NFTA_SET_ID, s.id),
+		),
+		newMessage(unix.NFT_MSG_NEWSETELEM, unix.NLM_F_CREATE, family,
+			stringAttr(unix.NFTA_SET_ELEM_LIST_TABLE, table),
+			stringAttr(unix.NFTA_SET_ELEM_LIST_SET, anonymousSetName),
+			uint32Attr(unix.NFTA_SET_ELEM_LIST_SET_ID, s.id),
+			nestedAttr(unix.NFTA_SET_ELEM_LIST_ELEMENTS, elements...),
+		),
+	}
+}
+
+// ruleParser translates a rule specification into nftables expressions.
+type ruleParser struct {
+	family    IPFamily
+	table     string
+	nextSetID func() uint32
+
+	tokens []string
+	exprs  []attr
+	sets   []anonymousSet
+}
+
+func (r *ruleParser) parse(rulespec []string) error {
+	r.tokens = tokenize(rulespec)
+	for len(r.tokens) > 0 {
+		token := r.next()
+		var err error
+		switch token {
+		case string(IPv4), string(IPv6):
+			err = r.parseAddressMatch(IPFamily(token))
+		case "tcp", "udp":
+			err = r.parsePortMatch(token)
+		case "icmpv6":
+			err = r.parseICMPv6TypeMatch()
+		case "meta":
+			err = r.parseMetaMatch()
+		case "iifname":
+			err = r.parseInterfaceMatch(unix.NFT_META_IIFNAME)
+		case "oifname":
+			err = r.parseInterfaceMatch(unix.NFT_META_OIFNAME)
+		case "ct":
+			err = r.parseConntrackMatch()
+		case "counter":
+			r.addExpr("counter", uint64Attr(unix.NFTA_COUNTER_BYTES, 0), uint64Attr(unix.NFTA_COUNTER_PACKETS, 0))
+		case "masquerade":
+			r.addExpr("masq")
+		case "snat":
+			err = r.parseNAT(unix.NFT_NAT_SNAT)
+		case "dnat":
+			err = r.parseNAT(unix.NFT_NAT_DNAT)
+		case "accept":
+			r.addVerdict(verdictAccept, "")
+		case "drop":
+			r.addVerdict(verdictDrop, "")
+		case "return":
+			r.addVerdict(unix.NFT_RETURN, "")
+		case "jump":
+			err = r.parseJump(unix.NFT_JUMP)
+		case "goto":
+			err = r.parseJump(unix.NFT_GOTO)
+		default:
+			err = fmt.Errorf("unsupported statement %q", token)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ruleParser) next() string {
+	if len(r.tokens) == 0 {
+		return ""
+	}
+	token := r.tokens[0]
+	r.tokens = r.tokens[1:]
+	return token
+}
+
+// nextValues returns either a single value or the elements of a set, such as "{ 80, 443 }".
+func (r *ruleParser) nextValues() ([]string, bool, error) {
+	token := r.next()
+	if token != "{" {
+		if token == "" {
+			return nil, false, fmt.Errorf("missing value")
+		}
+		return []string{token}, false, nil
+	}
+
+	var values []string
+	for {
+		token = r.next()
+		switch token {
+		case "}":
+			if len(values) == 0 {
+				return nil, false, fmt.Errorf("empty set")
+			}
+			return values, true, nil
+		case "":
+			return nil, false, fmt.Errorf("unterminated set")
+		case ",":
+		default:
+			values = append(values, token)
+		}
+	}
+}
+
+func (r *ruleParser) expect(token string) error {
+	if actual := r.next(); actual != token {
+		return fmt.Errorf("expected %q, got %q", token, actual)
+	}
+	return nil
+}
+
+func (r *ruleParser) parseAddressMatch(family IPFamily) error {
+	if family != r.family {
+		return fmt.Errorf("%s address match in %s table", family, r.family)
+	}
+
+	var offset uint32
+	switch field := r.next(); {
+	case field == "saddr" && family == IPv4:
+		offset = 12
+	case field == "daddr" && family == IPv4:
+		offset = 16
+	case field == "saddr" && family == IPv6:
+		offset = 8
+	case field == "daddr" && family == IPv6:
+		offset = 24
+	default:
+		return fmt.Errorf("unsupported %s field %q", family, field)
+	}
+
+	values, isSet, err := r.nextValues()
+	if err != nil {
+		return err
+	}
+	addrLen := uint32(net.IPv4len)
+	keyType := uint32(setKeyTypeIPv4Addr)
+	if family == IPv6 {
+		addrLen = net.IPv6len
+		keyType = setKeyTypeIPv6Addr
+	}
+	r.addPayloadLoad(unix.NFT_PAYLOAD_NETWORK_HEADER, offset, addrLen)
+
+	if isSet {
+		var keys [][]byte
+		for _, value := range values {
+			addr, err := parseAddress(family, value)
+			if err != nil {
+				return err
+			}
+			keys = append(keys, addr)
+		}
+		r.addLookup(keyType, addrLen, keys)
+		return nil
+	}
+
+	if !strings.Contains(values[0], "/") {
+		addr, err := parseAddress(family, values[0])
+		if err != nil {
+			return err
+		}
+		r.addCmp(unix.NFT_CMP_EQ, addr)
+		return nil
+	}
+
+	_, ipNet, err := net.ParseCIDR(values[0])
+	if err != nil {
+		return err
+	}
+	network, err := parseAddress(family, ipNet.IP.String())
+	if err != nil {
+		return err
+	}
+	r.addExpr("bitwise",
+		uint32Attr(unix.NFTA_BITWISE_SREG, unix.NFT_REG_1),
+		uint32Attr(unix.NFTA_BITWISE_DREG, unix.NFT_REG_1),
+		uint32Attr(unix.NFTA_BITWISE_LEN, addrLen),
+		nestedAttr(unix.NFTA_BITWISE_MASK, bytesAttr(unix.NFTA_DATA_VALUE, []byte(ipNet.Mask))),
+		nestedAttr(unix.NFTA_BITWISE_XOR, bytesAttr(unix.NFTA_DATA_VALUE, make([]byte, addrLen))),
+	)
+	r.addCmp(unix.NFT_CMP_EQ, network)
+	return nil
+}
+
+func parseAddress(family IPFamily, value string) ([]byte, error) {
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid address %q", value)
+	}
+	if family == IPv4 {
+		if ip4 := ip.To4(); ip4 != nil {
+			return ip4, nil
+		}
+		return nil, fmt.Errorf("invalid IPv4 address %q", value)
+	}
+	if ip.To4() != nil {
+		return nil, fmt.Errorf("invalid IPv6 address %q", value)
+	}
+	return ip.To16(), nil
+}
+
+func (r *ruleParser) parsePortMatch(protocol string) error {
+	var offset uint32
+	switch field := r.next(); field {
+	case "sport":
+		offset = 0
+	case "dport":
+		offset = 2
+	default:
+		return fmt.Errorf("unsupported %s field %q", protocol, field)
+	}
+
+	values, isSet, err := r.nextValues()
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for _, value := range values {
+		port, err := strconv.ParseUint(value, 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid port %q", value)
+		}
+		keys = append(keys, binary.BigEndian.AppendUint16(nil, uint16(port)))
+	}
+
+	r.addL4ProtoMatch(l4Protocols[protocol])
+	r.addPayloadLoad(unix.NFT_PAYLOAD_TRANSPORT_HEADER, offset, 2)
+	if isSet {
+		r.addLookup(setKeyTypeInetService, 2, keys)
+	} else {
+		r.addCmp(unix.NFT_CMP_EQ, keys[0])
+	}
+	return nil
+}
+
+func (r *ruleParser) parseICMPv6TypeMatch() error {
+	if err := r.expect("type"); err != nil {
+		return err
+	}
+	values, isSet, err := r.nextValues()
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for _, value := range values {
+		icmpType, exists := icmpv6Types[value]
+		if !exists {
+			return fmt.Errorf("unsupported icmpv6 type %q", value)
+		}
+		keys = append(keys, []byte{icmpType})
+	}
+
+	r.addL4ProtoMatch(unix.IPPROTO_ICMPV6)
+	r.addPayloadLoad(unix.NFT_PAYLOAD_TRANSPORT_HEADER, 0, 1)
+	if isSet {
+		r.addLookup(setKeyTypeICMPv6Type, 1, keys)
+	} else {
+		r.addCmp(unix.NFT_CMP_EQ, keys[0])
+	}
+	return nil
+}
+
+func (r *ruleParser) parseMetaMatch() error {
+	if err := r.expect("l4proto"); err != nil {
+		return err
+	}
+	value := r.next()
+	protocol, exists := l4Protocols[value]
+	if !exists {
+		return fmt.Errorf("unsupported protocol %q", value)
+	}
+	r.addL4ProtoMatch(protocol)
+	return nil
+}
+
+func (r *ruleParser) parseInterfaceMatch(metaKey uint32) error {
+	name := r.next()
+	if name == "" || len(name) >= unix.IFNAMSIZ {
+		return fmt.Errorf("invalid interface name %q", name)
+	}
+	r.addMetaLoad(metaKey)
+	ifname := make([]byte, unix.IFNAMSIZ)
+	copy(ifname, name)
+	r.addCmp(unix.NFT_CMP_EQ, ifname)
+	return nil
+}
+
+func (r *ruleParser) parseConntrackMatch() error {
+	if err := r.expect("state"); err != nil {
+		return err
+	}
+	values, _, err := r.nextValues()
+	if err != nil {
+		return err
+	}
+	// A list of states such as "established,related" is split by the tokenizer
+	var mask uint32
+	for _, value := range append(values, r.nextListItems()...) {
+		state, exists := ctStates[value]
+		if !exists {
+			return fmt.Errorf("unsupported conntrack state %q", value)
+		}
+		mask |= state
+	}
+
+	r.addExpr("ct",
+		uint32Attr(unix.NFTA_CT_DREG, unix.NFT_REG_1),
+		uint32Attr(unix.NFTA_CT_KEY, unix.NFT_CT_STATE),
+	)
+	r.addExpr("bitwise",
+		uint32Attr(unix.NFTA_BITWISE_SREG, unix.NFT_REG_1),
+		uint32Attr(unix.NFTA_BITWISE_DREG, unix.NFT_REG_1),
+		uint32Attr(unix.NFTA_BITWISE_LEN, 4),
+		nestedAttr(unix.NFTA_BITWISE_MASK, bytesAttr(unix.NFTA_DATA_VALUE, binary.NativeEndian.AppendUint32(nil, mask))),
+		nestedAttr(unix.NFTA_BITWISE_XOR, bytesAttr(unix.NFTA_DATA_VALUE, make([]byte, 4))),
+	)
+	r.addCmp(unix.NFT_CMP_NEQ, make([]byte, 4))
+	return nil
+}
+
+// nextListItems returns the remaining items of a comma separated list.
+func (r *ruleParser) nextListItems() []string {
+	var items []string
+	for len(r.tokens) >= 2 && r.tokens[0] == "," {
+		items = append(items, r.tokens[1])
+		r.tokens = r.tokens[2:]
+	}
+	return items
+}
+
+func (r *ruleParser) parseNAT(natType uint32) error {
+	if err := r.expect("to"); err != nil {
+		return err
+	}
+	value := r.next()
+	addr, err := parseAddress(r.family, strings.Trim(value, "[]"))
+	if err != nil {
+		return err
+	}
+	nfFamily, err := netfilterFamily(r.family)
+	if err != nil {
+		return err
+	}
+
+	r.addExpr("immediate",
+		uint32Attr(unix.NFTA_IMMEDIATE_DREG, unix.NFT_REG_1),
+		nestedAttr(unix.NFTA_IMMEDIATE_DATA, bytesAttr(unix.NFTA_DATA_VALUE, addr)),
+	)
+	r.addExpr("nat",
+		uint32Attr(unix.NFTA_NAT_TYPE, natType),
+		uint32Attr(unix.NFTA_NAT_FAMILY, uint32(nfFamily)),
+		uint32Attr(unix.NFTA_NAT_REG_ADDR_MIN, unix.NFT_REG_1),
+	)
+	return nil
+}
+
+func (r *ruleParser) parseJump(code int32) error {
+	chain := r.next()
+	if chain == "" {
+		return fmt.Errorf("missing chain name")
+	}
+	r.addVerdict(code, chain)
+	return nil
+}
+
+func (r *ruleParser) addExpr(name string, data ...attr) {
+	expr := []attr{stringAttr(unix.NFTA_EXPR_NAME, name)}
+	if len(data) > 0 {
+		expr = append(expr, nestedAttr(unix.NFTA_EXPR_DATA, data...))
+	}
+	r.exprs = append(r.exprs, nestedAttr(unix.NFTA_LIST_ELEM, expr...))
+}
+
+func (r *ruleParser) addMetaLoad(key uint32) {
+	r.addExpr("meta",
+		uint32Attr(unix.NFTA_META_KEY, key),
+		uint32Attr(unix.NFTA_META_DREG, unix.NFT_REG_1),
+	)
+}
+
+func (r *ruleParser) addL4ProtoMatch(protocol byte) {
+	r.addMetaLoad(unix.NFT_META_L4PROTO)
+	r.addCmp(unix.NFT_CMP_EQ, []byte{protocol})
+}
+
+func (r *ruleParser) addPayloadLoad(base, offset, length uint32) {
+	r.addExpr("payload",
+		uint32Attr(unix.NFTA_PAYLOAD_DREG, unix.NFT_REG_1),
+		uint32Attr(unix.NFTA_PAYLOAD_BASE, base),
+		uint32Attr(unix.NFTA_PAYLOAD_OFFSET, offset),
+		uint32Attr(unix.NFTA_PAYLOAD_LEN, length),
+	)
+}
+
+func (r *ruleParser) addCmp(op uint32, data []byte) {
+	r.addExpr("cmp",
+		uint32Attr(unix.NFTA_CMP_SREG, unix.NFT_REG_1),
+		uint32Attr(unix.NFTA_CMP_OP, op),
+		nestedAttr(unix.NFTA_CMP_DATA, bytesAttr(unix.NFTA_DATA_VALUE, data)),
+	)
+}
+
+// addLookup matches the loaded value against an anonymous set, created in the same transaction.
+func (r *ruleParser) addLookup(keyType, keyLen uint32, keys [][]byte) {
+	set := anonymousSet{id: r.nextSetID(), keyType: keyType, keyLen: keyLen, keys: keys}
+	r.sets = append(r.sets, set)
+	r.addExpr("lookup",
+		stringAttr(unix.NFTA_LOOKUP_SET, anonymousSetName),
+		uint32Attr(unix.NFTA_LOOKUP_SET_ID, set.id),
+		uint32Attr(unix.NFTA_LOOKUP_SREG, unix.NFT_REG_1),
+	)
+}
+
+func (r *ruleParser) addVerdict(code int32, chain string) {
+	verdict := []attr{uint32Attr(unix.NFTA_VERDICT_CODE, uint32(code))}
+	if chain != "" {
+		verdict = append(verdict, stringAttr(unix.NFTA_VERDICT_CHAIN, chain))
+	}
+	r.addExpr("immediate",
+		uint32Attr(unix.NFTA_IMMEDIATE_DREG, unix.NFT_REG_VERDICT),
+		nestedAttr(unix.NFTA_IMMEDIATE_DATA, nestedAttr(unix.NFTA_DATA_VERDICT, verdict...)),
+	)
+}
@@ -0,0 +1,261 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package firewall
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+)
+
+type nftable interface {
+	AddTable(family nft.IPFamily, name string) error
+	DeleteTable(family nft.IPFamily, name string) error
+	AddChain(family nft.IPFamily, table, name string, chainspec ...string) error
+	AddRule(family nft.IPFamily, table, chain string, rulespec ...string) error
+	Commit() error
+}
+
+// Mode defines where the traffic of the virtual machine is filtered.
+type Mode string
+
+const (
+	// ModeForward filters the traffic forwarded between the pod interface and the guest,
+	// as done by the masquerade binding.
+	ModeForward Mode = "forward"
+	// ModeLocal filters the traffic terminated in the pod network namespace,
+	// as done by the passt binding.
+	ModeLocal Mode = "local"
+)
+
+const (
+	filterTable = "kubevirt_firewall"
+
+	forwardChain = "forward"
+	inputChain   = "input"
+	outputChain  = "output"
+	ingressChain = "ingress"
+	egressChain  = "egress"
+)
+
+type Firewall struct {
+	nftable        nftable
+	mode           Mode
+	podIfaceName   string
+	migrationPorts []int
+}
+
+type option func(*Firewall)
+
+func New(mode Mode, podIfaceName string, opts ...option) Firewall {
+	f := Firewall{nftable: nft.NFTBin{}, mode: mode, podIfaceName: podIfaceName}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return f
+}
+
+func WithNftableAdapter(h nftable) option {
+	return func(f *Firewall) {
+		f.nftable = h
+	}
+}
+
+// WithLegacyMigrationPorts is used for legacy setups where migration ports are in use.
+// When set, the traffic to the reserved migration ports is never filtered.
+func WithLegacyMigrationPorts() option {
+	const LibvirtDirectMigrationPort = 49152
+	const LibvirtBlockMigrationPort = 49153
+	return func(f *Firewall) {
+		f.migrationPorts = []int{LibvirtDirectMigrationPort, LibvirtBlockMigrationPort}
+	}
+}
+
+// Apply replaces the rules enforced on the pod interface with the given ones.
+// When the given firewall is nil, all rules are removed.
+// The rules of both families are replaced in a single transaction.
+func (f Firewall) Apply(firewall *v1.Firewall) error {
+	for _, family := range []nft.IPFamily{nft.IPv4, nft.IPv6} {
+		if err := f.applyByFamily(family, firewall); err != nil {
+			return err
+		}
+	}
+	return f.nftable.Commit()
+}
+
+func (f Firewall) applyByFamily(family nft.IPFamily, firewall *v1.Firewall) error {
+	// Adding the table is a no-op when it already exists, it makes the removal succeed on the first setup.
+	if err := f.nftable.AddTable(family, filterTable); err != nil {
+		return err
+	}
+	if err := f.nftable.DeleteTable(family, filterTable); err != nil {
+		return err
+	}
+	if firewall == nil {
+		return nil
+	}
+
+	if err := f.nftable.AddTable(family, filterTable); err != nil {
+		return err
+	}
+	if err := f.addBaseChains(family); err != nil {
+		return err
+	}
+	if err := f.addRuleSet(family, ingressChain, "saddr", firewall.Ingress); err != nil {
+		return fmt.Errorf("failed to define the ingress firewall rules: %v", err)
+	}
+	if err := f.addRuleSet(family, egressChain, "daddr", firewall.Egress); err != nil {
+		return fmt.Errorf("failed to define the egress firewall rules: %v", err)
+	}
+	return nil
+}
+
+func (f Firewall) addBaseChains(family nft.IPFamily) error {
+	ingressHookChain, egressHookChain := forwardChain, forwardChain
+	if f.mode == ModeLocal {
+		ingressHookChain, egressHookChain = inputChain, outputChain
+	}
+
+	if err := f.nftable.AddChain(family, filterTable, ingressHookChain, baseChainSpec(ingressHookChain)); err != nil {
+		return err
+	}
+	if egressHookChain != ingressHookChain {
+		if err := f.nftable.AddChain(family, filterTable, egressHookChain, baseChainSpec(egressHookChain)); err != nil {
+			return err
+		}
+	}
+	if err := f.nftable.AddChain(family, filterTable, ingressChain); err != nil {
+		return err
+	}
+	if err := f.nftable.AddChain(family, filterTable, egressChain); err != nil {
+		return err
+	}
+
+	if err := f.nftable.AddRule(family, filterTable, ingressHookChain, "iifname", f.podIfaceName, "jump", ingressChain); err != nil {
+		return err
+	}
+	return f.nftable.AddRule(family, filterTable, egressHookChain, "oifname", f.podIfaceName, "jump", egressChain)
+}
+
+func baseChainSpec(hook string) string {
+	return fmt.Sprintf("{ type filter hook %s priority 0; }", hook)
+}
+
+func (f Firewall) addRuleSet(family nft.IPFamily, chain, addrMatch string, ruleSet *v1.FirewallRuleSet) error {
+	if err := f.nftable.AddRule(family, filterTable, chain, "ct", "state", "established,related", "accept"); err != nil {
+		return err
+	}
+	if f.mode == ModeLocal {
+		if err := f.addLocalServiceRules(family, chain); err != nil {
+			return err
+		}
+	}
+
+	defaultAction := v1.FirewallActionDrop
+	if ruleSet != nil {
+		for _, rule := range ruleSet.Rules {
+			rulespec, err := ruleSpec(family, addrMatch, rule)
+			if err != nil {
+				return err
+			}
+			if rulespec == nil {
+				continue
+			}
+			if err := f.nftable.AddRule(family, filterTable, chain, rulespec...); err != nil {
+				return err
+			}
+		}
+		if ruleSet.DefaultAction != "" {
+			defaultAction = ruleSet.DefaultAction
+		}
+	}
+	return f.nftable.AddRule(family, filterTable, chain, "counter", verdict(defaultAction))
+}
+
+// addLocalServiceRules accepts the traffic the pod network namespace depends on,
+// when it is filtered in the input and output hooks.
+func (f Firewall) addLocalServiceRules(family nft.IPFamily, chain string) error {
+	if family == nft.IPv6 {
+		const neighborDiscoveryTypes = "{ nd-neighbor-solicit, nd-neighbor-advert, nd-router-solicit, nd-router-advert }"
+		if err := f.nftable.AddRule(family, filterTable, chain, "icmpv6", "type", neighborDiscoveryTypes, "accept"); err != nil {
+			return err
+		}
+	}
+	if len(f.migrationPorts) > 0 && chain == ingressChain {
+		var ports []string
+		for _, p := range f.migrationPorts {
+			ports = append(ports, strconv.Itoa(p))
+		}
+		portsSpec := fmt.Sprintf("{ %s }", strings.Join(ports, ", "))
+		if err := f.nftable.AddRule(family, filterTable, chain, "tcp", "dport", portsSpec, "accept"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ruleSpec returns the nftables rule matching the given firewall rule,
+// or nil when the rule CIDR does not belong to the given family.
+func ruleSpec(family nft.IPFamily, addrMatch string, rule v1.FirewallRule) ([]string, error) {
+	var rulespec []string
+	if rule.CIDR != "" {
+		_, ipNet, err := net.ParseCIDR(rule.CIDR)
+		if err != nil {
+			return nil, err
+		}
+		if (ipNet.IP.To4() != nil) != (family == nft.IPv4) {
+			return nil, nil
+		}
+		rulespec = append(rulespec, string(family), addrMatch, ipNet.String())
+	}
+
+	switch rule.Protocol {
+	case v1.FirewallProtocolTCP, v1.FirewallProtocolUDP:
+		protocol := strings.ToLower(string(rule.Protocol))
+		if rule.Port != 0 {
+			rulespec = append(rulespec, protocol, "dport", strconv.Itoa(int(rule.Port)))
+		} else {
+			rulespec = append(rulespec, "meta", "l4proto", protocol)
+		}
+	case v1.FirewallProtocolICMP:
+		if family == nft.IPv4 {
+			rulespec = append(rulespec, "meta", "l4proto", "icmp")
+		} else {
+			rulespec = append(rulespec, "meta", "l4proto", "ipv6-icmp")
+		}
+	case "":
+	default:
+		return nil, fmt.Errorf("unsupported protocol %q", rule.Protocol)
+	}
+
+	return append(rulespec, "counter", verdict(rule.Action)), nil
+}
+
+func verdict(action v1.FirewallAction) string {
+	if action == v1.FirewallActionAccept {
+		return "accept"
+	}
+	return "drop"
+}
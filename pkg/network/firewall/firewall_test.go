@@ -0,0 +1,203 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package firewall_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+	"kubevirt.io/kubevirt/pkg/network/firewall"
+)
+
+var _ = Describe("firewall", func() {
+	It("apply fails", func() {
+		testErr := errors.New("test error")
+		fw := firewall.New(firewall.ModeForward, "eth0", firewall.WithNftableAdapter(&nftableStub{deleteTableErr: testErr}))
+
+		Expect(fw.Apply(&v1.Firewall{})).To(MatchError(testErr))
+	})
+
+	It("removes all rules when the firewall is not defined", func() {
+		nftStub := &nftableStub{}
+		fw := firewall.New(firewall.ModeForward, "eth0", firewall.WithNftableAdapter(nftStub))
+
+		Expect(fw.Apply(nil)).To(Succeed())
+		expectedConfig := `add table ip kubevirt_firewall
+delete table ip kubevirt_firewall
+add table ip6 kubevirt_firewall
+delete table ip6 kubevirt_firewall
+`
+		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+	})
+
+	It("filters the forwarded traffic", func() {
+		nftStub := &nftableStub{}
+		fw := firewall.New(firewall.ModeForward, "eth0", firewall.WithNftableAdapter(nftStub))
+
+		Expect(fw.Apply(&v1.Firewall{
+			Ingress: &v1.FirewallRuleSet{
+				Rules: []v1.FirewallRule{
+					{CIDR: "10.10.0.0/16", Protocol: v1.FirewallProtocolTCP, Port: 22, Action: v1.FirewallActionAccept},
+					{CIDR: "fd10::/64", Protocol: v1.FirewallProtocolICMP, Action: v1.FirewallActionAccept},
+				},
+			},
+			Egress: &v1.FirewallRuleSet{
+				Rules: []v1.FirewallRule{
+					{CIDR: "192.168.1.0/24", Action: v1.FirewallActionDrop},
+					{Protocol: v1.FirewallProtocolUDP, Action: v1.FirewallActionDrop},
+				},
+				DefaultAction: v1.FirewallActionAccept,
+			},
+		})).To(Succeed())
+		expectedConfig := `add table ip kubevirt_firewall
+delete table ip kubevirt_firewall
+add table ip kubevirt_firewall
+add chain ip kubevirt_firewall forward [{ type filter hook forward priority 0; }]
+add chain ip kubevirt_firewall ingress []
+add chain ip kubevirt_firewall egress []
+add rule ip kubevirt_firewall forward [iifname eth0 jump ingress]
+add rule ip kubevirt_firewall forward [oifname eth0 jump egress]
+add rule ip kubevirt_firewall ingress [ct state established,related accept]
+add rule ip kubevirt_firewall ingress [ip saddr 10.10.0.0/16 tcp dport 22 counter accept]
+add rule ip kubevirt_firewall ingress [counter drop]
+add rule ip kubevirt_firewall egress [ct state established,related accept]
+add rule ip kubevirt_firewall egress [ip daddr 192.168.1.0/24 counter drop]
+add rule ip kubevirt_firewall egress [meta l4proto udp counter drop]
+add rule ip kubevirt_firewall egress [counter accept]
+add table ip6 kubevirt_firewall
+delete table ip6 kubevirt_firewall
+add table ip6 kubevirt_firewall
+add chain ip6 kubevirt_firewall forward [{ type filter hook forward priority 0; }]
+add chain ip6 kubevirt_firewall ingress []
+add chain ip6 kubevirt_firewall egress []
+add rule ip6 kubevirt_firewall forward [iifname eth0 jump ingress]
+add rule ip6 kubevirt_firewall forward [oifname eth0 jump egress]
+add rule ip6 kubevirt_firewall ingress [ct state established,related accept]
+add rule ip6 kubevirt_firewall ingress [ip6 saddr fd10::/64 meta l4proto ipv6-icmp counter accept]
+add rule ip6 kubevirt_firewall ingress [counter drop]
+add rule ip6 kubevirt_firewall egress [ct state established,related accept]
+add rule ip6 kubevirt_firewall egress [meta l4proto udp counter drop]
+add rule ip6 kubevirt_firewall egress [counter accept]
+`
+		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+	})
+
+	It("filters the local traffic", func() {
+		nftStub := &nftableStub{}
+		fw := firewall.New(firewall.ModeLocal, "eth0", firewall.WithNftableAdapter(nftStub), firewall.WithLegacyMigrationPorts())
+
+		Expect(fw.Apply(&v1.Firewall{
+			Ingress: &v1.FirewallRuleSet{
+				Rules: []v1.FirewallRule{{Protocol: v1.FirewallProtocolTCP, Port: 80, Action: v1.FirewallActionAccept}},
+			},
+		})).To(Succeed())
+		expectedConfig := `add table ip kubevirt_firewall
+delete table ip kubevirt_firewall
+add table ip kubevirt_firewall
+add chain ip kubevirt_firewall input [{ type filter hook input priority 0; }]
+add chain ip kubevirt_firewall output [{ type filter hook output priority 0; }]
+add chain ip kubevirt_firewall ingress []
+add chain ip kubevirt_firewall egress []
+add rule ip kubevirt_firewall input [iifname eth0 jump ingress]
+add rule ip kubevirt_firewall output [oifname eth0 jump egress]
+add rule ip kubevirt_firewall ingress [ct state established,related accept]
+add rule ip kubevirt_firewall ingress [tcp dport { 49152, 49153 } accept]
+add rule ip kubevirt_firewall ingress [tcp dport 80 counter accept]
+add rule ip kubevirt_firewall ingress [counter drop]
+add rule ip kubevirt_firewall egress [ct state established,related accept]
+add rule ip kubevirt_firewall egress [counter drop]
+add table ip6 kubevirt_firewall
+delete table ip6 kubevirt_firewall
+add table ip6 kubevirt_firewall
+add chain ip6 kubevirt_firewall input [{ type filter hook input priority 0; }]
+add chain ip6 kubevirt_firewall output [{ type filter hook output priority 0; }]
+add chain ip6 kubevirt_firewall ingress []
+add chain ip6 kubevirt_firewall egress []
+add rule ip6 kubevirt_firewall input [iifname eth0 jump ingress]
+add rule ip6 kubevirt_firewall output [oifname eth0 jump egress]
+add rule ip6 kubevirt_firewall ingress [ct state established,related accept]
+add rule ip6 kubevirt_firewall ingress [icmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-solicit, nd-router-advert } accept]
+add rule ip6 kubevirt_firewall ingress [tcp dport { 49152, 49153 } accept]
+add rule ip6 kubevirt_firewall ingress [tcp dport 80 counter accept]
+add rule ip6 kubevirt_firewall ingress [counter drop]
+add rule ip6 kubevirt_firewall egress [ct state established,related accept]
+add rule ip6 kubevirt_firewall egress [icmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-solicit, nd-router-advert } accept]
+add rule ip6 kubevirt_firewall egress [counter drop]
+`
+		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+	})
+
+	It("fails on an invalid CIDR", func() {
+		fw := firewall.New(firewall.ModeForward, "eth0", firewall.WithNftableAdapter(&nftableStub{}))
+
+		Expect(fw.Apply(&v1.Firewall{
+			Ingress: &v1.FirewallRuleSet{
+				Rules: []v1.FirewallRule{{CIDR: "10.10.0.0", Action: v1.FirewallActionAccept}},
+			},
+		})).NotTo(Succeed())
+	})
+})
+
+type nftableStub struct {
+	deleteTableErr error
+	ops            []string
+}
+
+func (n *nftableStub) AddTable(family nft.IPFamily, name string) error {
+	n.ops = append(n.ops, fmt.Sprintf("add table %s %s", family, name))
+	return nil
+}
+
+func (n *nftableStub) DeleteTable(family nft.IPFamily, name string) error {
+	if n.deleteTableErr != nil {
+		return n.deleteTableErr
+	}
+	n.ops = append(n.ops, fmt.Sprintf("delete table %s %s", family, name))
+	return nil
+}
+
+func (n *nftableStub) AddChain(family nft.IPFamily, table string, name string, chainspec ...string) error {
+	n.ops = append(n.ops, fmt.Sprintf("add chain %s %s %s %s", family, table, name, chainspec))
+	return nil
+}
+
+func (n *nftableStub) AddRule(family nft.IPFamily, table string, chain string, rulespec ...string) error {
+	n.ops = append(n.ops, fmt.Sprintf("add rule %s %s %s %s", family, table, chain, rulespec))
+	return nil
+}
+
+func (n *nftableStub) Commit() error {
+	return nil
+}
+
+func (n *nftableStub) String() string {
+	var out strings.Builder
+	for _, op := range n.ops {
+		out.WriteString(op + "\n")
+	}
+	return out.String()
+}
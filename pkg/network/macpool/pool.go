@@ -0,0 +1,292 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package macpool
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+)
+
+// allocationTimeout is the time an allocated MAC address is reserved for its holder,
+// until the holder is reported using it with Set.
+// It frees the addresses allocated to the admission requests which were eventually rejected.
+const allocationTimeout = time.Minute
+
+// Holder identifies an object using MAC addresses.
+// A VM and its VMI share their namespace and name: they are the same owner
+// and may use the same MAC addresses.
+type Holder struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (h Holder) String() string {
+	return fmt.Sprintf("%s %s/%s", h.Kind, h.Namespace, h.Name)
+}
+
+func (h Holder) sameOwner(other Holder) bool {
+	return h.Namespace == other.Namespace && h.Name == other.Name
+}
+
+// Conflict is a MAC address used by holders of different owners.
+type Conflict struct {
+	MacAddress string
+	Holders    []Holder
+}
+
+// Pool tracks the MAC addresses used by the VMs and the VMIs, and allocates the free ones.
+type Pool struct {
+	mu sync.Mutex
+	// holdings maps the holders to their MAC addresses, and the MAC addresses to their holders.
+	// The value is the expiration time of an allocation, zero once the holder is reported using the MAC address.
+	holdings map[Holder]map[uint64]time.Time
+	macs     map[uint64]map[Holder]time.Time
+	// indexers are the stores of the tracked informers, indexed by MAC address.
+	// They can be ahead of the holdings, which are updated by the event handlers of the informers.
+	indexers []cache.Indexer
+	now      func() time.Time
+	// offset returns the position in the ranges the allocation starts from, below the given size.
+	offset func(size uint64) uint64
+}
+
+func New() *Pool {
+	return &Pool{
+		holdings: map[Holder]map[uint64]time.Time{},
+		macs:     map[uint64]map[Holder]time.Time{},
+		now:      time.Now,
+		offset:   randomOffset,
+	}
+}
+
+func randomOffset(size uint64) uint64 {
+	return rand.Uint64() % size
+}
+
+// Set records the MAC addresses used by the holder, replacing the previously reported ones.
+// The pending allocations of the holder are kept until they expire.
+// Invalid MAC addresses are ignored.
+// It returns the conflicts of the holder with the other owners.
+func (p *Pool) Set(holder Holder, macAddresses []string) []Conflict {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for mac, expires := range p.holdings[holder] {
+		if expires.IsZero() || !p.live(expires) {
+			p.remove(holder, mac)
+		}
+	}
+
+	var macs []uint64
+	for _, macAddress := range macAddresses {
+		if mac, err := parseMac(macAddress); err == nil {
+			p.add(holder, mac, time.Time{})
+			macs = append(macs, mac)
+		}
+	}
+
+	var conflicts []Conflict
+	for _, mac := range macs {
+		if conflict := p.conflict(mac); conflict != nil {
+			conflicts = append(conflicts, *conflict)
+		}
+	}
+	return conflicts
+}
+
+// Release frees the MAC addresses used by the holder.
+func (p *Pool) Release(holder Holder) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for mac := range p.holdings[holder] {
+		p.remove(holder, mac)
+	}
+}
+
+// Allocate reserves a free MAC address of the ranges to the holder.
+// The pending allocations are not shared between the virt-api replicas: the search starts
+// from a random position in the ranges, so that the replicas are unlikely to hand out the same MAC address.
+func (p *Pool) Allocate(holder Holder, ranges []Range) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var size uint64
+	for _, r := range ranges {
+		size += r.end - r.start + 1
+	}
+	if size == 0 {
+		return "", fmt.Errorf("no free MAC address left in the pool")
+	}
+
+	// Scan the ranges from the offset on, and wrap around to the addresses before it
+	offset := p.offset(size)
+	var scan, wrapped []Range
+	for _, r := range ranges {
+		switch length := r.end - r.start + 1; {
+		case len(scan) > 0:
+			scan = append(scan, r)
+		case offset >= length:
+			offset -= length
+			wrapped = append(wrapped, r)
+		default:
+			scan = append(scan, Range{start: r.start + offset, end: r.end})
+			if offset > 0 {
+				wrapped = append(wrapped, Range{start: r.start, end: r.start + offset - 1})
+			}
+		}
+	}
+
+	for _, r := range append(scan, wrapped...) {
+		if mac, found := p.firstFree(r); found {
+			p.add(holder, mac, p.now().Add(allocationTimeout))
+			return formatMac(mac), nil
+		}
+	}
+	return "", fmt.Errorf("no free MAC address left in the pool")
+}
+
+func (p *Pool) firstFree(r Range) (uint64, bool) {
+	for mac := r.start; mac <= r.end; mac++ {
+		if isMulticast(mac) {
+			// Skip the whole block of multicast addresses sharing the first octet.
+			mac |= multicastBit - 1
+			continue
+		}
+		if p.inUse(mac) {
+			continue
+		}
+		return mac, true
+	}
+	return 0, false
+}
+
+// Holders returns the holders of other owners using the MAC address.
+func (p *Pool) Holders(holder Holder, macAddress string) []Holder {
+	mac, err := parseMac(macAddress)
+	if err != nil {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var holders []Holder
+	for h, expires := range p.macs[mac] {
+		if !h.sameOwner(holder) && p.live(expires) {
+			holders = append(holders, h)
+		}
+	}
+	sortHolders(holders)
+	return holders
+}
+
+// Conflicts returns all the MAC addresses used by holders of different owners.
+func (p *Pool) Conflicts() []Conflict {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var conflicts []Conflict
+	for mac := range p.macs {
+		if conflict := p.conflict(mac); conflict != nil {
+			conflicts = append(conflicts, *conflict)
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].MacAddress < conflicts[j].MacAddress
+	})
+	return conflicts
+}
+
+func (p *Pool) add(holder Holder, mac uint64, expires time.Time) {
+	if p.holdings[holder] == nil {
+		p.holdings[holder] = map[uint64]time.Time{}
+	}
+	if p.macs[mac] == nil {
+		p.macs[mac] = map[Holder]time.Time{}
+	}
+	p.holdings[holder][mac] = expires
+	p.macs[mac][holder] = expires
+}
+
+func (p *Pool) remove(holder Holder, mac uint64) {
+	delete(p.holdings[holder], mac)
+	if len(p.holdings[holder]) == 0 {
+		delete(p.holdings, holder)
+	}
+	delete(p.macs[mac], holder)
+	if len(p.macs[mac]) == 0 {
+		delete(p.macs, mac)
+	}
+}
+
+func (p *Pool) live(expires time.Time) bool {
+	return expires.IsZero() || p.now().Before(expires)
+}
+
+// inUse reports whether the MAC address is used by any holder, or by any object of the tracked informers.
+// The expired allocations found on the way are removed.
+func (p *Pool) inUse(mac uint64) bool {
+	for h, expires := range p.macs[mac] {
+		if p.live(expires) {
+			return true
+		}
+		p.remove(h, mac)
+	}
+	for _, indexer := range p.indexers {
+		if objs, err := indexer.ByIndex(macAddressIndex, formatMac(mac)); err == nil && len(objs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Pool) conflict(mac uint64) *Conflict {
+	var holders []Holder
+	for h, expires := range p.macs[mac] {
+		if p.live(expires) {
+			holders = append(holders, h)
+		}
+	}
+	for _, h := range holders {
+		if !h.sameOwner(holders[0]) {
+			sortHolders(holders)
+			return &Conflict{MacAddress: formatMac(mac), Holders: holders}
+		}
+	}
+	return nil
+}
+
+func sortHolders(holders []Holder) {
+	sort.Slice(holders, func(i, j int) bool {
+		if holders[i].Namespace != holders[j].Namespace {
+			return holders[i].Namespace < holders[j].Namespace
+		}
+		if holders[i].Name != holders[j].Name {
+			return holders[i].Name < holders[j].Name
+		}
+		return holders[i].Kind < holders[j].Kind
+	})
+}
@@ -0,0 +1,149 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package macpool
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("MAC address pool", func() {
+	var (
+		pool   *Pool
+		now    time.Time
+		ranges []Range
+	)
+
+	vm := func(name string) Holder {
+		return Holder{Kind: v1.VirtualMachineGroupVersionKind.Kind, Namespace: "default", Name: name}
+	}
+	vmi := func(name string) Holder {
+		return Holder{Kind: v1.VirtualMachineInstanceGroupVersionKind.Kind, Namespace: "default", Name: name}
+	}
+
+	BeforeEach(func() {
+		pool = New()
+		now = time.Now()
+		pool.now = func() time.Time { return now }
+		pool.offset = func(uint64) uint64 { return 0 }
+
+		var err error
+		ranges, err = ParseRanges(&v1.MacAddressPool{Ranges: []v1.MacAddressRange{
+			{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:01"},
+			{Start: "02:00:00:00:01:00", End: "02:00:00:00:01:00"},
+		}})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should allocate the free MAC addresses in the order of the ranges", func() {
+		Expect(pool.Set(vm("vm1"), []string{"02:00:00:00:00:00"})).To(BeEmpty())
+
+		Expect(pool.Allocate(vm("vm2"), ranges)).To(Equal("02:00:00:00:00:01"))
+		Expect(pool.Allocate(vm("vm2"), ranges)).To(Equal("02:00:00:00:01:00"))
+		_, err := pool.Allocate(vm("vm3"), ranges)
+		Expect(err).To(MatchError("no free MAC address left in the pool"))
+	})
+
+	It("should start the allocation from the offset and wrap around", func() {
+		pool.offset = func(size uint64) uint64 {
+			Expect(size).To(Equal(uint64(3)))
+			return 2
+		}
+
+		Expect(pool.Allocate(vm("vm1"), ranges)).To(Equal("02:00:00:00:01:00"))
+		Expect(pool.Allocate(vm("vm1"), ranges)).To(Equal("02:00:00:00:00:00"))
+		Expect(pool.Allocate(vm("vm1"), ranges)).To(Equal("02:00:00:00:00:01"))
+	})
+
+	It("should free the MAC addresses of a released holder", func() {
+		Expect(pool.Allocate(vm("vm1"), ranges)).To(Equal("02:00:00:00:00:00"))
+		pool.Release(vm("vm1"))
+
+		Expect(pool.Allocate(vm("vm2"), ranges)).To(Equal("02:00:00:00:00:00"))
+	})
+
+	It("should keep a MAC address used by the VMI of a deleted VM", func() {
+		pool.Set(vm("vm1"), []string{"02:00:00:00:00:00"})
+		pool.Set(vmi("vm1"), []string{"02:00:00:00:00:00"})
+		pool.Release(vm("vm1"))
+
+		Expect(pool.Allocate(vm("vm2"), ranges)).To(Equal("02:00:00:00:00:01"))
+	})
+
+	It("should keep a pending allocation when the holder is reported without it", func() {
+		Expect(pool.Allocate(vm("vm1"), ranges)).To(Equal("02:00:00:00:00:00"))
+		pool.Set(vm("vm1"), nil)
+
+		Expect(pool.Allocate(vm("vm2"), ranges)).To(Equal("02:00:00:00:00:01"))
+	})
+
+	It("should free an allocation which is not reported before it expires", func() {
+		Expect(pool.Allocate(vm("vm1"), ranges)).To(Equal("02:00:00:00:00:00"))
+		now = now.Add(allocationTimeout + time.Second)
+
+		Expect(pool.Allocate(vm("vm2"), ranges)).To(Equal("02:00:00:00:00:00"))
+	})
+
+	It("should keep an allocation reported before it expires", func() {
+		Expect(pool.Allocate(vm("vm1"), ranges)).To(Equal("02:00:00:00:00:00"))
+		pool.Set(vm("vm1"), []string{"02:00:00:00:00:00"})
+		now = now.Add(allocationTimeout + time.Second)
+
+		Expect(pool.Allocate(vm("vm2"), ranges)).To(Equal("02:00:00:00:00:01"))
+	})
+
+	It("should skip the multicast MAC addresses", func() {
+		ranges, err := ParseRanges(&v1.MacAddressPool{Ranges: []v1.MacAddressRange{
+			{Start: "02:ff:ff:ff:ff:ff", End: "04:00:00:00:00:00"},
+		}})
+		Expect(err).ToNot(HaveOccurred())
+		pool.Set(vm("vm1"), []string{"02:ff:ff:ff:ff:ff"})
+
+		Expect(pool.Allocate(vm("vm2"), ranges)).To(Equal("04:00:00:00:00:00"))
+	})
+
+	It("should report the conflicts between different owners", func() {
+		Expect(pool.Set(vm("vm1"), []string{"02:00:00:00:00:00"})).To(BeEmpty())
+		Expect(pool.Set(vmi("vm1"), []string{"02:00:00:00:00:00"})).To(BeEmpty())
+
+		expectedConflict := Conflict{
+			MacAddress: "02:00:00:00:00:00",
+			Holders:    []Holder{vm("vm1"), vmi("vm1"), vm("vm2")},
+		}
+		Expect(pool.Set(vm("vm2"), []string{"02:00:00:00:00:00"})).To(ConsistOf(expectedConflict))
+		Expect(pool.Conflicts()).To(ConsistOf(expectedConflict))
+		Expect(pool.Holders(vm("vm3"), "02:00:00:00:00:00")).To(Equal([]Holder{vm("vm1"), vmi("vm1"), vm("vm2")}))
+		Expect(pool.Holders(vm("vm1"), "02:00:00:00:00:00")).To(Equal([]Holder{vm("vm2")}))
+
+		pool.Release(vm("vm2"))
+		Expect(pool.Conflicts()).To(BeEmpty())
+	})
+
+	It("should match the MAC addresses regardless of their format", func() {
+		pool.Set(vm("vm1"), []string{"02-00-00-00-00-00"})
+
+		Expect(pool.Holders(vm("vm2"), "02:00:00:00:00:00")).To(Equal([]Holder{vm("vm1")}))
+		Expect(pool.Allocate(vm("vm2"), ranges)).To(Equal("02:00:00:00:00:01"))
+	})
+})
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package macpool
+
+import (
+	"fmt"
+	"net"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// Range is a range of MAC addresses, including both its start and end.
+type Range struct {
+	start uint64
+	end   uint64
+}
+
+// ParseRanges returns the ranges of the given pool configuration.
+func ParseRanges(pool *v1.MacAddressPool) ([]Range, error) {
+	if pool == nil {
+		return nil, nil
+	}
+	var ranges []Range
+	for _, r := range pool.Ranges {
+		parsed, err := ParseRange(r)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, parsed)
+	}
+	return ranges, nil
+}
+
+// ParseRange validates the given range configuration.
+// Its start and end must be unicast MAC addresses, the end must not precede the start.
+func ParseRange(r v1.MacAddressRange) (Range, error) {
+	start, err := parseMac(r.Start)
+	if err != nil {
+		return Range{}, fmt.Errorf("invalid range start: %v", err)
+	}
+	end, err := parseMac(r.End)
+	if err != nil {
+		return Range{}, fmt.Errorf("invalid range end: %v", err)
+	}
+	if isMulticast(start) || isMulticast(end) {
+		return Range{}, fmt.Errorf("range %s-%s includes a multicast MAC address bound", r.Start, r.End)
+	}
+	if end < start {
+		return Range{}, fmt.Errorf("range end %s precedes its start %s", r.End, r.Start)
+	}
+	return Range{start: start, end: end}, nil
+}
+
+func parseMac(macAddress string) (uint64, error) {
+	hwAddr, err := net.ParseMAC(macAddress)
+	if err != nil {
+		return 0, err
+	}
+	if len(hwAddr) != 6 {
+		return 0, fmt.Errorf("%s is not an EUI-48 MAC address", macAddress)
+	}
+	var mac uint64
+	for _, b := range hwAddr {
+		mac = mac<<8 | uint64(b)
+	}
+	return mac, nil
+}
+
+func formatMac(mac uint64) string {
+	hwAddr := make(net.HardwareAddr, 6)
+	for i := len(hwAddr) - 1; i >= 0; i-- {
+		hwAddr[i] = byte(mac)
+		mac >>= 8
+	}
+	return hwAddr.String()
+}
+
+// multicastBit is the least significant bit of the first octet.
+const multicastBit = 1 << 40
+
+func isMulticast(mac uint64) bool {
+	return mac&multicastBit != 0
+}
@@ -0,0 +1,53 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package macpool
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("MAC address range", func() {
+	DescribeTable("should be accepted", func(r v1.MacAddressRange, expected Range) {
+		Expect(ParseRange(r)).To(Equal(expected))
+	},
+		Entry("with a single address", v1.MacAddressRange{Start: "02:00:00:00:00:0a", End: "02:00:00:00:00:0a"}, Range{start: 0x02000000000a, end: 0x02000000000a}),
+		Entry("with upper case addresses", v1.MacAddressRange{Start: "02:00:00:00:00:0A", End: "02:00:00:00:FF:FF"}, Range{start: 0x02000000000a, end: 0x02000000ffff}),
+	)
+
+	DescribeTable("should be rejected", func(r v1.MacAddressRange, expectedError string) {
+		_, err := ParseRange(r)
+		Expect(err).To(MatchError(ContainSubstring(expectedError)))
+	},
+		Entry("with an invalid start", v1.MacAddressRange{Start: "02:00:00:00:00", End: "02:00:00:00:00:01"}, "invalid range start"),
+		Entry("with an invalid end", v1.MacAddressRange{Start: "02:00:00:00:00:00", End: "not-a-mac"}, "invalid range end"),
+		Entry("with an EUI-64 address", v1.MacAddressRange{Start: "02:00:00:00:00:00:00:00", End: "02:00:00:00:00:00:00:01"}, "is not an EUI-48 MAC address"),
+		Entry("with a multicast start", v1.MacAddressRange{Start: "01:00:00:00:00:00", End: "02:00:00:00:00:01"}, "includes a multicast MAC address bound"),
+		Entry("with an end preceding the start", v1.MacAddressRange{Start: "02:00:00:00:00:01", End: "02:00:00:00:00:00"}, "precedes its start"),
+	)
+
+	It("should format the MAC addresses it parses", func() {
+		mac, err := parseMac("0A:1b:2c:3d:4e:5f")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(formatMac(mac)).To(Equal("0a:1b:2c:3d:4e:5f"))
+	})
+})
@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package macpool
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+func VMHolder(vm *v1.VirtualMachine) Holder {
+	return Holder{Kind: v1.VirtualMachineGroupVersionKind.Kind, Namespace: vm.Namespace, Name: vm.Name}
+}
+
+func VMIHolder(vmi *v1.VirtualMachineInstance) Holder {
+	return Holder{Kind: v1.VirtualMachineInstanceGroupVersionKind.Kind, Namespace: vmi.Namespace, Name: vmi.Name}
+}
+
+// VMMacAddresses returns the MAC addresses set on the interfaces of the VM template.
+func VMMacAddresses(vm *v1.VirtualMachine) []string {
+	if vm.Spec.Template == nil {
+		return nil
+	}
+	return interfacesMacAddresses(vm.Spec.Template.Spec.Domain.Devices.Interfaces)
+}
+
+// VMIMacAddresses returns the MAC addresses set on the interfaces of the VMI.
+// A VMI in a final phase no longer uses its MAC addresses.
+func VMIMacAddresses(vmi *v1.VirtualMachineInstance) []string {
+	if vmi.IsFinal() {
+		return nil
+	}
+	return interfacesMacAddresses(vmi.Spec.Domain.Devices.Interfaces)
+}
+
+func interfacesMacAddresses(interfaces []v1.Interface) []string {
+	var macAddresses []string
+	for _, iface := range interfaces {
+		if iface.MacAddress != "" {
+			macAddresses = append(macAddresses, iface.MacAddress)
+		}
+	}
+	return macAddresses
+}
+
+const macAddressIndex = "macAddress"
+
+// Track keeps the pool up to date with the MAC addresses used by the VMs and the VMIs
+// of the given informers. The MAC addresses of the deleted objects are released.
+// The informers must not be started yet: their stores are indexed by MAC address,
+// and checked for the MAC addresses used by objects not handled by the pool yet.
+func (p *Pool) Track(vmInformer, vmiInformer cache.SharedIndexInformer) error {
+	err := addMacAddressIndexer(vmInformer, func(obj interface{}) ([]string, error) {
+		return normalizeMacs(VMMacAddresses(obj.(*v1.VirtualMachine))), nil
+	})
+	if err != nil {
+		return err
+	}
+	err = addMacAddressIndexer(vmiInformer, func(obj interface{}) ([]string, error) {
+		return normalizeMacs(VMIMacAddresses(obj.(*v1.VirtualMachineInstance))), nil
+	})
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	p.indexers = append(p.indexers, vmInformer.GetIndexer(), vmiInformer.GetIndexer())
+	p.mu.Unlock()
+
+	_, err = vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    p.setVM,
+		UpdateFunc: func(_, curr interface{}) { p.setVM(curr) },
+		DeleteFunc: p.releaseVM,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    p.setVMI,
+		UpdateFunc: func(_, curr interface{}) { p.setVMI(curr) },
+		DeleteFunc: p.releaseVMI,
+	})
+	return err
+}
+
+// addMacAddressIndexer indexes the store of the informer by MAC address, unless another pool already did.
+func addMacAddressIndexer(informer cache.SharedIndexInformer, indexFunc cache.IndexFunc) error {
+	if _, exists := informer.GetIndexer().GetIndexers()[macAddressIndex]; exists {
+		return nil
+	}
+	return informer.AddIndexers(cache.Indexers{macAddressIndex: indexFunc})
+}
+
+// normalizeMacs formats the valid MAC addresses the way the pool does, to look them up in the indexers.
+func normalizeMacs(macAddresses []string) []string {
+	var macs []string
+	for _, macAddress := range macAddresses {
+		if mac, err := parseMac(macAddress); err == nil {
+			macs = append(macs, formatMac(mac))
+		}
+	}
+	return macs
+}
+
+func (p *Pool) setVM(obj interface{}) {
+	vm := obj.(*v1.VirtualMachine)
+	p.Set(VMHolder(vm), VMMacAddresses(vm))
+}
+
+func (p *Pool) releaseVM(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if vm, ok := obj.(*v1.VirtualMachine); ok {
+		p.Release(VMHolder(vm))
+	}
+}
+
+func (p *Pool) setVMI(obj interface{}) {
+	vmi := obj.(*v1.VirtualMachineInstance)
+	p.Set(VMIHolder(vmi), VMIMacAddresses(vmi))
+}
+
+func (p *Pool) releaseVMI(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if vmi, ok := obj.(*v1.VirtualMachineInstance); ok {
+		p.Release(VMIHolder(vmi))
+	}
+}
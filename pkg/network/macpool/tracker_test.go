@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package macpool
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	framework "k8s.io/client-go/tools/cache/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("MAC address pools tracking the same informers", func() {
+	var (
+		stop         chan struct{}
+		vmInformer   cache.SharedIndexInformer
+		vmSource     *framework.FakeControllerSource
+		poolA, poolB *Pool
+		ranges       []Range
+	)
+
+	newVM := func(name, macAddress string) *v1.VirtualMachine {
+		return &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: v1.VirtualMachineSpec{
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							Devices: v1.Devices{
+								Interfaces: []v1.Interface{{Name: "default", MacAddress: macAddress}},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		stop = make(chan struct{})
+		vmInformer, vmSource = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+
+		// Both pools start the allocation from the same position
+		poolA = New()
+		poolA.offset = func(uint64) uint64 { return 0 }
+		Expect(poolA.Track(vmInformer, vmiInformer)).To(Succeed())
+		poolB = New()
+		poolB.offset = func(uint64) uint64 { return 0 }
+		Expect(poolB.Track(vmInformer, vmiInformer)).To(Succeed())
+
+		go vmInformer.Run(stop)
+		go vmiInformer.Run(stop)
+		Expect(cache.WaitForCacheSync(stop, vmInformer.HasSynced, vmiInformer.HasSynced)).To(BeTrue())
+
+		var err error
+		ranges, err = ParseRanges(&v1.MacAddressPool{Ranges: []v1.MacAddressRange{
+			{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:ff"},
+		}})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	It("should not allocate the MAC address of a VM created through the other pool", func() {
+		macAddress, err := poolA.Allocate(Holder{Kind: "VirtualMachine", Namespace: "default", Name: "vm1"}, ranges)
+		Expect(err).ToNot(HaveOccurred())
+
+		vmSource.Add(newVM("vm1", macAddress))
+		Eventually(vmInformer.GetStore().List).Should(HaveLen(1))
+
+		Expect(poolB.Allocate(Holder{Kind: "VirtualMachine", Namespace: "default", Name: "vm2"}, ranges)).ToNot(Equal(macAddress))
+	})
+
+})
@@ -24,6 +24,8 @@ import (
 	"strconv"
 	"sync"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
@@ -35,6 +37,8 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+	"kubevirt.io/kubevirt/pkg/network/firewall"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/netns"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
@@ -51,6 +55,9 @@ type NetConf struct {
 	nsFactory        nsFactory
 	state            map[string]*netpod.State
 	configStateMutex *sync.RWMutex
+	firewalls        map[string]*v1.Firewall
+	firewallsMutex   *sync.RWMutex
+	nftNetlink       func() bool
 }
 
 type nsFactory func(int) NSExecutor
@@ -59,17 +66,23 @@ type NSExecutor interface {
 	Do(func() error) error
 }
 
-func NewNetConf() *NetConf {
+// NewNetConf creates a NetConf which programs the nftables rules through netlink
+// when nftNetlink reports it enabled, and through the nft binary otherwise.
+func NewNetConf(nftNetlink func() bool) *NetConf {
 	var cacheFactory cache.CacheCreator
-	return NewNetConfWithCustomFactoryAndConfigState(func(pid int) NSExecutor {
+	netConf := NewNetConfWithCustomFactoryAndConfigState(func(pid int) NSExecutor {
 		return netns.New(pid)
 	}, cacheFactory, map[string]*netpod.State{})
+	netConf.nftNetlink = nftNetlink
+	return netConf
 }
 
 func NewNetConfWithCustomFactoryAndConfigState(nsFactory nsFactory, cacheCreator cacheCreator, state map[string]*netpod.State) *NetConf {
 	return &NetConf{
 		state:            state,
 		configStateMutex: &sync.RWMutex{},
+		firewalls:        map[string]*v1.Firewall{},
+		firewallsMutex:   &sync.RWMutex{},
 		cacheCreator:     cacheCreator,
 		nsFactory:        nsFactory,
 	}
@@ -110,7 +123,7 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 		ownerID,
 		queuesCapacity,
 		state,
-		netpod.WithMasqueradeAdapter(newMasqueradeAdapter(vmi)),
+		netpod.WithMasqueradeAdapter(newMasqueradeAdapter(vmi, c.newNftable())),
 		netpod.WithCacheCreator(c.cacheCreator),
 	)
 
@@ -145,10 +158,54 @@ func upgradeConfigStateCache(stateCache *ConfigStateCache, networks []v1.Network
 	return stateCache, nil
 }
 
+// SetupFirewall enforces the vmi firewall rules on the pod network interface.
+// The rules are applied again only when they changed since the last successful setup.
+func (c *NetConf) SetupFirewall(vmi *v1.VirtualMachineInstance, launcherPid int) error {
+	mode, supported := firewallMode(vmi)
+	desired := vmi.Spec.Firewall
+	if !supported {
+		desired = nil
+	}
+
+	c.firewallsMutex.RLock()
+	applied, exists := c.firewalls[string(vmi.UID)]
+	c.firewallsMutex.RUnlock()
+	if exists && equality.Semantic.DeepEqual(applied, desired) {
+		return nil
+	}
+
+	if supported {
+		fw := newFirewallAdapter(vmi, mode, c.newNftable())
+		err := c.nsFactory(launcherPid).Do(func() error {
+			return fw.Apply(desired)
+		})
+		if err != nil {
+			return fmt.Errorf("firewall setup failed, err: %w", err)
+		}
+	}
+
+	c.firewallsMutex.Lock()
+	c.firewalls[string(vmi.UID)] = desired.DeepCopy()
+	c.firewallsMutex.Unlock()
+	return nil
+}
+
+// AppliedFirewall returns the firewall rules enforced by the last successful setup.
+// The second return value is false when no setup occurred yet.
+func (c *NetConf) AppliedFirewall(vmi *v1.VirtualMachineInstance) (*v1.Firewall, bool) {
+	c.firewallsMutex.RLock()
+	defer c.firewallsMutex.RUnlock()
+	applied, exists := c.firewalls[string(vmi.UID)]
+	return applied.DeepCopy(), exists
+}
+
 func (c *NetConf) Teardown(vmi *v1.VirtualMachineInstance) error {
 	c.configStateMutex.Lock()
 	delete(c.state, string(vmi.UID))
 	c.configStateMutex.Unlock()
+	c.firewallsMutex.Lock()
+	delete(c.firewalls, string(vmi.UID))
+	c.firewallsMutex.Unlock()
 	podCache := cache.NewPodInterfaceCache(c.cacheCreator, string(vmi.UID))
 	if err := podCache.Remove(); err != nil {
 		return fmt.Errorf("teardown failed, err: %w", err)
@@ -157,13 +214,60 @@ func (c *NetConf) Teardown(vmi *v1.VirtualMachineInstance) error {
 	return nil
 }
 
-func newMasqueradeAdapter(vmi *v1.VirtualMachineInstance) masquerade.MasqPod {
+type nftable interface {
+	AddTable(family nft.IPFamily, name string) error
+	DeleteTable(family nft.IPFamily, name string) error
+	AddChain(family nft.IPFamily, table, name string, chainspec ...string) error
+	AddRule(family nft.IPFamily, table, chain string, rulespec ...string) error
+	Commit() error
+}
+
+func (c *NetConf) newNftable() nftable {
+	if c.nftNetlink != nil && c.nftNetlink() {
+		return nft.NewNFTNetlink()
+	}
+	return nft.NFTBin{}
+}
+
+func newMasqueradeAdapter(vmi *v1.VirtualMachineInstance, nftable nftable) masquerade.MasqPod {
 	if vmi.Status.MigrationTransport == v1.MigrationTransportUnix {
-		return masquerade.New(masquerade.WithIstio(istio.ProxyInjectionEnabled(vmi)))
+		return masquerade.New(
+			masquerade.WithIstio(istio.ProxyInjectionEnabled(vmi)),
+			masquerade.WithNftableAdapter(nftable),
+		)
 	} else {
 		return masquerade.New(
 			masquerade.WithIstio(istio.ProxyInjectionEnabled(vmi)),
 			masquerade.WithLegacyMigrationPorts(),
+			masquerade.WithNftableAdapter(nftable),
 		)
 	}
 }
+
+// passtBindingName is the name the passt network binding plugin is registered with.
+const passtBindingName = "passt"
+
+func firewallMode(vmi *v1.VirtualMachineInstance) (firewall.Mode, bool) {
+	podNetwork := vmispec.LookupPodNetwork(vmi.Spec.Networks)
+	if podNetwork == nil {
+		return "", false
+	}
+	iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, podNetwork.Name)
+	switch {
+	case iface == nil:
+		return "", false
+	case iface.Masquerade != nil && !istio.ProxyInjectionEnabled(vmi):
+		// The traffic proxied by istio is not forwarded, it cannot be filtered by the firewall.
+		return firewall.ModeForward, true
+	case iface.Passt != nil, iface.Binding != nil && iface.Binding.Name == passtBindingName:
+		return firewall.ModeLocal, true
+	}
+	return "", false
+}
+
+func newFirewallAdapter(vmi *v1.VirtualMachineInstance, mode firewall.Mode, nftable nftable) firewall.Firewall {
+	if vmi.Status.MigrationTransport == v1.MigrationTransportUnix {
+		return firewall.New(mode, namescheme.PrimaryPodInterfaceName, firewall.WithNftableAdapter(nftable))
+	}
+	return firewall.New(mode, namescheme.PrimaryPodInterfaceName, firewall.WithLegacyMigrationPorts(), firewall.WithNftableAdapter(nftable))
+}
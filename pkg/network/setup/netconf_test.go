@@ -126,6 +126,102 @@ var _ = Describe("netconf", func() {
 		netConf := netsetup.NewNetConfWithCustomFactoryAndConfigState(nil, failingCacheCreator{}, stateMap)
 		Expect(netConf.Teardown(vmi)).NotTo(Succeed())
 	})
+
+	Context("firewall", func() {
+		var testFirewall *v1.Firewall
+
+		BeforeEach(func() {
+			testFirewall = &v1.Firewall{
+				Ingress: &v1.FirewallRuleSet{
+					Rules: []v1.FirewallRule{{Protocol: v1.FirewallProtocolTCP, Port: 22, Action: v1.FirewallActionAccept}},
+				},
+			}
+			vmi.Spec.Firewall = testFirewall
+			vmi.Spec.Networks = []v1.Network{{
+				Name:          testNetworkName,
+				NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}},
+			}}
+		})
+
+		DescribeTable("is applied and reported", func(binding v1.InterfaceBindingMethod, pluginBinding *v1.PluginBinding) {
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   testNetworkName,
+				InterfaceBindingMethod: binding,
+				Binding:                pluginBinding,
+			}}
+
+			_, exists := netConf.AppliedFirewall(vmi)
+			Expect(exists).To(BeFalse())
+			Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+			applied, exists := netConf.AppliedFirewall(vmi)
+			Expect(exists).To(BeTrue())
+			Expect(applied).To(Equal(testFirewall))
+		},
+			Entry("with masquerade binding", v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}, nil),
+			Entry("with passt binding", v1.InterfaceBindingMethod{Passt: &v1.InterfacePasst{}}, nil),
+			Entry("with passt binding plugin", v1.InterfaceBindingMethod{}, &v1.PluginBinding{Name: "passt"}),
+		)
+
+		It("is not applied with an unsupported binding", func() {
+			netConf = netsetup.NewNetConfWithCustomFactoryAndConfigState(nsFailureFactory, &tempCacheCreator{}, stateMap)
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   testNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			}}
+
+			Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+			applied, exists := netConf.AppliedFirewall(vmi)
+			Expect(exists).To(BeTrue())
+			Expect(applied).To(BeNil())
+		})
+
+		It("is applied again only when changed", func() {
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   testNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			}}
+			var nsCalls int
+			countingNSFactory := func(_ int) netsetup.NSExecutor {
+				nsCalls++
+				return netnsStub{}
+			}
+			netConf = netsetup.NewNetConfWithCustomFactoryAndConfigState(countingNSFactory, &tempCacheCreator{}, stateMap)
+
+			Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+			Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+			Expect(nsCalls).To(Equal(1))
+
+			vmi.Spec.Firewall = nil
+			Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+			Expect(nsCalls).To(Equal(2))
+			applied, _ := netConf.AppliedFirewall(vmi)
+			Expect(applied).To(BeNil())
+		})
+
+		It("fails when the rules cannot be applied", func() {
+			netConf = netsetup.NewNetConfWithCustomFactoryAndConfigState(nsFailureFactory, &tempCacheCreator{}, stateMap)
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   testNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			}}
+
+			Expect(netConf.SetupFirewall(vmi, launcherPid)).NotTo(Succeed())
+			_, exists := netConf.AppliedFirewall(vmi)
+			Expect(exists).To(BeFalse())
+		})
+
+		It("is forgotten on teardown", func() {
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   testNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			}}
+			Expect(netConf.SetupFirewall(vmi, launcherPid)).To(Succeed())
+			Expect(netConf.Teardown(vmi)).To(Succeed())
+
+			_, exists := netConf.AppliedFirewall(vmi)
+			Expect(exists).To(BeFalse())
+		})
+	})
 })
 
 type netnsStub struct {
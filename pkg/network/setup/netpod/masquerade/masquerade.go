@@ -38,6 +38,7 @@ type nftable interface {
 	AddTable(family nft.IPFamily, name string) error
 	AddChain(family nft.IPFamily, table, name string, chainspec ...string) error
 	AddRule(family nft.IPFamily, table, chain string, rulespec ...string) error
+	Commit() error
 }
 
 type MasqPod struct {
@@ -100,6 +101,9 @@ func (m MasqPod) Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIfac
 			return err
 		}
 	}
+	if err := m.nftable.Commit(); err != nil {
+		return fmt.Errorf("failed to apply the NAT rules: %v", err)
+	}
 	return nil
 }
 
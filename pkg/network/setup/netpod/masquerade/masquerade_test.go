@@ -577,6 +577,10 @@ func (n *nftableStub) AddRule(family nft.IPFamily, table string, chain string, r
 	return nil
 }
 
+func (n *nftableStub) Commit() error {
+	return nil
+}
+
 func (n *nftableStub) String() string {
 	var out string
 
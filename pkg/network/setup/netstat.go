@@ -218,11 +218,20 @@ func ifacesStatusFromDomainInterfaces(domainSpecIfaces []api.Interface) []v1.Vir
 			MAC:        domainSpecIface.MAC.MAC,
 			InfoSource: netvmispec.InfoSourceDomain,
 			QueueCount: domainInterfaceQueues(domainSpecIface.Driver),
+			LinkState:  domainInterfaceLinkState(domainSpecIface.LinkState),
 		})
 	}
 	return vmiStatusIfaces
 }
 
+func domainInterfaceLinkState(linkState *api.LinkState) v1.InterfaceState {
+	if linkState != nil && linkState.State == string(v1.InterfaceStateLinkDown) {
+		return v1.InterfaceStateLinkDown
+	}
+
+	return v1.InterfaceStateLinkUp
+}
+
 func domainInterfaceQueues(driver *api.InterfaceDriver) int32 {
 	if driver != nil && driver.Queues != nil {
 		return int32(*driver.Queues)
@@ -141,6 +141,25 @@ var _ = Describe("netstat", func() {
 			Expect(setup.NetStat.PodInterfaceVolatileDataIsCached(setup.Vmi, primaryNetworkName)).To(BeTrue())
 		})
 
+		It("run status and expect interface/network to be reported with the link state of the domain (without guest-agent)", func() {
+			domainSpecInterface := newDomainSpecIface(primaryNetworkName, "")
+			domainSpecInterface.LinkState = &api.LinkState{State: "down"}
+
+			Expect(
+				setup.addNetworkInterface(
+					newVMISpecIfaceWithBridgeBinding(primaryNetworkName),
+					newVMISpecPodNetwork(primaryNetworkName),
+					domainSpecInterface,
+					primaryPodIPv4, primaryPodIPv6,
+				),
+			).To(Succeed())
+
+			Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+			Expect(setup.Vmi.Status.Interfaces).To(HaveLen(1))
+			Expect(setup.Vmi.Status.Interfaces[0].LinkState).To(Equal(v1.InterfaceStateLinkDown), "the link state should be reported in the status")
+		})
+
 		It("run status and expect 2 interfaces to be reported based on pod and guest-agent data", func() {
 			Expect(
 				setup.addNetworkInterface(
@@ -918,6 +937,11 @@ func newVMIStatusIface(name string, IPs []string, mac, ifaceName string, infoSou
 	if len(IPs) > 0 {
 		ip = IPs[0]
 	}
+	// interfaces reported from the domain spec have both a queue count and a link state
+	var linkState v1.InterfaceState
+	if queueCount != netsetup.UnknownInterfaceQueueCount {
+		linkState = v1.InterfaceStateLinkUp
+	}
 	return v1.VirtualMachineInstanceNetworkInterface{
 		Name:          name,
 		InterfaceName: ifaceName,
@@ -926,6 +950,7 @@ func newVMIStatusIface(name string, IPs []string, mac, ifaceName string, infoSou
 		MAC:           mac,
 		InfoSource:    infoSource,
 		QueueCount:    queueCount,
+		LinkState:     linkState,
 	}
 }
 
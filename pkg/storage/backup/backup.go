@@ -0,0 +1,698 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/status"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	unexpectedResourceFmt  = "unexpected resource %+v"
+	failedKeyFromObjectFmt = "failed to get key from object: %v, %v"
+	enqueuedForSyncFmt     = "enqueued %q for sync"
+
+	backupFileFormat = "qcow2"
+
+	vmBackupFinalizer = "snapshot.kubevirt.io/vmbackup-protection"
+
+	vmBackupDeadlineExceededError = "Failed to backup the VM within the failureDeadline"
+
+	backupStartedEvent   = "VirtualMachineBackupStarted"
+	backupSucceededEvent = "VirtualMachineBackupSucceeded"
+	backupFailedEvent    = "VirtualMachineBackupFailed"
+)
+
+// variable so can be overridden in tests
+var currentTime = func() *metav1.Time {
+	t := metav1.Now()
+	return &t
+}
+
+// VMBackupController is responsible for backing up running VMs
+type VMBackupController struct {
+	Client kubecli.KubevirtClient
+
+	VMBackupInformer cache.SharedIndexInformer
+	VMInformer       cache.SharedIndexInformer
+	VMIInformer      cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	ResyncPeriod time.Duration
+
+	vmBackupQueue workqueue.RateLimitingInterface
+
+	vmStatusUpdater *status.VMStatusUpdater
+}
+
+// Init initializes the backup controller
+func (ctrl *VMBackupController) Init() error {
+	ctrl.vmBackupQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-backup-vmbackup")
+
+	_, err := ctrl.VMBackupInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMBackup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMBackup(newObj) },
+		},
+		ctrl.ResyncPeriod,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMIInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMI,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMI(newObj) },
+			DeleteFunc: ctrl.handleVMI,
+		},
+		ctrl.ResyncPeriod,
+	)
+	if err != nil {
+		return err
+	}
+
+	ctrl.vmStatusUpdater = status.NewVMStatusUpdater(ctrl.Client)
+	return nil
+}
+
+// Run the controller
+func (ctrl *VMBackupController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmBackupQueue.ShutDown()
+
+	log.Log.Info("Starting backup controller.")
+	defer log.Log.Info("Shutting down backup controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMBackupInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmBackupWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMBackupController) vmBackupWorker() {
+	for ctrl.processVMBackupWorkItem() {
+	}
+}
+
+func (ctrl *VMBackupController) processVMBackupWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmBackupQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmBackup worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMBackupInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		vmBackup, ok := storeObj.(*snapshotv1.VirtualMachineBackup)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMBackup(vmBackup.DeepCopy())
+	})
+}
+
+func (ctrl *VMBackupController) handleVMBackup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmBackup, ok := obj.(*snapshotv1.VirtualMachineBackup); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(vmBackup)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, vmBackup)
+			return
+		}
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmBackupQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMBackupController) handleVMI(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmi, ok := obj.(*virtv1.VirtualMachineInstance); ok {
+		k, _ := cache.MetaNamespaceKeyFunc(vmi)
+		keys, err := ctrl.VMBackupInformer.GetIndexer().IndexKeys("vm", k)
+		if err != nil {
+			utilruntime.HandleError(err)
+			return
+		}
+
+		for _, key := range keys {
+			ctrl.vmBackupQueue.Add(key)
+		}
+	}
+}
+
+func (ctrl *VMBackupController) updateVMBackup(vmBackup *snapshotv1.VirtualMachineBackup) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineBackup %s/%s", vmBackup.Namespace, vmBackup.Name)
+
+	if vmBackup.DeletionTimestamp != nil {
+		return ctrl.deleteVMBackup(vmBackup)
+	}
+
+	if !controller.HasFinalizer(vmBackup, vmBackupFinalizer) {
+		controller.AddFinalizer(vmBackup, vmBackupFinalizer)
+		return 0, ctrl.updateVMBackupMetadata(vmBackup)
+	}
+
+	if vmBackup.Status == nil || vmBackup.Status.Phase == snapshotv1.BackupPhaseUnset {
+		vmBackup.Status = &snapshotv1.VirtualMachineBackupStatus{
+			Phase: snapshotv1.BackupPending,
+		}
+		updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, "Backup pending"))
+		updateBackupCondition(vmBackup, newReadyCondition(corev1.ConditionFalse, "Not ready"))
+		return 0, ctrl.updateVMBackupStatus(vmBackup)
+	}
+
+	vmi, err := ctrl.getVMI(vmBackup)
+	if err != nil {
+		return 0, err
+	}
+
+	if vmBackupCompleted(vmBackup) {
+		return 0, ctrl.removeBackupVolume(vmBackup, vmi)
+	}
+
+	if deadline := timeUntilDeadline(vmBackup); deadline < 0 {
+		ctrl.failVMBackup(vmBackup, vmBackupDeadlineExceededError)
+		if err := ctrl.updateVMBackupStatus(vmBackup); err != nil {
+			return 0, err
+		}
+		return 0, ctrl.removeBackupVolume(vmBackup, vmi)
+	}
+
+	switch vmBackup.Status.Phase {
+	case snapshotv1.BackupPending:
+		return ctrl.startVMBackup(vmBackup, vmi)
+	case snapshotv1.BackupInProgress:
+		return ctrl.syncVMBackupProgress(vmBackup, vmi)
+	}
+
+	return 0, nil
+}
+
+// deleteVMBackup detaches the backup volume from the VMI before the backup is removed.
+// A running Pull mode backup is finished first, so that virt-launcher removes the scratch images
+// from the target PVC. A running Push mode backup can not be interrupted, its volume is detached
+// once the job ended or the failure deadline passed.
+func (ctrl *VMBackupController) deleteVMBackup(vmBackup *snapshotv1.VirtualMachineBackup) (time.Duration, error) {
+	if !controller.HasFinalizer(vmBackup, vmBackupFinalizer) {
+		return 0, nil
+	}
+
+	vmi, err := ctrl.getVMI(vmBackup)
+	if err != nil {
+		return 0, err
+	}
+
+	volumeName := backupVolumeName(vmBackup)
+	if vmi != nil && ownsBackupVolume(vmBackup, vmi) {
+		if volumeStatus := getVolumeStatus(vmi, volumeName); volumeStatus != nil {
+			switch volumeStatus.Phase {
+			case virtv1.BackupVolumeExported:
+				return 0, ctrl.completePullBackup(vmBackup, vmi)
+			case virtv1.BackupVolumeInProgress:
+				if deadline := timeUntilDeadline(vmBackup); deadline > 0 {
+					return deadline, nil
+				}
+			}
+		}
+		return 0, ctrl.removeBackupVolume(vmBackup, vmi)
+	}
+
+	if vmi != nil && !hasVolume(vmi, volumeName) && ownsVolumeStatus(vmBackup, getVolumeStatus(vmi, volumeName)) {
+		// Wait for virt-handler to unplug the volume
+		return 0, nil
+	}
+
+	controller.RemoveFinalizer(vmBackup, vmBackupFinalizer)
+	return 0, ctrl.updateVMBackupMetadata(vmBackup)
+}
+
+func (ctrl *VMBackupController) startVMBackup(vmBackup *snapshotv1.VirtualMachineBackup, vmi *virtv1.VirtualMachineInstance) (time.Duration, error) {
+	vm, err := ctrl.getVM(vmBackup)
+	if err != nil {
+		return 0, err
+	}
+
+	if vm == nil {
+		updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, "Source does not exist"))
+		return timeUntilDeadline(vmBackup), ctrl.updateVMBackupStatus(vmBackup)
+	}
+
+	if vmi == nil || !vmi.IsRunning() {
+		updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, "Source is not running"))
+		return timeUntilDeadline(vmBackup), ctrl.updateVMBackupStatus(vmBackup)
+	}
+
+	inProgress, err := ctrl.otherBackupInProgress(vmBackup)
+	if err != nil {
+		return 0, err
+	}
+	if inProgress {
+		updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, "Another backup of the source is in progress"))
+		return timeUntilDeadline(vmBackup), ctrl.updateVMBackupStatus(vmBackup)
+	}
+
+	vmBackup.Status.SourceUID = &vm.UID
+	vmBackup.Status.Phase = snapshotv1.BackupInProgress
+	vmBackup.Status.StartTimestamp = currentTime()
+	vmBackup.Status.CheckpointName = pointerString(checkpointName(vmBackup))
+	if parent := parentCheckpoint(vmBackup, vm); parent != nil {
+		vmBackup.Status.ParentCheckpointName = pointerString(parent.Name)
+	}
+	updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionTrue, "Backup in progress"))
+
+	ctrl.Recorder.Eventf(vmBackup, corev1.EventTypeNormal, backupStartedEvent, "Started backup of VirtualMachine %s", vm.Name)
+	return 0, ctrl.updateVMBackupStatus(vmBackup)
+}
+
+func (ctrl *VMBackupController) syncVMBackupProgress(vmBackup *snapshotv1.VirtualMachineBackup, vmi *virtv1.VirtualMachineInstance) (time.Duration, error) {
+	if vmi == nil || vmBackup.Status.SourceUID == nil {
+		ctrl.failVMBackup(vmBackup, "Source stopped while the backup was in progress")
+		return 0, ctrl.updateVMBackupStatus(vmBackup)
+	}
+
+	volumeName := backupVolumeName(vmBackup)
+	if !hasVolume(vmi, volumeName) {
+		return 0, ctrl.addBackupVolume(vmBackup, vmi)
+	}
+
+	volumeStatus := getVolumeStatus(vmi, volumeName)
+	if volumeStatus == nil || volumeStatus.BackupVolume == nil {
+		return timeUntilDeadline(vmBackup), nil
+	}
+
+	switch volumeStatus.Phase {
+	case virtv1.BackupVolumeExported:
+		return ctrl.syncExportedVMBackup(vmBackup, vmi, volumeStatus.BackupVolume)
+	case virtv1.BackupVolumeCompleted:
+		if err := ctrl.updateVMCheckpoint(vmBackup, vmi, volumeStatus.BackupVolume); err != nil {
+			return 0, err
+		}
+		vmBackup.Status.Phase = snapshotv1.BackupSucceeded
+		vmBackup.Status.CompletionTimestamp = currentTime()
+		setBackupVolumes(vmBackup, volumeStatus.BackupVolume)
+		updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+		updateBackupCondition(vmBackup, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+		ctrl.Recorder.Eventf(vmBackup, corev1.EventTypeNormal, backupSucceededEvent, "Successfully completed VirtualMachineBackup %s", vmBackup.Name)
+	case virtv1.BackupVolumeFailed:
+		ctrl.failVMBackup(vmBackup, volumeStatus.Message)
+	default:
+		return timeUntilDeadline(vmBackup), nil
+	}
+
+	if err := ctrl.updateVMBackupStatus(vmBackup); err != nil {
+		return 0, err
+	}
+	return 0, ctrl.removeBackupVolume(vmBackup, vmi)
+}
+
+// syncExportedVMBackup reports the NBD exports of a Pull mode backup and passes its completion to the VMI
+func (ctrl *VMBackupController) syncExportedVMBackup(vmBackup *snapshotv1.VirtualMachineBackup, vmi *virtv1.VirtualMachineInstance, info *virtv1.DomainBackupInfo) (time.Duration, error) {
+	if vmBackup.Spec.PullCompleted != nil && *vmBackup.Spec.PullCompleted {
+		if err := ctrl.completePullBackup(vmBackup, vmi); err != nil {
+			return 0, err
+		}
+	}
+
+	vmBackupCopy := vmBackup.DeepCopy()
+	setBackupVolumes(vmBackupCopy, info)
+	updateBackupCondition(vmBackupCopy, newReadyCondition(corev1.ConditionTrue, "Backup exported"))
+	if equality.Semantic.DeepEqual(vmBackup.Status, vmBackupCopy.Status) {
+		return timeUntilDeadline(vmBackup), nil
+	}
+	return timeUntilDeadline(vmBackup), ctrl.updateVMBackupStatus(vmBackupCopy)
+}
+
+// setBackupVolumes reports the backup image or the NBD export of each volume.
+// The backup is incremental when all the volumes were backed up relative to the parent checkpoint.
+func setBackupVolumes(vmBackup *snapshotv1.VirtualMachineBackup, info *virtv1.DomainBackupInfo) {
+	vmBackup.Status.Volumes = nil
+	vmBackup.Status.Type = snapshotv1.BackupTypeFull
+	if vmBackup.Status.ParentCheckpointName != nil {
+		vmBackup.Status.Type = snapshotv1.BackupTypeIncremental
+	}
+	incrementalVolumes := sets.New(info.IncrementalVolumes...)
+	for _, volume := range info.Volumes {
+		volumeInfo := snapshotv1.BackupVolumeInfo{
+			VolumeName: volume,
+			Type:       snapshotv1.BackupTypeFull,
+		}
+		if isPullMode(vmBackup) {
+			volumeInfo.ExportName = volume
+		} else {
+			volumeInfo.FileName = backupFileName(vmBackup, volume)
+		}
+		if incrementalVolumes.Has(volume) {
+			volumeInfo.Type = snapshotv1.BackupTypeIncremental
+		} else {
+			vmBackup.Status.Type = snapshotv1.BackupTypeFull
+		}
+		vmBackup.Status.Volumes = append(vmBackup.Status.Volumes, volumeInfo)
+	}
+}
+
+// updateVMCheckpoint records the checkpoint of the completed backup in the VM status,
+// the next incremental backup is taken relative to it.
+// No checkpoint is recorded when none of the volumes can store a dirty bitmap.
+func (ctrl *VMBackupController) updateVMCheckpoint(vmBackup *snapshotv1.VirtualMachineBackup, vmi *virtv1.VirtualMachineInstance, info *virtv1.DomainBackupInfo) error {
+	vm, err := ctrl.getVM(vmBackup)
+	if err != nil || vm == nil {
+		return err
+	}
+
+	vmCopy := vm.DeepCopy()
+	vmCopy.Status.BackupCheckpoint = nil
+	if len(info.CheckpointVolumes) > 0 {
+		vmCopy.Status.BackupCheckpoint = &virtv1.VirtualMachineBackupCheckpoint{
+			Name:              *vmBackup.Status.CheckpointName,
+			BackupName:        vmBackup.Name,
+			VMIUID:            vmi.UID,
+			CreationTimestamp: *currentTime(),
+			Volumes:           info.CheckpointVolumes,
+		}
+	}
+	if equality.Semantic.DeepEqual(vm.Status, vmCopy.Status) {
+		return nil
+	}
+	return ctrl.vmStatusUpdater.UpdateStatus(vmCopy)
+}
+
+func (ctrl *VMBackupController) failVMBackup(vmBackup *snapshotv1.VirtualMachineBackup, reason string) {
+	vmBackup.Status.Phase = snapshotv1.BackupFailed
+	vmBackup.Status.CompletionTimestamp = currentTime()
+	vmBackup.Status.Error = &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: &reason,
+	}
+	updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, reason))
+	updateBackupCondition(vmBackup, newReadyCondition(corev1.ConditionFalse, "Error"))
+	ctrl.Recorder.Eventf(vmBackup, corev1.EventTypeWarning, backupFailedEvent, "VirtualMachineBackup %s failed: %s", vmBackup.Name, reason)
+}
+
+func (ctrl *VMBackupController) otherBackupInProgress(vmBackup *snapshotv1.VirtualMachineBackup) (bool, error) {
+	objs, err := ctrl.VMBackupInformer.GetIndexer().ByIndex("vm", cacheKeyFunc(vmBackup.Namespace, vmBackup.Spec.Source.Name))
+	if err != nil {
+		return false, err
+	}
+	for _, obj := range objs {
+		other := obj.(*snapshotv1.VirtualMachineBackup)
+		if other.Name != vmBackup.Name && other.Status != nil && other.Status.Phase == snapshotv1.BackupInProgress {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (ctrl *VMBackupController) addBackupVolume(vmBackup *snapshotv1.VirtualMachineBackup, vmi *virtv1.VirtualMachineInstance) error {
+	source := &virtv1.BackupVolumeSource{
+		PersistentVolumeClaimVolumeSource: virtv1.PersistentVolumeClaimVolumeSource{
+			PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: vmBackup.Spec.Target.PersistentVolumeClaimName,
+			},
+			Hotpluggable: true,
+		},
+		BackupName:     vmBackup.Name,
+		CheckpointName: *vmBackup.Status.CheckpointName,
+		Pull:           isPullMode(vmBackup),
+	}
+	if vmBackup.Status.ParentCheckpointName != nil {
+		source.IncrementalFrom = *vmBackup.Status.ParentCheckpointName
+	}
+
+	volumes := append(vmi.Spec.Volumes[:len(vmi.Spec.Volumes):len(vmi.Spec.Volumes)], virtv1.Volume{
+		Name:         backupVolumeName(vmBackup),
+		VolumeSource: virtv1.VolumeSource{Backup: source},
+	})
+	return ctrl.patchVMIVolumes(vmi, volumes)
+}
+
+// completePullBackup requests virt-handler to stop the NBD server of the backup
+func (ctrl *VMBackupController) completePullBackup(vmBackup *snapshotv1.VirtualMachineBackup, vmi *virtv1.VirtualMachineInstance) error {
+	volumeName := backupVolumeName(vmBackup)
+	volumes := make([]virtv1.Volume, len(vmi.Spec.Volumes))
+	for i, volume := range vmi.Spec.Volumes {
+		volumes[i] = *volume.DeepCopy()
+		if volume.Name != volumeName || volume.Backup == nil {
+			continue
+		}
+		if volume.Backup.PullCompleted {
+			return nil
+		}
+		volumes[i].Backup.PullCompleted = true
+	}
+	return ctrl.patchVMIVolumes(vmi, volumes)
+}
+
+func (ctrl *VMBackupController) removeBackupVolume(vmBackup *snapshotv1.VirtualMachineBackup, vmi *virtv1.VirtualMachineInstance) error {
+	volumeName := backupVolumeName(vmBackup)
+	if vmi == nil || !ownsBackupVolume(vmBackup, vmi) {
+		return nil
+	}
+
+	var volumes []virtv1.Volume
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name != volumeName {
+			volumes = append(volumes, volume)
+		}
+	}
+	return ctrl.patchVMIVolumes(vmi, volumes)
+}
+
+func (ctrl *VMBackupController) patchVMIVolumes(vmi *virtv1.VirtualMachineInstance, volumes []virtv1.Volume) error {
+	patchVerb := "add"
+	if len(vmi.Spec.Volumes) > 0 {
+		patchVerb = "replace"
+	}
+
+	oldJson, err := json.Marshal(vmi.Spec.Volumes)
+	if err != nil {
+		return err
+	}
+	newJson, err := json.Marshal(volumes)
+	if err != nil {
+		return err
+	}
+
+	test := fmt.Sprintf(`{ "op": "test", "path": "/spec/volumes", "value": %s}`, string(oldJson))
+	update := fmt.Sprintf(`{ "op": "%s", "path": "/spec/volumes", "value": %s}`, patchVerb, string(newJson))
+	patch := fmt.Sprintf("[%s, %s]", test, update)
+
+	_, err = ctrl.Client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, []byte(patch), &metav1.PatchOptions{})
+	return err
+}
+
+func (ctrl *VMBackupController) updateVMBackupStatus(vmBackup *snapshotv1.VirtualMachineBackup) error {
+	_, err := ctrl.Client.VirtualMachineBackup(vmBackup.Namespace).UpdateStatus(context.Background(), vmBackup, metav1.UpdateOptions{})
+	return err
+}
+
+func (ctrl *VMBackupController) updateVMBackupMetadata(vmBackup *snapshotv1.VirtualMachineBackup) error {
+	_, err := ctrl.Client.VirtualMachineBackup(vmBackup.Namespace).Update(context.Background(), vmBackup, metav1.UpdateOptions{})
+	return err
+}
+
+func (ctrl *VMBackupController) getVM(vmBackup *snapshotv1.VirtualMachineBackup) (*virtv1.VirtualMachine, error) {
+	obj, exists, err := ctrl.VMInformer.GetStore().GetByKey(cacheKeyFunc(vmBackup.Namespace, vmBackup.Spec.Source.Name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*virtv1.VirtualMachine), nil
+}
+
+func (ctrl *VMBackupController) getVMI(vmBackup *snapshotv1.VirtualMachineBackup) (*virtv1.VirtualMachineInstance, error) {
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(vmBackup.Namespace, vmBackup.Spec.Source.Name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*virtv1.VirtualMachineInstance), nil
+}
+
+// parentCheckpoint returns the checkpoint an incremental backup can be taken from.
+// Checkpoints of a previous run of the VM are redefined by virt-launcher from the bitmaps persisted in the disk
+// images, volumes whose bitmap is missing or inconsistent are copied entirely.
+func parentCheckpoint(vmBackup *snapshotv1.VirtualMachineBackup, vm *virtv1.VirtualMachine) *virtv1.VirtualMachineBackupCheckpoint {
+	if vmBackup.Spec.Incremental == nil || !*vmBackup.Spec.Incremental {
+		return nil
+	}
+	checkpoint := vm.Status.BackupCheckpoint
+	if checkpoint == nil || len(checkpoint.Volumes) == 0 {
+		return nil
+	}
+	return checkpoint
+}
+
+func isPullMode(vmBackup *snapshotv1.VirtualMachineBackup) bool {
+	return vmBackup.Spec.Mode != nil && *vmBackup.Spec.Mode == snapshotv1.BackupModePull
+}
+
+func vmBackupCompleted(vmBackup *snapshotv1.VirtualMachineBackup) bool {
+	return vmBackup.Status.Phase == snapshotv1.BackupSucceeded || vmBackup.Status.Phase == snapshotv1.BackupFailed
+}
+
+func checkpointName(vmBackup *snapshotv1.VirtualMachineBackup) string {
+	return fmt.Sprintf("vmbackup-%s", vmBackup.UID)
+}
+
+// backupVolumeName is the name of the hotplugged volume the backup is written to
+func backupVolumeName(vmBackup *snapshotv1.VirtualMachineBackup) string {
+	return vmBackup.Spec.Target.PersistentVolumeClaimName
+}
+
+func backupFileName(vmBackup *snapshotv1.VirtualMachineBackup, volumeName string) string {
+	return filepath.Join(vmBackup.Name, fmt.Sprintf("%s.%s", volumeName, backupFileFormat))
+}
+
+func hasVolume(vmi *virtv1.VirtualMachineInstance, volumeName string) bool {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == volumeName {
+			return true
+		}
+	}
+	return false
+}
+
+// ownsBackupVolume returns whether the backup volume of the VMI was added for this backup,
+// later backups to the same PVC use a volume of the same name
+func ownsBackupVolume(vmBackup *snapshotv1.VirtualMachineBackup, vmi *virtv1.VirtualMachineInstance) bool {
+	volumeName := backupVolumeName(vmBackup)
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == volumeName {
+			return volume.Backup != nil && volume.Backup.BackupName == vmBackup.Name
+		}
+	}
+	return false
+}
+
+func ownsVolumeStatus(vmBackup *snapshotv1.VirtualMachineBackup, volumeStatus *virtv1.VolumeStatus) bool {
+	return volumeStatus != nil && volumeStatus.BackupVolume != nil && volumeStatus.BackupVolume.TargetDirectory == vmBackup.Name
+}
+
+func getVolumeStatus(vmi *virtv1.VirtualMachineInstance, volumeName string) *virtv1.VolumeStatus {
+	for i := range vmi.Status.VolumeStatus {
+		if vmi.Status.VolumeStatus[i].Name == volumeName {
+			return &vmi.Status.VolumeStatus[i]
+		}
+	}
+	return nil
+}
+
+func getFailureDeadline(vmBackup *snapshotv1.VirtualMachineBackup) time.Duration {
+	failureDeadline := snapshotv1.DefaultFailureDeadline
+	if vmBackup.Spec.FailureDeadline != nil {
+		failureDeadline = vmBackup.Spec.FailureDeadline.Duration
+	}
+
+	return failureDeadline
+}
+
+func timeUntilDeadline(vmBackup *snapshotv1.VirtualMachineBackup) time.Duration {
+	failureDeadline := getFailureDeadline(vmBackup)
+	// No Deadline set by user
+	if failureDeadline == 0 {
+		return failureDeadline
+	}
+	deadline := vmBackup.CreationTimestamp.Add(failureDeadline)
+	return time.Until(deadline)
+}
+
+func updateBackupCondition(vmBackup *snapshotv1.VirtualMachineBackup, c snapshotv1.Condition) {
+	vmBackup.Status.Conditions = updateCondition(vmBackup.Status.Conditions, c)
+}
+
+func newReadyCondition(status corev1.ConditionStatus, reason string) snapshotv1.Condition {
+	return snapshotv1.Condition{
+		Type:               snapshotv1.ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func newProgressingCondition(status corev1.ConditionStatus, reason string) snapshotv1.Condition {
+	return snapshotv1.Condition{
+		Type:               snapshotv1.ConditionProgressing,
+		Status:             status,
+		Reason:             reason,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func updateCondition(conditions []snapshotv1.Condition, c snapshotv1.Condition) []snapshotv1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == c.Type {
+			if conditions[i].Status != c.Status || conditions[i].Reason != c.Reason {
+				conditions[i] = c
+			}
+			return conditions
+		}
+	}
+	return append(conditions, c)
+}
+
+func cacheKeyFunc(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
+
+func pointerString(s string) *string {
+	return &s
+}
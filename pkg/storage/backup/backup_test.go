@@ -0,0 +1,706 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const (
+	testNamespace = "default"
+	vmName        = "testvm"
+	backupName    = "test-backup"
+	pvcName       = "backup-pvc"
+	diskName      = "disk1"
+)
+
+var (
+	vmUID     types.UID = "vm-uid"
+	vmiUID    types.UID = "vmi-uid"
+	backupUID types.UID = "backup-uid"
+)
+
+var _ = Describe("Backup controller", func() {
+	var (
+		timeStamp  = metav1.Now()
+		vmAPIGroup = "kubevirt.io"
+	)
+
+	timeFunc := func() *metav1.Time {
+		return &timeStamp
+	}
+
+	var (
+		ctrl           *gomock.Controller
+		virtClient     *kubecli.MockKubevirtClient
+		vmInterface    *kubecli.MockVirtualMachineInterface
+		vmiInterface   *kubecli.MockVirtualMachineInstanceInterface
+		vmBackupClient *kubevirtfake.Clientset
+		vmBackupInformer,
+		vmInformer,
+		vmiInformer cache.SharedIndexInformer
+		recorder   *record.FakeRecorder
+		controller *VMBackupController
+	)
+
+	createVMBackup := func() *snapshotv1.VirtualMachineBackup {
+		return &snapshotv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              backupName,
+				Namespace:         testNamespace,
+				UID:               backupUID,
+				CreationTimestamp: timeStamp,
+				Finalizers:        []string{vmBackupFinalizer},
+			},
+			Spec: snapshotv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: &vmAPIGroup,
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+				Target: snapshotv1.BackupTarget{
+					PersistentVolumeClaimName: pvcName,
+				},
+			},
+		}
+	}
+
+	createVMBackupPending := func() *snapshotv1.VirtualMachineBackup {
+		vmBackup := createVMBackup()
+		vmBackup.Status = &snapshotv1.VirtualMachineBackupStatus{
+			Phase: snapshotv1.BackupPending,
+			Conditions: []snapshotv1.Condition{
+				newProgressingCondition(corev1.ConditionFalse, "Backup pending"),
+				newReadyCondition(corev1.ConditionFalse, "Not ready"),
+			},
+		}
+		return vmBackup
+	}
+
+	createVMBackupInProgress := func() *snapshotv1.VirtualMachineBackup {
+		vmBackup := createVMBackupPending()
+		vmBackup.Status.Phase = snapshotv1.BackupInProgress
+		vmBackup.Status.SourceUID = &vmUID
+		vmBackup.Status.StartTimestamp = timeFunc()
+		vmBackup.Status.CheckpointName = pointer.P(checkpointName(vmBackup))
+		updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionTrue, "Backup in progress"))
+		return vmBackup
+	}
+
+	createVM := func() *v1.VirtualMachine {
+		return &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vmName,
+				Namespace: testNamespace,
+				UID:       vmUID,
+			},
+		}
+	}
+
+	createVMI := func() *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vmName,
+				Namespace: testNamespace,
+				UID:       vmiUID,
+			},
+			Spec: v1.VirtualMachineInstanceSpec{
+				Volumes: []v1.Volume{
+					{
+						Name: diskName,
+						VolumeSource: v1.VolumeSource{
+							PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: diskName,
+								},
+							},
+						},
+					},
+				},
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase: v1.Running,
+			},
+		}
+	}
+
+	backupVolume := func(vmBackup *snapshotv1.VirtualMachineBackup) v1.Volume {
+		source := &v1.BackupVolumeSource{
+			PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+				PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: pvcName,
+				},
+				Hotpluggable: true,
+			},
+			BackupName:     vmBackup.Name,
+			CheckpointName: *vmBackup.Status.CheckpointName,
+			Pull:           vmBackup.Spec.Mode != nil && *vmBackup.Spec.Mode == snapshotv1.BackupModePull,
+		}
+		if vmBackup.Status.ParentCheckpointName != nil {
+			source.IncrementalFrom = *vmBackup.Status.ParentCheckpointName
+		}
+		return v1.Volume{
+			Name:         pvcName,
+			VolumeSource: v1.VolumeSource{Backup: source},
+		}
+	}
+
+	createVMIWithBackupVolume := func(vmBackup *snapshotv1.VirtualMachineBackup, phase v1.VolumePhase) *v1.VirtualMachineInstance {
+		vmi := createVMI()
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, backupVolume(vmBackup))
+		vmi.Status.VolumeStatus = []v1.VolumeStatus{
+			{
+				Name:    pvcName,
+				Phase:   phase,
+				Message: "backup message",
+				BackupVolume: &v1.DomainBackupInfo{
+					TargetDirectory:   vmBackup.Name,
+					Volumes:           []string{diskName},
+					CheckpointVolumes: []string{diskName},
+				},
+			},
+		}
+		return vmi
+	}
+
+	expectVMBackupUpdate := func(vmBackup *snapshotv1.VirtualMachineBackup) {
+		vmBackupClient.Fake.PrependReactor("update", "virtualmachinebackups", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			Expect(update.GetSubresource()).To(Equal("status"))
+
+			updateObj := update.GetObject().(*snapshotv1.VirtualMachineBackup)
+			Expect(updateObj).To(Equal(vmBackup))
+
+			return true, update.GetObject(), nil
+		})
+	}
+
+	expectVMBackupMetadataUpdate := func(vmBackup *snapshotv1.VirtualMachineBackup) {
+		vmBackupClient.Fake.PrependReactor("update", "virtualmachinebackups", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			Expect(update.GetSubresource()).To(BeEmpty())
+
+			updateObj := update.GetObject().(*snapshotv1.VirtualMachineBackup)
+			Expect(updateObj).To(Equal(vmBackup))
+
+			return true, update.GetObject(), nil
+		})
+	}
+
+	expectVMIVolumesPatch := func(vmi *v1.VirtualMachineInstance, volumes []v1.Volume) {
+		oldJson, err := json.Marshal(vmi.Spec.Volumes)
+		Expect(err).ToNot(HaveOccurred())
+		newJson, err := json.Marshal(volumes)
+		Expect(err).ToNot(HaveOccurred())
+
+		test := fmt.Sprintf(`{ "op": "test", "path": "/spec/volumes", "value": %s}`, string(oldJson))
+		update := fmt.Sprintf(`{ "op": "replace", "path": "/spec/volumes", "value": %s}`, string(newJson))
+		patch := fmt.Sprintf("[%s, %s]", test, update)
+
+		vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, []byte(patch), &metav1.PatchOptions{}).Return(vmi, nil)
+	}
+
+	addVMBackup := func(vmBackup *snapshotv1.VirtualMachineBackup) {
+		Expect(vmBackupInformer.GetStore().Add(vmBackup)).To(Succeed())
+		key, err := cache.MetaNamespaceKeyFunc(vmBackup)
+		Expect(err).ToNot(HaveOccurred())
+		controller.vmBackupQueue.Add(key)
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+
+		vmBackupInformer, _ = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineBackup{}, virtcontroller.GetVirtualMachineBackupInformerIndexers())
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, virtcontroller.GetVMIInformerIndexers())
+
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		controller = &VMBackupController{
+			Client:           virtClient,
+			VMBackupInformer: vmBackupInformer,
+			VMInformer:       vmInformer,
+			VMIInformer:      vmiInformer,
+			Recorder:         recorder,
+			ResyncPeriod:     60 * time.Second,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		virtClient.EXPECT().VirtualMachine(testNamespace).Return(vmInterface).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(testNamespace).Return(vmiInterface).AnyTimes()
+
+		vmBackupClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineBackup(testNamespace).
+			Return(vmBackupClient.SnapshotV1alpha1().VirtualMachineBackups(testNamespace)).AnyTimes()
+
+		vmBackupClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Expect(action).To(BeNil())
+			return true, nil, nil
+		})
+
+		currentTime = timeFunc
+	})
+
+	It("should add the finalizer to a new VirtualMachineBackup", func() {
+		vmBackup := createVMBackup()
+		vmBackup.Finalizers = nil
+		expectVMBackupMetadataUpdate(createVMBackup())
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+	})
+
+	It("should initialize VirtualMachineBackup status", func() {
+		vmBackup := createVMBackup()
+		expectVMBackupUpdate(createVMBackupPending())
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+	})
+
+	It("should wait for the VM to be running", func() {
+		vmBackup := createVMBackupPending()
+		Expect(vmInformer.GetStore().Add(createVM())).To(Succeed())
+
+		updatedBackup := vmBackup.DeepCopy()
+		updateBackupCondition(updatedBackup, newProgressingCondition(corev1.ConditionFalse, "Source is not running"))
+		expectVMBackupUpdate(updatedBackup)
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+	})
+
+	It("should wait for another backup of the VM to complete", func() {
+		vmBackup := createVMBackupPending()
+		Expect(vmInformer.GetStore().Add(createVM())).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(createVMI())).To(Succeed())
+
+		otherBackup := createVMBackupInProgress()
+		otherBackup.Name = "other-backup"
+		Expect(vmBackupInformer.GetStore().Add(otherBackup)).To(Succeed())
+
+		updatedBackup := vmBackup.DeepCopy()
+		updateBackupCondition(updatedBackup, newProgressingCondition(corev1.ConditionFalse, "Another backup of the source is in progress"))
+		expectVMBackupUpdate(updatedBackup)
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+	})
+
+	It("should start a full backup of a running VM", func() {
+		vmBackup := createVMBackupPending()
+		Expect(vmInformer.GetStore().Add(createVM())).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(createVMI())).To(Succeed())
+
+		expectVMBackupUpdate(createVMBackupInProgress())
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+		testutils.ExpectEvent(recorder, backupStartedEvent)
+	})
+
+	DescribeTable("should start an incremental backup", func(checkpointVMIUID types.UID, checkpointVolumes []string, expectParent bool) {
+		vmBackup := createVMBackupPending()
+		vmBackup.Spec.Incremental = pointer.P(true)
+		vm := createVM()
+		vm.Status.BackupCheckpoint = &v1.VirtualMachineBackupCheckpoint{
+			Name:       "vmbackup-parent",
+			BackupName: "parent-backup",
+			VMIUID:     checkpointVMIUID,
+			Volumes:    checkpointVolumes,
+		}
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(createVMI())).To(Succeed())
+
+		updatedBackup := createVMBackupInProgress()
+		updatedBackup.Spec.Incremental = pointer.P(true)
+		if expectParent {
+			updatedBackup.Status.ParentCheckpointName = pointer.P("vmbackup-parent")
+		}
+		expectVMBackupUpdate(updatedBackup)
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+	},
+		Entry("from the checkpoint of the running VMI", vmiUID, []string{diskName}, true),
+		Entry("from the checkpoint of a previous VMI", types.UID("old-vmi-uid"), []string{diskName}, true),
+		Entry("as a full backup when no volume is tracked by the checkpoint", vmiUID, nil, false),
+	)
+
+	It("should add the backup volume to the VMI", func() {
+		vmBackup := createVMBackupInProgress()
+		vmi := createVMI()
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+		expectVMIVolumesPatch(vmi, append(vmi.Spec.Volumes, backupVolume(vmBackup)))
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+	})
+
+	It("should wait for the backup volume to complete", func() {
+		vmBackup := createVMBackupInProgress()
+		Expect(vmiInformer.GetStore().Add(createVMIWithBackupVolume(vmBackup, v1.BackupVolumeInProgress))).To(Succeed())
+
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+	})
+
+	It("should complete the backup and record the checkpoint in the VM", func() {
+		vmBackup := createVMBackupInProgress()
+		vm := createVM()
+		vmi := createVMIWithBackupVolume(vmBackup, v1.BackupVolumeCompleted)
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+		updatedVM := vm.DeepCopy()
+		updatedVM.Status.BackupCheckpoint = &v1.VirtualMachineBackupCheckpoint{
+			Name:              *vmBackup.Status.CheckpointName,
+			BackupName:        backupName,
+			VMIUID:            vmiUID,
+			CreationTimestamp: timeStamp,
+			Volumes:           []string{diskName},
+		}
+		vmInterface.EXPECT().UpdateStatus(context.Background(), updatedVM).Return(updatedVM, nil)
+
+		updatedBackup := vmBackup.DeepCopy()
+		updatedBackup.Status.Phase = snapshotv1.BackupSucceeded
+		updatedBackup.Status.CompletionTimestamp = timeFunc()
+		updatedBackup.Status.Type = snapshotv1.BackupTypeFull
+		updatedBackup.Status.Volumes = []snapshotv1.BackupVolumeInfo{
+			{
+				VolumeName: diskName,
+				FileName:   "test-backup/disk1.qcow2",
+				Type:       snapshotv1.BackupTypeFull,
+			},
+		}
+		updateBackupCondition(updatedBackup, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+		updateBackupCondition(updatedBackup, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+		expectVMBackupUpdate(updatedBackup)
+		expectVMIVolumesPatch(vmi, createVMI().Spec.Volumes)
+
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+		testutils.ExpectEvent(recorder, backupSucceededEvent)
+	})
+
+	It("should add a pull mode backup volume to the VMI", func() {
+		vmBackup := createVMBackupInProgress()
+		vmBackup.Spec.Mode = pointer.P(snapshotv1.BackupModePull)
+		vmi := createVMI()
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+		volume := backupVolume(vmBackup)
+		Expect(volume.Backup.Pull).To(BeTrue())
+		expectVMIVolumesPatch(vmi, append(vmi.Spec.Volumes, volume))
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+	})
+
+	It("should report the NBD exports of a pull mode backup", func() {
+		vmBackup := createVMBackupInProgress()
+		vmBackup.Spec.Mode = pointer.P(snapshotv1.BackupModePull)
+		Expect(vmiInformer.GetStore().Add(createVMIWithBackupVolume(vmBackup, v1.BackupVolumeExported))).To(Succeed())
+
+		updatedBackup := vmBackup.DeepCopy()
+		updatedBackup.Status.Type = snapshotv1.BackupTypeFull
+		updatedBackup.Status.Volumes = []snapshotv1.BackupVolumeInfo{
+			{
+				VolumeName: diskName,
+				ExportName: diskName,
+				Type:       snapshotv1.BackupTypeFull,
+			},
+		}
+		updateBackupCondition(updatedBackup, newReadyCondition(corev1.ConditionTrue, "Backup exported"))
+		expectVMBackupUpdate(updatedBackup)
+
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+	})
+
+	It("should pass the completion of a pull mode backup to the VMI", func() {
+		vmBackup := createVMBackupInProgress()
+		vmBackup.Spec.Mode = pointer.P(snapshotv1.BackupModePull)
+		vmBackup.Spec.PullCompleted = pointer.P(true)
+		vmBackup.Status.Type = snapshotv1.BackupTypeFull
+		vmBackup.Status.Volumes = []snapshotv1.BackupVolumeInfo{
+			{
+				VolumeName: diskName,
+				ExportName: diskName,
+				Type:       snapshotv1.BackupTypeFull,
+			},
+		}
+		updateBackupCondition(vmBackup, newReadyCondition(corev1.ConditionTrue, "Backup exported"))
+		vmi := createVMIWithBackupVolume(vmBackup, v1.BackupVolumeExported)
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+		volumes := createVMI().Spec.Volumes
+		volume := backupVolume(vmBackup)
+		volume.Backup.PullCompleted = true
+		expectVMIVolumesPatch(vmi, append(volumes, volume))
+
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+	})
+
+	DescribeTable("should report the type of each backed up volume", func(incrementalVolumes []string, expectedType snapshotv1.BackupType) {
+		vmBackup := createVMBackupInProgress()
+		vmBackup.Spec.Incremental = pointer.P(true)
+		vmBackup.Status.ParentCheckpointName = pointer.P("vmbackup-parent")
+		vm := createVM()
+		vmi := createVMIWithBackupVolume(vmBackup, v1.BackupVolumeCompleted)
+		vmi.Status.VolumeStatus[0].BackupVolume.Volumes = []string{diskName, "disk2"}
+		vmi.Status.VolumeStatus[0].BackupVolume.IncrementalVolumes = incrementalVolumes
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Return(vm, nil)
+
+		volumeType := func(volume string) snapshotv1.BackupType {
+			for _, incremental := range incrementalVolumes {
+				if incremental == volume {
+					return snapshotv1.BackupTypeIncremental
+				}
+			}
+			return snapshotv1.BackupTypeFull
+		}
+		updatedBackup := vmBackup.DeepCopy()
+		updatedBackup.Status.Phase = snapshotv1.BackupSucceeded
+		updatedBackup.Status.CompletionTimestamp = timeFunc()
+		updatedBackup.Status.Type = expectedType
+		updatedBackup.Status.Volumes = []snapshotv1.BackupVolumeInfo{
+			{
+				VolumeName: diskName,
+				FileName:   "test-backup/disk1.qcow2",
+				Type:       volumeType(diskName),
+			},
+			{
+				VolumeName: "disk2",
+				FileName:   "test-backup/disk2.qcow2",
+				Type:       volumeType("disk2"),
+			},
+		}
+		updateBackupCondition(updatedBackup, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+		updateBackupCondition(updatedBackup, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+		expectVMBackupUpdate(updatedBackup)
+		expectVMIVolumesPatch(vmi, createVMI().Spec.Volumes)
+
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+	},
+		Entry("as incremental when all the volumes were backed up incrementally", []string{diskName, "disk2"}, snapshotv1.BackupTypeIncremental),
+		Entry("as full when a volume was copied entirely", []string{diskName}, snapshotv1.BackupTypeFull),
+	)
+
+	It("should clear the checkpoint of the VM when no volume can store a dirty bitmap", func() {
+		vmBackup := createVMBackupInProgress()
+		vm := createVM()
+		vm.Status.BackupCheckpoint = &v1.VirtualMachineBackupCheckpoint{
+			Name:       "vmbackup-parent",
+			BackupName: "parent-backup",
+			Volumes:    []string{diskName},
+		}
+		vmi := createVMIWithBackupVolume(vmBackup, v1.BackupVolumeCompleted)
+		vmi.Status.VolumeStatus[0].BackupVolume.CheckpointVolumes = nil
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+		updatedVM := vm.DeepCopy()
+		updatedVM.Status.BackupCheckpoint = nil
+		vmInterface.EXPECT().UpdateStatus(context.Background(), updatedVM).Return(updatedVM, nil)
+		vmBackupClient.Fake.PrependReactor("update", "virtualmachinebackups", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			return true, action.(testing.UpdateAction).GetObject(), nil
+		})
+		expectVMIVolumesPatch(vmi, createVMI().Spec.Volumes)
+
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+		testutils.ExpectEvent(recorder, backupSucceededEvent)
+	})
+
+	It("should fail the backup when the backup volume failed", func() {
+		vmBackup := createVMBackupInProgress()
+		vmi := createVMIWithBackupVolume(vmBackup, v1.BackupVolumeFailed)
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+		updatedBackup := vmBackup.DeepCopy()
+		updatedBackup.Status.Phase = snapshotv1.BackupFailed
+		updatedBackup.Status.CompletionTimestamp = timeFunc()
+		updatedBackup.Status.Error = &snapshotv1.Error{
+			Time:    timeFunc(),
+			Message: pointer.P("backup message"),
+		}
+		updateBackupCondition(updatedBackup, newProgressingCondition(corev1.ConditionFalse, "backup message"))
+		updateBackupCondition(updatedBackup, newReadyCondition(corev1.ConditionFalse, "Error"))
+		expectVMBackupUpdate(updatedBackup)
+		expectVMIVolumesPatch(vmi, createVMI().Spec.Volumes)
+
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+		testutils.ExpectEvent(recorder, backupFailedEvent)
+	})
+
+	It("should fail the backup when the failure deadline is exceeded", func() {
+		vmBackup := createVMBackupInProgress()
+		vmBackup.CreationTimestamp = metav1.NewTime(timeStamp.Add(-time.Hour))
+		Expect(vmiInformer.GetStore().Add(createVMI())).To(Succeed())
+
+		updatedBackup := vmBackup.DeepCopy()
+		updatedBackup.Status.Phase = snapshotv1.BackupFailed
+		updatedBackup.Status.CompletionTimestamp = timeFunc()
+		updatedBackup.Status.Error = &snapshotv1.Error{
+			Time:    timeFunc(),
+			Message: pointer.P(vmBackupDeadlineExceededError),
+		}
+		updateBackupCondition(updatedBackup, newProgressingCondition(corev1.ConditionFalse, vmBackupDeadlineExceededError))
+		updateBackupCondition(updatedBackup, newReadyCondition(corev1.ConditionFalse, "Error"))
+		expectVMBackupUpdate(updatedBackup)
+
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+		testutils.ExpectEvent(recorder, backupFailedEvent)
+	})
+
+	It("should remove the backup volume of a completed backup", func() {
+		vmBackup := createVMBackupInProgress()
+		vmBackup.Status.Phase = snapshotv1.BackupSucceeded
+		vmi := createVMIWithBackupVolume(vmBackup, v1.BackupVolumeCompleted)
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+		expectVMIVolumesPatch(vmi, createVMI().Spec.Volumes)
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+	})
+
+	It("should not remove the backup volume of another backup to the same PVC", func() {
+		vmBackup := createVMBackupInProgress()
+		vmBackup.Status.Phase = snapshotv1.BackupSucceeded
+		otherBackup := createVMBackupInProgress()
+		otherBackup.Name = "other-backup"
+		Expect(vmiInformer.GetStore().Add(createVMIWithBackupVolume(otherBackup, v1.BackupVolumeInProgress))).To(Succeed())
+
+		addVMBackup(vmBackup)
+		controller.processVMBackupWorkItem()
+	})
+
+	Context("when the backup is deleted while it is running", func() {
+		deleteVMBackup := func(vmBackup *snapshotv1.VirtualMachineBackup) *snapshotv1.VirtualMachineBackup {
+			vmBackup.DeletionTimestamp = timeFunc()
+			return vmBackup
+		}
+
+		It("should finish a Pull mode backup so that the scratch images are removed", func() {
+			vmBackup := deleteVMBackup(createVMBackupInProgress())
+			vmBackup.Spec.Mode = pointer.P(snapshotv1.BackupModePull)
+			vmi := createVMIWithBackupVolume(vmBackup, v1.BackupVolumeExported)
+			Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+			volume := backupVolume(vmBackup)
+			volume.Backup.PullCompleted = true
+			expectVMIVolumesPatch(vmi, append(createVMI().Spec.Volumes, volume))
+
+			addVMBackup(vmBackup)
+			controller.processVMBackupWorkItem()
+		})
+
+		It("should wait for a Push mode backup job to end", func() {
+			vmBackup := deleteVMBackup(createVMBackupInProgress())
+			Expect(vmiInformer.GetStore().Add(createVMIWithBackupVolume(vmBackup, v1.BackupVolumeInProgress))).To(Succeed())
+
+			addVMBackup(vmBackup)
+			controller.processVMBackupWorkItem()
+			Expect(vmBackupClient.Actions()).To(BeEmpty())
+		})
+
+		DescribeTable("should detach the backup volume", func(phase v1.VolumePhase, pastDeadline bool) {
+			vmBackup := deleteVMBackup(createVMBackupInProgress())
+			if pastDeadline {
+				vmBackup.CreationTimestamp = metav1.NewTime(timeStamp.Add(-time.Hour))
+			}
+			vmi := createVMIWithBackupVolume(vmBackup, phase)
+			Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+			expectVMIVolumesPatch(vmi, createVMI().Spec.Volumes)
+			addVMBackup(vmBackup)
+			controller.processVMBackupWorkItem()
+		},
+			Entry("once the backup job completed", v1.BackupVolumeCompleted, false),
+			Entry("once the backup job failed", v1.BackupVolumeFailed, false),
+			Entry("of a backup job running past the failure deadline", v1.BackupVolumeInProgress, true),
+		)
+
+		It("should wait for the backup volume to be unplugged", func() {
+			vmBackup := deleteVMBackup(createVMBackupInProgress())
+			vmi := createVMIWithBackupVolume(vmBackup, v1.BackupVolumeCompleted)
+			vmi.Spec.Volumes = createVMI().Spec.Volumes
+			Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+			addVMBackup(vmBackup)
+			controller.processVMBackupWorkItem()
+			Expect(vmBackupClient.Actions()).To(BeEmpty())
+		})
+
+		DescribeTable("should remove the finalizer", func(vmi *v1.VirtualMachineInstance) {
+			vmBackup := deleteVMBackup(createVMBackupInProgress())
+			if vmi != nil {
+				Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+			}
+
+			updatedBackup := vmBackup.DeepCopy()
+			updatedBackup.Finalizers = []string{}
+			expectVMBackupMetadataUpdate(updatedBackup)
+			addVMBackup(vmBackup)
+			controller.processVMBackupWorkItem()
+		},
+			Entry("once the backup volume is unplugged", createVMI()),
+			Entry("when the VMI is gone", nil),
+		)
+	})
+
+	It("should enqueue the backups of a VMI", func() {
+		vmBackup := createVMBackupPending()
+		Expect(vmBackupInformer.GetStore().Add(vmBackup)).To(Succeed())
+
+		controller.handleVMI(createVMI())
+		Expect(controller.vmBackupQueue.Len()).To(Equal(1))
+	})
+})
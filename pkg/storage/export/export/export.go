@@ -121,6 +121,9 @@ const (
 	manifestData           = "manifest-data"
 	manifestsPath          = "/manifests/all"
 	secretManifestPath     = "/manifests/secret"
+	ovfManifestPath        = "/manifests/ovf"
+	ovaManifestPath        = "/manifests/ova"
+	checksumsManifestPath  = "/manifests/checksums"
 	externalHostKey        = "external_host"
 	internalHostKey        = "internal_host"
 	externalCaConfigMapKey = "external_ca_cm"
@@ -153,6 +156,10 @@ func rawGzipURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.img.gz", urlBasePath, pvc.Name))
 }
 
+func qcow2URI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.qcow2", urlBasePath, pvc.Name))
+}
+
 func archiveURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.tar.gz", urlBasePath, pvc.Name))
 }
@@ -182,6 +189,7 @@ type VMExportController struct {
 	PVCInformer                 cache.SharedIndexInformer
 	VMSnapshotInformer          cache.SharedIndexInformer
 	VMSnapshotContentInformer   cache.SharedIndexInformer
+	VMBackupInformer            cache.SharedIndexInformer
 	PodInformer                 cache.SharedIndexInformer
 	DataVolumeInformer          cache.SharedIndexInformer
 	ConfigMapInformer           cache.SharedIndexInformer
@@ -306,6 +314,16 @@ func (ctrl *VMExportController) Init() error {
 	if err != nil {
 		return err
 	}
+	_, err = ctrl.VMBackupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMBackup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMBackup(newObj) },
+			DeleteFunc: ctrl.handleVMBackup,
+		},
+	)
+	if err != nil {
+		return err
+	}
 	_, err = ctrl.VMIInformer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    ctrl.handleVMI,
@@ -513,6 +531,9 @@ func (ctrl *VMExportController) updateVMExport(vmExport *exportv1.VirtualMachine
 	if ctrl.isSourceVM(&vmExport.Spec) {
 		return ctrl.handleSource(vmExport, service, ctrl.getPVCFromSourceVM, ctrl.updateVMExportVMStatus)
 	}
+	if ctrl.isSourceVMBackup(&vmExport.Spec) {
+		return ctrl.handleSource(vmExport, service, ctrl.getPVCFromSourceVMBackup, ctrl.updateVMExportVMBackupStatus)
+	}
 	return 0, nil
 }
 
@@ -896,7 +917,13 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 				},
 			},
 		})
-		ctrl.addVolumeEnvironmentVariables(&podManifest.Spec.Containers[0], pvc, i, mountPoint)
+		if ctrl.isSourceVMBackup(&vmExport.Spec) {
+			if err := ctrl.addBackupVolumeEnvironmentVariables(&podManifest.Spec.Containers[0], vmExport, mountPoint); err != nil {
+				return nil, err
+			}
+		} else {
+			ctrl.addVolumeEnvironmentVariables(&podManifest.Spec.Containers[0], pvc, i, mountPoint)
+		}
 	}
 
 	// Add token and certs ENV variables
@@ -918,6 +945,15 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 	}, corev1.EnvVar{
 		Name:  "EXPORT_SECRET_DEF_URI",
 		Value: secretManifestPath,
+	}, corev1.EnvVar{
+		Name:  "EXPORT_OVF_DEF_URI",
+		Value: ovfManifestPath,
+	}, corev1.EnvVar{
+		Name:  "EXPORT_OVA_URI",
+		Value: ovaManifestPath,
+	}, corev1.EnvVar{
+		Name:  "EXPORT_CHECKSUMS_URI",
+		Value: checksumsManifestPath,
 	})
 
 	tokenSecretRef := ""
@@ -1097,6 +1133,9 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 		}, corev1.EnvVar{
 			Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 			Value: rawGzipURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+			Value: qcow2URI(pvc),
 		})
 	} else {
 		if ctrl.isKubevirtContentType(pvc) {
@@ -1106,6 +1145,9 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 			}, corev1.EnvVar{
 				Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 				Value: rawGzipURI(pvc),
+			}, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+				Value: qcow2URI(pvc),
 			})
 		} else {
 			exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
@@ -89,6 +89,36 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 			},
 		},
 	}
+	// The OVF descriptor is generated from the VM definition, which only VM and VM snapshot sources have
+	if ctrl.isSourceVM(&export.Spec) || ctrl.isSourceVMSnapshot(&export.Spec) {
+		exportLink.Manifests = append(exportLink.Manifests,
+			exportv1.VirtualMachineExportManifest{
+				Type: exportv1.OVF,
+				Url:  scheme + path.Join(hostAndBase, linkType, ovfManifestPath),
+			},
+			exportv1.VirtualMachineExportManifest{
+				Type: exportv1.OVA,
+				Url:  scheme + path.Join(hostAndBase, linkType, ovaManifestPath),
+			},
+		)
+	}
+	// The export server only computes the checksums of raw volumes, which backups don't have
+	if !ctrl.isSourceVMBackup(&export.Spec) && ctrl.hasKubevirtContentType(pvcs) {
+		exportLink.Manifests = append(exportLink.Manifests, exportv1.VirtualMachineExportManifest{
+			Type: exportv1.Checksums,
+			Url:  scheme + path.Join(hostAndBase, linkType, checksumsManifestPath),
+		})
+	}
+	if ctrl.isSourceVMBackup(&export.Spec) {
+		if exporterPod != nil && exporterPod.Status.Phase == corev1.PodRunning {
+			volumes, err := ctrl.getVMBackupLinkVolumes(export, scheme, hostAndBase)
+			if err != nil {
+				return nil, err
+			}
+			exportLink.Volumes = append(exportLink.Volumes, volumes...)
+		}
+		return exportLink, nil
+	}
 	for _, pvc := range pvcs {
 		if pvc != nil && exporterPod != nil && exporterPod.Status.Phase == corev1.PodRunning {
 
@@ -104,6 +134,10 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 							Format: exportv1.KubeVirtGz,
 							Url:    scheme + path.Join(hostAndBase, rawGzipURI(pvc)),
 						},
+						{
+							Format: exportv1.KubeVirtQcow2,
+							Url:    scheme + path.Join(hostAndBase, qcow2URI(pvc)),
+						},
 					},
 				})
 			} else {
@@ -126,6 +160,15 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 	return exportLink, nil
 }
 
+func (ctrl *VMExportController) hasKubevirtContentType(pvcs []*corev1.PersistentVolumeClaim) bool {
+	for _, pvc := range pvcs {
+		if pvc != nil && ctrl.isKubevirtContentType(pvc) {
+			return true
+		}
+	}
+	return false
+}
+
 func (ctrl *VMExportController) internalExportCa() (string, error) {
 	key := controller.NamespacedKey(ctrl.KubevirtNamespace, components.KubeVirtExportCASecretName)
 	obj, exists, err := ctrl.ConfigMapInformer.GetStore().GetByKey(key)
@@ -76,6 +76,7 @@ var _ = Describe("PVC source", func() {
 		dvInformer                  cache.SharedIndexInformer
 		vmSnapshotInformer          cache.SharedIndexInformer
 		vmSnapshotContentInformer   cache.SharedIndexInformer
+		vmBackupInformer            cache.SharedIndexInformer
 		secretInformer              cache.SharedIndexInformer
 		vmInformer                  cache.SharedIndexInformer
 		vmiInformer                 cache.SharedIndexInformer
@@ -116,6 +117,7 @@ var _ = Describe("PVC source", func() {
 		dvInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
 		vmSnapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		vmBackupInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineBackup{})
 		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
 		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
 		routeInformer, _ := testutils.NewFakeInformerFor(&routev1.Route{})
@@ -163,6 +165,7 @@ var _ = Describe("PVC source", func() {
 			SecretInformer:              secretInformer,
 			VMSnapshotInformer:          vmSnapshotInformer,
 			VMSnapshotContentInformer:   vmSnapshotContentInformer,
+			VMBackupInformer:            vmBackupInformer,
 			VolumeSnapshotProvider:      fakeVolumeSnapshotProvider,
 			VMInformer:                  vmInformer,
 			VMIInformer:                 vmiInformer,
@@ -263,6 +266,8 @@ var _ = Describe("PVC source", func() {
 			Expect(vmExport.Status.Links).ToNot(BeNil())
 			Expect(vmExport.Status.Links.External).To(BeNil())
 			verifyArchiveInternal(vmExport, vmExport.Name, testNamespace, testVMExport.Spec.Source.Name)
+			// Only raw volumes have checksums
+			Expect(manifestTypes(vmExport.Status.Links.Internal)).ToNot(ContainElement(exportv1.Checksums))
 			return true, vmExport, nil
 		})
 		retry, err := controller.updateVMExport(testVMExport)
@@ -286,6 +291,11 @@ var _ = Describe("PVC source", func() {
 			Expect(ok).To(BeTrue())
 			verifyKubevirtInternal(vmExport, vmExport.Name, testNamespace, testVMExport.Spec.Source.Name)
 			verifyKubevirtExternal(vmExport, vmExport.Name, testNamespace, testVMExport.Spec.Source.Name)
+			// A PVC has no VM definition to describe in OVF
+			for _, link := range []*exportv1.VirtualMachineExportLink{vmExport.Status.Links.Internal, vmExport.Status.Links.External} {
+				Expect(manifestTypes(link)).To(ContainElements(exportv1.AllManifests, exportv1.AuthHeader, exportv1.Checksums))
+				Expect(manifestTypes(link)).ToNot(ContainElement(BeElementOf(exportv1.OVF, exportv1.OVA)))
+			}
 			return true, vmExport, nil
 		})
 		retry, err := controller.updateVMExport(testVMExport)
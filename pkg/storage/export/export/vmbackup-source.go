@@ -0,0 +1,202 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package export
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	exportv1 "kubevirt.io/api/export/v1alpha1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+func backupQcow2URI(volumeName string) string {
+	return path.Join(fmt.Sprintf("%s/%s/backup.qcow2", urlBasePath, volumeName))
+}
+
+func (ctrl *VMExportController) handleVMBackup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if backup, ok := obj.(*snapshotv1.VirtualMachineBackup); ok {
+		backupKey, _ := cache.MetaNamespaceKeyFunc(backup)
+		keys, err := ctrl.VMExportInformer.GetIndexer().IndexKeys("vmbackup", backupKey)
+		if err != nil {
+			utilruntime.HandleError(err)
+			return
+		}
+		for _, key := range keys {
+			log.Log.V(3).Infof("Adding VMExport due to VMBackup %s", backupKey)
+			ctrl.vmExportQueue.Add(key)
+		}
+	}
+}
+
+func (ctrl *VMExportController) isSourceVMBackup(source *exportv1.VirtualMachineExportSpec) bool {
+	return source != nil && source.Source.APIGroup != nil && *source.Source.APIGroup == snapshotv1.SchemeGroupVersion.Group && source.Source.Kind == "VirtualMachineBackup"
+}
+
+func (ctrl *VMExportController) getVMBackup(namespace, name string) (*snapshotv1.VirtualMachineBackup, bool, error) {
+	key := controller.NamespacedKey(namespace, name)
+	obj, exists, err := ctrl.VMBackupInformer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return nil, exists, err
+	}
+	return obj.(*snapshotv1.VirtualMachineBackup).DeepCopy(), true, nil
+}
+
+// getPVCFromSourceVMBackup returns the target PVC of a succeeded backup, the backup images are served from it
+func (ctrl *VMExportController) getPVCFromSourceVMBackup(vmExport *exportv1.VirtualMachineExport) (*sourceVolumes, error) {
+	backup, exists, err := ctrl.getVMBackup(vmExport.Namespace, vmExport.Spec.Source.Name)
+	if err != nil {
+		return &sourceVolumes{}, err
+	}
+	if !exists {
+		return &sourceVolumes{
+			volumes:          nil,
+			inUse:            false,
+			isPopulated:      false,
+			availableMessage: fmt.Sprintf("VirtualMachineBackup %s/%s does not exist", vmExport.Namespace, vmExport.Spec.Source.Name)}, nil
+	}
+	if backup.Status == nil || backup.Status.Phase != snapshotv1.BackupSucceeded {
+		return &sourceVolumes{
+			volumes:          nil,
+			inUse:            false,
+			isPopulated:      false,
+			availableMessage: fmt.Sprintf("VirtualMachineBackup %s/%s has not succeeded", vmExport.Namespace, vmExport.Spec.Source.Name)}, nil
+	}
+
+	pvcName := backup.Spec.Target.PersistentVolumeClaimName
+	pvc, pvcExists, err := ctrl.getPvc(vmExport.Namespace, pvcName)
+	if err != nil {
+		return &sourceVolumes{}, err
+	}
+	if !pvcExists {
+		return &sourceVolumes{
+			volumes:          nil,
+			inUse:            false,
+			isPopulated:      false,
+			availableMessage: fmt.Sprintf("pvc %s/%s not found", vmExport.Namespace, pvcName)}, nil
+	}
+
+	inUse, err := ctrl.isPVCInUse(vmExport, pvc)
+	if err != nil {
+		return &sourceVolumes{}, err
+	}
+	availableMessage := ""
+	if inUse {
+		availableMessage = fmt.Sprintf("pvc %s/%s is in use", pvc.Namespace, pvc.Name)
+	}
+	return &sourceVolumes{
+		volumes:          []*corev1.PersistentVolumeClaim{pvc},
+		inUse:            inUse,
+		isPopulated:      true,
+		availableMessage: availableMessage}, nil
+}
+
+func (ctrl *VMExportController) updateVMExportVMBackupStatus(vmExport *exportv1.VirtualMachineExport, exporterPod *corev1.Pod, service *corev1.Service, sourceVolumes *sourceVolumes) (time.Duration, error) {
+	var requeue time.Duration
+
+	if !sourceVolumes.isSourceAvailable() && len(sourceVolumes.volumes) > 0 {
+		log.Log.V(4).Infof("Source is not available %s, requeuing", sourceVolumes.availableMessage)
+		requeue = requeueTime
+	}
+
+	vmExportCopy := vmExport.DeepCopy()
+	vmExportCopy.Status.VirtualMachineName = pointer.StringPtr(ctrl.getVmNameFromVMBackup(vmExport))
+
+	if err := ctrl.updateCommonVMExportStatusFields(vmExport, vmExportCopy, exporterPod, service, sourceVolumes, getVolumeName); err != nil {
+		return requeue, err
+	}
+
+	if len(sourceVolumes.volumes) == 0 {
+		vmExportCopy.Status.Conditions = updateCondition(vmExportCopy.Status.Conditions, newPvcCondition(corev1.ConditionFalse, pvcNotFoundReason, sourceVolumes.availableMessage))
+	} else {
+		vmExportCopy.Status.Conditions = updateCondition(vmExportCopy.Status.Conditions, ctrl.pvcConditionFromPVC(sourceVolumes.volumes))
+	}
+
+	if err := ctrl.updateVMExportStatus(vmExport, vmExportCopy); err != nil {
+		return requeue, err
+	}
+	return requeue, nil
+}
+
+func (ctrl *VMExportController) getVmNameFromVMBackup(vmExport *exportv1.VirtualMachineExport) string {
+	if backup, exists, err := ctrl.getVMBackup(vmExport.Namespace, vmExport.Spec.Source.Name); err != nil {
+		log.Log.V(3).Infof("Error getting backup %v", err)
+		return ""
+	} else if exists {
+		return backup.Spec.Source.Name
+	}
+	return ""
+}
+
+// addBackupVolumeEnvironmentVariables serves the image of each backed up volume, the images are
+// stored in a directory named after the backup in the target PVC
+func (ctrl *VMExportController) addBackupVolumeEnvironmentVariables(exportContainer *corev1.Container, vmExport *exportv1.VirtualMachineExport, mountPoint string) error {
+	backup, exists, err := ctrl.getVMBackup(vmExport.Namespace, vmExport.Spec.Source.Name)
+	if err != nil {
+		return err
+	}
+	if !exists || backup.Status == nil {
+		return fmt.Errorf("VirtualMachineBackup %s/%s does not exist", vmExport.Namespace, vmExport.Spec.Source.Name)
+	}
+	for i, volume := range backup.Status.Volumes {
+		exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_PATH", i),
+			Value: filepath.Join(mountPoint, volume.FileName),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_BACKUP_QCOW2_URI", i),
+			Value: backupQcow2URI(volume.VolumeName),
+		})
+	}
+	return nil
+}
+
+func (ctrl *VMExportController) getVMBackupLinkVolumes(vmExport *exportv1.VirtualMachineExport, scheme, hostAndBase string) ([]exportv1.VirtualMachineExportVolume, error) {
+	backup, exists, err := ctrl.getVMBackup(vmExport.Namespace, vmExport.Spec.Source.Name)
+	if err != nil || !exists || backup.Status == nil {
+		return nil, err
+	}
+	var volumes []exportv1.VirtualMachineExportVolume
+	for _, volume := range backup.Status.Volumes {
+		volumes = append(volumes, exportv1.VirtualMachineExportVolume{
+			Name: volume.VolumeName,
+			Formats: []exportv1.VirtualMachineExportVolumeFormat{
+				{
+					Format: exportv1.BackupQcow2,
+					Url:    scheme + path.Join(hostAndBase, backupQcow2URI(volume.VolumeName)),
+				},
+			},
+		})
+	}
+	return volumes, nil
+}
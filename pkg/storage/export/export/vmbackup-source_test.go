@@ -0,0 +1,397 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+package export
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1alpha1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/certificates/bootstrap"
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+)
+
+const (
+	testVMBackupName = "test-backup"
+	testBackupPVC    = "backup-target"
+)
+
+var _ = Describe("VMBackup source", func() {
+	var (
+		ctrl                        *gomock.Controller
+		controller                  *VMExportController
+		recorder                    *record.FakeRecorder
+		pvcInformer                 cache.SharedIndexInformer
+		podInformer                 cache.SharedIndexInformer
+		cmInformer                  cache.SharedIndexInformer
+		vmExportInformer            cache.SharedIndexInformer
+		serviceInformer             cache.SharedIndexInformer
+		dvInformer                  cache.SharedIndexInformer
+		vmSnapshotInformer          cache.SharedIndexInformer
+		vmSnapshotContentInformer   cache.SharedIndexInformer
+		vmBackupInformer            cache.SharedIndexInformer
+		secretInformer              cache.SharedIndexInformer
+		vmInformer                  cache.SharedIndexInformer
+		vmiInformer                 cache.SharedIndexInformer
+		kvInformer                  cache.SharedIndexInformer
+		crdInformer                 cache.SharedIndexInformer
+		instancetypeInformer        cache.SharedIndexInformer
+		clusterInstancetypeInformer cache.SharedIndexInformer
+		preferenceInformer          cache.SharedIndexInformer
+		clusterPreferenceInformer   cache.SharedIndexInformer
+		controllerRevisionInformer  cache.SharedIndexInformer
+		rqInformer                  cache.SharedIndexInformer
+		nsInformer                  cache.SharedIndexInformer
+		k8sClient                   *k8sfake.Clientset
+		vmExportClient              *kubevirtfake.Clientset
+		fakeVolumeSnapshotProvider  *MockVolumeSnapshotProvider
+		mockVMExportQueue           *testutils.MockWorkQueue
+		routeCache                  cache.Store
+		ingressCache                cache.Store
+		certDir                     string
+		certFilePath                string
+		keyFilePath                 string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		var err error
+		certDir, err = os.MkdirTemp("", "certs")
+		Expect(err).ToNot(HaveOccurred())
+		certFilePath = filepath.Join(certDir, "tls.crt")
+		keyFilePath = filepath.Join(certDir, "tls.key")
+		writeCertsToDir(certDir)
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		pvcInformer, _ = testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		cmInformer, _ = testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
+		serviceInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Service{})
+		vmExportInformer, _ = testutils.NewFakeInformerWithIndexersFor(&exportv1.VirtualMachineExport{}, virtcontroller.GetVirtualMachineExportInformerIndexers())
+		dvInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+		vmSnapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		vmBackupInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineBackup{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		routeInformer, _ := testutils.NewFakeInformerFor(&routev1.Route{})
+		routeCache = routeInformer.GetStore()
+		ingressInformer, _ := testutils.NewFakeInformerFor(&networkingv1.Ingress{})
+		ingressCache = ingressInformer.GetStore()
+		secretInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Secret{})
+		kvInformer, _ = testutils.NewFakeInformerFor(&virtv1.KubeVirt{})
+		crdInformer, _ = testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
+		instancetypeInformer, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineInstancetype{})
+		clusterInstancetypeInformer, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
+		preferenceInformer, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachinePreference{})
+		clusterPreferenceInformer, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterPreference{})
+		controllerRevisionInformer, _ = testutils.NewFakeInformerFor(&appsv1.ControllerRevision{})
+		rqInformer, _ = testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
+		nsInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		fakeVolumeSnapshotProvider = &MockVolumeSnapshotProvider{
+			volumeSnapshots: []*vsv1.VolumeSnapshot{},
+		}
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{})
+		k8sClient = k8sfake.NewSimpleClientset()
+		vmExportClient = kubevirtfake.NewSimpleClientset()
+		recorder = record.NewFakeRecorder(100)
+
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineExport(testNamespace).
+			Return(vmExportClient.ExportV1alpha1().VirtualMachineExports(testNamespace)).AnyTimes()
+
+		controller = &VMExportController{
+			Client:                      virtClient,
+			Recorder:                    recorder,
+			PVCInformer:                 pvcInformer,
+			PodInformer:                 podInformer,
+			ConfigMapInformer:           cmInformer,
+			VMExportInformer:            vmExportInformer,
+			ServiceInformer:             serviceInformer,
+			DataVolumeInformer:          dvInformer,
+			KubevirtNamespace:           "kubevirt",
+			TemplateService:             services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", "g", pvcInformer.GetStore(), virtClient, config, qemuGid, "h", rqInformer.GetStore(), nsInformer.GetStore()),
+			caCertManager:               bootstrap.NewFileCertificateManager(certFilePath, keyFilePath),
+			RouteCache:                  routeCache,
+			IngressCache:                ingressCache,
+			RouteConfigMapInformer:      cmInformer,
+			SecretInformer:              secretInformer,
+			VMSnapshotInformer:          vmSnapshotInformer,
+			VMSnapshotContentInformer:   vmSnapshotContentInformer,
+			VMBackupInformer:            vmBackupInformer,
+			VolumeSnapshotProvider:      fakeVolumeSnapshotProvider,
+			VMInformer:                  vmInformer,
+			VMIInformer:                 vmiInformer,
+			CRDInformer:                 crdInformer,
+			KubeVirtInformer:            kvInformer,
+			InstancetypeInformer:        instancetypeInformer,
+			ClusterInstancetypeInformer: clusterInstancetypeInformer,
+			PreferenceInformer:          preferenceInformer,
+			ClusterPreferenceInformer:   clusterPreferenceInformer,
+			ControllerRevisionInformer:  controllerRevisionInformer,
+		}
+		initCert = func(ctrl *VMExportController) {
+			go controller.caCertManager.Start()
+			// Give the thread time to read the certs.
+			Eventually(func() *tls.Certificate {
+				return controller.caCertManager.Current()
+			}, time.Second, time.Millisecond).ShouldNot(BeNil())
+		}
+
+		controller.Init()
+		mockVMExportQueue = testutils.NewMockWorkQueue(controller.vmExportQueue)
+		controller.vmExportQueue = mockVMExportQueue
+
+		Expect(
+			cmInformer.GetStore().Add(&k8sv1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: controller.KubevirtNamespace,
+					Name:      components.KubeVirtExportCASecretName,
+				},
+				Data: map[string]string{
+					"ca-bundle": "replace me with ca cert",
+				},
+			}),
+		).To(Succeed())
+
+		Expect(
+			kvInformer.GetStore().Add(&virtv1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: controller.KubevirtNamespace,
+					Name:      "kv",
+				},
+				Spec: virtv1.KubeVirtSpec{
+					CertificateRotationStrategy: virtv1.KubeVirtCertificateRotateStrategy{
+						SelfSigned: &virtv1.KubeVirtSelfSignConfiguration{
+							CA: &virtv1.CertConfig{
+								Duration:    &metav1.Duration{Duration: 24 * time.Hour},
+								RenewBefore: &metav1.Duration{Duration: 3 * time.Hour},
+							},
+							Server: &virtv1.CertConfig{
+								Duration:    &metav1.Duration{Duration: 2 * time.Hour},
+								RenewBefore: &metav1.Duration{Duration: 1 * time.Hour},
+							},
+						},
+					},
+				},
+				Status: virtv1.KubeVirtStatus{
+					Phase: virtv1.KubeVirtPhaseDeployed,
+				},
+			}),
+		).To(Succeed())
+	})
+
+	AfterEach(func() {
+		controller.caCertManager.Stop()
+		os.RemoveAll(certDir)
+	})
+
+	createVMBackup := func(phase snapshotv1.VirtualMachineBackupPhase) *snapshotv1.VirtualMachineBackup {
+		return &snapshotv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testVMBackupName,
+				Namespace: testNamespace,
+			},
+			Spec: snapshotv1.VirtualMachineBackupSpec{
+				Source: k8sv1.TypedLocalObjectReference{
+					APIGroup: &virtv1.SchemeGroupVersion.Group,
+					Kind:     "VirtualMachine",
+					Name:     testVmName,
+				},
+				Target: snapshotv1.BackupTarget{
+					PersistentVolumeClaimName: testBackupPVC,
+				},
+			},
+			Status: &snapshotv1.VirtualMachineBackupStatus{
+				Phase: phase,
+				Volumes: []snapshotv1.BackupVolumeInfo{
+					{
+						VolumeName: "disk0",
+						FileName:   testVMBackupName + "/disk0.qcow2",
+						Type:       snapshotv1.BackupTypeIncremental,
+					},
+					{
+						VolumeName: "disk1",
+						FileName:   testVMBackupName + "/disk1.qcow2",
+						Type:       snapshotv1.BackupTypeFull,
+					},
+				},
+			},
+		}
+	}
+
+	backupQcow2Format := func(exportName, volumeName string) exportv1.VirtualMachineExportVolumeFormat {
+		return exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.BackupQcow2,
+			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/backup.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), testNamespace, volumeName),
+		}
+	}
+
+	It("should not start the exporter pod until the backup succeeded", func() {
+		testVMExport := createVMBackupVMExport()
+		Expect(vmBackupInformer.GetStore().Add(createVMBackup(snapshotv1.BackupInProgress))).To(Succeed())
+		Expect(pvcInformer.GetStore().Add(createPVC(testBackupPVC, ""))).To(Succeed())
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			verifyLinksEmpty(vmExport)
+			Expect(vmExport.Status.Phase).To(Equal(exportv1.Pending))
+			Expect(vmExport.Status.Conditions).To(ContainElement(HaveField("Message", "VirtualMachineBackup default/test-backup has not succeeded")))
+			return true, vmExport, nil
+		})
+
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+		pods, err := k8sClient.CoreV1().Pods(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pods.Items).To(BeEmpty())
+	})
+
+	It("should serve the backup image of each volume", func() {
+		testVMExport := createVMBackupVMExport()
+		Expect(vmBackupInformer.GetStore().Add(createVMBackup(snapshotv1.BackupSucceeded))).To(Succeed())
+		Expect(pvcInformer.GetStore().Add(createPVC(testBackupPVC, ""))).To(Succeed())
+		k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			create, ok := action.(testing.CreateAction)
+			Expect(ok).To(BeTrue())
+			exportPod, ok := create.GetObject().(*k8sv1.Pod)
+			Expect(ok).To(BeTrue())
+			Expect(exportPod.Spec.Volumes).To(ContainElement(HaveField("Name", testBackupPVC)))
+			Expect(exportPod.Spec.Containers[0].Env).To(ContainElements(
+				k8sv1.EnvVar{Name: "VOLUME0_EXPORT_PATH", Value: "/export-volumes/backup-target/test-backup/disk0.qcow2"},
+				k8sv1.EnvVar{Name: "VOLUME0_EXPORT_BACKUP_QCOW2_URI", Value: "/volumes/disk0/backup.qcow2"},
+				k8sv1.EnvVar{Name: "VOLUME1_EXPORT_PATH", Value: "/export-volumes/backup-target/test-backup/disk1.qcow2"},
+				k8sv1.EnvVar{Name: "VOLUME1_EXPORT_BACKUP_QCOW2_URI", Value: "/volumes/disk1/backup.qcow2"},
+			))
+			for _, env := range exportPod.Spec.Containers[0].Env {
+				Expect(env.Name).ToNot(HaveSuffix("_EXPORT_DIR_URI"))
+			}
+			exportPod.Status = k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+			}
+			return true, exportPod, nil
+		})
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			Expect(vmExport.Status.Phase).To(Equal(exportv1.Ready))
+			Expect(vmExport.Status.VirtualMachineName).To(HaveValue(Equal(testVmName)))
+			verifyLinksInternal(vmExport, backupQcow2Format(testVMExport.Name, "disk0"), backupQcow2Format(testVMExport.Name, "disk1"))
+			Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(2))
+			Expect(vmExport.Status.Links.Internal.Volumes[0].Name).To(Equal("disk0"))
+			Expect(vmExport.Status.Links.Internal.Volumes[1].Name).To(Equal("disk1"))
+			Expect(manifestTypes(vmExport.Status.Links.Internal)).ToNot(ContainElement(exportv1.Checksums))
+			return true, vmExport, nil
+		})
+
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+	})
+
+	It("should retry if the target PVC is in use by another backup", func() {
+		testVMExport := createVMBackupVMExport()
+		Expect(vmBackupInformer.GetStore().Add(createVMBackup(snapshotv1.BackupSucceeded))).To(Succeed())
+		Expect(pvcInformer.GetStore().Add(createPVC(testBackupPVC, ""))).To(Succeed())
+		Expect(podInformer.GetStore().Add(&k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "hp-volume-pod",
+				Namespace: testNamespace,
+			},
+			Spec: k8sv1.PodSpec{
+				Volumes: []k8sv1.Volume{
+					{
+						VolumeSource: k8sv1.VolumeSource{
+							PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: testBackupPVC,
+							},
+						},
+					},
+				},
+			},
+		})).To(Succeed())
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			verifyLinksEmpty(vmExport)
+			return true, vmExport, nil
+		})
+
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(requeueTime))
+	})
+})
+
+func createVMBackupVMExport() *exportv1.VirtualMachineExport {
+	return &exportv1.VirtualMachineExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test",
+			Namespace:         testNamespace,
+			CreationTimestamp: metav1.Now(),
+		},
+		Spec: exportv1.VirtualMachineExportSpec{
+			Source: k8sv1.TypedLocalObjectReference{
+				APIGroup: &snapshotv1.SchemeGroupVersion.Group,
+				Kind:     "VirtualMachineBackup",
+				Name:     testVMBackupName,
+			},
+			TokenSecretRef: pointer.StringPtr("token"),
+		},
+	}
+}
@@ -0,0 +1,491 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package ovf
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// virtualSystemType is the hardware version announced in exported descriptors,
+	// it is understood by all supported vSphere releases
+	virtualSystemType = "vmx-13"
+
+	subTypeVirtualSCSI = "VirtualSCSI"
+	subTypeLSILogic    = "lsilogic"
+	subTypeSATA        = "vmware.sata.ahci"
+
+	nicModelVmxNet3 = "VmxNet3"
+	nicModelE1000   = "E1000"
+	nicModelE1000e  = "E1000e"
+	nicModelPCNet32 = "PCNet32"
+
+	megabyteUnits = "byte * 2^20"
+	byteUnits     = "byte"
+)
+
+// DiskImage is an exported raw disk image backing a volume of the VirtualMachine
+type DiskImage struct {
+	VolumeName string
+	FileName   string
+	Size       int64
+}
+
+// ImportedDisk is a disk of an imported VirtualMachine which has to be populated from the referenced file
+type ImportedDisk struct {
+	VolumeName     string
+	DataVolumeName string
+	FileName       string
+	Capacity       resource.Quantity
+}
+
+type controller struct {
+	resourceType int
+	subType      string
+}
+
+// NewEnvelope generates an OVF descriptor describing the VirtualMachine and the given disk images.
+// Disks without an image, like cloud-init or container disks, are not part of the descriptor.
+func NewEnvelope(vm *v1.VirtualMachine, images []DiskImage) (*Envelope, error) {
+	if vm.Spec.Template == nil {
+		return nil, fmt.Errorf("VirtualMachine %s has no template", vm.Name)
+	}
+	spec := &vm.Spec.Template.Spec
+
+	memory := getGuestMemory(spec)
+	if memory == nil {
+		return nil, fmt.Errorf("unable to determine the guest memory of VirtualMachine %s", vm.Name)
+	}
+
+	envelope := &Envelope{
+		DiskSection:    &DiskSection{Info: "Virtual disk information"},
+		NetworkSection: &NetworkSection{Info: "The list of logical networks"},
+		VirtualSystem: &VirtualSystem{
+			ID:   vm.Name,
+			Info: "A KubeVirt virtual machine",
+			Name: vm.Name,
+			VirtualHardware: VirtualHardwareSection{
+				Info: "Virtual hardware requirements",
+				System: &VirtualSystemSettingData{
+					ElementName:             "Virtual Hardware Family",
+					InstanceID:              "0",
+					VirtualSystemIdentifier: vm.Name,
+					VirtualSystemType:       virtualSystemType,
+				},
+			},
+		},
+	}
+
+	instanceID := 0
+	addItem := func(item Item) string {
+		instanceID++
+		item.InstanceID = strconv.Itoa(instanceID)
+		envelope.VirtualSystem.VirtualHardware.Items = append(envelope.VirtualSystem.VirtualHardware.Items, item)
+		return item.InstanceID
+	}
+
+	cpus := getGuestCPUs(spec)
+	addItem(Item{
+		AllocationUnits: "hertz * 10^6",
+		Description:     "Number of Virtual CPUs",
+		ElementName:     fmt.Sprintf("%d virtual CPU(s)", cpus),
+		ResourceType:    ResourceTypeProcessor,
+		VirtualQuantity: cpus,
+	})
+	memoryMB := memory.Value() >> 20
+	addItem(Item{
+		AllocationUnits: megabyteUnits,
+		Description:     "Memory Size",
+		ElementName:     fmt.Sprintf("%dMB of memory", memoryMB),
+		ResourceType:    ResourceTypeMemory,
+		VirtualQuantity: memoryMB,
+	})
+
+	imagesByVolume := map[string]DiskImage{}
+	for _, image := range images {
+		imagesByVolume[image.VolumeName] = image
+	}
+
+	controllers := map[controller]string{}
+	controllerUnits := map[string]int{}
+	for _, disk := range spec.Domain.Devices.Disks {
+		if disk.Disk == nil {
+			continue
+		}
+		image, exists := imagesByVolume[disk.Name]
+		if !exists {
+			continue
+		}
+
+		ctrl := busToController(disk.Disk.Bus)
+		parent, exists := controllers[ctrl]
+		if !exists {
+			parent = addItem(Item{
+				Address:         strconv.Itoa(countControllers(controllers, ctrl.resourceType)),
+				Description:     fmt.Sprintf("%s Controller", ctrl.subType),
+				ElementName:     fmt.Sprintf("%s controller %d", ctrl.subType, countControllers(controllers, ctrl.resourceType)),
+				ResourceSubType: ctrl.subType,
+				ResourceType:    ctrl.resourceType,
+			})
+			controllers[ctrl] = parent
+		}
+
+		fileID := "file-" + disk.Name
+		envelope.References = append(envelope.References, File{
+			ID:   fileID,
+			Href: image.FileName,
+			Size: image.Size,
+		})
+		envelope.DiskSection.Disks = append(envelope.DiskSection.Disks, Disk{
+			DiskID:                  disk.Name,
+			FileRef:                 fileID,
+			Capacity:                strconv.FormatInt(image.Size, 10),
+			CapacityAllocationUnits: byteUnits,
+			Format:                  RawDiskFormat,
+			PopulatedSize:           image.Size,
+		})
+		addItem(Item{
+			AddressOnParent: strconv.Itoa(controllerUnits[parent]),
+			ElementName:     disk.Name,
+			HostResource:    []string{"ovf:/disk/" + disk.Name},
+			Parent:          parent,
+			ResourceType:    ResourceTypeDiskDrive,
+		})
+		controllerUnits[parent]++
+	}
+
+	networkNames := map[string]string{}
+	for _, network := range spec.Networks {
+		name := network.Name
+		if network.Multus != nil {
+			name = network.Multus.NetworkName
+		}
+		networkNames[network.Name] = name
+	}
+	knownNetworks := map[string]bool{}
+	for _, iface := range spec.Domain.Devices.Interfaces {
+		connection, exists := networkNames[iface.Name]
+		if !exists {
+			continue
+		}
+		if !knownNetworks[connection] {
+			knownNetworks[connection] = true
+			envelope.NetworkSection.Networks = append(envelope.NetworkSection.Networks, Network{
+				Name:        connection,
+				Description: fmt.Sprintf("The %s network", connection),
+			})
+		}
+		addItem(Item{
+			Address:             iface.MacAddress,
+			AutomaticAllocation: pointer.Bool(true),
+			Connection:          []string{connection},
+			ElementName:         iface.Name,
+			ResourceSubType:     interfaceModelToSubType(iface.Model),
+			ResourceType:        ResourceTypeEthernetAdapter,
+		})
+	}
+
+	return envelope, nil
+}
+
+func getGuestCPUs(spec *v1.VirtualMachineInstanceSpec) int64 {
+	if cpu := spec.Domain.CPU; cpu != nil && (cpu.Sockets != 0 || cpu.Cores != 0 || cpu.Threads != 0) {
+		return int64(max(cpu.Sockets, 1) * max(cpu.Cores, 1) * max(cpu.Threads, 1))
+	}
+	if cpu, exists := spec.Domain.Resources.Requests["cpu"]; exists {
+		return max((cpu.MilliValue()+999)/1000, 1)
+	}
+	if cpu, exists := spec.Domain.Resources.Limits["cpu"]; exists {
+		return max((cpu.MilliValue()+999)/1000, 1)
+	}
+	return 1
+}
+
+func getGuestMemory(spec *v1.VirtualMachineInstanceSpec) *resource.Quantity {
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
+		return spec.Domain.Memory.Guest
+	}
+	if memory, exists := spec.Domain.Resources.Requests["memory"]; exists {
+		return &memory
+	}
+	if memory, exists := spec.Domain.Resources.Limits["memory"]; exists {
+		return &memory
+	}
+	return nil
+}
+
+func countControllers(controllers map[controller]string, resourceType int) int {
+	count := 0
+	for ctrl := range controllers {
+		if ctrl.resourceType == resourceType {
+			count++
+		}
+	}
+	return count
+}
+
+func busToController(bus v1.DiskBus) controller {
+	switch bus {
+	case v1.DiskBusSCSI:
+		return controller{resourceType: ResourceTypeSCSIController, subType: subTypeLSILogic}
+	case v1.DiskBusSATA:
+		return controller{resourceType: ResourceTypeSATAController, subType: subTypeSATA}
+	default:
+		return controller{resourceType: ResourceTypeSCSIController, subType: subTypeVirtualSCSI}
+	}
+}
+
+func controllerToBus(item *Item) v1.DiskBus {
+	switch item.ResourceType {
+	case ResourceTypeSCSIController:
+		if strings.EqualFold(item.ResourceSubType, subTypeVirtualSCSI) {
+			return v1.DiskBusVirtio
+		}
+		return v1.DiskBusSCSI
+	case ResourceTypeIDEController, ResourceTypeSATAController:
+		return v1.DiskBusSATA
+	default:
+		return v1.DiskBusVirtio
+	}
+}
+
+func interfaceModelToSubType(model string) string {
+	switch model {
+	case "e1000":
+		return nicModelE1000
+	case "e1000e":
+		return nicModelE1000e
+	case "pcnet":
+		return nicModelPCNet32
+	default:
+		return nicModelVmxNet3
+	}
+}
+
+func subTypeToInterfaceModel(subType string) string {
+	switch strings.ToLower(subType) {
+	case strings.ToLower(nicModelE1000):
+		return "e1000"
+	case strings.ToLower(nicModelE1000e):
+		return "e1000e"
+	case strings.ToLower(nicModelPCNet32):
+		return "pcnet"
+	default:
+		return v1.VirtIO
+	}
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// SanitizeName turns an OVF identifier into a valid DNS-1123 label
+func SanitizeName(name string) string {
+	name = invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.Trim(name, "-")
+}
+
+// ToVirtualMachine converts an OVF descriptor into a stopped VirtualMachine. The first network
+// adapter is connected to the pod network, additional adapters are connected to the Multus network
+// named after their OVF network. The returned disks list the files which have to be imported into
+// the DataVolumes referenced by the VirtualMachine.
+func ToVirtualMachine(envelope *Envelope, name string) (*v1.VirtualMachine, []ImportedDisk, error) {
+	system := envelope.VirtualSystem
+	if system == nil {
+		return nil, nil, fmt.Errorf("the OVF descriptor contains no virtual system")
+	}
+	if name == "" {
+		name = system.Name
+		if name == "" {
+			name = system.ID
+		}
+		name = SanitizeName(name)
+	}
+	if name == "" {
+		return nil, nil, fmt.Errorf("unable to determine the VirtualMachine name, please specify one")
+	}
+
+	spec := v1.VirtualMachineInstanceSpec{}
+	items := map[string]*Item{}
+	for i := range system.VirtualHardware.Items {
+		item := &system.VirtualHardware.Items[i]
+		items[item.InstanceID] = item
+	}
+
+	var disks []ImportedDisk
+	for i := range system.VirtualHardware.Items {
+		item := &system.VirtualHardware.Items[i]
+		switch item.ResourceType {
+		case ResourceTypeProcessor:
+			spec.Domain.CPU = &v1.CPU{
+				Sockets: uint32(item.VirtualQuantity),
+				Cores:   1,
+				Threads: 1,
+			}
+		case ResourceTypeMemory:
+			units := item.AllocationUnits
+			if units == "" {
+				units = megabyteUnits
+			}
+			multiplier, err := ParseAllocationUnits(units)
+			if err != nil {
+				return nil, nil, err
+			}
+			spec.Domain.Memory = &v1.Memory{
+				Guest: resource.NewQuantity(item.VirtualQuantity*multiplier, resource.BinarySI),
+			}
+		case ResourceTypeDiskDrive:
+			disk, err := toImportedDisk(envelope, item, name)
+			if err != nil {
+				return nil, nil, err
+			}
+			bus := v1.DiskBusVirtio
+			if parent, exists := items[item.Parent]; exists {
+				bus = controllerToBus(parent)
+			}
+			spec.Domain.Devices.Disks = append(spec.Domain.Devices.Disks, v1.Disk{
+				Name: disk.VolumeName,
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: bus},
+				},
+			})
+			spec.Volumes = append(spec.Volumes, v1.Volume{
+				Name: disk.VolumeName,
+				VolumeSource: v1.VolumeSource{
+					DataVolume: &v1.DataVolumeSource{Name: disk.DataVolumeName},
+				},
+			})
+			disks = append(disks, *disk)
+		case ResourceTypeEthernetAdapter:
+			addInterface(&spec, item)
+		}
+	}
+
+	if spec.Domain.Memory == nil {
+		return nil, nil, fmt.Errorf("the OVF descriptor does not specify the memory size")
+	}
+	if len(spec.Domain.Devices.Interfaces) == 0 {
+		spec.Domain.Devices.AutoattachPodInterface = pointer.Bool(false)
+	}
+
+	runStrategy := v1.RunStrategyHalted
+	vm := &v1.VirtualMachine{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+			APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1.VirtualMachineSpec{
+			RunStrategy: &runStrategy,
+			Template: &v1.VirtualMachineInstanceTemplateSpec{
+				Spec: spec,
+			},
+		},
+	}
+	return vm, disks, nil
+}
+
+func toImportedDisk(envelope *Envelope, item *Item, vmName string) (*ImportedDisk, error) {
+	if len(item.HostResource) == 0 {
+		return nil, fmt.Errorf("disk %q has no host resource", item.ElementName)
+	}
+	diskID := strings.TrimPrefix(strings.TrimPrefix(item.HostResource[0], "ovf:"), "/disk/")
+	if envelope.DiskSection == nil {
+		return nil, fmt.Errorf("disk %q is not part of the disk section", diskID)
+	}
+	var disk *Disk
+	for i := range envelope.DiskSection.Disks {
+		if envelope.DiskSection.Disks[i].DiskID == diskID {
+			disk = &envelope.DiskSection.Disks[i]
+			break
+		}
+	}
+	if disk == nil {
+		return nil, fmt.Errorf("disk %q is not part of the disk section", diskID)
+	}
+
+	capacity, err := strconv.ParseInt(disk.Capacity, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid capacity %q of disk %q: %v", disk.Capacity, diskID, err)
+	}
+	multiplier, err := ParseAllocationUnits(disk.CapacityAllocationUnits)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := ""
+	for _, file := range envelope.References {
+		if file.ID == disk.FileRef {
+			fileName = file.Href
+			break
+		}
+	}
+	if fileName == "" {
+		return nil, fmt.Errorf("disk %q does not reference a file", diskID)
+	}
+
+	volumeName := SanitizeName(diskID)
+	return &ImportedDisk{
+		VolumeName:     volumeName,
+		DataVolumeName: SanitizeName(vmName + "-" + volumeName),
+		FileName:       fileName,
+		Capacity:       *resource.NewQuantity(capacity*multiplier, resource.BinarySI),
+	}, nil
+}
+
+func addInterface(spec *v1.VirtualMachineInstanceSpec, item *Item) {
+	iface := v1.Interface{
+		Model:      subTypeToInterfaceModel(item.ResourceSubType),
+		MacAddress: item.Address,
+	}
+	network := v1.Network{}
+	if len(spec.Networks) == 0 {
+		iface.Name = "default"
+		iface.InterfaceBindingMethod = v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+		network = *v1.DefaultPodNetwork()
+	} else {
+		iface.Name = fmt.Sprintf("nic%d", len(spec.Networks))
+		iface.InterfaceBindingMethod = v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}
+		networkName := iface.Name
+		if len(item.Connection) > 0 && SanitizeName(item.Connection[0]) != "" {
+			networkName = SanitizeName(item.Connection[0])
+		}
+		network = v1.Network{
+			Name: iface.Name,
+			NetworkSource: v1.NetworkSource{
+				Multus: &v1.MultusNetwork{NetworkName: networkName},
+			},
+		}
+	}
+	spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, iface)
+	spec.Networks = append(spec.Networks, network)
+}
@@ -0,0 +1,224 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package ovf
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("OVF conversion", func() {
+
+	newVirtualMachine := func() *v1.VirtualMachine {
+		guest := resource.MustParse("1Gi")
+		return &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testvm",
+				Namespace: "default",
+			},
+			Spec: v1.VirtualMachineSpec{
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							CPU:    &v1.CPU{Sockets: 2, Cores: 2},
+							Memory: &v1.Memory{Guest: &guest},
+							Devices: v1.Devices{
+								Disks: []v1.Disk{
+									{Name: "rootdisk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}},
+									{Name: "datadisk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSATA}}},
+									{Name: "cloudinit", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}},
+								},
+								Interfaces: []v1.Interface{
+									{Name: "default", InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+									{Name: "secondary", Model: "e1000e", MacAddress: "02:00:00:00:00:01", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+								},
+							},
+						},
+						Networks: []v1.Network{
+							*v1.DefaultPodNetwork(),
+							{Name: "secondary", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "vlan10"}}},
+						},
+						Volumes: []v1.Volume{
+							{Name: "rootdisk", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "root-dv"}}},
+							{Name: "datadisk", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{}}},
+							{Name: "cloudinit", VolumeSource: v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{}}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	images := []DiskImage{
+		{VolumeName: "rootdisk", FileName: "root-dv.img", Size: 10 << 30},
+		{VolumeName: "datadisk", FileName: "data-pvc.img", Size: 1 << 30},
+	}
+
+	itemsOfType := func(envelope *Envelope, resourceType int) []Item {
+		var result []Item
+		for _, item := range envelope.VirtualSystem.VirtualHardware.Items {
+			if item.ResourceType == resourceType {
+				result = append(result, item)
+			}
+		}
+		return result
+	}
+
+	Context("export", func() {
+		It("should describe CPU, memory, disks and NICs of the VirtualMachine", func() {
+			envelope, err := NewEnvelope(newVirtualMachine(), images)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(envelope.VirtualSystem.ID).To(Equal("testvm"))
+			Expect(itemsOfType(envelope, ResourceTypeProcessor)).To(ConsistOf(HaveField("VirtualQuantity", int64(4))))
+			Expect(itemsOfType(envelope, ResourceTypeMemory)).To(ConsistOf(And(
+				HaveField("VirtualQuantity", int64(1024)),
+				HaveField("AllocationUnits", "byte * 2^20"),
+			)))
+
+			Expect(envelope.References).To(ConsistOf(
+				File{ID: "file-rootdisk", Href: "root-dv.img", Size: 10 << 30},
+				File{ID: "file-datadisk", Href: "data-pvc.img", Size: 1 << 30},
+			))
+			Expect(envelope.DiskSection.Disks).To(HaveLen(2))
+			Expect(envelope.DiskSection.Disks[0].Format).To(Equal(RawDiskFormat))
+
+			scsi := itemsOfType(envelope, ResourceTypeSCSIController)
+			Expect(scsi).To(ConsistOf(HaveField("ResourceSubType", "VirtualSCSI")))
+			sata := itemsOfType(envelope, ResourceTypeSATAController)
+			Expect(sata).To(ConsistOf(HaveField("ResourceSubType", "vmware.sata.ahci")))
+			Expect(itemsOfType(envelope, ResourceTypeDiskDrive)).To(ConsistOf(
+				And(HaveField("HostResource", ConsistOf("ovf:/disk/rootdisk")), HaveField("Parent", scsi[0].InstanceID)),
+				And(HaveField("HostResource", ConsistOf("ovf:/disk/datadisk")), HaveField("Parent", sata[0].InstanceID)),
+			))
+
+			Expect(envelope.NetworkSection.Networks).To(ConsistOf(HaveField("Name", "default"), HaveField("Name", "vlan10")))
+			Expect(itemsOfType(envelope, ResourceTypeEthernetAdapter)).To(ConsistOf(
+				And(HaveField("ResourceSubType", "VmxNet3"), HaveField("Connection", ConsistOf("default"))),
+				And(HaveField("ResourceSubType", "E1000e"), HaveField("Connection", ConsistOf("vlan10")), HaveField("Address", "02:00:00:00:00:01")),
+			))
+		})
+
+		It("should use the memory request when no guest memory is set", func() {
+			vm := newVirtualMachine()
+			vm.Spec.Template.Spec.Domain.Memory = nil
+			vm.Spec.Template.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+				k8sv1.ResourceMemory: resource.MustParse("512Mi"),
+			}
+			envelope, err := NewEnvelope(vm, images)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(itemsOfType(envelope, ResourceTypeMemory)).To(ConsistOf(HaveField("VirtualQuantity", int64(512))))
+		})
+
+		It("should fail when the guest memory is unknown", func() {
+			vm := newVirtualMachine()
+			vm.Spec.Template.Spec.Domain.Memory = nil
+			_, err := NewEnvelope(vm, images)
+			Expect(err).To(MatchError(ContainSubstring("unable to determine the guest memory")))
+		})
+	})
+
+	Context("import", func() {
+		It("should convert a vSphere descriptor", func() {
+			envelope, err := Unmarshal([]byte(vmwareDescriptor))
+			Expect(err).ToNot(HaveOccurred())
+
+			vm, disks, err := ToVirtualMachine(envelope, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.Name).To(Equal("photon-os"))
+			Expect(*vm.Spec.RunStrategy).To(Equal(v1.RunStrategyHalted))
+
+			spec := vm.Spec.Template.Spec
+			Expect(spec.Domain.CPU.Sockets).To(BeEquivalentTo(2))
+			Expect(spec.Domain.Memory.Guest.Value()).To(BeEquivalentTo(2 << 30))
+			Expect(spec.Domain.Devices.Disks).To(ConsistOf(v1.Disk{
+				Name:       "vmdisk1",
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}},
+			}))
+			Expect(spec.Volumes).To(ConsistOf(v1.Volume{
+				Name:         "vmdisk1",
+				VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "photon-os-vmdisk1"}},
+			}))
+			Expect(spec.Domain.Devices.Interfaces).To(HaveLen(1))
+			Expect(spec.Domain.Devices.Interfaces[0].Model).To(Equal(v1.VirtIO))
+			Expect(spec.Domain.Devices.Interfaces[0].Masquerade).ToNot(BeNil())
+			Expect(spec.Networks).To(ConsistOf(*v1.DefaultPodNetwork()))
+
+			Expect(disks).To(HaveLen(1))
+			Expect(disks[0].DataVolumeName).To(Equal("photon-os-vmdisk1"))
+			Expect(disks[0].FileName).To(Equal("photon-disk1.vmdk"))
+			Expect(disks[0].Capacity.Value()).To(BeEquivalentTo(16 << 30))
+		})
+
+		It("should use the given name", func() {
+			envelope, err := Unmarshal([]byte(vmwareDescriptor))
+			Expect(err).ToNot(HaveOccurred())
+
+			vm, disks, err := ToVirtualMachine(envelope, "imported")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.Name).To(Equal("imported"))
+			Expect(disks[0].DataVolumeName).To(Equal("imported-vmdisk1"))
+		})
+
+		It("should restore an exported VirtualMachine", func() {
+			envelope, err := NewEnvelope(newVirtualMachine(), images)
+			Expect(err).ToNot(HaveOccurred())
+			data, err := Marshal(envelope)
+			Expect(err).ToNot(HaveOccurred())
+			envelope, err = Unmarshal(data)
+			Expect(err).ToNot(HaveOccurred())
+
+			vm, disks, err := ToVirtualMachine(envelope, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.Name).To(Equal("testvm"))
+
+			spec := vm.Spec.Template.Spec
+			Expect(spec.Domain.CPU.Sockets).To(BeEquivalentTo(4))
+			Expect(spec.Domain.Memory.Guest.Value()).To(BeEquivalentTo(1 << 30))
+			Expect(spec.Domain.Devices.Disks).To(ConsistOf(
+				HaveField("DiskDevice.Disk.Bus", v1.DiskBusVirtio),
+				HaveField("DiskDevice.Disk.Bus", v1.DiskBusSATA),
+			))
+			Expect(spec.Domain.Devices.Interfaces).To(ConsistOf(
+				And(HaveField("Name", "default"), HaveField("Model", v1.VirtIO)),
+				And(HaveField("Name", "nic1"), HaveField("Model", "e1000e"), HaveField("MacAddress", "02:00:00:00:00:01")),
+			))
+			Expect(spec.Networks).To(ContainElement(v1.Network{
+				Name:          "nic1",
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "vlan10"}},
+			}))
+			Expect(disks).To(ConsistOf(
+				HaveField("FileName", "root-dv.img"),
+				HaveField("FileName", "data-pvc.img"),
+			))
+		})
+
+		It("should fail without a virtual system", func() {
+			_, _, err := ToVirtualMachine(&Envelope{}, "")
+			Expect(err).To(MatchError(ContainSubstring("no virtual system")))
+		})
+	})
+})
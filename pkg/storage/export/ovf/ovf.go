@@ -0,0 +1,261 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package ovf
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	EnvelopeNamespace = "http://schemas.dmtf.org/ovf/envelope/1"
+	RASDNamespace     = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData"
+	VSSDNamespace     = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_VirtualSystemSettingData"
+
+	// RawDiskFormat is the disk format URI announced for exported raw disk images
+	RawDiskFormat = "http://www.kubevirt.io/ovf/disk-format/raw"
+
+	// Extension is the file extension of an OVF descriptor
+	Extension = ".ovf"
+)
+
+// CIM resource types used in the virtual hardware section
+const (
+	ResourceTypeProcessor       = 3
+	ResourceTypeMemory          = 4
+	ResourceTypeIDEController   = 5
+	ResourceTypeSCSIController  = 6
+	ResourceTypeEthernetAdapter = 10
+	ResourceTypeCDDrive         = 15
+	ResourceTypeDiskDrive       = 17
+	ResourceTypeSATAController  = 20
+)
+
+// Envelope is the root element of an OVF descriptor
+type Envelope struct {
+	XMLName        xml.Name        `xml:"Envelope"`
+	Xmlns          string          `xml:"xmlns,attr,omitempty"`
+	XmlnsOvf       string          `xml:"xmlns:ovf,attr,omitempty"`
+	XmlnsRasd      string          `xml:"xmlns:rasd,attr,omitempty"`
+	XmlnsVssd      string          `xml:"xmlns:vssd,attr,omitempty"`
+	References     []File          `xml:"References>File"`
+	DiskSection    *DiskSection    `xml:"DiskSection,omitempty"`
+	NetworkSection *NetworkSection `xml:"NetworkSection,omitempty"`
+	VirtualSystem  *VirtualSystem  `xml:"VirtualSystem,omitempty"`
+}
+
+// File is an external file referenced by the descriptor
+type File struct {
+	ID   string `xml:"ovf:id,attr"`
+	Href string `xml:"ovf:href,attr"`
+	Size int64  `xml:"ovf:size,attr,omitempty"`
+}
+
+type DiskSection struct {
+	Info  string `xml:"Info"`
+	Disks []Disk `xml:"Disk"`
+}
+
+// Disk describes a virtual disk and the file backing it
+type Disk struct {
+	DiskID                  string `xml:"ovf:diskId,attr"`
+	FileRef                 string `xml:"ovf:fileRef,attr,omitempty"`
+	Capacity                string `xml:"ovf:capacity,attr"`
+	CapacityAllocationUnits string `xml:"ovf:capacityAllocationUnits,attr,omitempty"`
+	Format                  string `xml:"ovf:format,attr,omitempty"`
+	PopulatedSize           int64  `xml:"ovf:populatedSize,attr,omitempty"`
+}
+
+type NetworkSection struct {
+	Info     string    `xml:"Info"`
+	Networks []Network `xml:"Network"`
+}
+
+type Network struct {
+	Name        string `xml:"ovf:name,attr"`
+	Description string `xml:"Description,omitempty"`
+}
+
+type VirtualSystem struct {
+	ID              string                 `xml:"ovf:id,attr"`
+	Info            string                 `xml:"Info"`
+	Name            string                 `xml:"Name,omitempty"`
+	VirtualHardware VirtualHardwareSection `xml:"VirtualHardwareSection"`
+}
+
+type VirtualHardwareSection struct {
+	Info   string                    `xml:"Info"`
+	System *VirtualSystemSettingData `xml:"System,omitempty"`
+	Items  []Item                    `xml:"Item"`
+}
+
+type VirtualSystemSettingData struct {
+	ElementName             string `xml:"vssd:ElementName"`
+	InstanceID              string `xml:"vssd:InstanceID"`
+	VirtualSystemIdentifier string `xml:"vssd:VirtualSystemIdentifier,omitempty"`
+	VirtualSystemType       string `xml:"vssd:VirtualSystemType,omitempty"`
+}
+
+// Item is a CIM resource allocation, the elements have to stay in alphabetical order
+type Item struct {
+	Address             string   `xml:"rasd:Address,omitempty"`
+	AddressOnParent     string   `xml:"rasd:AddressOnParent,omitempty"`
+	AllocationUnits     string   `xml:"rasd:AllocationUnits,omitempty"`
+	AutomaticAllocation *bool    `xml:"rasd:AutomaticAllocation,omitempty"`
+	Connection          []string `xml:"rasd:Connection,omitempty"`
+	Description         string   `xml:"rasd:Description,omitempty"`
+	ElementName         string   `xml:"rasd:ElementName"`
+	HostResource        []string `xml:"rasd:HostResource,omitempty"`
+	InstanceID          string   `xml:"rasd:InstanceID"`
+	Parent              string   `xml:"rasd:Parent,omitempty"`
+	ResourceSubType     string   `xml:"rasd:ResourceSubType,omitempty"`
+	ResourceType        int      `xml:"rasd:ResourceType"`
+	VirtualQuantity     int64    `xml:"rasd:VirtualQuantity,omitempty"`
+}
+
+// Marshal renders the envelope as an OVF descriptor
+func Marshal(envelope *Envelope) ([]byte, error) {
+	envelope.Xmlns = EnvelopeNamespace
+	envelope.XmlnsOvf = EnvelopeNamespace
+	envelope.XmlnsRasd = RASDNamespace
+	envelope.XmlnsVssd = VSSDNamespace
+
+	data, err := xml.MarshalIndent(envelope, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// Unmarshal parses an OVF descriptor. Elements and attributes are matched by
+// namespace, so documents using other prefixes than ovf, rasd and vssd are accepted too.
+func Unmarshal(data []byte) (*Envelope, error) {
+	return Decode(bytes.NewReader(data))
+}
+
+// Decode parses an OVF descriptor from a reader, see Unmarshal
+func Decode(r io.Reader) (*Envelope, error) {
+	envelope := &Envelope{}
+	decoder := xml.NewTokenDecoder(&prefixNormalizer{decoder: xml.NewDecoder(r)})
+	if err := decoder.Decode(envelope); err != nil {
+		return nil, fmt.Errorf("failed to parse OVF descriptor: %v", err)
+	}
+	return envelope, nil
+}
+
+// prefixNormalizer rewrites the resolved namespaces of the tokens to the
+// fixed prefixes used in the struct tags of the envelope types.
+type prefixNormalizer struct {
+	decoder *xml.Decoder
+}
+
+func (p *prefixNormalizer) Token() (xml.Token, error) {
+	token, err := p.decoder.Token()
+	if err != nil {
+		return token, err
+	}
+	switch t := token.(type) {
+	case xml.StartElement:
+		t.Name = normalizeElementName(t.Name)
+		attrs := make([]xml.Attr, 0, len(t.Attr))
+		for _, attr := range t.Attr {
+			// Namespace declarations were already resolved by the inner decoder
+			if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+				continue
+			}
+			attr.Name = normalizeAttrName(attr.Name)
+			attrs = append(attrs, attr)
+		}
+		t.Attr = attrs
+		return t, nil
+	case xml.EndElement:
+		t.Name = normalizeElementName(t.Name)
+		return t, nil
+	}
+	return token, nil
+}
+
+func normalizeElementName(name xml.Name) xml.Name {
+	switch name.Space {
+	case RASDNamespace:
+		return xml.Name{Local: "rasd:" + name.Local}
+	case VSSDNamespace:
+		return xml.Name{Local: "vssd:" + name.Local}
+	}
+	return xml.Name{Local: name.Local}
+}
+
+func normalizeAttrName(name xml.Name) xml.Name {
+	switch name.Space {
+	case "":
+		return name
+	case EnvelopeNamespace:
+		return xml.Name{Local: "ovf:" + name.Local}
+	}
+	return xml.Name{Local: name.Space + ":" + name.Local}
+}
+
+var allocationUnitsRegex = regexp.MustCompile(`^byte\s*(?:\*\s*(\d+)\s*\^\s*(\d+))?$`)
+
+// ParseAllocationUnits returns the number of bytes represented by the given
+// programmatic unit, e.g. "byte * 2^20". An empty unit defaults to bytes.
+func ParseAllocationUnits(units string) (int64, error) {
+	switch strings.ToLower(strings.TrimSpace(units)) {
+	case "", "byte", "bytes":
+		return 1, nil
+	case "kilobytes", "kb":
+		return 1 << 10, nil
+	case "megabytes", "mb":
+		return 1 << 20, nil
+	case "gigabytes", "gb":
+		return 1 << 30, nil
+	case "terabytes", "tb":
+		return 1 << 40, nil
+	}
+
+	matches := allocationUnitsRegex.FindStringSubmatch(strings.ToLower(strings.TrimSpace(units)))
+	if matches == nil {
+		return 0, fmt.Errorf("unsupported allocation units %q", units)
+	}
+	if matches[1] == "" {
+		return 1, nil
+	}
+	base, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	exponent, err := strconv.ParseInt(matches[2], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	result := int64(1)
+	for i := int64(0); i < exponent; i++ {
+		result *= base
+		if result <= 0 || result > 1<<50 {
+			return 0, fmt.Errorf("allocation units %q are out of range", units)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,177 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package ovf
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const vmwareDescriptor = `<?xml version="1.0" encoding="UTF-8"?>
+<Envelope vmw:buildId="build-123" xmlns="http://schemas.dmtf.org/ovf/envelope/1" xmlns:cim="http://schemas.dmtf.org/wbem/wscim/1/common" xmlns:o="http://schemas.dmtf.org/ovf/envelope/1" xmlns:r="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData" xmlns:vmw="http://www.vmware.com/schema/ovf" xmlns:vssd="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_VirtualSystemSettingData" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">
+  <References>
+    <File o:href="photon-disk1.vmdk" o:id="file1" o:size="318736384"/>
+  </References>
+  <DiskSection>
+    <Info>Virtual disk information</Info>
+    <Disk o:capacity="16" o:capacityAllocationUnits="byte * 2^30" o:diskId="vmdisk1" o:fileRef="file1" o:format="http://www.vmware.com/interfaces/specifications/vmdk.html#streamOptimized" o:populatedSize="654049280"/>
+  </DiskSection>
+  <NetworkSection>
+    <Info>The list of logical networks</Info>
+    <Network o:name="VM Network">
+      <Description>The VM Network network</Description>
+    </Network>
+  </NetworkSection>
+  <VirtualSystem o:id="Photon OS">
+    <Info>A virtual machine</Info>
+    <Name>Photon OS</Name>
+    <VirtualHardwareSection>
+      <Info>Virtual hardware requirements</Info>
+      <System>
+        <vssd:ElementName>Virtual Hardware Family</vssd:ElementName>
+        <vssd:InstanceID>0</vssd:InstanceID>
+        <vssd:VirtualSystemIdentifier>Photon OS</vssd:VirtualSystemIdentifier>
+        <vssd:VirtualSystemType>vmx-13</vssd:VirtualSystemType>
+      </System>
+      <Item>
+        <r:AllocationUnits>hertz * 10^6</r:AllocationUnits>
+        <r:ElementName>2 virtual CPU(s)</r:ElementName>
+        <r:InstanceID>1</r:InstanceID>
+        <r:ResourceType>3</r:ResourceType>
+        <r:VirtualQuantity>2</r:VirtualQuantity>
+      </Item>
+      <Item>
+        <r:AllocationUnits>byte * 2^20</r:AllocationUnits>
+        <r:ElementName>2048MB of memory</r:ElementName>
+        <r:InstanceID>2</r:InstanceID>
+        <r:ResourceType>4</r:ResourceType>
+        <r:VirtualQuantity>2048</r:VirtualQuantity>
+      </Item>
+      <Item>
+        <r:Address>0</r:Address>
+        <r:ElementName>SCSI Controller 0</r:ElementName>
+        <r:InstanceID>3</r:InstanceID>
+        <r:ResourceSubType>VirtualSCSI</r:ResourceSubType>
+        <r:ResourceType>6</r:ResourceType>
+      </Item>
+      <Item>
+        <r:Address>0</r:Address>
+        <r:ElementName>IDE Controller 0</r:ElementName>
+        <r:InstanceID>4</r:InstanceID>
+        <r:ResourceType>5</r:ResourceType>
+      </Item>
+      <Item o:required="false">
+        <r:AddressOnParent>0</r:AddressOnParent>
+        <r:AutomaticAllocation>false</r:AutomaticAllocation>
+        <r:ElementName>CD/DVD drive 1</r:ElementName>
+        <r:InstanceID>5</r:InstanceID>
+        <r:Parent>4</r:Parent>
+        <r:ResourceType>15</r:ResourceType>
+      </Item>
+      <Item>
+        <r:AddressOnParent>0</r:AddressOnParent>
+        <r:ElementName>Hard Disk 1</r:ElementName>
+        <r:HostResource>ovf:/disk/vmdisk1</r:HostResource>
+        <r:InstanceID>6</r:InstanceID>
+        <r:Parent>3</r:Parent>
+        <r:ResourceType>17</r:ResourceType>
+        <vmw:Config o:required="false" vmw:key="backing.writeThrough" vmw:value="false"/>
+      </Item>
+      <Item>
+        <r:AddressOnParent>7</r:AddressOnParent>
+        <r:AutomaticAllocation>true</r:AutomaticAllocation>
+        <r:Connection>VM Network</r:Connection>
+        <r:ElementName>Network adapter 1</r:ElementName>
+        <r:InstanceID>7</r:InstanceID>
+        <r:ResourceSubType>VmxNet3</r:ResourceSubType>
+        <r:ResourceType>10</r:ResourceType>
+      </Item>
+      <vmw:Config o:required="false" vmw:key="firmware" vmw:value="efi"/>
+    </VirtualHardwareSection>
+  </VirtualSystem>
+</Envelope>`
+
+var _ = Describe("OVF descriptor", func() {
+
+	It("should parse a descriptor using other namespace prefixes", func() {
+		envelope, err := Unmarshal([]byte(vmwareDescriptor))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(envelope.References).To(ConsistOf(File{ID: "file1", Href: "photon-disk1.vmdk", Size: 318736384}))
+		Expect(envelope.DiskSection.Disks).To(HaveLen(1))
+		Expect(envelope.DiskSection.Disks[0].DiskID).To(Equal("vmdisk1"))
+		Expect(envelope.DiskSection.Disks[0].Capacity).To(Equal("16"))
+		Expect(envelope.DiskSection.Disks[0].CapacityAllocationUnits).To(Equal("byte * 2^30"))
+		Expect(envelope.NetworkSection.Networks).To(ConsistOf(Network{Name: "VM Network", Description: "The VM Network network"}))
+		Expect(envelope.VirtualSystem.ID).To(Equal("Photon OS"))
+		Expect(envelope.VirtualSystem.VirtualHardware.System.VirtualSystemType).To(Equal("vmx-13"))
+
+		items := envelope.VirtualSystem.VirtualHardware.Items
+		Expect(items).To(HaveLen(7))
+		Expect(items[0].ResourceType).To(Equal(ResourceTypeProcessor))
+		Expect(items[0].VirtualQuantity).To(BeEquivalentTo(2))
+		Expect(items[5].HostResource).To(ConsistOf("ovf:/disk/vmdisk1"))
+		Expect(items[5].Parent).To(Equal("3"))
+		Expect(items[6].Connection).To(ConsistOf("VM Network"))
+	})
+
+	It("should preserve the descriptor through marshal and unmarshal", func() {
+		envelope, err := Unmarshal([]byte(vmwareDescriptor))
+		Expect(err).ToNot(HaveOccurred())
+
+		data, err := Marshal(envelope)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(`xmlns:rasd="` + RASDNamespace + `"`))
+		Expect(string(data)).To(ContainSubstring("<rasd:ResourceType>17</rasd:ResourceType>"))
+
+		result, err := Unmarshal(data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.References).To(Equal(envelope.References))
+		Expect(result.DiskSection).To(Equal(envelope.DiskSection))
+		Expect(result.NetworkSection).To(Equal(envelope.NetworkSection))
+		Expect(result.VirtualSystem).To(Equal(envelope.VirtualSystem))
+	})
+
+	It("should fail to parse invalid XML", func() {
+		_, err := Unmarshal([]byte("<Envelope><References>"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("should parse allocation units", func(units string, expected int64) {
+		multiplier, err := ParseAllocationUnits(units)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(multiplier).To(Equal(expected))
+	},
+		Entry("empty", "", int64(1)),
+		Entry("byte", "byte", int64(1)),
+		Entry("byte * 2^20", "byte * 2^20", int64(1<<20)),
+		Entry("byte*2^30", "byte*2^30", int64(1<<30)),
+		Entry("byte * 10^3", "byte * 10^3", int64(1000)),
+		Entry("MegaBytes", "MegaBytes", int64(1<<20)),
+	)
+
+	DescribeTable("should reject invalid allocation units", func(units string) {
+		_, err := ParseAllocationUnits(units)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("unknown unit", "hertz * 10^6"),
+		Entry("out of range", "byte * 2^64"),
+	)
+})
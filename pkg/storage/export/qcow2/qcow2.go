@@ -0,0 +1,468 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+// Package qcow2 generates qcow2 images from raw disks as a stream. The raw disk
+// is scanned once to find the allocated clusters, which allows writing all the
+// metadata in front of the data without seeking in the output. The scan reads at
+// most scanLimit bytes, the rest of the allocated data is stored as is.
+package qcow2
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+)
+
+const (
+	magic   = 0x514649fb
+	version = 2
+
+	clusterBits          = 16
+	clusterSize          = 1 << clusterBits
+	l2Entries            = clusterSize / 8
+	refcountBlockEntries = clusterSize / 2
+
+	oflagCopied     = uint64(1) << 63
+	oflagCompressed = uint64(1) << 62
+	csizeShift      = 62 - (clusterBits - 8)
+	sectorBits      = 9
+
+	// seekData and seekHole are the lseek whences to find the next data region and hole of a sparse file
+	seekData = 3
+	seekHole = 4
+)
+
+// scanLimit bounds the data read before the first byte of the image is written. Allocated clusters
+// beyond the limit are neither checked for zeroes nor compressed, so that large disks without holes,
+// like block devices, are not read twice before the image is sent.
+var scanLimit int64 = 2 << 30
+
+// Image is a qcow2 image of a raw disk, generated while it is written
+type Image struct {
+	disk        io.ReaderAt
+	virtualSize int64
+	compressed  bool
+
+	// clusters holds the stored size of each guest cluster, zero marks an unallocated cluster
+	clusters []uint32
+
+	l1Size                int64
+	l1Clusters            int64
+	refcountTableClusters int64
+	refcountBlocks        int64
+	l2Tables              int64
+	dataClusters          int64
+}
+
+// NewImage scans the raw disk for allocated clusters. Clusters containing only zeroes are
+// left unallocated, holes of sparse files are skipped without reading them. With compressed
+// set, clusters are stored deflate compressed unless that does not save space.
+// Once scanLimit bytes were read, the remaining data regions are stored without reading them.
+func NewImage(disk io.ReaderAt, virtualSize int64, compressed bool) (*Image, error) {
+	img := &Image{
+		disk:        disk,
+		virtualSize: virtualSize,
+		compressed:  compressed,
+		clusters:    make([]uint32, divRoundUp(virtualSize, clusterSize)),
+	}
+
+	buf := make([]byte, clusterSize)
+	compressor := newClusterCompressor()
+	holes := newHoleFinder(disk, virtualSize)
+	scanned := int64(0)
+	for i := int64(0); i < int64(len(img.clusters)); i++ {
+		next := holes.nextData(i * clusterSize)
+		if next >= virtualSize {
+			break
+		}
+		if next/clusterSize > i {
+			i = next/clusterSize - 1
+			continue
+		}
+		if scanned >= scanLimit {
+			// The cluster holding next is allocated even if the hole finder reports a hole before next
+			end := max(divRoundUp(holes.nextHole(next), clusterSize), i+1)
+			for ; i < min(end, int64(len(img.clusters))); i++ {
+				img.clusters[i] = clusterSize
+			}
+			i--
+			continue
+		}
+		scanned += clusterSize
+		if err := img.readCluster(i, buf); err != nil {
+			return nil, err
+		}
+		if isZero(buf) {
+			continue
+		}
+		img.clusters[i] = clusterSize
+		if compressed {
+			data, err := compressor.compress(buf)
+			if err != nil {
+				return nil, err
+			}
+			if len(data) < clusterSize {
+				img.clusters[i] = uint32(len(data))
+			}
+		}
+	}
+
+	img.layout()
+	return img, nil
+}
+
+// Size returns the size of the generated image in bytes
+func (img *Image) Size() int64 {
+	return img.dataOffset() + img.dataClusters*clusterSize
+}
+
+// layout calculates the number of clusters of each metadata structure. The image
+// is laid out as header, L1 table, refcount table, refcount blocks, L2 tables and data.
+func (img *Image) layout() {
+	img.l1Size = divRoundUp(int64(len(img.clusters)), l2Entries)
+	img.l1Clusters = max(divRoundUp(img.l1Size*8, clusterSize), 1)
+
+	for table := int64(0); table < img.l1Size; table++ {
+		if img.isTableAllocated(table) {
+			img.l2Tables++
+		}
+	}
+
+	dataSize := int64(0)
+	img.forEachCluster(func(_, offset int64, size uint32) error {
+		dataSize = offset + int64(size)
+		return nil
+	})
+	img.dataClusters = divRoundUp(dataSize, clusterSize)
+
+	// The refcount blocks have to cover themselves
+	fixedClusters := 1 + img.l1Clusters + img.l2Tables + img.dataClusters
+	for {
+		total := fixedClusters + img.refcountTableClusters + img.refcountBlocks
+		blocks := divRoundUp(total, refcountBlockEntries)
+		tableClusters := divRoundUp(blocks*8, clusterSize)
+		if blocks == img.refcountBlocks && tableClusters == img.refcountTableClusters {
+			break
+		}
+		img.refcountBlocks = blocks
+		img.refcountTableClusters = tableClusters
+	}
+}
+
+func (img *Image) l1Offset() int64 {
+	return clusterSize
+}
+
+func (img *Image) refcountTableOffset() int64 {
+	return img.l1Offset() + img.l1Clusters*clusterSize
+}
+
+func (img *Image) refcountBlocksOffset() int64 {
+	return img.refcountTableOffset() + img.refcountTableClusters*clusterSize
+}
+
+func (img *Image) l2TablesOffset() int64 {
+	return img.refcountBlocksOffset() + img.refcountBlocks*clusterSize
+}
+
+func (img *Image) dataOffset() int64 {
+	return img.l2TablesOffset() + img.l2Tables*clusterSize
+}
+
+func (img *Image) isTableAllocated(table int64) bool {
+	end := min((table+1)*l2Entries, int64(len(img.clusters)))
+	for i := table * l2Entries; i < end; i++ {
+		if img.clusters[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// forEachCluster walks the allocated clusters in guest order along with their offset in the
+// data area. Compressed clusters are packed, uncompressed clusters are aligned to host clusters.
+func (img *Image) forEachCluster(fn func(index, offset int64, size uint32) error) error {
+	offset := int64(0)
+	for i, size := range img.clusters {
+		if size == 0 {
+			continue
+		}
+		if size == clusterSize {
+			offset = divRoundUp(offset, clusterSize) * clusterSize
+		}
+		if err := fn(int64(i), offset, size); err != nil {
+			return err
+		}
+		offset += int64(size)
+	}
+	return nil
+}
+
+// WriteTo writes the image, the disk is read a second time to write the data clusters
+func (img *Image) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	for _, write := range []func(io.Writer) error{
+		img.writeHeader,
+		img.writeL1Table,
+		img.writeRefcountTable,
+		img.writeRefcountBlocks,
+		img.writeL2Tables,
+		img.writeData,
+	} {
+		if err := write(cw); err != nil {
+			return cw.n, err
+		}
+	}
+	return cw.n, nil
+}
+
+func (img *Image) writeHeader(w io.Writer) error {
+	header := make([]byte, clusterSize)
+	binary.BigEndian.PutUint32(header[0:], magic)
+	binary.BigEndian.PutUint32(header[4:], version)
+	binary.BigEndian.PutUint32(header[20:], clusterBits)
+	binary.BigEndian.PutUint64(header[24:], uint64(img.virtualSize))
+	binary.BigEndian.PutUint32(header[36:], uint32(img.l1Size))
+	binary.BigEndian.PutUint64(header[40:], uint64(img.l1Offset()))
+	binary.BigEndian.PutUint64(header[48:], uint64(img.refcountTableOffset()))
+	binary.BigEndian.PutUint32(header[56:], uint32(img.refcountTableClusters))
+	_, err := w.Write(header)
+	return err
+}
+
+func (img *Image) writeL1Table(w io.Writer) error {
+	table := make([]byte, img.l1Clusters*clusterSize)
+	l2Offset := img.l2TablesOffset()
+	for i := int64(0); i < img.l1Size; i++ {
+		if img.isTableAllocated(i) {
+			binary.BigEndian.PutUint64(table[i*8:], uint64(l2Offset)|oflagCopied)
+			l2Offset += clusterSize
+		}
+	}
+	_, err := w.Write(table)
+	return err
+}
+
+func (img *Image) writeRefcountTable(w io.Writer) error {
+	table := make([]byte, img.refcountTableClusters*clusterSize)
+	for i := int64(0); i < img.refcountBlocks; i++ {
+		binary.BigEndian.PutUint64(table[i*8:], uint64(img.refcountBlocksOffset()+i*clusterSize))
+	}
+	_, err := w.Write(table)
+	return err
+}
+
+func (img *Image) writeRefcountBlocks(w io.Writer) error {
+	refcounts := make([]uint16, img.refcountBlocks*refcountBlockEntries)
+	metadataClusters := img.dataOffset() / clusterSize
+	for i := int64(0); i < metadataClusters; i++ {
+		refcounts[i] = 1
+	}
+	// Every compressed cluster adds a reference to all host clusters it touches
+	img.forEachCluster(func(_, offset int64, size uint32) error {
+		first := (img.dataOffset() + offset) / clusterSize
+		last := (img.dataOffset() + offset + int64(size) - 1) / clusterSize
+		for i := first; i <= last; i++ {
+			refcounts[i]++
+		}
+		return nil
+	})
+	return binary.Write(w, binary.BigEndian, refcounts)
+}
+
+func (img *Image) writeL2Tables(w io.Writer) error {
+	table := make([]byte, clusterSize)
+	current := int64(-1)
+	flush := func() error {
+		if current < 0 {
+			return nil
+		}
+		_, err := w.Write(table)
+		clear(table)
+		return err
+	}
+	err := img.forEachCluster(func(index, offset int64, size uint32) error {
+		if index/l2Entries != current {
+			if err := flush(); err != nil {
+				return err
+			}
+			current = index / l2Entries
+		}
+		hostOffset := uint64(img.dataOffset() + offset)
+		entry := hostOffset | oflagCopied
+		if size < clusterSize {
+			sectors := ((hostOffset + uint64(size) - 1) >> sectorBits) - (hostOffset >> sectorBits)
+			entry = hostOffset | oflagCompressed | sectors<<csizeShift
+		}
+		binary.BigEndian.PutUint64(table[(index%l2Entries)*8:], entry)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
+}
+
+func (img *Image) writeData(w io.Writer) error {
+	buf := make([]byte, clusterSize)
+	compressor := newClusterCompressor()
+	written := int64(0)
+	err := img.forEachCluster(func(index, offset int64, size uint32) error {
+		if err := writeZeroes(w, offset-written); err != nil {
+			return err
+		}
+		if err := img.readCluster(index, buf); err != nil {
+			return err
+		}
+		data := buf
+		if size < clusterSize {
+			var err error
+			if data, err = compressor.compress(buf); err != nil {
+				return err
+			}
+			if len(data) != int(size) {
+				return fmt.Errorf("cluster %d of the disk changed while generating the image", index)
+			}
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		written = offset + int64(size)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return writeZeroes(w, img.dataClusters*clusterSize-written)
+}
+
+func (img *Image) readCluster(index int64, buf []byte) error {
+	offset := index * clusterSize
+	length := min(int64(clusterSize), img.virtualSize-offset)
+	n, err := img.disk.ReadAt(buf[:length], offset)
+	if err != nil && !(errors.Is(err, io.EOF) && int64(n) == length) {
+		return err
+	}
+	clear(buf[length:])
+	return nil
+}
+
+// clusterCompressor uses Huffman-only deflate, qemu inflates compressed clusters with a 4KiB
+// window and the default compression levels reference data up to 32KiB back.
+type clusterCompressor struct {
+	buf    bytes.Buffer
+	writer *flate.Writer
+}
+
+func newClusterCompressor() *clusterCompressor {
+	c := &clusterCompressor{}
+	c.writer, _ = flate.NewWriter(&c.buf, flate.HuffmanOnly)
+	return c
+}
+
+func (c *clusterCompressor) compress(data []byte) ([]byte, error) {
+	c.buf.Reset()
+	c.writer.Reset(&c.buf)
+	if _, err := c.writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := c.writer.Close(); err != nil {
+		return nil, err
+	}
+	return c.buf.Bytes(), nil
+}
+
+// holeFinder uses SEEK_DATA and SEEK_HOLE to skip the holes of sparse files,
+// block devices report all of their content as data
+type holeFinder struct {
+	file *os.File
+	size int64
+}
+
+func newHoleFinder(disk io.ReaderAt, size int64) *holeFinder {
+	file, _ := disk.(*os.File)
+	return &holeFinder{file: file, size: size}
+}
+
+// nextData returns the offset of the next data region at or after offset
+func (h *holeFinder) nextData(offset int64) int64 {
+	if h.file == nil {
+		return offset
+	}
+	next, err := h.file.Seek(offset, seekData)
+	if err != nil {
+		if errors.Is(err, syscall.ENXIO) {
+			// No data beyond offset
+			return 1<<63 - 1
+		}
+		// Not supported by the file system, read everything
+		h.file = nil
+		return offset
+	}
+	return next
+}
+
+// nextHole returns the offset of the next hole at or after offset, the end of the disk counts as hole
+func (h *holeFinder) nextHole(offset int64) int64 {
+	if h.file == nil {
+		return h.size
+	}
+	next, err := h.file.Seek(offset, seekHole)
+	if err != nil {
+		return h.size
+	}
+	return min(next, h.size)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+var zeroes = make([]byte, clusterSize)
+
+func writeZeroes(w io.Writer, length int64) error {
+	for length > 0 {
+		n := min(length, clusterSize)
+		if _, err := w.Write(zeroes[:n]); err != nil {
+			return err
+		}
+		length -= n
+	}
+	return nil
+}
+
+func isZero(buf []byte) bool {
+	return bytes.Equal(buf, zeroes[:len(buf)])
+}
+
+func divRoundUp(a, b int64) int64 {
+	return (a + b - 1) / b
+}
@@ -0,0 +1,297 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package qcow2
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// readImage decodes a qcow2 image into the raw guest data and verifies
+// the refcounts the same way qemu-img check does
+func readImage(image []byte) []byte {
+	Expect(binary.BigEndian.Uint32(image[0:])).To(BeEquivalentTo(magic))
+	Expect(binary.BigEndian.Uint32(image[4:])).To(BeEquivalentTo(version))
+	Expect(binary.BigEndian.Uint32(image[20:])).To(BeEquivalentTo(clusterBits))
+	Expect(len(image) % clusterSize).To(BeZero())
+	virtualSize := int64(binary.BigEndian.Uint64(image[24:]))
+	l1Size := int64(binary.BigEndian.Uint32(image[36:]))
+	l1Offset := int64(binary.BigEndian.Uint64(image[40:]))
+	refcountTableOffset := int64(binary.BigEndian.Uint64(image[48:]))
+	refcountTableClusters := int64(binary.BigEndian.Uint32(image[56:]))
+
+	expectedRefcounts := make([]uint16, len(image)/clusterSize)
+	reference := func(offset, length int64) {
+		for i := offset / clusterSize; i <= (offset+length-1)/clusterSize; i++ {
+			expectedRefcounts[i]++
+		}
+	}
+	reference(0, clusterSize)
+	reference(l1Offset, l1Size*8)
+	reference(refcountTableOffset, refcountTableClusters*clusterSize)
+
+	const offsetMask = 0x00fffffffffffe00
+	raw := make([]byte, divRoundUp(virtualSize, clusterSize)*clusterSize)
+	for i := int64(0); i < l1Size; i++ {
+		l1Entry := binary.BigEndian.Uint64(image[l1Offset+i*8:])
+		if l1Entry == 0 {
+			continue
+		}
+		l2Offset := int64(l1Entry & offsetMask)
+		reference(l2Offset, clusterSize)
+		for j := int64(0); j < l2Entries; j++ {
+			entry := binary.BigEndian.Uint64(image[l2Offset+j*8:])
+			if entry == 0 {
+				continue
+			}
+			guestOffset := (i*l2Entries + j) * clusterSize
+			cluster := raw[guestOffset : guestOffset+clusterSize]
+			if entry&oflagCompressed != 0 {
+				Expect(entry & oflagCopied).To(BeZero())
+				offset := int64(entry & (1<<csizeShift - 1))
+				sectors := int64((entry>>csizeShift)&0xff) + 1
+				reference(offset&^511, sectors*512)
+				length := sectors*512 - offset&511
+				_, err := io.ReadFull(flate.NewReader(bytes.NewReader(image[offset:offset+length])), cluster)
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(entry & oflagCopied).ToNot(BeZero())
+				offset := int64(entry & offsetMask)
+				reference(offset, clusterSize)
+				copy(cluster, image[offset:offset+clusterSize])
+			}
+		}
+	}
+
+	refcounts := make([]uint16, 0, len(expectedRefcounts))
+	for i := int64(0); i < refcountTableClusters*clusterSize/8; i++ {
+		blockOffset := int64(binary.BigEndian.Uint64(image[refcountTableOffset+i*8:]))
+		if blockOffset == 0 {
+			continue
+		}
+		reference(blockOffset, clusterSize)
+		for j := int64(0); j < refcountBlockEntries; j++ {
+			refcounts = append(refcounts, binary.BigEndian.Uint16(image[blockOffset+j*2:]))
+		}
+	}
+	Expect(len(refcounts)).To(BeNumerically(">=", len(expectedRefcounts)))
+	Expect(refcounts[:len(expectedRefcounts)]).To(Equal(expectedRefcounts))
+	for _, refcount := range refcounts[len(expectedRefcounts):] {
+		Expect(refcount).To(BeZero())
+	}
+
+	return raw[:virtualSize]
+}
+
+func generate(disk io.ReaderAt, size int64, compressed bool) []byte {
+	img, err := NewImage(disk, size, compressed)
+	Expect(err).ToNot(HaveOccurred())
+	buf := &bytes.Buffer{}
+	n, err := img.WriteTo(buf)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(n).To(Equal(img.Size()))
+	Expect(buf.Len()).To(BeEquivalentTo(img.Size()))
+	return buf.Bytes()
+}
+
+var _ = Describe("qcow2 image", func() {
+	var disk []byte
+
+	BeforeEach(func() {
+		random := rand.New(rand.NewSource(1))
+		disk = make([]byte, 20*clusterSize+1000)
+		// Incompressible cluster
+		random.Read(disk[2*clusterSize : 3*clusterSize])
+		// Compressible clusters
+		for i := 5 * clusterSize; i < 9*clusterSize; i += 7 {
+			disk[i] = byte(i % 3)
+		}
+		// A single byte in an otherwise empty cluster
+		disk[12*clusterSize+100] = 0xff
+		// The partial last cluster
+		copy(disk[20*clusterSize:], "last cluster")
+	})
+
+	DescribeTable("should contain the disk data", func(compressed bool) {
+		image := generate(bytes.NewReader(disk), int64(len(disk)), compressed)
+		Expect(readImage(image)).To(Equal(disk))
+	},
+		Entry("uncompressed", false),
+		Entry("compressed", true),
+	)
+
+	It("should not allocate zero clusters", func() {
+		image := generate(bytes.NewReader(disk), int64(len(disk)), false)
+		img, err := NewImage(bytes.NewReader(disk), int64(len(disk)), false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(img.dataClusters).To(BeEquivalentTo(7))
+		Expect(len(image)).To(BeNumerically("<", len(disk)))
+	})
+
+	It("should be smaller when compressed", func() {
+		uncompressed := generate(bytes.NewReader(disk), int64(len(disk)), false)
+		compressed := generate(bytes.NewReader(disk), int64(len(disk)), true)
+		Expect(len(compressed)).To(BeNumerically("<", len(uncompressed)))
+	})
+
+	It("should handle an empty disk", func() {
+		empty := make([]byte, 3*clusterSize)
+		image := generate(bytes.NewReader(empty), int64(len(empty)), true)
+		Expect(image).To(HaveLen(4 * clusterSize))
+		Expect(readImage(image)).To(Equal(empty))
+	})
+
+	It("should fail when the disk changes while writing", func() {
+		img, err := NewImage(bytes.NewReader(disk), int64(len(disk)), true)
+		Expect(err).ToNot(HaveOccurred())
+		disk[6*clusterSize+1] = 0x42
+		img.disk = bytes.NewReader(disk)
+		_, err = img.WriteTo(io.Discard)
+		Expect(err).To(MatchError(ContainSubstring("changed while generating the image")))
+	})
+
+	Context("with a scan limit", func() {
+		var oldScanLimit int64
+
+		BeforeEach(func() {
+			oldScanLimit = scanLimit
+			scanLimit = 4 * clusterSize
+		})
+
+		AfterEach(func() {
+			scanLimit = oldScanLimit
+		})
+
+		DescribeTable("should only scan the beginning of a disk without holes, like a block device", func(compressed bool) {
+			blockDevice := &countingReaderAt{r: bytes.NewReader(disk)}
+			img, err := NewImage(blockDevice, int64(len(disk)), compressed)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(blockDevice.n).To(BeEquivalentTo(scanLimit))
+			// Only the incompressible cluster is allocated within the limit, all clusters after it are stored
+			Expect(img.dataClusters).To(BeEquivalentTo(1 + 17))
+
+			buf := &bytes.Buffer{}
+			n, err := img.WriteTo(buf)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(img.Size()))
+			Expect(readImage(buf.Bytes())).To(Equal(disk))
+		},
+			Entry("uncompressed", false),
+			Entry("compressed", true),
+		)
+
+		It("should write the first bytes before the whole disk is read", func() {
+			blockDevice := &countingReaderAt{r: bytes.NewReader(disk)}
+			readBeforeFirstWrite := int64(-1)
+			w := writerFunc(func(p []byte) (int, error) {
+				if readBeforeFirstWrite < 0 {
+					readBeforeFirstWrite = blockDevice.n
+				}
+				return len(p), nil
+			})
+
+			img, err := NewImage(blockDevice, int64(len(disk)), true)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = img.WriteTo(w)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readBeforeFirstWrite).To(Equal(scanLimit))
+			Expect(blockDevice.n).To(BeNumerically(">", len(disk)))
+		})
+
+		It("should only store the data regions of a sparse file beyond the limit", func() {
+			const size = 1536 << 20
+			file, err := os.Create(filepath.Join(GinkgoT().TempDir(), "disk.img"))
+			Expect(err).ToNot(HaveOccurred())
+			defer file.Close()
+			Expect(file.Truncate(size)).To(Succeed())
+			_, err = file.WriteAt(disk, 0)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = file.WriteAt([]byte("third table"), 1<<30+12345)
+			Expect(err).ToNot(HaveOccurred())
+
+			img, err := NewImage(file, size, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(img.l2Tables).To(BeEquivalentTo(2))
+			// The data clusters beyond the limit depend on the block size of the file system
+			Expect(img.dataClusters).To(BeNumerically("<", 64))
+
+			buf := &bytes.Buffer{}
+			_, err = img.WriteTo(buf)
+			Expect(err).ToNot(HaveOccurred())
+			raw := readImage(buf.Bytes())
+			Expect(raw[:len(disk)]).To(Equal(disk))
+			Expect(string(raw[1<<30+12345 : 1<<30+12345+11])).To(Equal("third table"))
+		})
+	})
+
+	DescribeTable("should skip the holes of a large sparse file", func(compressed bool) {
+		const size = 1536 << 20
+		file, err := os.Create(filepath.Join(GinkgoT().TempDir(), "disk.img"))
+		Expect(err).ToNot(HaveOccurred())
+		defer file.Close()
+		Expect(file.Truncate(size)).To(Succeed())
+		_, err = file.WriteAt([]byte("first table"), 4096)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = file.WriteAt([]byte("third table"), 1<<30+12345)
+		Expect(err).ToNot(HaveOccurred())
+
+		img, err := NewImage(file, size, compressed)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(img.l1Size).To(BeEquivalentTo(3))
+		Expect(img.l2Tables).To(BeEquivalentTo(2))
+
+		buf := &bytes.Buffer{}
+		_, err = img.WriteTo(buf)
+		Expect(err).ToNot(HaveOccurred())
+		raw := readImage(buf.Bytes())
+		Expect(raw).To(HaveLen(size))
+		Expect(string(raw[4096 : 4096+11])).To(Equal("first table"))
+		Expect(string(raw[1<<30+12345 : 1<<30+12345+11])).To(Equal("third table"))
+	},
+		Entry("uncompressed", false),
+		Entry("compressed", true),
+	)
+})
+
+// countingReaderAt counts the bytes read from a disk which does not report its holes
+type countingReaderAt struct {
+	r io.ReaderAt
+	n int64
+}
+
+func (c *countingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	n, err := c.r.ReadAt(p, off)
+	c.n += int64(n)
+	return n, err
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
@@ -20,9 +20,11 @@
 package virtexportserver
 
 import (
+	"archive/tar"
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"encoding/json"
 	"errors"
@@ -34,7 +36,9 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	flag "github.com/spf13/pflag"
@@ -50,10 +54,13 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/service"
+	"kubevirt.io/kubevirt/pkg/storage/export/ovf"
+	"kubevirt.io/kubevirt/pkg/storage/export/qcow2"
 )
 
 const (
 	authHeader              = "x-kubevirt-export-token"
+	compressedQueryParam    = "compressed"
 	manifestCmBasePath      = "/manifest_data/"
 	vmManifestPath          = manifestCmBasePath + "virtualmachine-manifest"
 	internalLinkPath        = manifestCmBasePath + "internal_host"
@@ -74,8 +81,15 @@ type VolumeInfo struct {
 	DirURI     string
 	RawURI     string
 	RawGzURI   string
+	Qcow2URI   string
 	VMURI      string
 	SecretURI  string
+	OVFURI     string
+	OVAURI     string
+	// ChecksumsURI serves the SHA-256 checksums of all raw volumes
+	ChecksumsURI string
+	// BackupQcow2URI serves the qcow2 image of a VirtualMachineBackup found at Path as is
+	BackupQcow2URI string
 }
 type ExportServerConfig struct {
 	Deadline time.Time
@@ -93,8 +107,12 @@ type ExportServerConfig struct {
 	DirHandler         func(string, string) http.Handler
 	FileHandler        func(string) http.Handler
 	GzipHandler        func(string) http.Handler
+	Qcow2Handler       func(string) http.Handler
 	VmHandler          func(string, []VolumeInfo, func() (string, error), func() (*corev1.ConfigMap, error)) http.Handler
 	TokenSecretHandler func(TokenGetterFunc) http.Handler
+	OvfHandler         func([]VolumeInfo) http.Handler
+	OvaHandler         func([]VolumeInfo) http.Handler
+	ChecksumsHandler   func([]VolumeInfo) http.Handler
 
 	TokenGetter TokenGetterFunc
 }
@@ -144,6 +162,20 @@ func (s *exportServer) initHandler() {
 				mux.Handle(filepath.Join(internal, vi.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 				mux.Handle(filepath.Join(external, vi.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 			}
+			if vi.OVFURI != "" {
+				mux.Handle(filepath.Join(internal, vi.OVFURI), tokenChecker(s.TokenGetter, s.OvfHandler(s.Volumes)))
+				mux.Handle(filepath.Join(external, vi.OVFURI), tokenChecker(s.TokenGetter, s.OvfHandler(s.Volumes)))
+			}
+			if vi.OVAURI != "" {
+				mux.Handle(filepath.Join(internal, vi.OVAURI), tokenChecker(s.TokenGetter, s.OvaHandler(s.Volumes)))
+				mux.Handle(filepath.Join(external, vi.OVAURI), tokenChecker(s.TokenGetter, s.OvaHandler(s.Volumes)))
+			}
+			if vi.ChecksumsURI != "" {
+				// Both paths share the handler, so the checksums are only computed once
+				checksumsHandler := tokenChecker(s.TokenGetter, s.ChecksumsHandler(s.Volumes))
+				mux.Handle(filepath.Join(internal, vi.ChecksumsURI), checksumsHandler)
+				mux.Handle(filepath.Join(external, vi.ChecksumsURI), checksumsHandler)
+			}
 		}
 	}
 
@@ -188,6 +220,14 @@ func (s *exportServer) getHandlerMap(vi VolumeInfo) map[string]http.Handler {
 		result[vi.RawGzURI] = s.GzipHandler(p)
 	}
 
+	if vi.Qcow2URI != "" {
+		result[vi.Qcow2URI] = s.Qcow2Handler(p)
+	}
+
+	if vi.BackupQcow2URI != "" {
+		result[vi.BackupQcow2URI] = s.FileHandler(p)
+	}
+
 	return result
 }
 
@@ -247,6 +287,10 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.GzipHandler = gzipHandler
 	}
 
+	if es.Qcow2Handler == nil {
+		es.Qcow2Handler = qcow2Handler
+	}
+
 	if es.VmHandler == nil {
 		es.VmHandler = vmHandler
 	}
@@ -255,6 +299,18 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.TokenSecretHandler = secretHandler
 	}
 
+	if es.OvfHandler == nil {
+		es.OvfHandler = ovfHandler
+	}
+
+	if es.OvaHandler == nil {
+		es.OvaHandler = ovaHandler
+	}
+
+	if es.ChecksumsHandler == nil {
+		es.ChecksumsHandler = checksumsHandler
+	}
+
 	if es.TokenGetter == nil {
 		es.TokenGetter = func() (string, error) {
 			return getToken(es.TokenFile)
@@ -515,6 +571,53 @@ func gzipHandler(filePath string) http.Handler {
 	})
 }
 
+// qcow2Handler scans the disk for allocated clusters before the first byte is sent. The scan reads at most
+// the first 2GiB of data, the data regions after that are sent as is, so that block devices and large
+// disks start streaming quickly
+func qcow2Handler(filePath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		compressed := false
+		if value := req.URL.Query().Get(compressedQueryParam); value != "" {
+			var err error
+			if compressed, err = strconv.ParseBool(value); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		f, err := os.Open(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		// Seeking works for block devices as well, where the file size is zero
+		size, err := f.Seek(0, io.SeekEnd)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error getting size of %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		image, err := qcow2.NewImage(f, size, compressed)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error scanning %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.FormatInt(image.Size(), 10))
+		n, err := image.WriteTo(w)
+		if err != nil {
+			log.Log.Reason(err).Error("error writing qcow2 image")
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
 func vmHandler(filePath string, vi []VolumeInfo, getBasePath func() (string, error), getCmFunc func() (*corev1.ConfigMap, error)) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		if req.Method != http.MethodGet {
@@ -653,7 +756,21 @@ func fileHandler(file string) http.Handler {
 			return
 		}
 		defer f.Close()
-		http.ServeContent(w, r, "disk.img", time.Time{}, f)
+		fi, err := f.Stat()
+		if err != nil {
+			log.Log.Reason(err).Errorf("error statting %s", file)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if !fi.Mode().IsRegular() {
+			// The modification time of a block device node doesn't change with its content, so
+			// there is no validator, and clients can't resume interrupted downloads with If-Range
+			http.ServeContent(w, r, "disk.img", time.Time{}, f)
+			return
+		}
+		// A strong validator allows clients to resume interrupted downloads with If-Range
+		w.Header().Set("ETag", fmt.Sprintf("\"%x-%x\"", fi.ModTime().UnixNano(), fi.Size()))
+		http.ServeContent(w, r, "disk.img", fi.ModTime(), f)
 	})
 }
 
@@ -716,3 +833,280 @@ func secretHandler(tokenGetter TokenGetterFunc) http.Handler {
 		log.Log.Infof("Wrote %d bytes\n", n)
 	})
 }
+
+type ovfDisk struct {
+	image ovf.DiskImage
+	path  string
+}
+
+// getOvfDisks returns the raw disk images of the exported volumes backing the VM volumes
+func getOvfDisks(vm *virtv1.VirtualMachine, vi []VolumeInfo) ([]ovfDisk, error) {
+	var disks []ovfDisk
+	if vm.Spec.Template == nil {
+		return disks, nil
+	}
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		claimName := ""
+		if volume.DataVolume != nil {
+			claimName = volume.DataVolume.Name
+		} else if volume.PersistentVolumeClaim != nil {
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		}
+		if claimName == "" {
+			continue
+		}
+		for _, info := range vi {
+			if info.RawURI == "" || path.Base(path.Dir(info.RawURI)) != claimName {
+				continue
+			}
+			diskPath, size, err := getRawDiskPathAndSize(info.Path)
+			if err != nil {
+				return nil, err
+			}
+			disks = append(disks, ovfDisk{
+				image: ovf.DiskImage{
+					VolumeName: volume.Name,
+					FileName:   claimName + ".img",
+					Size:       size,
+				},
+				path: diskPath,
+			})
+		}
+	}
+	return disks, nil
+}
+
+func getRawDiskPathAndSize(volumePath string) (string, int64, error) {
+	fi, err := os.Stat(volumePath)
+	if err != nil {
+		return "", 0, err
+	}
+	if fi.IsDir() {
+		volumePath = path.Join(volumePath, "disk.img")
+	}
+	f, err := os.Open(volumePath)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	// Seeking works for block devices as well, where the file size is zero
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return "", 0, err
+	}
+	return volumePath, size, nil
+}
+
+// errNoVMDefinition is returned when the export has no VM definition to describe, like for a PVC source
+var errNoVMDefinition = errors.New("export has no VM definition")
+
+func getOvfDescriptor(vi []VolumeInfo) (*virtv1.VirtualMachine, []ovfDisk, []byte, error) {
+	expandedVm := getExpandedVM()
+	if expandedVm == nil {
+		return nil, nil, nil, errNoVMDefinition
+	}
+	disks, err := getOvfDisks(expandedVm, vi)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	images := make([]ovf.DiskImage, 0, len(disks))
+	for _, disk := range disks {
+		images = append(images, disk.image)
+	}
+	envelope, err := ovf.NewEnvelope(expandedVm, images)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	data, err := ovf.Marshal(envelope)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return expandedVm, disks, data, nil
+}
+
+func ovfHandler(vi []VolumeInfo) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _, data, err := getOvfDescriptor(vi)
+		if errors.Is(err, errNoVMDefinition) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Log.Reason(err).Error("error generating OVF descriptor")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		n, err := w.Write(data)
+		if err != nil {
+			log.Log.Reason(err).Error("error writing OVF descriptor")
+			return
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
+// ovaHandler streams a tar archive containing the OVF descriptor followed by the raw disk images
+func ovaHandler(vi []VolumeInfo) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		vm, disks, data, err := getOvfDescriptor(vi)
+		if errors.Is(err, errNoVMDefinition) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Log.Reason(err).Error("error generating OVF descriptor")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-tar")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", vm.Name+".ova"))
+
+		tw := tar.NewWriter(w)
+		modTime := time.Now()
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    vm.Name + ovf.Extension,
+			Mode:    0644,
+			Size:    int64(len(data)),
+			ModTime: modTime,
+		}); err != nil {
+			log.Log.Reason(err).Error("error writing OVA header")
+			return
+		}
+		if _, err := tw.Write(data); err != nil {
+			log.Log.Reason(err).Error("error writing OVF descriptor")
+			return
+		}
+		for _, disk := range disks {
+			if err := writeTarFile(tw, disk.path, disk.image.FileName, disk.image.Size, modTime); err != nil {
+				log.Log.Reason(err).Errorf("error writing %s to OVA", disk.path)
+				return
+			}
+		}
+		if err := tw.Close(); err != nil {
+			log.Log.Reason(err).Error("error closing OVA")
+		}
+	})
+}
+
+func writeTarFile(tw *tar.Writer, filePath, name string, size int64, modTime time.Time) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    size,
+		ModTime: modTime,
+	}); err != nil {
+		return err
+	}
+	n, err := io.CopyN(tw, f, size)
+	if err != nil {
+		return err
+	}
+	log.Log.Infof("Wrote %d bytes of %s\n", n, name)
+	return nil
+}
+
+// checksumsRetryAfter is the number of seconds clients are asked to wait while the checksums are computed
+const checksumsRetryAfter = "10"
+
+var computeChecksums = getChecksums
+
+// checksumsHandler serves the checksums in the format of sha256sum, with the raw URI
+// as file name. The volumes don't change while exported, so the checksums are computed
+// once in the background on the first request, and clients get a 503 until then.
+// A failed computation is reported once with a 500, the next request starts it again.
+func checksumsHandler(vi []VolumeInfo) http.Handler {
+	var (
+		mutex     sync.Mutex
+		started   bool
+		done      bool
+		checksums []byte
+		sumErr    error
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mutex.Lock()
+		if !done {
+			if !started {
+				started = true
+				go func() {
+					sums, err := computeChecksums(vi)
+					if err != nil {
+						log.Log.Reason(err).Error("error computing checksums")
+					}
+					mutex.Lock()
+					defer mutex.Unlock()
+					checksums, sumErr, done = sums, err, true
+				}()
+			}
+			mutex.Unlock()
+			w.Header().Set("Retry-After", checksumsRetryAfter)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if sumErr != nil {
+			started, done, sumErr = false, false, nil
+			mutex.Unlock()
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		mutex.Unlock()
+
+		w.Header().Set("Content-Type", "text/plain")
+		n, err := w.Write(checksums)
+		if err != nil {
+			log.Log.Reason(err).Error("error writing checksums")
+			return
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
+func getChecksums(vi []VolumeInfo) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	for _, volume := range vi {
+		if volume.RawURI == "" {
+			continue
+		}
+		diskPath, _, err := getRawDiskPathAndSize(volume.Path)
+		if err != nil {
+			return nil, err
+		}
+		sum, err := sha256File(diskPath)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(buf, "%x  %s\n", sum, volume.RawURI)
+	}
+	return buf.Bytes(), nil
+}
+
+func sha256File(filePath string) ([]byte, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
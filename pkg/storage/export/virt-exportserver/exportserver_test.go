@@ -20,12 +20,20 @@
 package virtexportserver
 
 import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -37,6 +45,8 @@ import (
 	"kubevirt.io/client-go/log"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	"sigs.k8s.io/yaml"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/ovf"
 )
 
 const (
@@ -61,12 +71,24 @@ func newTestServer(token string) *exportServer {
 		GzipHandler: func(string) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		Qcow2Handler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		VmHandler: func(string, []VolumeInfo, func() (string, error), func() (*v1.ConfigMap, error)) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
 		TokenSecretHandler: func(tgf TokenGetterFunc) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		OvfHandler: func([]VolumeInfo) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		OvaHandler: func([]VolumeInfo) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		ChecksumsHandler: func([]VolumeInfo) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		TokenGetter: func() (string, error) {
 			return token, nil
 		},
@@ -113,6 +135,14 @@ var _ = Describe("exportserver", func() {
 			VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("backup qcow2 URI",
+			VolumeInfo{Path: "/tmp", BackupQcow2URI: "/volume/v1/backup.qcow2"},
+			"/volume/v1/backup.qcow2",
+		),
 		Entry("VM definition URI",
 			VolumeInfo{Path: "/tmp", VMURI: "/manifest"},
 			"/internal/manifest",
@@ -121,6 +151,18 @@ var _ = Describe("exportserver", func() {
 			VolumeInfo{Path: "/tmp", VMURI: "/manifest/secret"},
 			"/internal/manifest/secret",
 		),
+		Entry("OVF URI",
+			VolumeInfo{Path: "/tmp", OVFURI: "/manifest/ovf"},
+			"/internal/manifest/ovf",
+		),
+		Entry("OVA URI",
+			VolumeInfo{Path: "/tmp", OVAURI: "/manifest/ova"},
+			"/internal/manifest/ova",
+		),
+		Entry("checksums URI",
+			VolumeInfo{Path: "/tmp", ChecksumsURI: "/manifest/checksums"},
+			"/internal/manifest/checksums",
+		),
 	)
 
 	DescribeTable("should handle (query param version)", func(vi VolumeInfo, uri string) {
@@ -159,6 +201,14 @@ var _ = Describe("exportserver", func() {
 			VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("backup qcow2 URI",
+			VolumeInfo{Path: "/tmp", BackupQcow2URI: "/volume/v1/backup.qcow2"},
+			"/volume/v1/backup.qcow2",
+		),
 		Entry("VM definition URI",
 			VolumeInfo{Path: "/tmp", VMURI: "/manifest"},
 			"/internal/manifest",
@@ -167,6 +217,18 @@ var _ = Describe("exportserver", func() {
 			VolumeInfo{Path: "/tmp", VMURI: "/manifest/secret"},
 			"/internal/manifest/secret",
 		),
+		Entry("OVF URI",
+			VolumeInfo{Path: "/tmp", OVFURI: "/manifest/ovf"},
+			"/internal/manifest/ovf",
+		),
+		Entry("OVA URI",
+			VolumeInfo{Path: "/tmp", OVAURI: "/manifest/ova"},
+			"/internal/manifest/ova",
+		),
+		Entry("checksums URI",
+			VolumeInfo{Path: "/tmp", ChecksumsURI: "/manifest/checksums"},
+			"/internal/manifest/checksums",
+		),
 	)
 
 	DescribeTable("should fail bad token", func(vi VolumeInfo, uri string) {
@@ -202,6 +264,14 @@ var _ = Describe("exportserver", func() {
 			VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("backup qcow2 URI",
+			VolumeInfo{Path: "/tmp", BackupQcow2URI: "/volume/v1/backup.qcow2"},
+			"/volume/v1/backup.qcow2",
+		),
 		Entry("VM definition URI",
 			VolumeInfo{Path: "/tmp", VMURI: "/manifest"},
 			"/external/manifest",
@@ -210,6 +280,18 @@ var _ = Describe("exportserver", func() {
 			VolumeInfo{Path: "/tmp", VMURI: "/manifest/secret"},
 			"/external/manifest/secret",
 		),
+		Entry("OVF URI",
+			VolumeInfo{Path: "/tmp", OVFURI: "/manifest/ovf"},
+			"/external/manifest/ovf",
+		),
+		Entry("OVA URI",
+			VolumeInfo{Path: "/tmp", OVAURI: "/manifest/ova"},
+			"/external/manifest/ova",
+		),
+		Entry("checksums URI",
+			VolumeInfo{Path: "/tmp", ChecksumsURI: "/manifest/checksums"},
+			"/external/manifest/checksums",
+		),
 	)
 
 	DescribeTable("should fail bad token (query param version)", func(vi VolumeInfo, uri string) {
@@ -244,6 +326,14 @@ var _ = Describe("exportserver", func() {
 			VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("backup qcow2 URI",
+			VolumeInfo{Path: "/tmp", BackupQcow2URI: "/volume/v1/backup.qcow2"},
+			"/volume/v1/backup.qcow2",
+		),
 		Entry("VM definition URI",
 			VolumeInfo{Path: "/tmp", VMURI: "/manifest"},
 			"/external/manifest",
@@ -252,6 +342,18 @@ var _ = Describe("exportserver", func() {
 			VolumeInfo{Path: "/tmp", VMURI: "/manifest/secret"},
 			"/internal/manifest/secret",
 		),
+		Entry("OVF URI",
+			VolumeInfo{Path: "/tmp", OVFURI: "/manifest/ovf"},
+			"/internal/manifest/ovf",
+		),
+		Entry("OVA URI",
+			VolumeInfo{Path: "/tmp", OVAURI: "/manifest/ova"},
+			"/internal/manifest/ova",
+		),
+		Entry("checksums URI",
+			VolumeInfo{Path: "/tmp", ChecksumsURI: "/manifest/checksums"},
+			"/internal/manifest/checksums",
+		),
 	)
 
 	Context("Vm handler", func() {
@@ -729,4 +831,347 @@ var _ = Describe("exportserver", func() {
 			verifySecret(string(list.Items[0].Raw))
 		})
 	})
+
+	Context("OVF handler", func() {
+		var (
+			orgGetExpandedVM = getExpandedVM
+			volumes          []VolumeInfo
+		)
+
+		const diskSize = 1024 * 1024
+
+		BeforeEach(func() {
+			tempDir := GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(tempDir, "disk.img"), bytes.Repeat([]byte{1}, diskSize), 0644)).To(Succeed())
+			volumes = []VolumeInfo{
+				{Path: tempDir, RawURI: "/volumes/test-pvc/disk.img", OVFURI: "/manifests/ovf", OVAURI: "/manifests/ova"},
+			}
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				guest := resource.MustParse("1Gi")
+				return &virtv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-vm",
+						Namespace: testNamespace,
+					},
+					Spec: virtv1.VirtualMachineSpec{
+						Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+							Spec: virtv1.VirtualMachineInstanceSpec{
+								Domain: virtv1.DomainSpec{
+									Memory: &virtv1.Memory{Guest: &guest},
+									Devices: virtv1.Devices{
+										Disks: []virtv1.Disk{
+											{Name: "rootdisk", DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBusVirtio}}},
+										},
+									},
+								},
+								Volumes: []virtv1.Volume{
+									{
+										Name: "rootdisk",
+										VolumeSource: virtv1.VolumeSource{
+											PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+												PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{ClaimName: "test-pvc"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			}
+		})
+
+		AfterEach(func() {
+			getExpandedVM = orgGetExpandedVM
+		})
+
+		DescribeTable("should return error on non GET", func(handler func([]VolumeInfo) http.Handler, verb string) {
+			req, err := http.NewRequest(verb, "https://test.blah.invalid/manifests/ovf", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			handler(volumes).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusBadRequest))
+		},
+			Entry("OVF POST", ovfHandler, "POST"),
+			Entry("OVF DELETE", ovfHandler, "DELETE"),
+			Entry("OVA POST", ovaHandler, "POST"),
+			Entry("OVA DELETE", ovaHandler, "DELETE"),
+		)
+
+		DescribeTable("should return 404 if there is no VM definition, like for a PVC export", func(handler func([]VolumeInfo) http.Handler) {
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				return nil
+			}
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/manifests/ovf", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			handler(volumes).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusNotFound))
+			Expect(resp.Body.Len()).To(BeZero())
+		},
+			Entry("OVF", ovfHandler),
+			Entry("OVA", ovaHandler),
+		)
+
+		It("should return the OVF descriptor of the VM", func() {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/manifests/ovf", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			ovfHandler(volumes).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusOK))
+
+			envelope, err := ovf.Unmarshal(resp.Body.Bytes())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(envelope.VirtualSystem.Name).To(Equal("test-vm"))
+			Expect(envelope.References).To(ConsistOf(ovf.File{ID: "file-rootdisk", Href: "test-pvc.img", Size: diskSize}))
+		})
+
+		It("should return an OVA containing the descriptor and the disk images", func() {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/manifests/ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			ovaHandler(volumes).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusOK))
+
+			tr := tar.NewReader(resp.Body)
+			header, err := tr.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(header.Name).To(Equal("test-vm.ovf"))
+			envelope, err := ovf.Decode(tr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(envelope.DiskSection.Disks).To(HaveLen(1))
+
+			header, err = tr.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(header.Name).To(Equal("test-pvc.img"))
+			Expect(header.Size).To(BeEquivalentTo(diskSize))
+			data, err := io.ReadAll(tr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(HaveLen(diskSize))
+
+			_, err = tr.Next()
+			Expect(err).To(MatchError(io.EOF))
+		})
+	})
+
+	Context("qcow2 handler", func() {
+		var diskPath string
+
+		BeforeEach(func() {
+			diskPath = filepath.Join(GinkgoT().TempDir(), "disk.img")
+			disk := make([]byte, 4*1024*1024)
+			copy(disk[1024*1024:], "some data")
+			Expect(os.WriteFile(diskPath, disk, 0644)).To(Succeed())
+		})
+
+		DescribeTable("should return error on non GET", func(verb string) {
+			req, err := http.NewRequest(verb, "https://test.blah.invalid/volumes/v1/disk.qcow2", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			qcow2Handler(diskPath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusBadRequest))
+		},
+			Entry("POST", "POST"),
+			Entry("PUT", "PUT"),
+			Entry("PATCH", "PATCH"),
+			Entry("DELETE", "DELETE"),
+		)
+
+		It("should return error on an invalid compressed parameter", func() {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/disk.qcow2?compressed=maybe", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			qcow2Handler(diskPath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusBadRequest))
+		})
+
+		It("should return 500 if the disk cannot be opened", func() {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/disk.qcow2", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			qcow2Handler("/does/not/exist").ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusInternalServerError))
+		})
+
+		DescribeTable("should return a sparse qcow2 image", func(query string) {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/disk.qcow2"+query, nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			qcow2Handler(diskPath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusOK))
+			Expect(resp.Header().Get("Content-Length")).To(Equal(strconv.Itoa(resp.Body.Len())))
+			Expect(resp.Body.Bytes()[:4]).To(Equal([]byte("QFI\xfb")))
+			Expect(resp.Body.Len()).To(BeNumerically("<", 4*1024*1024))
+		},
+			Entry("uncompressed", ""),
+			Entry("compressed", "?compressed=true"),
+		)
+	})
+	Context("file handler", func() {
+		var diskPath string
+
+		BeforeEach(func() {
+			diskPath = filepath.Join(GinkgoT().TempDir(), "disk.img")
+			Expect(os.WriteFile(diskPath, []byte("0123456789"), 0644)).To(Succeed())
+		})
+
+		It("should return a range of the disk", func() {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/disk.img", nil)
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set("Range", "bytes=2-5")
+			resp := httptest.NewRecorder()
+			fileHandler(diskPath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusPartialContent))
+			Expect(resp.Header().Get("Content-Range")).To(Equal("bytes 2-5/10"))
+			Expect(resp.Body.String()).To(Equal("2345"))
+		})
+
+		It("should only return the range if the ETag matches", func() {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/disk.img", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			fileHandler(diskPath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			etag := resp.Header().Get("ETag")
+			Expect(etag).ToNot(BeEmpty())
+
+			req.Header.Set("Range", "bytes=8-")
+			req.Header.Set("If-Range", etag)
+			resp = httptest.NewRecorder()
+			fileHandler(diskPath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusPartialContent))
+			Expect(resp.Body.String()).To(Equal("89"))
+
+			req.Header.Set("If-Range", `"changed"`)
+			resp = httptest.NewRecorder()
+			fileHandler(diskPath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Body.String()).To(Equal("0123456789"))
+		})
+
+		It("should not return a validator for devices", func() {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/disk.img", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			fileHandler(os.DevNull).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Header().Get("ETag")).To(BeEmpty())
+			Expect(resp.Header().Get("Last-Modified")).To(BeEmpty())
+		})
+	})
+
+	Context("checksums handler", func() {
+		var volumes []VolumeInfo
+
+		BeforeEach(func() {
+			tempDir := GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(tempDir, "disk.img"), []byte("test data"), 0644)).To(Succeed())
+			volumes = []VolumeInfo{
+				{Path: tempDir, RawURI: "/volumes/test-pvc/disk.img", ChecksumsURI: "/manifests/checksums"},
+				{Path: GinkgoT().TempDir(), ArchiveURI: "/volumes/archive-pvc/disk.tar.gz", ChecksumsURI: "/manifests/checksums"},
+			}
+		})
+
+		DescribeTable("should return error on non GET", func(verb string) {
+			req, err := http.NewRequest(verb, "https://test.blah.invalid/manifests/checksums", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			checksumsHandler(volumes).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusBadRequest))
+		},
+			Entry("POST", "POST"),
+			Entry("DELETE", "DELETE"),
+		)
+
+		getChecksums := func(handler http.Handler) *httptest.ResponseRecorder {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/manifests/checksums", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+			return resp
+		}
+
+		stubComputeChecksums := func(stub func(vi []VolumeInfo) ([]byte, error)) {
+			origComputeChecksums := computeChecksums
+			DeferCleanup(func() {
+				computeChecksums = origComputeChecksums
+			})
+			computeChecksums = stub
+		}
+
+		It("should return 503 until the checksums are computed", func() {
+			unblock := make(chan struct{})
+			origComputeChecksums := computeChecksums
+			stubComputeChecksums(func(vi []VolumeInfo) ([]byte, error) {
+				<-unblock
+				return origComputeChecksums(vi)
+			})
+			handler := checksumsHandler(volumes)
+			resp := getChecksums(handler)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusServiceUnavailable))
+			Expect(resp.Header().Get("Retry-After")).To(Equal(checksumsRetryAfter))
+
+			close(unblock)
+			Eventually(func() int {
+				return getChecksums(handler).Code
+			}).Should(BeEquivalentTo(http.StatusOK))
+		})
+
+		It("should only compute the checksums once requested", func() {
+			var calls atomic.Int32
+			origComputeChecksums := computeChecksums
+			stubComputeChecksums(func(vi []VolumeInfo) ([]byte, error) {
+				calls.Add(1)
+				return origComputeChecksums(vi)
+			})
+			handler := checksumsHandler(volumes)
+			Consistently(calls.Load).WithTimeout(100 * time.Millisecond).Should(BeZero())
+
+			Expect(getChecksums(handler).Code).To(BeEquivalentTo(http.StatusServiceUnavailable))
+			Eventually(func() int {
+				return getChecksums(handler).Code
+			}).Should(BeEquivalentTo(http.StatusOK))
+			Expect(calls.Load()).To(BeEquivalentTo(1))
+		})
+
+		It("should return 500 if a disk cannot be read", func() {
+			volumes[0].Path = "/does/not/exist"
+			handler := checksumsHandler(volumes)
+			Eventually(func() int {
+				return getChecksums(handler).Code
+			}).Should(BeEquivalentTo(http.StatusInternalServerError))
+		})
+
+		It("should compute the checksums again after an error", func() {
+			var calls atomic.Int32
+			origComputeChecksums := computeChecksums
+			stubComputeChecksums(func(vi []VolumeInfo) ([]byte, error) {
+				if calls.Add(1) == 1 {
+					return nil, fmt.Errorf("read error")
+				}
+				return origComputeChecksums(vi)
+			})
+			handler := checksumsHandler(volumes)
+			Eventually(func() int {
+				return getChecksums(handler).Code
+			}).Should(BeEquivalentTo(http.StatusInternalServerError))
+
+			Eventually(func() int {
+				return getChecksums(handler).Code
+			}).Should(BeEquivalentTo(http.StatusOK))
+			Expect(calls.Load()).To(BeEquivalentTo(2))
+		})
+
+		It("should return the checksums of the raw volumes", func() {
+			handler := checksumsHandler(volumes)
+			Eventually(func() int {
+				return getChecksums(handler).Code
+			}).Should(BeEquivalentTo(http.StatusOK))
+			for i := 0; i < 2; i++ {
+				resp := getChecksums(handler)
+				Expect(resp.Code).To(BeEquivalentTo(http.StatusOK))
+				Expect(resp.Body.String()).To(Equal(fmt.Sprintf("%x  /volumes/test-pvc/disk.img\n", sha256.Sum256([]byte("test data")))))
+			}
+		})
+	})
 })
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/google/uuid"
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -35,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	kubevirtv1 "kubevirt.io/api/core/v1"
@@ -563,6 +565,7 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 	newVM.Spec.DataVolumeTemplates = newTemplates
 	newVM.Spec.Template.Spec.Volumes = newVolumes
 	setLastRestoreAnnotation(t.vmRestore, newVM)
+	regenerateGenerationID(t.vmRestore, newVM)
 
 	if err = t.restoreInstancetypeControllerRevisions(newVM); err != nil {
 		return false, err
@@ -1047,6 +1050,16 @@ func hasLastRestoreAnnotation(restore *snapshotv1.VirtualMachineRestore, obj met
 	return obj.GetAnnotations()[lastRestoreAnnotation] == getRestoreAnnotationValue(restore)
 }
 
+// regenerateGenerationID assigns a new VM Generation ID to the restored VM, so that the guest
+// notices the rollback. The ID is derived from the restore to keep it stable across reconciles.
+func regenerateGenerationID(restore *snapshotv1.VirtualMachineRestore, vm *kubevirtv1.VirtualMachine) {
+	firmware := vm.Spec.Template.Spec.Domain.Firmware
+	if firmware == nil || firmware.GenerationID == nil {
+		return
+	}
+	firmware.GenerationID.UUID = types.UID(uuid.NewSHA1(uuid.NameSpaceOID, []byte(restore.UID)).String())
+}
+
 func setLastRestoreAnnotation(restore *snapshotv1.VirtualMachineRestore, obj metav1.Object) {
 	if obj.GetAnnotations() == nil {
 		obj.SetAnnotations(make(map[string]string))
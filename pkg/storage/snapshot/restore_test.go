@@ -653,6 +653,45 @@ var _ = Describe("Restore controller", func() {
 				controller.processVMRestoreWorkItem()
 			})
 
+			It("should regenerate the VM Generation ID of the VM", func() {
+				const snapshotGenerationID = "9b0a8b4b-4a4e-4d7a-9d1d-0b8c6e0e2f55"
+				sc.Spec.Source.VirtualMachine.Spec.Template.Spec.Domain.Firmware = &v1.Firmware{
+					GenerationID: &v1.GenerationID{UUID: snapshotGenerationID},
+				}
+				vmSnapshotContentSource.Modify(sc)
+
+				r := createRestoreWithOwner()
+				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete:           &f,
+					DeletedDataVolumes: getDeletedDataVolumes(createModifiedVM()),
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Updating target spec"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for target update"),
+					},
+				}
+				addVolumeRestores(r)
+				for i := range r.Status.Restores {
+					r.Status.Restores[i].DataVolumeName = &r.Status.Restores[i].PersistentVolumeClaimName
+				}
+				vm := createModifiedVM()
+				vm.Status.RestoreInProgress = &vmRestoreName
+				vmSource.Add(vm)
+				vmInterface.EXPECT().Update(context.Background(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedVM *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+					generationID := updatedVM.Spec.Template.Spec.Domain.Firmware.GenerationID
+					Expect(generationID).ToNot(BeNil())
+					Expect(generationID.UUID).ToNot(BeEmpty())
+					Expect(string(generationID.UUID)).ToNot(Equal(snapshotGenerationID))
+					return updatedVM, nil
+				})
+				for _, pvc := range getRestorePVCs(r) {
+					pvc.Annotations["cdi.kubevirt.io/storage.populatedFor"] = pvc.Name
+					pvc.Status.Phase = corev1.ClaimBound
+					pvcSource.Add(&pvc)
+				}
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+			})
+
 			It("should cleanup and unlock vm", func() {
 				r := createRestoreWithOwner()
 				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
@@ -103,6 +103,8 @@ func PVCNameFromVirtVolume(volume *virtv1.Volume) string {
 		return volume.PersistentVolumeClaim.ClaimName
 	} else if volume.MemoryDump != nil {
 		return volume.MemoryDump.ClaimName
+	} else if volume.Backup != nil {
+		return volume.Backup.ClaimName
 	}
 
 	return ""
@@ -0,0 +1,317 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package volumemigration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+const (
+	// VolumeMigrationFailedReason is reported in the VM status when the migration copying the volumes failed
+	VolumeMigrationFailedReason = "the migration copying the volumes failed"
+	// VolumeMigrationInterruptedReason is reported in the VM status when the VMI stopped during the volume migration
+	VolumeMigrationInterruptedReason = "the VMI stopped before the completion of the volume migration"
+)
+
+// IsVolumeMigrating returns true if the VMI has volumes waiting to be copied to their destination
+func IsVolumeMigrating(vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi != nil && len(vmi.Status.MigratedVolumes) > 0
+}
+
+func claimNameFromVolume(volume *virtv1.Volume) string {
+	if volume.DataVolume == nil && volume.PersistentVolumeClaim == nil {
+		return ""
+	}
+	return storagetypes.PVCNameFromVirtVolume(volume)
+}
+
+func pvcInfoFromStore(pvcStore cache.Store, namespace, claimName string) (*virtv1.PersistentVolumeClaimInfo, error) {
+	pvc, exists, _, err := storagetypes.IsPVCBlockFromStore(pvcStore, namespace, claimName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, storagetypes.PvcNotFoundError{Reason: fmt.Sprintf("persistentvolumeclaim %s not found", claimName)}
+	}
+	return &virtv1.PersistentVolumeClaimInfo{
+		ClaimName:    pvc.Name,
+		AccessModes:  pvc.Spec.AccessModes,
+		VolumeMode:   pvc.Spec.VolumeMode,
+		Capacity:     pvc.Status.Capacity,
+		Requests:     pvc.Spec.Resources.Requests,
+		Preallocated: storagetypes.IsPreallocated(pvc.ObjectMeta.Annotations),
+	}, nil
+}
+
+func volumeModeOrDefault(info *virtv1.PersistentVolumeClaimInfo) k8sv1.PersistentVolumeMode {
+	if info.VolumeMode == nil {
+		return k8sv1.PersistentVolumeFilesystem
+	}
+	return *info.VolumeMode
+}
+
+func isSmaller(dst, src k8sv1.ResourceList) bool {
+	dstSize, dstOk := dst[k8sv1.ResourceStorage]
+	srcSize, srcOk := src[k8sv1.ResourceStorage]
+	return dstOk && srcOk && dstSize.Cmp(srcSize) < 0
+}
+
+// GenerateMigratedVolumes compares the volumes of the VM template with the ones of the running VMI,
+// and returns the volumes whose claim has been replaced in the VM template.
+func GenerateMigratedVolumes(pvcStore cache.Store, vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine) ([]virtv1.StorageMigratedVolumeInfo, error) {
+	var migVolsInfo []virtv1.StorageMigratedVolumeInfo
+	vmiVolumes := make(map[string]*virtv1.Volume)
+	for i := range vmi.Spec.Volumes {
+		vmiVolumes[vmi.Spec.Volumes[i].Name] = &vmi.Spec.Volumes[i]
+	}
+	hotplugVolumes := make(map[string]bool)
+	for _, status := range vmi.Status.VolumeStatus {
+		if status.HotplugVolume != nil {
+			hotplugVolumes[status.Name] = true
+		}
+	}
+
+	for i := range vm.Spec.Template.Spec.Volumes {
+		volume := &vm.Spec.Template.Spec.Volumes[i]
+		vmiVolume, ok := vmiVolumes[volume.Name]
+		if !ok {
+			continue
+		}
+		srcClaim := claimNameFromVolume(vmiVolume)
+		dstClaim := claimNameFromVolume(volume)
+		if srcClaim == "" || dstClaim == "" || srcClaim == dstClaim {
+			continue
+		}
+		if hotplugVolumes[volume.Name] {
+			return nil, fmt.Errorf("the migration of the hotplugged volume %s is not supported", volume.Name)
+		}
+		srcInfo, err := pvcInfoFromStore(pvcStore, vmi.Namespace, srcClaim)
+		if err != nil {
+			return nil, err
+		}
+		dstInfo, err := pvcInfoFromStore(pvcStore, vmi.Namespace, dstClaim)
+		if err != nil {
+			return nil, err
+		}
+		if volumeModeOrDefault(srcInfo) != volumeModeOrDefault(dstInfo) {
+			return nil, fmt.Errorf("the volume mode of the destination volume %s differs from the source volume %s", dstClaim, srcClaim)
+		}
+		if isSmaller(dstInfo.Capacity, srcInfo.Capacity) {
+			return nil, fmt.Errorf("the destination volume %s is smaller than the source volume %s", dstClaim, srcClaim)
+		}
+		migVolsInfo = append(migVolsInfo, virtv1.StorageMigratedVolumeInfo{
+			VolumeName:         volume.Name,
+			SourcePVCInfo:      srcInfo,
+			DestinationPVCInfo: dstInfo,
+		})
+	}
+
+	return migVolsInfo, nil
+}
+
+// IsRevertRequested returns true if the VM template points back to the source volumes of an ongoing volume migration
+func IsRevertRequested(vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine) bool {
+	return IsVolumeMigrating(vmi) && hasClaims(vm.Spec.Template.Spec.Volumes, vmi.Status.MigratedVolumes, sourcePVCInfo)
+}
+
+// replaceVolumeSources returns the VMI volumes with the source of the migrated volumes taken from the VM template
+func replaceVolumeSources(vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine, migVolsInfo []virtv1.StorageMigratedVolumeInfo) []virtv1.Volume {
+	migrated := make(map[string]bool)
+	for _, v := range migVolsInfo {
+		migrated[v.VolumeName] = true
+	}
+	vmVolumes := make(map[string]*virtv1.Volume)
+	for i := range vm.Spec.Template.Spec.Volumes {
+		vmVolumes[vm.Spec.Template.Spec.Volumes[i].Name] = &vm.Spec.Template.Spec.Volumes[i]
+	}
+	var volumes []virtv1.Volume
+	for _, v := range vmi.Spec.Volumes {
+		if vmVolume, ok := vmVolumes[v.Name]; ok && migrated[v.Name] {
+			volumes = append(volumes, *vmVolume.DeepCopy())
+			continue
+		}
+		volumes = append(volumes, *v.DeepCopy())
+	}
+	return volumes
+}
+
+func generateVolumesPatch(vmi *virtv1.VirtualMachineInstance, volumes []virtv1.Volume, migVolsInfo []virtv1.StorageMigratedVolumeInfo) ([]byte, error) {
+	var ops []string
+	oldVolumesJSON, err := json.Marshal(vmi.Spec.Volumes)
+	if err != nil {
+		return nil, err
+	}
+	newVolumesJSON, err := json.Marshal(volumes)
+	if err != nil {
+		return nil, err
+	}
+	ops = append(ops, fmt.Sprintf(`{ "op": "test", "path": "/spec/volumes", "value": %s }`, string(oldVolumesJSON)))
+	ops = append(ops, fmt.Sprintf(`{ "op": "replace", "path": "/spec/volumes", "value": %s }`, string(newVolumesJSON)))
+
+	if equality.Semantic.DeepEqual(vmi.Status.MigratedVolumes, migVolsInfo) {
+		return controller.GeneratePatchBytes(ops), nil
+	}
+	if len(vmi.Status.MigratedVolumes) > 0 {
+		oldMigVolsJSON, err := json.Marshal(vmi.Status.MigratedVolumes)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, fmt.Sprintf(`{ "op": "test", "path": "/status/migratedVolumes", "value": %s }`, string(oldMigVolsJSON)))
+	}
+	if len(migVolsInfo) > 0 {
+		migVolsJSON, err := json.Marshal(migVolsInfo)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, fmt.Sprintf(`{ "op": "add", "path": "/status/migratedVolumes", "value": %s }`, string(migVolsJSON)))
+	} else if len(vmi.Status.MigratedVolumes) > 0 {
+		ops = append(ops, `{ "op": "remove", "path": "/status/migratedVolumes" }`)
+	}
+
+	return controller.GeneratePatchBytes(ops), nil
+}
+
+// PatchVMIVolumes replaces the source of the migrated volumes in the VMI spec with the destination volumes of the
+// VM template, and records the migrated volumes in the VMI status.
+func PatchVMIVolumes(client kubecli.KubevirtClient, vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine, migVolsInfo []virtv1.StorageMigratedVolumeInfo) error {
+	if equality.Semantic.DeepEqual(vmi.Status.MigratedVolumes, migVolsInfo) {
+		return nil
+	}
+	patch, err := generateVolumesPatch(vmi, replaceVolumeSources(vmi, vm, migVolsInfo), migVolsInfo)
+	if err != nil {
+		return err
+	}
+	_, err = client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patch, &metav1.PatchOptions{})
+	return err
+}
+
+// RevertVMIVolumes restores the source volumes in the VMI spec and then clears the migrated volumes from the VMI
+// status. The two steps are applied by separate patches since the update of the permanent volumes is only admitted
+// for the volumes listed in the migrated volumes.
+func RevertVMIVolumes(client kubecli.KubevirtClient, vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine) error {
+	volumes := replaceVolumeSources(vmi, vm, vmi.Status.MigratedVolumes)
+	if !equality.Semantic.DeepEqual(vmi.Spec.Volumes, volumes) {
+		patch, err := generateVolumesPatch(vmi, volumes, vmi.Status.MigratedVolumes)
+		if err != nil {
+			return err
+		}
+		vmi, err = client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patch, &metav1.PatchOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	patch, err := generateVolumesPatch(vmi, vmi.Spec.Volumes, nil)
+	if err != nil {
+		return err
+	}
+	_, err = client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patch, &metav1.PatchOptions{})
+	return err
+}
+
+func hasClaims(volumes []virtv1.Volume, migVolsInfo []virtv1.StorageMigratedVolumeInfo, pvcInfo func(virtv1.StorageMigratedVolumeInfo) *virtv1.PersistentVolumeClaimInfo) bool {
+	claims := storagetypes.GetPVCsFromVolumes(volumes)
+	for _, v := range migVolsInfo {
+		info := pvcInfo(v)
+		if info == nil || claims[v.VolumeName] != info.ClaimName {
+			return false
+		}
+	}
+	return true
+}
+
+func sourcePVCInfo(v virtv1.StorageMigratedVolumeInfo) *virtv1.PersistentVolumeClaimInfo {
+	return v.SourcePVCInfo
+}
+
+func destinationPVCInfo(v virtv1.StorageMigratedVolumeInfo) *virtv1.PersistentVolumeClaimInfo {
+	return v.DestinationPVCInfo
+}
+
+func startedAfter(migState *virtv1.VirtualMachineInstanceMigrationState, timestamp *metav1.Time) bool {
+	return migState != nil && migState.StartTimestamp != nil &&
+		(timestamp == nil || !migState.StartTimestamp.Before(timestamp))
+}
+
+// SyncVolumeMigrationState reports in the VM status the progress and the result of the volume migration of the VMI
+func SyncVolumeMigrationState(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	var state *virtv1.VolumeMigrationState
+	if vm.Status.VolumeUpdateState != nil && vm.Status.VolumeUpdateState.VolumeMigrationState != nil {
+		state = vm.Status.VolumeUpdateState.VolumeMigrationState.DeepCopy()
+	}
+	now := metav1.Now()
+
+	switch {
+	case IsVolumeMigrating(vmi) && !vmi.IsFinal():
+		if state == nil || state.Phase == virtv1.VolumeMigrationSucceeded ||
+			!equality.Semantic.DeepEqual(state.MigratedVolumes, vmi.Status.MigratedVolumes) {
+			state = &virtv1.VolumeMigrationState{
+				MigratedVolumes: vmi.Status.MigratedVolumes,
+				Phase:           virtv1.VolumeMigrationPending,
+				StartTimestamp:  &now,
+			}
+		}
+		migState := vmi.Status.MigrationState
+		if startedAfter(migState, state.StartTimestamp) {
+			switch {
+			case migState.Completed && migState.Failed:
+				state.Phase = virtv1.VolumeMigrationFailed
+				state.FailureReason = VolumeMigrationFailedReason
+			case !migState.Completed:
+				state.Phase = virtv1.VolumeMigrationRunning
+			}
+		}
+	case state == nil:
+		return
+	case hasClaims(vm.Spec.Template.Spec.Volumes, state.MigratedVolumes, sourcePVCInfo):
+		// The volume update has been reverted
+		state = nil
+	case state.Phase == virtv1.VolumeMigrationSucceeded || state.ManualRecoveryRequired:
+	case vmi == nil || vmi.IsFinal():
+		state.Phase = virtv1.VolumeMigrationFailed
+		state.FailureReason = VolumeMigrationInterruptedReason
+		state.ManualRecoveryRequired = true
+		state.EndTimestamp = &now
+	case hasClaims(vmi.Spec.Volumes, state.MigratedVolumes, destinationPVCInfo):
+		state.Phase = virtv1.VolumeMigrationSucceeded
+		state.EndTimestamp = &now
+	}
+
+	if state == nil {
+		vm.Status.VolumeUpdateState = nil
+		return
+	}
+	vm.Status.VolumeUpdateState = &virtv1.VolumeUpdateState{
+		VolumeMigrationState: state,
+	}
+}
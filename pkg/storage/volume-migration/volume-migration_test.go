@@ -0,0 +1,247 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package volumemigration
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const testNamespace = "test"
+
+var _ = Describe("Volume migration", func() {
+	var pvcStore cache.Store
+
+	newPVC := func(name string, mode k8sv1.PersistentVolumeMode, size string) *k8sv1.PersistentVolumeClaim {
+		return &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+			Spec: k8sv1.PersistentVolumeClaimSpec{
+				AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+				VolumeMode:  pointer.P(mode),
+			},
+			Status: k8sv1.PersistentVolumeClaimStatus{
+				Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse(size)},
+			},
+		}
+	}
+
+	newVMI := func(claims ...string) *virtv1.VirtualMachineInstance {
+		vmi := api.NewMinimalVMIWithNS(testNamespace, "testvmi")
+		for i, claim := range claims {
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, virtv1.Volume{
+				Name: volumeName(i),
+				VolumeSource: virtv1.VolumeSource{
+					PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: claim},
+					},
+				},
+			})
+		}
+		vmi.Status.Phase = virtv1.Running
+		return vmi
+	}
+
+	newVM := func(claims ...string) *virtv1.VirtualMachine {
+		vmi := newVMI(claims...)
+		return &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: vmi.Name, Namespace: testNamespace},
+			Spec: virtv1.VirtualMachineSpec{
+				Template: &virtv1.VirtualMachineInstanceTemplateSpec{Spec: vmi.Spec},
+			},
+		}
+	}
+
+	migratedVolume := func(name, src, dst string) virtv1.StorageMigratedVolumeInfo {
+		return virtv1.StorageMigratedVolumeInfo{
+			VolumeName:         name,
+			SourcePVCInfo:      &virtv1.PersistentVolumeClaimInfo{ClaimName: src},
+			DestinationPVCInfo: &virtv1.PersistentVolumeClaimInfo{ClaimName: dst},
+		}
+	}
+
+	BeforeEach(func() {
+		pvcStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		Expect(pvcStore.Add(newPVC("src0", k8sv1.PersistentVolumeFilesystem, "1Gi"))).To(Succeed())
+		Expect(pvcStore.Add(newPVC("src1", k8sv1.PersistentVolumeFilesystem, "1Gi"))).To(Succeed())
+		Expect(pvcStore.Add(newPVC("dst0", k8sv1.PersistentVolumeFilesystem, "2Gi"))).To(Succeed())
+		Expect(pvcStore.Add(newPVC("dst-small", k8sv1.PersistentVolumeFilesystem, "512Mi"))).To(Succeed())
+		Expect(pvcStore.Add(newPVC("dst-block", k8sv1.PersistentVolumeBlock, "1Gi"))).To(Succeed())
+	})
+
+	Context("GenerateMigratedVolumes", func() {
+		It("should return the volumes with a different claim in the VM template", func() {
+			migVols, err := GenerateMigratedVolumes(pvcStore, newVMI("src0", "src1"), newVM("dst0", "src1"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migVols).To(HaveLen(1))
+			Expect(migVols[0].VolumeName).To(Equal(volumeName(0)))
+			Expect(migVols[0].SourcePVCInfo.ClaimName).To(Equal("src0"))
+			Expect(migVols[0].DestinationPVCInfo.ClaimName).To(Equal("dst0"))
+		})
+
+		It("should not return any volume if the claims are the same", func() {
+			migVols, err := GenerateMigratedVolumes(pvcStore, newVMI("src0", "src1"), newVM("src0", "src1"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migVols).To(BeEmpty())
+		})
+
+		DescribeTable("should fail", func(dst, expectedErr string) {
+			_, err := GenerateMigratedVolumes(pvcStore, newVMI("src0"), newVM(dst))
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("if the destination volume does not exist", "missing", "persistentvolumeclaim missing not found"),
+			Entry("if the volume mode differs", "dst-block", "the volume mode of the destination volume dst-block differs"),
+			Entry("if the destination volume is smaller", "dst-small", "the destination volume dst-small is smaller"),
+		)
+
+		It("should fail with a hotplugged volume", func() {
+			vmi := newVMI("src0")
+			vmi.Status.VolumeStatus = []virtv1.VolumeStatus{{Name: volumeName(0), HotplugVolume: &virtv1.HotplugVolumeStatus{}}}
+			_, err := GenerateMigratedVolumes(pvcStore, vmi, newVM("dst0"))
+			Expect(err).To(MatchError(ContainSubstring("hotplugged volume")))
+		})
+	})
+
+	Context("generateVolumesPatch", func() {
+		It("should replace the volumes and add the migrated volumes", func() {
+			vmi := newVMI("src0")
+			vm := newVM("dst0")
+			migVols := []virtv1.StorageMigratedVolumeInfo{migratedVolume(volumeName(0), "src0", "dst0")}
+			patch, err := generateVolumesPatch(vmi, replaceVolumeSources(vmi, vm, migVols), migVols)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(patch)).To(Equal(`[{ "op": "test", "path": "/spec/volumes", "value": [{"name":"disk0","persistentVolumeClaim":{"claimName":"src0"}}] }, ` +
+				`{ "op": "replace", "path": "/spec/volumes", "value": [{"name":"disk0","persistentVolumeClaim":{"claimName":"dst0"}}] }, ` +
+				`{ "op": "add", "path": "/status/migratedVolumes", "value": [{"volumeName":"disk0","sourcePVCInfo":{"claimName":"src0"},"destinationPVCInfo":{"claimName":"dst0"}}] }]`))
+		})
+
+		It("should remove the migrated volumes", func() {
+			vmi := newVMI("src0")
+			vmi.Status.MigratedVolumes = []virtv1.StorageMigratedVolumeInfo{migratedVolume(volumeName(0), "src0", "dst0")}
+			patch, err := generateVolumesPatch(vmi, vmi.Spec.Volumes, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(patch)).To(ContainSubstring(`{ "op": "test", "path": "/status/migratedVolumes", "value": [{"volumeName":"disk0","sourcePVCInfo":{"claimName":"src0"},"destinationPVCInfo":{"claimName":"dst0"}}] }`))
+			Expect(string(patch)).To(HaveSuffix(`{ "op": "remove", "path": "/status/migratedVolumes" }]`))
+		})
+	})
+
+	It("should detect a revert of the volume update", func() {
+		vmi := newVMI("dst0")
+		vmi.Status.MigratedVolumes = []virtv1.StorageMigratedVolumeInfo{migratedVolume(volumeName(0), "src0", "dst0")}
+		Expect(IsRevertRequested(vmi, newVM("src0"))).To(BeTrue())
+		Expect(IsRevertRequested(vmi, newVM("dst0"))).To(BeFalse())
+	})
+
+	Context("SyncVolumeMigrationState", func() {
+		var (
+			vm  *virtv1.VirtualMachine
+			vmi *virtv1.VirtualMachineInstance
+		)
+
+		BeforeEach(func() {
+			vm = newVM("dst0")
+			vmi = newVMI("dst0")
+			vmi.Status.MigratedVolumes = []virtv1.StorageMigratedVolumeInfo{migratedVolume(volumeName(0), "src0", "dst0")}
+		})
+
+		state := func() *virtv1.VolumeMigrationState {
+			Expect(vm.Status.VolumeUpdateState).ToNot(BeNil())
+			return vm.Status.VolumeUpdateState.VolumeMigrationState
+		}
+
+		It("should report a pending volume migration", func() {
+			SyncVolumeMigrationState(vm, vmi)
+			Expect(state().Phase).To(Equal(virtv1.VolumeMigrationPending))
+			Expect(state().MigratedVolumes).To(Equal(vmi.Status.MigratedVolumes))
+			Expect(state().StartTimestamp).ToNot(BeNil())
+		})
+
+		It("should report a running volume migration", func() {
+			SyncVolumeMigrationState(vm, vmi)
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				StartTimestamp: pointer.P(metav1.NewTime(state().StartTimestamp.Add(time.Second))),
+			}
+			SyncVolumeMigrationState(vm, vmi)
+			Expect(state().Phase).To(Equal(virtv1.VolumeMigrationRunning))
+		})
+
+		It("should ignore a migration started before the volume update", func() {
+			SyncVolumeMigrationState(vm, vmi)
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				StartTimestamp: pointer.P(metav1.NewTime(state().StartTimestamp.Add(-time.Minute))),
+				Completed:      true,
+				Failed:         true,
+			}
+			SyncVolumeMigrationState(vm, vmi)
+			Expect(state().Phase).To(Equal(virtv1.VolumeMigrationPending))
+		})
+
+		It("should report a failed volume migration", func() {
+			SyncVolumeMigrationState(vm, vmi)
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				StartTimestamp: pointer.P(metav1.NewTime(state().StartTimestamp.Add(time.Second))),
+				Completed:      true,
+				Failed:         true,
+			}
+			SyncVolumeMigrationState(vm, vmi)
+			Expect(state().Phase).To(Equal(virtv1.VolumeMigrationFailed))
+			Expect(state().FailureReason).To(Equal(VolumeMigrationFailedReason))
+			Expect(state().ManualRecoveryRequired).To(BeFalse())
+		})
+
+		It("should report a successful volume migration", func() {
+			SyncVolumeMigrationState(vm, vmi)
+			vmi.Status.MigratedVolumes = nil
+			SyncVolumeMigrationState(vm, vmi)
+			Expect(state().Phase).To(Equal(virtv1.VolumeMigrationSucceeded))
+			Expect(state().EndTimestamp).ToNot(BeNil())
+		})
+
+		It("should require a manual recovery if the VMI stopped during the volume migration", func() {
+			SyncVolumeMigrationState(vm, vmi)
+			SyncVolumeMigrationState(vm, nil)
+			Expect(state().Phase).To(Equal(virtv1.VolumeMigrationFailed))
+			Expect(state().FailureReason).To(Equal(VolumeMigrationInterruptedReason))
+			Expect(state().ManualRecoveryRequired).To(BeTrue())
+		})
+
+		It("should clear the state once the volume update is reverted", func() {
+			SyncVolumeMigrationState(vm, vmi)
+			vm.Spec.Template.Spec.Volumes = newVM("src0").Spec.Template.Spec.Volumes
+			vmi = newVMI("src0")
+			SyncVolumeMigrationState(vm, vmi)
+			Expect(vm.Status.VolumeUpdateState).To(BeNil())
+		})
+	})
+})
+
+func volumeName(i int) string {
+	return fmt.Sprintf("disk%d", i)
+}
@@ -1,6 +1,10 @@
 package migrations
 
 import (
+	"fmt"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
@@ -83,3 +87,87 @@ func VMIMigratableOnEviction(clusterConfig *virtconfig.ClusterConfig, vmi *v1.Vi
 	}
 	return false
 }
+
+// Priority returns the priority of the migration. Migrations without an explicit
+// priority get a default derived from their origin.
+func Priority(migration *v1.VirtualMachineInstanceMigration) int32 {
+	if migration.Spec.Priority != nil {
+		return *migration.Spec.Priority
+	}
+	if _, exists := migration.Annotations[v1.EvacuationMigrationAnnotation]; exists {
+		return v1.MigrationPriorityEvacuation
+	}
+	if _, exists := migration.Annotations[v1.WorkloadUpdateMigrationAnnotation]; exists {
+		return v1.MigrationPriorityWorkloadUpdate
+	}
+	return v1.MigrationPriorityUser
+}
+
+// HostModelNodeSelector returns the node labels a target node has to provide to run the
+// host-model CPU of a VMI, based on its source node and the node selector of its source pod
+func HostModelNodeSelector(sourceNode *k8sv1.Node, sourcePod *k8sv1.Pod) (map[string]string, error) {
+	nodeSelector := map[string]string{}
+
+	// if the vmi already migrated before it should include node selector that consider CPUModelLabel
+	for key, value := range sourcePod.Spec.NodeSelector {
+		if strings.Contains(key, v1.CPUFeatureLabel) || strings.Contains(key, v1.SupportedHostModelMigrationCPU) {
+			nodeSelector[key] = value
+		}
+	}
+	if len(nodeSelector) > 0 {
+		return nodeSelector, nil
+	}
+
+	var hostCpuModel, hostModelLabelValue string
+	for key, value := range sourceNode.Labels {
+		if strings.HasPrefix(key, v1.HostModelCPULabel) {
+			hostCpuModel = strings.TrimPrefix(key, v1.HostModelCPULabel)
+			hostModelLabelValue = value
+		}
+
+		if strings.HasPrefix(key, v1.HostModelRequiredFeaturesLabel) {
+			requiredFeature := strings.TrimPrefix(key, v1.HostModelRequiredFeaturesLabel)
+			nodeSelector[v1.CPUFeatureLabel+requiredFeature] = value
+		}
+	}
+
+	if hostCpuModel == "" {
+		return nil, fmt.Errorf("node does not contain labal \"%s\" with information about host cpu model", v1.HostModelCPULabel)
+	}
+
+	nodeSelector[v1.SupportedHostModelMigrationCPU+hostCpuModel] = hostModelLabelValue
+	return nodeSelector, nil
+}
+
+// IsNodeSuitableForHostModelMigration returns true if the node provides all labels required by the host-model CPU of a VMI
+func IsNodeSuitableForHostModelMigration(node *k8sv1.Node, requiredNodeLabels map[string]string) bool {
+	for key, value := range requiredNodeLabels {
+		nodeValue, ok := node.Labels[key]
+
+		if !ok || nodeValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AdditionalMigrationInterfaceName returns the name of the interface virt-handler is attached to
+// the additional migration network at the given index with
+func AdditionalMigrationInterfaceName(index int) string {
+	return fmt.Sprintf("migration%d", index+1)
+}
+
+// MigrationInterfaceName returns the name of the interface virt-handler is attached to the given
+// migration network with. It returns false if virt-handler is not attached to the network.
+func MigrationInterfaceName(migrationConfig *v1.MigrationConfiguration, network string) (string, bool) {
+	if migrationConfig.Network != nil && *migrationConfig.Network == network {
+		return v1.MigrationInterfaceName, true
+	}
+	for i, additionalNetwork := range migrationConfig.AdditionalNetworks {
+		if additionalNetwork == network {
+			return AdditionalMigrationInterfaceName(i), true
+		}
+	}
+	return "", false
+}
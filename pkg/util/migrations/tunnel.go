@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MigrationTunnelPort is the port virt-api serves the migration tunnel of cross-cluster migrations on
+const MigrationTunnelPort = 8444
+
+// Every connection through the migration tunnel starts with a CONNECT request for the receiving VMI
+// and the port of the target node the connection is forwarded to.
+const migrationTunnelPathFormat = "/namespaces/%s/virtualmachineinstances/%s/ports/%d"
+
+// MigrationTunnelPath returns the path of the CONNECT request for a port of the target node
+func MigrationTunnelPath(namespace, name string, port int) string {
+	return fmt.Sprintf(migrationTunnelPathFormat, namespace, name, port)
+}
+
+// ParseMigrationTunnelPath returns the receiving VMI and the port of the target node of a CONNECT request
+func ParseMigrationTunnelPath(path string) (namespace, name string, port int, err error) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 7 || parts[0] != "" || parts[1] != "namespaces" || parts[3] != "virtualmachineinstances" || parts[5] != "ports" ||
+		parts[2] == "" || parts[4] == "" {
+		return "", "", 0, fmt.Errorf("invalid migration tunnel path %s", path)
+	}
+	port, err = strconv.Atoi(parts[6])
+	if err != nil || port <= 0 || port > 65535 {
+		return "", "", 0, fmt.Errorf("invalid port in migration tunnel path %s", path)
+	}
+	return parts[2], parts[4], port, nil
+}
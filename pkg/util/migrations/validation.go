@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// QEMU does not support more multifd channels
+	MaxMultifdChannels      = 255
+	MaxZlibCompressionLevel = 9
+	MaxZstdCompressionLevel = 20
+)
+
+// ValidateMultifdChannels validates the number of multifd channels of a migration policy or of KubeVirt
+func ValidateMultifdChannels(field *k8sfield.Path, channels *uint32) []metav1.StatusCause {
+	if channels != nil && (*channels == 0 || *channels > MaxMultifdChannels) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("must be between 1 and %d", MaxMultifdChannels),
+			Field:   field.String(),
+		}}
+	}
+	return nil
+}
+
+// ValidateCompression validates the compression method and level of a migration policy or of KubeVirt
+func ValidateCompression(field *k8sfield.Path, compression *v1.MigrationCompression) []metav1.StatusCause {
+	if compression == nil {
+		return nil
+	}
+
+	var maxLevel int32
+	switch compression.Method {
+	case v1.MigrationCompressionZlib:
+		maxLevel = MaxZlibCompressionLevel
+	case v1.MigrationCompressionZstd:
+		maxLevel = MaxZstdCompressionLevel
+	default:
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("must be one of %s, %s", v1.MigrationCompressionZstd, v1.MigrationCompressionZlib),
+			Field:   field.Child("method").String(),
+		}}
+	}
+
+	if compression.Level != nil && (*compression.Level < 0 || *compression.Level > maxLevel) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("must be between 0 and %d for %s", maxLevel, compression.Method),
+			Field:   field.Child("level").String(),
+		}}
+	}
+	return nil
+}
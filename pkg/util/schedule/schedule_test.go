@@ -0,0 +1,74 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package schedule
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	// Wednesday
+	now := time.Date(2024, time.May, 15, 10, 30, 0, 0, time.UTC)
+
+	DescribeTable("should reject invalid schedules", func(spec string) {
+		_, err := Parse(spec)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("with too few fields", "0 2 * *"),
+		Entry("with too many fields", "0 2 * * * *"),
+		Entry("with a value out of range", "60 2 * * *"),
+		Entry("with a non numeric value", "0 two * * *"),
+		Entry("with an inverted range", "0 5-2 * * *"),
+		Entry("with an invalid step", "*/0 2 * * *"),
+		Entry("which never matches", "0 2 30 2 *"),
+	)
+
+	DescribeTable("should find the next activation", func(spec string, expected time.Time) {
+		s, err := Parse(spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Next(now)).To(Equal(expected))
+	},
+		Entry("every minute", "* * * * *", time.Date(2024, time.May, 15, 10, 31, 0, 0, time.UTC)),
+		Entry("later on the same day", "0 22 * * *", time.Date(2024, time.May, 15, 22, 0, 0, 0, time.UTC)),
+		Entry("on the next day", "0 2 * * *", time.Date(2024, time.May, 16, 2, 0, 0, 0, time.UTC)),
+		Entry("on a weekday", "0 2 * * 6", time.Date(2024, time.May, 18, 2, 0, 0, 0, time.UTC)),
+		Entry("on Sunday given as 7", "0 2 * * 7", time.Date(2024, time.May, 19, 2, 0, 0, 0, time.UTC)),
+		Entry("with a step", "*/20 * * * *", time.Date(2024, time.May, 15, 10, 40, 0, 0, time.UTC)),
+		Entry("with a list", "0 4,12 * * *", time.Date(2024, time.May, 15, 12, 0, 0, 0, time.UTC)),
+		Entry("in the next month", "0 0 1 * *", time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("in the next year", "0 0 1 1 *", time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("on either restricted day", "0 0 20 * 5", time.Date(2024, time.May, 17, 0, 0, 0, 0, time.UTC)),
+	)
+
+	DescribeTable("should detect active windows", func(spec string, duration time.Duration, active bool) {
+		s, err := Parse(spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.IsActive(now, duration)).To(Equal(active))
+	},
+		Entry("inside the window", "0 10 * * *", time.Hour, true),
+		Entry("at the start of the window", "30 10 * * *", time.Hour, true),
+		Entry("at the end of the window", "30 9 * * *", time.Hour, false),
+		Entry("before the window", "0 11 * * *", time.Hour, false),
+		Entry("on another day", "0 10 * * 6", time.Hour, false),
+	)
+})
@@ -215,6 +215,38 @@ func SetupTLSForVirtHandlerClients(caManager ClientCAManager, certManager certif
 	}
 }
 
+// SetupTLSForMigrationTunnel requests a client certificate without verifying it, the CA of the source
+// cluster is only known once the receiving migration of the connection is found
+func SetupTLSForMigrationTunnel(certManager certificate.Manager, clusterConfig *virtconfig.ClusterConfig) *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(info *tls.ClientHelloInfo) (*tls.Config, error) {
+			cert := certManager.Current()
+			if cert == nil {
+				return nil, fmt.Errorf(noSrvCertMessage)
+			}
+
+			kv := clusterConfig.GetConfigFromKubeVirtCR()
+			tlsConfig := getTLSConfiguration(kv)
+			return &tls.Config{
+				CipherSuites: CipherSuiteIds(tlsConfig.Ciphers),
+				MinVersion:   TLSVersion(tlsConfig.MinTLSVersion),
+				Certificates: []tls.Certificate{*cert},
+				ClientAuth:   tls.RequireAnyClientCert,
+			}, nil
+		},
+	}
+}
+
+// VerifyMigrationTunnelClient verifies that the client certificate of a connection to the migration
+// tunnel is a virt-handler client certificate signed by the CA bundle of the source cluster
+func VerifyMigrationTunnelClient(rawCerts [][]byte, caBundle []byte) error {
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caBundle) {
+		return fmt.Errorf("the CA bundle of the source cluster holds no certificate")
+	}
+	return verifyPeerCert(rawCerts, false, certPool, x509.ExtKeyUsageClientAuth, "client")
+}
+
 func getTLSConfiguration(kubevirt *v1.KubeVirt) *v1.TLSConfiguration {
 	tlsConfiguration := &v1.TLSConfiguration{
 		MinTLSVersion: v1.VersionTLS12,
@@ -25,6 +25,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/certificate"
 
+	"kubevirt.io/kubevirt/pkg/certificates/triple"
 	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 )
@@ -72,6 +73,7 @@ var _ = Describe("TLS", func() {
 	var certmanagers map[string]certificate.Manager
 	var clusterConfig *virtconfig.ClusterConfig
 	var kubeVirtInformer cache.SharedIndexInformer
+	var caBundle []byte
 
 	BeforeEach(func() {
 		// Bootstrap TLS for kubevirt
@@ -94,7 +96,7 @@ var _ = Describe("TLS", func() {
 			certmanagers[secret.Name] = &mockCertManager{crt: crt}
 			Expect(err).ToNot(HaveOccurred())
 		}
-		caBundle := cert.EncodeCertPEM(caCert.Leaf)
+		caBundle = cert.EncodeCertPEM(caCert.Leaf)
 		caManager = &mockCAManager{caBundle: caBundle}
 
 		kv := &v12.KubeVirt{
@@ -306,6 +308,40 @@ var _ = Describe("TLS", func() {
 		),
 	)
 
+	DescribeTable("on the migration tunnel should", func(clientSecret string, otherCA bool, expectedStatus int) {
+		sourceCABundle := caBundle
+		if otherCA {
+			otherCAKeyPair, err := triple.NewCA("other", time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			sourceCABundle = cert.EncodeCertPEM(otherCAKeyPair.Cert)
+		}
+		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var rawCerts [][]byte
+			for _, peerCert := range r.TLS.PeerCertificates {
+				rawCerts = append(rawCerts, peerCert.Raw)
+			}
+			if err := kvtls.VerifyMigrationTunnelClient(rawCerts, sourceCABundle); err != nil {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			fmt.Fprintln(w, "hello")
+		}))
+		srv.TLS = kvtls.SetupTLSForMigrationTunnel(certmanagers[components.VirtApiCertSecretName], clusterConfig)
+		srv.StartTLS()
+		defer srv.Close()
+		clientTLSConfig := kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[clientSecret], false)
+		// The migration tunnel is served with a virt-api certificate
+		clientTLSConfig.VerifyPeerCertificate = nil
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLSConfig}}
+		resp, err := client.Get(srv.URL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(expectedStatus))
+	},
+		Entry("accept virt-handler client certificates of the source cluster", components.VirtHandlerCertSecretName, false, http.StatusOK),
+		Entry("refuse virt-handler server certificates", components.VirtHandlerServerCertSecretName, false, http.StatusForbidden),
+		Entry("refuse certificates of another CA", components.VirtHandlerCertSecretName, true, http.StatusForbidden),
+	)
+
 	type configFunc func() *tls.Config
 
 	DescribeTable("should use updated kubevirt TLSConfiguration", func(serverTLSConfigFunc, clientTLSConfigFunc configFunc) {
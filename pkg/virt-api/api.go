@@ -62,10 +62,12 @@ import (
 	"kubevirt.io/kubevirt/pkg/healthz"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
 	"kubevirt.io/kubevirt/pkg/monitoring/profiler"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	mime "kubevirt.io/kubevirt/pkg/rest"
 	"kubevirt.io/kubevirt/pkg/rest/filter"
 	"kubevirt.io/kubevirt/pkg/service"
 	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 	"kubevirt.io/kubevirt/pkg/util/openapi"
 	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 	"kubevirt.io/kubevirt/pkg/virt-api/rest"
@@ -120,6 +122,7 @@ type virtAPIApp struct {
 	tlsConfig               *tls.Config
 	certificate             *tls.Certificate
 	consoleServerPort       int
+	migrationTunnelPort     int
 	certmanager             certificate2.Manager
 	handlerTLSConfiguration *tls.Config
 	handlerCertManager      certificate2.Manager
@@ -135,6 +138,9 @@ type virtAPIApp struct {
 
 	// indicates if controllers were started with or without CDI/DataSource support
 	hasCDIDataSource bool
+	// macAddressPool is only tracking the VMs and the VMIs when the MacAddressPool feature gate is enabled
+	hasMacAddressPool bool
+	macAddressPool    *macpool.Pool
 	// the channel used to trigger re-initialization.
 	reInitChan chan string
 }
@@ -357,6 +363,11 @@ func (app *virtAPIApp) composeSubresources() {
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.MoveCursorParam(subws)).
 			Operation(version.Version + "VNCScreenshot").
 			Doc("Get a PNG VNC screenshot of the specified VirtualMachineInstance."))
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("nbd")).
+			To(subresourceApp.NBDRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version + "NBD").
+			Doc("Open a websocket connection to the NBD server exporting the disks of a pull mode backup of the specified VirtualMachineInstance."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("usbredir")).
 			To(subresourceApp.USBRedirRequestHandler).
 			Param(definitions.NamespaceParam(subws)).
@@ -470,6 +481,17 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("migrate-check")).
+			To(subresourceApp.MigrateCheckVMIRequestHandler).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"MigrateCheck").
+			Doc("Check whether a VirtualMachineInstance can be live migrated without starting a migration.").
+			Writes(v1.VirtualMachineInstanceMigrateCheck{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceMigrateCheck{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMIAddVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -595,6 +617,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/console",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/nbd",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/portforward",
 						Namespaced: true,
@@ -651,6 +677,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/migrate-check",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -894,6 +924,9 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMRestores(w, r, app.clusterConfig, app.virtCli, informers)
 	})
+	http.HandleFunc(components.VMBackupValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMBackups(w, r, app.clusterConfig, app.virtCli)
+	})
 	http.HandleFunc(components.VMExportValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMExports(w, r, app.clusterConfig)
 	})
@@ -926,10 +959,10 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 func (app *virtAPIApp) registerMutatingWebhook(informers *webhooks.Informers) {
 
 	http.HandleFunc(components.VMMutatePath, func(w http.ResponseWriter, r *http.Request) {
-		mutating_webhook.ServeVMs(w, r, app.clusterConfig, app.virtCli)
+		mutating_webhook.ServeVMs(w, r, app.clusterConfig, app.virtCli, app.macAddressPool)
 	})
 	http.HandleFunc(components.VMIMutatePath, func(w http.ResponseWriter, r *http.Request) {
-		mutating_webhook.ServeVMIs(w, r, app.clusterConfig, informers)
+		mutating_webhook.ServeVMIs(w, r, app.clusterConfig, informers, app.macAddressPool)
 	})
 	http.HandleFunc(components.MigrationMutatePath, func(w http.ResponseWriter, r *http.Request) {
 		mutating_webhook.ServeMigrationCreate(w, r)
@@ -962,7 +995,7 @@ func (app *virtAPIApp) setupTLS(k8sCAManager kvtls.ClientCAManager, kubevirtCAMa
 
 func (app *virtAPIApp) startTLS(informerFactory controller.KubeInformerFactory) error {
 
-	errors := make(chan error)
+	errors := make(chan error, 2)
 	c := make(chan os.Signal, 1)
 
 	signal.Notify(c, os.Interrupt,
@@ -990,11 +1023,25 @@ func (app *virtAPIApp) startTLS(informerFactory controller.KubeInformerFactory)
 		TLSNextProto: map[string]func(*http.Server, *tls.Conn, http.Handler){},
 	}
 
+	// The migration tunnel is served on its own port, it is exposed outside of the cluster
+	tunnelServer := &http.Server{
+		Addr:      fmt.Sprintf("%s:%d", app.BindAddress, app.migrationTunnelPort),
+		Handler:   rest.NewMigrationTunnel(app.virtCli, app.handlerTLSConfiguration, app.clusterConfig),
+		TLSConfig: kvtls.SetupTLSForMigrationTunnel(app.certmanager, app.clusterConfig),
+		// The tunneled connections are hijacked, HTTP/2 is not supported
+		TLSNextProto: map[string]func(*http.Server, *tls.Conn, http.Handler){},
+	}
+
 	// start TLS server
 	go func() {
 		errors <- server.ListenAndServeTLS("", "")
 	}()
 
+	// start migration tunnel server
+	go func() {
+		errors <- tunnelServer.ListenAndServeTLS("", "")
+	}()
+
 	// start graceful shutdown handler
 	go func() {
 		select {
@@ -1025,6 +1072,8 @@ func (app *virtAPIApp) startTLS(informerFactory controller.KubeInformerFactory)
 		// Shutdown forces any existing connections that persist after the shutdown
 		// times out to be forced closed.
 		server.Close()
+		// Hijacked tunnel connections are not tracked by the server, they end with virt-api
+		tunnelServer.Close()
 	}()
 
 	// wait for server to exit
@@ -1096,6 +1145,15 @@ func (app *virtAPIApp) Run() {
 		log.Log.Infof("CDI not detected, DataSource integration disabled")
 	}
 
+	app.hasMacAddressPool = app.clusterConfig.MacAddressPoolEnabled()
+	if app.hasMacAddressPool {
+		app.macAddressPool = macpool.New()
+		if err := app.macAddressPool.Track(kubeInformerFactory.VirtualMachine(), kubeInformerFactory.VMI()); err != nil {
+			panic(err)
+		}
+		log.Log.Infof("MAC address pool enabled")
+	}
+
 	// It is safe to call kubeInformerFactory.Start multiple times.
 	// The function is idempotent and will only start the informers that
 	// have not been started yet
@@ -1137,6 +1195,12 @@ func (app *virtAPIApp) configModificationCallback() {
 		}
 		app.reInitChan <- "reinit due to CDI api change"
 	}
+
+	// The VMs and the VMIs are only watched when the MAC address pool is enabled
+	if app.clusterConfig.MacAddressPoolEnabled() != app.hasMacAddressPool {
+		log.Log.Infof("Reinitialize virt-api, the MAC address pool has been toggled")
+		app.reInitChan <- "reinit due to MAC address pool change"
+	}
 }
 
 // Update virt-api log verbosity on relevant config changes
@@ -1170,6 +1234,8 @@ func (app *virtAPIApp) AddFlags() {
 		"Only serve subresource endpoints")
 	flag.IntVar(&app.consoleServerPort, "console-server-port", DefaultConsoleServerPort,
 		"The port virt-handler listens on for console requests")
+	flag.IntVar(&app.migrationTunnelPort, "migration-tunnel-port", migrations.MigrationTunnelPort,
+		"The port the migration tunnel of cross-cluster migrations is served on")
 	flag.StringVar(&app.caConfigMapName, "ca-configmap-name", defaultCAConfigMapName,
 		"The name of configmap containing CA certificates to authenticate requests presenting client certificates with matching CommonName")
 	flag.StringVar(&app.tlsCertFilePath, "tls-cert-file", defaultTlsCertFilePath,
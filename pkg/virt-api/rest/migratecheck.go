@@ -0,0 +1,362 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+// migrateCheck runs the live migration pre-flight checks for a running VMI
+func (app *SubresourceAPIApp) migrateCheck(vmi *v1.VirtualMachineInstance) (*v1.VirtualMachineInstanceMigrateCheck, error) {
+	report := &v1.VirtualMachineInstanceMigrateCheck{
+		TypeMeta: k8smetav1.TypeMeta{
+			APIVersion: v1.GroupVersion.String(),
+			Kind:       "VirtualMachineInstanceMigrateCheck",
+		},
+		Namespace: vmi.Namespace,
+		Name:      vmi.Name,
+		NodeName:  vmi.Status.NodeName,
+	}
+
+	sourcePod, err := app.findSourcePod(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	report.Checks = append(report.Checks, checkLiveMigratable(vmi))
+
+	volumeCheck, err := app.checkVolumeAccessModes(vmi)
+	if err != nil {
+		return nil, err
+	}
+	report.Checks = append(report.Checks, volumeCheck)
+
+	pdbCheck, err := app.checkDisruptionBudgets(vmi, sourcePod)
+	if err != nil {
+		return nil, err
+	}
+	report.Checks = append(report.Checks, pdbCheck)
+
+	nodeChecks, targetNodes, err := app.checkTargetNodes(vmi, sourcePod)
+	if err != nil {
+		return nil, err
+	}
+	report.Checks = append(report.Checks, nodeChecks...)
+	report.TargetNodes = targetNodes
+
+	report.Migratable = true
+	for _, check := range report.Checks {
+		if !check.Passed {
+			report.Migratable = false
+		}
+	}
+	return report, nil
+}
+
+// findSourcePod returns the virt-launcher pod of the VMI on its current node
+func (app *SubresourceAPIApp) findSourcePod(vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error) {
+	labelSelector := labels.SelectorFromSet(labels.Set{
+		v1.AppLabel:       "virt-launcher",
+		v1.CreatedByLabel: string(vmi.UID),
+	})
+	podList, err := app.virtCli.CoreV1().Pods(vmi.Namespace).List(context.Background(), k8smetav1.ListOptions{LabelSelector: labelSelector.String()})
+	if err != nil {
+		return nil, err
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Spec.NodeName == vmi.Status.NodeName && pod.Status.Phase == k8sv1.PodRunning {
+			return pod, nil
+		}
+	}
+	return nil, fmt.Errorf("unable to find the virt-launcher pod of vmi %s on node %s", vmi.Name, vmi.Status.NodeName)
+}
+
+func checkLiveMigratable(vmi *v1.VirtualMachineInstance) v1.MigrateCheck {
+	check := v1.MigrateCheck{Type: v1.MigrateCheckLiveMigratable}
+
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceIsMigratable)
+	switch {
+	case cond == nil:
+		check.Message = "VMI does not report the LiveMigratable condition"
+	case cond.Status == k8sv1.ConditionTrue:
+		check.Passed = true
+	case cond.Message != "":
+		check.Message = fmt.Sprintf("%s: %s", cond.Reason, cond.Message)
+	default:
+		check.Message = cond.Reason
+	}
+	return check
+}
+
+func (app *SubresourceAPIApp) checkVolumeAccessModes(vmi *v1.VirtualMachineInstance) (v1.MigrateCheck, error) {
+	check := v1.MigrateCheck{Type: v1.MigrateCheckVolumeAccessModes}
+
+	var notShared []string
+	for _, volume := range vmi.Spec.Volumes {
+		claimName := storagetypes.PVCNameFromVirtVolume(&volume)
+		if claimName == "" {
+			continue
+		}
+		pvc, err := app.virtCli.CoreV1().PersistentVolumeClaims(vmi.Namespace).Get(context.Background(), claimName, k8smetav1.GetOptions{})
+		if err != nil {
+			return check, err
+		}
+		if !storagetypes.HasSharedAccessMode(pvc.Spec.AccessModes) {
+			notShared = append(notShared, claimName)
+		}
+	}
+
+	if len(notShared) > 0 {
+		check.Message = fmt.Sprintf("persistent volume claims without ReadWriteMany access mode: %s", strings.Join(notShared, ", "))
+		return check, nil
+	}
+	check.Passed = true
+	return check, nil
+}
+
+// checkDisruptionBudgets verifies that the PodDisruptionBudgets selecting the virt-launcher pod
+// allow a disruption. The disruption budget KubeVirt creates for the VMI itself is ignored,
+// it is adjusted for the migration by virt-controller.
+func (app *SubresourceAPIApp) checkDisruptionBudgets(vmi *v1.VirtualMachineInstance, sourcePod *k8sv1.Pod) (v1.MigrateCheck, error) {
+	check := v1.MigrateCheck{Type: v1.MigrateCheckDisruptionBudget}
+
+	pdbs, err := app.virtCli.PolicyV1().PodDisruptionBudgets(vmi.Namespace).List(context.Background(), k8smetav1.ListOptions{})
+	if err != nil {
+		return check, err
+	}
+
+	var exhausted []string
+	for i := range pdbs.Items {
+		pdb := &pdbs.Items[i]
+		if k8smetav1.IsControlledBy(pdb, vmi) {
+			continue
+		}
+		selector, err := k8smetav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || selector.Empty() || !selector.Matches(labels.Set(sourcePod.Labels)) {
+			continue
+		}
+		if pdb.Status.DisruptionsAllowed < 1 {
+			exhausted = append(exhausted, pdb.Name)
+		}
+	}
+
+	if len(exhausted) > 0 {
+		check.Message = fmt.Sprintf("pod disruption budgets without disruptions allowed: %s", strings.Join(exhausted, ", "))
+		return check, nil
+	}
+	check.Passed = true
+	return check, nil
+}
+
+// checkTargetNodes checks which nodes could host the migration target. A node has to
+// provide the host-model CPU of the VMI, match the node selector and tolerate the taints
+// of the source pod and have enough free capacity for its resource requests.
+func (app *SubresourceAPIApp) checkTargetNodes(vmi *v1.VirtualMachineInstance, sourcePod *k8sv1.Pod) ([]v1.MigrateCheck, []string, error) {
+	nodeList, err := app.virtCli.CoreV1().Nodes().List(context.Background(), k8smetav1.ListOptions{
+		LabelSelector: v1.NodeSchedulable + "=true",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var candidates []*k8sv1.Node
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if node.Name != vmi.Status.NodeName && !node.Spec.Unschedulable && isNodeReady(node) {
+			candidates = append(candidates, node)
+		}
+	}
+
+	var checks []v1.MigrateCheck
+	if cpu := vmi.Spec.Domain.CPU; cpu != nil && cpu.Model == v1.CPUModeHostModel {
+		sourceNode, err := app.virtCli.CoreV1().Nodes().Get(context.Background(), vmi.Status.NodeName, k8smetav1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		hostModelCheck := v1.MigrateCheck{Type: v1.MigrateCheckHostModelCPU}
+		var suitable []*k8sv1.Node
+		if requiredNodeLabels, err := migrations.HostModelNodeSelector(sourceNode, sourcePod); err != nil {
+			hostModelCheck.Message = err.Error()
+		} else {
+			for _, node := range candidates {
+				if migrations.IsNodeSuitableForHostModelMigration(node, requiredNodeLabels) {
+					suitable = append(suitable, node)
+				}
+			}
+			if len(suitable) == 0 {
+				hostModelCheck.Message = fmt.Sprintf("no node is suitable to run the required CPU model / required features: %v", requiredNodeLabels)
+			} else {
+				hostModelCheck.Passed = true
+			}
+		}
+		checks = append(checks, hostModelCheck)
+		candidates = suitable
+	}
+
+	requests := podRequests(sourcePod)
+
+	nodeSelector := labels.Set{}
+	for key, value := range sourcePod.Spec.NodeSelector {
+		// The CPU labels of the target pod are covered by the host-model check
+		if !strings.HasPrefix(key, v1.CPUFeatureLabel) && !strings.HasPrefix(key, v1.SupportedHostModelMigrationCPU) {
+			nodeSelector[key] = value
+		}
+	}
+
+	var targetNodes []string
+	for _, node := range candidates {
+		if !labels.SelectorFromSet(nodeSelector).Matches(labels.Set(node.Labels)) ||
+			!toleratesTaints(sourcePod.Spec.Tolerations, node.Spec.Taints) {
+			continue
+		}
+		allocated, err := app.allocatedNodeResources(node.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if fitsNode(requests, allocated, node.Status.Allocatable) {
+			targetNodes = append(targetNodes, node.Name)
+		}
+	}
+	sort.Strings(targetNodes)
+
+	capacityCheck := v1.MigrateCheck{Type: v1.MigrateCheckTargetNodeCapacity, Passed: len(targetNodes) > 0}
+	if !capacityCheck.Passed {
+		capacityCheck.Message = fmt.Sprintf("no node has the capacity to run the migration target (cpu: %s, memory: %s)",
+			requests.Cpu().String(), requests.Memory().String())
+	}
+	checks = append(checks, capacityCheck)
+
+	return checks, targetNodes, nil
+}
+
+// allocatedNodeResources sums up the resource requests of the non-terminated pods on the node.
+// Only the pods of the node are listed, the candidate nodes were already filtered by their labels and taints.
+func (app *SubresourceAPIApp) allocatedNodeResources(nodeName string) (k8sv1.ResourceList, error) {
+	podList, err := app.virtCli.CoreV1().Pods(k8sv1.NamespaceAll).List(context.Background(), k8smetav1.ListOptions{
+		FieldSelector: fields.AndSelectors(
+			fields.OneTermEqualSelector("spec.nodeName", nodeName),
+			fields.OneTermNotEqualSelector("status.phase", string(k8sv1.PodSucceeded)),
+			fields.OneTermNotEqualSelector("status.phase", string(k8sv1.PodFailed)),
+		).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	allocated := k8sv1.ResourceList{}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Spec.NodeName != nodeName || pod.Status.Phase == k8sv1.PodSucceeded || pod.Status.Phase == k8sv1.PodFailed {
+			continue
+		}
+		for name, quantity := range podRequests(pod) {
+			sum := allocated[name]
+			sum.Add(quantity)
+			allocated[name] = sum
+		}
+	}
+	return allocated, nil
+}
+
+func podRequests(pod *k8sv1.Pod) k8sv1.ResourceList {
+	requests := k8sv1.ResourceList{
+		k8sv1.ResourceCPU:    resource.Quantity{},
+		k8sv1.ResourceMemory: resource.Quantity{},
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, name := range []k8sv1.ResourceName{k8sv1.ResourceCPU, k8sv1.ResourceMemory} {
+			if quantity, exists := container.Resources.Requests[name]; exists {
+				sum := requests[name]
+				sum.Add(quantity)
+				requests[name] = sum
+			}
+		}
+	}
+	if pod.Spec.Overhead != nil {
+		for _, name := range []k8sv1.ResourceName{k8sv1.ResourceCPU, k8sv1.ResourceMemory} {
+			if quantity, exists := pod.Spec.Overhead[name]; exists {
+				sum := requests[name]
+				sum.Add(quantity)
+				requests[name] = sum
+			}
+		}
+	}
+	return requests
+}
+
+func fitsNode(requests, allocated, allocatable k8sv1.ResourceList) bool {
+	for name, request := range requests {
+		free := allocatable[name].DeepCopy()
+		if used, exists := allocated[name]; exists {
+			free.Sub(used)
+		}
+		if free.Cmp(request) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func toleratesTaints(tolerations []k8sv1.Toleration, taints []k8sv1.Taint) bool {
+	for i := range taints {
+		taint := &taints[i]
+		if taint.Effect != k8sv1.TaintEffectNoSchedule && taint.Effect != k8sv1.TaintEffectNoExecute {
+			continue
+		}
+		tolerated := false
+		for j := range tolerations {
+			if tolerations[j].ToleratesTaint(taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+	return true
+}
+
+func isNodeReady(node *k8sv1.Node) bool {
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == k8sv1.NodeReady {
+			return cond.Status == k8sv1.ConditionTrue
+		}
+	}
+	return false
+}
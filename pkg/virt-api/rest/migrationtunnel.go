@@ -0,0 +1,177 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2025 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// MigrationTunnel forwards the migration streams of cross-cluster migrations from the source
+// nodes in another cluster to the target nodes of this cluster.
+//
+// Every connection starts with a CONNECT request for a port of the target node of a receiving VMI.
+// The client certificate of the connection has to be a virt-handler client certificate signed by
+// the CA bundle of the source cluster, which is part of the receiving migration.
+type MigrationTunnel struct {
+	client           kubecli.KubevirtClient
+	handlerTLSConfig *tls.Config
+	clusterConfig    *virtconfig.ClusterConfig
+}
+
+// NewMigrationTunnel creates the migration tunnel, it connects to the target nodes with the client
+// certificate of virt-handler
+func NewMigrationTunnel(client kubecli.KubevirtClient, handlerTLSConfig *tls.Config, clusterConfig *virtconfig.ClusterConfig) *MigrationTunnel {
+	return &MigrationTunnel{
+		client:           client,
+		handlerTLSConfig: handlerTLSConfig,
+		clusterConfig:    clusterConfig,
+	}
+}
+
+func (t *MigrationTunnel) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodConnect {
+		http.Error(w, "only CONNECT requests are served", http.StatusMethodNotAllowed)
+		return
+	}
+	if !t.clusterConfig.CrossClusterLiveMigrationEnabled() {
+		http.Error(w, fmt.Sprintf("the %s feature gate is not enabled", virtconfig.CrossClusterLiveMigrationGate), http.StatusForbidden)
+		return
+	}
+	namespace, name, port, err := migrations.ParseMigrationTunnelPath(r.URL.Path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	targetAddress, status, err := t.targetAddress(r, namespace, name, port)
+	if err != nil {
+		log.Log.Reason(err).Warningf("Refused migration tunnel connection to %s/%s", namespace, name)
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	targetConn, err := tls.Dial("tcp", targetAddress, t.handlerTLSConfig)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to connect to the target node %s of %s/%s", targetAddress, namespace, name)
+		http.Error(w, "failed to connect to the target node", http.StatusBadGateway)
+		return
+	}
+	defer targetConn.Close()
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "the connection can not be tunneled", http.StatusInternalServerError)
+		return
+	}
+	conn, buf, err := hijacker.Hijack()
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to take over the migration tunnel connection")
+		return
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte("HTTP/1.1 200 OK\r\n\r\n")); err != nil {
+		return
+	}
+
+	log.Log.Infof("Tunneling migration connection of %s/%s to %s", namespace, name, targetAddress)
+	errChan := make(chan error, 2)
+	go func() {
+		// The source may have sent data right after its request
+		_, err := io.Copy(targetConn, buf)
+		errChan <- err
+	}()
+	go func() {
+		_, err := io.Copy(conn, targetConn)
+		errChan <- err
+	}()
+	if err := <-errChan; err != nil {
+		log.Log.Reason(err).Errorf("Migration tunnel connection of %s/%s closed", namespace, name)
+	}
+}
+
+// targetAddress returns the address of the target node port the connection is forwarded to, if the
+// VMI receives a migration from the cluster which issued the client certificate of the connection
+func (t *MigrationTunnel) targetAddress(r *http.Request, namespace, name string, port int) (string, int, error) {
+	vmi, err := t.client.VirtualMachineInstance(namespace).Get(context.Background(), name, &k8smetav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return "", http.StatusNotFound, err
+	} else if err != nil {
+		return "", http.StatusInternalServerError, err
+	}
+	migrationID, ok := vmi.Annotations[v1.MigrationReceiverAnnotation]
+	if !ok {
+		return "", http.StatusNotFound, fmt.Errorf("the VMI %s/%s does not receive a migration", namespace, name)
+	}
+	state := vmi.Status.MigrationState
+	if state == nil || state.TargetNodeAddress == "" {
+		return "", http.StatusNotFound, fmt.Errorf("the target node of the VMI %s/%s does not listen yet", namespace, name)
+	}
+	if _, ok := state.TargetDirectMigrationNodePorts[strconv.Itoa(port)]; !ok {
+		return "", http.StatusNotFound, fmt.Errorf("the target node of the VMI %s/%s does not listen on port %d", namespace, name, port)
+	}
+
+	migrationList, err := t.client.VirtualMachineInstanceMigration(namespace).List(&k8smetav1.ListOptions{})
+	if err != nil {
+		return "", http.StatusInternalServerError, err
+	}
+	var receiving *v1.VirtualMachineInstanceMigration
+	for i := range migrationList.Items {
+		migration := &migrationList.Items[i]
+		if migration.Spec.Receive != nil && migration.Spec.Receive.MigrationID == migrationID &&
+			migration.Spec.VMIName == name && migration.UID == state.MigrationUID && !migration.IsFinal() {
+			receiving = migration
+			break
+		}
+	}
+	if receiving == nil {
+		return "", http.StatusNotFound, fmt.Errorf("no receiving migration of the VMI %s/%s is running", namespace, name)
+	}
+
+	if r.TLS == nil {
+		return "", http.StatusForbidden, fmt.Errorf("no client certificate provided")
+	}
+	var rawCerts [][]byte
+	for _, peerCert := range r.TLS.PeerCertificates {
+		rawCerts = append(rawCerts, peerCert.Raw)
+	}
+	if err := kvtls.VerifyMigrationTunnelClient(rawCerts, receiving.Spec.Receive.SourceCABundle); err != nil {
+		return "", http.StatusForbidden, err
+	}
+
+	return net.JoinHostPort(state.TargetNodeAddress, strconv.Itoa(port)), http.StatusOK, nil
+}
@@ -0,0 +1,236 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2025 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/certificates/triple"
+	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Migration tunnel", func() {
+	const migrationID = "cross-cluster-migration"
+
+	var (
+		virtClient     *kubecli.MockKubevirtClient
+		vmiClient      *kubecli.MockVirtualMachineInstanceInterface
+		migrateClient  *kubecli.MockVirtualMachineInstanceMigrationInterface
+		targetListener net.Listener
+		targetPort     int
+		sourceCA       *triple.KeyPair
+		clientCert     tls.Certificate
+		vmi            *v1.VirtualMachineInstance
+		migration      *v1.VirtualMachineInstanceMigration
+	)
+
+	keyPairCertificate := func(keyPair *triple.KeyPair) tls.Certificate {
+		return tls.Certificate{Certificate: [][]byte{keyPair.Cert.Raw}, PrivateKey: keyPair.Key}
+	}
+
+	newClusterConfig := func(featureGates ...string) *virtconfig.ClusterConfig {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		return config
+	}
+
+	startTunnel := func(clusterConfig *virtconfig.ClusterConfig) *httptest.Server {
+		srv := httptest.NewUnstartedServer(NewMigrationTunnel(virtClient, &tls.Config{InsecureSkipVerify: true}, clusterConfig))
+		srv.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+		srv.StartTLS()
+		return srv
+	}
+
+	connect := func(srv *httptest.Server, method, path string, clientCert tls.Certificate) (net.Conn, *bufio.Reader, *http.Response) {
+		conn, err := tls.Dial("tcp", srv.Listener.Addr().String(), &tls.Config{
+			InsecureSkipVerify: true,
+			Certificates:       []tls.Certificate{clientCert},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		req := &http.Request{
+			Method: method,
+			URL:    &url.URL{Path: path},
+			Host:   srv.Listener.Addr().String(),
+			Header: http.Header{},
+		}
+		Expect(req.Write(conn)).To(Succeed())
+		reader := bufio.NewReader(conn)
+		resp, err := http.ReadResponse(reader, req)
+		Expect(err).ToNot(HaveOccurred())
+		return conn, reader, resp
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		migrateClient = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+		virtClient.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstanceMigration(k8smetav1.NamespaceDefault).Return(migrateClient).AnyTimes()
+
+		var err error
+		sourceCA, err = triple.NewCA("kubevirt.io", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		clientKeyPair, err := triple.NewClientKeyPair(sourceCA, "kubevirt.io:system:client:virt-handler", nil, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		clientCert = keyPairCertificate(clientKeyPair)
+
+		targetCA, err := triple.NewCA("kubevirt.io", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		nodeKeyPair, err := triple.NewServerKeyPair(targetCA, "kubevirt.io:system:node:virt-handler", "", "", "", nil, nil, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		targetListener, err = tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			Certificates: []tls.Certificate{keyPairCertificate(nodeKeyPair)},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(targetListener.Close)
+		targetPort = targetListener.Addr().(*net.TCPAddr).Port
+
+		migration = &v1.VirtualMachineInstanceMigration{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				Name:      "receiving-migration",
+				Namespace: k8smetav1.NamespaceDefault,
+				UID:       types.UID("receiving-migration-uid"),
+			},
+			Spec: v1.VirtualMachineInstanceMigrationSpec{
+				VMIName: testVMIName,
+				Receive: &v1.VirtualMachineInstanceMigrationReceive{
+					MigrationID:    migrationID,
+					SourceCABundle: cert.EncodeCertPEM(sourceCA.Cert),
+				},
+			},
+			Status: v1.VirtualMachineInstanceMigrationStatus{
+				Phase: v1.MigrationScheduled,
+			},
+		}
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				Name:        testVMIName,
+				Namespace:   k8smetav1.NamespaceDefault,
+				Annotations: map[string]string{v1.MigrationReceiverAnnotation: migrationID},
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				MigrationState: &v1.VirtualMachineInstanceMigrationState{
+					MigrationUID:                   migration.UID,
+					TargetNodeAddress:              "127.0.0.1",
+					TargetDirectMigrationNodePorts: map[string]int{strconv.Itoa(targetPort): 0},
+				},
+			},
+		}
+		vmiClient.EXPECT().Get(context.Background(), testVMIName, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ *k8smetav1.GetOptions) (*v1.VirtualMachineInstance, error) {
+				return vmi, nil
+			}).AnyTimes()
+		migrateClient.EXPECT().List(gomock.Any()).DoAndReturn(
+			func(_ *k8smetav1.ListOptions) (*v1.VirtualMachineInstanceMigrationList, error) {
+				return &v1.VirtualMachineInstanceMigrationList{Items: []v1.VirtualMachineInstanceMigration{*migration}}, nil
+			}).AnyTimes()
+	})
+
+	It("should forward the connection to the target node", func() {
+		srv := startTunnel(newClusterConfig(virtconfig.CrossClusterLiveMigrationGate))
+		defer srv.Close()
+
+		received := make(chan string, 1)
+		go func() {
+			defer GinkgoRecover()
+			conn, err := targetListener.Accept()
+			Expect(err).ToNot(HaveOccurred())
+			defer conn.Close()
+			var bytes [1024]byte
+			n, err := conn.Read(bytes[0:])
+			Expect(err).ToNot(HaveOccurred())
+			received <- string(bytes[:n])
+			_, err = conn.Write([]byte("from the target node"))
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		conn, reader, resp := connect(srv, http.MethodConnect, migrations.MigrationTunnelPath(k8smetav1.NamespaceDefault, testVMIName, targetPort), clientCert)
+		defer conn.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+		_, err := conn.Write([]byte("from the source node"))
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(received).Should(Receive(Equal("from the source node")))
+
+		var bytes [1024]byte
+		n, err := reader.Read(bytes[0:])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(bytes[:n])).To(Equal("from the target node"))
+	})
+
+	DescribeTable("should refuse the connection", func(featureGates []string, method string, modify func(), useOtherCA bool, expectedStatus int) {
+		srv := startTunnel(newClusterConfig(featureGates...))
+		defer srv.Close()
+		if modify != nil {
+			modify()
+		}
+		certificate := clientCert
+		if useOtherCA {
+			otherCA, err := triple.NewCA("other", time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			otherKeyPair, err := triple.NewClientKeyPair(otherCA, "kubevirt.io:system:client:virt-handler", nil, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			certificate = keyPairCertificate(otherKeyPair)
+		}
+
+		conn, _, resp := connect(srv, method, migrations.MigrationTunnelPath(k8smetav1.NamespaceDefault, testVMIName, targetPort), certificate)
+		defer conn.Close()
+		Expect(resp.StatusCode).To(Equal(expectedStatus))
+	},
+		Entry("without the feature gate", nil, http.MethodConnect, nil, false, http.StatusForbidden),
+		Entry("for other methods than CONNECT", []string{virtconfig.CrossClusterLiveMigrationGate}, http.MethodGet, nil, false, http.StatusMethodNotAllowed),
+		Entry("to a VMI which does not receive a migration", []string{virtconfig.CrossClusterLiveMigrationGate}, http.MethodConnect, func() {
+			delete(vmi.Annotations, v1.MigrationReceiverAnnotation)
+		}, false, http.StatusNotFound),
+		Entry("to a port the target node does not listen on", []string{virtconfig.CrossClusterLiveMigrationGate}, http.MethodConnect, func() {
+			vmi.Status.MigrationState.TargetDirectMigrationNodePorts = map[string]int{"1": 0}
+		}, false, http.StatusNotFound),
+		Entry("if the receiving migration is final", []string{virtconfig.CrossClusterLiveMigrationGate}, http.MethodConnect, func() {
+			migration.Status.Phase = v1.MigrationFailed
+		}, false, http.StatusNotFound),
+		Entry("if the receiving migration has another migration ID", []string{virtconfig.CrossClusterLiveMigrationGate}, http.MethodConnect, func() {
+			migration.Spec.Receive.MigrationID = "other-migration"
+		}, false, http.StatusNotFound),
+		Entry("from a client certificate of another CA", []string{virtconfig.CrossClusterLiveMigrationGate}, http.MethodConnect, nil, true, http.StatusForbidden),
+	)
+})
@@ -0,0 +1,36 @@
+package rest
+
+import (
+	"fmt"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+// NBDRequestHandler streams a connection to the NBD server exporting the disks of a pull mode backup
+func (app *SubresourceAPIApp) NBDRequestHandler(request *restful.Request, response *restful.Response) {
+	streamer := NewRawStreamer(
+		app.FetchVirtualMachineInstance,
+		validateVMIForNBD,
+		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.NBDURI(vmi)
+		}),
+	)
+
+	streamer.Handle(request, response)
+}
+
+func validateVMIForNBD(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if !vmi.IsRunning() {
+		return errors.NewBadRequest(vmiNotRunning)
+	}
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.Phase == v1.BackupVolumeExported {
+			return nil
+		}
+	}
+	return errors.NewBadRequest(fmt.Sprintf("VMI %s does not have an exported backup", vmi.Name))
+}
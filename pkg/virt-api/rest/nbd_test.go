@@ -0,0 +1,28 @@
+package rest
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+)
+
+var _ = Describe("NBD", func() {
+	DescribeTable("should validate the VMI", func(phase v1.VirtualMachineInstancePhase, volumePhase v1.VolumePhase, expectedErr string) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Status.Phase = phase
+		vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "backup-target", Phase: volumePhase}}
+
+		err := validateVMIForNBD(vmi)
+		if expectedErr == "" {
+			Expect(err).To(BeNil())
+		} else {
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		}
+	},
+		Entry("with an exported backup", v1.Running, v1.BackupVolumeExported, ""),
+		Entry("when the VMI is not running", v1.Scheduled, v1.BackupVolumeExported, vmiNotRunning),
+		Entry("when the backup is not exported yet", v1.Running, v1.BackupVolumeInProgress, "VMI testvmi does not have an exported backup"),
+	)
+})
@@ -962,6 +962,33 @@ func (app *SubresourceAPIApp) FilesystemList(request *restful.Request, response
 	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceFileSystemList{})
 }
 
+// MigrateCheckVMIRequestHandler handles the subresource reporting whether a VMI can be live migrated
+func (app *SubresourceAPIApp) MigrateCheckVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	vmi, statusErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	if !vmi.IsRunning() {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf(vmiNotRunning)), response)
+		return
+	}
+
+	report, err := app.migrateCheck(vmi)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	if err := response.WriteEntity(report); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
 func generateVMVolumeRequestPatch(vm *v1.VirtualMachine, volumeRequest *v1.VirtualMachineVolumeRequest) (string, error) {
 	vmCopy := vm.DeepCopy()
 
@@ -52,6 +52,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/util/status"
 
 	k8sv1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -1769,6 +1770,227 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		)
 	})
 
+	Context("Subresource api - MigrateCheckVMIRequestHandler", func() {
+		const (
+			sourceNode   = "node01"
+			hostCPUModel = "Skylake"
+		)
+
+		var vmi *v1.VirtualMachineInstance
+		var sourcePod *k8sv1.Pod
+		var pvc *k8sv1.PersistentVolumeClaim
+
+		newNode := func(name string, labels map[string]string) *k8sv1.Node {
+			node := &k8sv1.Node{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{v1.NodeSchedulable: "true"},
+				},
+				Status: k8sv1.NodeStatus{
+					Allocatable: k8sv1.ResourceList{
+						k8sv1.ResourceCPU:    resource.MustParse("4"),
+						k8sv1.ResourceMemory: resource.MustParse("8Gi"),
+					},
+					Conditions: []k8sv1.NodeCondition{
+						{Type: k8sv1.NodeReady, Status: k8sv1.ConditionTrue},
+					},
+				},
+			}
+			for key, value := range labels {
+				node.Labels[key] = value
+			}
+			return node
+		}
+
+		newPod := func(name, nodeName string, cpu string) *k8sv1.Pod {
+			return &k8sv1.Pod{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:      name,
+					Namespace: k8smetav1.NamespaceDefault,
+				},
+				Spec: k8sv1.PodSpec{
+					NodeName: nodeName,
+					Containers: []k8sv1.Container{{
+						Name: "compute",
+						Resources: k8sv1.ResourceRequirements{
+							Requests: k8sv1.ResourceList{
+								k8sv1.ResourceCPU:    resource.MustParse(cpu),
+								k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+							},
+						},
+					}},
+				},
+				Status: k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+			}
+		}
+
+		addObjects := func(objects ...runtime.Object) {
+			for _, obj := range objects {
+				Expect(kubeClient.Tracker().Add(obj)).To(Succeed())
+			}
+		}
+
+		runMigrateCheck := func() *v1.VirtualMachineInstanceMigrateCheck {
+			addObjects(sourcePod, pvc)
+			vmiClient.EXPECT().Get(context.Background(), vmi.Name, &k8smetav1.GetOptions{}).Return(vmi, nil)
+
+			app.MigrateCheckVMIRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			report := &v1.VirtualMachineInstanceMigrateCheck{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), report)).To(Succeed())
+			return report
+		}
+
+		expectCheck := func(report *v1.VirtualMachineInstanceMigrateCheck, checkType v1.MigrateCheckType, passed bool) {
+			for _, check := range report.Checks {
+				if check.Type == checkType {
+					Expect(check.Passed).To(Equal(passed), check.Message)
+					return
+				}
+			}
+			Fail(fmt.Sprintf("check %s is not part of the report", checkType))
+		}
+
+		BeforeEach(func() {
+			kubeClient.Fake.PrependReactor("*", "*", testing.ObjectReaction(kubeClient.Tracker()))
+			virtClient.EXPECT().PolicyV1().Return(kubeClient.PolicyV1()).AnyTimes()
+
+			request.PathParameters()["name"] = testVMIName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+			response.SetRequestAccepts(restful.MIME_JSON)
+
+			vmi = api.NewMinimalVMI(testVMIName)
+			vmi.UID = "vmi-uid"
+			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
+			vmi.Spec.Volumes = []v1.Volume{{
+				Name: "disk",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "disk-pvc"},
+					},
+				},
+			}}
+			vmi.Status.Phase = v1.Running
+			vmi.Status.NodeName = sourceNode
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{Type: v1.VirtualMachineInstanceIsMigratable, Status: k8sv1.ConditionTrue},
+			}
+
+			sourcePod = newPod("virt-launcher-testvmi", sourceNode, "1")
+			sourcePod.Labels = map[string]string{
+				v1.AppLabel:       "virt-launcher",
+				v1.CreatedByLabel: string(vmi.UID),
+				"app":             "database",
+			}
+			sourcePod.Spec.NodeSelector = map[string]string{v1.NodeSchedulable: "true"}
+
+			pvc = &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:      "disk-pvc",
+					Namespace: k8smetav1.NamespaceDefault,
+				},
+				Spec: k8sv1.PersistentVolumeClaimSpec{
+					AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteMany},
+				},
+			}
+
+			addObjects(
+				newNode(sourceNode, map[string]string{v1.HostModelCPULabel + hostCPUModel: "true"}),
+				newNode("node02", map[string]string{v1.SupportedHostModelMigrationCPU + hostCPUModel: "true"}),
+				newNode("node03", nil),
+				// The disruption budget of the VMI itself must be ignored
+				&policyv1.PodDisruptionBudget{
+					ObjectMeta: k8smetav1.ObjectMeta{
+						Name:            "kubevirt-disruption-budget-testvmi",
+						Namespace:       k8smetav1.NamespaceDefault,
+						OwnerReferences: []k8smetav1.OwnerReference{*k8smetav1.NewControllerRef(vmi, v1.VirtualMachineInstanceGroupVersionKind)},
+					},
+					Spec: policyv1.PodDisruptionBudgetSpec{
+						Selector: &k8smetav1.LabelSelector{MatchLabels: map[string]string{v1.CreatedByLabel: string(vmi.UID)}},
+					},
+				},
+			)
+		})
+
+		It("should fail if the VMI is not running", func() {
+			vmi.Status.Phase = v1.Scheduled
+			vmiClient.EXPECT().Get(context.Background(), vmi.Name, &k8smetav1.GetOptions{}).Return(vmi, nil)
+
+			app.MigrateCheckVMIRequestHandler(request, response)
+
+			status := ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+			Expect(status.Error()).To(ContainSubstring(vmiNotRunning))
+		})
+
+		It("should report a migratable VMI and the nodes which can host the target", func() {
+			report := runMigrateCheck()
+
+			Expect(report.Migratable).To(BeTrue())
+			Expect(report.NodeName).To(Equal(sourceNode))
+			Expect(report.Checks).To(HaveLen(5))
+			Expect(report.TargetNodes).To(Equal([]string{"node02"}))
+		})
+
+		It("should ignore terminated pods when computing the free capacity", func() {
+			terminated := newPod("terminated", "node02", "3500m")
+			terminated.Status.Phase = k8sv1.PodSucceeded
+			addObjects(terminated)
+
+			report := runMigrateCheck()
+
+			Expect(report.Migratable).To(BeTrue())
+			Expect(report.TargetNodes).To(Equal([]string{"node02"}))
+		})
+
+		It("should only list the non-terminated pods of the candidate nodes", func() {
+			var fieldSelectors []string
+			kubeClient.Fake.PrependReactor("list", "pods", func(action testing.Action) (bool, runtime.Object, error) {
+				// The pods of the VMI are listed by their labels
+				if fieldSelector := action.(testing.ListAction).GetListRestrictions().Fields; !fieldSelector.Empty() {
+					fieldSelectors = append(fieldSelectors, fieldSelector.String())
+				}
+				return false, nil, nil
+			})
+
+			runMigrateCheck()
+
+			Expect(fieldSelectors).To(ConsistOf("spec.nodeName=node02,status.phase!=Failed,status.phase!=Succeeded"))
+		})
+
+		DescribeTable("should report a VMI which can't be migrated", func(modify func(), failedCheck v1.MigrateCheckType) {
+			modify()
+
+			report := runMigrateCheck()
+
+			Expect(report.Migratable).To(BeFalse())
+			expectCheck(report, failedCheck, false)
+		},
+			Entry("if it is not live migratable", func() {
+				vmi.Status.Conditions[0].Status = k8sv1.ConditionFalse
+				vmi.Status.Conditions[0].Reason = v1.VirtualMachineInstanceReasonDisksNotMigratable
+			}, v1.MigrateCheckLiveMigratable),
+			Entry("if a volume can't be shared between nodes", func() {
+				pvc.Spec.AccessModes = []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce}
+			}, v1.MigrateCheckVolumeAccessModes),
+			Entry("if a disruption budget of the pod does not allow a disruption", func() {
+				addObjects(&policyv1.PodDisruptionBudget{
+					ObjectMeta: k8smetav1.ObjectMeta{Name: "database", Namespace: k8smetav1.NamespaceDefault},
+					Spec: policyv1.PodDisruptionBudgetSpec{
+						Selector: &k8smetav1.LabelSelector{MatchLabels: map[string]string{"app": "database"}},
+					},
+				})
+			}, v1.MigrateCheckDisruptionBudget),
+			Entry("if no other node provides the host-model CPU", func() {
+				vmi.Status.NodeName = "node03"
+				sourcePod.Spec.NodeName = "node03"
+			}, v1.MigrateCheckHostModelCPU),
+			Entry("if no node has the capacity for the target", func() {
+				addObjects(newPod("other", "node02", "3500m"))
+			}, v1.MigrateCheckTargetNodeCapacity),
+		)
+	})
+
 	Context("Subresource api - Guest OS Info", func() {
 		type subRes func(request *restful.Request, response *restful.Response)
 
@@ -41,6 +41,7 @@ func ValidateVirtualMachineInstanceArm64Setting(field *k8sfield.Path, spec *v1.V
 	validateDiskBus(field, spec, &statusCauses)
 	validateWatchdog(field, spec, &statusCauses)
 	validateSoundDevice(field, spec, &statusCauses)
+	validatePanicDevice(field, spec, &statusCauses)
 	return statusCauses
 }
 
@@ -134,6 +135,19 @@ func validateSoundDevice(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSp
 	}
 }
 
+func validatePanicDevice(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.Devices.PanicDevice != nil {
+		model := spec.Domain.Devices.PanicDevice.Model
+		if model != "" && model != v1.PanicDeviceModelPVPanic {
+			*statusCauses = append(*statusCauses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "Arm64 only supports the pvpanic panic device model",
+				Field:   field.Child("domain", "devices", "panicDevice", "model").String(),
+			})
+		}
+	}
+}
+
 // setDefaultCPUModel set default cpu model to host-passthrough
 func setDefaultArm64CPUModel(spec *v1.VirtualMachineInstanceSpec) {
 	if spec.Domain.CPU == nil {
@@ -0,0 +1,75 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package mutators
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/macpool"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// assignMacAddresses sets a MAC address from the pool on the interfaces not specifying one,
+// except for the interfaces in skip.
+// The MAC addresses already used by another VM or VMI are reported as warnings:
+// the duplicates are not rejected, since they may be intended by the user.
+func assignMacAddresses(clusterConfig *virtconfig.ClusterConfig, pool *macpool.Pool, holder macpool.Holder, interfaces []v1.Interface, skip map[string]struct{}) ([]string, error) {
+	if pool == nil || !clusterConfig.MacAddressPoolEnabled() {
+		return nil, nil
+	}
+
+	ranges, err := macpool.ParseRanges(clusterConfig.GetMacAddressPool())
+	if err != nil {
+		return nil, fmt.Errorf("invalid MAC address pool configuration: %v", err)
+	}
+
+	var warnings []string
+	for i := range interfaces {
+		iface := &interfaces[i]
+		if _, exists := skip[iface.Name]; exists {
+			continue
+		}
+		if iface.MacAddress != "" {
+			if holders := pool.Holders(holder, iface.MacAddress); len(holders) > 0 {
+				warnings = append(warnings, duplicateMacAddressWarning(iface, holders))
+			}
+			continue
+		}
+		if len(ranges) == 0 {
+			continue
+		}
+		iface.MacAddress, err = pool.Allocate(holder, ranges)
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign a MAC address to interface %s: %v", iface.Name, err)
+		}
+	}
+	return warnings, nil
+}
+
+func duplicateMacAddressWarning(iface *v1.Interface, holders []macpool.Holder) string {
+	var names []string
+	for _, holder := range holders {
+		names = append(names, holder.String())
+	}
+	return fmt.Sprintf("MAC address %s of interface %s is already used by %s", iface.MacAddress, iface.Name, strings.Join(names, ", "))
+}
@@ -35,6 +35,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/instancetype"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -43,6 +44,7 @@ import (
 type VMsMutator struct {
 	ClusterConfig       *virtconfig.ClusterConfig
 	InstancetypeMethods instancetype.Methods
+	MacAddressPool      *macpool.Pool
 }
 
 func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
@@ -70,6 +72,9 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 		}
 	}
 
+	// The interfaces of the VM are assigned a MAC address once, when they are added
+	existingInterfaces := map[string]struct{}{}
+
 	// Validate updates to the {Instancetype,Preference}Matchers
 	if ar.Request.Operation == admissionv1.Update {
 		newVM, oldVM, err := webhookutils.GetVMFromAdmissionReview(ar)
@@ -82,6 +87,11 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 		if causes := validatePreferenceMatcherUpdate(newVM.Spec.Preference, oldVM.Spec.Preference); len(causes) > 0 {
 			return webhookutils.ToAdmissionResponse(causes)
 		}
+		if oldVM.Spec.Template != nil {
+			for _, iface := range oldVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+				existingInterfaces[iface.Name] = struct{}{}
+			}
+		}
 	}
 
 	// Validate the InstancetypeMatcher before proceeding, the schema check above isn't enough
@@ -124,6 +134,20 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 	mutator.setDefaultMachineType(&vm, preferenceSpec)
 	mutator.setPreferenceStorageClassName(&vm, preferenceSpec)
 
+	var warnings []string
+	if vm.Spec.Template != nil {
+		warnings, err = assignMacAddresses(mutator.ClusterConfig, mutator.MacAddressPool, macpool.VMHolder(&vm), vm.Spec.Template.Spec.Domain.Devices.Interfaces, existingInterfaces)
+		if err != nil {
+			log.Log.Reason(err).Error("admission failed, unable to assign MAC addresses")
+			return &admissionv1.AdmissionResponse{
+				Result: &metav1.Status{
+					Message: err.Error(),
+					Code:    http.StatusUnprocessableEntity,
+				},
+			}
+		}
+	}
+
 	patchBytes, err := patch.GeneratePatchPayload(
 		patch.PatchOperation{
 			Op:    patch.PatchReplaceOp,
@@ -152,6 +176,7 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 		Allowed:   true,
 		Patch:     patchBytes,
 		PatchType: &jsonPatchType,
+		Warnings:  warnings,
 	}
 }
 
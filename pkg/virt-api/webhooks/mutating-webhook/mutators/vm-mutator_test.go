@@ -50,8 +50,10 @@ import (
 	instancetypeclientset "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 var _ = Describe("VirtualMachine Mutator", func() {
@@ -1930,4 +1932,92 @@ var _ = Describe("VirtualMachine Mutator", func() {
 			Entry("PreferenceMatcher provides invalid value to InferFromVolumeFailurePolicy", nil, &v1.PreferenceMatcher{InferFromVolume: "bar", InferFromVolumeFailurePolicy: &invalidInferFromVolumeFailurePolicy}, k8sfield.NewPath("spec", "preference", "inferFromVolumeFailurePolicy").String(), "Invalid value 'not-valid' for InferFromVolumeFailurePolicy"),
 		)
 	})
+
+	Context("with the MAC address pool", func() {
+		const otherVMMacAddress = "02:00:00:00:00:0a"
+
+		enableMacAddressPool := func(featureGates ...string) {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: featureGates,
+						},
+						NetworkConfiguration: &v1.NetworkConfiguration{
+							MacAddressPool: &v1.MacAddressPool{
+								Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:00"}},
+							},
+						},
+					},
+				},
+			})
+		}
+
+		BeforeEach(func() {
+			vm.Name = "testvm"
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{Name: "default"},
+				{Name: "secondary", MacAddress: otherVMMacAddress},
+			}
+
+			mutator.MacAddressPool = macpool.New()
+			mutator.MacAddressPool.Set(macpool.Holder{Kind: "VirtualMachine", Namespace: vm.Namespace, Name: "othervm"}, []string{otherVMMacAddress})
+		})
+
+		It("should assign a MAC address to the interfaces not specifying one", func() {
+			enableMacAddressPool(virtconfig.MacAddressPoolGate)
+
+			resp := admitVM(rt.GOARCH)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(resp.Warnings).To(ConsistOf("MAC address 02:00:00:00:00:0a of interface secondary is already used by VirtualMachine default/othervm"))
+
+			vmSpec := &v1.VirtualMachineSpec{}
+			patchOps := []patch.PatchOperation{{Value: vmSpec}}
+			Expect(json.Unmarshal(resp.Patch, &patchOps)).To(Succeed())
+			Expect(vmSpec.Template.Spec.Domain.Devices.Interfaces).To(Equal([]v1.Interface{
+				{Name: "default", MacAddress: "02:00:00:00:00:00"},
+				{Name: "secondary", MacAddress: otherVMMacAddress},
+			}))
+		})
+
+		It("should not assign a MAC address when the feature gate is disabled", func() {
+			enableMacAddressPool()
+
+			resp := admitVM(rt.GOARCH)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(resp.Warnings).To(BeEmpty())
+
+			vmSpec, _ := getVMSpecMetaFromResponse(rt.GOARCH)
+			Expect(vmSpec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+		})
+
+		It("should only assign a MAC address to the interfaces added by an update", func() {
+			enableMacAddressPool(virtconfig.MacAddressPoolGate)
+
+			oldVM := vm.DeepCopy()
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = append(vm.Spec.Template.Spec.Domain.Devices.Interfaces, v1.Interface{Name: "added"})
+
+			resp := getResponseFromVMUpdate(oldVM, vm)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(resp.Warnings).To(BeEmpty())
+
+			vmSpec := &v1.VirtualMachineSpec{}
+			patchOps := []patch.PatchOperation{{Value: vmSpec}}
+			Expect(json.Unmarshal(resp.Patch, &patchOps)).To(Succeed())
+			Expect(vmSpec.Template.Spec.Domain.Devices.Interfaces).To(Equal([]v1.Interface{
+				{Name: "default"},
+				{Name: "secondary", MacAddress: otherVMMacAddress},
+				{Name: "added", MacAddress: "02:00:00:00:00:00"},
+			}))
+		})
+
+		It("should reject the VM when the pool is exhausted", func() {
+			enableMacAddressPool(virtconfig.MacAddressPoolGate)
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "first"}, {Name: "second"}}
+
+			resp := admitVM(rt.GOARCH)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(Equal("failed to assign a MAC address to interface second: no free MAC address left in the pool"))
+		})
+	})
 })
@@ -35,6 +35,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	"kubevirt.io/kubevirt/pkg/util"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
@@ -44,6 +45,7 @@ import (
 type VMIsMutator struct {
 	ClusterConfig     *virtconfig.ClusterConfig
 	VMIPresetInformer cache.SharedIndexInformer
+	MacAddressPool    *macpool.Pool
 }
 
 const presetDeprecationWarning = "kubevirt.io/v1 VirtualMachineInstancePresets is now deprecated and will be removed in v2."
@@ -64,6 +66,7 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 	}
 
 	var patchOps []patch.PatchOperation
+	var warnings []string
 
 	// Patch the spec, metadata and status with defaults if we deal with a create operation
 	if ar.Request.Operation == admissionv1.Create {
@@ -125,6 +128,19 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 			util.MarkAsNonroot(newVMI)
 		}
 
+		// The interfaces of a VMI created by a VM already got their MAC address from the VM template
+		if owner := metav1.GetControllerOf(newVMI); owner == nil || owner.Kind != v1.VirtualMachineGroupVersionKind.Kind {
+			warnings, err = assignMacAddresses(mutator.ClusterConfig, mutator.MacAddressPool, macpool.VMIHolder(newVMI), newVMI.Spec.Domain.Devices.Interfaces, nil)
+			if err != nil {
+				return &admissionv1.AdmissionResponse{
+					Result: &metav1.Status{
+						Message: err.Error(),
+						Code:    http.StatusUnprocessableEntity,
+					},
+				}
+			}
+		}
+
 		var value interface{}
 		value = newVMI.Spec
 		patchOps = append(patchOps, patch.PatchOperation{
@@ -173,12 +189,8 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 	// If newVMI has been annotated with presets include a deprecation warning in the response
 	for annotation := range newVMI.Annotations {
 		if strings.Contains(annotation, "virtualmachinepreset") {
-			return &admissionv1.AdmissionResponse{
-				Allowed:   true,
-				Patch:     patchBytes,
-				PatchType: &jsonPatchType,
-				Warnings:  []string{presetDeprecationWarning},
-			}
+			warnings = append(warnings, presetDeprecationWarning)
+			break
 		}
 	}
 
@@ -186,6 +198,7 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 		Allowed:   true,
 		Patch:     patchBytes,
 		PatchType: &jsonPatchType,
+		Warnings:  warnings,
 	}
 }
 
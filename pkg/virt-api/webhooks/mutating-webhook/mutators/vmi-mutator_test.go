@@ -42,6 +42,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -1261,4 +1262,38 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Expect(*status.Memory.GuestCurrent).To(Equal(memory))
 		Expect(*status.Memory.GuestRequested).To(Equal(memory))
 	})
+
+	Context("with the MAC address pool", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{virtconfig.MacAddressPoolGate},
+						},
+						NetworkConfiguration: &v1.NetworkConfiguration{
+							MacAddressPool: &v1.MacAddressPool{
+								Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:00"}},
+							},
+						},
+					},
+				},
+			})
+			mutator.MacAddressPool = macpool.New()
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default"}}
+		})
+
+		It("should assign a MAC address to the interfaces of a standalone VMI", func() {
+			_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(vmiSpec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("02:00:00:00:00:00"))
+		})
+
+		It("should not assign a MAC address to the interfaces of a VMI created by a VM", func() {
+			vmi.OwnerReferences = []k8smetav1.OwnerReference{
+				*k8smetav1.NewControllerRef(&v1.VirtualMachine{ObjectMeta: k8smetav1.ObjectMeta{Name: vmi.Name}}, v1.VirtualMachineGroupVersionKind),
+			}
+			_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(vmiSpec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+		})
+	})
 })
@@ -81,6 +81,17 @@ type Informers struct {
 }
 
 func IsKubeVirtServiceAccount(serviceAccount string) bool {
+	prefix := kubeVirtServiceAccountPrefix()
+	return serviceAccount == fmt.Sprintf("%s:%s", prefix, components.ApiServiceAccountName) ||
+		serviceAccount == fmt.Sprintf("%s:%s", prefix, components.HandlerServiceAccountName) ||
+		serviceAccount == fmt.Sprintf("%s:%s", prefix, components.ControllerServiceAccountName)
+}
+
+func IsControllerServiceAccount(serviceAccount string) bool {
+	return serviceAccount == fmt.Sprintf("%s:%s", kubeVirtServiceAccountPrefix(), components.ControllerServiceAccountName)
+}
+
+func kubeVirtServiceAccountPrefix() string {
 	ns, err := clientutil.GetNamespace()
 	logger := log.DefaultLogger()
 
@@ -89,10 +100,7 @@ func IsKubeVirtServiceAccount(serviceAccount string) bool {
 		ns = "kubevirt"
 	}
 
-	prefix := fmt.Sprintf("system:serviceaccount:%s", ns)
-	return serviceAccount == fmt.Sprintf("%s:%s", prefix, components.ApiServiceAccountName) ||
-		serviceAccount == fmt.Sprintf("%s:%s", prefix, components.HandlerServiceAccountName) ||
-		serviceAccount == fmt.Sprintf("%s:%s", prefix, components.ControllerServiceAccountName)
+	return fmt.Sprintf("system:serviceaccount:%s", ns)
 }
 
 func IsARM64(vmiSpec *v1.VirtualMachineInstanceSpec) bool {
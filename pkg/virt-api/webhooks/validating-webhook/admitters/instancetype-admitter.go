@@ -39,6 +39,8 @@ func ValidateInstanceTypeSpec(field *k8sfield.Path, spec *instancetypev1beta1.Vi
 
 	causes = append(causes, validateMemoryOvercommitPercentSetting(field, spec)...)
 	causes = append(causes, validateMemoryOvercommitPercentNoHugepages(field, spec)...)
+	causes = append(causes, validateDiskIOTune(field.Child("diskIOTune"), spec.DiskIOTune)...)
+	causes = append(causes, validateInterfaceBandwidth(field.Child("interfaceBandwidth"), spec.InterfaceBandwidth)...)
 	return causes
 }
 
@@ -25,6 +25,8 @@ import (
 	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,6 +36,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -92,6 +95,16 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	if migration.Spec.SendTo != nil || migration.Spec.Receive != nil {
+		if !admitter.ClusterConfig.CrossClusterLiveMigrationEnabled() {
+			return webhookutils.ToAdmissionResponseError(fmt.Errorf("cross-cluster live migration requires the %s feature gate", virtconfig.CrossClusterLiveMigrationGate))
+		}
+	}
+
+	if migration.Spec.Receive != nil {
+		return admitter.admitReceivingMigration(ar.Request.UserInfo, migration)
+	}
+
 	vmi, err := admitter.VirtClient.VirtualMachineInstance(migration.Namespace).Get(context.Background(), migration.Spec.VMIName, &metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		// ensure VMI exists for the migration
@@ -100,6 +113,16 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
+	if err := admitter.ensurePriorityAllowed(ar.Request.UserInfo, migration); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	if migration.Spec.SendTo != nil {
+		if err := admitter.ensureKubeconfigSecretAllowed(ar.Request.UserInfo, migration); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+	}
+
 	// Don't allow introducing a migration job for a VMI that has already finalized
 	if vmi.IsFinal() {
 		return webhookutils.ToAdmissionResponseError(fmt.Errorf("Cannot migrate VMI in finalized state."))
@@ -123,6 +146,102 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *admissionv1.AdmissionReview)
 	return &reviewResponse
 }
 
+// ensurePriorityAllowed only lets KubeVirt components and KubeVirt admins, who may update
+// the KubeVirt CR, create migrations with a priority above the one of user migrations.
+// Otherwise users could jump the migration queue ahead of evacuations.
+func (admitter *MigrationCreateAdmitter) ensurePriorityAllowed(userInfo authenticationv1.UserInfo, migration *v1.VirtualMachineInstanceMigration) error {
+	priority := migrationutils.Priority(migration)
+	if priority <= v1.MigrationPriorityUser || webhooks.IsKubeVirtServiceAccount(userInfo.Username) {
+		return nil
+	}
+
+	allowed, err := admitter.isAllowed(userInfo, &authv1.ResourceAttributes{
+		Verb:     "update",
+		Group:    v1.GroupVersion.Group,
+		Resource: "kubevirts",
+	})
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return fmt.Errorf("only KubeVirt admins may create migrations with a priority above %d, got %d", v1.MigrationPriorityUser, priority)
+	}
+	return nil
+}
+
+// ensureKubeconfigSecretAllowed only lets users send a VMI to another cluster with a kubeconfig
+// they may read themselves. virt-controller can read all secrets to access the target cluster.
+func (admitter *MigrationCreateAdmitter) ensureKubeconfigSecretAllowed(userInfo authenticationv1.UserInfo, migration *v1.VirtualMachineInstanceMigration) error {
+	allowed, err := admitter.isAllowed(userInfo, &authv1.ResourceAttributes{
+		Namespace: migration.Namespace,
+		Verb:      "get",
+		Resource:  "secrets",
+		Name:      migration.Spec.SendTo.KubeconfigSecretName,
+	})
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return fmt.Errorf("user %s is not allowed to read the secret %s/%s", userInfo.Username, migration.Namespace, migration.Spec.SendTo.KubeconfigSecretName)
+	}
+	return nil
+}
+
+func (admitter *MigrationCreateAdmitter) isAllowed(userInfo authenticationv1.UserInfo, attributes *authv1.ResourceAttributes) (bool, error) {
+	extra := map[string]authv1.ExtraValue{}
+	for k, v := range userInfo.Extra {
+		extra[k] = authv1.ExtraValue(v)
+	}
+	sar := &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:               userInfo.Username,
+			Groups:             userInfo.Groups,
+			UID:                userInfo.UID,
+			Extra:              extra,
+			ResourceAttributes: attributes,
+		},
+	}
+	sar, err := admitter.VirtClient.AuthorizationV1().SubjectAccessReviews().Create(context.Background(), sar, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return sar.Status.Allowed, nil
+}
+
+// admitReceivingMigration only accepts receiving migrations from users who may create the receiving
+// VMI themselves, virt-controller creates it from the template of the migration.
+func (admitter *MigrationCreateAdmitter) admitReceivingMigration(userInfo authenticationv1.UserInfo, migration *v1.VirtualMachineInstanceMigration) *admissionv1.AdmissionResponse {
+	templateField := k8sfield.NewPath("spec", "receive", "template", "metadata")
+	causes := ValidateVirtualMachineInstanceMetadata(templateField, &migration.Spec.Receive.Template.ObjectMeta, admitter.ClusterConfig, userInfo.Username)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	allowed, err := admitter.isAllowed(userInfo, &authv1.ResourceAttributes{
+		Namespace: migration.Namespace,
+		Verb:      "create",
+		Group:     v1.GroupVersion.Group,
+		Resource:  "virtualmachineinstances",
+	})
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	if !allowed {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("user %s is not allowed to create VMIs in the namespace %s", userInfo.Username, migration.Namespace))
+	}
+
+	_, err = admitter.VirtClient.VirtualMachineInstance(migration.Namespace).Get(context.Background(), migration.Spec.VMIName, &metav1.GetOptions{})
+	if err == nil {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("the VMI \"%s/%s\" already exists", migration.Namespace, migration.Spec.VMIName))
+	} else if !errors.IsNotFound(err) {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{}
+	reviewResponse.Allowed = true
+	return &reviewResponse
+}
+
 func getAdmissionReviewMigration(ar *admissionv1.AdmissionReview) (new *v1.VirtualMachineInstanceMigration, old *v1.VirtualMachineInstanceMigration, err error) {
 
 	if !webhookutils.ValidateRequestResource(ar.Request.Resource, webhooks.MigrationGroupVersionResource.Group, webhooks.MigrationGroupVersionResource.Resource) {
@@ -161,5 +280,54 @@ func ValidateVirtualMachineInstanceMigrationSpec(field *k8sfield.Path, spec *v1.
 		})
 	}
 
+	if spec.SendTo != nil && spec.Receive != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "a migration can not both send and receive a VMI",
+			Field:   field.Child("sendTo").String(),
+		})
+	}
+
+	if spec.SendTo != nil {
+		if spec.SendTo.MigrationID == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "migrationID is missing",
+				Field:   field.Child("sendTo", "migrationID").String(),
+			})
+		}
+		if spec.SendTo.KubeconfigSecretName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "kubeconfigSecretName is missing",
+				Field:   field.Child("sendTo", "kubeconfigSecretName").String(),
+			})
+		}
+	}
+
+	if spec.Receive != nil {
+		if spec.Receive.MigrationID == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "migrationID is missing",
+				Field:   field.Child("receive", "migrationID").String(),
+			})
+		}
+		if spec.Receive.Template == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "template is missing",
+				Field:   field.Child("receive", "template").String(),
+			})
+		}
+		if len(spec.Receive.SourceCABundle) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "sourceCABundle is missing",
+				Field:   field.Child("receive", "sourceCABundle").String(),
+			})
+		}
+	}
+
 	return causes
 }
@@ -27,9 +27,14 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
 
 	"kubevirt.io/client-go/api"
 
@@ -38,7 +43,9 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/deprecation"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 )
 
 var _ = Describe("Validating MigrationCreate Admitter", func() {
@@ -301,6 +308,303 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			Expect(resp.Result.Message).To(ContainSubstring("DisksNotLiveMigratable"))
 		})
 
+		Context("with priority", func() {
+			var (
+				k8sClient *k8sfake.Clientset
+				sars      []*authorizationv1.SubjectAccessReview
+			)
+
+			admit := func(migration *v1.VirtualMachineInstanceMigration, username string) *admissionv1.AdmissionResponse {
+				migrationBytes, _ := json.Marshal(migration)
+				ar := &admissionv1.AdmissionReview{
+					Request: &admissionv1.AdmissionRequest{
+						Resource: webhooks.MigrationGroupVersionResource,
+						Object: runtime.RawExtension{
+							Raw: migrationBytes,
+						},
+						UserInfo: authenticationv1.UserInfo{
+							Username: username,
+							Groups:   []string{"somegroup"},
+						},
+					},
+				}
+				return migrationCreateAdmitter.Admit(ar)
+			}
+
+			allowSARs := func(allowed bool) {
+				k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					sar := action.(testing.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+					sars = append(sars, sar)
+					return true, &authorizationv1.SubjectAccessReview{
+						Status: authorizationv1.SubjectAccessReviewStatus{
+							Allowed: allowed,
+						},
+					}, nil
+				})
+			}
+
+			BeforeEach(func() {
+				enableFeatureGate(deprecation.LiveMigrationGate)
+				k8sClient = k8sfake.NewSimpleClientset()
+				sars = nil
+				virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+			})
+
+			newMigration := func(vmiName string, priority int32) *v1.VirtualMachineInstanceMigration {
+				return &v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+					},
+					Spec: v1.VirtualMachineInstanceMigrationSpec{
+						VMIName:  vmiName,
+						Priority: &priority,
+					},
+				}
+			}
+
+			It("should accept priorities up to the one of user migrations without further checks", func() {
+				vmi := api.NewMinimalVMI("testvmi")
+				mockVMIClient.EXPECT().Get(context.Background(), vmi.Name, gomock.Any()).Return(vmi, nil)
+				allowSARs(false)
+
+				Expect(admit(newMigration(vmi.Name, v1.MigrationPriorityUser), "user").Allowed).To(BeTrue())
+				Expect(sars).To(BeEmpty())
+			})
+
+			DescribeTable("should only accept priorities above the one of user migrations from KubeVirt admins", func(allowed bool) {
+				vmi := api.NewMinimalVMI("testvmi")
+				mockVMIClient.EXPECT().Get(context.Background(), vmi.Name, gomock.Any()).Return(vmi, nil)
+				allowSARs(allowed)
+
+				resp := admit(newMigration(vmi.Name, v1.MigrationPriorityEvacuation), "user")
+				Expect(resp.Allowed).To(Equal(allowed))
+				if !allowed {
+					Expect(resp.Result.Message).To(ContainSubstring("only KubeVirt admins"))
+				}
+				Expect(sars).To(HaveLen(1))
+				Expect(sars[0].Spec.User).To(Equal("user"))
+				Expect(sars[0].Spec.Groups).To(ConsistOf("somegroup"))
+				Expect(sars[0].Spec.ResourceAttributes.Verb).To(Equal("update"))
+				Expect(sars[0].Spec.ResourceAttributes.Resource).To(Equal("kubevirts"))
+			},
+				Entry("for admins", true),
+				Entry("for other users", false),
+			)
+
+			It("should reject users raising the priority through the evacuation annotation", func() {
+				vmi := api.NewMinimalVMI("testvmi")
+				mockVMIClient.EXPECT().Get(context.Background(), vmi.Name, gomock.Any()).Return(vmi, nil)
+				allowSARs(false)
+				migration := newMigration(vmi.Name, 0)
+				migration.Spec.Priority = nil
+				migration.Annotations = map[string]string{v1.EvacuationMigrationAnnotation: "node01"}
+
+				Expect(admit(migration, "user").Allowed).To(BeFalse())
+			})
+
+			It("should accept priorities above the one of user migrations from KubeVirt components", func() {
+				vmi := api.NewMinimalVMI("testvmi")
+				mockVMIClient.EXPECT().Get(context.Background(), vmi.Name, gomock.Any()).Return(vmi, nil)
+				allowSARs(false)
+
+				Expect(admit(newMigration(vmi.Name, v1.MigrationPriorityEvacuation), "system:serviceaccount:kubevirt:"+components.ControllerServiceAccountName).Allowed).To(BeTrue())
+				Expect(sars).To(BeEmpty())
+			})
+		})
+
+		Context("cross-cluster", func() {
+			admit := func(migration *v1.VirtualMachineInstanceMigration) *admissionv1.AdmissionResponse {
+				migrationBytes, _ := json.Marshal(migration)
+				ar := &admissionv1.AdmissionReview{
+					Request: &admissionv1.AdmissionRequest{
+						Resource: webhooks.MigrationGroupVersionResource,
+						Object: runtime.RawExtension{
+							Raw: migrationBytes,
+						},
+						UserInfo: authenticationv1.UserInfo{
+							Username: "user",
+						},
+					},
+				}
+				return migrationCreateAdmitter.Admit(ar)
+			}
+
+			newMigration := func(vmiName string) *v1.VirtualMachineInstanceMigration {
+				return &v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+					},
+					Spec: v1.VirtualMachineInstanceMigrationSpec{
+						VMIName: vmiName,
+					},
+				}
+			}
+
+			It("should reject migrations to other clusters if the feature gate is disabled", func() {
+				migration := newMigration("testvmi")
+				migration.Spec.SendTo = &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "id", KubeconfigSecretName: "target"}
+
+				resp := admit(migration)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Message).To(ContainSubstring(virtconfig.CrossClusterLiveMigrationGate))
+			})
+
+			DescribeTable("should reject invalid specs", func(sendTo *v1.VirtualMachineInstanceMigrationSendTo, receive *v1.VirtualMachineInstanceMigrationReceive, field string) {
+				enableFeatureGate(virtconfig.CrossClusterLiveMigrationGate)
+				migration := newMigration("testvmi")
+				migration.Spec.SendTo = sendTo
+				migration.Spec.Receive = receive
+
+				resp := admit(migration)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+			},
+				Entry("without migration ID to send", &v1.VirtualMachineInstanceMigrationSendTo{KubeconfigSecretName: "target"}, nil, "spec.sendTo.migrationID"),
+				Entry("without kubeconfig secret", &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "id"}, nil, "spec.sendTo.kubeconfigSecretName"),
+				Entry("without migration ID to receive", nil,
+					&v1.VirtualMachineInstanceMigrationReceive{Template: &v1.VirtualMachineInstanceTemplateSpec{}, SourceCABundle: []byte("ca")},
+					"spec.receive.migrationID"),
+				Entry("which send and receive",
+					&v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "id", KubeconfigSecretName: "target"},
+					&v1.VirtualMachineInstanceMigrationReceive{MigrationID: "id", Template: &v1.VirtualMachineInstanceTemplateSpec{}, SourceCABundle: []byte("ca")},
+					"spec.sendTo"),
+			)
+
+			DescribeTable("should reject receiving migrations", func(receive *v1.VirtualMachineInstanceMigrationReceive, field string) {
+				enableFeatureGate(virtconfig.CrossClusterLiveMigrationGate)
+				migration := newMigration("testvmi")
+				migration.Spec.Receive = receive
+
+				resp := admit(migration)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Message).To(ContainSubstring(field))
+			},
+				Entry("without template", &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "id", SourceCABundle: []byte("ca")}, "spec.receive.template"),
+				Entry("without CA bundle of the source cluster", &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "id", Template: &v1.VirtualMachineInstanceTemplateSpec{}}, "spec.receive.sourceCABundle"),
+				Entry("with an empty CA bundle of the source cluster", &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "id", Template: &v1.VirtualMachineInstanceTemplateSpec{}, SourceCABundle: []byte{}}, "spec.receive.sourceCABundle"),
+			)
+
+			DescribeTable("should only accept migrations to other clusters from users who may read the kubeconfig", func(allowed bool) {
+				enableFeatureGate(virtconfig.CrossClusterLiveMigrationGate)
+				vmi := api.NewMinimalVMI("testvmi")
+				mockVMIClient.EXPECT().Get(context.Background(), vmi.Name, gomock.Any()).Return(vmi, nil)
+				var sars []*authorizationv1.SubjectAccessReview
+				k8sClient := k8sfake.NewSimpleClientset()
+				k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					sar := action.(testing.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+					sars = append(sars, sar)
+					return true, &authorizationv1.SubjectAccessReview{
+						Status: authorizationv1.SubjectAccessReviewStatus{
+							Allowed: allowed,
+						},
+					}, nil
+				})
+				virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+				migration := newMigration(vmi.Name)
+				migration.Spec.SendTo = &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "id", KubeconfigSecretName: "target"}
+
+				resp := admit(migration)
+				Expect(resp.Allowed).To(Equal(allowed))
+				if !allowed {
+					Expect(resp.Result.Message).To(ContainSubstring("not allowed to read the secret default/target"))
+				}
+				Expect(sars).To(HaveLen(1))
+				Expect(sars[0].Spec.User).To(Equal("user"))
+				Expect(sars[0].Spec.ResourceAttributes).To(Equal(&authorizationv1.ResourceAttributes{
+					Namespace: "default",
+					Verb:      "get",
+					Resource:  "secrets",
+					Name:      "target",
+				}))
+			},
+				Entry("if the user may read the secret", true),
+				Entry("if the user may not read the secret", false),
+			)
+
+			Context("receiving", func() {
+				var sars []*authorizationv1.SubjectAccessReview
+
+				allowSARs := func(allowed bool) {
+					k8sClient := k8sfake.NewSimpleClientset()
+					k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						sar := action.(testing.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+						sars = append(sars, sar)
+						return true, &authorizationv1.SubjectAccessReview{
+							Status: authorizationv1.SubjectAccessReviewStatus{
+								Allowed: allowed,
+							},
+						}, nil
+					})
+					virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+				}
+
+				newReceivingMigration := func() *v1.VirtualMachineInstanceMigration {
+					migration := newMigration("testvmi")
+					migration.Spec.Receive = &v1.VirtualMachineInstanceMigrationReceive{
+						MigrationID: "id",
+						Template: &v1.VirtualMachineInstanceTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels:      map[string]string{"app": "test"},
+								Annotations: map[string]string{v1.MigrationSourceUIDAnnotation: "source-uid"},
+							},
+						},
+						SourceCABundle: []byte("ca"),
+					}
+					return migration
+				}
+
+				BeforeEach(func() {
+					sars = nil
+					enableFeatureGate(virtconfig.CrossClusterLiveMigrationGate)
+				})
+
+				It("should accept receiving migrations from users who may create the VMI", func() {
+					allowSARs(true)
+					mockVMIClient.EXPECT().Get(context.Background(), "testvmi", gomock.Any()).Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstances"), "testvmi"))
+
+					Expect(admit(newReceivingMigration()).Allowed).To(BeTrue())
+					Expect(sars).To(HaveLen(1))
+					Expect(sars[0].Spec.User).To(Equal("user"))
+					Expect(sars[0].Spec.ResourceAttributes).To(Equal(&authorizationv1.ResourceAttributes{
+						Namespace: "default",
+						Verb:      "create",
+						Group:     v1.GroupVersion.Group,
+						Resource:  "virtualmachineinstances",
+					}))
+				})
+
+				It("should reject receiving migrations from users who may not create VMIs", func() {
+					allowSARs(false)
+
+					resp := admit(newReceivingMigration())
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Message).To(ContainSubstring("not allowed to create VMIs in the namespace default"))
+				})
+
+				It("should reject receiving migrations if the VMI already exists", func() {
+					allowSARs(true)
+					mockVMIClient.EXPECT().Get(context.Background(), "testvmi", gomock.Any()).Return(api.NewMinimalVMI("testvmi"), nil)
+
+					resp := admit(newReceivingMigration())
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Message).To(ContainSubstring("already exists"))
+				})
+
+				It("should reject templates with the receiver annotation", func() {
+					allowSARs(true)
+					migration := newReceivingMigration()
+					migration.Spec.Receive.Template.ObjectMeta.Annotations[v1.MigrationReceiverAnnotation] = "id"
+
+					resp := admit(migration)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.receive.template.metadata.annotations.kubevirt.io/migration-receiver"))
+					Expect(sars).To(BeEmpty())
+				})
+			})
+		})
+
 		DescribeTable("should reject documents containing unknown or missing fields for", func(data string, validationResult string, gvr metav1.GroupVersionResource, review func(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse) {
 			input := map[string]interface{}{}
 			json.Unmarshal([]byte(data), &input)
@@ -26,7 +26,9 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 )
 
 type MigrationUpdateAdmitter struct {
@@ -81,6 +83,16 @@ func (admitter *MigrationUpdateAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		})
 	}
 
+	// The priority is checked on creation, so it can't be changed through the annotations later on
+	if migrationutils.Priority(newMigration) != migrationutils.Priority(oldMigration) && !webhooks.IsKubeVirtServiceAccount(ar.Request.UserInfo.Username) {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "the priority of a Migration can't be changed",
+			},
+		})
+	}
+
 	// Reject Migration update if selector label changed on an in-flight migration
 	causes := ensureSelectorLabelSafe(newMigration, oldMigration)
 	if len(causes) > 0 {
@@ -265,4 +265,42 @@ var _ = Describe("Validating MigrationUpdate Admitter", func() {
 		resp := migrationUpdateAdmitter.Admit(ar)
 		Expect(resp.Allowed).To(BeTrue())
 	})
+
+	It("should reject Migration on update if the priority is raised through the annotations", func() {
+		migration := v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "somemigration",
+				Namespace: "default",
+				UID:       "1234",
+			},
+			Spec: v1.VirtualMachineInstanceMigrationSpec{
+				VMIName: "testmigratevmiupdate-priority",
+			},
+		}
+
+		oldMigrationBytes, _ := json.Marshal(&migration)
+
+		newMigration := migration.DeepCopy()
+		newMigration.Annotations = map[string]string{v1.EvacuationMigrationAnnotation: "node01"}
+		newMigrationBytes, _ := json.Marshal(&newMigration)
+
+		enableFeatureGate(deprecation.LiveMigrationGate)
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.MigrationGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: newMigrationBytes,
+				},
+				OldObject: runtime.RawExtension{
+					Raw: oldMigrationBytes,
+				},
+				Operation: admissionv1.Update,
+			},
+		}
+
+		resp := migrationUpdateAdmitter.Admit(ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("priority"))
+	})
 })
@@ -22,23 +22,32 @@ package admitters
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations"
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	unversionedvalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 
 	"kubevirt.io/client-go/kubecli"
 
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 )
 
+const (
+	// QEMU rejects cpu-throttle-initial and cpu-throttle-increment above 99
+	maxAutoConvergeThrottle = 99
+)
+
 // MigrationPolicyAdmitter validates VirtualMachineSnapshots
 type MigrationPolicyAdmitter struct {
 	ClusterConfig *virtconfig.ClusterConfig
@@ -95,6 +104,71 @@ func (admitter *MigrationPolicyAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		}
 	}
 
+	if spec.ProgressTimeout != nil && *spec.ProgressTimeout < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must not be negative",
+			Field:   sourceField.Child("progressTimeout").String(),
+		})
+	}
+
+	if spec.ParallelMigrations != nil && *spec.ParallelMigrations == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be greater than zero",
+			Field:   sourceField.Child("parallelMigrations").String(),
+		})
+	}
+
+	if spec.Escalation != nil {
+		causes = append(causes, validateMigrationEscalation(sourceField.Child("escalation"), spec.Escalation)...)
+	}
+
+	causes = append(causes, migrationutils.ValidateMultifdChannels(sourceField.Child("multifdChannels"), spec.MultifdChannels)...)
+	causes = append(causes, migrationutils.ValidateCompression(sourceField.Child("compression"), spec.Compression)...)
+
+	if spec.MaxDowntime != nil && spec.MaxDowntime.Duration < time.Millisecond {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be at least 1ms",
+			Field:   sourceField.Child("maxDowntime").String(),
+		})
+	}
+
+	migrationConfig := admitter.ClusterConfig.GetMigrationConfiguration()
+	if spec.Network != nil {
+		if _, attached := migrationutils.MigrationInterfaceName(migrationConfig, *spec.Network); !attached {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must be the migration network or one of the additional migration networks of KubeVirt",
+				Field:   sourceField.Child("network").String(),
+			})
+		}
+	}
+
+	if spec.DisableTLS != nil && *spec.DisableTLS && migrationConfig.Network == nil && spec.Network == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "TLS can only be disabled if the migrations run on a dedicated migration network",
+			Field:   sourceField.Child("disableTLS").String(),
+		})
+	}
+
+	if spec.Selectors != nil {
+		selectorsField := sourceField.Child("selectors")
+		errs := unversionedvalidation.ValidateLabelSelector(spec.Selectors.NamespaceLabelSelector,
+			unversionedvalidation.LabelSelectorValidationOptions{}, selectorsField.Child("namespaceLabelSelector"))
+		errs = append(errs, unversionedvalidation.ValidateLabelSelector(spec.Selectors.VirtualMachineInstanceLabelSelector,
+			unversionedvalidation.LabelSelectorValidationOptions{}, selectorsField.Child("virtualMachineInstanceLabelSelector"))...)
+		for _, err := range errs {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Detail,
+				Field:   err.Field,
+			})
+		}
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -104,3 +178,45 @@ func (admitter *MigrationPolicyAdmitter) Admit(ar *admissionv1.AdmissionReview)
 	}
 	return &reviewResponse
 }
+
+func validateMigrationEscalation(field *k8sfield.Path, escalation *v1.MigrationEscalation) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if escalation.StageDuration != nil && *escalation.StageDuration < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must not be negative",
+			Field:   field.Child("stageDuration").String(),
+		})
+	}
+	if escalation.RemainingDataThreshold != nil && escalation.RemainingDataThreshold.Sign() < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must not be negative",
+			Field:   field.Child("remainingDataThreshold").String(),
+		})
+	}
+	if escalation.Bandwidth != nil && escalation.Bandwidth.Sign() < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must not be negative",
+			Field:   field.Child("bandwidth").String(),
+		})
+	}
+	if escalation.AutoConvergeInitial != nil && (*escalation.AutoConvergeInitial < 1 || *escalation.AutoConvergeInitial > maxAutoConvergeThrottle) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("must be between 1 and %d", maxAutoConvergeThrottle),
+			Field:   field.Child("autoConvergeInitial").String(),
+		})
+	}
+	if escalation.AutoConvergeIncrement != nil && (*escalation.AutoConvergeIncrement < 1 || *escalation.AutoConvergeIncrement > maxAutoConvergeThrottle) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("must be between 1 and %d", maxAutoConvergeThrottle),
+			Field:   field.Child("autoConvergeIncrement").String(),
+		})
+	}
+
+	return causes
+}
@@ -21,6 +21,7 @@ package admitters
 
 import (
 	"encoding/json"
+	"time"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -45,7 +46,7 @@ import (
 )
 
 var _ = Describe("Validating MigrationPolicy Admitter", func() {
-	config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+	config, _, kvInformer := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
 
 	var ctrl *gomock.Controller
 	var virtClient *kubecli.MockKubevirtClient
@@ -78,6 +79,62 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("negative CompletionTimeoutPerGiB",
 			migrationsv1.MigrationPolicySpec{CompletionTimeoutPerGiB: pointer.Int64Ptr(-1)},
 		),
+
+		Entry("negative ProgressTimeout",
+			migrationsv1.MigrationPolicySpec{ProgressTimeout: pointer.Int64Ptr(-1)},
+		),
+
+		Entry("zero ParallelMigrations",
+			migrationsv1.MigrationPolicySpec{ParallelMigrations: pointer.Uint32(0)},
+		),
+
+		Entry("invalid VirtualMachineInstanceLabelSelector operator",
+			migrationsv1.MigrationPolicySpec{Selectors: &migrationsv1.Selectors{
+				VirtualMachineInstanceLabelSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Near", Values: []string{"gold"}}},
+				},
+			}},
+		),
+
+		Entry("Exists NamespaceLabelSelector with values",
+			migrationsv1.MigrationPolicySpec{Selectors: &migrationsv1.Selectors{
+				NamespaceLabelSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: metav1.LabelSelectorOpExists, Values: []string{"gold"}}},
+				},
+			}},
+		),
+
+		Entry("negative escalation StageDuration",
+			migrationsv1.MigrationPolicySpec{Escalation: &v1.MigrationEscalation{StageDuration: pointer.Int64(-1)}},
+		),
+
+		Entry("negative escalation Bandwidth",
+			migrationsv1.MigrationPolicySpec{Escalation: &v1.MigrationEscalation{Bandwidth: resource.NewScaledQuantity(-1, 1)}},
+		),
+
+		Entry("out of range escalation AutoConvergeInitial",
+			migrationsv1.MigrationPolicySpec{Escalation: &v1.MigrationEscalation{AutoConvergeInitial: pointer.Int32(100)}},
+		),
+
+		Entry("out of range escalation AutoConvergeIncrement",
+			migrationsv1.MigrationPolicySpec{Escalation: &v1.MigrationEscalation{AutoConvergeIncrement: pointer.Int32(100)}},
+		),
+
+		Entry("zero MultifdChannels",
+			migrationsv1.MigrationPolicySpec{MultifdChannels: pointer.Uint32(0)},
+		),
+
+		Entry("unknown compression method",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: "lz4"}},
+		),
+
+		Entry("out of range zstd compression level",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.Int32(21)}},
+		),
+
+		Entry("MaxDowntime below a millisecond",
+			migrationsv1.MigrationPolicySpec{MaxDowntime: &metav1.Duration{Duration: time.Microsecond}},
+		),
 	)
 
 	DescribeTable("should accept migration policy with", func(policySpec migrationsv1.MigrationPolicySpec) {
@@ -107,7 +164,128 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("empty spec",
 			migrationsv1.MigrationPolicySpec{},
 		),
+
+		Entry("ParallelMigrations and ProgressTimeout",
+			migrationsv1.MigrationPolicySpec{ParallelMigrations: pointer.Uint32(2), ProgressTimeout: pointer.Int64Ptr(300)},
+		),
+
+		Entry("set-based label selectors",
+			migrationsv1.MigrationPolicySpec{Selectors: &migrationsv1.Selectors{
+				NamespaceLabelSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: metav1.LabelSelectorOpExists}},
+				},
+				VirtualMachineInstanceLabelSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"gold", "silver"}}},
+				},
+			}},
+		),
+
+		Entry("escalation",
+			migrationsv1.MigrationPolicySpec{Escalation: &v1.MigrationEscalation{
+				StageDuration:         pointer.Int64(60),
+				Bandwidth:             resource.NewScaledQuantity(0, 1),
+				AutoConvergeInitial:   pointer.Int32(20),
+				AutoConvergeIncrement: pointer.Int32(10),
+			}},
+		),
+
+		Entry("MultifdChannels and compression",
+			migrationsv1.MigrationPolicySpec{
+				MultifdChannels: pointer.Uint32(8),
+				Compression:     &v1.MigrationCompression{Method: v1.MigrationCompressionZlib, Level: pointer.Int32(9)},
+			},
+		),
+
+		Entry("MaxDowntime",
+			migrationsv1.MigrationPolicySpec{MaxDowntime: &metav1.Duration{Duration: 50 * time.Millisecond}},
+		),
+
+		Entry("TLS enabled",
+			migrationsv1.MigrationPolicySpec{DisableTLS: pointer.Bool(false)},
+		),
 	)
+
+	Context("with TLS disabled", func() {
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{})
+		})
+
+		It("should reject the policy without a dedicated migration network", func() {
+			policy := kubecli.NewMinimalMigrationPolicy(policyName)
+			policy.Spec.DisableTLS = pointer.Bool(true)
+
+			admitter.admitAndExpect(policy, false)
+		})
+
+		It("should accept the policy with a dedicated migration network", func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						MigrationConfiguration: &v1.MigrationConfiguration{
+							Network: pointer.String("migration-network"),
+						},
+					},
+				},
+			})
+			policy := kubecli.NewMinimalMigrationPolicy(policyName)
+			policy.Spec.DisableTLS = pointer.Bool(true)
+
+			admitter.admitAndExpect(policy, true)
+		})
+
+		It("should accept the policy with a migration network", func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						MigrationConfiguration: &v1.MigrationConfiguration{
+							AdditionalNetworks: []string{"migration-network"},
+						},
+					},
+				},
+			})
+			policy := kubecli.NewMinimalMigrationPolicy(policyName)
+			policy.Spec.Network = pointer.String("migration-network")
+			policy.Spec.DisableTLS = pointer.Bool(true)
+
+			admitter.admitAndExpect(policy, true)
+		})
+	})
+
+	Context("with a migration network", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						MigrationConfiguration: &v1.MigrationConfiguration{
+							Network:            pointer.String("migration-network"),
+							AdditionalNetworks: []string{"fast-network", "isolated-network"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{})
+		})
+
+		DescribeTable("should accept the policy", func(network string) {
+			policy := kubecli.NewMinimalMigrationPolicy(policyName)
+			policy.Spec.Network = pointer.String(network)
+
+			admitter.admitAndExpect(policy, true)
+		},
+			Entry("with the migration network", "migration-network"),
+			Entry("with an additional migration network", "isolated-network"),
+		)
+
+		It("should reject the policy with a network virt-handler is not attached to", func() {
+			policy := kubecli.NewMinimalMigrationPolicy(policyName)
+			policy.Spec.Network = pointer.String("other-network")
+
+			admitter.admitAndExpect(policy, false)
+		})
+	})
 })
 
 func createPolicyAdmissionReview(policy *migrationsv1.MigrationPolicy, namespace string) *admissionv1.AdmissionReview {
@@ -21,6 +21,7 @@ package admitters
 
 import (
 	"fmt"
+	"net"
 
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 
@@ -33,13 +34,21 @@ import (
 func validateInterfaceStateValue(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	for idx, iface := range spec.Domain.Devices.Interfaces {
-		if iface.State != "" && iface.State != v1.InterfaceStateAbsent {
+		if iface.State != "" && iface.State != v1.InterfaceStateAbsent &&
+			iface.State != v1.InterfaceStateLinkUp && iface.State != v1.InterfaceStateLinkDown {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
 				Message: fmt.Sprintf("logical %s interface state value is unsupported: %s", iface.Name, iface.State),
 				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("state").String(),
 			})
 		}
+		if (iface.State == v1.InterfaceStateLinkUp || iface.State == v1.InterfaceStateLinkDown) && iface.SRIOV != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%q interface's state %q is not supported for SR-IOV binding", iface.Name, iface.State),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("state").String(),
+			})
+		}
 		if iface.State == v1.InterfaceStateAbsent && iface.Bridge == nil {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
@@ -83,3 +92,104 @@ func hasInterfaceBindingMethod(iface v1.Interface) bool {
 		iface.InterfaceBindingMethod.Macvtap != nil ||
 		iface.InterfaceBindingMethod.Passt != nil
 }
+
+// passtBindingPluginName is the name the passt network binding plugin is registered with.
+const passtBindingPluginName = "passt"
+
+func validateFirewall(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	if spec.Firewall == nil {
+		return nil
+	}
+	firewallField := field.Child("firewall")
+
+	var causes []metav1.StatusCause
+	if !hasFirewallSupportingPodInterface(spec) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "firewall requires a pod network interface with masquerade or passt binding",
+			Field:   firewallField.String(),
+		})
+	}
+	causes = append(causes, validateFirewallRuleSet(firewallField.Child("ingress"), spec.Firewall.Ingress)...)
+	causes = append(causes, validateFirewallRuleSet(firewallField.Child("egress"), spec.Firewall.Egress)...)
+	return causes
+}
+
+func hasFirewallSupportingPodInterface(spec *v1.VirtualMachineInstanceSpec) bool {
+	podNetwork := vmispec.LookupPodNetwork(spec.Networks)
+	if podNetwork == nil {
+		return false
+	}
+	iface := vmispec.LookupInterfaceByName(spec.Domain.Devices.Interfaces, podNetwork.Name)
+	if iface == nil {
+		return false
+	}
+	return iface.Masquerade != nil || iface.Passt != nil || (iface.Binding != nil && iface.Binding.Name == passtBindingPluginName)
+}
+
+func validateFirewallRuleSet(field *k8sfield.Path, ruleSet *v1.FirewallRuleSet) []metav1.StatusCause {
+	if ruleSet == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	for idx, rule := range ruleSet.Rules {
+		ruleField := field.Child("rules").Index(idx)
+		if rule.CIDR != "" {
+			if _, _, err := net.ParseCIDR(rule.CIDR); err != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s is not a valid CIDR: %s", ruleField.Child("cidr").String(), rule.CIDR),
+					Field:   ruleField.Child("cidr").String(),
+				})
+			}
+		}
+		switch rule.Protocol {
+		case "", v1.FirewallProtocolTCP, v1.FirewallProtocolUDP, v1.FirewallProtocolICMP:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is not supported: %s", ruleField.Child("protocol").String(), rule.Protocol),
+				Field:   ruleField.Child("protocol").String(),
+			})
+		}
+		if rule.Port != 0 {
+			if rule.Protocol != v1.FirewallProtocolTCP && rule.Protocol != v1.FirewallProtocolUDP {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s requires the TCP or UDP protocol", ruleField.Child("port").String()),
+					Field:   ruleField.Child("port").String(),
+				})
+			} else if rule.Port < 1 || rule.Port > 65535 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must be between 1 and 65535", ruleField.Child("port").String()),
+					Field:   ruleField.Child("port").String(),
+				})
+			}
+		}
+		causes = append(causes, validateFirewallAction(ruleField.Child("action"), rule.Action, false)...)
+	}
+	causes = append(causes, validateFirewallAction(field.Child("defaultAction"), ruleSet.DefaultAction, true)...)
+	return causes
+}
+
+func validateFirewallAction(field *k8sfield.Path, action v1.FirewallAction, optional bool) []metav1.StatusCause {
+	switch {
+	case action == v1.FirewallActionAccept, action == v1.FirewallActionDrop:
+		return nil
+	case action == "" && optional:
+		return nil
+	case action == "":
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s is required", field.String()),
+			Field:   field.String(),
+		}}
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("%s is not supported: %s", field.String(), action),
+		Field:   field.String(),
+	}}
+}
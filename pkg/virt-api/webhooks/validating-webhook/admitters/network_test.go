@@ -44,6 +44,8 @@ var _ = Describe("Validating VMI network spec", func() {
 	},
 		Entry("is empty", v1.InterfaceState("")),
 		Entry("is absent when bridge binding is used", v1.InterfaceStateAbsent),
+		Entry("is up", v1.InterfaceStateLinkUp),
+		Entry("is down", v1.InterfaceStateLinkDown),
 	)
 
 	It("network interface state value is invalid", func() {
@@ -72,6 +74,21 @@ var _ = Describe("Validating VMI network spec", func() {
 			}))
 	})
 
+	It("network interface link state is not supported when SR-IOV binding is used", func() {
+		vm := api.NewMinimalVMI("testvm")
+		vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "foo",
+			State:                  v1.InterfaceStateLinkDown,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+		}}
+		Expect(validateInterfaceStateValue(k8sfield.NewPath("fake"), &vm.Spec)).To(
+			ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "\"foo\" interface's state \"down\" is not supported for SR-IOV binding",
+				Field:   "fake.domain.devices.interfaces[0].state",
+			}))
+	})
+
 	It("network interface state value of absent is not supported on the default network", func() {
 		vm := api.NewMinimalVMI("testvm")
 		vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
@@ -120,4 +137,113 @@ var _ = Describe("Validating VMI network spec", func() {
 		}}
 		Expect(validateInterfaceBinding(k8sfield.NewPath("fake"), &vm.Spec)).To(BeEmpty())
 	})
+
+	Context("firewall", func() {
+		var vm *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vm = api.NewMinimalVMI("testvm")
+			vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			}}
+			vm.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		})
+
+		It("should accept valid rules", func() {
+			vm.Spec.Firewall = &v1.Firewall{
+				Ingress: &v1.FirewallRuleSet{
+					Rules: []v1.FirewallRule{
+						{CIDR: "10.0.0.0/8", Protocol: v1.FirewallProtocolTCP, Port: 22, Action: v1.FirewallActionAccept},
+						{CIDR: "fd00::/64", Protocol: v1.FirewallProtocolICMP, Action: v1.FirewallActionAccept},
+					},
+				},
+				Egress: &v1.FirewallRuleSet{DefaultAction: v1.FirewallActionAccept},
+			}
+			Expect(validateFirewall(k8sfield.NewPath("fake"), &vm.Spec)).To(BeEmpty())
+		})
+
+		DescribeTable("should accept a pod network interface with", func(binding v1.InterfaceBindingMethod, pluginBinding *v1.PluginBinding) {
+			vm.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = binding
+			vm.Spec.Domain.Devices.Interfaces[0].Binding = pluginBinding
+			vm.Spec.Firewall = &v1.Firewall{}
+			Expect(validateFirewall(k8sfield.NewPath("fake"), &vm.Spec)).To(BeEmpty())
+		},
+			Entry("passt binding", v1.InterfaceBindingMethod{Passt: &v1.InterfacePasst{}}, nil),
+			Entry("passt binding plugin", v1.InterfaceBindingMethod{}, &v1.PluginBinding{Name: "passt"}),
+		)
+
+		It("should reject a pod network interface with bridge binding", func() {
+			vm.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}
+			vm.Spec.Firewall = &v1.Firewall{}
+			Expect(validateFirewall(k8sfield.NewPath("fake"), &vm.Spec)).To(
+				ConsistOf(metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "firewall requires a pod network interface with masquerade or passt binding",
+					Field:   "fake.firewall",
+				}))
+		})
+
+		DescribeTable("should reject an invalid rule", func(rule v1.FirewallRule, expectedCause metav1.StatusCause) {
+			vm.Spec.Firewall = &v1.Firewall{
+				Egress: &v1.FirewallRuleSet{Rules: []v1.FirewallRule{rule}},
+			}
+			Expect(validateFirewall(k8sfield.NewPath("fake"), &vm.Spec)).To(ConsistOf(expectedCause))
+		},
+			Entry("with an invalid CIDR",
+				v1.FirewallRule{CIDR: "10.0.0.1", Action: v1.FirewallActionDrop},
+				metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "fake.firewall.egress.rules[0].cidr is not a valid CIDR: 10.0.0.1",
+					Field:   "fake.firewall.egress.rules[0].cidr",
+				}),
+			Entry("with an unsupported protocol",
+				v1.FirewallRule{Protocol: "SCTP", Action: v1.FirewallActionDrop},
+				metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: "fake.firewall.egress.rules[0].protocol is not supported: SCTP",
+					Field:   "fake.firewall.egress.rules[0].protocol",
+				}),
+			Entry("with a port and no protocol",
+				v1.FirewallRule{Port: 80, Action: v1.FirewallActionDrop},
+				metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "fake.firewall.egress.rules[0].port requires the TCP or UDP protocol",
+					Field:   "fake.firewall.egress.rules[0].port",
+				}),
+			Entry("with a port out of range",
+				v1.FirewallRule{Protocol: v1.FirewallProtocolUDP, Port: 70000, Action: v1.FirewallActionDrop},
+				metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "fake.firewall.egress.rules[0].port must be between 1 and 65535",
+					Field:   "fake.firewall.egress.rules[0].port",
+				}),
+			Entry("without an action",
+				v1.FirewallRule{Protocol: v1.FirewallProtocolTCP},
+				metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: "fake.firewall.egress.rules[0].action is required",
+					Field:   "fake.firewall.egress.rules[0].action",
+				}),
+			Entry("with an unsupported action",
+				v1.FirewallRule{Action: "Reject"},
+				metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: "fake.firewall.egress.rules[0].action is not supported: Reject",
+					Field:   "fake.firewall.egress.rules[0].action",
+				}),
+		)
+
+		It("should reject an unsupported default action", func() {
+			vm.Spec.Firewall = &v1.Firewall{
+				Ingress: &v1.FirewallRuleSet{DefaultAction: "Reject"},
+			}
+			Expect(validateFirewall(k8sfield.NewPath("fake"), &vm.Spec)).To(
+				ConsistOf(metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: "fake.firewall.ingress.defaultAction is not supported: Reject",
+					Field:   "fake.firewall.ingress.defaultAction",
+				}))
+		})
+	})
 })
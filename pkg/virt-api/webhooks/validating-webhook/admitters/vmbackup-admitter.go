@@ -0,0 +1,202 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	"kubevirt.io/api/core"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMBackupAdmitter validates VirtualMachineBackups
+type VMBackupAdmitter struct {
+	Config *virtconfig.ClusterConfig
+	Client kubecli.KubevirtClient
+}
+
+// NewVMBackupAdmitter creates a VMBackupAdmitter
+func NewVMBackupAdmitter(config *virtconfig.ClusterConfig, client kubecli.KubevirtClient) *VMBackupAdmitter {
+	return &VMBackupAdmitter{
+		Config: config,
+		Client: client,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMBackupAdmitter) Admit(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != snapshotv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinebackups" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.IncrementalBackupEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("%s feature gate not enabled", virtconfig.IncrementalBackupGate))
+	}
+
+	vmBackup := &snapshotv1.VirtualMachineBackup{}
+	err := json.Unmarshal(ar.Request.Object.Raw, vmBackup)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		causes, err = admitter.validateCreate(ar.Request.Namespace, &vmBackup.Spec)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+	case admissionv1.Update:
+		prevObj := &snapshotv1.VirtualMachineBackup{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		causes = validateUpdate(&prevObj.Spec, &vmBackup.Spec)
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func (admitter *VMBackupAdmitter) validateCreate(namespace string, spec *snapshotv1.VirtualMachineBackupSpec) ([]metav1.StatusCause, error) {
+	specField := k8sfield.NewPath("spec")
+	sourceField := specField.Child("source")
+
+	if spec.Source.APIGroup == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotFound,
+			Message: "missing apiGroup",
+			Field:   sourceField.Child("apiGroup").String(),
+		}}, nil
+	}
+
+	if *spec.Source.APIGroup != core.GroupName {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "invalid apiGroup",
+			Field:   sourceField.Child("apiGroup").String(),
+		}}, nil
+	}
+
+	if spec.Source.Kind != "VirtualMachine" {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "invalid kind",
+			Field:   sourceField.Child("kind").String(),
+		}}, nil
+	}
+
+	if spec.Mode != nil && *spec.Mode != snapshotv1.BackupModePush && *spec.Mode != snapshotv1.BackupModePull {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("backup mode %q is not supported", *spec.Mode),
+			Field:   specField.Child("mode").String(),
+		}}, nil
+	}
+
+	if spec.PullCompleted != nil && *spec.PullCompleted {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "pullCompleted can only be set once the backup is exported",
+			Field:   specField.Child("pullCompleted").String(),
+		}}, nil
+	}
+
+	if spec.Target.PersistentVolumeClaimName == "" {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "missing target persistentVolumeClaimName",
+			Field:   specField.Child("target", "persistentVolumeClaimName").String(),
+		}}, nil
+	}
+
+	_, err := admitter.Client.VirtualMachine(namespace).Get(context.Background(), spec.Source.Name, &metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("VirtualMachine %q does not exist", spec.Source.Name),
+			Field:   sourceField.Child("name").String(),
+		}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// validateUpdate only allows the client of a Pull mode backup to complete it
+func validateUpdate(prevSpec, spec *snapshotv1.VirtualMachineBackupSpec) []metav1.StatusCause {
+	specField := k8sfield.NewPath("spec")
+
+	prevPullCompleted := prevSpec.PullCompleted != nil && *prevSpec.PullCompleted
+	pullCompleted := spec.PullCompleted != nil && *spec.PullCompleted
+	if pullCompleted != prevPullCompleted {
+		if prevPullCompleted {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "pullCompleted cannot be unset",
+				Field:   specField.Child("pullCompleted").String(),
+			}}
+		}
+		if spec.Mode == nil || *spec.Mode != snapshotv1.BackupModePull {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "pullCompleted can only be set on a Pull mode backup",
+				Field:   specField.Child("pullCompleted").String(),
+			}}
+		}
+	}
+
+	prevSpec, spec = prevSpec.DeepCopy(), spec.DeepCopy()
+	prevSpec.PullCompleted, spec.PullCompleted = nil, nil
+	if !equality.Semantic.DeepEqual(prevSpec, spec) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "spec in immutable after creation",
+			Field:   specField.String(),
+		}}
+	}
+	return nil
+}
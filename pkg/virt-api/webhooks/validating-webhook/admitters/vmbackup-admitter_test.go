@@ -0,0 +1,269 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Validating VirtualMachineBackup Admitter", func() {
+	const vmName = "vm"
+	apiGroup := "kubevirt.io"
+
+	config, _, kvInformer := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newBackup := func() *snapshotv1.VirtualMachineBackup {
+		return &snapshotv1.VirtualMachineBackup{
+			Spec: snapshotv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+				Target: snapshotv1.BackupTarget{
+					PersistentVolumeClaimName: "backup-pvc",
+				},
+			},
+		}
+	}
+
+	Context("Without feature gate enabled", func() {
+		It("should reject anything", func() {
+			ar := createBackupAdmissionReview(newBackup())
+			resp := createTestVMBackupAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("IncrementalBackup feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{virtconfig.IncrementalBackupGate},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: make([]string, 0),
+						},
+					},
+				},
+			})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := createTestVMBackupAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		DescribeTable("should reject an invalid spec", func(mutate func(*snapshotv1.VirtualMachineBackup), field string) {
+			backup := newBackup()
+			mutate(backup)
+
+			ar := createBackupAdmissionReview(backup)
+			resp := createTestVMBackupAdmitter(config, &v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: vmName}}).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("with missing apiGroup", func(b *snapshotv1.VirtualMachineBackup) { b.Spec.Source.APIGroup = nil }, "spec.source.apiGroup"),
+			Entry("with invalid apiGroup", func(b *snapshotv1.VirtualMachineBackup) { b.Spec.Source.APIGroup = pointer.P("foo.bar") }, "spec.source.apiGroup"),
+			Entry("with invalid kind", func(b *snapshotv1.VirtualMachineBackup) { b.Spec.Source.Kind = "VirtualMachineInstance" }, "spec.source.kind"),
+			Entry("with unsupported mode", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Spec.Mode = pointer.P(snapshotv1.BackupMode("Stream"))
+			}, "spec.mode"),
+			Entry("with pullCompleted", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Spec.Mode = pointer.P(snapshotv1.BackupModePull)
+				b.Spec.PullCompleted = pointer.P(true)
+			}, "spec.pullCompleted"),
+			Entry("with missing target", func(b *snapshotv1.VirtualMachineBackup) { b.Spec.Target.PersistentVolumeClaimName = "" }, "spec.target.persistentVolumeClaimName"),
+		)
+
+		It("should reject when VM does not exist", func() {
+			ar := createBackupAdmissionReview(newBackup())
+			resp := createTestVMBackupAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source.name"))
+		})
+
+		It("should accept when VM exists", func() {
+			backup := newBackup()
+			backup.Spec.Mode = pointer.P(snapshotv1.BackupModePush)
+			backup.Spec.Incremental = pointer.P(true)
+
+			ar := createBackupAdmissionReview(backup)
+			resp := createTestVMBackupAdmitter(config, &v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: vmName}}).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should accept a Pull mode backup", func() {
+			backup := newBackup()
+			backup.Spec.Mode = pointer.P(snapshotv1.BackupModePull)
+
+			ar := createBackupAdmissionReview(backup)
+			resp := createTestVMBackupAdmitter(config, &v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: vmName}}).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should allow to complete a Pull mode backup", func() {
+			oldBackup := newBackup()
+			oldBackup.Spec.Mode = pointer.P(snapshotv1.BackupModePull)
+			backup := oldBackup.DeepCopy()
+			backup.Spec.PullCompleted = pointer.P(true)
+
+			ar := createBackupUpdateAdmissionReview(oldBackup, backup)
+			resp := createTestVMBackupAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject the update of pullCompleted", func(mode snapshotv1.BackupMode, oldPullCompleted, pullCompleted bool, message string) {
+			oldBackup := newBackup()
+			oldBackup.Spec.Mode = pointer.P(mode)
+			oldBackup.Spec.PullCompleted = pointer.P(oldPullCompleted)
+			backup := oldBackup.DeepCopy()
+			backup.Spec.PullCompleted = pointer.P(pullCompleted)
+
+			ar := createBackupUpdateAdmissionReview(oldBackup, backup)
+			resp := createTestVMBackupAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.pullCompleted"))
+			Expect(resp.Result.Details.Causes[0].Message).To(Equal(message))
+		},
+			Entry("on a Push mode backup", snapshotv1.BackupModePush, false, true, "pullCompleted can only be set on a Pull mode backup"),
+			Entry("when it is unset", snapshotv1.BackupModePull, true, false, "pullCompleted cannot be unset"),
+		)
+
+		It("should reject spec update", func() {
+			oldBackup := newBackup()
+			backup := newBackup()
+			backup.Spec.Target.PersistentVolumeClaimName = "other-pvc"
+
+			ar := createBackupUpdateAdmissionReview(oldBackup, backup)
+			resp := createTestVMBackupAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+
+		It("should allow metadata update", func() {
+			oldBackup := newBackup()
+			backup := newBackup()
+			backup.Finalizers = []string{"finalizer"}
+
+			ar := createBackupUpdateAdmissionReview(oldBackup, backup)
+			resp := createTestVMBackupAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+})
+
+func createBackupAdmissionReview(backup *snapshotv1.VirtualMachineBackup) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(backup)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinebackups",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
+
+func createBackupUpdateAdmissionReview(old, current *snapshotv1.VirtualMachineBackup) *admissionv1.AdmissionReview {
+	oldBytes, _ := json.Marshal(old)
+	currentBytes, _ := json.Marshal(current)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Update,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinebackups",
+			},
+			Object: runtime.RawExtension{
+				Raw: currentBytes,
+			},
+			OldObject: runtime.RawExtension{
+				Raw: oldBytes,
+			},
+		},
+	}
+}
+
+func createTestVMBackupAdmitter(config *virtconfig.ClusterConfig, vm *v1.VirtualMachine) *VMBackupAdmitter {
+	ctrl := gomock.NewController(GinkgoT())
+	virtClient := kubecli.NewMockKubevirtClient(ctrl)
+	vmInterface := kubecli.NewMockVirtualMachineInterface(ctrl)
+	virtClient.EXPECT().VirtualMachine(gomock.Any()).Return(vmInterface).AnyTimes()
+	if vm == nil {
+		err := errors.NewNotFound(schema.GroupResource{Group: "kubevirt.io", Resource: "virtualmachines"}, "foo")
+		vmInterface.EXPECT().Get(context.Background(), gomock.Any(), gomock.Any()).Return(nil, err).AnyTimes()
+	} else {
+		vmInterface.EXPECT().Get(context.Background(), vm.Name, gomock.Any()).Return(vm, nil).AnyTimes()
+	}
+	return NewVMBackupAdmitter(config, virtClient)
+}
@@ -40,6 +40,7 @@ const (
 	pvc            = "PersistentVolumeClaim"
 	vmSnapshotKind = "VirtualMachineSnapshot"
 	vmKind         = "VirtualMachine"
+	vmBackupKind   = "VirtualMachineBackup"
 )
 
 // VMExportAdmitter validates VirtualMachineExports
@@ -88,6 +89,12 @@ func (admitter *VMExportAdmitter) Admit(ar *admissionv1.AdmissionReview) *admiss
 		case vmKind:
 			causes = append(causes, admitter.validateVMName(sourceField.Child("name"), vmExport.Spec.Source.Name)...)
 			causes = append(causes, admitter.validateVMApiGroup(sourceField.Child("APIGroup"), vmExport.Spec.Source.APIGroup)...)
+		case vmBackupKind:
+			if !admitter.Config.IncrementalBackupEnabled() {
+				return webhookutils.ToAdmissionResponseError(fmt.Errorf("%s feature gate not enabled", virtconfig.IncrementalBackupGate))
+			}
+			causes = append(causes, admitter.validateVMBackupName(sourceField.Child("name"), vmExport.Spec.Source.Name)...)
+			causes = append(causes, admitter.validateVMBackupApiGroup(sourceField.Child("APIGroup"), vmExport.Spec.Source.APIGroup)...)
 		default:
 			causes = []metav1.StatusCause{
 				{
@@ -211,3 +218,31 @@ func (admitter *VMExportAdmitter) validateVMApiGroup(field *k8sfield.Path, apigr
 
 	return []metav1.StatusCause{}
 }
+
+func (admitter *VMExportAdmitter) validateVMBackupName(field *k8sfield.Path, name string) []metav1.StatusCause {
+	if name == "" {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "VMBackup name must not be empty",
+				Field:   field.String(),
+			},
+		}
+	}
+
+	return []metav1.StatusCause{}
+}
+
+func (admitter *VMExportAdmitter) validateVMBackupApiGroup(field *k8sfield.Path, apigroup *string) []metav1.StatusCause {
+	if apigroup == nil || *apigroup != snapshot.GroupName {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "VMBackup API group must be " + snapshot.GroupName,
+				Field:   field.String(),
+			},
+		}
+	}
+
+	return []metav1.StatusCause{}
+}
@@ -59,12 +59,12 @@ var _ = Describe("Validating VirtualMachineExport Admitter", func() {
 	})
 
 	Context("With feature gate enabled", func() {
-		enableFeatureGate := func(featureGate string) {
+		enableFeatureGate := func(featureGates ...string) {
 			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
 				Spec: v1.KubeVirtSpec{
 					Configuration: v1.KubeVirtConfiguration{
 						DeveloperConfiguration: &v1.DeveloperConfiguration{
-							FeatureGates: []string{featureGate},
+							FeatureGates: featureGates,
 						},
 					},
 				},
@@ -256,6 +256,45 @@ var _ = Describe("Validating VirtualMachineExport Admitter", func() {
 			Entry("virtual machine snapshot", "invalid", vmSnapshotKind),
 			Entry("virtual machine", "invalid", vmKind),
 		)
+
+		Context("with a VirtualMachineBackup source", func() {
+			createBackupExport := func(apiGroup, name string) *exportv1.VirtualMachineExport {
+				return &exportv1.VirtualMachineExport{
+					Spec: exportv1.VirtualMachineExportSpec{
+						Source: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     vmBackupKind,
+							Name:     name,
+						},
+					},
+				}
+			}
+
+			It("should reject the export when the IncrementalBackup feature gate is disabled", func() {
+				ar := createExportAdmissionReview(createBackupExport(snapshotApiGroup, "test"))
+				resp := createTestVMExportAdmitter(config).Admit(ar)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Message).To(Equal("IncrementalBackup feature gate not enabled"))
+			})
+
+			It("should allow the export when the IncrementalBackup feature gate is enabled", func() {
+				enableFeatureGate("VMExport", virtconfig.IncrementalBackupGate)
+				ar := createExportAdmissionReview(createBackupExport(snapshotApiGroup, "test"))
+				resp := createTestVMExportAdmitter(config).Admit(ar)
+				Expect(resp.Allowed).To(BeTrue())
+			})
+
+			DescribeTable("should reject", func(apiGroup, name, errorString string) {
+				enableFeatureGate("VMExport", virtconfig.IncrementalBackupGate)
+				ar := createExportAdmissionReview(createBackupExport(apiGroup, name))
+				resp := createTestVMExportAdmitter(config).Admit(ar)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Message).To(ContainSubstring(errorString))
+			},
+				Entry("a blank name", snapshotApiGroup, "", "VMBackup name must not be empty"),
+				Entry("an invalid apigroup", "invalid", "test", "VMBackup API group must be snapshot.kubevirt.io"),
+			)
+		})
 	})
 })
 
@@ -34,6 +34,7 @@ import (
 
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 
+	"github.com/google/uuid"
 	admissionv1 "k8s.io/api/admission/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -164,6 +165,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateGuestMemoryLimit(field, spec)...)
 	causes = append(causes, validateEmulatedMachine(field, spec, config)...)
 	causes = append(causes, validateFirmwareSerial(field, spec)...)
+	causes = append(causes, validateGenerationID(field, spec)...)
 	causes = append(causes, validateCPURequestNotNegative(field, spec)...)
 	causes = append(causes, validateCPULimitNotNegative(field, spec)...)
 	causes = append(causes, validateCpuRequestDoesNotExceedLimit(field, spec)...)
@@ -212,6 +214,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateNetworksAssignedToInterfaces(field, spec, networkInterfaceMap)...)
 	causes = append(causes, validateInterfaceStateValue(field, spec)...)
 	causes = append(causes, validateInterfaceBinding(field, spec)...)
+	causes = append(causes, validateFirewall(field, spec)...)
 
 	causes = append(causes, validateInputDevices(field, spec)...)
 	causes = append(causes, validateIOThreadsPolicy(field, spec)...)
@@ -237,6 +240,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateGPUsWithPassthroughEnabled(field, spec, config)...)
 	causes = append(causes, validateHostDevicesWithPassthroughEnabled(field, spec, config)...)
 	causes = append(causes, validateSoundDevices(field, spec)...)
+	causes = append(causes, validatePanicDevice(field, spec, config)...)
 	causes = append(causes, validateLaunchSecurity(field, spec, config)...)
 	causes = append(causes, validateVSOCK(field, spec, config)...)
 	causes = append(causes, validatePersistentReservation(field, spec, config)...)
@@ -276,7 +280,7 @@ func validateVirtualMachineInstanceSpecVolumeDisks(field *k8sfield.Path, spec *v
 
 	// Validate that volumes match disks and filesystems correctly
 	for idx, volume := range spec.Volumes {
-		if volume.MemoryDump != nil {
+		if volume.MemoryDump != nil || volume.Backup != nil {
 			continue
 		}
 		if _, matchingDiskExists := diskAndFilesystemNames[volume.Name]; !matchingDiskExists {
@@ -318,6 +322,8 @@ func validateNetworksMatchInterfaces(field *k8sfield.Path, spec *v1.VirtualMachi
 		causes = append(causes, validateMacAddress(field, iface, idx)...)
 		causes = append(causes, validateInterfaceBootOrder(field, iface, idx, bootOrderMap)...)
 		causes = append(causes, validateInterfacePciAddress(field, iface, idx)...)
+		causes = append(causes, validateInterfaceBandwidthBinding(field, iface, idx)...)
+		causes = append(causes, validateInterfaceBandwidth(field.Child("domain", "devices", "interfaces").Index(idx).Child("bandwidth"), iface.Bandwidth)...)
 
 		newCauses, newDone := validateDHCPExtraOptions(field, iface)
 		causes = append(causes, newCauses...)
@@ -425,6 +431,62 @@ func validateInterfacePciAddress(field *k8sfield.Path, iface v1.Interface, idx i
 	return causes
 }
 
+func validateInterfaceBandwidthBinding(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	// The limits are enforced on the tap devices which virt-handler creates for these bindings only
+	if iface.Bandwidth != nil && iface.Bridge == nil && iface.Masquerade == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("interface %s only supports bandwidth limits with the bridge and masquerade bindings.", field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String()),
+			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("bandwidth").String(),
+		})
+	}
+	return causes
+}
+
+func validateInterfaceBandwidth(field *k8sfield.Path, bandwidth *v1.InterfaceBandwidth) (causes []metav1.StatusCause) {
+	if bandwidth == nil {
+		return
+	}
+
+	directions := []struct {
+		name  string
+		limit *v1.InterfaceBandwidthLimit
+	}{
+		{"inbound", bandwidth.Inbound},
+		{"outbound", bandwidth.Outbound},
+	}
+
+	for _, direction := range directions {
+		name, limit := direction.name, direction.limit
+		if limit == nil {
+			continue
+		}
+		if limit.Average == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must be greater than 0", field.Child(name, "average").String()),
+				Field:   field.Child(name, "average").String(),
+			})
+		}
+		if limit.Peak != nil && *limit.Peak < limit.Average {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than or equal to average", field.Child(name, "peak").String()),
+				Field:   field.Child(name, "peak").String(),
+			})
+		}
+		if limit.Burst != nil && *limit.Burst == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than 0", field.Child(name, "burst").String()),
+				Field:   field.Child(name, "burst").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
 func validateInterfaceBootOrder(field *k8sfield.Path, iface v1.Interface, idx int, bootOrderMap map[uint]bool) (causes []metav1.StatusCause) {
 	if iface.BootOrder != nil {
 		order := *iface.BootOrder
@@ -884,6 +946,52 @@ func validateSoundDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
+func validatePanicDevice(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	panicDevice := spec.Domain.Devices.PanicDevice
+	if panicDevice == nil {
+		return causes
+	}
+	panicField := field.Child("domain", "devices", "panicDevice")
+
+	switch panicDevice.Model {
+	case "", v1.PanicDeviceModelPVPanic, v1.PanicDeviceModelISA, v1.PanicDeviceModelHyperV:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("panic device model %s is not supported. Options: '%s', '%s' or '%s'", panicDevice.Model, v1.PanicDeviceModelPVPanic, v1.PanicDeviceModelISA, v1.PanicDeviceModelHyperV),
+			Field:   panicField.Child("model").String(),
+		})
+	}
+
+	switch panicDevice.OnCrash {
+	case "", v1.CrashActionRestart, v1.CrashActionPoweroff, v1.CrashActionPreserve:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("crash action %s is not supported. Options: '%s', '%s' or '%s'", panicDevice.OnCrash, v1.CrashActionRestart, v1.CrashActionPoweroff, v1.CrashActionPreserve),
+			Field:   panicField.Child("onCrash").String(),
+		})
+	}
+
+	if panicDevice.MemoryDumpClaimName != "" {
+		if panicDevice.OnCrash != v1.CrashActionPreserve {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("a memory dump of a crashed guest requires the crash action %s", v1.CrashActionPreserve),
+				Field:   panicField.Child("memoryDumpClaimName").String(),
+			})
+		}
+		if !config.HotplugVolumesEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.HotplugVolumesGate),
+				Field:   panicField.Child("memoryDumpClaimName").String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateLaunchSecurity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
 	launchSecurity := spec.Domain.LaunchSecurity
 	if launchSecurity != nil && !config.WorkloadEncryptionSEVEnabled() {
@@ -1388,6 +1496,20 @@ func validateFirmwareSerial(field *k8sfield.Path, spec *v1.VirtualMachineInstanc
 	return causes
 }
 
+func validateGenerationID(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	if spec.Domain.Firmware == nil || spec.Domain.Firmware.GenerationID == nil || spec.Domain.Firmware.GenerationID.UUID == "" {
+		return causes
+	}
+	if _, err := uuid.Parse(string(spec.Domain.Firmware.GenerationID.UUID)); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be a valid UUID, if specified", field.Child("domain", "firmware", "generationID", "uuid").String()),
+			Field:   field.Child("domain", "firmware", "generationID", "uuid").String(),
+		})
+	}
+	return causes
+}
+
 func validateEmulatedMachine(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
 	if machine := spec.Domain.Machine; machine != nil && len(machine.Type) > 0 {
 		supportedMachines := config.GetEmulatedMachines(spec.Architecture)
@@ -1693,6 +1815,16 @@ func ValidateVirtualMachineInstanceMetadata(field *k8sfield.Path, metadata *meta
 		}
 	}
 
+	// Only virt-controller creates VMIs which receive a cross-cluster migration, the migration
+	// tunnel forwards connections to the target nodes of these VMIs
+	if _, exists := annotations[v1.MigrationReceiverAnnotation]; exists && !webhooks.IsControllerServiceAccount(accountName) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("creation of the annotation %s is only allowed for virt-controller", v1.MigrationReceiverAnnotation),
+			Field:   field.Child("annotations", v1.MigrationReceiverAnnotation).String(),
+		})
+	}
+
 	// Validate ignition feature gate if set when the corresponding annotation is found
 	if annotations[v1.IgnitionAnnotation] != "" && !config.IgnitionEnabled() {
 		causes = append(causes, metav1.StatusCause{
@@ -2102,6 +2234,9 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			memoryDumpVolumeCount++
 			volumeSourceSetCount++
 		}
+		if volume.Backup != nil {
+			volumeSourceSetCount++
+		}
 
 		if volumeSourceSetCount != 1 {
 			causes = append(causes, metav1.StatusCause{
@@ -2518,6 +2653,8 @@ func validateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 			})
 		}
 
+		causes = append(causes, validateDiskIOTune(field.Index(idx).Child("ioTune"), disk.IOTune)...)
+
 		// Verify disk and volume name can be a valid container name since disk
 		// name can become a container name which will fail to schedule if invalid
 		errs := validation.IsDNS1123Label(disk.Name)
@@ -2580,6 +2717,83 @@ func validateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 	return causes
 }
 
+type ioTuneLimit struct {
+	name      string
+	value     *uint64
+	max       *uint64
+	maxLength *uint64
+}
+
+func validateDiskIOTune(field *k8sfield.Path, ioTune *v1.DiskIOTune) (causes []metav1.StatusCause) {
+	if ioTune == nil {
+		return
+	}
+
+	limitGroups := [][3]ioTuneLimit{
+		{
+			{"totalBytesSec", ioTune.TotalBytesSec, ioTune.TotalBytesSecMax, ioTune.TotalBytesSecMaxLength},
+			{"readBytesSec", ioTune.ReadBytesSec, ioTune.ReadBytesSecMax, ioTune.ReadBytesSecMaxLength},
+			{"writeBytesSec", ioTune.WriteBytesSec, ioTune.WriteBytesSecMax, ioTune.WriteBytesSecMaxLength},
+		},
+		{
+			{"totalIOPSSec", ioTune.TotalIOPSSec, ioTune.TotalIOPSSecMax, ioTune.TotalIOPSSecMaxLength},
+			{"readIOPSSec", ioTune.ReadIOPSSec, ioTune.ReadIOPSSecMax, ioTune.ReadIOPSSecMaxLength},
+			{"writeIOPSSec", ioTune.WriteIOPSSec, ioTune.WriteIOPSSecMax, ioTune.WriteIOPSSecMaxLength},
+		},
+	}
+
+	hasLimit := false
+	for _, limits := range limitGroups {
+		total, read, write := limits[0], limits[1], limits[2]
+		if (total.value != nil && (read.value != nil || write.value != nil)) ||
+			(total.max != nil && (read.max != nil || write.max != nil)) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s can not be combined with %s or %s", field.Child(total.name).String(), read.name, write.name),
+				Field:   field.Child(total.name).String(),
+			})
+		}
+
+		for _, limit := range limits {
+			if limit.value != nil {
+				hasLimit = true
+			}
+			if limit.max != nil {
+				if limit.value == nil {
+					causes = append(causes, metav1.StatusCause{
+						Type:    metav1.CauseTypeFieldValueRequired,
+						Message: fmt.Sprintf("%s requires %s to be set", field.Child(limit.name+"Max").String(), limit.name),
+						Field:   field.Child(limit.name + "Max").String(),
+					})
+				} else if *limit.max < *limit.value {
+					causes = append(causes, metav1.StatusCause{
+						Type:    metav1.CauseTypeFieldValueInvalid,
+						Message: fmt.Sprintf("%s must be greater than or equal to %s", field.Child(limit.name+"Max").String(), limit.name),
+						Field:   field.Child(limit.name + "Max").String(),
+					})
+				}
+			}
+			if limit.maxLength != nil && limit.max == nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: fmt.Sprintf("%s requires %sMax to be set", field.Child(limit.name+"MaxLength").String(), limit.name),
+					Field:   field.Child(limit.name + "MaxLength").String(),
+				})
+			}
+		}
+	}
+
+	if ioTune.GroupName != "" && !hasLimit {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s requires at least one limit to be set", field.Child("groupName").String()),
+			Field:   field.Child("groupName").String(),
+		})
+	}
+
+	return causes
+}
+
 // Rejects kernel boot defined with initrd/kernel path but without an image
 func validateKernelBoot(field *k8sfield.Path, kernelBoot *v1.KernelBoot) (causes []metav1.StatusCause) {
 	if kernelBoot == nil {
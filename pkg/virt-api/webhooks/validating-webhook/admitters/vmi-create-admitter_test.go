@@ -462,6 +462,35 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				true,
 			),
 		)
+		DescribeTable("Should only allow virt-controller to create VMIs which receive a cross-cluster migration",
+			func(userAccount string, positive bool) {
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Annotations = map[string]string{v1.MigrationReceiverAnnotation: "id"}
+				vmiBytes, _ := json.Marshal(&vmi)
+				ar := &admissionv1.AdmissionReview{
+					Request: &admissionv1.AdmissionRequest{
+						Operation: admissionv1.Create,
+						UserInfo:  authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + userAccount},
+						Resource:  webhooks.VirtualMachineInstanceGroupVersionResource,
+						Object: runtime.RawExtension{
+							Raw: vmiBytes,
+						},
+					},
+				}
+				resp := vmiCreateAdmitter.Admit(ar)
+				if positive {
+					Expect(resp.Allowed).To(BeTrue())
+				} else {
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("metadata.annotations.kubevirt.io/migration-receiver"))
+				}
+			},
+			Entry("Create by Controller", components.ControllerServiceAccountName, true),
+			Entry("Create by API", components.ApiServiceAccountName, false),
+			Entry("Create by Handler", components.HandlerServiceAccountName, false),
+			Entry("Create by non kubevirt user", "user-account", false),
+		)
 		DescribeTable("should reject annotations which require feature gate enabled", func(annotations map[string]string, expectedMsg string) {
 			vmi := api.NewMinimalVMI("testvmi")
 			vmi.ObjectMeta = metav1.ObjectMeta{
@@ -661,6 +690,27 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(HaveLen(1))
 			Expect(causes[0].Field).To(Equal("fake.Sound"))
 		})
+		DescribeTable("should validate the panic device", func(panicDevice *v1.PanicDevice, hotplugVolumes bool, expectedFields ...string) {
+			if hotplugVolumes {
+				enableFeatureGate(virtconfig.HotplugVolumesGate)
+			}
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.PanicDevice = panicDevice
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, field := range expectedFields {
+				Expect(causes[i].Field).To(Equal(field))
+			}
+		},
+			Entry("and accept the defaults", &v1.PanicDevice{}, false),
+			Entry("and accept a supported model and crash action", &v1.PanicDevice{Model: v1.PanicDeviceModelISA, OnCrash: v1.CrashActionRestart}, false),
+			Entry("and accept a memory dump of a preserved guest", &v1.PanicDevice{OnCrash: v1.CrashActionPreserve, MemoryDumpClaimName: "dump"}, true),
+			Entry("and reject an unsupported model", &v1.PanicDevice{Model: "unknown"}, false, "fake.domain.devices.panicDevice.model"),
+			Entry("and reject an unsupported crash action", &v1.PanicDevice{OnCrash: "unknown"}, false, "fake.domain.devices.panicDevice.onCrash"),
+			Entry("and reject a memory dump without preserving the guest", &v1.PanicDevice{OnCrash: v1.CrashActionRestart, MemoryDumpClaimName: "dump"}, true, "fake.domain.devices.panicDevice.memoryDumpClaimName"),
+			Entry("and reject a memory dump without HotplugVolumes", &v1.PanicDevice{OnCrash: v1.CrashActionPreserve, MemoryDumpClaimName: "dump"}, false, "fake.domain.devices.panicDevice.memoryDumpClaimName"),
+		)
 		It("should reject volume with missing disk / file system", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
@@ -1976,6 +2026,53 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			}
 		})
 
+		It("should accept valid bandwidth limits", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{
+				Inbound:  &v1.InterfaceBandwidthLimit{Average: 1000, Peak: kubevirtpointer.P(uint64(2000)), Burst: kubevirtpointer.P(uint64(512))},
+				Outbound: &v1.InterfaceBandwidthLimit{Average: 500},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject invalid bandwidth limits", func(bandwidth *v1.InterfaceBandwidth, expectedField, expectedMessage string) {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = bandwidth
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("without an average",
+				&v1.InterfaceBandwidth{Inbound: &v1.InterfaceBandwidthLimit{Peak: kubevirtpointer.P(uint64(2000))}},
+				"fake.domain.devices.interfaces[0].bandwidth.inbound.average", "fake.domain.devices.interfaces[0].bandwidth.inbound.average must be greater than 0"),
+			Entry("with a peak below the average",
+				&v1.InterfaceBandwidth{Outbound: &v1.InterfaceBandwidthLimit{Average: 2000, Peak: kubevirtpointer.P(uint64(1000))}},
+				"fake.domain.devices.interfaces[0].bandwidth.outbound.peak", "fake.domain.devices.interfaces[0].bandwidth.outbound.peak must be greater than or equal to average"),
+			Entry("with an empty burst",
+				&v1.InterfaceBandwidth{Inbound: &v1.InterfaceBandwidthLimit{Average: 1000, Burst: kubevirtpointer.P(uint64(0))}},
+				"fake.domain.devices.interfaces[0].bandwidth.inbound.burst", "fake.domain.devices.interfaces[0].bandwidth.inbound.burst must be greater than 0"),
+		)
+
+		DescribeTable("should reject bandwidth limits on interfaces without a tap device created by virt-handler", func(binding v1.InterfaceBindingMethod) {
+			iface := v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: binding,
+				Bandwidth:              &v1.InterfaceBandwidth{Inbound: &v1.InterfaceBandwidthLimit{Average: 1000}},
+			}
+			causes := validateInterfaceBandwidthBinding(k8sfield.NewPath("fake"), iface, 0)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].bandwidth"))
+		},
+			Entry("with the SR-IOV binding", v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}),
+			Entry("with the passt binding", v1.InterfaceBindingMethod{Passt: &v1.InterfacePasst{}}),
+		)
+
 		It("should accept valid NTP servers", func() {
 			vmi := api.NewMinimalVMI("testvm")
 			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
@@ -3191,6 +3288,57 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("enospace", v1.DiskErrorPolicyEnospace),
 		)
 
+		DescribeTable("should reject disk with invalid ioTune", func(ioTune *v1.DiskIOTune, expectedField, expectedMessage string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", IOTune: ioTune, DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{}}})
+
+			causes := validateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("with total and read throughput",
+				&v1.DiskIOTune{TotalBytesSec: kubevirtpointer.P(uint64(1024)), ReadBytesSec: kubevirtpointer.P(uint64(1024))},
+				"fake[0].ioTune.totalBytesSec", "fake[0].ioTune.totalBytesSec can not be combined with readBytesSec or writeBytesSec"),
+			Entry("with total and write IOPS bursts",
+				&v1.DiskIOTune{
+					TotalIOPSSec: kubevirtpointer.P(uint64(100)), TotalIOPSSecMax: kubevirtpointer.P(uint64(200)),
+					WriteIOPSSec: kubevirtpointer.P(uint64(100)), WriteIOPSSecMax: kubevirtpointer.P(uint64(200)),
+				},
+				"fake[0].ioTune.totalIOPSSec", "fake[0].ioTune.totalIOPSSec can not be combined with readIOPSSec or writeIOPSSec"),
+			Entry("with a burst without a limit",
+				&v1.DiskIOTune{ReadIOPSSecMax: kubevirtpointer.P(uint64(100))},
+				"fake[0].ioTune.readIOPSSecMax", "fake[0].ioTune.readIOPSSecMax requires readIOPSSec to be set"),
+			Entry("with a burst below the limit",
+				&v1.DiskIOTune{WriteBytesSec: kubevirtpointer.P(uint64(2048)), WriteBytesSecMax: kubevirtpointer.P(uint64(1024))},
+				"fake[0].ioTune.writeBytesSecMax", "fake[0].ioTune.writeBytesSecMax must be greater than or equal to writeBytesSec"),
+			Entry("with a burst length without a burst",
+				&v1.DiskIOTune{TotalIOPSSec: kubevirtpointer.P(uint64(100)), TotalIOPSSecMaxLength: kubevirtpointer.P(uint64(10))},
+				"fake[0].ioTune.totalIOPSSecMaxLength", "fake[0].ioTune.totalIOPSSecMaxLength requires totalIOPSSecMax to be set"),
+			Entry("with a group without limits",
+				&v1.DiskIOTune{GroupName: "group"},
+				"fake[0].ioTune.groupName", "fake[0].ioTune.groupName requires at least one limit to be set"),
+		)
+
+		It("should accept a disk with a valid ioTune", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}},
+				IOTune: &v1.DiskIOTune{
+					TotalBytesSec:        kubevirtpointer.P(uint64(1024)),
+					ReadIOPSSec:          kubevirtpointer.P(uint64(100)),
+					ReadIOPSSecMax:       kubevirtpointer.P(uint64(200)),
+					ReadIOPSSecMaxLength: kubevirtpointer.P(uint64(10)),
+					WriteIOPSSec:         kubevirtpointer.P(uint64(50)),
+					GroupName:            "group",
+				}})
+
+			causes := validateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(BeEmpty())
+		})
+
 		It("should reject invalid SN characters", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 			order := uint(1)
@@ -3828,6 +3976,21 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.domain.devices.sound"))
 			Expect(causes[0].Message).To(Equal("Arm64 not support sound device"))
 		})
+
+		DescribeTable("should validate the panic device model", func(model v1.PanicDeviceModel, expectedCauses int) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.PanicDevice = &v1.PanicDevice{Model: model}
+			causes := webhooks.ValidateVirtualMachineInstanceArm64Setting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(expectedCauses))
+			if expectedCauses > 0 {
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.panicDevice.model"))
+			}
+		},
+			Entry("and accept the default model", v1.PanicDeviceModel(""), 0),
+			Entry("and accept pvpanic", v1.PanicDeviceModelPVPanic, 0),
+			Entry("and reject isa", v1.PanicDeviceModelISA, 1),
+			Entry("and reject hyperv", v1.PanicDeviceModelHyperV, 1),
+		)
 	})
 
 	Context("with realtime", func() {
@@ -5069,6 +5232,21 @@ var _ = Describe("Function getNumberOfPodInterfaces()", func() {
 		Expect(causes).To(BeEmpty())
 	})
 
+	DescribeTable("should validate the VM Generation ID", func(generationID v1.GenerationID, expectedCauses int) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Firmware = &v1.Firmware{GenerationID: &generationID}
+
+		causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), spec, config)
+		Expect(causes).To(HaveLen(expectedCauses))
+		if expectedCauses > 0 {
+			Expect(causes[0].Field).To(Equal("fake.domain.firmware.generationID.uuid"))
+		}
+	},
+		Entry("and accept an empty UUID", v1.GenerationID{}, 0),
+		Entry("and accept a valid UUID", v1.GenerationID{UUID: "6a1a24a1-4061-4607-8bf4-a3963d0c5895"}, 0),
+		Entry("and reject an invalid UUID", v1.GenerationID{UUID: "not-a-uuid"}, 1),
+	)
+
 	It("Should validate VMIs without HyperV configuration", func() {
 		vmi := api.NewMinimalVMI("testvmi")
 		Expect(vmi.Spec.Domain.Features).To(BeNil())
@@ -72,6 +72,10 @@ func (admitter *VMIUpdateAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 		return reviewResponse
 	}
 
+	if reviewResponse := admitMigrationReceiverUpdate(newVMI, oldVMI, ar); reviewResponse != nil {
+		return reviewResponse
+	}
+
 	return &admissionv1.AdmissionResponse{
 		Allowed:  true,
 		Warnings: warnDeprecatedAPIs(&newVMI.Spec, admitter.ClusterConfig),
@@ -79,13 +83,13 @@ func (admitter *VMIUpdateAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 }
 
 func getExpectedDisksAndFilesystems(newVolumes []v1.Volume) int {
-	numMemoryDumpVolumes := 0
+	numDisklessVolumes := 0
 	for _, volume := range newVolumes {
-		if volume.MemoryDump != nil {
-			numMemoryDumpVolumes = numMemoryDumpVolumes + 1
+		if volume.MemoryDump != nil || volume.Backup != nil {
+			numDisklessVolumes = numDisklessVolumes + 1
 		}
 	}
-	return len(newVolumes) - numMemoryDumpVolumes
+	return len(newVolumes) - numDisklessVolumes
 }
 
 // admitHotplugStorage compares the old and new volumes and disks, and ensures that they match and are valid.
@@ -108,7 +112,7 @@ func admitHotplugStorage(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks
 	newDiskMap := getDiskMap(newDisks)
 	oldDiskMap := getDiskMap(oldDisks)
 
-	permanentAr := verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap, newDiskMap, oldDiskMap)
+	permanentAr := verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap, newDiskMap, oldDiskMap, getMigratedVolumeNames(newVMI))
 	if permanentAr != nil {
 		return permanentAr
 	}
@@ -130,7 +134,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 	for k, v := range newHotplugVolumeMap {
 		if _, ok := oldHotplugVolumeMap[k]; ok {
 			// New and old have same volume, ensure they are the same
-			if !equality.Semantic.DeepEqual(v, oldHotplugVolumeMap[k]) {
+			if !equalVolumesIgnoringPullCompleted(v, oldHotplugVolumeMap[k]) {
 				return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 					{
 						Type:    metav1.CauseTypeFieldValueInvalid,
@@ -138,7 +142,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					},
 				})
 			}
-			if v.MemoryDump == nil {
+			if v.MemoryDump == nil && v.Backup == nil {
 				if _, ok := newDisks[k]; !ok {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 						{
@@ -147,7 +151,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 						},
 					})
 				}
-				if !equality.Semantic.DeepEqual(newDisks[k], oldDisks[k]) {
+				if !equalDisksIgnoringIOTune(newDisks[k], oldDisks[k]) {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 						{
 							Type:    metav1.CauseTypeFieldValueInvalid,
@@ -157,8 +161,8 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 				}
 			}
 		} else {
-			// This is a new volume, ensure that the volume is either DV, PVC or memoryDumpVolume
-			if v.DataVolume == nil && v.PersistentVolumeClaim == nil && v.MemoryDump == nil {
+			// This is a new volume, ensure that the volume is either DV, PVC, memoryDumpVolume or backupVolume
+			if v.DataVolume == nil && v.PersistentVolumeClaim == nil && v.MemoryDump == nil && v.Backup == nil {
 				return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 					{
 						Type:    metav1.CauseTypeFieldValueInvalid,
@@ -166,7 +170,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					},
 				})
 			}
-			if v.MemoryDump == nil {
+			if v.MemoryDump == nil && v.Backup == nil {
 				// Also ensure the matching new disk exists and is of type scsi
 				if _, ok := newDisks[k]; !ok {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
@@ -200,7 +204,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 	return nil
 }
 
-func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[string]v1.Volume, newDisks, oldDisks map[string]v1.Disk) *admissionv1.AdmissionResponse {
+func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[string]v1.Volume, newDisks, oldDisks map[string]v1.Disk, migratedVolumes map[string]bool) *admissionv1.AdmissionResponse {
 	if len(newPermanentVolumeMap) != len(oldPermanentVolumeMap) {
 		// Removed one of the permanent volumes, reject admission.
 		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
@@ -222,7 +226,8 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 				},
 			})
 		}
-		if !equality.Semantic.DeepEqual(v, oldPermanentVolumeMap[k]) {
+		// The source of the volumes under migration is replaced by the destination volume
+		if !migratedVolumes[k] && !equality.Semantic.DeepEqual(v, oldPermanentVolumeMap[k]) {
 			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 				{
 					Type:    metav1.CauseTypeFieldValueInvalid,
@@ -230,7 +235,7 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 				},
 			})
 		}
-		if !equality.Semantic.DeepEqual(newDisks[k], oldDisks[k]) {
+		if !equalDisksIgnoringIOTune(newDisks[k], oldDisks[k]) {
 			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 				{
 					Type:    metav1.CauseTypeFieldValueInvalid,
@@ -242,6 +247,32 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 	return nil
 }
 
+// equalDisksIgnoringIOTune compares the disks without their IO throttling, which can be updated live
+func equalDisksIgnoringIOTune(newDisk, oldDisk v1.Disk) bool {
+	newDisk.IOTune = nil
+	oldDisk.IOTune = nil
+	return equality.Semantic.DeepEqual(newDisk, oldDisk)
+}
+
+// equalVolumesIgnoringPullCompleted compares the volumes without the completion of a pull mode backup,
+// which is requested once the client read the exported disks
+func equalVolumesIgnoringPullCompleted(newVolume, oldVolume v1.Volume) bool {
+	if newVolume.Backup != nil && oldVolume.Backup != nil {
+		newBackup, oldBackup := *newVolume.Backup, *oldVolume.Backup
+		newBackup.PullCompleted, oldBackup.PullCompleted = false, false
+		newVolume.Backup, oldVolume.Backup = &newBackup, &oldBackup
+	}
+	return equality.Semantic.DeepEqual(newVolume, oldVolume)
+}
+
+func getMigratedVolumeNames(vmi *v1.VirtualMachineInstance) map[string]bool {
+	migratedVolumes := make(map[string]bool)
+	for _, v := range vmi.Status.MigratedVolumes {
+		migratedVolumes[v.VolumeName] = true
+	}
+	return migratedVolumes
+}
+
 func getDiskMap(disks []v1.Disk) map[string]v1.Disk {
 	newDiskMap := make(map[string]v1.Disk, 0)
 	for _, disk := range disks {
@@ -308,6 +339,30 @@ func admitVMILabelsUpdate(
 	return nil
 }
 
+// admitMigrationReceiverUpdate only allows virt-controller to change which cross-cluster migration a VMI receives
+func admitMigrationReceiverUpdate(
+	newVMI *v1.VirtualMachineInstance,
+	oldVMI *v1.VirtualMachineInstance,
+	ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+
+	if webhooks.IsControllerServiceAccount(ar.Request.UserInfo.Username) {
+		return nil
+	}
+
+	oldMigrationID, oldExists := oldVMI.Annotations[v1.MigrationReceiverAnnotation]
+	newMigrationID, newExists := newVMI.Annotations[v1.MigrationReceiverAnnotation]
+	if oldExists != newExists || oldMigrationID != newMigrationID {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("modification of the annotation %s on a VMI object is prohibited", v1.MigrationReceiverAnnotation),
+			},
+		})
+	}
+
+	return nil
+}
+
 func filterKubevirtLabels(labels map[string]string) map[string]string {
 	m := make(map[string]string)
 	if len(labels) == 0 {
@@ -37,6 +37,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
@@ -264,6 +265,42 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		),
 	)
 
+	DescribeTable("Should only allow virt-controller to modify the migration receiver annotation",
+		func(originalAnnotations map[string]string, updateAnnotations map[string]string, serviceAccount string, allowed bool) {
+			vmi := api.NewMinimalVMI("testvmi")
+			updateVmi := vmi.DeepCopy()
+			vmi.Annotations = originalAnnotations
+			updateVmi.Annotations = updateAnnotations
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					UserInfo:  authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + serviceAccount},
+					Resource:  webhooks.VirtualMachineInstanceGroupVersionResource,
+					Operation: admissionv1.Update,
+				},
+			}
+			resp := admitMigrationReceiverUpdate(updateVmi, vmi, ar)
+			if allowed {
+				Expect(resp).To(BeNil())
+			} else {
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Message).To(Equal("modification of the annotation kubevirt.io/migration-receiver on a VMI object is prohibited"))
+			}
+		},
+		Entry("Removal by Controller",
+			map[string]string{v1.MigrationReceiverAnnotation: "id"}, nil, components.ControllerServiceAccountName, true),
+		Entry("Removal by API",
+			map[string]string{v1.MigrationReceiverAnnotation: "id"}, nil, components.ApiServiceAccountName, false),
+		Entry("Addition by Handler",
+			nil, map[string]string{v1.MigrationReceiverAnnotation: "id"}, components.HandlerServiceAccountName, false),
+		Entry("Addition by a user",
+			nil, map[string]string{v1.MigrationReceiverAnnotation: "id"}, "user-account", false),
+		Entry("Change by a user",
+			map[string]string{v1.MigrationReceiverAnnotation: "id"}, map[string]string{v1.MigrationReceiverAnnotation: "other"}, "user-account", false),
+		Entry("Update of other annotations by a user",
+			map[string]string{v1.MigrationReceiverAnnotation: "id"}, map[string]string{v1.MigrationReceiverAnnotation: "id", "other": "value"}, "user-account", true),
+	)
+
 	emptyResult := func() map[string]v1.Volume {
 		return make(map[string]v1.Volume, 0)
 	}
@@ -438,6 +475,14 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		return res
 	}
 
+	makeDisksWithIOTune := func(indexes ...int) []v1.Disk {
+		res := makeDisks(indexes...)
+		for i := range res {
+			res[i].IOTune = &v1.DiskIOTune{TotalIOPSSec: pointer.P(uint64(100))}
+		}
+		return res
+	}
+
 	makeDisksNoVolume := func(indexes ...int) []v1.Disk {
 		res := make([]v1.Disk, 0)
 		for _, index := range indexes {
@@ -508,6 +553,20 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		Entry("Should return 3 volumes if  1 hotplugged volume", makeVolumes(0, 1, 2, 3), makeStatus(4, 1), makeResult(0, 1, 2)),
 	)
 
+	makeVolumesWithBackupVol := func(backupName string, pullCompleted bool) []v1.Volume {
+		source := &v1.BackupVolumeSource{
+			BackupName:    backupName,
+			Pull:          true,
+			PullCompleted: pullCompleted,
+		}
+		source.ClaimName = "volume-name-2"
+		source.Hotpluggable = true
+		return append(makeVolumes(0, 1), v1.Volume{
+			Name:         "volume-name-2",
+			VolumeSource: v1.VolumeSource{Backup: source},
+		})
+	}
+
 	testHotplugResponse := func(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks []v1.Disk, filesystems []v1.Filesystem, volumeStatuses []v1.VolumeStatus, expected *admissionv1.AdmissionResponse) {
 		newVMI := api.NewMinimalVMI("testvmi")
 		newVMI.Spec.Volumes = newVolumes
@@ -559,6 +618,14 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeFilesystems(),
 			makeStatus(1, 0),
 			makeExpected("permanent disk volume-name-0, changed", "")),
+		Entry("Should accept if the IO throttling of a disk changed",
+			makeVolumes(0, 1),
+			makeVolumes(0, 1),
+			makeDisksWithIOTune(0, 1),
+			makeDisks(0, 1),
+			makeFilesystems(),
+			makeStatus(2, 1),
+			nil),
 		Entry("Should reject if a hotplug volume changed",
 			makeInvalidVolumes(2, 1),
 			makeVolumes(0, 1),
@@ -631,6 +698,22 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeFilesystems(),
 			makeStatus(3, 1),
 			nil),
+		Entry("Should accept if the completion of a pull mode backup is requested",
+			makeVolumesWithBackupVol("backup", true),
+			makeVolumesWithBackupVol("backup", false),
+			makeDisks(0, 1),
+			makeDisks(0, 1),
+			makeFilesystems(),
+			makeStatus(3, 1),
+			nil),
+		Entry("Should reject if another field of a backup volume changed",
+			makeVolumesWithBackupVol("other-backup", false),
+			makeVolumesWithBackupVol("backup", false),
+			makeDisks(0, 1),
+			makeDisks(0, 1),
+			makeFilesystems(),
+			makeStatus(3, 1),
+			makeExpected("hotplug volume volume-name-2, changed", "")),
 		Entry("Should reject if #volumes != #disks even when there is memory dump volume",
 			makeVolumesWithMemoryDumpVol(3, 2),
 			makeVolumesWithMemoryDumpVol(3, 2),
@@ -641,6 +724,24 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeExpected("number of disks and filesystems (1) does not equal the number of volumes (2)", "")),
 	)
 
+	DescribeTable("Should admit the update of the migrated volumes", func(migratedVolumes []v1.StorageMigratedVolumeInfo, expected *admissionv1.AdmissionResponse) {
+		oldVolumes := makeVolumes(0, 1)
+		newVolumes := makeVolumes(0, 1)
+		newVolumes[1].DataVolume.Name = "dv-dst"
+		newVMI := api.NewMinimalVMI("testvmi")
+		newVMI.Spec.Volumes = newVolumes
+		newVMI.Spec.Domain.Devices.Disks = makeDisks(0, 1)
+		newVMI.Status.MigratedVolumes = migratedVolumes
+
+		result := admitHotplugStorage(newVolumes, oldVolumes, makeDisks(0, 1), makeDisks(0, 1), makeStatus(2, 0), newVMI, vmiUpdateAdmitter.ClusterConfig)
+		Expect(equality.Semantic.DeepEqual(result, expected)).To(BeTrue(), "result: %v and expected: %v do not match", result, expected)
+	},
+		Entry("Should accept if the volume is migrated", []v1.StorageMigratedVolumeInfo{{VolumeName: "volume-name-1"}}, nil),
+		Entry("Should reject if another volume is migrated", []v1.StorageMigratedVolumeInfo{{VolumeName: "volume-name-0"}},
+			makeExpected("permanent volume volume-name-1, changed", "")),
+		Entry("Should reject if no volume is migrated", nil, makeExpected("permanent volume volume-name-1, changed", "")),
+	)
+
 	Context("with filesystem devices", func() {
 		BeforeEach(func() {
 			enableFeatureGate(virtconfig.VirtIOFSGate)
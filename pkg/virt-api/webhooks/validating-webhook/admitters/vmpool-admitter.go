@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
@@ -117,6 +118,14 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 		})
 	}
 
+	if spec.UpdateStrategy != nil && spec.UpdateStrategy.RollingUpdate != nil {
+		causes = append(causes, validateVMPoolRollingUpdate(field.Child("updateStrategy", "rollingUpdate"), spec.UpdateStrategy.RollingUpdate)...)
+	}
+
+	if spec.ScaleInPolicy != nil {
+		causes = append(causes, validateVMPoolScaleInPolicy(field.Child("scaleInPolicy"), *spec.ScaleInPolicy)...)
+	}
+
 	if ar.Request.Operation == admissionv1.Update {
 		oldPool := &poolv1.VirtualMachinePool{}
 		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldPool); err != nil {
@@ -136,3 +145,98 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 	}
 	return causes
 }
+
+func validateVMPoolRollingUpdate(field *k8sfield.Path, rollingUpdate *poolv1.VirtualMachinePoolRollingUpdate) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	maxUnavailable, maxUnavailableCauses := validateIntOrPercent(field.Child("maxUnavailable"), rollingUpdate.MaxUnavailable)
+	maxSurge, maxSurgeCauses := validateIntOrPercent(field.Child("maxSurge"), rollingUpdate.MaxSurge)
+	causes = append(causes, maxUnavailableCauses...)
+	causes = append(causes, maxSurgeCauses...)
+	if len(causes) == 0 && rollingUpdate.MaxUnavailable != nil && maxUnavailable == 0 && maxSurge == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxUnavailable may not be 0 when maxSurge is 0",
+			Field:   field.Child("maxUnavailable").String(),
+		})
+	}
+
+	if rollingUpdate.Partition != nil && *rollingUpdate.Partition < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("partition must not be negative, got %d", *rollingUpdate.Partition),
+			Field:   field.Child("partition").String(),
+		})
+	}
+
+	causes = append(causes, validateVMPoolUpdateMethod(field.Child("specChangeMethod"), rollingUpdate.SpecChangeMethod)...)
+	causes = append(causes, validateVMPoolUpdateMethod(field.Child("metadataChangeMethod"), rollingUpdate.MetadataChangeMethod)...)
+
+	return causes
+}
+
+// validateIntOrPercent returns the value of an absolute number or the number of a percentage
+func validateIntOrPercent(field *k8sfield.Path, value *intstr.IntOrString) (int, []metav1.StatusCause) {
+	if value == nil {
+		return 0, nil
+	}
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(value, 100, true)
+	if err != nil {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: err.Error(),
+			Field:   field.String(),
+		}}
+	}
+	if scaled < 0 {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be negative", field.String()),
+			Field:   field.String(),
+		}}
+	}
+	if value.Type == intstr.String && scaled > 100 {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be greater than 100%%", field.String()),
+			Field:   field.String(),
+		}}
+	}
+	return scaled, nil
+}
+
+func validateVMPoolUpdateMethod(field *k8sfield.Path, method *poolv1.VirtualMachinePoolUpdateMethod) []metav1.StatusCause {
+	if method == nil {
+		return nil
+	}
+	switch *method {
+	case poolv1.VirtualMachinePoolUpdateMethodRestart, poolv1.VirtualMachinePoolUpdateMethodLiveMigrate:
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type: metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("%s is not supported, supported values are %s and %s", *method,
+			poolv1.VirtualMachinePoolUpdateMethodRestart, poolv1.VirtualMachinePoolUpdateMethodLiveMigrate),
+		Field: field.String(),
+	}}
+}
+
+func validateVMPoolScaleInPolicy(field *k8sfield.Path, policy poolv1.VirtualMachinePoolScaleInPolicy) []metav1.StatusCause {
+	supported := []poolv1.VirtualMachinePoolScaleInPolicy{
+		poolv1.VirtualMachinePoolScaleInPolicyRandom,
+		poolv1.VirtualMachinePoolScaleInPolicyOldestFirst,
+		poolv1.VirtualMachinePoolScaleInPolicyNotReadyFirst,
+		poolv1.VirtualMachinePoolScaleInPolicyLowestIndexFirst,
+		poolv1.VirtualMachinePoolScaleInPolicyHighestIndexFirst,
+	}
+	for _, supportedPolicy := range supported {
+		if policy == supportedPolicy {
+			return nil
+		}
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("%s is not supported, supported values are %v", policy, supported),
+		Field:   field.String(),
+	}}
+}
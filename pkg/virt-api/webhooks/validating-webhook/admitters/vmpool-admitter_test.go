@@ -27,11 +27,13 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "kubevirt.io/api/core/v1"
 	virtv1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 )
@@ -42,6 +44,37 @@ var _ = Describe("Validating Pool Admitter", func() {
 
 	always := v1.RunStrategyAlways
 
+	newValidVMPool := func() *poolv1.VirtualMachinePool {
+		return &poolv1.VirtualMachinePool{
+			Spec: poolv1.VirtualMachinePoolSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"match": "me"},
+				},
+
+				VirtualMachineTemplate: &poolv1.VirtualMachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"match": "me"},
+					},
+					Spec: v1.VirtualMachineSpec{
+						RunStrategy: &always,
+						Template: newVirtualMachineBuilder().
+							WithDisk(v1.Disk{
+								Name: "testdisk",
+							}).
+							WithVolume(v1.Volume{
+								Name: "testdisk",
+								VolumeSource: v1.VolumeSource{
+									ContainerDisk: testutils.NewFakeContainerDiskSource(),
+								},
+							}).
+							WithLabel("match", "me").
+							BuildTemplate(),
+					},
+				},
+			},
+		}
+	}
+
 	DescribeTable("should reject documents containing unknown or missing fields for", func(data string, validationResult string, gvr metav1.GroupVersionResource, review func(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse) {
 		input := map[string]interface{}{}
 		json.Unmarshal([]byte(data), &input)
@@ -131,35 +164,105 @@ var _ = Describe("Validating Pool Admitter", func() {
 			"spec.selector",
 		}),
 	)
-	It("should accept valid vm spec", func() {
-		pool := &poolv1.VirtualMachinePool{
-			Spec: poolv1.VirtualMachinePoolSpec{
-				Selector: &metav1.LabelSelector{
-					MatchLabels: map[string]string{"match": "me"},
+	DescribeTable("reject invalid rolling update strategy", func(rollingUpdate *poolv1.VirtualMachinePoolRollingUpdate, causes []string) {
+		pool := newValidVMPool()
+		pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{RollingUpdate: rollingUpdate}
+		poolBytes, _ := json.Marshal(&pool)
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachinePoolGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: poolBytes,
 				},
+			},
+		}
 
-				VirtualMachineTemplate: &poolv1.VirtualMachineTemplateSpec{
-					ObjectMeta: metav1.ObjectMeta{
-						Labels: map[string]string{"match": "me"},
-					},
-					Spec: v1.VirtualMachineSpec{
-						RunStrategy: &always,
-						Template: newVirtualMachineBuilder().
-							WithDisk(v1.Disk{
-								Name: "testdisk",
-							}).
-							WithVolume(v1.Volume{
-								Name: "testdisk",
-								VolumeSource: v1.VolumeSource{
-									ContainerDisk: testutils.NewFakeContainerDiskSource(),
-								},
-							}).
-							WithLabel("match", "me").
-							BuildTemplate(),
-					},
+		resp := poolAdmitter.Admit(ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(len(causes)))
+		for i, cause := range causes {
+			Expect(resp.Result.Details.Causes[i].Field).To(Equal(cause))
+		}
+	},
+		Entry("with maxUnavailable and maxSurge both 0", &poolv1.VirtualMachinePoolRollingUpdate{
+			MaxUnavailable: pointer.P(intstr.FromString("0%")),
+		}, []string{"spec.updateStrategy.rollingUpdate.maxUnavailable"}),
+		Entry("with a negative maxSurge", &poolv1.VirtualMachinePoolRollingUpdate{
+			MaxSurge: pointer.P(intstr.FromInt(-1)),
+		}, []string{"spec.updateStrategy.rollingUpdate.maxSurge"}),
+		Entry("with an invalid maxUnavailable percentage", &poolv1.VirtualMachinePoolRollingUpdate{
+			MaxUnavailable: pointer.P(intstr.FromString("120%")),
+		}, []string{"spec.updateStrategy.rollingUpdate.maxUnavailable"}),
+		Entry("with a malformed maxSurge", &poolv1.VirtualMachinePoolRollingUpdate{
+			MaxSurge: pointer.P(intstr.FromString("one")),
+		}, []string{"spec.updateStrategy.rollingUpdate.maxSurge"}),
+		Entry("with a negative partition", &poolv1.VirtualMachinePoolRollingUpdate{
+			Partition: pointer.P(int32(-1)),
+		}, []string{"spec.updateStrategy.rollingUpdate.partition"}),
+		Entry("with unsupported update methods", &poolv1.VirtualMachinePoolRollingUpdate{
+			SpecChangeMethod:     pointer.P(poolv1.VirtualMachinePoolUpdateMethod("Recreate")),
+			MetadataChangeMethod: pointer.P(poolv1.VirtualMachinePoolUpdateMethod("Patch")),
+		}, []string{
+			"spec.updateStrategy.rollingUpdate.specChangeMethod",
+			"spec.updateStrategy.rollingUpdate.metadataChangeMethod",
+		}),
+	)
+	DescribeTable("scale in policy", func(policy poolv1.VirtualMachinePoolScaleInPolicy, allowed bool) {
+		pool := newValidVMPool()
+		pool.Spec.ScaleInPolicy = &policy
+		poolBytes, _ := json.Marshal(&pool)
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachinePoolGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: poolBytes,
+				},
+			},
+		}
+
+		resp := poolAdmitter.Admit(ar)
+		Expect(resp.Allowed).To(Equal(allowed))
+		if !allowed {
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.scaleInPolicy"))
+		}
+	},
+		Entry("should accept Random", poolv1.VirtualMachinePoolScaleInPolicyRandom, true),
+		Entry("should accept OldestFirst", poolv1.VirtualMachinePoolScaleInPolicyOldestFirst, true),
+		Entry("should accept NotReadyFirst", poolv1.VirtualMachinePoolScaleInPolicyNotReadyFirst, true),
+		Entry("should accept LowestIndexFirst", poolv1.VirtualMachinePoolScaleInPolicyLowestIndexFirst, true),
+		Entry("should accept HighestIndexFirst", poolv1.VirtualMachinePoolScaleInPolicyHighestIndexFirst, true),
+		Entry("should reject an unknown policy", poolv1.VirtualMachinePoolScaleInPolicy("NewestFirst"), false),
+	)
+	It("should accept a valid rolling update strategy", func() {
+		pool := newValidVMPool()
+		pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable:       pointer.P(intstr.FromInt(0)),
+				MaxSurge:             pointer.P(intstr.FromString("25%")),
+				Partition:            pointer.P(int32(2)),
+				SpecChangeMethod:     pointer.P(poolv1.VirtualMachinePoolUpdateMethodRestart),
+				MetadataChangeMethod: pointer.P(poolv1.VirtualMachinePoolUpdateMethodLiveMigrate),
+			},
+		}
+		poolBytes, _ := json.Marshal(&pool)
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachinePoolGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: poolBytes,
 				},
 			},
 		}
+
+		resp := poolAdmitter.Admit(ar)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+	It("should accept valid vm spec", func() {
+		pool := newValidVMPool()
 		poolBytes, _ := json.Marshal(&pool)
 
 		ar := &admissionv1.AdmissionReview{
@@ -363,6 +363,7 @@ func ValidateVirtualMachineSpec(field *k8sfield.Path, spec *v1.VirtualMachineSpe
 	causes = append(causes, validateDataVolumeTemplate(field, spec)...)
 	causes = append(causes, validateRunStrategy(field, spec)...)
 	causes = append(causes, validateLiveUpdateFeatures(field, spec, config)...)
+	causes = append(causes, validateUpdateVolumesStrategy(field, spec, config)...)
 
 	return causes
 }
@@ -439,6 +440,31 @@ func validateRunStrategy(field *k8sfield.Path, spec *v1.VirtualMachineSpec) (cau
 	return causes
 }
 
+func validateUpdateVolumesStrategy(field *k8sfield.Path, spec *v1.VirtualMachineSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if spec.UpdateVolumesStrategy == nil {
+		return causes
+	}
+
+	switch *spec.UpdateVolumesStrategy {
+	case v1.UpdateVolumesStrategyReplacement:
+	case v1.UpdateVolumesStrategyMigration:
+		if !config.VolumeMigrationEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.VolumeMigration),
+				Field:   field.Child("updateVolumesStrategy").String(),
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Invalid UpdateVolumesStrategy (%s)", *spec.UpdateVolumesStrategy),
+			Field:   field.Child("updateVolumesStrategy").String(),
+		})
+	}
+	return causes
+}
+
 func validateLiveUpdateFeatures(field *k8sfield.Path, spec *v1.VirtualMachineSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
 	if spec.LiveUpdateFeatures != nil && !config.VMLiveUpdateFeaturesEnabled() {
 		causes = append(causes, metav1.StatusCause{
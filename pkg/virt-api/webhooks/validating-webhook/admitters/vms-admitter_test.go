@@ -2352,6 +2352,48 @@ var _ = Describe("Validating VM Admitter", func() {
 		})
 	})
 
+	Context("Update volumes strategy", func() {
+		var vm *v1.VirtualMachine
+
+		BeforeEach(func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vm = &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					Running: &notRunning,
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+				},
+			}
+		})
+
+		DescribeTable("should validate the strategy", func(strategy v1.UpdateVolumesStrategy, featureGates []string, expectedCauses []metav1.StatusCause) {
+			enableFeatureGate(featureGates...)
+			vm.Spec.UpdateVolumesStrategy = &strategy
+			response := admitVm(vmsAdmitter, vm)
+			Expect(response.Allowed).To(Equal(len(expectedCauses) == 0))
+			if len(expectedCauses) > 0 {
+				Expect(response.Result.Details.Causes).To(Equal(expectedCauses))
+			}
+		},
+			Entry("with the replacement strategy", v1.UpdateVolumesStrategyReplacement, []string{}, nil),
+			Entry("with the migration strategy and the feature gate enabled", v1.UpdateVolumesStrategyMigration,
+				[]string{virtconfig.VolumeMigration}, nil),
+			Entry("with the migration strategy and the feature gate disabled", v1.UpdateVolumesStrategyMigration, []string{},
+				[]metav1.StatusCause{{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.VolumeMigration),
+					Field:   "spec.updateVolumesStrategy",
+				}}),
+			Entry("with an invalid strategy", v1.UpdateVolumesStrategy("invalid"), []string{virtconfig.VolumeMigration},
+				[]metav1.StatusCause{{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "Invalid UpdateVolumesStrategy (invalid)",
+					Field:   "spec.updateVolumesStrategy",
+				}}),
+		)
+	})
+
 	It("should raise a warning when Deprecated API is used", func() {
 		enableFeatureGate(deprecation.PasstGate)
 		vmi := api.NewMinimalVMI("testvmi")
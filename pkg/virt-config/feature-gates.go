@@ -78,6 +78,16 @@ const (
 	CommonInstancetypesDeploymentGate = "CommonInstancetypesDeploymentGate"
 	// AlignCPUsGate allows emulator thread to assign two extra CPUs if needed to complete even parity.
 	AlignCPUsGate = "AlignCPUs"
+	// VolumeMigration enables to migrate the storage of a running VM to new volumes.
+	VolumeMigration = "VolumeMigration"
+	// IncrementalBackupGate enables the backup of running VMs with changed block tracking checkpoints.
+	IncrementalBackupGate = "IncrementalBackup"
+	// CrossClusterLiveMigrationGate enables live migrations of VMIs between KubeVirt installations.
+	CrossClusterLiveMigrationGate = "CrossClusterLiveMigration"
+	// MacAddressPoolGate enables assigning MAC addresses to the VM interfaces from the configured pool.
+	MacAddressPoolGate = "MacAddressPool"
+	// NftNetlinkGate programs the nftables rules of the pod through netlink in a single transaction instead of the nft binary.
+	NftNetlinkGate = "NftNetlink"
 )
 
 func (config *ClusterConfig) isFeatureGateEnabled(featureGate string) bool {
@@ -246,3 +256,23 @@ func (config *ClusterConfig) CommonInstancetypesDeploymentEnabled() bool {
 func (config *ClusterConfig) AlignCPUsEnabled() bool {
 	return config.isFeatureGateEnabled(AlignCPUsGate)
 }
+
+func (config *ClusterConfig) VolumeMigrationEnabled() bool {
+	return config.isFeatureGateEnabled(VolumeMigration)
+}
+
+func (config *ClusterConfig) IncrementalBackupEnabled() bool {
+	return config.isFeatureGateEnabled(IncrementalBackupGate)
+}
+
+func (config *ClusterConfig) CrossClusterLiveMigrationEnabled() bool {
+	return config.isFeatureGateEnabled(CrossClusterLiveMigrationGate)
+}
+
+func (config *ClusterConfig) MacAddressPoolEnabled() bool {
+	return config.isFeatureGateEnabled(MacAddressPoolGate)
+}
+
+func (config *ClusterConfig) NftNetlinkEnabled() bool {
+	return config.isFeatureGateEnabled(NftNetlinkGate)
+}
@@ -475,3 +475,11 @@ func (c *ClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPlugi
 	}
 	return nil
 }
+
+func (c *ClusterConfig) GetMacAddressPool() *v1.MacAddressPool {
+	networkConfig := c.GetConfig().NetworkConfiguration
+	if networkConfig != nil {
+		return networkConfig.MacAddressPool
+	}
+	return nil
+}
@@ -76,6 +76,7 @@ import (
 
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/service"
+	"kubevirt.io/kubevirt/pkg/storage/backup"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -173,14 +174,22 @@ type VirtControllerApp struct {
 	migrationController *MigrationController
 	migrationInformer   cache.SharedIndexInformer
 
+	migrationPolicyStatusController *MigrationPolicyStatusController
+
+	crossClusterMigrationController *CrossClusterMigrationController
+
+	macAddressConflictController *MacAddressConflictController
+
 	workloadUpdateController *workloadupdater.WorkloadUpdateController
 
 	caExportConfigMapInformer    cache.SharedIndexInformer
+	caConfigMapInformer          cache.SharedIndexInformer
 	exportRouteConfigMapInformer cache.SharedInformer
 	exportServiceInformer        cache.SharedIndexInformer
 	exportController             *export.VMExportController
 	snapshotController           *snapshot.VMSnapshotController
 	restoreController            *snapshot.VMRestoreController
+	backupController             *backup.VMBackupController
 	vmExportInformer             cache.SharedIndexInformer
 	routeCache                   cache.Store
 	ingressCache                 cache.Store
@@ -188,6 +197,7 @@ type VirtControllerApp struct {
 	vmSnapshotInformer           cache.SharedIndexInformer
 	vmSnapshotContentInformer    cache.SharedIndexInformer
 	vmRestoreInformer            cache.SharedIndexInformer
+	vmBackupInformer             cache.SharedIndexInformer
 	storageClassInformer         cache.SharedIndexInformer
 	allPodInformer               cache.SharedIndexInformer
 	resourceQuotaInformer        cache.SharedIndexInformer
@@ -369,8 +379,10 @@ func Execute() {
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
 	app.vmRestoreInformer = app.informerFactory.VirtualMachineRestore()
+	app.vmBackupInformer = app.informerFactory.VirtualMachineBackup()
 	app.storageClassInformer = app.informerFactory.StorageClass()
 	app.caExportConfigMapInformer = app.informerFactory.KubeVirtExportCAConfigMap()
+	app.caConfigMapInformer = app.informerFactory.KubeVirtCAConfigMap()
 	app.exportRouteConfigMapInformer = app.informerFactory.ExportRouteConfigMap()
 	app.unmanagedSecretInformer = app.informerFactory.UnmanagedSecrets()
 	app.allPodInformer = app.informerFactory.Pod()
@@ -438,6 +450,7 @@ func Execute() {
 	app.initEvacuationController()
 	app.initSnapshotController()
 	app.initRestoreController()
+	app.initBackupController()
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
@@ -539,6 +552,10 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 			golog.Fatalf("failed to add vmi phase transition time handler: %v", err)
 		}
 
+		if err := metrics.CreateVMIMigrationOutcomeCleanupHandler(vca.vmiInformer); err != nil {
+			golog.Fatalf("failed to add vmi migration outcome cleanup handler: %v", err)
+		}
+
 		go vca.evacuationController.Run(vca.evacuationControllerThreads, stop)
 		go vca.disruptionBudgetController.Run(vca.disruptionBudgetControllerThreads, stop)
 		go vca.nodeController.Run(vca.nodeControllerThreads, stop)
@@ -547,6 +564,9 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		go vca.poolController.Run(vca.poolControllerThreads, stop)
 		go vca.vmController.Run(vca.vmControllerThreads, stop)
 		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
+		go vca.migrationPolicyStatusController.Run(stop)
+		go vca.crossClusterMigrationController.Run(vca.migrationControllerThreads, stop)
+		go vca.macAddressConflictController.Run(stop)
 		go func() {
 			if err := vca.snapshotController.Run(vca.snapshotControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the snapshot controller: %v", err)
@@ -557,6 +577,11 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the restore controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.backupController.Run(vca.snapshotControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the backup controller: %v", err)
+			}
+		}()
 		go func() {
 			if err := vca.exportController.Run(vca.exportControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the export controller: %v", err)
@@ -655,6 +680,7 @@ func (vca *VirtControllerApp) initCommon() {
 		vca.pdbInformer,
 		vca.migrationPolicyInformer,
 		vca.resourceQuotaInformer,
+		vca.namespaceStore,
 		vca.vmiRecorder,
 		clientSet,
 		vca.clusterConfig,
@@ -663,6 +689,42 @@ func (vca *VirtControllerApp) initCommon() {
 		panic(err)
 	}
 
+	vca.migrationPolicyStatusController, err = NewMigrationPolicyStatusController(
+		clientSet,
+		vca.migrationPolicyInformer,
+		vca.vmiInformer,
+		vca.namespaceInformer,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	vca.crossClusterMigrationController, err = NewCrossClusterMigrationController(
+		vca.templateService,
+		vca.migrationInformer,
+		vca.vmiInformer,
+		vca.kvPodInformer,
+		vca.caConfigMapInformer,
+		vca.kubevirtNamespace,
+		vca.newRecorder(k8sv1.NamespaceAll, "cross-cluster-migration-controller"),
+		clientSet,
+		NewRemoteClient,
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	vca.macAddressConflictController, err = NewMacAddressConflictController(
+		vca.vmInformer,
+		vca.vmiInformer,
+		vca.newRecorder(k8sv1.NamespaceAll, "mac-address-conflict-controller"),
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+
 	vca.nodeTopologyUpdater = topology.NewNodeTopologyUpdater(vca.clientSet, topologyHinter, vca.nodeInformer)
 }
 
@@ -747,6 +809,7 @@ func (vca *VirtControllerApp) initWorkloadUpdaterController() {
 		vca.kvPodInformer,
 		vca.migrationInformer,
 		vca.kubeVirtInformer,
+		vca.namespaceStore,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig)
@@ -815,6 +878,21 @@ func (vca *VirtControllerApp) initRestoreController() {
 	}
 }
 
+func (vca *VirtControllerApp) initBackupController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "backup-controller")
+	vca.backupController = &backup.VMBackupController{
+		Client:           vca.clientSet,
+		VMBackupInformer: vca.vmBackupInformer,
+		VMInformer:       vca.vmInformer,
+		VMIInformer:      vca.vmiInformer,
+		Recorder:         recorder,
+		ResyncPeriod:     vca.snapshotControllerResyncPeriod,
+	}
+	if err := vca.backupController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initExportController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "export-controller")
 	vca.exportController = &export.VMExportController{
@@ -835,6 +913,7 @@ func (vca *VirtControllerApp) initExportController() {
 		VolumeSnapshotProvider:      vca.snapshotController,
 		VMSnapshotInformer:          vca.vmSnapshotInformer,
 		VMSnapshotContentInformer:   vca.vmSnapshotContentInformer,
+		VMBackupInformer:            vca.vmBackupInformer,
 		VMInformer:                  vca.vmInformer,
 		VMIInformer:                 vca.vmiInformer,
 		CRDInformer:                 vca.crdInformer,
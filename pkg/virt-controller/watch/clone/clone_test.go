@@ -868,6 +868,24 @@ var _ = Describe("Clone", func() {
 
 		})
 
+		Context("VM Generation ID", func() {
+			It("should reset the VM Generation ID", func() {
+				sourceVM.Spec.Template.Spec.Domain.Firmware = &virtv1.Firmware{
+					GenerationID: &virtv1.GenerationID{UUID: "5ad4d4bc-6b0e-4bb5-a8b4-b2b4c5a0e6f3"},
+				}
+				addClone(vmClone)
+
+				expectedVM := sourceVM.DeepCopy()
+				expectedVM.Spec.Template.Spec.Domain.Firmware.GenerationID.UUID = ""
+				expectedVM.Spec.Template.Spec.Domain.Firmware.Serial = ""
+				expectedVM.Spec.Template.Spec.Domain.Firmware.UUID = ""
+				expectVMCreationFromPatches(expectedVM)
+				expectSnapshotContentGet(sourceVM)
+
+				controller.Execute()
+			})
+		})
+
 		Context("Labels and annotations", func() {
 
 			type mapType string
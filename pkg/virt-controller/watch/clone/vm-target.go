@@ -53,6 +53,9 @@ func generatePatches(source *k6tv1.VirtualMachine, cloneSpec *clonev1alpha1.Virt
 	firmwareUUIDPatches := generateFirmwareUUIDPatches(source.Spec.Template.Spec.Domain.Firmware)
 	patches = append(patches, firmwareUUIDPatches...)
 
+	generationIDPatches := generateGenerationIDPatches(source.Spec.Template.Spec.Domain.Firmware)
+	patches = append(patches, generationIDPatches...)
+
 	log.Log.V(defaultVerbosityLevel).Object(source).Infof("patches generated for vm %s clone: %v", source.Name, patches)
 	return patches
 }
@@ -197,3 +200,14 @@ func generateFirmwareUUIDPatches(firmware *k6tv1.Firmware) (patches []string) {
 
 	return []string{firmwareUUIDPatch}
 }
+
+func generateGenerationIDPatches(firmware *k6tv1.Firmware) (patches []string) {
+	// An empty VM Generation ID is derived from the new VM, the guest will therefore notice it got cloned
+	const generationIDPatch = `{"op": "replace", "path": "/spec/template/spec/domain/firmware/generationID/uuid", "value": ""}`
+
+	if firmware == nil || firmware.GenerationID == nil {
+		return nil
+	}
+
+	return []string{generationIDPatch}
+}
@@ -0,0 +1,800 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+)
+
+const (
+	// The state of the other cluster is not watched, it is polled in this interval
+	crossClusterMigrationPollInterval = 5 * time.Second
+
+	FailedCrossClusterMigrationReason     = "FailedCrossClusterMigration"
+	SuccessfulCrossClusterMigrationReason = "SuccessfulCrossClusterMigration"
+
+	kubeVirtCAConfigMapName = "kubevirt-ca"
+)
+
+// RemoteClientFactory creates a client for the target cluster of a cross-cluster migration
+type RemoteClientFactory func(kubeconfig []byte) (kubecli.KubevirtClient, error)
+
+// NewRemoteClient creates a client from the kubeconfig of the target cluster
+func NewRemoteClient(kubeconfig []byte) (kubecli.KubevirtClient, error) {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return kubecli.GetKubevirtClientFromRESTConfig(config)
+}
+
+func isCrossClusterMigration(migration *virtv1.VirtualMachineInstanceMigration) bool {
+	return migration.Spec.SendTo != nil || migration.Spec.Receive != nil
+}
+
+// CrossClusterMigrationController drives both sides of migrations between two KubeVirt installations.
+//
+// The source side creates a receiving migration in the target cluster, which carries the template
+// of the receiving VMI and the CA bundle of the source cluster. Once the target node listens, it
+// copies the listener and the migration tunnel of the target cluster into the migration state of
+// the migrated VMI, where virt-handler picks it up like for any other migration. The stream is sent
+// through the migration tunnel of virt-api in the target cluster, which only accepts virt-handler
+// client certificates signed by the CA bundle of the receiving migration.
+//
+// The target side creates the receiving VMI and its target pod and hands the VMI over to the
+// target node once the domain is running there.
+type CrossClusterMigrationController struct {
+	templateService     services.TemplateService
+	clientset           kubecli.KubevirtClient
+	remoteClient        RemoteClientFactory
+	Queue               workqueue.RateLimitingInterface
+	migrationInformer   cache.SharedIndexInformer
+	vmiInformer         cache.SharedIndexInformer
+	podInformer         cache.SharedIndexInformer
+	caConfigMapInformer cache.SharedIndexInformer
+	caManager           kvtls.ClientCAManager
+	kubevirtNamespace   string
+	recorder            record.EventRecorder
+	clusterConfig       *virtconfig.ClusterConfig
+}
+
+// NewCrossClusterMigrationController creates a new instance of the CrossClusterMigrationController struct.
+func NewCrossClusterMigrationController(templateService services.TemplateService,
+	migrationInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	podInformer cache.SharedIndexInformer,
+	caConfigMapInformer cache.SharedIndexInformer,
+	kubevirtNamespace string,
+	recorder record.EventRecorder,
+	clientset kubecli.KubevirtClient,
+	remoteClient RemoteClientFactory,
+	clusterConfig *virtconfig.ClusterConfig,
+) (*CrossClusterMigrationController, error) {
+	c := &CrossClusterMigrationController{
+		templateService:     templateService,
+		clientset:           clientset,
+		remoteClient:        remoteClient,
+		Queue:               workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-cross-cluster-migration"),
+		migrationInformer:   migrationInformer,
+		vmiInformer:         vmiInformer,
+		podInformer:         podInformer,
+		caConfigMapInformer: caConfigMapInformer,
+		caManager:           kvtls.NewCAManager(caConfigMapInformer.GetStore(), kubevirtNamespace, kubeVirtCAConfigMapName),
+		kubevirtNamespace:   kubevirtNamespace,
+		recorder:            recorder,
+		clusterConfig:       clusterConfig,
+	}
+
+	_, err := c.migrationInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueMigration,
+		DeleteFunc: c.enqueueMigration,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueMigration(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueVMI,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueVMI(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueuePod,
+		UpdateFunc: func(_, curr interface{}) { c.enqueuePod(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *CrossClusterMigrationController) enqueueMigration(obj interface{}) {
+	migration, ok := obj.(*virtv1.VirtualMachineInstanceMigration)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if migration, ok = tombstone.Obj.(*virtv1.VirtualMachineInstanceMigration); !ok {
+			return
+		}
+	}
+	if !isCrossClusterMigration(migration) {
+		return
+	}
+	key, err := controller.KeyFunc(migration)
+	if err != nil {
+		log.Log.Object(migration).Reason(err).Error("Failed to extract key from migration.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+func (c *CrossClusterMigrationController) enqueueVMI(obj interface{}) {
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	objs, err := c.migrationInformer.GetIndexer().ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		return
+	}
+	for _, obj := range objs {
+		migration := obj.(*virtv1.VirtualMachineInstanceMigration)
+		if migration.Spec.VMIName == vmi.Name && !migration.IsFinal() {
+			c.enqueueMigration(migration)
+		}
+	}
+}
+
+func (c *CrossClusterMigrationController) enqueuePod(obj interface{}) {
+	pod := obj.(*k8sv1.Pod)
+	name, ok := pod.Annotations[virtv1.MigrationJobNameAnnotation]
+	if !ok {
+		return
+	}
+	obj, exists, err := c.migrationInformer.GetStore().GetByKey(pod.Namespace + "/" + name)
+	if err != nil || !exists {
+		return
+	}
+	c.enqueueMigration(obj)
+}
+
+// Run runs the passed in CrossClusterMigrationController.
+func (c *CrossClusterMigrationController) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting cross-cluster migration controller.")
+
+	// Wait for cache sync before we start the controller
+	cache.WaitForCacheSync(stopCh, c.migrationInformer.HasSynced, c.vmiInformer.HasSynced, c.podInformer.HasSynced, c.caConfigMapInformer.HasSynced)
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping cross-cluster migration controller.")
+}
+
+func (c *CrossClusterMigrationController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *CrossClusterMigrationController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+	err := c.execute(key.(string))
+
+	if err != nil {
+		log.Log.Reason(err).Infof("reenqueuing cross-cluster Migration %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed cross-cluster Migration %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *CrossClusterMigrationController) execute(key string) error {
+	obj, exists, err := c.migrationInformer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	migration := obj.(*virtv1.VirtualMachineInstanceMigration)
+	if !isCrossClusterMigration(migration) {
+		return nil
+	}
+
+	if migration.IsFinal() {
+		return c.removeFinalizer(migration)
+	}
+
+	var vmi *virtv1.VirtualMachineInstance
+	vmiObj, vmiExists, err := c.vmiInformer.GetStore().GetByKey(fmt.Sprintf("%s/%s", migration.Namespace, migration.Spec.VMIName))
+	if err != nil {
+		return err
+	}
+	if vmiExists {
+		vmi = vmiObj.(*virtv1.VirtualMachineInstance)
+	}
+
+	if migration.Spec.SendTo != nil {
+		return c.syncSource(key, migration, vmi)
+	}
+	return c.syncTarget(migration, vmi)
+}
+
+// syncSource drives the migration on the side of the cluster which sends the VMI
+func (c *CrossClusterMigrationController) syncSource(key string, migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.IsFinal() {
+		return c.failSourceMigration(migration, nil, "the VMI does not run")
+	}
+
+	remote, err := c.getRemoteClient(migration)
+	if err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedCrossClusterMigrationReason, "Failed to access the target cluster: %v", err)
+		return err
+	}
+
+	if migration.DeletionTimestamp != nil {
+		return c.failSourceMigration(migration, remote, "the migration was canceled")
+	}
+
+	if state := vmi.Status.MigrationState; state != nil && state.MigrationUID == migration.UID && state.Failed {
+		return c.failSourceMigration(migration, remote, "the migration failed on the source node")
+	}
+
+	remoteVMIs := remote.VirtualMachineInstance(migration.Namespace)
+	receiver, err := remoteVMIs.Get(context.Background(), vmi.Name, &metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		if migration.Status.Phase != virtv1.MigrationPending && migration.Status.Phase != virtv1.MigrationPhaseUnset {
+			return c.waitForReceiver(key, migration, remote)
+		}
+		reason, err := c.checkSharedStorage(vmi, remote)
+		if err != nil {
+			return err
+		} else if reason != "" {
+			return c.failSourceMigration(migration, nil, reason)
+		}
+		return c.createReceiver(migration, vmi, remote)
+	} else if err != nil {
+		return err
+	}
+
+	if receiver.Annotations[virtv1.MigrationSourceUIDAnnotation] != string(vmi.UID) {
+		return c.failSourceMigration(migration, nil, fmt.Sprintf("the VMI %s/%s in the target cluster does not receive this VMI", receiver.Namespace, receiver.Name))
+	}
+
+	receiverState := receiver.Status.MigrationState
+	if receiverState == nil || receiverState.TargetNodeAddress == "" || receiverState.TargetTunnel == nil {
+		// The target node did not start listening for the migration yet
+		c.Queue.AddAfter(key, crossClusterMigrationPollInterval)
+		return nil
+	}
+	if receiverState.Failed {
+		return c.failSourceMigration(migration, remote, "the migration failed on the target node")
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	state := vmiCopy.Status.MigrationState
+	if state == nil || state.MigrationUID != migration.UID {
+		state = &virtv1.VirtualMachineInstanceMigrationState{
+			MigrationUID:           migration.UID,
+			SourceNode:             vmi.Status.NodeName,
+			MigrationConfiguration: c.crossClusterMigrationConfiguration(),
+		}
+		vmiCopy.Status.MigrationState = state
+	}
+	state.TargetNode = receiverState.TargetNode
+	state.TargetPod = receiverState.TargetPod
+	state.TargetNodeAddress = receiverState.TargetNodeAddress
+	state.TargetTunnel = receiverState.TargetTunnel.DeepCopy()
+	state.TargetDirectMigrationNodePorts = receiverState.TargetDirectMigrationNodePorts
+	state.TargetCPUSet = receiverState.TargetCPUSet
+	state.TargetNodeTopology = receiverState.TargetNodeTopology
+	state.TargetVirtualMachineInstanceUID = receiver.UID
+	state.TargetNodeDomainDetected = receiverState.TargetNodeDomainDetected
+	state.TargetNodeDomainReadyTimestamp = receiverState.TargetNodeDomainReadyTimestamp
+
+	if !equality.Semantic.DeepEqual(vmi.Status.MigrationState, vmiCopy.Status.MigrationState) {
+		if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Update(context.Background(), vmiCopy); err != nil {
+			return err
+		}
+	}
+
+	if receiverState.TargetNodeDomainReadyTimestamp != nil {
+		// The domain runs in the target cluster, the VMI in this cluster must not be restarted
+		return c.completeSourceMigration(migration, vmiCopy)
+	}
+
+	phase := virtv1.MigrationScheduled
+	if state.StartTimestamp != nil {
+		phase = virtv1.MigrationRunning
+	}
+	if err := c.updateMigrationPhase(migration, phase, nil); err != nil {
+		return err
+	}
+	c.Queue.AddAfter(key, crossClusterMigrationPollInterval)
+	return nil
+}
+
+// waitForReceiver waits for the target cluster to create the receiving VMI from the receiving migration
+func (c *CrossClusterMigrationController) waitForReceiver(key string, migration *virtv1.VirtualMachineInstanceMigration, remote kubecli.KubevirtClient) error {
+	receivingMigration, err := remote.VirtualMachineInstanceMigration(migration.Namespace).Get(migration.Name, &metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return c.failSourceMigration(migration, remote, "the receiving migration disappeared from the target cluster")
+	} else if err != nil {
+		return err
+	}
+	if receivingMigration.IsFinal() {
+		return c.failSourceMigration(migration, remote, "the receiving migration ended in the target cluster")
+	}
+	c.Queue.AddAfter(key, crossClusterMigrationPollInterval)
+	return nil
+}
+
+// crossClusterMigrationConfiguration never disables TLS, the migration stream leaves the cluster
+func (c *CrossClusterMigrationController) crossClusterMigrationConfiguration() *virtv1.MigrationConfiguration {
+	config := c.clusterConfig.GetMigrationConfiguration().DeepCopy()
+	config.DisableTLS = pointer.P(false)
+	return config
+}
+
+func (c *CrossClusterMigrationController) getRemoteClient(migration *virtv1.VirtualMachineInstanceMigration) (kubecli.KubevirtClient, error) {
+	secret, err := c.clientset.CoreV1().Secrets(migration.Namespace).Get(context.Background(), migration.Spec.SendTo.KubeconfigSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// virt-controller may read all secrets, only consume the ones dedicated to cross-cluster migrations
+	if secret.Type != virtv1.CrossClusterMigrationKubeconfigSecretType {
+		return nil, fmt.Errorf("secret %s/%s is not of the type %s", secret.Namespace, secret.Name, virtv1.CrossClusterMigrationKubeconfigSecretType)
+	}
+	kubeconfig, ok := secret.Data[virtv1.CrossClusterMigrationKubeconfigKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s has no %s", secret.Namespace, secret.Name, virtv1.CrossClusterMigrationKubeconfigKey)
+	}
+	return c.remoteClient(kubeconfig)
+}
+
+// checkSharedStorage returns why the VMI can not be sent to the target cluster, if its volumes are not
+// shared with it. The disks are not copied, every claim of the VMI has to exist in the target cluster
+// under the same name and has to be bound to the same CSI volume or NFS export as in this cluster.
+func (c *CrossClusterMigrationController) checkSharedStorage(vmi *virtv1.VirtualMachineInstance, remote kubecli.KubevirtClient) (string, error) {
+	for i := range vmi.Spec.Volumes {
+		volume := &vmi.Spec.Volumes[i]
+		if volume.HostDisk != nil {
+			return fmt.Sprintf("the host disk %s can not be shared with the target cluster", volume.Name), nil
+		}
+		claimName := storagetypes.PVCNameFromVirtVolume(volume)
+		if claimName == "" {
+			continue
+		}
+
+		pv, reason, err := boundPersistentVolume(c.clientset, vmi.Namespace, claimName)
+		if err != nil || reason != "" {
+			return reason, err
+		}
+		remotePV, reason, err := boundPersistentVolume(remote, vmi.Namespace, claimName)
+		if err != nil || reason != "" {
+			return reason + " in the target cluster", err
+		}
+		if !sameBackingStorage(pv, remotePV) {
+			return fmt.Sprintf("the persistent volume claim %s is not bound to the same storage in both clusters", claimName), nil
+		}
+	}
+	return "", nil
+}
+
+func boundPersistentVolume(client kubecli.KubevirtClient, namespace, claimName string) (*k8sv1.PersistentVolume, string, error) {
+	pvc, err := client.CoreV1().PersistentVolumeClaims(namespace).Get(context.Background(), claimName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, fmt.Sprintf("the persistent volume claim %s does not exist", claimName), nil
+	} else if err != nil {
+		return nil, "", err
+	}
+	if pvc.Spec.VolumeName == "" {
+		return nil, fmt.Sprintf("the persistent volume claim %s is not bound", claimName), nil
+	}
+	pv, err := client.CoreV1().PersistentVolumes().Get(context.Background(), pvc.Spec.VolumeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	return pv, "", nil
+}
+
+func sameBackingStorage(a, b *k8sv1.PersistentVolume) bool {
+	switch {
+	case a.Spec.CSI != nil && b.Spec.CSI != nil:
+		return a.Spec.CSI.Driver == b.Spec.CSI.Driver && a.Spec.CSI.VolumeHandle == b.Spec.CSI.VolumeHandle
+	case a.Spec.NFS != nil && b.Spec.NFS != nil:
+		return a.Spec.NFS.Server == b.Spec.NFS.Server && a.Spec.NFS.Path == b.Spec.NFS.Path
+	}
+	return false
+}
+
+// createReceiver creates the migration which receives the VMI in the target cluster
+func (c *CrossClusterMigrationController) createReceiver(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, remote kubecli.KubevirtClient) error {
+	caBundle, err := c.caManager.GetCurrentRaw()
+	if err != nil {
+		return fmt.Errorf("failed to get the CA bundle of the cluster: %v", err)
+	}
+
+	template := &virtv1.VirtualMachineInstanceTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      map[string]string{},
+			Annotations: map[string]string{},
+		},
+		Spec: *vmi.Spec.DeepCopy(),
+	}
+	for k, v := range vmi.Labels {
+		template.ObjectMeta.Labels[k] = v
+	}
+	// Labels which point to objects of this cluster are meaningless in the target cluster
+	delete(template.ObjectMeta.Labels, virtv1.CreatedByLabel)
+	delete(template.ObjectMeta.Labels, virtv1.NodeNameLabel)
+	delete(template.ObjectMeta.Labels, virtv1.MigrationTargetNodeNameLabel)
+	delete(template.ObjectMeta.Labels, virtv1.OutdatedLauncherImageLabel)
+	for k, v := range vmi.Annotations {
+		template.ObjectMeta.Annotations[k] = v
+	}
+	delete(template.ObjectMeta.Annotations, virtv1.MigrationReceiverAnnotation)
+	template.ObjectMeta.Annotations[virtv1.MigrationSourceUIDAnnotation] = string(vmi.UID)
+
+	receivingMigration := &virtv1.VirtualMachineInstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      migration.Name,
+			Namespace: migration.Namespace,
+		},
+		Spec: virtv1.VirtualMachineInstanceMigrationSpec{
+			VMIName: vmi.Name,
+			Receive: &virtv1.VirtualMachineInstanceMigrationReceive{
+				MigrationID:    migration.Spec.SendTo.MigrationID,
+				Template:       template,
+				SourceCABundle: caBundle,
+			},
+		},
+	}
+	_, err = remote.VirtualMachineInstanceMigration(migration.Namespace).Create(receivingMigration, &metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedCrossClusterMigrationReason, "Failed to create the receiving migration in the target cluster: %v", err)
+		return err
+	}
+
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, SuccessfulCrossClusterMigrationReason, "Created the receiving migration %s/%s in the target cluster", receivingMigration.Namespace, receivingMigration.Name)
+	return c.updateMigrationPhase(migration, virtv1.MigrationScheduling, nil)
+}
+
+// completeSourceMigration stops the VMI from being restarted in this cluster and removes it
+func (c *CrossClusterMigrationController) completeSourceMigration(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if err := c.haltOwningVM(vmi); err != nil {
+		return err
+	}
+	if err := c.updateMigrationPhase(migration, virtv1.MigrationSucceeded, vmi.Status.MigrationState); err != nil {
+		return err
+	}
+	err := c.clientset.VirtualMachineInstance(vmi.Namespace).Delete(context.Background(), vmi.Name, &metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, SuccessfulCrossClusterMigrationReason, "The VMI migrated to the target cluster")
+	return nil
+}
+
+func (c *CrossClusterMigrationController) haltOwningVM(vmi *virtv1.VirtualMachineInstance) error {
+	owner := metav1.GetControllerOf(vmi)
+	if owner == nil || owner.Kind != virtv1.VirtualMachineGroupVersionKind.Kind {
+		return nil
+	}
+	vms := c.clientset.VirtualMachine(vmi.Namespace)
+	vm, err := vms.Get(context.Background(), owner.Name, &metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if vm.Spec.RunStrategy != nil {
+		if *vm.Spec.RunStrategy == virtv1.RunStrategyHalted {
+			return nil
+		}
+		vm.Spec.RunStrategy = pointer.P(virtv1.RunStrategyHalted)
+	} else {
+		if vm.Spec.Running != nil && !*vm.Spec.Running {
+			return nil
+		}
+		vm.Spec.Running = pointer.P(false)
+	}
+	_, err = vms.Update(context.Background(), vm)
+	return err
+}
+
+// failSourceMigration removes the receiving side from the target cluster, if it is reachable
+func (c *CrossClusterMigrationController) failSourceMigration(migration *virtv1.VirtualMachineInstanceMigration, remote kubecli.KubevirtClient, reason string) error {
+	if remote != nil {
+		err := remote.VirtualMachineInstanceMigration(migration.Namespace).Delete(migration.Name, &metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		receivers := remote.VirtualMachineInstance(migration.Namespace)
+		receiver, err := receivers.Get(context.Background(), migration.Spec.VMIName, &metav1.GetOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		// Never remove a VMI which was not created for this migration
+		if err == nil && receiver.Annotations[virtv1.MigrationReceiverAnnotation] == migration.Spec.SendTo.MigrationID {
+			err = receivers.Delete(context.Background(), receiver.Name, &metav1.DeleteOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	return c.failMigration(migration, reason)
+}
+
+// syncTarget drives the migration on the side of the cluster which receives the VMI
+func (c *CrossClusterMigrationController) syncTarget(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if c.clusterConfig.GetMigrationConfiguration().CrossClusterTunnelAddress == nil {
+		return c.failMigration(migration, "the migration tunnel of the cluster is not exposed, crossClusterTunnelAddress is not set")
+	}
+	if vmi == nil {
+		if migration.Status.Phase != virtv1.MigrationPending && migration.Status.Phase != virtv1.MigrationPhaseUnset {
+			return c.failMigration(migration, "the receiving VMI disappeared")
+		}
+		if migration.DeletionTimestamp != nil {
+			return c.failMigration(migration, "the migration was canceled")
+		}
+		return c.createReceivingVMI(migration)
+	}
+	if vmi.IsFinal() {
+		return c.failMigration(migration, "the receiving VMI does not run")
+	}
+	if vmi.Annotations[virtv1.MigrationReceiverAnnotation] != migration.Spec.Receive.MigrationID {
+		if state := vmi.Status.MigrationState; state != nil && state.MigrationUID == migration.UID && state.Completed {
+			return c.updateMigrationPhase(migration, virtv1.MigrationSucceeded, state)
+		}
+		return c.failMigration(migration, "the VMI does not wait to receive this migration")
+	}
+	if migration.DeletionTimestamp != nil {
+		return c.failMigration(migration, "the migration was canceled")
+	}
+
+	pod, err := c.targetPod(migration)
+	if err != nil {
+		return err
+	}
+	if pod == nil {
+		if migration.TargetIsCreated() {
+			return c.failMigration(migration, "the target pod disappeared")
+		}
+		return c.createReceiverPod(migration, vmi)
+	}
+	if isPodDownOrGoingDown(pod) {
+		return c.failMigration(migration, fmt.Sprintf("the target pod %s/%s shut down", pod.Namespace, pod.Name))
+	}
+	if pod.Spec.NodeName == "" || !isPodReady(pod) {
+		return c.updateMigrationPhase(migration, virtv1.MigrationScheduling, nil)
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	if vmiCopy.Labels == nil {
+		vmiCopy.Labels = map[string]string{}
+	}
+	vmiCopy.Labels[virtv1.MigrationTargetNodeNameLabel] = pod.Spec.NodeName
+	// The target node only prepares running VMIs
+	vmiCopy.Status.Phase = virtv1.Running
+	vmiCopy.Status.MigrationTransport = virtv1.MigrationTransportUnix
+	if vmiCopy.Status.MigrationState == nil || vmiCopy.Status.MigrationState.MigrationUID != migration.UID {
+		vmiCopy.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+			MigrationUID:                    migration.UID,
+			MigrationConfiguration:          c.crossClusterMigrationConfiguration(),
+			SourceVirtualMachineInstanceUID: types.UID(vmi.Annotations[virtv1.MigrationSourceUIDAnnotation]),
+		}
+	}
+	state := vmiCopy.Status.MigrationState
+	state.TargetNode = pod.Spec.NodeName
+	state.TargetPod = pod.Name
+	tunnel, err := c.migrationTunnel()
+	if err != nil {
+		return err
+	}
+	state.TargetTunnel = tunnel
+
+	phase := virtv1.MigrationScheduled
+	if state.TargetNodeAddress != "" {
+		phase = virtv1.MigrationRunning
+	}
+
+	if state.TargetNodeDomainReadyTimestamp != nil {
+		// Hand the VMI over to the target node, like the source node does within a cluster
+		vmiCopy.Labels[virtv1.NodeNameLabel] = state.TargetNode
+		vmiCopy.Status.NodeName = state.TargetNode
+		delete(vmiCopy.Annotations, virtv1.MigrationReceiverAnnotation)
+		if state.EndTimestamp == nil {
+			now := metav1.Now()
+			state.EndTimestamp = &now
+		}
+		state.Completed = true
+		phase = virtv1.MigrationSucceeded
+	}
+
+	if !equality.Semantic.DeepEqual(vmi, vmiCopy) {
+		if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Update(context.Background(), vmiCopy); err != nil {
+			return err
+		}
+	}
+	if phase == virtv1.MigrationSucceeded {
+		c.recorder.Eventf(migration, k8sv1.EventTypeNormal, SuccessfulCrossClusterMigrationReason, "The VMI was received on node %s", state.TargetNode)
+		return c.updateMigrationPhase(migration, phase, state)
+	}
+	return c.updateMigrationPhase(migration, phase, nil)
+}
+
+// createReceivingVMI creates the receiving VMI from the template of the receiving migration, the
+// admission of the migration ensured that its creator may create VMIs in the namespace
+func (c *CrossClusterMigrationController) createReceivingVMI(migration *virtv1.VirtualMachineInstanceMigration) error {
+	template := migration.Spec.Receive.Template
+	vmi := &virtv1.VirtualMachineInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        migration.Spec.VMIName,
+			Namespace:   migration.Namespace,
+			Labels:      map[string]string{},
+			Annotations: map[string]string{},
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+	for k, v := range template.ObjectMeta.Labels {
+		vmi.Labels[k] = v
+	}
+	delete(vmi.Labels, virtv1.NodeNameLabel)
+	delete(vmi.Labels, virtv1.MigrationTargetNodeNameLabel)
+	delete(vmi.Labels, virtv1.OutdatedLauncherImageLabel)
+	for k, v := range template.ObjectMeta.Annotations {
+		vmi.Annotations[k] = v
+	}
+	vmi.Annotations[virtv1.MigrationReceiverAnnotation] = migration.Spec.Receive.MigrationID
+
+	_, err := c.clientset.VirtualMachineInstance(migration.Namespace).Create(context.Background(), vmi)
+	if k8serrors.IsAlreadyExists(err) {
+		// The VMI is synced once the informer observes it
+		return nil
+	} else if err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedCrossClusterMigrationReason, "Failed to create the receiving VMI: %v", err)
+		return err
+	}
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, SuccessfulCrossClusterMigrationReason, "Created the receiving VMI %s/%s", vmi.Namespace, vmi.Name)
+	return nil
+}
+
+// migrationTunnel returns how the source cluster reaches the migration tunnel of virt-api
+func (c *CrossClusterMigrationController) migrationTunnel() (*virtv1.VirtualMachineInstanceMigrationTunnel, error) {
+	caBundle, err := c.caManager.GetCurrentRaw()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the CA bundle of the cluster: %v", err)
+	}
+	return &virtv1.VirtualMachineInstanceMigrationTunnel{
+		Address: *c.clusterConfig.GetMigrationConfiguration().CrossClusterTunnelAddress,
+		// The serving certificate of virt-api is issued for its service
+		ServerName: fmt.Sprintf("virt-api.%s.svc", c.kubevirtNamespace),
+		CABundle:   caBundle,
+	}, nil
+}
+
+func (c *CrossClusterMigrationController) targetPod(migration *virtv1.VirtualMachineInstanceMigration) (*k8sv1.Pod, error) {
+	objs, err := c.podInformer.GetIndexer().ByIndex(cache.NamespaceIndex, migration.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		pod := obj.(*k8sv1.Pod)
+		if pod.Labels[virtv1.MigrationJobLabel] == string(migration.UID) {
+			return pod, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *CrossClusterMigrationController) createReceiverPod(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	templatePod, err := c.templateService.RenderLaunchManifest(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to render launch manifest: %v", err)
+	}
+	templatePod.ObjectMeta.Labels[virtv1.MigrationJobLabel] = string(migration.UID)
+	templatePod.ObjectMeta.Annotations[virtv1.MigrationJobNameAnnotation] = migration.Name
+
+	pod, err := c.clientset.CoreV1().Pods(vmi.Namespace).Create(context.Background(), templatePod, metav1.CreateOptions{})
+	if err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedCreatePodReason, "Error creating pod: %v", err)
+		return fmt.Errorf("failed to create the receiving pod: %v", err)
+	}
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, SuccessfulCreatePodReason, "Created migration target pod %s", pod.Name)
+
+	migrationCopy := migration.DeepCopy()
+	migrationCopy.Status.Phase = virtv1.MigrationScheduling
+	migrationCopy.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+		TargetPod: pod.Name,
+	}
+	return c.updateMigrationStatus(migration, migrationCopy)
+}
+
+func (c *CrossClusterMigrationController) failMigration(migration *virtv1.VirtualMachineInstanceMigration, reason string) error {
+	log.Log.Object(migration).Errorf("cross-cluster migration failed: %s", reason)
+	c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedMigrationReason, "Migration failed because %s", reason)
+	return c.updateMigrationPhase(migration, virtv1.MigrationFailed, nil)
+}
+
+func (c *CrossClusterMigrationController) updateMigrationPhase(migration *virtv1.VirtualMachineInstanceMigration, phase virtv1.VirtualMachineInstanceMigrationPhase, state *virtv1.VirtualMachineInstanceMigrationState) error {
+	migrationCopy := migration.DeepCopy()
+	migrationCopy.Status.Phase = phase
+	if state != nil {
+		migrationCopy.Status.MigrationState = state.DeepCopy()
+	}
+	return c.updateMigrationStatus(migration, migrationCopy)
+}
+
+func (c *CrossClusterMigrationController) updateMigrationStatus(migration, migrationCopy *virtv1.VirtualMachineInstanceMigration) error {
+	controller.SetVMIMigrationPhaseTransitionTimestamp(migration, migrationCopy)
+	if equality.Semantic.DeepEqual(migration.Status, migrationCopy.Status) {
+		return nil
+	}
+	_, err := c.clientset.VirtualMachineInstanceMigration(migration.Namespace).UpdateStatus(migrationCopy)
+	return err
+}
+
+func (c *CrossClusterMigrationController) removeFinalizer(migration *virtv1.VirtualMachineInstanceMigration) error {
+	if !controller.HasFinalizer(migration, virtv1.VirtualMachineInstanceMigrationFinalizer) {
+		return nil
+	}
+	migrationCopy := migration.DeepCopy()
+	controller.RemoveFinalizer(migrationCopy, virtv1.VirtualMachineInstanceMigrationFinalizer)
+	_, err := c.clientset.VirtualMachineInstanceMigration(migration.Namespace).Update(migrationCopy)
+	return err
+}
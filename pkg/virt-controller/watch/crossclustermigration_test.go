@@ -0,0 +1,738 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/tools/record"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/certificates/triple"
+	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+)
+
+const (
+	crossClusterKubeVirtNamespace = "kubevirt"
+	crossClusterTunnelAddress     = "tunnel.target.example.com:8444"
+)
+
+// fakeAPIServer serves the objects of one cluster over HTTP, so that the controllers of both
+// clusters talk to each other through real clients like they do in a cross-cluster migration.
+// Like the API server, it assigns UIDs and names, adds the finalizer of migrations and only removes
+// objects with finalizers once the finalizers are gone.
+type fakeAPIServer struct {
+	name    string
+	tracker k8stesting.ObjectTracker
+	counter int64
+}
+
+// The kinds of the resources the controller accesses
+var fakeAPIServerKinds = map[string]string{
+	"pods":                             "Pod",
+	"secrets":                          "Secret",
+	"configmaps":                       "ConfigMap",
+	"persistentvolumeclaims":           "PersistentVolumeClaim",
+	"persistentvolumes":                "PersistentVolume",
+	"virtualmachines":                  "VirtualMachine",
+	"virtualmachineinstances":          "VirtualMachineInstance",
+	"virtualmachineinstancemigrations": "VirtualMachineInstanceMigration",
+}
+
+func newFakeAPIServer(name string) *fakeAPIServer {
+	return &fakeAPIServer{
+		name:    name,
+		tracker: k8stesting.NewObjectTracker(scheme.Scheme, scheme.Codecs.UniversalDecoder()),
+	}
+}
+
+// parseResourcePath splits /api/v1/namespaces/{namespace}/{resource}/{name}/{subresource} and
+// /apis/{group}/{version}/namespaces/{namespace}/{resource}/{name}/{subresource}
+func parseResourcePath(path string) (gvr schema.GroupVersionResource, namespace, name string, err error) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case len(parts) >= 3 && parts[0] == "api":
+		gvr.Version, parts = parts[1], parts[2:]
+	case len(parts) >= 4 && parts[0] == "apis":
+		gvr.Group, gvr.Version, parts = parts[1], parts[2], parts[3:]
+	default:
+		return gvr, "", "", fmt.Errorf("unknown path %s", path)
+	}
+	if len(parts) >= 3 && parts[0] == "namespaces" {
+		namespace, parts = parts[1], parts[2:]
+	}
+	gvr.Resource = parts[0]
+	if len(parts) > 1 {
+		name = parts[1]
+	}
+	return gvr, namespace, name, nil
+}
+
+func (s *fakeAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	gvr, namespace, name, err := parseResourcePath(r.URL.Path)
+	if err != nil {
+		writeError(w, k8serrors.NewNotFound(schema.GroupResource{}, r.URL.Path))
+		return
+	}
+	kind, ok := fakeAPIServerKinds[gvr.Resource]
+	if !ok {
+		writeError(w, k8serrors.NewNotFound(gvr.GroupResource(), name))
+		return
+	}
+	gvk := gvr.GroupVersion().WithKind(kind)
+
+	var obj runtime.Object
+	status := http.StatusOK
+	switch r.Method {
+	case http.MethodGet:
+		if name == "" {
+			obj, err = s.tracker.List(gvr, gvk, namespace)
+			gvk.Kind += "List"
+		} else {
+			obj, err = s.tracker.Get(gvr, namespace, name)
+		}
+	case http.MethodPost:
+		if obj, err = decodeObject(r, gvk); err == nil {
+			err = s.create(gvr, namespace, obj)
+			status = http.StatusCreated
+		}
+	case http.MethodPut:
+		if obj, err = decodeObject(r, gvk); err == nil {
+			err = s.update(gvr, namespace, obj)
+		}
+	case http.MethodDelete:
+		obj, err = s.delete(gvr, namespace, name)
+	default:
+		err = k8serrors.NewMethodNotSupported(gvr.GroupResource(), r.Method)
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	w.Header().Set("Content-Type", runtime.ContentTypeJSON)
+	w.WriteHeader(status)
+	Expect(json.NewEncoder(w).Encode(obj)).To(Succeed())
+}
+
+func decodeObject(r *http.Request, gvk schema.GroupVersionKind) (runtime.Object, error) {
+	obj, err := scheme.Scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.NewDecoder(r.Body).Decode(obj); err != nil {
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+	return obj, nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	apiStatus, ok := err.(k8serrors.APIStatus)
+	if !ok {
+		apiStatus = k8serrors.NewInternalError(err)
+	}
+	status := apiStatus.Status()
+	status.SetGroupVersionKind(metav1.SchemeGroupVersion.WithKind("Status"))
+	w.Header().Set("Content-Type", runtime.ContentTypeJSON)
+	w.WriteHeader(int(status.Code))
+	Expect(json.NewEncoder(w).Encode(status)).To(Succeed())
+}
+
+func (s *fakeAPIServer) create(gvr schema.GroupVersionResource, namespace string, obj runtime.Object) error {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	if accessor.GetName() == "" && accessor.GetGenerateName() != "" {
+		accessor.SetName(fmt.Sprintf("%s%d", accessor.GetGenerateName(), atomic.AddInt64(&s.counter, 1)))
+	}
+	if accessor.GetUID() == "" {
+		accessor.SetUID(types.UID(fmt.Sprintf("%s-%s", s.name, accessor.GetName())))
+	}
+	if migration, ok := obj.(*virtv1.VirtualMachineInstanceMigration); ok {
+		// Added by the mutating webhook of KubeVirt
+		if len(migration.Finalizers) == 0 {
+			migration.Finalizers = []string{virtv1.VirtualMachineInstanceMigrationFinalizer}
+		}
+	}
+	return s.tracker.Create(gvr, obj, namespace)
+}
+
+func (s *fakeAPIServer) update(gvr schema.GroupVersionResource, namespace string, obj runtime.Object) error {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	if accessor.GetDeletionTimestamp() != nil && len(accessor.GetFinalizers()) == 0 {
+		return s.tracker.Delete(gvr, namespace, accessor.GetName())
+	}
+	return s.tracker.Update(gvr, obj, namespace)
+}
+
+func (s *fakeAPIServer) delete(gvr schema.GroupVersionResource, namespace, name string) (runtime.Object, error) {
+	obj, err := s.tracker.Get(gvr, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	if len(accessor.GetFinalizers()) == 0 {
+		return &metav1.Status{Status: metav1.StatusSuccess}, s.tracker.Delete(gvr, namespace, name)
+	}
+	if accessor.GetDeletionTimestamp() == nil {
+		now := metav1.Now()
+		accessor.SetDeletionTimestamp(&now)
+		if err := s.tracker.Update(gvr, obj, namespace); err != nil {
+			return nil, err
+		}
+	}
+	return obj, nil
+}
+
+// fakeCluster stands in for one of the two KubeVirt installations of a cross-cluster migration.
+// Its objects are served by an in-process API server, the informers are refreshed from it by execute.
+type fakeCluster struct {
+	server   *httptest.Server
+	client   kubecli.KubevirtClient
+	caBundle []byte
+
+	migrationInformer cache.SharedIndexInformer
+	vmiInformer       cache.SharedIndexInformer
+	podInformer       cache.SharedIndexInformer
+	recorder          *record.FakeRecorder
+	controller        *CrossClusterMigrationController
+}
+
+func newFakeCluster(name string, remoteClient RemoteClientFactory) *fakeCluster {
+	c := &fakeCluster{
+		server:   httptest.NewServer(newFakeAPIServer(name)),
+		recorder: record.NewFakeRecorder(100),
+	}
+	DeferCleanup(c.server.Close)
+
+	var err error
+	c.client, err = kubecli.GetKubevirtClientFromRESTConfig(&rest.Config{Host: c.server.URL})
+	Expect(err).ToNot(HaveOccurred())
+
+	ca, err := triple.NewCA(name, time.Hour)
+	Expect(err).ToNot(HaveOccurred())
+	c.caBundle = cert.EncodeCertPEM(ca.Cert)
+	caConfigMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
+	Expect(caConfigMapInformer.GetStore().Add(&k8sv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: kubeVirtCAConfigMapName, Namespace: crossClusterKubeVirtNamespace},
+		Data:       map[string]string{components.CABundleKey: string(c.caBundle)},
+	})).To(Succeed())
+
+	c.migrationInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstanceMigration{})
+	c.vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+	c.podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+	pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+	resourceQuotaInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
+	namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+	config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+		DeveloperConfiguration: &virtv1.DeveloperConfiguration{
+			FeatureGates: []string{virtconfig.CrossClusterLiveMigrationGate},
+		},
+		MigrationConfiguration: &virtv1.MigrationConfiguration{
+			CrossClusterTunnelAddress: pointer.P(crossClusterTunnelAddress),
+		},
+	})
+
+	c.controller, err = NewCrossClusterMigrationController(
+		services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", "g", pvcInformer.GetStore(), c.client, config, 107, "h", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
+		c.migrationInformer,
+		c.vmiInformer,
+		c.podInformer,
+		caConfigMapInformer,
+		crossClusterKubeVirtNamespace,
+		c.recorder,
+		c.client,
+		remoteClient,
+		config,
+	)
+	Expect(err).ToNot(HaveOccurred())
+	return c
+}
+
+// kubeconfig returns a kubeconfig which points to the API server of the cluster
+func (c *fakeCluster) kubeconfig() []byte {
+	config := clientcmdapi.NewConfig()
+	config.Clusters["cluster"] = &clientcmdapi.Cluster{Server: c.server.URL}
+	config.AuthInfos["user"] = &clientcmdapi.AuthInfo{}
+	config.Contexts["context"] = &clientcmdapi.Context{Cluster: "cluster", AuthInfo: "user"}
+	config.CurrentContext = "context"
+	kubeconfig, err := clientcmd.Write(*config)
+	Expect(err).ToNot(HaveOccurred())
+	return kubeconfig
+}
+
+// execute refreshes the informers of the cluster and processes the migration
+func (c *fakeCluster) execute(name string) error {
+	var migrations, vmis, pods []interface{}
+	migrationList, err := c.client.VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).List(&metav1.ListOptions{})
+	Expect(err).ToNot(HaveOccurred())
+	for i := range migrationList.Items {
+		migrations = append(migrations, &migrationList.Items[i])
+	}
+	vmiList, err := c.client.VirtualMachineInstance(k8sv1.NamespaceDefault).List(context.Background(), &metav1.ListOptions{})
+	Expect(err).ToNot(HaveOccurred())
+	for i := range vmiList.Items {
+		vmis = append(vmis, &vmiList.Items[i])
+	}
+	podList := c.pods()
+	for i := range podList {
+		pods = append(pods, &podList[i])
+	}
+	Expect(c.migrationInformer.GetStore().Replace(migrations, "")).To(Succeed())
+	Expect(c.vmiInformer.GetStore().Replace(vmis, "")).To(Succeed())
+	Expect(c.podInformer.GetStore().Replace(pods, "")).To(Succeed())
+
+	return c.controller.execute(k8sv1.NamespaceDefault + "/" + name)
+}
+
+func (c *fakeCluster) pods() []k8sv1.Pod {
+	podList, err := c.client.CoreV1().Pods(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+	Expect(err).ToNot(HaveOccurred())
+	return podList.Items
+}
+
+// vmi returns the VMI of the cluster, or nil if it does not exist
+func (c *fakeCluster) vmi(name string) *virtv1.VirtualMachineInstance {
+	vmi, err := c.client.VirtualMachineInstance(k8sv1.NamespaceDefault).Get(context.Background(), name, &metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	Expect(err).ToNot(HaveOccurred())
+	return vmi
+}
+
+func (c *fakeCluster) updateVMI(name string, update func(vmi *virtv1.VirtualMachineInstance)) {
+	vmi := c.vmi(name)
+	Expect(vmi).ToNot(BeNil())
+	update(vmi)
+	_, err := c.client.VirtualMachineInstance(k8sv1.NamespaceDefault).Update(context.Background(), vmi)
+	Expect(err).ToNot(HaveOccurred())
+}
+
+// migration returns the migration of the cluster, or nil if it does not exist
+func (c *fakeCluster) migration(name string) *virtv1.VirtualMachineInstanceMigration {
+	migration, err := c.client.VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Get(name, &metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	Expect(err).ToNot(HaveOccurred())
+	return migration
+}
+
+func (c *fakeCluster) vm(name string) *virtv1.VirtualMachine {
+	vm, err := c.client.VirtualMachine(k8sv1.NamespaceDefault).Get(context.Background(), name, &metav1.GetOptions{})
+	Expect(err).ToNot(HaveOccurred())
+	return vm
+}
+
+var _ = Describe("Cross-cluster migration controller", func() {
+	const (
+		migrationName = "testmigration"
+		vmiName       = "testvmi"
+		secretName    = "target-kubeconfig"
+		migrationID   = "migration-id"
+	)
+
+	var source, target *fakeCluster
+
+	BeforeEach(func() {
+		target = newFakeCluster("target", nil)
+		source = newFakeCluster("source", NewRemoteClient)
+
+		_, err := source.client.CoreV1().Secrets(k8sv1.NamespaceDefault).Create(context.Background(), &k8sv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: k8sv1.NamespaceDefault},
+			Type:       virtv1.CrossClusterMigrationKubeconfigSecretType,
+			Data:       map[string][]byte{virtv1.CrossClusterMigrationKubeconfigKey: target.kubeconfig()},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		vm := &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "testvm", Namespace: k8sv1.NamespaceDefault, UID: "vm-uid"},
+			Spec:       virtv1.VirtualMachineSpec{Running: pointer.P(true)},
+		}
+		_, err = source.client.VirtualMachine(k8sv1.NamespaceDefault).Create(context.Background(), vm)
+		Expect(err).ToNot(HaveOccurred())
+
+		vmi := newVirtualMachine(vmiName, virtv1.Running)
+		vmi.UID = "source-testvmi"
+		vmi.Status.NodeName = "source-node"
+		vmi.Labels = map[string]string{virtv1.NodeNameLabel: "source-node", "app": "test"}
+		vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind)}
+		_, err = source.client.VirtualMachineInstance(k8sv1.NamespaceDefault).Create(context.Background(), vmi)
+		Expect(err).ToNot(HaveOccurred())
+
+		migration := newMigration(migrationName, vmiName, virtv1.MigrationPending)
+		migration.Spec.SendTo = &virtv1.VirtualMachineInstanceMigrationSendTo{
+			MigrationID:          migrationID,
+			KubeconfigSecretName: secretName,
+		}
+		_, err = source.client.VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Create(migration, &metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	runTargetPod := func(nodeName string) {
+		pods := target.pods()
+		Expect(pods).To(HaveLen(1))
+		pod := pods[0]
+		pod.Spec.NodeName = nodeName
+		pod.Status.Phase = k8sv1.PodRunning
+		pod.Status.ContainerStatuses = []k8sv1.ContainerStatus{{
+			Name: "compute", State: k8sv1.ContainerState{Running: &k8sv1.ContainerStateRunning{}},
+		}}
+		_, err := target.client.CoreV1().Pods(k8sv1.NamespaceDefault).Update(context.Background(), &pod, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	listen := func(vmi *virtv1.VirtualMachineInstance) {
+		vmi.Status.MigrationState.TargetNodeAddress = "10.0.0.2"
+		vmi.Status.MigrationState.TargetDirectMigrationNodePorts = map[string]int{"37001": 49152}
+	}
+
+	runDomain := func(vmi *virtv1.VirtualMachineInstance) {
+		now := metav1.Now()
+		vmi.Status.MigrationState.TargetNodeDomainDetected = true
+		vmi.Status.MigrationState.TargetNodeDomainReadyTimestamp = &now
+	}
+
+	// prepareReceiver brings the receiving side to the point where the target node listens for the migration
+	prepareReceiver := func() {
+		Expect(source.execute(migrationName)).To(Succeed())
+		Expect(target.execute(migrationName)).To(Succeed())
+		Expect(target.execute(migrationName)).To(Succeed())
+		runTargetPod("target-node")
+		Expect(target.execute(migrationName)).To(Succeed())
+
+		// virt-handler on the target node starts to listen
+		target.updateVMI(vmiName, listen)
+
+		testutils.ExpectEvent(source.recorder, SuccessfulCrossClusterMigrationReason)
+		testutils.ExpectEvent(target.recorder, SuccessfulCrossClusterMigrationReason)
+		testutils.ExpectEvent(target.recorder, SuccessfulCreatePodReason)
+	}
+
+	It("should migrate the VMI to the target cluster", func() {
+		By("creating the receiving migration in the target cluster")
+		Expect(source.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(source.recorder, SuccessfulCrossClusterMigrationReason)
+		Expect(source.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationScheduling))
+
+		receive := target.migration(migrationName).Spec.Receive
+		Expect(receive).ToNot(BeNil())
+		Expect(receive.MigrationID).To(Equal(migrationID))
+		Expect(receive.SourceCABundle).To(Equal(source.caBundle))
+		Expect(receive.Template.Spec).To(Equal(source.vmi(vmiName).Spec))
+		Expect(receive.Template.ObjectMeta.Labels).To(Equal(map[string]string{"app": "test"}))
+		Expect(receive.Template.ObjectMeta.Annotations).To(HaveKeyWithValue(virtv1.MigrationSourceUIDAnnotation, "source-testvmi"))
+		Expect(target.vmi(vmiName)).To(BeNil())
+
+		By("creating the receiving VMI from the template in the target cluster")
+		Expect(target.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(target.recorder, SuccessfulCrossClusterMigrationReason)
+		receiver := target.vmi(vmiName)
+		Expect(receiver).ToNot(BeNil())
+		Expect(receiver.Spec).To(Equal(source.vmi(vmiName).Spec))
+		Expect(receiver.Labels).To(Equal(map[string]string{"app": "test"}))
+		Expect(receiver.Annotations).To(HaveKeyWithValue(virtv1.MigrationReceiverAnnotation, migrationID))
+		Expect(receiver.Annotations).To(HaveKeyWithValue(virtv1.MigrationSourceUIDAnnotation, "source-testvmi"))
+
+		By("creating the target pod in the target cluster")
+		Expect(target.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(target.recorder, SuccessfulCreatePodReason)
+		pods := target.pods()
+		Expect(pods).To(HaveLen(1))
+		Expect(pods[0].Labels).To(HaveKeyWithValue(virtv1.MigrationJobLabel, "target-"+migrationName))
+		Expect(target.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationScheduling))
+
+		By("handing the receiving VMI to the target node once the pod runs")
+		runTargetPod("target-node")
+		Expect(target.execute(migrationName)).To(Succeed())
+		receiver = target.vmi(vmiName)
+		Expect(receiver.Labels).To(HaveKeyWithValue(virtv1.MigrationTargetNodeNameLabel, "target-node"))
+		Expect(receiver.Status.Phase).To(Equal(virtv1.Running))
+		Expect(receiver.Status.MigrationState.TargetNode).To(Equal("target-node"))
+		Expect(receiver.Status.MigrationState.SourceVirtualMachineInstanceUID).To(Equal(types.UID("source-testvmi")))
+		Expect(*receiver.Status.MigrationState.MigrationConfiguration.DisableTLS).To(BeFalse())
+		Expect(receiver.Status.MigrationState.TargetTunnel).To(Equal(&virtv1.VirtualMachineInstanceMigrationTunnel{
+			Address:    crossClusterTunnelAddress,
+			ServerName: "virt-api.kubevirt.svc",
+			CABundle:   target.caBundle,
+		}))
+		Expect(target.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationScheduled))
+
+		By("waiting for the target node to listen")
+		Expect(source.execute(migrationName)).To(Succeed())
+		Expect(source.vmi(vmiName).Status.MigrationState).To(BeNil())
+
+		By("passing the listener and the migration tunnel of the target cluster to the source node")
+		target.updateVMI(vmiName, listen)
+		Expect(source.execute(migrationName)).To(Succeed())
+		state := source.vmi(vmiName).Status.MigrationState
+		Expect(state).ToNot(BeNil())
+		Expect(state.MigrationUID).To(Equal(source.migration(migrationName).UID))
+		Expect(state.SourceNode).To(Equal("source-node"))
+		Expect(state.TargetNode).To(Equal("target-node"))
+		Expect(state.TargetNodeAddress).To(Equal("10.0.0.2"))
+		Expect(state.TargetDirectMigrationNodePorts).To(Equal(map[string]int{"37001": 49152}))
+		Expect(state.TargetTunnel).To(Equal(target.vmi(vmiName).Status.MigrationState.TargetTunnel))
+		Expect(state.TargetVirtualMachineInstanceUID).To(Equal(types.UID("target-testvmi")))
+		Expect(*state.MigrationConfiguration.DisableTLS).To(BeFalse())
+		Expect(source.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationScheduled))
+
+		By("completing the migration once the domain runs on the target node")
+		target.updateVMI(vmiName, runDomain)
+		Expect(source.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(source.recorder, SuccessfulCrossClusterMigrationReason)
+		Expect(source.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationSucceeded))
+		Expect(source.vm("testvm").Spec.Running).To(Equal(pointer.P(false)))
+		Expect(source.vmi(vmiName)).To(BeNil())
+
+		Expect(target.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(target.recorder, SuccessfulCrossClusterMigrationReason)
+		receiver = target.vmi(vmiName)
+		Expect(receiver.Annotations).ToNot(HaveKey(virtv1.MigrationReceiverAnnotation))
+		Expect(receiver.Labels).To(HaveKeyWithValue(virtv1.NodeNameLabel, "target-node"))
+		Expect(receiver.Status.NodeName).To(Equal("target-node"))
+		Expect(receiver.Status.MigrationState.Completed).To(BeTrue())
+		Expect(target.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationSucceeded))
+
+		By("removing the finalizers of the finished migrations")
+		Expect(source.execute(migrationName)).To(Succeed())
+		Expect(source.migration(migrationName).Finalizers).To(BeEmpty())
+		Expect(target.execute(migrationName)).To(Succeed())
+		Expect(target.migration(migrationName).Finalizers).To(BeEmpty())
+	})
+
+	It("should halt VMs with a run strategy", func() {
+		vm := source.vm("testvm")
+		vm.Spec.Running = nil
+		vm.Spec.RunStrategy = pointer.P(virtv1.RunStrategyAlways)
+		_, err := source.client.VirtualMachine(k8sv1.NamespaceDefault).Update(context.Background(), vm)
+		Expect(err).ToNot(HaveOccurred())
+		prepareReceiver()
+
+		target.updateVMI(vmiName, runDomain)
+		Expect(source.execute(migrationName)).To(Succeed())
+		Expect(source.vm("testvm").Spec.RunStrategy).To(Equal(pointer.P(virtv1.RunStrategyHalted)))
+		Expect(source.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationSucceeded))
+	})
+
+	It("should remove the receiving side if the migration fails on the source node", func() {
+		prepareReceiver()
+		Expect(source.execute(migrationName)).To(Succeed())
+
+		source.updateVMI(vmiName, func(vmi *virtv1.VirtualMachineInstance) {
+			vmi.Status.MigrationState.Completed = true
+			vmi.Status.MigrationState.Failed = true
+		})
+		Expect(source.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(source.recorder, FailedMigrationReason)
+		Expect(source.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationFailed))
+		Expect(source.vmi(vmiName)).ToNot(BeNil())
+		Expect(target.vmi(vmiName)).To(BeNil())
+		Expect(target.migration(migrationName).DeletionTimestamp).ToNot(BeNil())
+
+		By("failing the deleted receiving migration in the target cluster")
+		Expect(target.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(target.recorder, FailedMigrationReason)
+		Expect(target.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationFailed))
+		Expect(target.execute(migrationName)).To(Succeed())
+		Expect(target.migration(migrationName)).To(BeNil())
+	})
+
+	It("should fail both sides if the receiving VMI disappears", func() {
+		prepareReceiver()
+		Expect(target.client.VirtualMachineInstance(k8sv1.NamespaceDefault).Delete(context.Background(), vmiName, &metav1.DeleteOptions{})).To(Succeed())
+
+		Expect(target.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(target.recorder, FailedMigrationReason)
+		Expect(target.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationFailed))
+
+		Expect(source.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(source.recorder, FailedMigrationReason)
+		Expect(source.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationFailed))
+	})
+
+	It("should fail if the receiving migration disappears before the receiving VMI is created", func() {
+		Expect(source.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(source.recorder, SuccessfulCrossClusterMigrationReason)
+		migration := target.migration(migrationName)
+		migration.Finalizers = nil
+		_, err := target.client.VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Update(migration)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(target.client.VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Delete(migrationName, &metav1.DeleteOptions{})).To(Succeed())
+
+		Expect(source.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(source.recorder, FailedMigrationReason)
+		Expect(source.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationFailed))
+	})
+
+	It("should not touch a VMI with the same name in the target cluster", func() {
+		_, err := target.client.VirtualMachineInstance(k8sv1.NamespaceDefault).Create(context.Background(), newVirtualMachine(vmiName, virtv1.Running))
+		Expect(err).ToNot(HaveOccurred())
+		other := target.vmi(vmiName)
+
+		Expect(source.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(source.recorder, FailedMigrationReason)
+		Expect(source.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationFailed))
+		Expect(target.vmi(vmiName)).To(Equal(other))
+		Expect(target.migration(migrationName)).To(BeNil())
+	})
+
+	It("should fail the receiving migration if the target pod disappears", func() {
+		prepareReceiver()
+		Expect(target.client.CoreV1().Pods(k8sv1.NamespaceDefault).Delete(context.Background(), target.pods()[0].Name, metav1.DeleteOptions{})).To(Succeed())
+
+		Expect(target.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(target.recorder, FailedMigrationReason)
+		Expect(target.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationFailed))
+	})
+
+	Context("with persistent volumes", func() {
+		const claimName = "testclaim"
+
+		createClaim := func(cluster *fakeCluster, pvName string, source k8sv1.PersistentVolumeSource) {
+			_, err := cluster.client.CoreV1().PersistentVolumes().Create(context.Background(), &k8sv1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: pvName},
+				Spec:       k8sv1.PersistentVolumeSpec{PersistentVolumeSource: source},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = cluster.client.CoreV1().PersistentVolumeClaims(k8sv1.NamespaceDefault).Create(context.Background(), &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: claimName, Namespace: k8sv1.NamespaceDefault},
+				Spec:       k8sv1.PersistentVolumeClaimSpec{VolumeName: pvName},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		csiVolume := func(handle string) k8sv1.PersistentVolumeSource {
+			return k8sv1.PersistentVolumeSource{CSI: &k8sv1.CSIPersistentVolumeSource{Driver: "csi.example.com", VolumeHandle: handle}}
+		}
+
+		BeforeEach(func() {
+			source.updateVMI(vmiName, func(vmi *virtv1.VirtualMachineInstance) {
+				vmi.Spec.Volumes = []virtv1.Volume{{
+					Name: "disk0",
+					VolumeSource: virtv1.VolumeSource{
+						PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+						},
+					},
+				}}
+			})
+			createClaim(source, "source-pv", csiVolume("volume-1"))
+		})
+
+		It("should create the receiver if the volumes are shared", func() {
+			createClaim(target, "target-pv", csiVolume("volume-1"))
+
+			Expect(source.execute(migrationName)).To(Succeed())
+			testutils.ExpectEvent(source.recorder, SuccessfulCrossClusterMigrationReason)
+			Expect(source.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationScheduling))
+			Expect(target.migration(migrationName)).ToNot(BeNil())
+		})
+
+		DescribeTable("should fail if the volumes are not shared", func(prepareTarget func()) {
+			prepareTarget()
+
+			Expect(source.execute(migrationName)).To(Succeed())
+			testutils.ExpectEvent(source.recorder, FailedMigrationReason)
+			Expect(source.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationFailed))
+			Expect(target.migration(migrationName)).To(BeNil())
+		},
+			Entry("with the claim missing in the target cluster", func() {}),
+			Entry("with the claim bound to another volume", func() {
+				createClaim(target, "target-pv", csiVolume("volume-2"))
+			}),
+			Entry("with the claim bound to another kind of volume", func() {
+				createClaim(target, "target-pv", k8sv1.PersistentVolumeSource{NFS: &k8sv1.NFSVolumeSource{Server: "nfs.example.com", Path: "/volume-1"}})
+			}),
+		)
+	})
+
+	It("should fail if the VMI uses a host disk", func() {
+		source.updateVMI(vmiName, func(vmi *virtv1.VirtualMachineInstance) {
+			vmi.Spec.Volumes = []virtv1.Volume{{
+				Name:         "disk0",
+				VolumeSource: virtv1.VolumeSource{HostDisk: &virtv1.HostDisk{Path: "/data/disk.img"}},
+			}}
+		})
+
+		Expect(source.execute(migrationName)).To(Succeed())
+		testutils.ExpectEvent(source.recorder, FailedMigrationReason)
+		Expect(source.migration(migrationName).Status.Phase).To(Equal(virtv1.MigrationFailed))
+		Expect(target.migration(migrationName)).To(BeNil())
+	})
+
+	It("should fail if the kubeconfig of the target cluster is missing", func() {
+		Expect(source.client.CoreV1().Secrets(k8sv1.NamespaceDefault).Delete(context.Background(), secretName, metav1.DeleteOptions{})).To(Succeed())
+
+		Expect(source.execute(migrationName)).ToNot(Succeed())
+		testutils.ExpectEvent(source.recorder, FailedCrossClusterMigrationReason)
+		Expect(target.migration(migrationName)).To(BeNil())
+	})
+
+	It("should not use secrets of another type", func() {
+		secret, err := source.client.CoreV1().Secrets(k8sv1.NamespaceDefault).Get(context.Background(), secretName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		secret.Type = k8sv1.SecretTypeOpaque
+		_, err = source.client.CoreV1().Secrets(k8sv1.NamespaceDefault).Update(context.Background(), secret, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(source.execute(migrationName)).ToNot(Succeed())
+		testutils.ExpectEvent(source.recorder, FailedCrossClusterMigrationReason)
+		Expect(target.migration(migrationName)).To(BeNil())
+	})
+})
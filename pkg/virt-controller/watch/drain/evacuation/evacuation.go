@@ -344,9 +344,20 @@ func (c *EvacuationController) execute(key string) error {
 
 	migrations := migrationutils.ListUnfinishedMigrations(c.migrationInformer)
 
+	if err := c.updateEvacuationStatus(node, vmis, migrations, c.drainTaint()); err != nil {
+		return err
+	}
+
 	return c.sync(node, vmis, migrations)
 }
 
+func (c *EvacuationController) drainTaint() *k8sv1.Taint {
+	return &k8sv1.Taint{
+		Key:    *c.clusterConfig.GetMigrationConfiguration().NodeDrainTaintKey,
+		Effect: k8sv1.TaintEffectNoSchedule,
+	}
+}
+
 func getMarkedForEvictionVMIs(vmis []*virtv1.VirtualMachineInstance) []*virtv1.VirtualMachineInstance {
 	var evictionCandidates []*virtv1.VirtualMachineInstance
 	for _, vmi := range vmis {
@@ -375,13 +386,7 @@ func GenerateNewMigration(vmiName string, key string) *virtv1.VirtualMachineInst
 
 func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.VirtualMachineInstance, activeMigrations []*virtv1.VirtualMachineInstanceMigration) error {
 	// If the node has no drain taint, we have nothing to do
-	taintKey := *c.clusterConfig.GetMigrationConfiguration().NodeDrainTaintKey
-	taint := &k8sv1.Taint{
-		Key:    taintKey,
-		Effect: k8sv1.TaintEffectNoSchedule,
-	}
-
-	vmisToMigrate := vmisToMigrate(node, vmisOnNode, taint)
+	vmisToMigrate := vmisToMigrate(node, vmisOnNode, c.drainTaint())
 	if len(vmisToMigrate) == 0 {
 		return nil
 	}
@@ -1,6 +1,7 @@
 package evacuation_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -46,6 +47,7 @@ var _ = Describe("Evacuation", func() {
 	var kubeClient *fake.Clientset
 	var migrationFeeder *testutils.MigrationFeeder
 	var vmiFeeder *testutils.VirtualMachineFeeder
+	var nodePatches map[string][]byte
 
 	var controller *evacuation.EvacuationController
 
@@ -105,6 +107,13 @@ var _ = Describe("Evacuation", func() {
 			Expect(action).To(BeNil())
 			return true, nil, nil
 		})
+		// The evacuation status of draining nodes is patched on every sync
+		nodePatches = map[string][]byte{}
+		kubeClient.Fake.PrependReactor("patch", "nodes", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			patch := action.(testing.PatchAction)
+			nodePatches[patch.GetName()] = patch.GetPatch()
+			return true, nil, nil
+		})
 		syncCaches(stop)
 	})
 
@@ -542,6 +551,143 @@ var _ = Describe("Evacuation", func() {
 		})
 	})
 
+	Context("evacuation status", func() {
+
+		evacuationStatus := func(nodeName string) *v1.NodeEvacuationStatus {
+			patch := &v12.Node{}
+			Expect(nodePatches).To(HaveKey(nodeName))
+			Expect(json.Unmarshal(nodePatches[nodeName], patch)).To(Succeed())
+			Expect(patch.Annotations).To(HaveKey(v1.NodeEvacuationStatusAnnotation))
+			status := &v1.NodeEvacuationStatus{}
+			Expect(json.Unmarshal([]byte(patch.Annotations[v1.NodeEvacuationStatusAnnotation]), status)).To(Succeed())
+			return status
+		}
+
+		It("should not annotate a node which is not drained", func() {
+			node := newNode("testnode")
+			addNode(node)
+			vmiFeeder.Add(newVirtualMachine("testvm", node.Name))
+
+			controller.Execute()
+			Expect(nodePatches).To(BeEmpty())
+		})
+
+		It("should list the planned action and progress of every VMI on a cordoned node", func() {
+			node := newNode("testnode")
+			node.Spec.Unschedulable = true
+			addNode(node)
+
+			migrating := newVirtualMachine("migrating", node.Name)
+			migrating.Spec.EvictionStrategy = newEvictionStrategyLiveMigrate()
+			vmiFeeder.Add(migrating)
+			migrationFeeder.Add(newMigration("mig1", migrating.Name, v1.MigrationRunning))
+
+			blocked := newVirtualMachine("blocked", node.Name)
+			blocked.Spec.EvictionStrategy = newEvictionStrategyLiveMigrate()
+			blocked.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:    v1.VirtualMachineInstanceIsMigratable,
+				Status:  v12.ConditionFalse,
+				Reason:  v1.VirtualMachineInstanceReasonDisksNotMigratable,
+				Message: "cannot migrate VMI: PVC disk0 is not shared",
+			}}
+			vmiFeeder.Add(blocked)
+
+			shutdown := newVirtualMachine("shutdown", node.Name)
+			shutdown.Spec.EvictionStrategy = newEvictionStrategyNone()
+			vmiFeeder.Add(shutdown)
+
+			ifPossible := newVirtualMachine("ifpossible", node.Name)
+			ifPossible.Spec.EvictionStrategy = pointer.P(v1.EvictionStrategyLiveMigrateIfPossible)
+			ifPossible.Status.Conditions = nil
+			vmiFeeder.Add(ifPossible)
+
+			failed := newVirtualMachine("failed", node.Name)
+			failed.Spec.EvictionStrategy = newEvictionStrategyLiveMigrate()
+			failed.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{Failed: true}
+			vmiFeeder.Add(failed)
+
+			final := newVirtualMachine("final", node.Name)
+			final.Status.Phase = v1.Succeeded
+			vmiFeeder.Add(final)
+
+			controller.Execute()
+
+			status := evacuationStatus(node.Name)
+			Expect(status.Phase).To(Equal(v1.NodeEvacuationInProgress))
+			Expect(status.VirtualMachineInstances).To(Equal([]v1.VirtualMachineInstanceEvacuation{
+				{
+					Namespace: v12.NamespaceDefault,
+					Name:      "blocked",
+					Action:    v1.EvacuationActionBlocked,
+					Reason:    "DisksNotLiveMigratable: cannot migrate VMI: PVC disk0 is not shared",
+					Progress:  v1.EvacuationPending,
+				},
+				{
+					Namespace: v12.NamespaceDefault,
+					Name:      "failed",
+					Action:    v1.EvacuationActionLiveMigrate,
+					Progress:  v1.EvacuationFailed,
+				},
+				{
+					Namespace: v12.NamespaceDefault,
+					Name:      "ifpossible",
+					Action:    v1.EvacuationActionShutdown,
+					Reason:    "VirtualMachineInstance is not live migratable",
+					Progress:  v1.EvacuationPending,
+				},
+				{
+					Namespace:      v12.NamespaceDefault,
+					Name:           "migrating",
+					Action:         v1.EvacuationActionLiveMigrate,
+					Progress:       v1.EvacuationMigrating,
+					MigrationName:  "mig1",
+					MigrationPhase: v1.MigrationRunning,
+				},
+				{
+					Namespace: v12.NamespaceDefault,
+					Name:      "shutdown",
+					Action:    v1.EvacuationActionShutdown,
+					Reason:    "EvictionStrategy is None",
+					Progress:  v1.EvacuationPending,
+				},
+			}))
+		})
+
+		It("should report a completed evacuation once no VMI is left on the node", func() {
+			node := newNode("testnode")
+			node.Spec.Taints = append(node.Spec.Taints, *newTaint())
+			addNode(node)
+
+			controller.Execute()
+
+			status := evacuationStatus(node.Name)
+			Expect(status.Phase).To(Equal(v1.NodeEvacuationCompleted))
+			Expect(status.VirtualMachineInstances).To(BeEmpty())
+		})
+
+		It("should not patch the node if the evacuation status did not change", func() {
+			node := newNode("testnode")
+			node.Spec.Taints = append(node.Spec.Taints, *newTaint())
+			data, err := json.Marshal(&v1.NodeEvacuationStatus{Phase: v1.NodeEvacuationCompleted})
+			Expect(err).ToNot(HaveOccurred())
+			node.Annotations = map[string]string{v1.NodeEvacuationStatusAnnotation: string(data)}
+			addNode(node)
+
+			controller.Execute()
+			Expect(nodePatches).To(BeEmpty())
+		})
+
+		It("should remove the evacuation status once the node is back in service", func() {
+			node := newNode("testnode")
+			node.Annotations = map[string]string{v1.NodeEvacuationStatusAnnotation: "{}"}
+			addNode(node)
+
+			controller.Execute()
+			Expect(nodePatches).To(HaveKeyWithValue(node.Name,
+				[]byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:null}}}`, v1.NodeEvacuationStatusAnnotation))))
+		})
+	})
+
 	AfterEach(func() {
 		close(stop)
 		// Ensure that we add checks for expected events to every test
@@ -0,0 +1,180 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package evacuation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	k8sv1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+// updateEvacuationStatus keeps the evacuation status annotation of the node up to date
+// while the node is drained and removes it once the node is back in service.
+func (c *EvacuationController) updateEvacuationStatus(node *k8sv1.Node, vmisOnNode []*virtv1.VirtualMachineInstance, activeMigrations []*virtv1.VirtualMachineInstanceMigration, taint *k8sv1.Taint) error {
+	current, hasStatus := node.Annotations[virtv1.NodeEvacuationStatusAnnotation]
+
+	if !isEvacuating(node, vmisOnNode, taint) {
+		if !hasStatus {
+			return nil
+		}
+		return c.patchEvacuationStatus(node, nil)
+	}
+
+	status := c.calculateEvacuationStatus(vmisOnNode, activeMigrations)
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	if hasStatus && current == string(data) {
+		return nil
+	}
+	value := string(data)
+	return c.patchEvacuationStatus(node, &value)
+}
+
+func (c *EvacuationController) patchEvacuationStatus(node *k8sv1.Node, value *string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]*string{
+				virtv1.NodeEvacuationStatusAnnotation: value,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := c.clientset.CoreV1().Nodes().Patch(context.Background(), node.Name, types.StrategicMergePatchType, patch, v1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to update the evacuation status of node %s: %v", node.Name, err)
+	}
+	return nil
+}
+
+// isEvacuating returns true if the node is cordoned, carries the drain taint
+// or VMIs on it are marked for eviction
+func isEvacuating(node *k8sv1.Node, vmisOnNode []*virtv1.VirtualMachineInstance, taint *k8sv1.Taint) bool {
+	if node.Spec.Unschedulable || nodeHasTaint(taint, node) {
+		return true
+	}
+	for _, vmi := range vmisOnNode {
+		if vmi.IsMarkedForEviction() && !hasMigratedOnEviction(vmi) && !vmi.IsFinal() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *EvacuationController) calculateEvacuationStatus(vmisOnNode []*virtv1.VirtualMachineInstance, activeMigrations []*virtv1.VirtualMachineInstanceMigration) *virtv1.NodeEvacuationStatus {
+	lookup := map[string]*virtv1.VirtualMachineInstanceMigration{}
+	for _, migration := range activeMigrations {
+		lookup[migration.Namespace+"/"+migration.Spec.VMIName] = migration
+	}
+
+	status := &virtv1.NodeEvacuationStatus{}
+	for _, vmi := range vmisOnNode {
+		if vmi.IsFinal() {
+			continue
+		}
+
+		action, reason := c.plannedEvacuationAction(vmi)
+		evacuation := virtv1.VirtualMachineInstanceEvacuation{
+			Namespace: vmi.Namespace,
+			Name:      vmi.Name,
+			Action:    action,
+			Reason:    reason,
+			Progress:  virtv1.EvacuationPending,
+		}
+
+		migration := lookup[vmi.Namespace+"/"+vmi.Name]
+		switch {
+		case vmi.DeletionTimestamp != nil:
+			evacuation.Progress = virtv1.EvacuationShuttingDown
+		case migration != nil:
+			evacuation.Progress = virtv1.EvacuationMigrating
+			evacuation.MigrationName = migration.Name
+			evacuation.MigrationPhase = migration.Status.Phase
+		case action == virtv1.EvacuationActionLiveMigrate && vmi.Status.MigrationState != nil && vmi.Status.MigrationState.Failed:
+			evacuation.Progress = virtv1.EvacuationFailed
+		}
+		status.VirtualMachineInstances = append(status.VirtualMachineInstances, evacuation)
+	}
+
+	sort.Slice(status.VirtualMachineInstances, func(i, j int) bool {
+		a, b := status.VirtualMachineInstances[i], status.VirtualMachineInstances[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	status.Phase = virtv1.NodeEvacuationCompleted
+	if len(status.VirtualMachineInstances) > 0 {
+		status.Phase = virtv1.NodeEvacuationInProgress
+	}
+	return status
+}
+
+// plannedEvacuationAction derives what happens to the VMI on a drain from its
+// eviction strategy and whether it can be live migrated
+func (c *EvacuationController) plannedEvacuationAction(vmi *virtv1.VirtualMachineInstance) (virtv1.EvacuationAction, string) {
+	strategy := migrationutils.VMIEvictionStrategy(c.clusterConfig, vmi)
+	if strategy == nil {
+		return virtv1.EvacuationActionShutdown, "no EvictionStrategy is set"
+	}
+
+	switch *strategy {
+	case virtv1.EvictionStrategyLiveMigrate:
+		if migratable, reason := isLiveMigratable(vmi); !migratable {
+			return virtv1.EvacuationActionBlocked, reason
+		}
+		return virtv1.EvacuationActionLiveMigrate, ""
+	case virtv1.EvictionStrategyLiveMigrateIfPossible:
+		if migratable, reason := isLiveMigratable(vmi); !migratable {
+			return virtv1.EvacuationActionShutdown, reason
+		}
+		return virtv1.EvacuationActionLiveMigrate, ""
+	case virtv1.EvictionStrategyExternal:
+		return virtv1.EvacuationActionExternal, "EvictionStrategy is External"
+	}
+	return virtv1.EvacuationActionShutdown, fmt.Sprintf("EvictionStrategy is %s", *strategy)
+}
+
+func isLiveMigratable(vmi *virtv1.VirtualMachineInstance) (bool, string) {
+	condition := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, virtv1.VirtualMachineInstanceIsMigratable)
+	if condition != nil && condition.Status == k8sv1.ConditionTrue {
+		return true, ""
+	}
+	if condition == nil || condition.Reason == "" {
+		return false, "VirtualMachineInstance is not live migratable"
+	}
+	if condition.Message != "" {
+		return false, fmt.Sprintf("%s: %s", condition.Reason, condition.Message)
+	}
+	return false, condition.Reason
+}
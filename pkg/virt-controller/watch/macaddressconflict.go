@@ -0,0 +1,206 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// The conflicts are detected across all the VMs and the VMIs at once
+	macAddressConflictKey = "macaddresses"
+
+	// MacAddressConflictReason is the reason of the events reporting a MAC address used by another VM or VMI
+	MacAddressConflictReason = "MacAddressConflict"
+)
+
+// MacAddressConflictController reports the MAC addresses used by several VMs or VMIs,
+// when the MacAddressPool feature gate is enabled.
+// Each virt-api instance assigns the MAC addresses from its own view of the cluster,
+// concurrent admissions may therefore assign the same MAC address twice.
+type MacAddressConflictController struct {
+	Queue         workqueue.RateLimitingInterface
+	vmInformer    cache.SharedIndexInformer
+	vmiInformer   cache.SharedIndexInformer
+	recorder      record.EventRecorder
+	clusterConfig *virtconfig.ClusterConfig
+	// reported holds the conflicts already reported, a conflict is reported again
+	// only after it is resolved and shows up once more
+	reported map[string]struct{}
+}
+
+// NewMacAddressConflictController creates a new instance of the MacAddressConflictController struct.
+func NewMacAddressConflictController(vmInformer, vmiInformer cache.SharedIndexInformer, recorder record.EventRecorder, clusterConfig *virtconfig.ClusterConfig) (*MacAddressConflictController, error) {
+	c := &MacAddressConflictController{
+		Queue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-mac-address-conflict"),
+		vmInformer:    vmInformer,
+		vmiInformer:   vmiInformer,
+		recorder:      recorder,
+		clusterConfig: clusterConfig,
+		reported:      map[string]struct{}{},
+	}
+
+	_, err := c.vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueue() },
+		DeleteFunc: func(_ interface{}) { c.enqueue() },
+		UpdateFunc: c.updateVM,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueue() },
+		DeleteFunc: func(_ interface{}) { c.enqueue() },
+		UpdateFunc: c.updateVMI,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The feature gate and the ranges may change at any time
+	clusterConfig.SetConfigModifiedCallback(c.enqueue)
+
+	return c, nil
+}
+
+func (c *MacAddressConflictController) enqueue() {
+	c.Queue.Add(macAddressConflictKey)
+}
+
+func (c *MacAddressConflictController) updateVM(old, curr interface{}) {
+	oldVM := old.(*virtv1.VirtualMachine)
+	currVM := curr.(*virtv1.VirtualMachine)
+	if !equality.Semantic.DeepEqual(macpool.VMMacAddresses(oldVM), macpool.VMMacAddresses(currVM)) {
+		c.enqueue()
+	}
+}
+
+func (c *MacAddressConflictController) updateVMI(old, curr interface{}) {
+	oldVMI := old.(*virtv1.VirtualMachineInstance)
+	currVMI := curr.(*virtv1.VirtualMachineInstance)
+	if !equality.Semantic.DeepEqual(macpool.VMIMacAddresses(oldVMI), macpool.VMIMacAddresses(currVMI)) {
+		c.enqueue()
+	}
+}
+
+// Run runs the passed in MacAddressConflictController.
+func (c *MacAddressConflictController) Run(stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting MAC address conflict controller.")
+
+	// The queue only ever holds a single key, more workers would not help
+	threadiness := 1
+
+	// Wait for cache sync before we start the controller
+	cache.WaitForCacheSync(stopCh, c.vmInformer.HasSynced, c.vmiInformer.HasSynced)
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping MAC address conflict controller.")
+}
+
+func (c *MacAddressConflictController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute runs the detection of the conflicts queued by the informers.
+// Returns false if the queue is shut down.
+func (c *MacAddressConflictController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	c.execute()
+	c.Queue.Forget(key)
+	return true
+}
+
+func (c *MacAddressConflictController) execute() {
+	if !c.clusterConfig.MacAddressPoolEnabled() {
+		c.reported = map[string]struct{}{}
+		return
+	}
+
+	pool := macpool.New()
+	objects := map[macpool.Holder]runtime.Object{}
+	for _, obj := range c.vmInformer.GetStore().List() {
+		vm := obj.(*virtv1.VirtualMachine)
+		holder := macpool.VMHolder(vm)
+		pool.Set(holder, macpool.VMMacAddresses(vm))
+		objects[holder] = vm
+	}
+	for _, obj := range c.vmiInformer.GetStore().List() {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		holder := macpool.VMIHolder(vmi)
+		pool.Set(holder, macpool.VMIMacAddresses(vmi))
+		objects[holder] = vmi
+	}
+
+	reported := map[string]struct{}{}
+	for _, conflict := range pool.Conflicts() {
+		for _, holder := range conflict.Holders {
+			key := fmt.Sprintf("%s/%s", conflict.MacAddress, holder)
+			reported[key] = struct{}{}
+			if _, exists := c.reported[key]; exists {
+				continue
+			}
+			c.recorder.Eventf(objects[holder], k8sv1.EventTypeWarning, MacAddressConflictReason,
+				"MAC address %s is also used by %s", conflict.MacAddress, otherHolders(conflict.Holders, holder))
+		}
+	}
+	c.reported = reported
+}
+
+// otherHolders lists the holders of the MAC address which do not share the owner of the given holder.
+func otherHolders(holders []macpool.Holder, holder macpool.Holder) string {
+	var others []string
+	for _, h := range holders {
+		if h.Namespace != holder.Namespace || h.Name != holder.Name {
+			others = append(others, h.String())
+		}
+	}
+	return strings.Join(others, ", ")
+}
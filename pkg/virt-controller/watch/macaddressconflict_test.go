@@ -0,0 +1,135 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("MAC address conflict controller", func() {
+	var (
+		controller  *MacAddressConflictController
+		vmInformer  cache.SharedIndexInformer
+		vmiInformer cache.SharedIndexInformer
+		recorder    *record.FakeRecorder
+	)
+
+	newVM := func(name string, macAddresses ...string) *virtv1.VirtualMachine {
+		vm := &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name},
+			Spec:       virtv1.VirtualMachineSpec{Template: &virtv1.VirtualMachineInstanceTemplateSpec{}},
+		}
+		for _, macAddress := range macAddresses {
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = append(vm.Spec.Template.Spec.Domain.Devices.Interfaces,
+				virtv1.Interface{Name: macAddress, MacAddress: macAddress})
+		}
+		return vm
+	}
+
+	newVMI := func(name string, macAddresses ...string) *virtv1.VirtualMachineInstance {
+		vmi := &virtv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name},
+		}
+		for _, macAddress := range macAddresses {
+			vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces,
+				virtv1.Interface{Name: macAddress, MacAddress: macAddress})
+		}
+		return vmi
+	}
+
+	newController := func(featureGates ...string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+			DeveloperConfiguration: &virtv1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		var err error
+		controller, err = NewMacAddressConflictController(vmInformer, vmiInformer, recorder, clusterConfig)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		recorder = record.NewFakeRecorder(100)
+	})
+
+	It("should report a MAC address used by several VMs once", func() {
+		newController(virtconfig.MacAddressPoolGate)
+		Expect(vmInformer.GetStore().Add(newVM("vm1", "02:00:00:00:00:01"))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(newVMI("vm1", "02:00:00:00:00:01"))).To(Succeed())
+		Expect(vmInformer.GetStore().Add(newVM("vm2", "02:00:00:00:00:01", "02:00:00:00:00:02"))).To(Succeed())
+
+		controller.execute()
+		Expect(recorder.Events).To(HaveLen(3))
+		By("Reporting the conflict on the VM and the VMI of vm1")
+		Expect(<-recorder.Events).To(ContainSubstring("MAC address 02:00:00:00:00:01 is also used by VirtualMachine default/vm2"))
+		Expect(<-recorder.Events).To(ContainSubstring("MAC address 02:00:00:00:00:01 is also used by VirtualMachine default/vm2"))
+		By("Reporting the conflict on vm2")
+		Expect(<-recorder.Events).To(ContainSubstring("MAC address 02:00:00:00:00:01 is also used by VirtualMachine default/vm1, VirtualMachineInstance default/vm1"))
+
+		controller.execute()
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should report a conflict again once it shows up after being resolved", func() {
+		newController(virtconfig.MacAddressPoolGate)
+		vm2 := newVM("vm2", "02:00:00:00:00:01")
+		Expect(vmInformer.GetStore().Add(newVM("vm1", "02:00:00:00:00:01"))).To(Succeed())
+		Expect(vmInformer.GetStore().Add(vm2)).To(Succeed())
+		controller.execute()
+		testutils.ExpectEvents(recorder, MacAddressConflictReason, MacAddressConflictReason)
+
+		Expect(vmInformer.GetStore().Delete(vm2)).To(Succeed())
+		controller.execute()
+		Expect(recorder.Events).To(BeEmpty())
+
+		Expect(vmInformer.GetStore().Add(vm2)).To(Succeed())
+		controller.execute()
+		testutils.ExpectEvents(recorder, MacAddressConflictReason, MacAddressConflictReason)
+	})
+
+	It("should ignore the VMIs in a final phase", func() {
+		newController(virtconfig.MacAddressPoolGate)
+		vmi := newVMI("vmi1", "02:00:00:00:00:01")
+		vmi.Status.Phase = virtv1.Succeeded
+		Expect(vmInformer.GetStore().Add(newVM("vm1", "02:00:00:00:00:01"))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+		controller.execute()
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should not report conflicts when the feature gate is disabled", func() {
+		newController()
+		Expect(vmInformer.GetStore().Add(newVM("vm1", "02:00:00:00:00:01"))).To(Succeed())
+		Expect(vmInformer.GetStore().Add(newVM("vm2", "02:00:00:00:00:01"))).To(Succeed())
+
+		controller.execute()
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
@@ -81,6 +81,10 @@ const defaultUnschedulablePendingTimeoutSeconds = int64(60 * 5)
 // migration objects
 const defaultFinalizedMigrationGarbageCollectionBuffer = 5
 
+// This is how many finished migrations are kept in the
+// migration history of a VMI
+const defaultMigrationHistoryLength = 10
+
 // This is catch all timeout used when a target pod is stuck in
 // a in the pending phase for any reason. The theory behind this timeout
 // being longer than the unschedulable timeout is that we don't necessarily
@@ -103,6 +107,7 @@ type MigrationController struct {
 	pdbInformer             cache.SharedIndexInformer
 	migrationPolicyInformer cache.SharedIndexInformer
 	resourceQuotaInformer   cache.SharedIndexInformer
+	namespaceStore          cache.Store
 	recorder                record.EventRecorder
 	podExpectations         *controller.UIDTrackingControllerExpectations
 	migrationStartLock      *sync.Mutex
@@ -127,6 +132,7 @@ func NewMigrationController(templateService services.TemplateService,
 	pdbInformer cache.SharedIndexInformer,
 	migrationPolicyInformer cache.SharedIndexInformer,
 	resourceQuotaInformer cache.SharedIndexInformer,
+	namespaceStore cache.Store,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -143,6 +149,7 @@ func NewMigrationController(templateService services.TemplateService,
 		pdbInformer:             pdbInformer,
 		resourceQuotaInformer:   resourceQuotaInformer,
 		migrationPolicyInformer: migrationPolicyInformer,
+		namespaceStore:          namespaceStore,
 		recorder:                recorder,
 		clientset:               clientset,
 		podExpectations:         controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
@@ -319,6 +326,11 @@ func (c *MigrationController) execute(key string) error {
 		return err
 	}
 
+	// Migrations between clusters are driven by the cross-cluster migration controller
+	if isCrossClusterMigration(migration) {
+		return nil
+	}
+
 	vmiObj, vmiExists, err := c.vmiInformer.GetStore().GetByKey(fmt.Sprintf("%s/%s", migration.Namespace, migration.Spec.VMIName))
 	if err != nil {
 		return err
@@ -361,6 +373,10 @@ func (c *MigrationController) execute(key string) error {
 	}
 
 	if migration.IsFinal() {
+		err = c.recordMigrationHistory(migration, vmi)
+		if err != nil {
+			return err
+		}
 		err = c.garbageCollectFinalizedMigrations(vmi)
 		if err != nil {
 			return err
@@ -504,6 +520,11 @@ func (c *MigrationController) updateStatus(migration *virtv1.VirtualMachineInsta
 		}
 	}
 
+	// The queue position is only meaningful while the migration waits for its target pod
+	if podExists || migrationCopy.Status.Phase != virtv1.MigrationPending {
+		migrationCopy.Status.QueuePosition = nil
+	}
+
 	controller.SetVMIMigrationPhaseTransitionTimestamp(migration, migrationCopy)
 
 	if !equality.Semantic.DeepEqual(migration.Status, migrationCopy.Status) {
@@ -555,13 +576,21 @@ func (c *MigrationController) processMigrationPhase(
 			} else {
 				migrationCopy.Status.Phase = virtv1.MigrationScheduling
 			}
-		} else if syncError != nil && strings.Contains(syncError.Error(), "exceeded quota") && !conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota) {
-			condition := virtv1.VirtualMachineInstanceMigrationCondition{
-				Type:          virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota,
-				Status:        k8sv1.ConditionTrue,
-				LastProbeTime: v1.Now(),
+		} else {
+			position, err := c.migrationQueuePosition(migration)
+			if err != nil {
+				return err
+			}
+			migrationCopy.Status.QueuePosition = position
+
+			if syncError != nil && strings.Contains(syncError.Error(), "exceeded quota") && !conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota) {
+				condition := virtv1.VirtualMachineInstanceMigrationCondition{
+					Type:          virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota,
+					Status:        k8sv1.ConditionTrue,
+					LastProbeTime: v1.Now(),
+				}
+				migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, condition)
 			}
-			migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, condition)
 		}
 	case virtv1.MigrationScheduling:
 		if conditionManager.HasCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota) {
@@ -607,7 +636,8 @@ func (c *MigrationController) processMigrationPhase(
 
 		if vmi.Status.MigrationState.Completed &&
 			!vmiConditionManager.HasCondition(vmi, virtv1.VirtualMachineInstanceVCPUChange) &&
-			!vmiConditionManager.HasCondition(vmi, virtv1.VirtualMachineInstanceMemoryChange) {
+			!vmiConditionManager.HasCondition(vmi, virtv1.VirtualMachineInstanceMemoryChange) &&
+			!vmiConditionManager.HasCondition(vmi, virtv1.VirtualMachineInstanceVolumesChange) {
 			migrationCopy.Status.Phase = virtv1.MigrationSucceeded
 			c.recorder.Eventf(migration, k8sv1.EventTypeNormal, SuccessfulMigrationReason, "Source node reported migration succeeded")
 			log.Log.Object(migration).Infof("VMI reported migration succeeded.")
@@ -758,22 +788,39 @@ func (c *MigrationController) handleMigrationBackoff(key string, vmi *virtv1.Vir
 	if _, exists := migration.Annotations[virtv1.FuncTestForceIgnoreMigrationBackoffAnnotation]; exists {
 		return nil
 	}
+
+	backoff, err := c.remainingMigrationBackoff(vmi, migration)
+	if err != nil {
+		return err
+	}
+
+	if backoff > 0 {
+		log.Log.Object(vmi).Errorf("vmi in migration backoff, re-enqueueing after %v", backoff)
+		c.Queue.AddAfter(key, backoff)
+		return migrationBackoffError
+	}
+	return nil
+}
+
+// remainingMigrationBackoff returns how long an evacuation migration has to wait
+// because the previous evacuation migrations of the vmi failed
+func (c *MigrationController) remainingMigrationBackoff(vmi *virtv1.VirtualMachineInstance, migration *virtv1.VirtualMachineInstanceMigration) (time.Duration, error) {
 	if _, exists := migration.Annotations[virtv1.EvacuationMigrationAnnotation]; !exists {
-		return nil
+		return 0, nil
 	}
 
 	migrations, err := c.listEvacuationMigrations(vmi.Namespace, vmi.Name)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	if len(migrations) < 2 {
-		return nil
+		return 0, nil
 	}
 
 	// Newest first
 	sort.Sort(sort.Reverse(vmimCollection(migrations)))
 	if migrations[0].UID != migration.UID {
-		return nil
+		return 0, nil
 	}
 
 	backoff := time.Second * 0
@@ -794,7 +841,7 @@ func (c *MigrationController) handleMigrationBackoff(key string, vmi *virtv1.Vir
 		}
 	}
 	if backoff == 0 {
-		return nil
+		return 0, nil
 	}
 
 	getFailedTS := func(migration *virtv1.VirtualMachineInstanceMigration) metav1.Time {
@@ -807,14 +854,7 @@ func (c *MigrationController) handleMigrationBackoff(key string, vmi *virtv1.Vir
 	}
 
 	outOffBackoffTS := getFailedTS(migrations[1]).Add(backoff)
-	backoff = outOffBackoffTS.Sub(time.Now())
-
-	if backoff > 0 {
-		log.Log.Object(vmi).Errorf("vmi in migration backoff, re-enqueueing after %v", backoff)
-		c.Queue.AddAfter(key, backoff)
-		return migrationBackoffError
-	}
-	return nil
+	return outOffBackoffTS.Sub(time.Now()), nil
 }
 
 func (c *MigrationController) handleMarkMigrationFailedOnVMI(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
@@ -1021,6 +1061,18 @@ func (c *MigrationController) handleTargetPodCreation(key string, migration *vir
 		return nil
 	}
 
+	hasFreeSlot, err := c.migrationHasFreeSlot(migration, runningMigrations)
+	if err != nil {
+		return err
+	}
+
+	if !hasFreeSlot {
+		// Migrations with a higher priority or from namespaces with less running migrations go first
+		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because other queued migrations take the remaining parallel migration slots or its migration policy is at its parallel migration limit.", vmi.Namespace, vmi.Name)
+		c.Queue.AddAfter(key, time.Second*5)
+		return nil
+	}
+
 	// migration was accepted into the system, now see if we
 	// should create the target pod
 	if vmi.IsRunning() {
@@ -1682,6 +1734,87 @@ func (c *MigrationController) garbageCollectFinalizedMigrations(vmi *virtv1.Virt
 	return nil
 }
 
+// recordMigrationHistory adds a finished migration to the migration history of the VMI,
+// the migration objects themselves get garbage collected
+func (c *MigrationController) recordMigrationHistory(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	// The migration belongs to a previous VMI with the same name
+	if migration.CreationTimestamp.Before(&vmi.CreationTimestamp) {
+		return nil
+	}
+	for _, entry := range vmi.Status.MigrationHistory {
+		if entry.MigrationUID == migration.UID {
+			return nil
+		}
+	}
+
+	history := append([]virtv1.VirtualMachineInstanceMigrationHistoryEntry{}, vmi.Status.MigrationHistory...)
+	history = append(history, newMigrationHistoryEntry(migration, vmi))
+	if len(history) > defaultMigrationHistoryLength {
+		history = history[len(history)-defaultMigrationHistoryLength:]
+	}
+
+	newHistory, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+	var ops []string
+	if vmi.Status.MigrationHistory == nil {
+		ops = append(ops, fmt.Sprintf(`{ "op": "add", "path": "/status/migrationHistory", "value": %s }`, string(newHistory)))
+	} else {
+		oldHistory, err := json.Marshal(vmi.Status.MigrationHistory)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, fmt.Sprintf(`{ "op": "test", "path": "/status/migrationHistory", "value": %s }`, string(oldHistory)))
+		ops = append(ops, fmt.Sprintf(`{ "op": "replace", "path": "/status/migrationHistory", "value": %s }`, string(newHistory)))
+	}
+
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, controller.GeneratePatchBytes(ops), &v1.PatchOptions{})
+	return err
+}
+
+func newMigrationHistoryEntry(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) virtv1.VirtualMachineInstanceMigrationHistoryEntry {
+	entry := virtv1.VirtualMachineInstanceMigrationHistoryEntry{
+		MigrationName: migration.Name,
+		MigrationUID:  migration.UID,
+		Failed:        migration.Status.Phase == virtv1.MigrationFailed,
+	}
+
+	state := vmi.Status.MigrationState
+	if state == nil || state.MigrationUID != migration.UID {
+		state = migration.Status.MigrationState
+	}
+	if state != nil && state.MigrationUID == migration.UID {
+		entry.StartTimestamp = state.StartTimestamp
+		entry.EndTimestamp = state.EndTimestamp
+		entry.SourceNode = state.SourceNode
+		entry.TargetNode = state.TargetNode
+		entry.Mode = state.Mode
+		entry.Downtime = state.Downtime
+		entry.DataTransferred = state.DataTransferred
+		entry.FailureReason = state.FailureReason
+	}
+
+	// Migrations which failed before the source node started them only have the timestamps of the migration object
+	if entry.StartTimestamp == nil {
+		startTimestamp := migration.CreationTimestamp
+		entry.StartTimestamp = &startTimestamp
+	}
+	if entry.EndTimestamp == nil {
+		for _, transition := range migration.Status.PhaseTransitionTimestamps {
+			if transition.Phase == migration.Status.Phase {
+				endTimestamp := transition.PhaseTransitionTimestamp
+				entry.EndTimestamp = &endTimestamp
+			}
+		}
+	}
+	if entry.EndTimestamp != nil {
+		entry.Duration = &v1.Duration{Duration: entry.EndTimestamp.Sub(entry.StartTimestamp.Time)}
+	}
+
+	return entry
+}
+
 func (c *MigrationController) filterMigrations(namespace string, filter func(*virtv1.VirtualMachineInstanceMigration) bool) ([]*virtv1.VirtualMachineInstanceMigration, error) {
 	objs, err := c.migrationInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
 	if err != nil {
@@ -1867,7 +2000,7 @@ func (c *MigrationController) alertIfHostModelIsUnschedulable(vmi *virtv1.Virtua
 			continue // avoid checking the VMI's source node
 		}
 
-		if isNodeSuitableForHostModelMigration(node, requiredNodeLabels) {
+		if migrations.IsNodeSuitableForHostModelMigration(node, requiredNodeLabels) {
 			log.Log.Object(vmi).Infof("Node %s is suitable to run vmi %s host model cpu mode (more nodes may fit as well)", node.Name, vmi.Name)
 			fittingNodeFound = true
 			break
@@ -1882,72 +2015,51 @@ func (c *MigrationController) alertIfHostModelIsUnschedulable(vmi *virtv1.Virtua
 }
 
 func prepareNodeSelectorForHostCpuModel(node *k8sv1.Node, pod *k8sv1.Pod, sourcePod *k8sv1.Pod) error {
-	var hostCpuModel, nodeSelectorKeyForHostModel, hostModelLabelValue string
-	migratedAtLeastOnce := false
-
-	// if the vmi already migrated before it should include node selector that consider CPUModelLabel
-	for key, value := range sourcePod.Spec.NodeSelector {
-		if strings.Contains(key, virtv1.CPUFeatureLabel) || strings.Contains(key, virtv1.SupportedHostModelMigrationCPU) {
-			pod.Spec.NodeSelector[key] = value
-			migratedAtLeastOnce = true
-		}
+	nodeSelector, err := migrations.HostModelNodeSelector(node, sourcePod)
+	if err != nil {
+		return err
 	}
 
-	if !migratedAtLeastOnce {
-		for key, value := range node.Labels {
-			if strings.HasPrefix(key, virtv1.HostModelCPULabel) {
-				hostCpuModel = strings.TrimPrefix(key, virtv1.HostModelCPULabel)
-				hostModelLabelValue = value
-			}
-
-			if strings.HasPrefix(key, virtv1.HostModelRequiredFeaturesLabel) {
-				requiredFeature := strings.TrimPrefix(key, virtv1.HostModelRequiredFeaturesLabel)
-				pod.Spec.NodeSelector[virtv1.CPUFeatureLabel+requiredFeature] = value
-			}
-		}
-
-		if hostCpuModel == "" {
-			return fmt.Errorf("node does not contain labal \"%s\" with information about host cpu model", virtv1.HostModelCPULabel)
-		}
-
-		nodeSelectorKeyForHostModel = virtv1.SupportedHostModelMigrationCPU + hostCpuModel
-		pod.Spec.NodeSelector[nodeSelectorKeyForHostModel] = hostModelLabelValue
-
-		log.Log.Object(pod).Infof("cpu model label selector (\"%s\") defined for migration target pod", nodeSelectorKeyForHostModel)
+	for key, value := range nodeSelector {
+		pod.Spec.NodeSelector[key] = value
 	}
+	log.Log.Object(pod).Infof("cpu model label selector (%v) defined for migration target pod", nodeSelector)
 
 	return nil
 }
 
-func isNodeSuitableForHostModelMigration(node *k8sv1.Node, requiredNodeLabels map[string]string) bool {
-	for key, value := range requiredNodeLabels {
-		nodeValue, ok := node.Labels[key]
-
-		if !ok || nodeValue != value {
-			return false
-		}
-	}
-
-	return true
-}
-
-func (c *MigrationController) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, clusterMigrationConfiguration *virtv1.MigrationConfiguration) error {
-	vmiNamespace, err := c.clientset.CoreV1().Namespaces().Get(context.Background(), vmi.Namespace, v1.GetOptions{})
+// findMigrationPolicy returns the migration policy which applies to the vmi, or nil if no policy applies
+func (c *MigrationController) findMigrationPolicy(vmi *virtv1.VirtualMachineInstance) (*v1alpha1.MigrationPolicy, error) {
+	obj, exists, err := c.namespaceStore.GetByKey(vmi.Namespace)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	if !exists {
+		return nil, fmt.Errorf("namespace %s of the vmi does not exist in the cache", vmi.Namespace)
+	}
+	vmiNamespace := obj.(*k8sv1.Namespace)
 
-	// Fetch cluster policies
+	// Fetch cluster policies, policies in dry run mode are only reported in the policy status
 	var policies []v1alpha1.MigrationPolicy
 	migrationInterfaceList := c.migrationPolicyInformer.GetStore().List()
 	for _, obj := range migrationInterfaceList {
 		policy := obj.(*v1alpha1.MigrationPolicy)
+		if isDryRunPolicy(policy) {
+			continue
+		}
 		policies = append(policies, *policy)
 	}
 	policiesListObj := v1alpha1.MigrationPolicyList{Items: policies}
 
+	return MatchPolicy(&policiesListObj, vmi, vmiNamespace), nil
+}
+
+func (c *MigrationController) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, clusterMigrationConfiguration *virtv1.MigrationConfiguration) error {
 	// Override cluster-wide migration configuration if migration policy is matched
-	matchedPolicy := MatchPolicy(&policiesListObj, vmi, vmiNamespace)
+	matchedPolicy, err := c.findMigrationPolicy(vmi)
+	if err != nil {
+		return err
+	}
 
 	if matchedPolicy == nil {
 		log.Log.Object(vmi).Reason(err).Infof("no migration policy matched for VMI %s", vmi.Name)
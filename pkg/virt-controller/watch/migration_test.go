@@ -196,6 +196,14 @@ var _ = Describe("Migration watcher", func() {
 		})
 	}
 
+	shouldExpectMigrationQueuePosition := func(position int32) {
+		migrationInterface.EXPECT().UpdateStatus(gomock.Any()).DoAndReturn(func(arg interface{}) (interface{}, interface{}) {
+			Expect(arg.(*virtv1.VirtualMachineInstanceMigration).Status.Phase).To(Equal(virtv1.MigrationPending))
+			Expect(arg.(*virtv1.VirtualMachineInstanceMigration).Status.QueuePosition).To(HaveValue(Equal(position)))
+			return arg, nil
+		})
+	}
+
 	shouldExpectMigrationPreparingTargetState := func(migration *virtv1.VirtualMachineInstanceMigration) {
 		migrationInterface.EXPECT().UpdateStatus(gomock.Any()).DoAndReturn(func(arg interface{}) (interface{}, interface{}) {
 			Expect(arg.(*virtv1.VirtualMachineInstanceMigration).Status.Phase).To(Equal(virtv1.MigrationPreparingTarget))
@@ -251,6 +259,22 @@ var _ = Describe("Migration watcher", func() {
 		vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, []byte(patch), &metav1.PatchOptions{}).Return(vmi, nil)
 	}
 
+	shouldExpectMigrationHistoryPatch := func(vmi *virtv1.VirtualMachineInstance) *[]virtv1.VirtualMachineInstanceMigrationHistoryEntry {
+		history := &[]virtv1.VirtualMachineInstanceMigrationHistoryEntry{}
+		vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).DoAndReturn(func(ctx context.Context, name interface{}, ptype interface{}, vmiStatusPatch []byte, options interface{}, _ ...string) (*virtv1.VirtualMachineInstance, error) {
+			ops, err := apimachpatch.UnmarshalPatch(vmiStatusPatch)
+			Expect(err).ToNot(HaveOccurred())
+			last := ops[len(ops)-1]
+			Expect(last.Path).To(Equal("/status/migrationHistory"))
+
+			b, err := json.Marshal(last.Value)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(json.Unmarshal(b, history)).To(Succeed())
+			return vmi, nil
+		})
+		return history
+	}
+
 	shouldExpectMigrationCondition := func(migration *virtv1.VirtualMachineInstanceMigration, conditionType virtv1.VirtualMachineInstanceMigrationConditionType) {
 		migrationInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(arg interface{}) (interface{}, interface{}) {
 			vmim := arg.(*virtv1.VirtualMachineInstanceMigration)
@@ -304,6 +328,7 @@ var _ = Describe("Migration watcher", func() {
 			pdbInformer,
 			migrationPolicyInformer,
 			resourceQuotaInformer,
+			namespaceInformer.GetStore(),
 			recorder,
 			virtClient,
 			config,
@@ -354,15 +379,13 @@ var _ = Describe("Migration watcher", func() {
 
 		// Make sure that all unexpected calls to kubeClient will fail
 		kubeClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
-			if action.GetVerb() == "get" && action.GetResource().Resource == "namespaces" {
-				return true, &namespace, nil
-			}
-
 			Expect(action).To(BeNil())
 			return true, nil, nil
 		})
 
 		syncCaches(stop)
+		// The labels of the namespace are changed by the tests after the informer synced
+		Expect(namespaceInformer.GetStore().Add(&namespace)).To(Succeed())
 	})
 
 	AfterEach(func() {
@@ -716,6 +739,7 @@ var _ = Describe("Migration watcher", func() {
 				addVirtualMachineInstance(vmi)
 			}
 
+			shouldExpectMigrationQueuePosition(1)
 			controller.Execute()
 		})
 
@@ -749,6 +773,7 @@ var _ = Describe("Migration watcher", func() {
 				Expect(podInformer.GetStore().Add(pod)).To(Succeed())
 			}
 
+			shouldExpectMigrationQueuePosition(1)
 			controller.Execute()
 		})
 
@@ -791,9 +816,131 @@ var _ = Describe("Migration watcher", func() {
 				addVirtualMachineInstance(vmi)
 			}
 
+			shouldExpectMigrationQueuePosition(1)
+			controller.Execute()
+		})
+
+		addRunningMigrations := func(nodes ...string) {
+			for i, node := range nodes {
+				vmi := newVirtualMachine(fmt.Sprintf("runningvmi%v", i), virtv1.Running)
+				vmi.Status.NodeName = node
+				migration := newMigration(fmt.Sprintf("runningmigration%v", i), vmi.Name, virtv1.MigrationScheduling)
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+			}
+		}
+
+		addQueuedMigration := func(name, namespace, node string, created time.Time) *virtv1.VirtualMachineInstanceMigration {
+			vmi := newVirtualMachine("vmi-"+name, virtv1.Running)
+			vmi.Namespace = namespace
+			vmi.Status.NodeName = node
+			migration := newMigration(name, vmi.Name, virtv1.MigrationPending)
+			migration.Namespace = namespace
+			migration.CreationTimestamp = metav1.NewTime(created)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			return migration
+		}
+
+		DescribeTable("should hand out the last free migration slot by priority", func(annotation string, priority *int32, expectStart bool) {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addRunningMigrations("node0", "node1", "node2", "node3")
+
+			other := addQueuedMigration("othermigration", k8sv1.NamespaceDefault, "othernode", time.Now())
+			if annotation != "" {
+				other.Annotations[annotation] = ""
+			}
+			other.Spec.Priority = priority
+
+			if expectStart {
+				shouldExpectPodCreation(vmi.UID, migration.UID, 1, 0, 0)
+			} else {
+				shouldExpectMigrationQueuePosition(2)
+			}
+			controller.Execute()
+			if expectStart {
+				testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+			}
+		},
+			Entry("to the older migration if both have the same priority", "", nil, true),
+			Entry("to an evacuation before a user migration", virtv1.EvacuationMigrationAnnotation, nil, false),
+			Entry("to a user migration before a workload update", virtv1.WorkloadUpdateMigrationAnnotation, nil, true),
+			Entry("to a migration with a higher explicit priority", "", pointer.Int32(virtv1.MigrationPriorityUser+1), false),
+			Entry("to the user migration if the evacuation has a lower explicit priority", virtv1.EvacuationMigrationAnnotation, pointer.Int32(virtv1.MigrationPriorityUser-1), true),
+		)
+
+		It("should share the free migration slots fairly between namespaces", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			// All running migrations belong to the namespace of the test migration
+			addRunningMigrations("node0", "node1", "node2", "node3")
+			addQueuedMigration("othermigration", "othernamespace", "othernode", time.Now())
+
+			shouldExpectMigrationQueuePosition(2)
+			controller.Execute()
+		})
+
+		It("should not let migrations from a node at its outbound limit block other migrations", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addRunningMigrations("node0", "node1", "busynode", "busynode")
+			addQueuedMigration("othermigration", k8sv1.NamespaceDefault, "busynode", time.Now().Add(-time.Minute))
+
+			shouldExpectPodCreation(vmi.UID, migration.UID, 1, 0, 0)
+			controller.Execute()
+			testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+		})
+
+		It("should respect the parallel migration limit of the migration policy", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addRunningMigrations("node0")
+
+			policy := kubecli.NewMinimalMigrationPolicy("limited")
+			policy.Spec.Selectors = &migrationsv1.Selectors{}
+			policy.Spec.ParallelMigrations = pointer.Uint32(1)
+			addMigrationPolicies(*policy)
+
+			shouldExpectMigrationQueuePosition(1)
 			controller.Execute()
 		})
 
+		It("should ignore the parallel migration limit of a migration policy in dry run mode", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addRunningMigrations("node0")
+
+			policy := kubecli.NewMinimalMigrationPolicy("limited")
+			policy.Spec.Selectors = &migrationsv1.Selectors{}
+			policy.Spec.ParallelMigrations = pointer.Uint32(1)
+			policy.Spec.DryRun = pointer.BoolPtr(true)
+			addMigrationPolicies(*policy)
+
+			shouldExpectPodCreation(vmi.UID, migration.UID, 1, 0, 0)
+			controller.Execute()
+			testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+		})
+
 		It("should create target pod and not override existing affinity rules", func() {
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
 			antiAffinityTerm := k8sv1.PodAffinityTerm{
@@ -1004,6 +1151,7 @@ var _ = Describe("Migration watcher", func() {
 
 			if keyMigration.IsFinal() {
 				finalizedMigrations++
+				shouldExpectMigrationHistoryPatch(vmi)
 				shouldExpectMigrationDeletion("should-delete", finalizedMigrations-defaultFinalizedMigrationGarbageCollectionBuffer)
 			} else {
 				migrationInterface.EXPECT().UpdateStatus(gomock.Any()).AnyTimes().DoAndReturn(func(arg interface{}) (interface{}, interface{}) {
@@ -1412,6 +1560,81 @@ var _ = Describe("Migration watcher", func() {
 			podFeeder.Add(pod)
 
 			shouldExpectMigrationStateUpdatedAndFinalizerRemoved(migration, vmi.Status.MigrationState)
+			shouldExpectMigrationHistoryPatch(vmi)
+
+			controller.Execute()
+		})
+		It("should record a finished migration in the migration history of the VMI", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			vmi.Status.NodeName = "node01"
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationSucceeded)
+			migration.Finalizers = []string{}
+			startTimestamp := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+			endTimestamp := metav1.NewTime(startTimestamp.Add(30 * time.Second))
+
+			vmi.Status.MigrationHistory = []virtv1.VirtualMachineInstanceMigrationHistoryEntry{{MigrationName: "previous", MigrationUID: "previous"}}
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:    migration.UID,
+				TargetNode:      "node01",
+				SourceNode:      "node02",
+				StartTimestamp:  &startTimestamp,
+				EndTimestamp:    &endTimestamp,
+				Completed:       true,
+				Mode:            virtv1.MigrationPostCopy,
+				DataTransferred: 4096,
+				Downtime:        &metav1.Duration{Duration: 42 * time.Millisecond},
+			}
+			migration.Status.MigrationState = vmi.Status.MigrationState
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			history := shouldExpectMigrationHistoryPatch(vmi)
+
+			controller.Execute()
+
+			Expect(*history).To(HaveLen(2))
+			Expect((*history)[1]).To(Equal(virtv1.VirtualMachineInstanceMigrationHistoryEntry{
+				MigrationName:   migration.Name,
+				MigrationUID:    migration.UID,
+				StartTimestamp:  &startTimestamp,
+				EndTimestamp:    &endTimestamp,
+				Duration:        &metav1.Duration{Duration: 30 * time.Second},
+				SourceNode:      "node02",
+				TargetNode:      "node01",
+				Mode:            virtv1.MigrationPostCopy,
+				Downtime:        &metav1.Duration{Duration: 42 * time.Millisecond},
+				DataTransferred: 4096,
+			}))
+		})
+		It("should keep a bounded migration history", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationFailed)
+			migration.Finalizers = []string{}
+			for i := 0; i < defaultMigrationHistoryLength; i++ {
+				uid := types.UID(fmt.Sprintf("previous-%d", i))
+				vmi.Status.MigrationHistory = append(vmi.Status.MigrationHistory, virtv1.VirtualMachineInstanceMigrationHistoryEntry{MigrationUID: uid})
+			}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			history := shouldExpectMigrationHistoryPatch(vmi)
+
+			controller.Execute()
+
+			Expect(*history).To(HaveLen(defaultMigrationHistoryLength))
+			Expect((*history)[0].MigrationUID).To(Equal(types.UID("previous-1")))
+			last := (*history)[defaultMigrationHistoryLength-1]
+			Expect(last.MigrationUID).To(Equal(migration.UID))
+			Expect(last.Failed).To(BeTrue())
+			Expect(last.StartTimestamp).ToNot(BeNil())
+		})
+		It("should not record a migration twice in the migration history", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationSucceeded)
+			migration.Finalizers = []string{}
+			vmi.Status.MigrationHistory = []virtv1.VirtualMachineInstanceMigrationHistoryEntry{{MigrationName: migration.Name, MigrationUID: migration.UID}}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
 
 			controller.Execute()
 		})
@@ -1509,6 +1732,9 @@ var _ = Describe("Migration watcher", func() {
 					return vmi, nil
 				})
 			}
+			if phase == virtv1.MigrationFailed {
+				shouldExpectMigrationHistoryPatch(vmi)
+			}
 
 			controller.Execute()
 
@@ -1734,6 +1960,60 @@ var _ = Describe("Migration watcher", func() {
 				Expect(matchedPolicy).To(BeNil())
 			})
 
+			DescribeTable("should match set-based label selectors", func(vmiSelector, namespaceSelector *metav1.LabelSelector, expectMatch bool) {
+				vmi.Labels = map[string]string{"tier": "gold"}
+				namespace.Labels = map[string]string{"team": "infra"}
+
+				policy := kubecli.NewMinimalMigrationPolicy("set-based")
+				policy.Spec.Selectors = &migrationsv1.Selectors{
+					VirtualMachineInstanceLabelSelector: vmiSelector,
+					NamespaceLabelSelector:              namespaceSelector,
+				}
+
+				matchedPolicy := MatchPolicy(kubecli.NewMinimalMigrationPolicyList(*policy), vmi, &namespace)
+				if expectMatch {
+					Expect(matchedPolicy).ToNot(BeNil())
+				} else {
+					Expect(matchedPolicy).To(BeNil())
+				}
+			},
+				Entry("with In requirement on the VMI",
+					&metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"gold", "silver"}}}},
+					nil, true),
+				Entry("with NotIn requirement on the VMI",
+					&metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"gold"}}}},
+					nil, false),
+				Entry("with Exists requirement on the namespace",
+					nil,
+					&metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: metav1.LabelSelectorOpExists}}}, true),
+				Entry("with DoesNotExist requirement on the namespace",
+					nil,
+					&metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: metav1.LabelSelectorOpDoesNotExist}}}, false),
+				Entry("with a requirement on the VMI and one not matching the namespace",
+					&metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}},
+					&metav1.LabelSelector{MatchLabels: map[string]string{"team": "storage"}}, false),
+			)
+
+			It("should count the requirements of set-based label selectors for precedence", func() {
+				vmi.Labels = map[string]string{"tier": "gold", "app": "db"}
+
+				simplePolicy := kubecli.NewMinimalMigrationPolicy("aa-simple")
+				simplePolicy.Spec.Selectors = &migrationsv1.Selectors{
+					VirtualMachineInstanceSelector: migrationsv1.LabelSelector{"tier": "gold"},
+				}
+				detailedPolicy := kubecli.NewMinimalMigrationPolicy("zz-detailed")
+				detailedPolicy.Spec.Selectors = &migrationsv1.Selectors{
+					VirtualMachineInstanceLabelSelector: &metav1.LabelSelector{
+						MatchLabels:      map[string]string{"tier": "gold"},
+						MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: metav1.LabelSelectorOpExists}},
+					},
+				}
+
+				matchedPolicy := MatchPolicy(kubecli.NewMinimalMigrationPolicyList(*simplePolicy, *detailedPolicy), vmi, &namespace)
+				Expect(matchedPolicy).ToNot(BeNil())
+				Expect(matchedPolicy.Name).To(Equal(detailedPolicy.Name))
+			})
+
 			It("when no policies exist, MatchPolicy() should return nil", func() {
 				policyList := kubecli.NewMinimalMigrationPolicyList()
 				matchedPolicy := MatchPolicy(policyList, vmi, &namespace)
@@ -1816,6 +2096,20 @@ var _ = Describe("Migration watcher", func() {
 				},
 				true,
 			),
+			Entry("set progress timeout",
+				func(p *migrationsv1.MigrationPolicySpec) { p.ProgressTimeout = &stubNumber },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.ProgressTimeout).To(HaveValue(Equal(stubNumber)))
+				},
+				true,
+			),
+			Entry("disable TLS",
+				func(p *migrationsv1.MigrationPolicySpec) { p.DisableTLS = pointer.BoolPtr(true) },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.DisableTLS).To(HaveValue(BeTrue()))
+				},
+				true,
+			),
 			Entry("nothing is changed",
 				func(p *migrationsv1.MigrationPolicySpec) {},
 				func(c *virtv1.MigrationConfiguration) {},
@@ -1823,6 +2117,18 @@ var _ = Describe("Migration watcher", func() {
 			),
 		)
 
+		It("should not apply a policy in dry run mode", func() {
+			migrationPolicy := generatePolicyAndAlignVMI(vmi)
+			migrationPolicy.Spec.AllowPostCopy = pointer.BoolPtr(true)
+			migrationPolicy.Spec.DryRun = pointer.BoolPtr(true)
+			addMigrationPolicies(*migrationPolicy)
+
+			patch := getExpectedVmiPatch(false, getDefaultMigrationConfiguration(), migrationPolicy)
+			shouldExpectVirtualMachineInstancePatch(vmi, patch)
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, SuccessfulHandOverPodReason)
+		})
 	})
 
 	Context("Migration of host-model VMI", func() {
@@ -2298,3 +2604,92 @@ func preparePolicyAndVMIWithNSAndVMILabels(vmi *v1.VirtualMachineInstance, names
 func generatePolicyAndAlignVMI(vmi *v1.VirtualMachineInstance) *migrationsv1.MigrationPolicy {
 	return preparePolicyAndVMIWithNSAndVMILabels(vmi, nil, 1, 0)
 }
+
+var _ = Describe("Migration policy status", func() {
+
+	newPolicy := func(name string, vmiLabels map[string]string) *migrationsv1.MigrationPolicy {
+		policy := kubecli.NewMinimalMigrationPolicy(name)
+		policy.Spec.Selectors = &migrationsv1.Selectors{
+			VirtualMachineInstanceSelector: vmiLabels,
+		}
+		return policy
+	}
+
+	newVMI := func(namespace, name string, labels map[string]string) *v1.VirtualMachineInstance {
+		vmi := api.NewMinimalVMIWithNS(namespace, name)
+		vmi.Labels = labels
+		return vmi
+	}
+
+	getNamespace := func(name string) *k8sv1.Namespace {
+		return &k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+
+	It("should list the VMIs a policy applies to", func() {
+		policy := newPolicy("gold", map[string]string{"tier": "gold"})
+		vmis := []*v1.VirtualMachineInstance{
+			newVMI("ns2", "vmi1", map[string]string{"tier": "gold"}),
+			newVMI("ns1", "vmi2", map[string]string{"tier": "gold"}),
+			newVMI("ns1", "vmi3", map[string]string{"tier": "silver"}),
+		}
+
+		statuses := calculateMigrationPolicyStatuses([]*migrationsv1.MigrationPolicy{policy}, vmis, getNamespace)
+		Expect(statuses).To(HaveKey(policy.Name))
+		status := statuses[policy.Name]
+		Expect(status.MatchedVirtualMachineInstanceCount).To(BeEquivalentTo(2))
+		Expect(status.MatchedVirtualMachineInstances).To(Equal([]migrationsv1.MigrationPolicyMatch{
+			{Namespace: "ns1", Name: "vmi2"},
+			{Namespace: "ns2", Name: "vmi1"},
+		}))
+		Expect(status.ConflictCount).To(BeZero())
+		Expect(status.Conflicts).To(BeEmpty())
+	})
+
+	It("should report the VMIs on which a policy loses against another policy", func() {
+		generic := newPolicy("generic", map[string]string{"tier": "gold"})
+		specific := newPolicy("specific", map[string]string{"tier": "gold", "app": "db"})
+		vmis := []*v1.VirtualMachineInstance{
+			newVMI("ns1", "db", map[string]string{"tier": "gold", "app": "db"}),
+			newVMI("ns1", "web", map[string]string{"tier": "gold", "app": "web"}),
+		}
+
+		statuses := calculateMigrationPolicyStatuses([]*migrationsv1.MigrationPolicy{generic, specific}, vmis, getNamespace)
+		Expect(statuses[generic.Name].MatchedVirtualMachineInstances).To(Equal([]migrationsv1.MigrationPolicyMatch{
+			{Namespace: "ns1", Name: "web"},
+		}))
+		Expect(statuses[generic.Name].ConflictCount).To(BeEquivalentTo(1))
+		Expect(statuses[generic.Name].Conflicts).To(Equal([]migrationsv1.MigrationPolicyConflict{
+			{Namespace: "ns1", Name: "db", AppliedPolicy: specific.Name},
+		}))
+		Expect(statuses[specific.Name].MatchedVirtualMachineInstances).To(Equal([]migrationsv1.MigrationPolicyMatch{
+			{Namespace: "ns1", Name: "db"},
+		}))
+		Expect(statuses[specific.Name].Conflicts).To(BeEmpty())
+	})
+
+	It("should report the VMIs a policy in dry run mode would apply to without affecting other policies", func() {
+		generic := newPolicy("generic", map[string]string{"tier": "gold"})
+		dryRun := newPolicy("dry-run", map[string]string{"tier": "gold", "app": "db"})
+		dryRun.Spec.DryRun = pointer.BoolPtr(true)
+		vmis := []*v1.VirtualMachineInstance{
+			newVMI("ns1", "db", map[string]string{"tier": "gold", "app": "db"}),
+		}
+
+		statuses := calculateMigrationPolicyStatuses([]*migrationsv1.MigrationPolicy{generic, dryRun}, vmis, getNamespace)
+		Expect(statuses[dryRun.Name].MatchedVirtualMachineInstanceCount).To(BeEquivalentTo(1))
+		Expect(statuses[generic.Name].MatchedVirtualMachineInstanceCount).To(BeEquivalentTo(1))
+		Expect(statuses[generic.Name].Conflicts).To(BeEmpty())
+	})
+
+	It("should limit the number of listed VMIs but count all of them", func() {
+		policy := newPolicy("all", map[string]string{})
+		var vmis []*v1.VirtualMachineInstance
+		for i := 0; i < maxMigrationPolicyStatusEntries+10; i++ {
+			vmis = append(vmis, newVMI("ns1", fmt.Sprintf("vmi%03d", i), map[string]string{}))
+		}
+
+		statuses := calculateMigrationPolicyStatuses([]*migrationsv1.MigrationPolicy{policy}, vmis, getNamespace)
+		Expect(statuses[policy.Name].MatchedVirtualMachineInstanceCount).To(BeEquivalentTo(maxMigrationPolicyStatusEntries + 10))
+		Expect(statuses[policy.Name].MatchedVirtualMachineInstances).To(HaveLen(maxMigrationPolicyStatusEntries))
+	})
+})
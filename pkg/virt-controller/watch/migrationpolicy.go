@@ -2,6 +2,8 @@ package watch
 
 import (
 	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	k6tv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations/v1alpha1"
@@ -114,9 +116,39 @@ func countMatchingLabels(policy *v1alpha1.MigrationPolicy, vmiLabels, namespaceL
 		matchingNSLabels = countLabelsHelper(policy.Spec.Selectors.NamespaceSelector, namespaceLabels)
 	}
 
+	if doesMatch && policy.Spec.Selectors.VirtualMachineInstanceLabelSelector != nil {
+		var requirements int
+		doesMatch, requirements = matchLabelSelector(policy.Spec.Selectors.VirtualMachineInstanceLabelSelector, vmiLabels)
+		matchingVMILabels += requirements
+	}
+
+	if doesMatch && policy.Spec.Selectors.NamespaceLabelSelector != nil {
+		var requirements int
+		doesMatch, requirements = matchLabelSelector(policy.Spec.Selectors.NamespaceLabelSelector, namespaceLabels)
+		matchingNSLabels += requirements
+	}
+
 	if doesMatch {
 		score = migrationPolicyMatchScore{matchingVMILabels: matchingVMILabels, matchingNSLabels: matchingNSLabels}
 	}
 
 	return doesMatch, score
 }
+
+// matchLabelSelector checks if the labels match the selector and returns the number of requirements
+// of the selector, which counts towards the detail level of the policy. Invalid selectors never match.
+func matchLabelSelector(labelSelector *metav1.LabelSelector, objectLabels map[string]string) (doesMatch bool, requirements int) {
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false, 0
+	}
+	if !selector.Matches(labels.Set(objectLabels)) {
+		return false, 0
+	}
+	return true, len(labelSelector.MatchLabels) + len(labelSelector.MatchExpressions)
+}
+
+// isDryRunPolicy returns true if the policy must not be applied to migrations
+func isDryRunPolicy(policy *v1alpha1.MigrationPolicy) bool {
+	return policy.Spec.DryRun != nil && *policy.Spec.DryRun
+}
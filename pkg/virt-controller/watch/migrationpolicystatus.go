@@ -0,0 +1,287 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	// The status of all policies is calculated at once, since a change
+	// of one policy can create or resolve conflicts with the others
+	migrationPolicyStatusKey = "migrationpolicies"
+
+	// maxMigrationPolicyStatusEntries limits the number of VMIs listed in the status of a policy
+	maxMigrationPolicyStatusEntries = 100
+)
+
+// MigrationPolicyStatusController reports the VMIs the migration policies apply to
+// and the VMIs they conflict on in the status of the policies.
+type MigrationPolicyStatusController struct {
+	clientset               kubecli.KubevirtClient
+	Queue                   workqueue.RateLimitingInterface
+	migrationPolicyInformer cache.SharedIndexInformer
+	vmiInformer             cache.SharedIndexInformer
+	namespaceInformer       cache.SharedIndexInformer
+}
+
+// NewMigrationPolicyStatusController creates a new instance of the MigrationPolicyStatusController struct.
+func NewMigrationPolicyStatusController(clientset kubecli.KubevirtClient, migrationPolicyInformer, vmiInformer, namespaceInformer cache.SharedIndexInformer) (*MigrationPolicyStatusController, error) {
+	c := &MigrationPolicyStatusController{
+		clientset:               clientset,
+		Queue:                   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-migration-policy-status"),
+		migrationPolicyInformer: migrationPolicyInformer,
+		vmiInformer:             vmiInformer,
+		namespaceInformer:       namespaceInformer,
+	}
+
+	_, err := c.migrationPolicyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueue() },
+		DeleteFunc: func(_ interface{}) { c.enqueue() },
+		UpdateFunc: c.updateMigrationPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueue() },
+		DeleteFunc: func(_ interface{}) { c.enqueue() },
+		UpdateFunc: c.updateVMI,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.namespaceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.updateNamespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *MigrationPolicyStatusController) enqueue() {
+	c.Queue.Add(migrationPolicyStatusKey)
+}
+
+func (c *MigrationPolicyStatusController) updateMigrationPolicy(old, curr interface{}) {
+	oldPolicy := old.(*v1alpha1.MigrationPolicy)
+	currPolicy := curr.(*v1alpha1.MigrationPolicy)
+	// Ignore our own status updates
+	if !equality.Semantic.DeepEqual(oldPolicy.Spec, currPolicy.Spec) {
+		c.enqueue()
+	}
+}
+
+func (c *MigrationPolicyStatusController) updateVMI(old, curr interface{}) {
+	oldVMI := old.(*virtv1.VirtualMachineInstance)
+	currVMI := curr.(*virtv1.VirtualMachineInstance)
+	if !equality.Semantic.DeepEqual(oldVMI.Labels, currVMI.Labels) {
+		c.enqueue()
+	}
+}
+
+func (c *MigrationPolicyStatusController) updateNamespace(old, curr interface{}) {
+	oldNamespace := old.(*k8sv1.Namespace)
+	currNamespace := curr.(*k8sv1.Namespace)
+	if !equality.Semantic.DeepEqual(oldNamespace.Labels, currNamespace.Labels) {
+		c.enqueue()
+	}
+}
+
+// Run runs the passed in MigrationPolicyStatusController.
+func (c *MigrationPolicyStatusController) Run(stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting migration policy status controller.")
+
+	// The queue only ever holds a single key, more workers would not help
+	threadiness := 1
+
+	// Wait for cache sync before we start the controller
+	cache.WaitForCacheSync(stopCh, c.migrationPolicyInformer.HasSynced, c.vmiInformer.HasSynced, c.namespaceInformer.HasSynced)
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping migration policy status controller.")
+}
+
+func (c *MigrationPolicyStatusController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute runs commands from the controller queue, if there is
+// an error it requeues the command. Returns false if the queue
+// is empty.
+func (c *MigrationPolicyStatusController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing migration policy status update")
+		c.Queue.AddRateLimited(key)
+	} else {
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *MigrationPolicyStatusController) execute() error {
+	var policies []*v1alpha1.MigrationPolicy
+	for _, obj := range c.migrationPolicyInformer.GetStore().List() {
+		policies = append(policies, obj.(*v1alpha1.MigrationPolicy))
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	var vmis []*virtv1.VirtualMachineInstance
+	for _, obj := range c.vmiInformer.GetStore().List() {
+		if vmi := obj.(*virtv1.VirtualMachineInstance); !vmi.IsFinal() {
+			vmis = append(vmis, vmi)
+		}
+	}
+
+	statuses := calculateMigrationPolicyStatuses(policies, vmis, c.getNamespace)
+
+	for _, policy := range policies {
+		status := statuses[policy.Name]
+		if equality.Semantic.DeepEqual(policy.Status, status) {
+			continue
+		}
+		policyCopy := policy.DeepCopy()
+		policyCopy.Status = status
+		if _, err := c.clientset.MigrationPolicy().UpdateStatus(context.Background(), policyCopy, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *MigrationPolicyStatusController) getNamespace(name string) *k8sv1.Namespace {
+	obj, exists, err := c.namespaceInformer.GetStore().GetByKey(name)
+	if err != nil || !exists {
+		return &k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+	return obj.(*k8sv1.Namespace)
+}
+
+// calculateMigrationPolicyStatuses determines for every VMI which of the selecting policies applies to it.
+// Policies in dry run mode report the VMIs they would apply to if they were rolled out.
+func calculateMigrationPolicyStatuses(policies []*v1alpha1.MigrationPolicy, vmis []*virtv1.VirtualMachineInstance, getNamespace func(string) *k8sv1.Namespace) map[string]v1alpha1.MigrationPolicyStatus {
+	activePolicies := v1alpha1.MigrationPolicyList{}
+	for _, policy := range policies {
+		if !isDryRunPolicy(policy) {
+			activePolicies.Items = append(activePolicies.Items, *policy)
+		}
+	}
+
+	statuses := map[string]*v1alpha1.MigrationPolicyStatus{}
+	for _, policy := range policies {
+		statuses[policy.Name] = &v1alpha1.MigrationPolicyStatus{}
+	}
+
+	for _, vmi := range vmis {
+		namespace := getNamespace(vmi.Namespace)
+		appliedPolicy := MatchPolicy(&activePolicies, vmi, namespace)
+
+		for _, policy := range policies {
+			if doesMatch, _ := countMatchingLabels(policy, vmi.Labels, namespace.Labels); !doesMatch {
+				continue
+			}
+
+			winner := appliedPolicy
+			if isDryRunPolicy(policy) {
+				withPolicy := v1alpha1.MigrationPolicyList{Items: append([]v1alpha1.MigrationPolicy{*policy}, activePolicies.Items...)}
+				winner = MatchPolicy(&withPolicy, vmi, namespace)
+			}
+
+			status := statuses[policy.Name]
+			if winner != nil && winner.Name == policy.Name {
+				status.MatchedVirtualMachineInstanceCount++
+				status.MatchedVirtualMachineInstances = append(status.MatchedVirtualMachineInstances, v1alpha1.MigrationPolicyMatch{
+					Namespace: vmi.Namespace,
+					Name:      vmi.Name,
+				})
+			} else if winner != nil {
+				status.ConflictCount++
+				status.Conflicts = append(status.Conflicts, v1alpha1.MigrationPolicyConflict{
+					Namespace:     vmi.Namespace,
+					Name:          vmi.Name,
+					AppliedPolicy: winner.Name,
+				})
+			}
+		}
+	}
+
+	result := map[string]v1alpha1.MigrationPolicyStatus{}
+	for name, status := range statuses {
+		sort.Slice(status.MatchedVirtualMachineInstances, func(i, j int) bool {
+			return vmiReferenceLess(status.MatchedVirtualMachineInstances[i].Namespace, status.MatchedVirtualMachineInstances[i].Name,
+				status.MatchedVirtualMachineInstances[j].Namespace, status.MatchedVirtualMachineInstances[j].Name)
+		})
+		sort.Slice(status.Conflicts, func(i, j int) bool {
+			return vmiReferenceLess(status.Conflicts[i].Namespace, status.Conflicts[i].Name,
+				status.Conflicts[j].Namespace, status.Conflicts[j].Name)
+		})
+		if len(status.MatchedVirtualMachineInstances) > maxMigrationPolicyStatusEntries {
+			status.MatchedVirtualMachineInstances = status.MatchedVirtualMachineInstances[:maxMigrationPolicyStatusEntries]
+		}
+		if len(status.Conflicts) > maxMigrationPolicyStatusEntries {
+			status.Conflicts = status.Conflicts[:maxMigrationPolicyStatusEntries]
+		}
+		result[name] = *status
+	}
+	return result
+}
+
+func vmiReferenceLess(namespace1, name1, namespace2, name2 string) bool {
+	if namespace1 != namespace2 {
+		return namespace1 < namespace2
+	}
+	return name1 < name2
+}
@@ -0,0 +1,283 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+// pendingMigrationQueue returns the migrations which wait for their target pod,
+// in the order in which they are allowed to start.
+func (c *MigrationController) pendingMigrationQueue(runningMigrations []*virtv1.VirtualMachineInstanceMigration) ([]*virtv1.VirtualMachineInstanceMigration, error) {
+	running := map[types.UID]bool{}
+	for _, migration := range runningMigrations {
+		running[migration.UID] = true
+	}
+
+	var pending []*virtv1.VirtualMachineInstanceMigration
+	for _, migration := range migrations.ListUnfinishedMigrations(c.migrationInformer) {
+		if running[migration.UID] || migration.Status.Phase != virtv1.MigrationPending || migration.DeletionTimestamp != nil {
+			continue
+		}
+		vmi, exists, err := c.migrationSourceVMI(migration)
+		if err != nil {
+			return nil, err
+		}
+		if !exists || !vmi.IsRunning() {
+			continue
+		}
+		// Migrations which can't start right now must not hold back the others
+		if canMigrate, err := c.canMigrateVMI(migration, vmi); err != nil {
+			return nil, err
+		} else if !canMigrate {
+			continue
+		}
+		if _, ignoreBackoff := migration.Annotations[virtv1.FuncTestForceIgnoreMigrationBackoffAnnotation]; !ignoreBackoff {
+			if backoff, err := c.remainingMigrationBackoff(vmi, migration); err != nil {
+				return nil, err
+			} else if backoff > 0 {
+				continue
+			}
+		}
+		pending = append(pending, migration)
+	}
+
+	return orderMigrationQueue(pending, runningMigrations), nil
+}
+
+// migrationQueuePosition returns the 1-based position of the migration in the pending
+// migration queue, or nil if the migration does not have to wait for a free slot
+func (c *MigrationController) migrationQueuePosition(migration *virtv1.VirtualMachineInstanceMigration) (*int32, error) {
+	runningMigrations, err := c.findRunningMigrations()
+	if err != nil {
+		return nil, err
+	}
+	queue, err := c.pendingMigrationQueue(runningMigrations)
+	if err != nil {
+		return nil, err
+	}
+	if hasFreeSlot, err := c.queueHasFreeSlot(migration, queue, runningMigrations); err != nil || hasFreeSlot {
+		return nil, err
+	}
+	for i, queued := range queue {
+		if queued.UID == migration.UID {
+			position := int32(i + 1)
+			return &position, nil
+		}
+	}
+	return nil, nil
+}
+
+// migrationHasFreeSlot returns true if the migration may start according to its position in the queue
+func (c *MigrationController) migrationHasFreeSlot(migration *virtv1.VirtualMachineInstanceMigration, runningMigrations []*virtv1.VirtualMachineInstanceMigration) (bool, error) {
+	queue, err := c.pendingMigrationQueue(runningMigrations)
+	if err != nil {
+		return false, err
+	}
+	return c.queueHasFreeSlot(migration, queue, runningMigrations)
+}
+
+// queueHasFreeSlot walks the queue and hands out the free migration slots of the cluster.
+// Migrations whose source node is at its outbound limit or whose migration policy is at
+// its parallel migration limit are skipped, so that they don't block other migrations.
+func (c *MigrationController) queueHasFreeSlot(migration *virtv1.VirtualMachineInstanceMigration, queue, runningMigrations []*virtv1.VirtualMachineInstanceMigration) (bool, error) {
+	policyLimits := c.newMigrationPolicyLimits()
+	outbound := map[string]int{}
+	for _, m := range runningMigrations {
+		if vmi, exists, _ := c.migrationSourceVMI(m); exists {
+			outbound[vmi.Status.NodeName]++
+			if _, err := policyLimits.add(vmi); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	queued := false
+	for _, m := range queue {
+		if m.UID == migration.UID {
+			queued = true
+			break
+		}
+	}
+	// Migrations which are not part of the queue are only subject to the parallel migration limits
+	if !queued {
+		vmi, exists, err := c.migrationSourceVMI(migration)
+		if err != nil {
+			return false, err
+		}
+		if !exists {
+			return true, nil
+		}
+		return policyLimits.add(vmi)
+	}
+
+	migrationConfig := c.clusterConfig.GetMigrationConfiguration()
+	freeSlots := int(*migrationConfig.ParallelMigrationsPerCluster) - len(runningMigrations)
+	outboundLimit := int(*migrationConfig.ParallelOutboundMigrationsPerNode)
+
+	for _, m := range queue {
+		if freeSlots <= 0 {
+			return false, nil
+		}
+		vmi, exists, err := c.migrationSourceVMI(m)
+		if err != nil {
+			return false, err
+		}
+		if !exists || outbound[vmi.Status.NodeName] >= outboundLimit {
+			continue
+		}
+		if added, err := policyLimits.add(vmi); err != nil {
+			return false, err
+		} else if !added {
+			continue
+		}
+		if m.UID == migration.UID {
+			return true, nil
+		}
+		freeSlots--
+		outbound[vmi.Status.NodeName]++
+	}
+	return false, nil
+}
+
+// migrationPolicyLimits counts the migrations of the migration policies which limit the number of parallel migrations
+type migrationPolicyLimits struct {
+	controller *MigrationController
+	limits     map[string]int
+	migrations map[string]int
+}
+
+func (c *MigrationController) newMigrationPolicyLimits() *migrationPolicyLimits {
+	limits := map[string]int{}
+	for _, obj := range c.migrationPolicyInformer.GetStore().List() {
+		policy := obj.(*v1alpha1.MigrationPolicy)
+		if policy.Spec.ParallelMigrations != nil && !isDryRunPolicy(policy) {
+			limits[policy.Name] = int(*policy.Spec.ParallelMigrations)
+		}
+	}
+	return &migrationPolicyLimits{
+		controller: c,
+		limits:     limits,
+		migrations: map[string]int{},
+	}
+}
+
+// add counts a migration of the vmi against the limit of its migration policy,
+// it returns false if the limit is already reached
+func (l *migrationPolicyLimits) add(vmi *virtv1.VirtualMachineInstance) (bool, error) {
+	// Avoid matching the policies if none of them limits the parallel migrations
+	if len(l.limits) == 0 {
+		return true, nil
+	}
+	policy, err := l.controller.findMigrationPolicy(vmi)
+	if err != nil {
+		return false, err
+	}
+	if policy == nil {
+		return true, nil
+	}
+	limit, limited := l.limits[policy.Name]
+	if !limited {
+		return true, nil
+	}
+	if l.migrations[policy.Name] >= limit {
+		return false, nil
+	}
+	l.migrations[policy.Name]++
+	return true, nil
+}
+
+func (c *MigrationController) migrationSourceVMI(migration *virtv1.VirtualMachineInstanceMigration) (*virtv1.VirtualMachineInstance, bool, error) {
+	obj, exists, err := c.vmiInformer.GetStore().GetByKey(migration.Namespace + "/" + migration.Spec.VMIName)
+	if err != nil || !exists {
+		return nil, exists, err
+	}
+	return obj.(*virtv1.VirtualMachineInstance), true, nil
+}
+
+// orderMigrationQueue orders the pending migrations by priority. Migrations with the same
+// priority are shared fairly between namespaces: the next migration is taken from the
+// namespace with the fewest running and already queued migrations, ties are broken
+// by the age of the migrations.
+func orderMigrationQueue(pending, runningMigrations []*virtv1.VirtualMachineInstanceMigration) []*virtv1.VirtualMachineInstanceMigration {
+	namespaceLoad := map[string]int{}
+	for _, migration := range runningMigrations {
+		namespaceLoad[migration.Namespace]++
+	}
+
+	byPriority := map[int32]map[string][]*virtv1.VirtualMachineInstanceMigration{}
+	var priorities []int32
+	for _, migration := range pending {
+		priority := migrations.Priority(migration)
+		if _, exists := byPriority[priority]; !exists {
+			byPriority[priority] = map[string][]*virtv1.VirtualMachineInstanceMigration{}
+			priorities = append(priorities, priority)
+		}
+		byPriority[priority][migration.Namespace] = append(byPriority[priority][migration.Namespace], migration)
+	}
+	sort.Slice(priorities, func(i, j int) bool {
+		return priorities[i] > priorities[j]
+	})
+
+	queue := make([]*virtv1.VirtualMachineInstanceMigration, 0, len(pending))
+	for _, priority := range priorities {
+		namespaces := byPriority[priority]
+		for _, namespaceMigrations := range namespaces {
+			sort.SliceStable(namespaceMigrations, func(i, j int) bool {
+				return queuedBefore(namespaceMigrations[i], namespaceMigrations[j])
+			})
+		}
+		for len(namespaces) > 0 {
+			next := ""
+			for namespace, namespaceMigrations := range namespaces {
+				if next == "" ||
+					namespaceLoad[namespace] < namespaceLoad[next] ||
+					(namespaceLoad[namespace] == namespaceLoad[next] && queuedBefore(namespaceMigrations[0], namespaces[next][0])) {
+					next = namespace
+				}
+			}
+			queue = append(queue, namespaces[next][0])
+			namespaceLoad[next]++
+			if len(namespaces[next]) == 1 {
+				delete(namespaces, next)
+			} else {
+				namespaces[next] = namespaces[next][1:]
+			}
+		}
+	}
+	return queue
+}
+
+func queuedBefore(m1, m2 *virtv1.VirtualMachineInstanceMigration) bool {
+	if !m1.CreationTimestamp.Equal(&m2.CreationTimestamp) {
+		return m1.CreationTimestamp.Before(&m2.CreationTimestamp)
+	}
+	if m1.Namespace != m2.Namespace {
+		return m1.Namespace < m2.Namespace
+	}
+	return m1.Name < m2.Name
+}
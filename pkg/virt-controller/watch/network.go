@@ -19,6 +19,8 @@
 package watch
 
 import (
+	"fmt"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
@@ -83,3 +85,31 @@ func clearDetachedInterfaces(specIfaces []v1.Interface, specNets []v1.Network, s
 
 	return ifaces, vmispec.FilterNetworksByInterfaces(specNets, ifaces)
 }
+
+// generateInterfaceLinkStatePatch returns the patch operations which propagate the link state
+// of the VM interfaces to the matching VMI interfaces.
+func generateInterfaceLinkStatePatch(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) []string {
+	vmIndexedInterfaces := vmispec.IndexInterfaceSpecByName(vm.Spec.Template.Spec.Domain.Devices.Interfaces)
+
+	var ops []string
+	for i, vmiIface := range vmi.Spec.Domain.Devices.Interfaces {
+		vmIface, exists := vmIndexedInterfaces[vmiIface.Name]
+		if !exists || vmiIface.SRIOV != nil || vmIface.State == vmiIface.State ||
+			!isLinkState(vmIface.State) || !isLinkState(vmiIface.State) {
+			continue
+		}
+
+		path := fmt.Sprintf("/spec/domain/devices/interfaces/%d", i)
+		ops = append(ops, fmt.Sprintf(`{ "op": "test", "path": "%s/name", "value": %q }`, path, vmiIface.Name))
+		if vmIface.State == "" {
+			ops = append(ops, fmt.Sprintf(`{ "op": "remove", "path": "%s/state" }`, path))
+		} else {
+			ops = append(ops, fmt.Sprintf(`{ "op": "add", "path": "%s/state", "value": %q }`, path, vmIface.State))
+		}
+	}
+	return ops
+}
+
+func isLinkState(state v1.InterfaceState) bool {
+	return state == "" || state == v1.InterfaceStateLinkUp || state == v1.InterfaceStateLinkDown
+}
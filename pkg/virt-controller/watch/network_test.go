@@ -311,6 +311,47 @@ var _ = Describe("Network interface hot{un}plug", func() {
 			[]v1.Network{{Name: "blue"}},
 		),
 	)
+
+	DescribeTable("generate interface link state patch",
+		func(vmIfaces, vmiIfaces []v1.Interface, expectedOps []string) {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.Devices.Interfaces = vmiIfaces
+			vm := VirtualMachineFromVMI(vmi.Name, libvmi.New(), true)
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = vmIfaces
+			Expect(generateInterfaceLinkStatePatch(vm, vmi)).To(Equal(expectedOps))
+		},
+		Entry("when the link state did not change",
+			[]v1.Interface{{Name: "blue", State: v1.InterfaceStateLinkDown}, {Name: "red"}},
+			[]v1.Interface{{Name: "blue", State: v1.InterfaceStateLinkDown}, {Name: "red"}},
+			nil,
+		),
+		Entry("when the link state of an interface is set",
+			[]v1.Interface{{Name: "blue"}, {Name: "red", State: v1.InterfaceStateLinkDown}},
+			[]v1.Interface{{Name: "blue"}, {Name: "red"}},
+			[]string{
+				`{ "op": "test", "path": "/spec/domain/devices/interfaces/1/name", "value": "red" }`,
+				`{ "op": "add", "path": "/spec/domain/devices/interfaces/1/state", "value": "down" }`,
+			},
+		),
+		Entry("when the link state of an interface is cleared",
+			[]v1.Interface{{Name: "blue"}},
+			[]v1.Interface{{Name: "blue", State: v1.InterfaceStateLinkDown}},
+			[]string{
+				`{ "op": "test", "path": "/spec/domain/devices/interfaces/0/name", "value": "blue" }`,
+				`{ "op": "remove", "path": "/spec/domain/devices/interfaces/0/state" }`,
+			},
+		),
+		Entry("when an interface is hot-unplugged",
+			[]v1.Interface{{Name: "blue", State: v1.InterfaceStateAbsent}},
+			[]v1.Interface{{Name: "blue", State: v1.InterfaceStateLinkDown}},
+			nil,
+		),
+		Entry("when the interface uses SR-IOV binding",
+			[]v1.Interface{sriovInterface("blue")},
+			[]v1.Interface{{Name: "blue", State: v1.InterfaceStateLinkDown, InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}}},
+			nil,
+		),
+	)
 })
 
 func bridgeInterface(name string) v1.Interface {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -22,6 +23,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -56,7 +58,14 @@ const (
 	FailedUpdateVirtualMachineReason     = "FailedUpdate"
 	SuccessfulUpdateVirtualMachineReason = "SuccessfulUpdate"
 
+	defaultPoolMaxUnavailable = 1
+
 	defaultAddDelay = 1 * time.Second
+
+	// poolUpdateMigrationAnnotation and poolUpdateRevisionAnnotation record the migration which updates
+	// a VMI to a pool revision, the revision label of the VMI is only updated once it succeeded
+	poolUpdateMigrationAnnotation = "kubevirt.io/vmpool-update-migration-uid"
+	poolUpdateRevisionAnnotation  = "kubevirt.io/vmpool-update-revision-name"
 )
 
 const (
@@ -65,8 +74,9 @@ const (
 	FailedUpdateReason          = "FailedUpdate"
 	FailedRevisionPruningReason = "FailedRevisionPruning"
 
-	SuccessfulPausedPoolReason = "SuccessfulPaused"
-	SuccessfulResumePoolReason = "SuccessfulResume"
+	SuccessfulPausedPoolReason            = "SuccessfulPaused"
+	SuccessfulResumePoolReason            = "SuccessfulResume"
+	SuccessfulMigrateVirtualMachineReason = "SuccessfulMigrate"
 )
 
 var virtControllerPoolWorkQueueTracer = &traceUtils.Tracer{Threshold: time.Second}
@@ -470,13 +480,82 @@ func (c *PoolController) listVMsFromNamespace(namespace string) ([]*virtv1.Virtu
 	return vms, nil
 }
 
-func (c *PoolController) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
-	wantedReplicas := int32(1)
+func (c *PoolController) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (int, error) {
+	wantedReplicas := getWantedReplicas(pool)
+
+	// Keep the surge VMs around until the update is done
+	if _, maxSurge := getRollingUpdateLimits(pool); maxSurge > 0 {
+		inProgress, err := c.isUpdateInProgress(pool, vms)
+		if err != nil {
+			return 0, err
+		}
+		if inProgress {
+			wantedReplicas += maxSurge
+		}
+	}
+
+	return len(vms) - wantedReplicas, nil
+}
+
+func getWantedReplicas(pool *poolv1.VirtualMachinePool) int {
 	if pool.Spec.Replicas != nil {
-		wantedReplicas = *pool.Spec.Replicas
+		return int(*pool.Spec.Replicas)
+	}
+	return 1
+}
+
+func getRollingUpdate(pool *poolv1.VirtualMachinePool) *poolv1.VirtualMachinePoolRollingUpdate {
+	if pool.Spec.UpdateStrategy == nil {
+		return nil
+	}
+	return pool.Spec.UpdateStrategy.RollingUpdate
+}
+
+// getRollingUpdateLimits returns the resolved maxUnavailable and maxSurge of the pool, maxUnavailable is -1 when not limited
+func getRollingUpdateLimits(pool *poolv1.VirtualMachinePool) (int, int) {
+	rollingUpdate := getRollingUpdate(pool)
+	if rollingUpdate == nil {
+		return -1, 0
+	}
+
+	replicas := getWantedReplicas(pool)
+	maxUnavailable := defaultPoolMaxUnavailable
+	if rollingUpdate.MaxUnavailable != nil {
+		if value, err := intstr.GetScaledValueFromIntOrPercent(rollingUpdate.MaxUnavailable, replicas, false); err == nil {
+			maxUnavailable = value
+		}
+	}
+	maxSurge := 0
+	if rollingUpdate.MaxSurge != nil {
+		if value, err := intstr.GetScaledValueFromIntOrPercent(rollingUpdate.MaxSurge, replicas, true); err == nil {
+			maxSurge = value
+		}
+	}
+	// Like Deployments, allow one unavailable VM when both limits round down to zero, so the update can progress
+	if maxUnavailable == 0 && maxSurge == 0 {
+		maxUnavailable = 1
+	}
+	return maxUnavailable, maxSurge
+}
+
+// isPartitioned returns true when the VM is excluded from updates by the partition of the pool
+func isPartitioned(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) bool {
+	rollingUpdate := getRollingUpdate(pool)
+	if rollingUpdate == nil || rollingUpdate.Partition == nil {
+		return false
+	}
+	index, err := indexFromName(vm.Name)
+	if err != nil {
+		return false
 	}
+	return index < int(*rollingUpdate.Partition)
+}
 
-	return len(vms) - int(wantedReplicas)
+func getUpdateMethod(method *poolv1.VirtualMachinePoolUpdateMethod) poolv1.VirtualMachinePoolUpdateMethod {
+	if method == nil {
+		return poolv1.VirtualMachinePoolUpdateMethodRestart
+	}
+	return *method
 }
 
 func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
@@ -492,9 +571,11 @@ func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
 
 // filterReadyVMs takes a list of VMs and returns all VMs which are in ready state.
 func (c *PoolController) filterReadyVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
-	return filterVMs(vms, func(vm *virtv1.VirtualMachine) bool {
-		return controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineConditionType(k8score.PodReady), k8score.ConditionTrue)
-	})
+	return filterVMs(vms, isReadyVM)
+}
+
+func isReadyVM(vm *virtv1.VirtualMachine) bool {
+	return controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineConditionType(k8score.PodReady), k8score.ConditionTrue)
 }
 
 func filterVMs(vms []*virtv1.VirtualMachine, f func(vmi *virtv1.VirtualMachine) bool) []*virtv1.VirtualMachine {
@@ -525,10 +606,7 @@ func (c *PoolController) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.
 		count = len(elgibleVMs)
 	}
 
-	// random delete strategy
-	rand.Shuffle(len(elgibleVMs), func(i, j int) {
-		elgibleVMs[i], elgibleVMs[j] = elgibleVMs[j], elgibleVMs[i]
-	})
+	sortVMsForScaleIn(pool, elgibleVMs)
 
 	log.Log.Object(pool).Infof("Removing %d VMs from pool", count)
 
@@ -568,6 +646,61 @@ func (c *PoolController) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.
 	return nil
 }
 
+// getDeletionCost returns the deletion cost of a VM. VMs without a valid cost have a cost of 0.
+func getDeletionCost(vm *virtv1.VirtualMachine) int64 {
+	costStr, exists := vm.Annotations[poolv1.VirtualMachinePoolDeletionCostAnnotation]
+	if !exists {
+		return 0
+	}
+	cost, err := strconv.ParseInt(costStr, 10, 64)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Warningf("Ignoring invalid %s annotation", poolv1.VirtualMachinePoolDeletionCostAnnotation)
+		return 0
+	}
+	return cost
+}
+
+// sortVMsForScaleIn orders the VMs so that the VMs which should be removed first come first.
+// The deletion cost is respected by all policies, ties are resolved by the scale in policy of the pool
+// and remaining ties are resolved randomly.
+func sortVMsForScaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) {
+	policy := poolv1.VirtualMachinePoolScaleInPolicyRandom
+	if pool.Spec.ScaleInPolicy != nil {
+		policy = *pool.Spec.ScaleInPolicy
+	}
+
+	rand.Shuffle(len(vms), func(i, j int) {
+		vms[i], vms[j] = vms[j], vms[i]
+	})
+
+	sort.SliceStable(vms, func(i, j int) bool {
+		if costI, costJ := getDeletionCost(vms[i]), getDeletionCost(vms[j]); costI != costJ {
+			return costI < costJ
+		}
+
+		switch policy {
+		case poolv1.VirtualMachinePoolScaleInPolicyOldestFirst:
+			return vms[i].CreationTimestamp.Before(&vms[j].CreationTimestamp)
+		case poolv1.VirtualMachinePoolScaleInPolicyNotReadyFirst:
+			return !isReadyVM(vms[i]) && isReadyVM(vms[j])
+		case poolv1.VirtualMachinePoolScaleInPolicyLowestIndexFirst:
+			return vmIndex(vms[i]) < vmIndex(vms[j])
+		case poolv1.VirtualMachinePoolScaleInPolicyHighestIndexFirst:
+			return vmIndex(vms[i]) > vmIndex(vms[j])
+		}
+		return false
+	})
+}
+
+// vmIndex returns the ordinal of a VM, or -1 if the VM name does not carry one
+func vmIndex(vm *virtv1.VirtualMachine) int {
+	index, err := indexFromName(vm.Name)
+	if err != nil {
+		return -1
+	}
+	return index
+}
+
 func generateVMName(index int, baseName string) string {
 	return fmt.Sprintf("%s-%d", baseName, index)
 }
@@ -797,7 +930,10 @@ func (c *PoolController) scaleOut(pool *poolv1.VirtualMachinePool, count int) er
 }
 
 func (c *PoolController) scale(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (syncError, bool) {
-	diff := c.calcDiff(pool, vms)
+	diff, err := c.calcDiff(pool, vms)
+	if err != nil {
+		return &syncErrorImpl{fmt.Errorf("Error while detecting outdated VMs: %v", err), FailedUpdateReason}, false
+	}
 	if diff == 0 {
 		// nothing to do
 		return nil, true
@@ -874,34 +1010,129 @@ func (c *PoolController) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vm
 	return nil
 }
 
-func (c *PoolController) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedList []*virtv1.VirtualMachine) error {
-	var wg sync.WaitGroup
-	wg.Add(len(vmUpdatedList))
-	errChan := make(chan error, len(vmUpdatedList))
-	for i := 0; i < len(vmUpdatedList); i++ {
-		go func(idx int) {
-			defer wg.Done()
-			vm := vmUpdatedList[idx]
+type vmiUpdate struct {
+	vm         *virtv1.VirtualMachine
+	vmi        *virtv1.VirtualMachineInstance
+	updateType proactiveUpdateType
+}
 
-			vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
-			obj, exists, _ := c.vmiInformer.GetStore().GetByKey(vmiKey)
-			if !exists {
-				// no VMI to update
-				return
-			}
-			vmi := obj.(*virtv1.VirtualMachineInstance)
-			if vmi.DeletionTimestamp != nil {
-				// ignore VMIs which are already deleting
-				return
-			}
+// getVMIUpdates returns the pending updates of the VMIs which belong to up-to-date VMs
+func (c *PoolController) getVMIUpdates(pool *poolv1.VirtualMachinePool, vmUpdatedList []*virtv1.VirtualMachine) ([]vmiUpdate, error) {
+	var updates []vmiUpdate
+	for _, vm := range vmUpdatedList {
+		vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
+		obj, exists, _ := c.vmiInformer.GetStore().GetByKey(vmiKey)
+		if !exists {
+			// no VMI to update
+			continue
+		}
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.DeletionTimestamp != nil {
+			// ignore VMIs which are already deleting
+			continue
+		}
 
-			updateType, err := c.isOutdatedVMI(vm, vmi)
-			if err != nil {
-				errChan <- err
-				return
+		updateType, err := c.isOutdatedVMI(pool, vm, vmi)
+		if err != nil {
+			return nil, err
+		}
+		if updateType != proactiveUpdateTypeNone {
+			updates = append(updates, vmiUpdate{vm: vm, vmi: vmi, updateType: updateType})
+		}
+	}
+	return updates, nil
+}
+
+func isVMIMigrationInProgress(vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi.Status.MigrationState != nil && !vmi.Status.MigrationState.Completed
+}
+
+// calcUpdateBudget returns how many VMIs can be disrupted by the update right now, or -1 when it is not limited
+func (c *PoolController) calcUpdateBudget(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
+	maxUnavailable, _ := getRollingUpdateLimits(pool)
+	if maxUnavailable < 0 {
+		return -1
+	}
+
+	unavailable := 0
+	available := 0
+	for _, vm := range vms {
+		if vm.DeletionTimestamp != nil {
+			unavailable++
+			continue
+		}
+		if runStrategy, err := vm.RunStrategy(); err == nil && runStrategy == virtv1.RunStrategyHalted {
+			// stopped VMs are not disrupted by the update
+			continue
+		}
+
+		migrating := false
+		vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
+		if obj, exists, _ := c.vmiInformer.GetStore().GetByKey(vmiKey); exists {
+			migrating = isVMIMigrationInProgress(obj.(*virtv1.VirtualMachineInstance))
+		}
+		if migrating || len(c.filterReadyVMs([]*virtv1.VirtualMachine{vm})) == 0 {
+			unavailable++
+		} else {
+			available++
+		}
+	}
+
+	budget := maxUnavailable - unavailable
+	// VMs available above the wanted replicas, e.g. surge VMs, allow further disruptions
+	if surplus := available - getWantedReplicas(pool); surplus > 0 {
+		budget += surplus
+	}
+	if budget < 0 {
+		return 0
+	}
+	return budget
+}
+
+// limitDisruptiveUpdates drops the restarts and migrations which exceed the update budget.
+// VMs with higher ordinals are updated first.
+func (c *PoolController) limitDisruptiveUpdates(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, updates []vmiUpdate) []vmiUpdate {
+	budget := c.calcUpdateBudget(pool, vms)
+	if budget < 0 {
+		return updates
+	}
+
+	sort.SliceStable(updates, func(i, j int) bool {
+		indexI, _ := indexFromName(updates[i].vm.Name)
+		indexJ, _ := indexFromName(updates[j].vm.Name)
+		return indexI > indexJ
+	})
 
+	var limited []vmiUpdate
+	for _, update := range updates {
+		if update.updateType == proactiveUpdateTypeRestart || update.updateType == proactiveUpdateTypeMigrate {
+			if budget == 0 {
+				continue
 			}
-			switch updateType {
+			budget--
+		}
+		limited = append(limited, update)
+	}
+	return limited
+}
+
+func (c *PoolController) proactiveUpdate(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, vmUpdatedList []*virtv1.VirtualMachine) error {
+	updates, err := c.getVMIUpdates(pool, vmUpdatedList)
+	if err != nil {
+		return err
+	}
+	updates = c.limitDisruptiveUpdates(pool, vms, updates)
+
+	var wg sync.WaitGroup
+	wg.Add(len(updates))
+	errChan := make(chan error, len(updates))
+	for i := 0; i < len(updates); i++ {
+		go func(idx int) {
+			defer wg.Done()
+			vm := updates[idx].vm
+			vmi := updates[idx].vmi
+
+			switch updates[idx].updateType {
 			case proactiveUpdateTypeRestart:
 				err := c.clientset.VirtualMachineInstance(vm.ObjectMeta.Namespace).Delete(context.Background(), vmi.ObjectMeta.Name, &v1.DeleteOptions{})
 				if err != nil {
@@ -911,40 +1142,31 @@ func (c *PoolController) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpda
 				}
 				log.Log.Object(pool).Infof("Proactively updating vm %s/%s in pool via vmi deletion", vm.Namespace, vm.Name)
 				c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulDeleteVirtualMachineReason, "Proactive update of VM %s/%s by deleting outdated VMI", vm.Namespace, vm.Name)
-			case proactiveUpdateTypePatchRevisionLabel:
-				var patchOps []string
-				vmiCopy := vmi.DeepCopy()
-				if vmiCopy.Labels == nil {
-					vmiCopy.Labels = make(map[string]string)
-				}
-				revisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
-				if !exists {
-					// nothing to do
-					return
-				}
-				vmiCopy.Labels[virtv1.VirtualMachinePoolRevisionName] = revisionName
-
-				newLabelBytes, err := json.Marshal(vmiCopy.Labels)
+			case proactiveUpdateTypeMigrate:
+				err := c.migrateOutdatedVMI(pool, vm, vmi)
 				if err != nil {
+					c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedUpdateVirtualMachineReason, "Error proactively updating VM %s/%s by migrating outdated VMI: %v", vm.Namespace, vm.Name, err)
 					errChan <- err
 					return
 				}
-				oldLabelBytes, err := json.Marshal(vmi.Labels)
-				if err != nil {
-					errChan <- err
+				log.Log.Object(pool).Infof("Proactively updating vm %s/%s in pool via vmi migration", vm.Namespace, vm.Name)
+				c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulMigrateVirtualMachineReason, "Proactive update of VM %s/%s by migrating outdated VMI", vm.Namespace, vm.Name)
+			case proactiveUpdateTypePatchRevisionLabel:
+				revisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
+				if !exists {
+					// nothing to do
 					return
 				}
-
-				if vmi.Labels == nil {
-					patchOps = append(patchOps, fmt.Sprintf(`{ "op": "add", "path": "/metadata/labels", "value": %s }`, string(newLabelBytes)))
-				} else {
-					patchOps = append(patchOps, fmt.Sprintf(`{ "op": "test", "path": "/metadata/labels", "value": %s }`, string(oldLabelBytes)))
-					patchOps = append(patchOps, fmt.Sprintf(`{ "op": "replace", "path": "/metadata/labels", "value": %s }`, string(newLabelBytes)))
+				labels := mapCopy(vmi.Labels)
+				labels[virtv1.VirtualMachinePoolRevisionName] = revisionName
+				annotations := vmi.Annotations
+				if _, exists := annotations[poolUpdateMigrationAnnotation]; exists {
+					annotations = mapCopy(vmi.Annotations)
+					delete(annotations, poolUpdateMigrationAnnotation)
+					delete(annotations, poolUpdateRevisionAnnotation)
 				}
 
-				patchBytes := controller.GeneratePatchBytes(patchOps)
-
-				_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, &v1.PatchOptions{})
+				err := c.patchVMIMetadata(vmi, labels, annotations)
 				if err != nil {
 					errChan <- fmt.Errorf("patching of vmi labels with new pool revision name: %v", err)
 					return
@@ -965,18 +1187,173 @@ func (c *PoolController) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpda
 	return nil
 }
 
+func (c *PoolController) patchVMIMetadata(vmi *virtv1.VirtualMachineInstance, labels, annotations map[string]string) error {
+	var patchOps []string
+
+	for _, field := range []struct {
+		path     string
+		oldValue map[string]string
+		newValue map[string]string
+	}{
+		{"/metadata/labels", vmi.Labels, labels},
+		{"/metadata/annotations", vmi.Annotations, annotations},
+	} {
+		if equality.Semantic.DeepEqual(field.oldValue, field.newValue) {
+			continue
+		}
+		newBytes, err := json.Marshal(field.newValue)
+		if err != nil {
+			return err
+		}
+		if field.oldValue == nil {
+			patchOps = append(patchOps, fmt.Sprintf(`{ "op": "add", "path": "%s", "value": %s }`, field.path, string(newBytes)))
+			continue
+		}
+		oldBytes, err := json.Marshal(field.oldValue)
+		if err != nil {
+			return err
+		}
+		patchOps = append(patchOps, fmt.Sprintf(`{ "op": "test", "path": "%s", "value": %s }`, field.path, string(oldBytes)))
+		patchOps = append(patchOps, fmt.Sprintf(`{ "op": "replace", "path": "%s", "value": %s }`, field.path, string(newBytes)))
+	}
+
+	if len(patchOps) == 0 {
+		return nil
+	}
+
+	_, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, controller.GeneratePatchBytes(patchOps), &v1.PatchOptions{})
+	return err
+}
+
+// syncTemplateMetadata replaces the entries of the old template with the ones of the new template
+func syncTemplateMetadata(current, oldTemplate, newTemplate map[string]string) map[string]string {
+	synced := mapCopy(current)
+	for key := range oldTemplate {
+		delete(synced, key)
+	}
+	for key, value := range newTemplate {
+		synced[key] = value
+	}
+	return synced
+}
+
+// migrateOutdatedVMI syncs the VMI metadata with the template of the VM and migrates the VMI.
+// The migration target pod picks up the new metadata and the live updated spec of the VMI.
+func (c *PoolController) migrateOutdatedVMI(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if isVMIMigrationInProgress(vmi) {
+		return nil
+	}
+
+	var oldTemplateMeta, newTemplateMeta metav1.ObjectMeta
+	oldPoolSpec, exists, err := c.getControllerRevision(vmi.Namespace, vmi.Labels[virtv1.VirtualMachinePoolRevisionName])
+	if err != nil {
+		return err
+	}
+	if exists && oldPoolSpec.VirtualMachineTemplate.Spec.Template != nil {
+		oldTemplateMeta = oldPoolSpec.VirtualMachineTemplate.Spec.Template.ObjectMeta
+	}
+	if vm.Spec.Template != nil {
+		newTemplateMeta = vm.Spec.Template.ObjectMeta
+	}
+
+	// The revision label is only updated once the migration succeeded, see isOutdatedVMI
+	vmiRevisionName := vmi.Labels[virtv1.VirtualMachinePoolRevisionName]
+	labels := syncTemplateMetadata(vmi.Labels, oldTemplateMeta.Labels, newTemplateMeta.Labels)
+	labels[virtv1.VirtualMachinePoolRevisionName] = vmiRevisionName
+	annotations := syncTemplateMetadata(vmi.Annotations, oldTemplateMeta.Annotations, newTemplateMeta.Annotations)
+	if err := c.patchVMIMetadata(vmi, labels, annotations); err != nil {
+		return err
+	}
+
+	migration, err := c.clientset.VirtualMachineInstanceMigration(vmi.Namespace).Create(&virtv1.VirtualMachineInstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-update-", pool.Name),
+		},
+		Spec: virtv1.VirtualMachineInstanceMigrationSpec{
+			VMIName: vmi.Name,
+		},
+	}, &metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Labels = labels
+	vmiCopy.Annotations = annotations
+	migrationAnnotations := mapCopy(annotations)
+	migrationAnnotations[poolUpdateMigrationAnnotation] = string(migration.UID)
+	migrationAnnotations[poolUpdateRevisionAnnotation] = vm.Labels[virtv1.VirtualMachinePoolRevisionName]
+	return c.patchVMIMetadata(vmiCopy, labels, migrationAnnotations)
+}
+
+// isUpdateMigrationSucceeded returns true when the last migration of the VMI updated it to the given pool revision
+func isUpdateMigrationSucceeded(vmi *virtv1.VirtualMachineInstance, revisionName string) bool {
+	migrationState := vmi.Status.MigrationState
+	if migrationState == nil || !migrationState.Completed || migrationState.Failed {
+		return false
+	}
+	return vmi.Annotations[poolUpdateMigrationAnnotation] == string(migrationState.MigrationUID) &&
+		vmi.Annotations[poolUpdateRevisionAnnotation] == revisionName
+}
+
+// isUpdateInProgress returns true when any VM or VMI of the pool, which is not partitioned, still has to be updated
+func (c *PoolController) isUpdateInProgress(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (bool, error) {
+	var vmUpdatedList []*virtv1.VirtualMachine
+	for _, vm := range vms {
+		if isPartitioned(pool, vm) {
+			continue
+		}
+		outdated, err := c.isOutdatedVM(pool, vm)
+		if err != nil {
+			return false, err
+		}
+		if outdated {
+			return true, nil
+		}
+		vmUpdatedList = append(vmUpdatedList, vm)
+	}
+
+	updates, err := c.getVMIUpdates(pool, vmUpdatedList)
+	if err != nil {
+		return false, err
+	}
+	for _, update := range updates {
+		if update.updateType != proactiveUpdateTypePatchRevisionLabel {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isUpdatedVM returns true when the VM and its VMI match the current pool spec
+func (c *PoolController) isUpdatedVM(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) bool {
+	if outdated, err := c.isOutdatedVM(pool, vm); err != nil || outdated {
+		return false
+	}
+
+	vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
+	obj, exists, _ := c.vmiInformer.GetStore().GetByKey(vmiKey)
+	if !exists {
+		return true
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	return vmi.Labels[virtv1.VirtualMachinePoolRevisionName] == vm.Labels[virtv1.VirtualMachinePoolRevisionName]
+}
+
 type proactiveUpdateType string
 
 const (
 	// VMI spec has changed within vmi pool and requires restart
 	proactiveUpdateTypeRestart proactiveUpdateType = "restart"
+	// VMI has changed within vmi pool and gets updated by a live migration
+	proactiveUpdateTypeMigrate proactiveUpdateType = "migrate"
 	// VMI spec is identify in current vmi pool, just needs revision label updated
 	proactiveUpdateTypePatchRevisionLabel proactiveUpdateType = "label-patch"
 	// VMI does not need an update
 	proactiveUpdateTypeNone proactiveUpdateType = "no-update"
 )
 
-func (c *PoolController) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (proactiveUpdateType, error) {
+func (c *PoolController) isOutdatedVMI(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (proactiveUpdateType, error) {
 	// This function compares the pool revision (pool spec at a specific point in time) synced
 	// to the VM vs the one used to create the VMI. By comparing the pool spec revisions between
 	// the VM and VMI we can determine if the VM has mutated in a way that should result
@@ -996,6 +1373,8 @@ func (c *PoolController) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Vi
 	//    proactive restart is required.
 	// 4. If the expected VMI template specs from the revisions are not identical in name, but
 	//    are identical in DeepEquals, patch the VMI with the new revision name used on the vm.
+	// 5. If the VMI got updated to the revision of the VM by a live migration which succeeded,
+	//    patch the VMI with the new revision name used on the vm.
 
 	vmRevisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
 	if !exists {
@@ -1017,6 +1396,11 @@ func (c *PoolController) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Vi
 		return proactiveUpdateTypeNone, nil
 	}
 
+	if isUpdateMigrationSucceeded(vmi, vmRevisionName) {
+		// the VMI got updated by a live migration, only the revision label is left
+		return proactiveUpdateTypePatchRevisionLabel, nil
+	}
+
 	// Get the pool revision used to create the VM
 	poolSpecRevisionForVM, exists, err := c.getControllerRevision(vm.Namespace, vmRevisionName)
 	if err != nil {
@@ -1046,7 +1430,7 @@ func (c *PoolController) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Vi
 	// must be updated.
 	if !equality.Semantic.DeepEqual(currentVMITemplate, expectedVMITemplate) {
 		log.Log.Infof("Marking vmi %s/%s for update due out of sync spec", vm.Namespace, vm.Name)
-		return proactiveUpdateTypeRestart, nil
+		return getVMIUpdateType(pool, vm, vmi, currentVMITemplate, expectedVMITemplate), nil
 	}
 
 	// If we get here, the vmi templates are identical, but the revision
@@ -1054,6 +1438,72 @@ func (c *PoolController) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Vi
 	return proactiveUpdateTypePatchRevisionLabel, nil
 }
 
+// getVMIUpdateType picks the update type for the kind of template change according to the update strategy of the pool
+// A spec change is only live migrated when the VM controller propagates all of it to the running VMI,
+// any other spec change requires a restart.
+func getVMIUpdateType(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, currentVMITemplate, expectedVMITemplate *virtv1.VirtualMachineInstanceTemplateSpec) proactiveUpdateType {
+	rollingUpdate := getRollingUpdate(pool)
+	if rollingUpdate == nil || currentVMITemplate == nil || expectedVMITemplate == nil {
+		return proactiveUpdateTypeRestart
+	}
+
+	method := getUpdateMethod(rollingUpdate.MetadataChangeMethod)
+	if !equality.Semantic.DeepEqual(currentVMITemplate.Spec, expectedVMITemplate.Spec) {
+		method = getUpdateMethod(rollingUpdate.SpecChangeMethod)
+		if !equality.Semantic.DeepEqual(withoutLiveUpdatableFields(vm, &currentVMITemplate.Spec), withoutLiveUpdatableFields(vm, &expectedVMITemplate.Spec)) {
+			return proactiveUpdateTypeRestart
+		}
+	}
+
+	if method == poolv1.VirtualMachinePoolUpdateMethodLiveMigrate && vmi.IsMigratable() {
+		return proactiveUpdateTypeMigrate
+	}
+	return proactiveUpdateTypeRestart
+}
+
+// withoutLiveUpdatableFields returns a copy of the VMI spec without the fields the VM controller updates on the running VMI
+func withoutLiveUpdatableFields(vm *virtv1.VirtualMachine, spec *virtv1.VirtualMachineInstanceSpec) *virtv1.VirtualMachineInstanceSpec {
+	spec = spec.DeepCopy()
+	spec.Firewall = nil
+	for i, iface := range spec.Domain.Devices.Interfaces {
+		if iface.SRIOV == nil && isLinkState(iface.State) {
+			spec.Domain.Devices.Interfaces[i].State = ""
+		}
+	}
+
+	features := vm.Spec.LiveUpdateFeatures
+	if features == nil {
+		return spec
+	}
+	if features.CPU != nil && spec.Domain.CPU != nil {
+		spec.Domain.CPU.Sockets = 0
+	}
+	if features.Memory != nil && spec.Domain.Memory != nil {
+		spec.Domain.Memory.Guest = nil
+	}
+	if features.Affinity != nil {
+		spec.Affinity = nil
+		spec.NodeSelector = nil
+	}
+	// The IO throttling and the bandwidth limits of an instancetype are only applied to the VMI
+	if vm.Spec.Instancetype != nil {
+		return spec
+	}
+	if features.DiskIOTune != nil {
+		for i := range spec.Domain.Devices.Disks {
+			spec.Domain.Devices.Disks[i].IOTune = nil
+		}
+	}
+	if features.InterfaceBandwidth != nil {
+		for i, iface := range spec.Domain.Devices.Interfaces {
+			if iface.SRIOV == nil {
+				spec.Domain.Devices.Interfaces[i].Bandwidth = nil
+			}
+		}
+	}
+	return spec
+}
+
 func (c *PoolController) isOutdatedVM(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) (bool, error) {
 
 	if vm.Labels == nil {
@@ -1143,6 +1593,10 @@ func (c *PoolController) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.V
 	vmUpdatedList := []*virtv1.VirtualMachine{}
 
 	for _, vm := range vms {
+		if isPartitioned(pool, vm) {
+			continue
+		}
+
 		outdated, err := c.isOutdatedVM(pool, vm)
 		if err != nil {
 			return &syncErrorImpl{fmt.Errorf("Error while detected outdated VMs: %v", err), FailedUpdateReason}, false
@@ -1160,7 +1614,7 @@ func (c *PoolController) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.V
 		return &syncErrorImpl{fmt.Errorf("Error during VM update: %v", err), FailedUpdateReason}, false
 	}
 
-	err = c.proactiveUpdate(pool, vmUpdatedList)
+	err = c.proactiveUpdate(pool, vms, vmUpdatedList)
 	if err != nil {
 		return &syncErrorImpl{fmt.Errorf("Error during VMI update: %v", err), FailedUpdateReason}, false
 	}
@@ -1250,6 +1704,14 @@ func (c *PoolController) updateStatus(origPool *poolv1.VirtualMachinePool, vms [
 	pool.Status.Replicas = int32(len(vms))
 	pool.Status.ReadyReplicas = int32(len(c.filterReadyVMs(vms)))
 
+	pool.Status.UpdatedReplicas = int32(len(filterVMs(vms, func(vm *virtv1.VirtualMachine) bool {
+		return c.isUpdatedVM(pool, vm)
+	})))
+	pool.Status.UpdateRevision = getRevisionName(pool)
+	if pool.Status.UpdatedReplicas == pool.Status.Replicas {
+		pool.Status.CurrentRevision = pool.Status.UpdateRevision
+	}
+
 	if !equality.Semantic.DeepEqual(pool.Status, origPool.Status) || pool.Status.Replicas != pool.Status.ReadyReplicas {
 		err := c.statusUpdater.UpdateStatus(pool)
 		if err != nil {
@@ -22,6 +22,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/golang/mock/gomock"
@@ -31,6 +32,8 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -45,6 +48,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	testutils "kubevirt.io/kubevirt/pkg/testutils"
 )
 
@@ -80,6 +84,64 @@ var _ = Describe("Pool", func() {
 			2),
 	)
 
+	DescribeTable("Order VMs on scale in", func(policy *poolv1.VirtualMachinePoolScaleInPolicy, expected []string) {
+		pool, _ := DefaultPool(0)
+		pool.Spec.ScaleInPolicy = policy
+
+		newVM := func(index int, age time.Duration, ready bool, cost string) *virtv1.VirtualMachine {
+			vm, _ := DefaultVirtualMachine(true)
+			vm.Name = fmt.Sprintf("%s-%d", pool.Name, index)
+			vm.CreationTimestamp = metav1.NewTime(time.Now().Add(-age))
+			if ready {
+				markVmAsReady(vm)
+			}
+			if cost != "" {
+				vm.Annotations = map[string]string{poolv1.VirtualMachinePoolDeletionCostAnnotation: cost}
+			}
+			return vm
+		}
+		vms := []*virtv1.VirtualMachine{
+			newVM(0, time.Hour, true, ""),
+			newVM(1, 3*time.Hour, true, ""),
+			newVM(2, 2*time.Hour, false, ""),
+			newVM(3, 4*time.Hour, true, "100"),
+			newVM(4, time.Minute, true, "-1"),
+		}
+
+		sortVMsForScaleIn(pool, vms)
+
+		var names []string
+		for _, vm := range vms {
+			names = append(names, vm.Name)
+		}
+		if expected == nil {
+			Expect(names[0]).To(Equal("my-pool-4"))
+			Expect(names[4]).To(Equal("my-pool-3"))
+		} else {
+			Expect(names).To(Equal(expected))
+		}
+	},
+		Entry("should respect the deletion cost with the random policy", nil, nil),
+		Entry("should remove the oldest VMs first",
+			pointer.P(poolv1.VirtualMachinePoolScaleInPolicyOldestFirst),
+			[]string{"my-pool-4", "my-pool-1", "my-pool-2", "my-pool-0", "my-pool-3"}),
+		Entry("should remove not ready VMs first",
+			pointer.P(poolv1.VirtualMachinePoolScaleInPolicyNotReadyFirst),
+			nil),
+		Entry("should remove the VMs with the lowest ordinal first",
+			pointer.P(poolv1.VirtualMachinePoolScaleInPolicyLowestIndexFirst),
+			[]string{"my-pool-4", "my-pool-0", "my-pool-1", "my-pool-2", "my-pool-3"}),
+		Entry("should remove the VMs with the highest ordinal first",
+			pointer.P(poolv1.VirtualMachinePoolScaleInPolicyHighestIndexFirst),
+			[]string{"my-pool-4", "my-pool-2", "my-pool-1", "my-pool-0", "my-pool-3"}),
+	)
+
+	It("should treat an invalid deletion cost as no cost", func() {
+		vm, _ := DefaultVirtualMachine(true)
+		vm.Annotations = map[string]string{poolv1.VirtualMachinePoolDeletionCostAnnotation: "high"}
+		Expect(getDeletionCost(vm)).To(BeZero())
+	})
+
 	Context("One valid Pool controller given", func() {
 
 		const (
@@ -93,6 +155,7 @@ var _ = Describe("Pool", func() {
 
 		var vmInterface *kubecli.MockVirtualMachineInterface
 		var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		var migrationInterface *kubecli.MockVirtualMachineInstanceMigrationInterface
 
 		var vmiSource *framework.FakeControllerSource
 		var vmSource *framework.FakeControllerSource
@@ -146,6 +209,7 @@ var _ = Describe("Pool", func() {
 
 			vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
 			vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+			migrationInterface = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
 			vmiInformer, vmiSource = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
 			vmInformer, vmSource = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
 			poolInformer, poolSource = testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
@@ -180,6 +244,7 @@ var _ = Describe("Pool", func() {
 			// Set up mock client
 			virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
 			virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+			virtClient.EXPECT().VirtualMachineInstanceMigration(metav1.NamespaceDefault).Return(migrationInterface).AnyTimes()
 
 			virtClient.EXPECT().VirtualMachinePool(testNamespace).Return(client.PoolV1alpha1().VirtualMachinePools(testNamespace)).AnyTimes()
 
@@ -270,6 +335,9 @@ var _ = Describe("Pool", func() {
 
 			pool.Generation = 123
 			newPoolRevision := createPoolRevision(pool)
+			pool.Status.UpdatedReplicas = 1
+			pool.Status.UpdateRevision = newPoolRevision.Name
+			pool.Status.CurrentRevision = newPoolRevision.Name
 
 			vm.Name = fmt.Sprintf("%s-0", pool.Name)
 
@@ -335,6 +403,7 @@ var _ = Describe("Pool", func() {
 			pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{}
 			pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels["newkey"] = "newval"
 			newPoolRevision := createPoolRevision(pool)
+			pool.Status.UpdateRevision = newPoolRevision.Name
 
 			vm = injectPoolRevisionLabelsIntoVM(vm, newPoolRevision.Name)
 			vm.Name = fmt.Sprintf("%s-0", pool.Name)
@@ -371,6 +440,288 @@ var _ = Describe("Pool", func() {
 			testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
 		})
 
+		Context("with a rolling update strategy", func() {
+
+			// addOutdatedPool adds a pool whose VMs are up-to-date, but whose VMIs were created from an outdated VMI template
+			changeHostname := func(spec *virtv1.VirtualMachineSpec) {
+				spec.Template.Spec.Hostname = "newhostname"
+			}
+
+			addOutdatedPool := func(replicas int, rollingUpdate *poolv1.VirtualMachinePoolRollingUpdate, specChange func(spec *virtv1.VirtualMachineSpec)) *poolv1.VirtualMachinePool {
+				pool, vm := DefaultPool(int32(replicas))
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{RollingUpdate: rollingUpdate}
+				pool.Status.Replicas = int32(replicas)
+				pool.Status.ReadyReplicas = int32(replicas)
+
+				oldPoolRevision := createPoolRevision(pool)
+
+				pool.Generation = 123
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				if specChange != nil {
+					specChange(&pool.Spec.VirtualMachineTemplate.Spec)
+				}
+				newPoolRevision := createPoolRevision(pool)
+				pool.Status.UpdateRevision = newPoolRevision.Name
+
+				addPool(pool)
+				addCR(oldPoolRevision)
+				addCR(newPoolRevision)
+
+				for i := 0; i < replicas; i++ {
+					vmCopy := vm.DeepCopy()
+					vmCopy.Name = fmt.Sprintf("%s-%d", pool.Name, i)
+					vmCopy.UID = types.UID(vmCopy.Name)
+					vmCopy.Spec.Template = pool.Spec.VirtualMachineTemplate.Spec.Template.DeepCopy()
+					vmCopy.Spec.LiveUpdateFeatures = pool.Spec.VirtualMachineTemplate.Spec.LiveUpdateFeatures.DeepCopy()
+					vmCopy = injectPoolRevisionLabelsIntoVM(vmCopy, newPoolRevision.Name)
+					markVmAsReady(vmCopy)
+
+					vmi := api.NewMinimalVMI(vmCopy.Name)
+					vmi.Namespace = vmCopy.Namespace
+					vmi.Labels = map[string]string{virtv1.VirtualMachinePoolRevisionName: oldPoolRevision.Name}
+					vmi.OwnerReferences = []metav1.OwnerReference{{
+						APIVersion:         virtv1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+						Kind:               virtv1.VirtualMachineGroupVersionKind.Kind,
+						Name:               vmCopy.Name,
+						UID:                vmCopy.UID,
+						Controller:         &t,
+						BlockOwnerDeletion: &t,
+					}}
+					vmi.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{{
+						Type:   virtv1.VirtualMachineInstanceIsMigratable,
+						Status: k8sv1.ConditionTrue,
+					}}
+
+					addVM(vmCopy)
+					addVMI(vmi, true)
+				}
+				return pool
+			}
+
+			It("should restart no more VMIs than maxUnavailable, starting with the highest ordinal", func() {
+				maxUnavailable := intstr.FromInt(1)
+				addOutdatedPool(3, &poolv1.VirtualMachinePoolRollingUpdate{MaxUnavailable: &maxUnavailable}, changeHostname)
+
+				vmiInterface.EXPECT().Delete(context.Background(), "my-pool-2", gomock.Any()).Return(nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should restart one VMI when maxUnavailable and maxSurge round down to zero", func() {
+				maxUnavailable := intstr.FromString("10%")
+				maxSurge := intstr.FromInt(0)
+				addOutdatedPool(3, &poolv1.VirtualMachinePoolRollingUpdate{MaxUnavailable: &maxUnavailable, MaxSurge: &maxSurge}, changeHostname)
+
+				vmiInterface.EXPECT().Delete(context.Background(), "my-pool-2", gomock.Any()).Return(nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should not restart VMIs while the pool is unavailable", func() {
+				maxUnavailable := intstr.FromInt(1)
+				pool := addOutdatedPool(2, &poolv1.VirtualMachinePoolRollingUpdate{MaxUnavailable: &maxUnavailable}, changeHostname)
+
+				obj, exists, err := vmInformer.GetStore().GetByKey(fmt.Sprintf("%s/%s-0", pool.Namespace, pool.Name))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exists).To(BeTrue())
+				vm := obj.(*virtv1.VirtualMachine).DeepCopy()
+				vm.Status.Conditions = nil
+				Expect(vmInformer.GetStore().Update(vm)).To(Succeed())
+
+				vmiInterface.EXPECT().Delete(context.Background(), gomock.Any(), gomock.Any()).Times(0)
+				client.Fake.PrependReactor("update", "virtualmachinepools", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(testing.UpdateAction)
+					Expect(ok).To(BeTrue())
+					Expect(update.GetObject().(*poolv1.VirtualMachinePool).Status.ReadyReplicas).To(Equal(int32(1)))
+					return true, update.GetObject(), nil
+				})
+
+				controller.Execute()
+			})
+
+			It("should not update VMs below the partition", func() {
+				maxUnavailable := intstr.FromInt(3)
+				partition := int32(2)
+				addOutdatedPool(3, &poolv1.VirtualMachinePoolRollingUpdate{MaxUnavailable: &maxUnavailable, Partition: &partition}, changeHostname)
+
+				vmiInterface.EXPECT().Delete(context.Background(), "my-pool-2", gomock.Any()).Return(nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should live migrate VMIs with outdated metadata when requested", func() {
+				maxUnavailable := intstr.FromInt(1)
+				liveMigrate := poolv1.VirtualMachinePoolUpdateMethodLiveMigrate
+				pool := addOutdatedPool(2, &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable:       &maxUnavailable,
+					MetadataChangeMethod: &liveMigrate,
+				}, nil)
+
+				var patches []string
+				vmiInterface.EXPECT().Patch(context.Background(), "my-pool-1", types.JSONPatchType, gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+					func(_ context.Context, _ string, _ types.PatchType, patch []byte, _ *metav1.PatchOptions, _ ...string) (*virtv1.VirtualMachineInstance, error) {
+						patches = append(patches, string(patch))
+						return nil, nil
+					})
+				migrationInterface.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(migration *virtv1.VirtualMachineInstanceMigration, _ *metav1.CreateOptions) (*virtv1.VirtualMachineInstanceMigration, error) {
+						Expect(migration.Spec.VMIName).To(Equal("my-pool-1"))
+						// the template labels have to be in place before the target pod is created
+						Expect(patches).To(HaveLen(1))
+						migration.UID = "migration-uid"
+						return migration, nil
+					})
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulMigrateVirtualMachineReason)
+				Expect(patches[0]).To(ContainSubstring(`"newkey":"newval"`))
+				Expect(patches[0]).To(ContainSubstring(fmt.Sprintf(`"%s":"%s-0"`, virtv1.VirtualMachinePoolRevisionName, pool.Name)))
+				// the revision label is only updated once the migration succeeded
+				Expect(patches[1]).ToNot(ContainSubstring("/metadata/labels"))
+				Expect(patches[1]).To(ContainSubstring(fmt.Sprintf(`"%s":"migration-uid"`, poolUpdateMigrationAnnotation)))
+				Expect(patches[1]).To(ContainSubstring(fmt.Sprintf(`"%s":"%s-123"`, poolUpdateRevisionAnnotation, pool.Name)))
+			})
+
+			DescribeTable("once the update migration finished", func(failed bool, expectMigration bool) {
+				maxUnavailable := intstr.FromInt(1)
+				liveMigrate := poolv1.VirtualMachinePoolUpdateMethodLiveMigrate
+				pool := addOutdatedPool(2, &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable:       &maxUnavailable,
+					MetadataChangeMethod: &liveMigrate,
+				}, nil)
+
+				var patchesLock sync.Mutex
+				patches := map[string]string{}
+				for i := 0; i < 2; i++ {
+					obj, exists, err := vmiInformer.GetStore().GetByKey(fmt.Sprintf("%s/%s-%d", pool.Namespace, pool.Name, i))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(exists).To(BeTrue())
+					vmi := obj.(*virtv1.VirtualMachineInstance).DeepCopy()
+					vmi.Labels["newkey"] = "newval"
+					vmi.Annotations = map[string]string{
+						poolUpdateMigrationAnnotation: "migration-uid",
+						poolUpdateRevisionAnnotation:  fmt.Sprintf("%s-123", pool.Name),
+					}
+					vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+						MigrationUID: "migration-uid",
+						Completed:    true,
+						Failed:       failed,
+					}
+					Expect(vmiInformer.GetStore().Update(vmi)).To(Succeed())
+				}
+
+				if expectMigration {
+					vmiInterface.EXPECT().Patch(context.Background(), "my-pool-1", types.JSONPatchType, gomock.Any(), gomock.Any()).Return(nil, nil)
+					migrationInterface.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+						func(migration *virtv1.VirtualMachineInstanceMigration, _ *metav1.CreateOptions) (*virtv1.VirtualMachineInstanceMigration, error) {
+							return migration, nil
+						})
+				} else {
+					vmiInterface.EXPECT().Patch(context.Background(), gomock.Any(), types.JSONPatchType, gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+						func(_ context.Context, name string, _ types.PatchType, patch []byte, _ *metav1.PatchOptions, _ ...string) (*virtv1.VirtualMachineInstance, error) {
+							patchesLock.Lock()
+							defer patchesLock.Unlock()
+							patches[name] = string(patch)
+							return nil, nil
+						})
+					migrationInterface.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+				}
+
+				controller.Execute()
+
+				if expectMigration {
+					testutils.ExpectEvent(recorder, SuccessfulMigrateVirtualMachineReason)
+					return
+				}
+				Expect(patches).To(HaveLen(2))
+				for _, patch := range patches {
+					Expect(patch).To(ContainSubstring(fmt.Sprintf(`"path": "/metadata/labels", "value": {"%s":"%s-123","newkey":"newval"} }`, virtv1.VirtualMachinePoolRevisionName, pool.Name)))
+					Expect(patch).To(ContainSubstring(`"path": "/metadata/annotations", "value": {} }`))
+				}
+			},
+				Entry("should patch the revision label when it succeeded", false, false),
+				Entry("should migrate again when it failed", true, true),
+			)
+
+			It("should restart VMIs with a spec change which can not be live updated", func() {
+				maxUnavailable := intstr.FromInt(1)
+				liveMigrate := poolv1.VirtualMachinePoolUpdateMethodLiveMigrate
+				addOutdatedPool(2, &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable:   &maxUnavailable,
+					SpecChangeMethod: &liveMigrate,
+				}, changeHostname)
+
+				vmiInterface.EXPECT().Delete(context.Background(), "my-pool-1", gomock.Any()).Return(nil)
+				migrationInterface.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should live migrate VMIs with a spec change which is live updated", func() {
+				maxUnavailable := intstr.FromInt(1)
+				liveMigrate := poolv1.VirtualMachinePoolUpdateMethodLiveMigrate
+				addOutdatedPool(2, &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable:   &maxUnavailable,
+					SpecChangeMethod: &liveMigrate,
+				}, func(spec *virtv1.VirtualMachineSpec) {
+					spec.LiveUpdateFeatures = &virtv1.LiveUpdateFeatures{Affinity: &virtv1.LiveUpdateAffinity{}}
+					spec.Template.Spec.NodeSelector = map[string]string{"newkey": "newval"}
+				})
+
+				vmiInterface.EXPECT().Patch(context.Background(), "my-pool-1", types.JSONPatchType, gomock.Any(), gomock.Any()).Times(2).Return(nil, nil)
+				migrationInterface.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(migration *virtv1.VirtualMachineInstanceMigration, _ *metav1.CreateOptions) (*virtv1.VirtualMachineInstanceMigration, error) {
+						return migration, nil
+					})
+				vmiInterface.EXPECT().Delete(context.Background(), gomock.Any(), gomock.Any()).Times(0)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulMigrateVirtualMachineReason)
+			})
+
+			It("should restart VMIs with an outdated spec when only metadata changes are live migrated", func() {
+				maxUnavailable := intstr.FromInt(1)
+				liveMigrate := poolv1.VirtualMachinePoolUpdateMethodLiveMigrate
+				addOutdatedPool(2, &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable:       &maxUnavailable,
+					MetadataChangeMethod: &liveMigrate,
+				}, changeHostname)
+
+				vmiInterface.EXPECT().Delete(context.Background(), "my-pool-1", gomock.Any()).Return(nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should create surge VMs before restarting VMIs", func() {
+				maxUnavailable := intstr.FromInt(0)
+				maxSurge := intstr.FromInt(1)
+				pool := addOutdatedPool(2, &poolv1.VirtualMachinePoolRollingUpdate{MaxUnavailable: &maxUnavailable, MaxSurge: &maxSurge}, changeHostname)
+
+				vmInterface.EXPECT().Create(context.Background(), gomock.Any()).DoAndReturn(func(_ context.Context, vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine, error) {
+					Expect(vm.Name).To(Equal(fmt.Sprintf("%s-2", pool.Name)))
+					Expect(vm.Labels).To(HaveKeyWithValue(virtv1.VirtualMachinePoolRevisionName, pool.Status.UpdateRevision))
+					return vm, nil
+				})
+				vmiInterface.EXPECT().Delete(context.Background(), gomock.Any(), gomock.Any()).Times(0)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+			})
+		})
+
 		It("should do nothing", func() {
 			pool, vm := DefaultPool(1)
 			vm.Name = fmt.Sprintf("%s-0", pool.Name)
@@ -381,6 +732,7 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -401,6 +753,7 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -590,6 +943,34 @@ var _ = Describe("Pool", func() {
 			}
 		})
 
+		It("should delete VMs according to the scale in policy", func() {
+			pool, vm := DefaultPool(2)
+			pool.Spec.ScaleInPolicy = pointer.P(poolv1.VirtualMachinePoolScaleInPolicyHighestIndexFirst)
+
+			addPool(pool)
+
+			for x := 0; x < 4; x++ {
+				newVM := vm.DeepCopy()
+				newVM.Name = fmt.Sprintf("%s-%d", pool.Name, x)
+				addVM(newVM)
+			}
+
+			client.Fake.PrependReactor("update", "virtualmachinepools", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				update, ok := action.(testing.UpdateAction)
+				Expect(ok).To(BeTrue())
+				return true, update.GetObject(), nil
+			})
+
+			vmInterface.EXPECT().Delete(context.Background(), "my-pool-3", gomock.Any()).Return(nil)
+			vmInterface.EXPECT().Delete(context.Background(), "my-pool-2", gomock.Any()).Return(nil)
+
+			controller.Execute()
+
+			for x := 0; x < 2; x++ {
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			}
+		})
+
 		It("should not delete vms which are already marked deleted", func() {
 
 			pool, vm := DefaultPool(0)
@@ -740,6 +1121,8 @@ func PoolFromVM(name string, vm *v1.VirtualMachine, replicas int32) *poolv1.Virt
 		},
 		Status: poolv1.VirtualMachinePoolStatus{LabelSelector: s.String()},
 	}
+	pool.Status.UpdateRevision = getRevisionName(pool)
+	pool.Status.CurrentRevision = pool.Status.UpdateRevision
 	return pool
 }
 
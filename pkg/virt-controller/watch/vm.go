@@ -65,6 +65,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	volumemigration "kubevirt.io/kubevirt/pkg/storage/volume-migration"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
 	"kubevirt.io/kubevirt/pkg/util/status"
@@ -105,15 +106,20 @@ const (
 )
 
 const (
-	HotPlugVolumeErrorReason           = "HotPlugVolumeError"
-	HotPlugCPUErrorReason              = "HotPlugCPUError"
-	MemoryDumpErrorReason              = "MemoryDumpError"
-	FailedUpdateErrorReason            = "FailedUpdateError"
-	FailedCreateReason                 = "FailedCreate"
-	VMIFailedDeleteReason              = "FailedDelete"
-	HotPlugNetworkInterfaceErrorReason = "HotPlugNetworkInterfaceError"
-	AffinityChangeErrorReason          = "AffinityChangeError"
-	HotPlugMemoryErrorReason           = "HotPlugMemoryError"
+	HotPlugVolumeErrorReason            = "HotPlugVolumeError"
+	HotPlugCPUErrorReason               = "HotPlugCPUError"
+	MemoryDumpErrorReason               = "MemoryDumpError"
+	FailedUpdateErrorReason             = "FailedUpdateError"
+	FailedCreateReason                  = "FailedCreate"
+	VMIFailedDeleteReason               = "FailedDelete"
+	HotPlugNetworkInterfaceErrorReason  = "HotPlugNetworkInterfaceError"
+	AffinityChangeErrorReason           = "AffinityChangeError"
+	HotPlugMemoryErrorReason            = "HotPlugMemoryError"
+	VolumesUpdateErrorReason            = "VolumesUpdateError"
+	DiskIOTuneChangeErrorReason         = "DiskIOTuneChangeError"
+	InterfaceBandwidthChangeErrorReason = "InterfaceBandwidthChangeError"
+	InterfaceLinkStateChangeErrorReason = "InterfaceLinkStateChangeError"
+	FirewallChangeErrorReason           = "FirewallChangeError"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
@@ -761,6 +767,222 @@ func (c *VMController) handleAffinityChangeRequest(vm *virtv1.VirtualMachine, vm
 	return nil
 }
 
+func (c *VMController) handleDiskIOTuneChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if vm.Spec.LiveUpdateFeatures == nil || vm.Spec.LiveUpdateFeatures.DiskIOTune == nil {
+		return nil
+	}
+
+	// The IO throttling of an instancetype is only applied to the VMI
+	if vm.Spec.Instancetype != nil {
+		return nil
+	}
+
+	vmDisks := make(map[string]virtv1.Disk)
+	for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+		vmDisks[disk.Name] = disk
+	}
+
+	var ops []string
+	for i, vmiDisk := range vmi.Spec.Domain.Devices.Disks {
+		vmDisk, exists := vmDisks[vmiDisk.Name]
+		if !exists || equality.Semantic.DeepEqual(vmDisk.IOTune, vmiDisk.IOTune) {
+			continue
+		}
+		diskOps, err := generateDiskIOTunePatch(i, vmiDisk.IOTune, vmDisk.IOTune)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, diskOps...)
+	}
+	if len(ops) == 0 {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("disk IO throttling should not be changed during VMI migration")
+	}
+
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, controller.GeneratePatchBytes(ops), &v1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update disk IO throttling: %v", err)
+		return err
+	}
+	return nil
+}
+
+func generateDiskIOTunePatch(diskIndex int, currentIOTune, desiredIOTune *virtv1.DiskIOTune) ([]string, error) {
+	path := fmt.Sprintf("/spec/domain/devices/disks/%d/ioTune", diskIndex)
+
+	if currentIOTune == nil {
+		desiredIOTuneJson, err := json.Marshal(desiredIOTune)
+		if err != nil {
+			return nil, err
+		}
+		return []string{fmt.Sprintf(`{ "op": "add", "path": "%s", "value": %s }`, path, string(desiredIOTuneJson))}, nil
+	}
+
+	currentIOTuneJson, err := json.Marshal(currentIOTune)
+	if err != nil {
+		return nil, err
+	}
+	ops := []string{fmt.Sprintf(`{ "op": "test", "path": "%s", "value": %s }`, path, string(currentIOTuneJson))}
+	if desiredIOTune == nil {
+		return append(ops, fmt.Sprintf(`{ "op": "remove", "path": "%s" }`, path)), nil
+	}
+	desiredIOTuneJson, err := json.Marshal(desiredIOTune)
+	if err != nil {
+		return nil, err
+	}
+	return append(ops, fmt.Sprintf(`{ "op": "replace", "path": "%s", "value": %s }`, path, string(desiredIOTuneJson))), nil
+}
+
+func (c *VMController) handleInterfaceBandwidthChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if vm.Spec.LiveUpdateFeatures == nil || vm.Spec.LiveUpdateFeatures.InterfaceBandwidth == nil {
+		return nil
+	}
+
+	// The bandwidth limits of an instancetype are only applied to the VMI
+	if vm.Spec.Instancetype != nil {
+		return nil
+	}
+
+	vmInterfaces := make(map[string]virtv1.Interface)
+	for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+		vmInterfaces[iface.Name] = iface
+	}
+
+	var ops []string
+	for i, vmiInterface := range vmi.Spec.Domain.Devices.Interfaces {
+		vmInterface, exists := vmInterfaces[vmiInterface.Name]
+		if !exists || vmiInterface.SRIOV != nil || equality.Semantic.DeepEqual(vmInterface.Bandwidth, vmiInterface.Bandwidth) {
+			continue
+		}
+		ifaceOps, err := generateInterfaceBandwidthPatch(i, vmiInterface.Bandwidth, vmInterface.Bandwidth)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, ifaceOps...)
+	}
+	if len(ops) == 0 {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("interface bandwidth limits should not be changed during VMI migration")
+	}
+
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, controller.GeneratePatchBytes(ops), &v1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update interface bandwidth limits: %v", err)
+		return err
+	}
+	return nil
+}
+
+func generateInterfaceBandwidthPatch(interfaceIndex int, currentBandwidth, desiredBandwidth *virtv1.InterfaceBandwidth) ([]string, error) {
+	path := fmt.Sprintf("/spec/domain/devices/interfaces/%d/bandwidth", interfaceIndex)
+
+	if currentBandwidth == nil {
+		desiredBandwidthJson, err := json.Marshal(desiredBandwidth)
+		if err != nil {
+			return nil, err
+		}
+		return []string{fmt.Sprintf(`{ "op": "add", "path": "%s", "value": %s }`, path, string(desiredBandwidthJson))}, nil
+	}
+
+	currentBandwidthJson, err := json.Marshal(currentBandwidth)
+	if err != nil {
+		return nil, err
+	}
+	ops := []string{fmt.Sprintf(`{ "op": "test", "path": "%s", "value": %s }`, path, string(currentBandwidthJson))}
+	if desiredBandwidth == nil {
+		return append(ops, fmt.Sprintf(`{ "op": "remove", "path": "%s" }`, path)), nil
+	}
+	desiredBandwidthJson, err := json.Marshal(desiredBandwidth)
+	if err != nil {
+		return nil, err
+	}
+	return append(ops, fmt.Sprintf(`{ "op": "replace", "path": "%s", "value": %s }`, path, string(desiredBandwidthJson))), nil
+}
+
+func (c *VMController) handleInterfaceLinkStateChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	ops := generateInterfaceLinkStatePatch(vm, vmi)
+	if len(ops) == 0 {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("interface link state should not be changed during VMI migration")
+	}
+
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, controller.GeneratePatchBytes(ops), &v1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update interface link state: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (c *VMController) handleFirewallChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if equality.Semantic.DeepEqual(vm.Spec.Template.Spec.Firewall, vmi.Spec.Firewall) {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("firewall rules should not be changed during VMI migration")
+	}
+
+	ops, err := generateFirewallPatch(vmi.Spec.Firewall, vm.Spec.Template.Spec.Firewall)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, controller.GeneratePatchBytes(ops), &v1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update firewall rules: %v", err)
+		return err
+	}
+	return nil
+}
+
+func generateFirewallPatch(currentFirewall, desiredFirewall *virtv1.Firewall) ([]string, error) {
+	const path = "/spec/firewall"
+
+	if currentFirewall == nil {
+		desiredFirewallJson, err := json.Marshal(desiredFirewall)
+		if err != nil {
+			return nil, err
+		}
+		return []string{fmt.Sprintf(`{ "op": "add", "path": "%s", "value": %s }`, path, string(desiredFirewallJson))}, nil
+	}
+
+	currentFirewallJson, err := json.Marshal(currentFirewall)
+	if err != nil {
+		return nil, err
+	}
+	ops := []string{fmt.Sprintf(`{ "op": "test", "path": "%s", "value": %s }`, path, string(currentFirewallJson))}
+	if desiredFirewall == nil {
+		return append(ops, fmt.Sprintf(`{ "op": "remove", "path": "%s" }`, path)), nil
+	}
+	desiredFirewallJson, err := json.Marshal(desiredFirewall)
+	if err != nil {
+		return nil, err
+	}
+	return append(ops, fmt.Sprintf(`{ "op": "replace", "path": "%s", "value": %s }`, path, string(desiredFirewallJson))), nil
+}
+
 func (c *VMController) handleMemoryDumpRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vm.Status.MemoryDumpRequest == nil {
 		return nil
@@ -1597,6 +1819,7 @@ func (c *VMController) setupVMIFromVM(vm *virtv1.VirtualMachine) *virtv1.Virtual
 	}
 
 	setupStableFirmwareUUID(vm, vmi)
+	setupStableGenerationID(vm, vmi)
 
 	// TODO check if vmi labels exist, and when make sure that they match. For now just override them
 	vmi.ObjectMeta.Labels = vm.Spec.Template.ObjectMeta.Labels
@@ -1708,6 +1931,18 @@ func setupStableFirmwareUUID(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachi
 	vmi.Spec.Domain.Firmware.UUID = types.UID(uuid.NewSHA1(firmwareUUIDns, []byte(vmi.ObjectMeta.Name)).String())
 }
 
+// setupStableGenerationID makes sure the VM Generation ID of the VirtualMachineInstance being started
+// does not change across reboots. It is derived from the VirtualMachine UID, so that a new
+// VirtualMachine, e.g. a clone, gets a new ID.
+func setupStableGenerationID(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	firmware := vmi.Spec.Domain.Firmware
+	if firmware == nil || firmware.GenerationID == nil || firmware.GenerationID.UUID != "" {
+		return
+	}
+
+	firmware.GenerationID.UUID = types.UID(uuid.NewSHA1(firmwareUUIDns, []byte(vm.UID)).String())
+}
+
 func (c *VMController) setupCPUHotplug(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, VMIDefaults *virtv1.VirtualMachineInstance, maxRatio uint32) {
 	if vm.Spec.LiveUpdateFeatures.CPU == nil {
 		return
@@ -2269,7 +2504,9 @@ func (c *VMController) updateStatus(vmOrig *virtv1.VirtualMachine, vmi *virtv1.V
 	vm.Status.Ready = ready
 
 	c.trimDoneVolumeRequests(vm)
+	c.requestGuestCrashMemoryDump(vm, vmi)
 	c.updateMemoryDumpRequest(vm, vmi)
+	volumemigration.SyncVolumeMigrationState(vm, vmi)
 
 	if c.isTrimFirstChangeRequestNeeded(vm, vmi) {
 		vm.Status.StateChangeRequests = vm.Status.StateChangeRequests[1:]
@@ -2628,6 +2865,39 @@ func (c *VMController) isTrimFirstChangeRequestNeeded(vm *virtv1.VirtualMachine,
 	return false
 }
 
+// requestGuestCrashMemoryDump issues a memory dump request when the guest crashed and
+// the panic device asks for the memory of the preserved guest to be captured.
+func (c *VMController) requestGuestCrashMemoryDump(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	if vmi == nil || !c.clusterConfig.HotplugVolumesEnabled() {
+		return
+	}
+	panicDevice := vmi.Spec.Domain.Devices.PanicDevice
+	if panicDevice == nil || panicDevice.OnCrash != virtv1.CrashActionPreserve || panicDevice.MemoryDumpClaimName == "" {
+		return
+	}
+	crashedCond := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, virtv1.VirtualMachineInstanceGuestCrashed)
+	if crashedCond == nil || crashedCond.Status != k8score.ConditionTrue {
+		return
+	}
+
+	if memoryDumpReq := vm.Status.MemoryDumpRequest; memoryDumpReq != nil {
+		// Never interfere with a request of the user and only dump once per crash
+		if memoryDumpReq.ClaimName != panicDevice.MemoryDumpClaimName || memoryDumpReq.Remove ||
+			(memoryDumpReq.Phase != virtv1.MemoryDumpCompleted && memoryDumpReq.Phase != virtv1.MemoryDumpFailed) {
+			return
+		}
+		if memoryDumpReq.EndTimestamp == nil || !memoryDumpReq.EndTimestamp.Before(&crashedCond.LastTransitionTime) {
+			return
+		}
+	}
+
+	log.Log.Object(vm).Infof("Guest crashed, requesting memory dump to claim %s", panicDevice.MemoryDumpClaimName)
+	vm.Status.MemoryDumpRequest = &virtv1.VirtualMachineMemoryDumpRequest{
+		ClaimName: panicDevice.MemoryDumpClaimName,
+		Phase:     virtv1.MemoryDumpAssociating,
+	}
+}
+
 func (c *VMController) updateMemoryDumpRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
 	if vm.Status.MemoryDumpRequest == nil {
 		return
@@ -2888,6 +3158,22 @@ func (c *VMController) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 			syncErr = &syncErrorImpl{fmt.Errorf("Error encountered while handling node affinity change request: %v", err), AffinityChangeErrorReason}
 		}
 
+		if err := c.handleDiskIOTuneChangeRequest(vmCopy, vmi); err != nil {
+			syncErr = &syncErrorImpl{fmt.Errorf("Error encountered while handling disk IO throttling change request: %v", err), DiskIOTuneChangeErrorReason}
+		}
+
+		if err := c.handleInterfaceBandwidthChangeRequest(vmCopy, vmi); err != nil {
+			syncErr = &syncErrorImpl{fmt.Errorf("Error encountered while handling interface bandwidth change request: %v", err), InterfaceBandwidthChangeErrorReason}
+		}
+
+		if err := c.handleInterfaceLinkStateChangeRequest(vmCopy, vmi); err != nil {
+			syncErr = &syncErrorImpl{fmt.Errorf("Error encountered while handling interface link state change request: %v", err), InterfaceLinkStateChangeErrorReason}
+		}
+
+		if err := c.handleFirewallChangeRequest(vmCopy, vmi); err != nil {
+			syncErr = &syncErrorImpl{fmt.Errorf("Error encountered while handling firewall change request: %v", err), FirewallChangeErrorReason}
+		}
+
 		if err := c.handleMemoryHotplugRequest(vmCopy, vmi); err != nil {
 			syncErr = &syncErrorImpl{
 				err:    fmt.Errorf("error encountered while handling memory hotplug requests: %v", err),
@@ -2895,6 +3181,13 @@ func (c *VMController) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 			}
 		}
 
+		if err := c.handleVolumeUpdateRequest(vmCopy, vmi); err != nil {
+			syncErr = &syncErrorImpl{
+				err:    fmt.Errorf("error encountered while handling volumes update requests: %v", err),
+				reason: VolumesUpdateErrorReason,
+			}
+		}
+
 		if syncErr == nil {
 			if !equality.Semantic.DeepEqual(vm, vmCopy) {
 				vm, err = c.clientset.VirtualMachine(vmCopy.Namespace).Update(context.Background(), vmCopy)
@@ -2977,6 +3270,48 @@ func (c *VMController) hasOrdinalNetworkInterfaces(vmi *virtv1.VirtualMachineIns
 	return hasOrdinalIfaces, nil
 }
 
+func (c *VMController) handleVolumeUpdateRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil || !vmi.IsRunning() {
+		return nil
+	}
+
+	if vm.Spec.UpdateVolumesStrategy == nil || *vm.Spec.UpdateVolumesStrategy != virtv1.UpdateVolumesStrategyMigration {
+		return nil
+	}
+
+	if volumemigration.IsRevertRequested(vmi, vm) {
+		if migrations.IsMigrating(vmi) {
+			return fmt.Errorf("the volume migration cannot be reverted while the VMI is migrating")
+		}
+		if err := volumemigration.RevertVMIVolumes(c.clientset, vmi, vm); err != nil {
+			log.Log.Object(vmi).Errorf("unable to patch vmi to revert the volume migration: %v", err)
+			return err
+		}
+		return nil
+	}
+
+	migVolsInfo, err := volumemigration.GenerateMigratedVolumes(c.pvcInformer.GetStore(), vmi, vm)
+	if err != nil {
+		return err
+	}
+	if len(migVolsInfo) == 0 {
+		return nil
+	}
+	if volumemigration.IsVolumeMigrating(vmi) {
+		return fmt.Errorf("the volumes cannot be updated while another volume migration is in progress")
+	}
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("the volumes cannot be updated while the VMI is migrating")
+	}
+
+	if err := volumemigration.PatchVMIVolumes(c.clientset, vmi, vm, migVolsInfo); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update the migrated volumes: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (c *VMController) handleMemoryHotplugRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
 		return nil
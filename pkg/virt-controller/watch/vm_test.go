@@ -2054,6 +2054,39 @@ var _ = Describe("VirtualMachine", func() {
 			Expect(string(vmi1.Spec.Domain.Firmware.UUID)).To(Equal(uid))
 		})
 
+		It("should have stable VM Generation IDs", func() {
+			vm1, _ := DefaultVirtualMachineWithNames(true, "testvm1", "testvmi1")
+			vm1.UID = types.UID(uuid.NewString())
+			vm1.Spec.Template.Spec.Domain.Firmware = &virtv1.Firmware{GenerationID: &virtv1.GenerationID{}}
+			vmi1 := controller.setupVMIFromVM(vm1)
+			Expect(vmi1.Spec.Domain.Firmware.GenerationID.UUID).ToNot(BeEmpty())
+			Expect(vm1.Spec.Template.Spec.Domain.Firmware.GenerationID.UUID).To(BeEmpty())
+
+			// a restart of the same VM
+			Expect(controller.setupVMIFromVM(vm1).Spec.Domain.Firmware.GenerationID.UUID).To(Equal(vmi1.Spec.Domain.Firmware.GenerationID.UUID))
+
+			// a new VM with the same name, e.g. a clone
+			vm2 := vm1.DeepCopy()
+			vm2.UID = types.UID(uuid.NewString())
+			vmi2 := controller.setupVMIFromVM(vm2)
+			Expect(vmi2.Spec.Domain.Firmware.GenerationID.UUID).NotTo(Equal(vmi1.Spec.Domain.Firmware.GenerationID.UUID))
+		})
+
+		It("should honour any VM Generation ID present in the template", func() {
+			uid := uuid.NewString()
+			vm1, _ := DefaultVirtualMachineWithNames(true, "testvm1", "testvmi1")
+			vm1.Spec.Template.Spec.Domain.Firmware = &virtv1.Firmware{GenerationID: &virtv1.GenerationID{UUID: types.UID(uid)}}
+
+			vmi1 := controller.setupVMIFromVM(vm1)
+			Expect(string(vmi1.Spec.Domain.Firmware.GenerationID.UUID)).To(Equal(uid))
+		})
+
+		It("should not add a VM Generation ID by default", func() {
+			vm1, _ := DefaultVirtualMachineWithNames(true, "testvm1", "testvmi1")
+			vmi1 := controller.setupVMIFromVM(vm1)
+			Expect(vmi1.Spec.Domain.Firmware.GenerationID).To(BeNil())
+		})
+
 		It("should delete VirtualMachineInstance when stopped", func() {
 			vm, vmi := DefaultVirtualMachine(false)
 
@@ -2821,6 +2854,89 @@ var _ = Describe("VirtualMachine", func() {
 				controller.Execute()
 			})
 
+			Context("with a crashed guest", func() {
+				addCrashedGuest := func(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, crashTime metav1.Time) {
+					panicDevice := &virtv1.PanicDevice{
+						OnCrash:             virtv1.CrashActionPreserve,
+						MemoryDumpClaimName: testPVCName,
+					}
+					vm.Spec.Template.Spec.Domain.Devices.PanicDevice = panicDevice
+					vmi.Spec.Domain.Devices.PanicDevice = panicDevice
+					vmi.Status.Conditions = append(vmi.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+						Type:               virtv1.VirtualMachineInstanceGuestCrashed,
+						Status:             k8score.ConditionTrue,
+						LastTransitionTime: crashTime,
+					})
+				}
+
+				enableHotplugVolumes := func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								DeveloperConfiguration: &v1.DeveloperConfiguration{
+									FeatureGates: []string{virtconfig.HotplugVolumesGate},
+								},
+							},
+						},
+					})
+				}
+
+				It("should request a memory dump", func() {
+					enableHotplugVolumes()
+					vm, vmi := DefaultVirtualMachine(true)
+					vm.Status.Created = true
+					vm.Status.Ready = true
+					addCrashedGuest(vm, vmi, metav1.Now())
+
+					addVirtualMachine(vm)
+					markAsReady(vmi)
+					vmiFeeder.Add(vmi)
+
+					expectedMemoryDump := &virtv1.VirtualMachineMemoryDumpRequest{
+						ClaimName: testPVCName,
+						Phase:     virtv1.MemoryDumpAssociating,
+					}
+					vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Do(func(ctx context.Context, arg interface{}) {
+						Expect(arg.(*virtv1.VirtualMachine).Status.MemoryDumpRequest).To(Equal(expectedMemoryDump))
+					}).Return(nil, nil)
+
+					controller.Execute()
+				})
+
+				DescribeTable("should not request a memory dump", func(hotplugEnabled bool, memoryDumpReq *virtv1.VirtualMachineMemoryDumpRequest) {
+					if hotplugEnabled {
+						enableHotplugVolumes()
+					}
+					vm, vmi := DefaultVirtualMachine(true)
+					vm.Status.Created = true
+					vm.Status.Ready = true
+					vm.Status.MemoryDumpRequest = memoryDumpReq
+					addCrashedGuest(vm, vmi, metav1.NewTime(time.Now().Add(-time.Hour)))
+
+					addVirtualMachine(vm)
+					markAsReady(vmi)
+					vmiFeeder.Add(vmi)
+
+					vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Do(func(ctx context.Context, arg interface{}) {
+						Expect(arg.(*virtv1.VirtualMachine).Status.MemoryDumpRequest).To(Equal(memoryDumpReq))
+					}).Return(nil, nil).AnyTimes()
+
+					controller.Execute()
+				},
+					Entry("when HotplugVolumes is disabled", false, nil),
+					Entry("when the crash was already dumped", true, &virtv1.VirtualMachineMemoryDumpRequest{
+						ClaimName:    testPVCName,
+						Phase:        virtv1.MemoryDumpCompleted,
+						EndTimestamp: &metav1.Time{Time: time.Now()},
+					}),
+					Entry("when the claim is used by another memory dump", true, &virtv1.VirtualMachineMemoryDumpRequest{
+						ClaimName:    "otherPVC",
+						Phase:        virtv1.MemoryDumpCompleted,
+						EndTimestamp: &metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
+					}),
+				)
+			})
+
 			It("should remove memory dump volume from vm volumes list when status is Dissociating", func() {
 				// No need to add vmi - can do this action even if vm not running
 				vm, _ := DefaultVirtualMachine(false)
@@ -5295,6 +5411,287 @@ var _ = Describe("VirtualMachine", func() {
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
+
+			Context("Disk IO throttling", func() {
+				var vm *virtv1.VirtualMachine
+				var vmi *virtv1.VirtualMachineInstance
+
+				applyPatch := func(patch []byte) *virtv1.VirtualMachineInstance {
+					originalVMIBytes, err := json.Marshal(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					patchJSON, err := jsonpatch.DecodePatch(patch)
+					Expect(err).ToNot(HaveOccurred())
+					newVMIBytes, err := patchJSON.Apply(originalVMIBytes)
+					Expect(err).ToNot(HaveOccurred())
+
+					var newVMI *virtv1.VirtualMachineInstance
+					Expect(json.Unmarshal(newVMIBytes, &newVMI)).To(Succeed())
+					return newVMI
+				}
+
+				BeforeEach(func() {
+					vm, vmi = DefaultVirtualMachine(true)
+					vm.Spec.LiveUpdateFeatures = &virtv1.LiveUpdateFeatures{
+						DiskIOTune: &virtv1.LiveUpdateDiskIOTune{},
+					}
+					vm.Spec.Template.Spec.Domain.Devices.Disks = []virtv1.Disk{{Name: "disk0"}, {Name: "disk1"}}
+					vmi.Spec.Domain.Devices.Disks = []virtv1.Disk{{Name: "disk0"}, {Name: "disk1"}}
+				})
+
+				It("should add the IO throttling to the VMI disk", func() {
+					vm.Spec.Template.Spec.Domain.Devices.Disks[1].IOTune = &virtv1.DiskIOTune{TotalIOPSSec: kvpointer.P(uint64(100))}
+
+					vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).DoAndReturn(func(ctx context.Context, name string, patchType types.PatchType, patch []byte, opts *metav1.PatchOptions, subs ...string) (*virtv1.VirtualMachineInstance, error) {
+						newVMI := applyPatch(patch)
+						Expect(newVMI.Spec.Domain.Devices.Disks[0].IOTune).To(BeNil())
+						Expect(newVMI.Spec.Domain.Devices.Disks[1].IOTune).To(Equal(vm.Spec.Template.Spec.Domain.Devices.Disks[1].IOTune))
+						return newVMI, nil
+					})
+
+					Expect(controller.handleDiskIOTuneChangeRequest(vm, vmi)).To(Succeed())
+				})
+
+				It("should replace and remove the IO throttling of the VMI disks", func() {
+					vm.Spec.Template.Spec.Domain.Devices.Disks[0].IOTune = &virtv1.DiskIOTune{ReadBytesSec: kvpointer.P(uint64(1024))}
+					vmi.Spec.Domain.Devices.Disks[0].IOTune = &virtv1.DiskIOTune{TotalBytesSec: kvpointer.P(uint64(1024))}
+					vmi.Spec.Domain.Devices.Disks[1].IOTune = &virtv1.DiskIOTune{TotalIOPSSec: kvpointer.P(uint64(100))}
+
+					vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).DoAndReturn(func(ctx context.Context, name string, patchType types.PatchType, patch []byte, opts *metav1.PatchOptions, subs ...string) (*virtv1.VirtualMachineInstance, error) {
+						newVMI := applyPatch(patch)
+						Expect(newVMI.Spec.Domain.Devices.Disks[0].IOTune).To(Equal(vm.Spec.Template.Spec.Domain.Devices.Disks[0].IOTune))
+						Expect(newVMI.Spec.Domain.Devices.Disks[1].IOTune).To(BeNil())
+						return newVMI, nil
+					})
+
+					Expect(controller.handleDiskIOTuneChangeRequest(vm, vmi)).To(Succeed())
+				})
+
+				It("should not patch the VMI if the IO throttling did not change", func() {
+					vm.Spec.Template.Spec.Domain.Devices.Disks[0].IOTune = &virtv1.DiskIOTune{TotalIOPSSec: kvpointer.P(uint64(100))}
+					vmi.Spec.Domain.Devices.Disks[0].IOTune = &virtv1.DiskIOTune{TotalIOPSSec: kvpointer.P(uint64(100))}
+
+					Expect(controller.handleDiskIOTuneChangeRequest(vm, vmi)).To(Succeed())
+				})
+
+				It("should not patch the VMI if the VM uses an instancetype", func() {
+					vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: "instancetype"}
+					vmi.Spec.Domain.Devices.Disks[0].IOTune = &virtv1.DiskIOTune{TotalIOPSSec: kvpointer.P(uint64(100))}
+
+					Expect(controller.handleDiskIOTuneChangeRequest(vm, vmi)).To(Succeed())
+				})
+
+				It("should not patch the VMI if a migration is in progress", func() {
+					vm.Spec.Template.Spec.Domain.Devices.Disks[0].IOTune = &virtv1.DiskIOTune{TotalIOPSSec: kvpointer.P(uint64(100))}
+					vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+						StartTimestamp: kvpointer.P(metav1.Now()),
+					}
+
+					Expect(controller.handleDiskIOTuneChangeRequest(vm, vmi)).ToNot(Succeed())
+				})
+			})
+
+			Context("Interface bandwidth", func() {
+				var vm *virtv1.VirtualMachine
+				var vmi *virtv1.VirtualMachineInstance
+
+				applyPatch := func(patch []byte) *virtv1.VirtualMachineInstance {
+					originalVMIBytes, err := json.Marshal(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					patchJSON, err := jsonpatch.DecodePatch(patch)
+					Expect(err).ToNot(HaveOccurred())
+					newVMIBytes, err := patchJSON.Apply(originalVMIBytes)
+					Expect(err).ToNot(HaveOccurred())
+
+					var newVMI *virtv1.VirtualMachineInstance
+					Expect(json.Unmarshal(newVMIBytes, &newVMI)).To(Succeed())
+					return newVMI
+				}
+
+				BeforeEach(func() {
+					vm, vmi = DefaultVirtualMachine(true)
+					vm.Spec.LiveUpdateFeatures = &virtv1.LiveUpdateFeatures{
+						InterfaceBandwidth: &virtv1.LiveUpdateInterfaceBandwidth{},
+					}
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces = []virtv1.Interface{{Name: "default"}, {Name: "secondary"}}
+					vmi.Spec.Domain.Devices.Interfaces = []virtv1.Interface{{Name: "default"}, {Name: "secondary"}}
+				})
+
+				It("should add the bandwidth limits to the VMI interface", func() {
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces[1].Bandwidth = &virtv1.InterfaceBandwidth{
+						Inbound: &virtv1.InterfaceBandwidthLimit{Average: 1000},
+					}
+
+					vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).DoAndReturn(func(ctx context.Context, name string, patchType types.PatchType, patch []byte, opts *metav1.PatchOptions, subs ...string) (*virtv1.VirtualMachineInstance, error) {
+						newVMI := applyPatch(patch)
+						Expect(newVMI.Spec.Domain.Devices.Interfaces[0].Bandwidth).To(BeNil())
+						Expect(newVMI.Spec.Domain.Devices.Interfaces[1].Bandwidth).To(Equal(vm.Spec.Template.Spec.Domain.Devices.Interfaces[1].Bandwidth))
+						return newVMI, nil
+					})
+
+					Expect(controller.handleInterfaceBandwidthChangeRequest(vm, vmi)).To(Succeed())
+				})
+
+				It("should replace and remove the bandwidth limits of the VMI interfaces", func() {
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth = &virtv1.InterfaceBandwidth{
+						Outbound: &virtv1.InterfaceBandwidthLimit{Average: 2000},
+					}
+					vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &virtv1.InterfaceBandwidth{
+						Outbound: &virtv1.InterfaceBandwidthLimit{Average: 1000},
+					}
+					vmi.Spec.Domain.Devices.Interfaces[1].Bandwidth = &virtv1.InterfaceBandwidth{
+						Inbound: &virtv1.InterfaceBandwidthLimit{Average: 1000},
+					}
+
+					vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).DoAndReturn(func(ctx context.Context, name string, patchType types.PatchType, patch []byte, opts *metav1.PatchOptions, subs ...string) (*virtv1.VirtualMachineInstance, error) {
+						newVMI := applyPatch(patch)
+						Expect(newVMI.Spec.Domain.Devices.Interfaces[0].Bandwidth).To(Equal(vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth))
+						Expect(newVMI.Spec.Domain.Devices.Interfaces[1].Bandwidth).To(BeNil())
+						return newVMI, nil
+					})
+
+					Expect(controller.handleInterfaceBandwidthChangeRequest(vm, vmi)).To(Succeed())
+				})
+
+				It("should not patch the VMI if the bandwidth limits did not change", func() {
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth = &virtv1.InterfaceBandwidth{
+						Inbound: &virtv1.InterfaceBandwidthLimit{Average: 1000},
+					}
+					vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &virtv1.InterfaceBandwidth{
+						Inbound: &virtv1.InterfaceBandwidthLimit{Average: 1000},
+					}
+
+					Expect(controller.handleInterfaceBandwidthChangeRequest(vm, vmi)).To(Succeed())
+				})
+
+				It("should not patch the VMI if the VM uses an instancetype", func() {
+					vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: "instancetype"}
+					vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &virtv1.InterfaceBandwidth{
+						Inbound: &virtv1.InterfaceBandwidthLimit{Average: 1000},
+					}
+
+					Expect(controller.handleInterfaceBandwidthChangeRequest(vm, vmi)).To(Succeed())
+				})
+
+				It("should not patch the VMI if a migration is in progress", func() {
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth = &virtv1.InterfaceBandwidth{
+						Inbound: &virtv1.InterfaceBandwidthLimit{Average: 1000},
+					}
+					vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+						StartTimestamp: kvpointer.P(metav1.Now()),
+					}
+
+					Expect(controller.handleInterfaceBandwidthChangeRequest(vm, vmi)).ToNot(Succeed())
+				})
+			})
+
+			Context("Interface link state", func() {
+				var vm *virtv1.VirtualMachine
+				var vmi *virtv1.VirtualMachineInstance
+
+				BeforeEach(func() {
+					vm, vmi = DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces = []virtv1.Interface{{Name: "default", State: virtv1.InterfaceStateLinkDown}}
+					vmi.Spec.Domain.Devices.Interfaces = []virtv1.Interface{{Name: "default"}}
+				})
+
+				It("should patch the link state of the VMI interface", func() {
+					vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).DoAndReturn(func(ctx context.Context, name string, patchType types.PatchType, patch []byte, opts *metav1.PatchOptions, subs ...string) (*virtv1.VirtualMachineInstance, error) {
+						originalVMIBytes, err := json.Marshal(vmi)
+						Expect(err).ToNot(HaveOccurred())
+						patchJSON, err := jsonpatch.DecodePatch(patch)
+						Expect(err).ToNot(HaveOccurred())
+						newVMIBytes, err := patchJSON.Apply(originalVMIBytes)
+						Expect(err).ToNot(HaveOccurred())
+
+						var newVMI *virtv1.VirtualMachineInstance
+						Expect(json.Unmarshal(newVMIBytes, &newVMI)).To(Succeed())
+						Expect(newVMI.Spec.Domain.Devices.Interfaces[0].State).To(Equal(virtv1.InterfaceStateLinkDown))
+						return newVMI, nil
+					})
+
+					Expect(controller.handleInterfaceLinkStateChangeRequest(vm, vmi)).To(Succeed())
+				})
+
+				It("should not patch the VMI if a migration is in progress", func() {
+					vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+						StartTimestamp: kvpointer.P(metav1.Now()),
+					}
+
+					Expect(controller.handleInterfaceLinkStateChangeRequest(vm, vmi)).ToNot(Succeed())
+				})
+			})
+
+			Context("Firewall", func() {
+				var vm *virtv1.VirtualMachine
+				var vmi *virtv1.VirtualMachineInstance
+
+				BeforeEach(func() {
+					vm, vmi = DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Firewall = &virtv1.Firewall{
+						Ingress: &virtv1.FirewallRuleSet{
+							Rules: []virtv1.FirewallRule{{Protocol: virtv1.FirewallProtocolTCP, Port: 22, Action: virtv1.FirewallActionAccept}},
+						},
+					}
+				})
+
+				DescribeTable("should patch the firewall of the VMI", func(currentFirewall *virtv1.Firewall) {
+					vmi.Spec.Firewall = currentFirewall
+					vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).DoAndReturn(func(ctx context.Context, name string, patchType types.PatchType, patch []byte, opts *metav1.PatchOptions, subs ...string) (*virtv1.VirtualMachineInstance, error) {
+						originalVMIBytes, err := json.Marshal(vmi)
+						Expect(err).ToNot(HaveOccurred())
+						patchJSON, err := jsonpatch.DecodePatch(patch)
+						Expect(err).ToNot(HaveOccurred())
+						newVMIBytes, err := patchJSON.Apply(originalVMIBytes)
+						Expect(err).ToNot(HaveOccurred())
+
+						var newVMI *virtv1.VirtualMachineInstance
+						Expect(json.Unmarshal(newVMIBytes, &newVMI)).To(Succeed())
+						Expect(newVMI.Spec.Firewall).To(Equal(vm.Spec.Template.Spec.Firewall))
+						return newVMI, nil
+					})
+
+					Expect(controller.handleFirewallChangeRequest(vm, vmi)).To(Succeed())
+				},
+					Entry("when it has no firewall", nil),
+					Entry("when it has a different firewall", &virtv1.Firewall{
+						Egress: &virtv1.FirewallRuleSet{DefaultAction: virtv1.FirewallActionAccept},
+					}),
+				)
+
+				It("should remove the firewall of the VMI", func() {
+					vmi.Spec.Firewall = vm.Spec.Template.Spec.Firewall.DeepCopy()
+					vm.Spec.Template.Spec.Firewall = nil
+					vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).DoAndReturn(func(ctx context.Context, name string, patchType types.PatchType, patch []byte, opts *metav1.PatchOptions, subs ...string) (*virtv1.VirtualMachineInstance, error) {
+						originalVMIBytes, err := json.Marshal(vmi)
+						Expect(err).ToNot(HaveOccurred())
+						patchJSON, err := jsonpatch.DecodePatch(patch)
+						Expect(err).ToNot(HaveOccurred())
+						newVMIBytes, err := patchJSON.Apply(originalVMIBytes)
+						Expect(err).ToNot(HaveOccurred())
+
+						var newVMI *virtv1.VirtualMachineInstance
+						Expect(json.Unmarshal(newVMIBytes, &newVMI)).To(Succeed())
+						Expect(newVMI.Spec.Firewall).To(BeNil())
+						return newVMI, nil
+					})
+
+					Expect(controller.handleFirewallChangeRequest(vm, vmi)).To(Succeed())
+				})
+
+				It("should not patch the VMI if the firewall is unchanged", func() {
+					vmi.Spec.Firewall = vm.Spec.Template.Spec.Firewall.DeepCopy()
+
+					Expect(controller.handleFirewallChangeRequest(vm, vmi)).To(Succeed())
+				})
+
+				It("should not patch the VMI if a migration is in progress", func() {
+					vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+						StartTimestamp: kvpointer.P(metav1.Now()),
+					}
+
+					Expect(controller.handleFirewallChangeRequest(vm, vmi)).ToNot(Succeed())
+				})
+			})
 		})
 
 		Context("CPU topology", func() {
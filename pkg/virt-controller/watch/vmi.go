@@ -55,6 +55,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/sriov"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	volumemigration "kubevirt.io/kubevirt/pkg/storage/volume-migration"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
 	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
@@ -363,6 +364,12 @@ func (c *VMIController) execute(key string) error {
 		return nil
 	}
 
+	// A VMI which waits to receive a migration from another cluster is managed by
+	// the cross-cluster migration controller until it is handed over to its node
+	if _, receiving := vmi.Annotations[virtv1.MigrationReceiverAnnotation]; receiving && vmi.DeletionTimestamp == nil {
+		return nil
+	}
+
 	// If needsSync is true (expectations fulfilled) we can make save assumptions if virt-handler or virt-controller owns the pod
 	needsSync := c.podExpectations.SatisfiedExpectations(key) && c.vmiExpectations.SatisfiedExpectations(key)
 
@@ -736,6 +743,8 @@ func (c *VMIController) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8
 			c.syncMemoryHotplug(vmiCopy)
 		}
 
+		syncVolumesChangeCondition(vmiCopy)
+
 	case vmi.IsScheduled():
 		if !vmiPodExists {
 			vmiCopy.Status.Phase = virtv1.Failed
@@ -1761,7 +1770,7 @@ func getHotplugVolumes(vmi *virtv1.VirtualMachineInstance, virtlauncherPod *k8sv
 		podVolumeMap[podVolume.Name] = podVolume
 	}
 	for _, vmiVolume := range vmiVolumes {
-		if _, ok := podVolumeMap[vmiVolume.Name]; !ok && (vmiVolume.DataVolume != nil || vmiVolume.PersistentVolumeClaim != nil || vmiVolume.MemoryDump != nil) {
+		if _, ok := podVolumeMap[vmiVolume.Name]; !ok && (vmiVolume.DataVolume != nil || vmiVolume.PersistentVolumeClaim != nil || vmiVolume.MemoryDump != nil || vmiVolume.Backup != nil) {
 			hotplugVolumes = append(hotplugVolumes, vmiVolume.DeepCopy())
 		}
 	}
@@ -2216,6 +2225,9 @@ func (c *VMIController) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, v
 					ClaimName: volume.Name,
 				}
 			}
+			if volume.Backup != nil && status.BackupVolume == nil {
+				status.BackupVolume = &virtv1.DomainBackupInfo{}
+			}
 			if attachmentPod == nil {
 				if !c.volumeReady(status.Phase) {
 					status.HotplugVolume.AttachPodUID = ""
@@ -2242,7 +2254,7 @@ func (c *VMIController) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, v
 			}
 		}
 
-		if volume.VolumeSource.PersistentVolumeClaim != nil || volume.VolumeSource.DataVolume != nil || volume.VolumeSource.MemoryDump != nil {
+		if volume.VolumeSource.PersistentVolumeClaim != nil || volume.VolumeSource.DataVolume != nil || volume.VolumeSource.MemoryDump != nil || volume.VolumeSource.Backup != nil {
 
 			pvcName := storagetypes.PVCNameFromVirtVolume(&volume)
 
@@ -2250,6 +2262,7 @@ func (c *VMIController) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, v
 			if pvcExists {
 				pvc := pvcInterface.(*k8sv1.PersistentVolumeClaim)
 				status.PersistentVolumeClaimInfo = &virtv1.PersistentVolumeClaimInfo{
+					ClaimName:    pvc.Name,
 					AccessModes:  pvc.Spec.AccessModes,
 					VolumeMode:   pvc.Spec.VolumeMode,
 					Capacity:     pvc.Status.Capacity,
@@ -2426,6 +2439,22 @@ func (c *VMIController) syncHotplugCondition(vmi *virtv1.VirtualMachineInstance,
 
 }
 
+func syncVolumesChangeCondition(vmi *virtv1.VirtualMachineInstance) {
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+	if volumemigration.IsVolumeMigrating(vmi) {
+		if !vmiConditions.HasCondition(vmi, virtv1.VirtualMachineInstanceVolumesChange) {
+			vmiConditions.UpdateCondition(vmi, &virtv1.VirtualMachineInstanceCondition{
+				Type:               virtv1.VirtualMachineInstanceVolumesChange,
+				LastTransitionTime: v1.Now(),
+				Status:             k8sv1.ConditionTrue,
+				Message:            "migrate volumes",
+			})
+		}
+		return
+	}
+	vmiConditions.RemoveCondition(vmi, virtv1.VirtualMachineInstanceVolumesChange)
+}
+
 func (c *VMIController) requireCPUHotplug(vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi.Status.CurrentCPUTopology == nil ||
 		vmi.Spec.Domain.CPU == nil ||
@@ -0,0 +1,143 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package workloadupdater
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/schedule"
+)
+
+type maintenanceWindowState struct {
+	// open is true if outdated workloads may be updated right now
+	open bool
+	// window is the currently open maintenance window, if any
+	window *virtv1.WorkloadUpdateMaintenanceWindow
+	// next is the time when the next maintenance window opens, if any
+	next *time.Time
+}
+
+func getMaintenanceWindowState(windows []virtv1.WorkloadUpdateMaintenanceWindow, now time.Time) maintenanceWindowState {
+	if len(windows) == 0 {
+		return maintenanceWindowState{open: true}
+	}
+
+	state := maintenanceWindowState{}
+	for i := range windows {
+		window := &windows[i]
+		s, err := schedule.Parse(window.Schedule)
+		if err != nil {
+			log.Log.Reason(err).Warningf("Ignoring invalid workload update maintenance window %q", window.Schedule)
+			continue
+		}
+
+		if !state.open && s.IsActive(now, window.Duration.Duration) {
+			state.open = true
+			state.window = window
+		}
+
+		if next := s.Next(now); !next.IsZero() && (state.next == nil || next.Before(*state.next)) {
+			state.next = &next
+		}
+	}
+
+	return state
+}
+
+func (s maintenanceWindowState) batchMigrationSize() *int {
+	if s.window == nil {
+		return nil
+	}
+	return s.window.BatchMigrationSize
+}
+
+func (s maintenanceWindowState) batchEvictionSize() *int {
+	if s.window == nil {
+		return nil
+	}
+	return s.window.BatchEvictionSize
+}
+
+// newWorkloadUpdateStatus reports the progress of the current workload update. The
+// estimated completion assumes that the remaining workloads are updated at the same
+// average rate as the ones updated so far, including the time outside of maintenance windows.
+// It is only recomputed when the number of remaining workloads or the maintenance window
+// changes, so that it does not move on every sync while the update stalls.
+func newWorkloadUpdateStatus(kv *virtv1.KubeVirt, data *updateData, state maintenanceWindowState, now time.Time) *virtv1.KubeVirtWorkloadUpdateStatus {
+	pending := len(data.allOutdatedVMIs) - data.numOptedOut
+	if pending == 0 && data.numOptedOut == 0 && len(kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows) == 0 {
+		return nil
+	}
+
+	// the status is stored with a precision of seconds, truncate to avoid needless updates
+	now = now.Truncate(time.Second)
+
+	status := &virtv1.KubeVirtWorkloadUpdateStatus{
+		OptedOutWorkloads:   data.numOptedOut,
+		InMaintenanceWindow: state.open,
+	}
+	if state.next != nil {
+		next := metav1.NewTime(*state.next)
+		status.NextMaintenanceWindow = &next
+	}
+
+	if pending == 0 {
+		return status
+	}
+
+	previous := kv.Status.WorkloadUpdateStatus
+	if previous != nil && previous.StartTimestamp != nil {
+		status.StartTimestamp = previous.StartTimestamp.DeepCopy()
+		status.TotalWorkloads = previous.TotalWorkloads
+	} else {
+		start := metav1.NewTime(now)
+		status.StartTimestamp = &start
+	}
+	if status.TotalWorkloads < pending {
+		status.TotalWorkloads = pending
+	}
+	status.UpdatedWorkloads = status.TotalWorkloads - pending
+
+	if previous != nil && previous.EstimatedCompletionTimestamp != nil && isSameProgress(previous, status) {
+		status.EstimatedCompletionTimestamp = previous.EstimatedCompletionTimestamp.DeepCopy()
+		return status
+	}
+
+	elapsed := now.Sub(status.StartTimestamp.Time)
+	if status.UpdatedWorkloads > 0 && elapsed > 0 {
+		remaining := time.Duration(float64(elapsed) * float64(pending) / float64(status.UpdatedWorkloads))
+		estimation := metav1.NewTime(now.Add(remaining).Truncate(time.Second))
+		status.EstimatedCompletionTimestamp = &estimation
+	}
+
+	return status
+}
+
+func isSameProgress(previous, current *virtv1.KubeVirtWorkloadUpdateStatus) bool {
+	return previous.TotalWorkloads == current.TotalWorkloads &&
+		previous.UpdatedWorkloads == current.UpdatedWorkloads &&
+		previous.InMaintenanceWindow == current.InMaintenanceWindow &&
+		previous.NextMaintenanceWindow.Equal(current.NextMaintenanceWindow)
+}
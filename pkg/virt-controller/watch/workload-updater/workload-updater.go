@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 
@@ -76,9 +78,11 @@ type WorkloadUpdateController struct {
 	recorder              record.EventRecorder
 	migrationExpectations *controller.UIDTrackingControllerExpectations
 	kubeVirtInformer      cache.SharedIndexInformer
+	namespaceStore        cache.Store
 	clusterConfig         *virtconfig.ClusterConfig
 	statusUpdater         *status.KVStatusUpdater
 	launcherImage         string
+	clock                 clock.Clock
 
 	lastDeletionBatch time.Time
 }
@@ -88,7 +92,9 @@ type updateData struct {
 	migratableOutdatedVMIs []*virtv1.VirtualMachineInstance
 	evictOutdatedVMIs      []*virtv1.VirtualMachineInstance
 
-	numActiveMigrations int
+	numActiveMigrations               int
+	numActiveWorkloadUpdateMigrations int
+	numOptedOut                       int
 }
 
 func NewWorkloadUpdateController(
@@ -97,6 +103,7 @@ func NewWorkloadUpdateController(
 	podInformer cache.SharedIndexInformer,
 	migrationInformer cache.SharedIndexInformer,
 	kubeVirtInformer cache.SharedIndexInformer,
+	namespaceStore cache.Store,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -113,12 +120,14 @@ func NewWorkloadUpdateController(
 		podInformer:           podInformer,
 		migrationInformer:     migrationInformer,
 		kubeVirtInformer:      kubeVirtInformer,
+		namespaceStore:        namespaceStore,
 		recorder:              recorder,
 		clientset:             clientset,
 		statusUpdater:         status.NewKubeVirtStatusUpdater(clientset),
 		launcherImage:         launcherImage,
 		migrationExpectations: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		clusterConfig:         clusterConfig,
+		clock:                 clock.RealClock{},
 	}
 
 	_, err := c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -218,7 +227,7 @@ func (c *WorkloadUpdateController) updateVmi(_, obj interface{}) {
 		return
 	}
 
-	if !isHotplugInProgress(vmi) || migrationutils.IsMigrating(vmi) {
+	if !(isHotplugInProgress(vmi) || isVolumeMigrationInProgress(vmi)) || migrationutils.IsMigrating(vmi) {
 		return
 	}
 
@@ -322,6 +331,18 @@ func isHotplugInProgress(vmi *virtv1.VirtualMachineInstance) bool {
 		condManager.HasCondition(vmi, virtv1.VirtualMachineInstanceMemoryChange)
 }
 
+func isVolumeMigrationInProgress(vmi *virtv1.VirtualMachineInstance) bool {
+	return controller.NewVirtualMachineInstanceConditionManager().HasCondition(vmi, virtv1.VirtualMachineInstanceVolumesChange)
+}
+
+func (c *WorkloadUpdateController) isOptedOut(vmi *virtv1.VirtualMachineInstance) bool {
+	obj, exists, err := c.namespaceStore.GetByKey(vmi.Namespace)
+	if err != nil || !exists {
+		return false
+	}
+	return obj.(*k8sv1.Namespace).Labels[virtv1.WorkloadUpdateOptOutLabel] == "true"
+}
+
 func (c *WorkloadUpdateController) doesRequireMigration(vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi.IsFinal() || migrationutils.IsMigrating(vmi) {
 		return false
@@ -331,10 +352,14 @@ func (c *WorkloadUpdateController) doesRequireMigration(vmi *virtv1.VirtualMachi
 		return true
 	}
 
+	if isVolumeMigrationInProgress(vmi) {
+		return true
+	}
+
 	return false
 }
 
-func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateData {
+func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt, inMaintenanceWindow bool) *updateData {
 	data := &updateData{}
 
 	lookup := make(map[string]bool)
@@ -343,6 +368,9 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 
 	for _, migration := range migrations {
 		lookup[migration.Namespace+"/"+migration.Spec.VMIName] = true
+		if _, ok := migration.Annotations[virtv1.WorkloadUpdateMigrationAnnotation]; ok {
+			data.numActiveWorkloadUpdateMigrations++
+		}
 	}
 
 	automatedMigrationAllowed := false
@@ -371,6 +399,15 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 
 		data.allOutdatedVMIs = append(data.allOutdatedVMIs, vmi)
 
+		// live updates requested on the VMI are not subject to the
+		// namespace opt-out and the maintenance windows, only the
+		// updates of outdated virt-launcher environments are
+		isLiveUpdate := isHotplugInProgress(vmi) || isVolumeMigrationInProgress(vmi)
+		if !isLiveUpdate && c.isOptedOut(vmi) {
+			data.numOptedOut++
+			continue
+		}
+
 		// don't consider VMIs with migrations inflight as migratable for our dataset
 		// while a migrating workload can still be counted towards
 		// the outDatedVMIs list, we don't want to add it to any
@@ -379,10 +416,15 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 			continue
 		} else if exists := lookup[vmi.Namespace+"/"+vmi.Name]; exists {
 			continue
+		} else if !isLiveUpdate && !inMaintenanceWindow {
+			continue
 		}
 
 		if automatedMigrationAllowed && vmi.IsMigratable() {
 			data.migratableOutdatedVMIs = append(data.migratableOutdatedVMIs, vmi)
+		} else if isVolumeMigrationInProgress(vmi) {
+			// evicting the VMI would restart it on the destination volumes before their content is copied
+			continue
 		} else if automatedShutdownAllowed {
 			data.evictOutdatedVMIs = append(data.evictOutdatedVMIs, vmi)
 		}
@@ -422,7 +464,10 @@ func (c *WorkloadUpdateController) execute(key string) error {
 
 func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
-	data := c.getUpdateData(kv)
+	now := c.clock.Now()
+	windowState := getMaintenanceWindowState(kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows, now)
+
+	data := c.getUpdateData(kv, windowState.open)
 
 	key, err := controller.KeyFunc(kv)
 	if err != nil {
@@ -431,6 +476,8 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
 	outdatedVirtualMachineInstanceWorkloads.Set(float64(len(data.allOutdatedVMIs)))
 
+	var patchOps []string
+
 	// update outdated workload count on kv
 	if kv.Status.OutdatedVirtualMachineInstanceWorkloads == nil || *kv.Status.OutdatedVirtualMachineInstanceWorkloads != len(data.allOutdatedVMIs) {
 		l := len(data.allOutdatedVMIs)
@@ -447,19 +494,28 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 			return err
 		}
 
-		patch := ""
 		if kv.Status.OutdatedVirtualMachineInstanceWorkloads == nil {
 			update := fmt.Sprintf(`{ "op": "add", "path": "/status/outdatedVirtualMachineInstanceWorkloads", "value": %s}`, string(newJson))
-			patch = fmt.Sprintf("[%s]", update)
+			patchOps = append(patchOps, update)
 		} else {
 			test := fmt.Sprintf(`{ "op": "test", "path": "/status/outdatedVirtualMachineInstanceWorkloads", "value": %s}`, string(oldJson))
 			update := fmt.Sprintf(`{ "op": "replace", "path": "/status/outdatedVirtualMachineInstanceWorkloads", "value": %s}`, string(newJson))
-			patch = fmt.Sprintf("[%s, %s]", test, update)
+			patchOps = append(patchOps, test, update)
 		}
+	}
 
+	// update the workload update progress on kv
+	workloadUpdateStatusOps, err := workloadUpdateStatusPatchOps(kv.Status.WorkloadUpdateStatus, newWorkloadUpdateStatus(kv, data, windowState, now))
+	if err != nil {
+		return err
+	}
+	patchOps = append(patchOps, workloadUpdateStatusOps...)
+
+	if len(patchOps) > 0 {
+		patch := fmt.Sprintf("[%s]", strings.Join(patchOps, ", "))
 		err = c.statusUpdater.PatchStatus(kv, types.JSONPatchType, []byte(patch))
 		if err != nil {
-			return fmt.Errorf("unable to patch kubevirt obj status to update the outdatedVirtualMachineInstanceWorkloads valued: %v", err)
+			return fmt.Errorf("unable to patch kubevirt obj status to update the workload update status: %v", err)
 		}
 	}
 
@@ -468,6 +524,9 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 	// when we don't need to be that efficent in how quickly the updates are being processed.
 	if len(data.evictOutdatedVMIs) != 0 || len(data.migratableOutdatedVMIs) != 0 {
 		c.queue.AddAfter(key, periodicReEnqueueIntervalSeconds)
+	} else if !windowState.open && windowState.next != nil && len(data.allOutdatedVMIs) > data.numOptedOut {
+		// wake up once the next maintenance window opens
+		c.queue.AddAfter(key, windowState.next.Sub(now))
 	}
 
 	// Randomizes list so we don't always re-attempt the same vmis in
@@ -479,7 +538,9 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 	batchDeletionInterval := time.Duration(defaultBatchDeletionIntervalSeconds) * time.Second
 	batchDeletionCount := defaultBatchDeletionCount
 
-	if kv.Spec.WorkloadUpdateStrategy.BatchEvictionSize != nil {
+	if size := windowState.batchEvictionSize(); size != nil {
+		batchDeletionCount = *size
+	} else if kv.Spec.WorkloadUpdateStrategy.BatchEvictionSize != nil {
 		batchDeletionCount = *kv.Spec.WorkloadUpdateStrategy.BatchEvictionSize
 	}
 
@@ -487,8 +548,6 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 		batchDeletionInterval = kv.Spec.WorkloadUpdateStrategy.BatchEvictionInterval.Duration
 	}
 
-	now := time.Now()
-
 	nextBatch := c.lastDeletionBatch.Add(batchDeletionInterval)
 	if now.After(nextBatch) && len(data.evictOutdatedVMIs) > 0 {
 		batchDeletionCount = int(math.Min(float64(batchDeletionCount), float64(len(data.evictOutdatedVMIs))))
@@ -505,6 +564,9 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 	maxParallelMigrations := int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster)
 
 	maxNewMigrations := maxParallelMigrations - data.numActiveMigrations
+	if size := windowState.batchMigrationSize(); size != nil && *size-data.numActiveWorkloadUpdateMigrations < maxNewMigrations {
+		maxNewMigrations = *size - data.numActiveWorkloadUpdateMigrations
+	}
 	if maxNewMigrations < 0 {
 		maxNewMigrations = 0
 	}
@@ -595,3 +657,33 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
 	return nil
 }
+
+func workloadUpdateStatusPatchOps(oldStatus, newStatus *virtv1.KubeVirtWorkloadUpdateStatus) ([]string, error) {
+	const path = "/status/workloadUpdateStatus"
+
+	oldJson, err := json.Marshal(oldStatus)
+	if err != nil {
+		return nil, err
+	}
+	newJson, err := json.Marshal(newStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case string(oldJson) == string(newJson):
+		return nil, nil
+	case oldStatus == nil:
+		return []string{fmt.Sprintf(`{ "op": "add", "path": "%s", "value": %s}`, path, string(newJson))}, nil
+	case newStatus == nil:
+		return []string{
+			fmt.Sprintf(`{ "op": "test", "path": "%s", "value": %s}`, path, string(oldJson)),
+			fmt.Sprintf(`{ "op": "remove", "path": "%s"}`, path),
+		}, nil
+	default:
+		return []string{
+			fmt.Sprintf(`{ "op": "test", "path": "%s", "value": %s}`, path, string(oldJson)),
+			fmt.Sprintf(`{ "op": "replace", "path": "%s", "value": %s}`, path, string(newJson)),
+		}, nil
+	}
+}
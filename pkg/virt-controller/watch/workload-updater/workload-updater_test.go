@@ -10,11 +10,13 @@ import (
 	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	framework "k8s.io/client-go/tools/cache/testing"
 	"k8s.io/client-go/tools/record"
+	testingclock "k8s.io/utils/clock/testing"
 
 	"kubevirt.io/client-go/api"
 
@@ -48,6 +50,10 @@ var _ = Describe("Workload Updater", func() {
 	var migrationSource *framework.FakeControllerSource
 	var kubeVirtSource *framework.FakeControllerSource
 	var kubeVirtInformer cache.SharedIndexInformer
+	var namespaceInformer cache.SharedIndexInformer
+	var namespaceSource *framework.FakeControllerSource
+	var fakeClock *testingclock.FakeClock
+	var statusPatches []string
 	var recorder *record.FakeRecorder
 	var mockQueue *testutils.MockWorkQueue
 	var kubeClient *fake.Clientset
@@ -62,11 +68,13 @@ var _ = Describe("Workload Updater", func() {
 		go podInformer.Run(stop)
 		go migrationInformer.Run(stop)
 		go kubeVirtInformer.Run(stop)
+		go namespaceInformer.Run(stop)
 
 		Expect(cache.WaitForCacheSync(stop,
 			vmiInformer.HasSynced,
 			migrationInformer.HasSynced,
 			kubeVirtInformer.HasSynced,
+			namespaceInformer.HasSynced,
 		)).To(BeTrue())
 	}
 
@@ -113,8 +121,11 @@ var _ = Describe("Workload Updater", func() {
 
 		kubeVirtInformer, _ = testutils.NewFakeInformerFor(&v1.KubeVirt{})
 		kubeVirtInformer, kubeVirtSource = testutils.NewFakeInformerFor(&v1.KubeVirt{})
+		namespaceInformer, namespaceSource = testutils.NewFakeInformerFor(&k8sv1.Namespace{})
 
-		controller, _ = NewWorkloadUpdateController(expectedImage, vmiInformer, podInformer, migrationInformer, kubeVirtInformer, recorder, virtClient, config)
+		controller, _ = NewWorkloadUpdateController(expectedImage, vmiInformer, podInformer, migrationInformer, kubeVirtInformer, namespaceInformer.GetStore(), recorder, virtClient, config)
+		fakeClock = testingclock.NewFakeClock(time.Now())
+		controller.clock = fakeClock
 		mockQueue = testutils.NewMockWorkQueue(controller.queue)
 		controller.queue = mockQueue
 		migrationFeeder = testutils.NewMigrationFeeder(mockQueue, migrationSource)
@@ -123,6 +134,11 @@ var _ = Describe("Workload Updater", func() {
 		virtClient.EXPECT().VirtualMachineInstanceMigration(v12.NamespaceDefault).Return(migrationInterface).AnyTimes()
 		virtClient.EXPECT().VirtualMachineInstance(v12.NamespaceDefault).Return(vmiInterface).AnyTimes()
 		virtClient.EXPECT().KubeVirt(v12.NamespaceDefault).Return(kubeVirtInterface).AnyTimes()
+		statusPatches = nil
+		kubeVirtInterface.EXPECT().PatchStatus(gomock.Any(), types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, _ types.PatchType, data []byte, _ *metav1.PatchOptions) (*v1.KubeVirt, error) {
+			statusPatches = append(statusPatches, string(data))
+			return nil, nil
+		}).AnyTimes()
 		kubeClient = fake.NewSimpleClientset()
 		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
 		virtClient.EXPECT().PolicyV1().Return(kubeClient.PolicyV1()).AnyTimes()
@@ -197,12 +213,6 @@ var _ = Describe("Workload Updater", func() {
 			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate, v1.WorkloadUpdateMethodEvict}
 			addKubeVirt(kv)
 
-			kubeVirtInterface.EXPECT().PatchStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Do(func(name string, pt types.PatchType, data []byte, patchOptions *metav1.PatchOptions) {
-				str := string(data)
-				Expect(str).To(Equal("[{ \"op\": \"test\", \"path\": \"/status/outdatedVirtualMachineInstanceWorkloads\", \"value\": 0}, { \"op\": \"replace\", \"path\": \"/status/outdatedVirtualMachineInstanceWorkloads\", \"value\": 100}]"))
-
-			}).Return(nil, nil).Times(1)
-
 			migrationInterface.EXPECT().Create(gomock.Any(), &metav1.CreateOptions{}).Return(&v1.VirtualMachineInstanceMigration{ObjectMeta: v13.ObjectMeta{Name: "something"}}, nil).Times(int(virtconfig.ParallelMigrationsPerClusterDefault))
 
 			evictionCount := 0
@@ -220,6 +230,9 @@ var _ = Describe("Workload Updater", func() {
 			Expect(dto.GetGauge().Value).To(Equal(&val))
 			Expect(evictionCount).To(Equal(defaultBatchDeletionCount))
 
+			Expect(statusPatches).To(HaveLen(1))
+			Expect(statusPatches[0]).To(HavePrefix("[{ \"op\": \"test\", \"path\": \"/status/outdatedVirtualMachineInstanceWorkloads\", \"value\": 0}, { \"op\": \"replace\", \"path\": \"/status/outdatedVirtualMachineInstanceWorkloads\", \"value\": 100}"))
+
 		})
 
 		It("should migrate VMIs up to the global max migration count and delete up to delete batch count", func() {
@@ -431,10 +444,10 @@ var _ = Describe("Workload Updater", func() {
 			controller.Execute()
 			Expect(recorder.Events).To(BeEmpty())
 
-			// sleep to account for batch interval
-			time.Sleep(3 * time.Second)
+			// step the clock to account for batch interval
+			fakeClock.Step(3 * time.Second)
 
-			// Should execute another batch of deletions after sleep
+			// Should execute another batch of deletions after the interval
 			addKubeVirt(kv)
 			controller.Execute()
 			testutils.ExpectEvents(recorder, reasons...)
@@ -443,6 +456,227 @@ var _ = Describe("Workload Updater", func() {
 
 	})
 
+	Context("maintenance windows", func() {
+		// Wednesday
+		now := time.Date(2024, time.May, 15, 10, 30, 0, 0, time.UTC)
+
+		newKubeVirtWithWindow := func(expectedNumOutdated int, schedule string) *v1.KubeVirt {
+			kv := newKubeVirt(expectedNumOutdated)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate, v1.WorkloadUpdateMethodEvict}
+			kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []v1.WorkloadUpdateMaintenanceWindow{{
+				Schedule: schedule,
+				Duration: metav1.Duration{Duration: time.Hour},
+			}}
+			return kv
+		}
+
+		reportedWorkloadUpdateStatus := func() *v1.KubeVirtWorkloadUpdateStatus {
+			ExpectWithOffset(1, statusPatches).To(HaveLen(1))
+			var ops []struct {
+				Op    string                           `json:"op"`
+				Path  string                           `json:"path"`
+				Value *v1.KubeVirtWorkloadUpdateStatus `json:"value"`
+			}
+			ExpectWithOffset(1, json.Unmarshal([]byte(statusPatches[0]), &ops)).To(Succeed())
+			for _, op := range ops {
+				if op.Path == "/status/workloadUpdateStatus" && op.Op != "test" {
+					return op.Value
+				}
+			}
+			return nil
+		}
+
+		BeforeEach(func() {
+			fakeClock.SetTime(now)
+		})
+
+		It("should not update outdated VMIs outside of the maintenance windows", func() {
+			newVirtualMachine("testvm-migratable", true, "madeup", vmiSource, podSource)
+			newVirtualMachine("testvm-non-migratable", false, "madeup", vmiSource, podSource)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 2)
+			addKubeVirt(newKubeVirtWithWindow(2, "0 2 * * *"))
+
+			controller.Execute()
+			Expect(recorder.Events).To(BeEmpty())
+
+			status := reportedWorkloadUpdateStatus()
+			Expect(status).ToNot(BeNil())
+			Expect(status.InMaintenanceWindow).To(BeFalse())
+			Expect(status.NextMaintenanceWindow.Time).To(BeTemporally("==", time.Date(2024, time.May, 16, 2, 0, 0, 0, time.UTC)))
+			Expect(status.TotalWorkloads).To(Equal(2))
+			Expect(status.UpdatedWorkloads).To(BeZero())
+			Expect(status.StartTimestamp.Time).To(BeTemporally("==", now))
+		})
+
+		It("should update outdated VMIs within a maintenance window with its batch sizes", func() {
+			batchMigrationSize := 2
+			batchEvictionSize := 3
+			reasons := []string{}
+			for i := 0; i < 10; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup", vmiSource, podSource)
+				newVirtualMachine(fmt.Sprintf("testvm-non-migratable-%d", i), false, "madeup", vmiSource, podSource)
+			}
+			for i := 0; i < batchMigrationSize; i++ {
+				reasons = append(reasons, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			}
+			for i := 0; i < batchEvictionSize; i++ {
+				reasons = append(reasons, SuccessfulEvictVirtualMachineInstanceReason)
+			}
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 20)
+
+			kv := newKubeVirtWithWindow(20, "0 10 * * *")
+			kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows[0].BatchMigrationSize = &batchMigrationSize
+			kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows[0].BatchEvictionSize = &batchEvictionSize
+			addKubeVirt(kv)
+
+			migrationInterface.EXPECT().Create(gomock.Any(), &metav1.CreateOptions{}).Return(&v1.VirtualMachineInstanceMigration{ObjectMeta: v13.ObjectMeta{Name: "something"}}, nil).Times(batchMigrationSize)
+			evictionCount := 0
+			shouldExpectMultiplePodEvictions(&evictionCount)
+
+			controller.Execute()
+			testutils.ExpectEvents(recorder, reasons...)
+			Expect(evictionCount).To(Equal(batchEvictionSize))
+			Expect(reportedWorkloadUpdateStatus().InMaintenanceWindow).To(BeTrue())
+		})
+
+		It("should count in-flight workload update migrations towards the batch size", func() {
+			batchMigrationSize := 2
+			kv := newKubeVirtWithWindow(4, "0 10 * * *")
+			kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows[0].BatchMigrationSize = &batchMigrationSize
+			addKubeVirt(kv)
+
+			vmi := newVirtualMachine("testvm-migrating", true, "madeup", vmiSource, podSource)
+			migration := newMigration("vmim-1", vmi.Name, v1.MigrationRunning)
+			migration.Annotations = map[string]string{v1.WorkloadUpdateMigrationAnnotation: ""}
+			migrationFeeder.Add(migration)
+			for i := 0; i < 3; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup", vmiSource, podSource)
+			}
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 4)
+
+			migrationInterface.EXPECT().Create(gomock.Any(), &metav1.CreateOptions{}).Return(&v1.VirtualMachineInstanceMigration{ObjectMeta: v13.ObjectMeta{Name: "something"}}, nil).Times(1)
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+		})
+
+		It("should migrate VMIs with requested live updates outside of the maintenance windows", func() {
+			vmi := api.NewMinimalVMI("testvm-hotplug")
+			vmi.Namespace = v12.NamespaceDefault
+			vmi.Status.Phase = v1.Running
+			vmi.Status.LauncherContainerImageVersion = expectedImage
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{Type: v1.VirtualMachineInstanceIsMigratable, Status: v12.ConditionTrue},
+				{Type: v1.VirtualMachineInstanceMemoryChange, Status: v12.ConditionTrue},
+			}
+			vmiSource.Add(vmi)
+			newVirtualMachine("testvm-outdated", true, "madeup", vmiSource, podSource)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 2)
+			addKubeVirt(newKubeVirtWithWindow(2, "0 2 * * *"))
+
+			migrationInterface.EXPECT().Create(gomock.Any(), &metav1.CreateOptions{}).DoAndReturn(func(migration *v1.VirtualMachineInstanceMigration, _ *metav1.CreateOptions) (*v1.VirtualMachineInstanceMigration, error) {
+				Expect(migration.Spec.VMIName).To(Equal(vmi.Name))
+				return &v1.VirtualMachineInstanceMigration{ObjectMeta: v13.ObjectMeta{Name: "something"}}, nil
+			}).Times(1)
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+		})
+
+		It("should not update VMIs in namespaces which opted out", func() {
+			namespaceSource.Add(&k8sv1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   v12.NamespaceDefault,
+					Labels: map[string]string{v1.WorkloadUpdateOptOutLabel: "true"},
+				},
+			})
+			Eventually(func() []interface{} {
+				return namespaceInformer.GetStore().List()
+			}, 3*time.Second, 200*time.Millisecond).Should(HaveLen(1))
+
+			newVirtualMachine("testvm-migratable", true, "madeup", vmiSource, podSource)
+			newVirtualMachine("testvm-non-migratable", false, "madeup", vmiSource, podSource)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 2)
+			kv := newKubeVirt(2)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate, v1.WorkloadUpdateMethodEvict}
+			addKubeVirt(kv)
+
+			controller.Execute()
+			Expect(recorder.Events).To(BeEmpty())
+
+			status := reportedWorkloadUpdateStatus()
+			Expect(status).ToNot(BeNil())
+			Expect(status.OptedOutWorkloads).To(Equal(2))
+			Expect(status.TotalWorkloads).To(BeZero())
+			Expect(status.StartTimestamp).To(BeNil())
+		})
+
+		It("should estimate the completion based on the progress so far", func() {
+			start := metav1.NewTime(now.Add(-time.Hour))
+			kv := newKubeVirt(0)
+			kv.Status.WorkloadUpdateStatus = &v1.KubeVirtWorkloadUpdateStatus{
+				StartTimestamp: &start,
+				TotalWorkloads: 40,
+			}
+			data := &updateData{allOutdatedVMIs: make([]*v1.VirtualMachineInstance, 30)}
+
+			status := newWorkloadUpdateStatus(kv, data, maintenanceWindowState{open: true}, now)
+			Expect(status.StartTimestamp).To(Equal(&start))
+			Expect(status.TotalWorkloads).To(Equal(40))
+			Expect(status.UpdatedWorkloads).To(Equal(10))
+			Expect(status.EstimatedCompletionTimestamp.Time).To(BeTemporally("==", now.Add(3*time.Hour)))
+		})
+
+		Context("with a previous estimation", func() {
+			var (
+				kv         *v1.KubeVirt
+				data       *updateData
+				estimation metav1.Time
+			)
+
+			BeforeEach(func() {
+				start := metav1.NewTime(now.Add(-time.Hour))
+				estimation = metav1.NewTime(now.Add(2 * time.Hour))
+				kv = newKubeVirt(0)
+				kv.Status.WorkloadUpdateStatus = &v1.KubeVirtWorkloadUpdateStatus{
+					StartTimestamp:               &start,
+					TotalWorkloads:               40,
+					UpdatedWorkloads:             10,
+					InMaintenanceWindow:          true,
+					EstimatedCompletionTimestamp: &estimation,
+				}
+				data = &updateData{allOutdatedVMIs: make([]*v1.VirtualMachineInstance, 30)}
+			})
+
+			It("should keep it while the progress and the maintenance window do not change", func() {
+				status := newWorkloadUpdateStatus(kv, data, maintenanceWindowState{open: true}, now)
+				Expect(status.EstimatedCompletionTimestamp).To(Equal(&estimation))
+			})
+
+			It("should recompute it once more workloads are updated", func() {
+				data.allOutdatedVMIs = data.allOutdatedVMIs[:20]
+				status := newWorkloadUpdateStatus(kv, data, maintenanceWindowState{open: true}, now)
+				Expect(status.EstimatedCompletionTimestamp.Time).To(BeTemporally("==", now.Add(time.Hour)))
+			})
+
+			It("should recompute it once the maintenance window closes", func() {
+				status := newWorkloadUpdateStatus(kv, data, maintenanceWindowState{}, now)
+				Expect(status.EstimatedCompletionTimestamp.Time).To(BeTemporally("==", now.Add(3*time.Hour)))
+			})
+		})
+
+		It("should clear the progress once all VMIs are updated", func() {
+			start := metav1.NewTime(now.Add(-time.Hour))
+			kv := newKubeVirt(0)
+			kv.Status.WorkloadUpdateStatus = &v1.KubeVirtWorkloadUpdateStatus{
+				StartTimestamp: &start,
+				TotalWorkloads: 40,
+			}
+
+			Expect(newWorkloadUpdateStatus(kv, &updateData{}, maintenanceWindowState{open: true}, now)).To(BeNil())
+		})
+	})
+
 	Context("LiveUpdate features", func() {
 		It("VMI needs to be migrated when memory hotplug is requested", func() {
 			vmi := api.NewMinimalVMI("testvm")
@@ -457,6 +691,20 @@ var _ = Describe("Workload Updater", func() {
 		})
 	})
 
+	Context("Volume migration", func() {
+		It("VMI needs to be migrated when its volumes are updated", func() {
+			vmi := api.NewMinimalVMI("testvm")
+
+			condition := v1.VirtualMachineInstanceCondition{
+				Type:   v1.VirtualMachineInstanceVolumesChange,
+				Status: k8sv1.ConditionTrue,
+			}
+			virtcontroller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &condition)
+
+			Expect(controller.doesRequireMigration(vmi)).To(BeTrue())
+		})
+	})
+
 	AfterEach(func() {
 
 		close(stop)
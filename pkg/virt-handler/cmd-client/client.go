@@ -79,6 +79,17 @@ type MigrationOptions struct {
 	AllowAutoConverge        bool
 	AllowPostCopy            bool
 	ParallelMigrationThreads *uint
+	Escalation               *v1.MigrationEscalation
+	Compression              *v1.MigrationCompression
+	MaxDowntimeMilliseconds  uint64
+}
+
+type BackupOptions struct {
+	BackupName      string
+	CheckpointName  string
+	IncrementalFrom string
+	TargetPath      string
+	Pull            bool
 }
 
 type LauncherClient interface {
@@ -93,6 +104,7 @@ type LauncherClient interface {
 	ShutdownVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	KillVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	MigrateVirtualMachine(vmi *v1.VirtualMachineInstance, options *MigrationOptions) error
+	MigrateVirtualMachineVolumes(vmi *v1.VirtualMachineInstance, options *MigrationOptions) error
 	CancelVirtualMachineMigration(vmi *v1.VirtualMachineInstance) error
 	FinalizeVirtualMachineMigration(vmi *v1.VirtualMachineInstance) error
 	HotplugHostDevices(vmi *v1.VirtualMachineInstance) error
@@ -107,6 +119,8 @@ type LauncherClient interface {
 	GuestPing(string, int32) error
 	Close()
 	VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
+	BackupVirtualMachine(vmi *v1.VirtualMachineInstance, options *BackupOptions) error
+	FinishBackup(vmi *v1.VirtualMachineInstance, backupName string) error
 	GetQemuVersion() (string, error)
 	SyncVirtualMachineCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
@@ -487,6 +501,50 @@ func (c *VirtLauncherClient) VirtualMachineMemoryDump(vmi *v1.VirtualMachineInst
 	return err
 }
 
+func (c *VirtLauncherClient) BackupVirtualMachine(vmi *v1.VirtualMachineInstance, options *BackupOptions) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.BackupRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		BackupName:      options.BackupName,
+		CheckpointName:  options.CheckpointName,
+		IncrementalFrom: options.IncrementalFrom,
+		TargetPath:      options.TargetPath,
+		Pull:            options.Pull,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+	response, err := c.v1client.BackupVirtualMachine(ctx, request)
+	err = handleError(err, "Backup", response)
+	return err
+}
+
+func (c *VirtLauncherClient) FinishBackup(vmi *v1.VirtualMachineInstance, backupName string) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.BackupRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		BackupName: backupName,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+	response, err := c.v1client.FinishBackup(ctx, request)
+	err = handleError(err, "FinishBackup", response)
+	return err
+}
+
 func (c *VirtLauncherClient) SoftRebootVirtualMachine(vmi *v1.VirtualMachineInstance) error {
 	return c.genericSendVMICmd("SoftReboot", c.v1client.SoftRebootVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
@@ -503,7 +561,9 @@ func (c *VirtLauncherClient) DeleteDomain(vmi *v1.VirtualMachineInstance) error
 	return c.genericSendVMICmd("Delete", c.v1client.DeleteVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
 
-func (c *VirtLauncherClient) MigrateVirtualMachine(vmi *v1.VirtualMachineInstance, options *MigrationOptions) error {
+func (c *VirtLauncherClient) genericSendMigrationCmd(cmdName string,
+	cmdFunc func(ctx context.Context, request *cmdv1.MigrationRequest, opts ...grpc.CallOption) (*cmdv1.Response, error),
+	vmi *v1.VirtualMachineInstance, options *MigrationOptions) error {
 
 	vmiJson, err := json.Marshal(vmi)
 	if err != nil {
@@ -524,11 +584,19 @@ func (c *VirtLauncherClient) MigrateVirtualMachine(vmi *v1.VirtualMachineInstanc
 
 	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
 	defer cancel()
-	response, err := c.v1client.MigrateVirtualMachine(ctx, request)
+	response, err := cmdFunc(ctx, request)
 
-	err = handleError(err, "Migrate", response)
-	return err
+	return handleError(err, cmdName, response)
+}
+
+func (c *VirtLauncherClient) MigrateVirtualMachine(vmi *v1.VirtualMachineInstance, options *MigrationOptions) error {
+	return c.genericSendMigrationCmd("Migrate", c.v1client.MigrateVirtualMachine, vmi, options)
+}
 
+// MigrateVirtualMachineVolumes migrates the VMI and copies the content of the volumes listed in the migrated volumes
+// of the VMI status to their destination volumes
+func (c *VirtLauncherClient) MigrateVirtualMachineVolumes(vmi *v1.VirtualMachineInstance, options *MigrationOptions) error {
+	return c.genericSendMigrationCmd("MigrateVolumes", c.v1client.MigrateVirtualMachineVolumes, vmi, options)
 }
 
 func (c *VirtLauncherClient) CancelVirtualMachineMigration(vmi *v1.VirtualMachineInstance) error {
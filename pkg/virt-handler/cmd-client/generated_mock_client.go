@@ -143,6 +143,16 @@ func (_mr *_MockLauncherClientRecorder) MigrateVirtualMachine(arg0, arg1 interfa
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrateVirtualMachine", arg0, arg1)
 }
 
+func (_m *MockLauncherClient) MigrateVirtualMachineVolumes(vmi *v1.VirtualMachineInstance, options *MigrationOptions) error {
+	ret := _m.ctrl.Call(_m, "MigrateVirtualMachineVolumes", vmi, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) MigrateVirtualMachineVolumes(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrateVirtualMachineVolumes", arg0, arg1)
+}
+
 func (_m *MockLauncherClient) CancelVirtualMachineMigration(vmi *v1.VirtualMachineInstance) error {
 	ret := _m.ctrl.Call(_m, "CancelVirtualMachineMigration", vmi)
 	ret0, _ := ret[0].(error)
@@ -290,6 +300,26 @@ func (_mr *_MockLauncherClientRecorder) VirtualMachineMemoryDump(arg0, arg1 inte
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineMemoryDump", arg0, arg1)
 }
 
+func (_m *MockLauncherClient) BackupVirtualMachine(vmi *v1.VirtualMachineInstance, options *BackupOptions) error {
+	ret := _m.ctrl.Call(_m, "BackupVirtualMachine", vmi, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) BackupVirtualMachine(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BackupVirtualMachine", arg0, arg1)
+}
+
+func (_m *MockLauncherClient) FinishBackup(vmi *v1.VirtualMachineInstance, backupName string) error {
+	ret := _m.ctrl.Call(_m, "FinishBackup", vmi, backupName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) FinishBackup(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "FinishBackup", arg0, arg1)
+}
+
 func (_m *MockLauncherClient) GetQemuVersion() (string, error) {
 	ret := _m.ctrl.Call(_m, "GetQemuVersion")
 	ret0, _ := ret[0].(string)
@@ -21,6 +21,7 @@ package migrationproxy
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
 	"net"
@@ -30,10 +31,12 @@ import (
 	"strings"
 	"sync"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 	"kubevirt.io/kubevirt/pkg/util/net/ip"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -46,11 +49,12 @@ const (
 var migrationPortsRange = []int{LibvirtDirectMigrationPort, LibvirtBlockMigrationPort}
 
 type ProxyManager interface {
-	StartTargetListener(key string, targetUnixFiles []string) error
+	StartTargetListener(key string, targetUnixFiles []string, migrationConfig *v1.MigrationConfiguration) error
 	GetTargetListenerPorts(key string) map[string]int
 	StopTargetListener(key string)
 
-	StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string) error
+	StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string, migrationConfig *v1.MigrationConfiguration) error
+	StartSourceTunnelListener(key string, tunnel *v1.VirtualMachineInstanceMigrationTunnel, namespace string, name string, destSrcPortMap map[string]int, baseDir string) error
 	GetSourceListenerFiles(key string) []string
 	StopSourceListener(key string)
 
@@ -81,9 +85,11 @@ type migrationProxy struct {
 	tcpBindPort    int
 	targetAddress  string
 	targetProtocol string
-	stopChan       chan struct{}
-	listenErrChan  chan error
-	fdChan         chan net.Conn
+	// tunnelPath is set if the connections are sent through the migration tunnel at targetAddress
+	tunnelPath    string
+	stopChan      chan struct{}
+	listenErrChan chan error
+	fdChan        chan net.Conn
 
 	listener        net.Listener
 	serverTLSConfig *tls.Config
@@ -128,7 +134,7 @@ func SourceUnixFile(baseDir string, key string) string {
 	return filepath.Join(baseDir, "migrationproxy", key+"-source.sock")
 }
 
-func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles []string) error {
+func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles []string, migrationConfig *v1.MigrationConfiguration) error {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
@@ -171,7 +177,7 @@ func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles
 	proxiesList := []*migrationProxy{}
 	serverTLSConfig := m.serverTLSConfig
 	clientTLSConfig := m.clientTLSConfig
-	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
+	if m.isTLSDisabled(migrationConfig) {
 		serverTLSConfig = nil
 		clientTLSConfig = nil
 	}
@@ -195,6 +201,16 @@ func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles
 	return nil
 }
 
+// isTLSDisabled checks if TLS is disabled for a migration. The migration configuration
+// of the VMI, which contains the settings of its migration policy, takes precedence.
+func (m *migrationProxyManager) isTLSDisabled(migrationConfig *v1.MigrationConfiguration) bool {
+	if migrationConfig != nil && migrationConfig.DisableTLS != nil {
+		return *migrationConfig.DisableTLS
+	}
+	disableTLS := m.config.GetMigrationConfiguration().DisableTLS
+	return disableTLS != nil && *disableTLS
+}
+
 func (m *migrationProxyManager) GetSourceListenerFiles(key string) []string {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
@@ -257,7 +273,65 @@ func (m *migrationProxyManager) StopTargetListener(key string) {
 	}
 }
 
-func (m *migrationProxyManager) StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string) error {
+// sourceDestination is where a source proxy forwards its connections to
+type sourceDestination struct {
+	address    string
+	tunnelPath string
+}
+
+func (m *migrationProxyManager) StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string, migrationConfig *v1.MigrationConfiguration) error {
+	destinations := map[int]sourceDestination{}
+	for destPort, srcPort := range destSrcPortMap {
+		destinations[srcPort] = sourceDestination{address: net.JoinHostPort(targetAddress, destPort)}
+	}
+
+	serverTLSConfig := m.serverTLSConfig
+	clientTLSConfig := m.clientTLSConfig
+	if m.isTLSDisabled(migrationConfig) {
+		serverTLSConfig = nil
+		clientTLSConfig = nil
+	}
+	return m.startSourceProxies(key, destinations, baseDir, serverTLSConfig, clientTLSConfig)
+}
+
+// StartSourceTunnelListener starts the source proxies of a cross-cluster migration, they connect
+// to the target node through the migration tunnel of the target cluster
+func (m *migrationProxyManager) StartSourceTunnelListener(key string, tunnel *v1.VirtualMachineInstanceMigrationTunnel, namespace string, name string, destSrcPortMap map[string]int, baseDir string) error {
+	destinations := map[int]sourceDestination{}
+	for destPort, srcPort := range destSrcPortMap {
+		port, err := strconv.Atoi(destPort)
+		if err != nil {
+			return fmt.Errorf("invalid port %s of the target node: %v", destPort, err)
+		}
+		destinations[srcPort] = sourceDestination{
+			address:    tunnel.Address,
+			tunnelPath: migrations.MigrationTunnelPath(namespace, name, port),
+		}
+	}
+
+	tunnelTLSConfig, err := m.tunnelTLSConfig(tunnel)
+	if err != nil {
+		return err
+	}
+	return m.startSourceProxies(key, destinations, baseDir, nil, tunnelTLSConfig)
+}
+
+// tunnelTLSConfig authenticates virt-handler with its client certificate to the migration tunnel
+// and verifies the certificate of the tunnel with the CA of the target cluster
+func (m *migrationProxyManager) tunnelTLSConfig(tunnel *v1.VirtualMachineInstanceMigrationTunnel) (*tls.Config, error) {
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(tunnel.CABundle) {
+		return nil, fmt.Errorf("the CA bundle of the migration tunnel %s holds no certificate", tunnel.Address)
+	}
+	return &tls.Config{
+		MinVersion:           tls.VersionTLS12,
+		RootCAs:              certPool,
+		ServerName:           tunnel.ServerName,
+		GetClientCertificate: m.clientTLSConfig.GetClientCertificate,
+	}, nil
+}
+
+func (m *migrationProxyManager) startSourceProxies(key string, destinations map[int]sourceDestination, baseDir string, serverTLSConfig *tls.Config, clientTLSConfig *tls.Config) error {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
@@ -265,17 +339,16 @@ func (m *migrationProxyManager) StartSourceListener(key string, targetAddress st
 		return fmt.Errorf("unable to process new migration connections during virt-handler shutdown")
 	}
 
-	isExistingProxy := func(curProxies []*migrationProxy, targetAddress string, destSrcPortMap map[string]int) bool {
-		if len(curProxies) != len(destSrcPortMap) {
+	isExistingProxy := func(curProxies []*migrationProxy, destinations map[int]sourceDestination) bool {
+		if len(curProxies) != len(destinations) {
 			return false
 		}
-		destSrcLookup := make(map[string]int)
-		for dest, src := range destSrcPortMap {
-			addr := net.JoinHostPort(targetAddress, dest)
-			destSrcLookup[addr] = src
+		destinationLookup := make(map[sourceDestination]struct{})
+		for _, destination := range destinations {
+			destinationLookup[destination] = struct{}{}
 		}
 		for _, curProxy := range curProxies {
-			if _, ok := destSrcLookup[curProxy.targetAddress]; !ok {
+			if _, ok := destinationLookup[sourceDestination{address: curProxy.targetAddress, tunnelPath: curProxy.tunnelPath}]; !ok {
 				return false
 			}
 		}
@@ -285,7 +358,7 @@ func (m *migrationProxyManager) StartSourceListener(key string, targetAddress st
 	curProxies, exists := m.sourceProxies[key]
 
 	if exists {
-		if isExistingProxy(curProxies, targetAddress, destSrcPortMap) {
+		if isExistingProxy(curProxies, destinations) {
 			// No Op, already exists
 			return nil
 		} else {
@@ -296,21 +369,18 @@ func (m *migrationProxyManager) StartSourceListener(key string, targetAddress st
 			}
 		}
 	}
-	serverTLSConfig := m.serverTLSConfig
-	clientTLSConfig := m.clientTLSConfig
-	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
-		serverTLSConfig = nil
-		clientTLSConfig = nil
-	}
 	proxiesList := []*migrationProxy{}
-	for destPort, srcPort := range destSrcPortMap {
+	for srcPort, destination := range destinations {
 		proxyKey := ConstructProxyKey(key, srcPort)
-		targetFullAddr := net.JoinHostPort(targetAddress, destPort)
 		filePath := SourceUnixFile(baseDir, proxyKey)
 
 		os.RemoveAll(filePath)
 
-		proxy := NewSourceProxy(filePath, targetFullAddr, serverTLSConfig, clientTLSConfig, key)
+		proxy := NewSourceProxy(filePath, destination.address, serverTLSConfig, clientTLSConfig, key)
+		if destination.tunnelPath != "" {
+			proxy.tunnelPath = destination.tunnelPath
+			proxy.logger = proxy.logger.With("tunnel", destination.tunnelPath)
+		}
 
 		err := proxy.Start()
 		if err != nil {
@@ -444,7 +514,9 @@ func (m *migrationProxy) handleConnection(fd net.Conn) {
 
 	var conn net.Conn
 	var err error
-	if m.targetProtocol == "tcp" && m.clientTLSConfig != nil {
+	if m.tunnelPath != "" {
+		conn, err = dialMigrationTunnel(m.targetAddress, m.tunnelPath, m.clientTLSConfig)
+	} else if m.targetProtocol == "tcp" && m.clientTLSConfig != nil {
 		conn, err = tls.Dial(m.targetProtocol, m.targetAddress, m.clientTLSConfig)
 	} else {
 		conn, err = net.Dial(m.targetProtocol, m.targetAddress)
@@ -453,6 +525,8 @@ func (m *migrationProxy) handleConnection(fd net.Conn) {
 		m.logger.Reason(err).Error("unable to create outbound leg of proxy to host")
 		return
 	}
+	// Parallel migrations open many connections, the outbound leg must not outlive the inbound one
+	defer conn.Close()
 
 	go func() {
 		//from outbound connection to proxy
@@ -20,11 +20,18 @@
 package migrationproxy
 
 import (
+	"bufio"
 	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -33,8 +40,11 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/certificates"
+	"kubevirt.io/kubevirt/pkg/certificates/triple"
+	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
 	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 )
 
 var _ = Describe("MigrationProxy", func() {
@@ -150,6 +160,56 @@ var _ = Describe("MigrationProxy", func() {
 				Expect(num).To(Equal(sentLen))
 			})
 
+			It("by forwarding parallel connections of multifd migrations", func() {
+				const channels = 8
+				sourceSock := filepath.Join(tmpDir, "source-sock")
+				virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
+				virtqemudListener, err := net.Listen("unix", virtqemudSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer virtqemudListener.Close()
+
+				targetProxy := NewTargetProxy("0.0.0.0", 12345, tlsConfig, tlsConfig, virtqemudSock, "123")
+				sourceProxy := NewSourceProxy(sourceSock, "127.0.0.1:12345", tlsConfig, tlsConfig, "123")
+				defer targetProxy.Stop()
+				defer sourceProxy.Stop()
+
+				Expect(targetProxy.Start()).To(Succeed())
+				Expect(sourceProxy.Start()).To(Succeed())
+
+				// All channels are opened before any of them is served, like QEMU does
+				var conns []net.Conn
+				for i := 0; i < channels; i++ {
+					conn, err := net.Dial("unix", sourceSock)
+					Expect(err).ShouldNot(HaveOccurred())
+					defer conn.Close()
+					conns = append(conns, conn)
+				}
+
+				var accepted []net.Conn
+				for i := 0; i < channels; i++ {
+					fd, err := virtqemudListener.Accept()
+					Expect(err).ShouldNot(HaveOccurred())
+					defer fd.Close()
+					accepted = append(accepted, fd)
+				}
+
+				for i, conn := range conns {
+					_, err := conn.Write([]byte(fmt.Sprintf("channel %d", i)))
+					Expect(err).ShouldNot(HaveOccurred())
+				}
+
+				var received []string
+				for _, fd := range accepted {
+					var bytes [1024]byte
+					n, err := fd.Read(bytes[0:])
+					Expect(err).ShouldNot(HaveOccurred())
+					received = append(received, string(bytes[:n]))
+				}
+				for i := 0; i < channels; i++ {
+					Expect(received).To(ContainElement(fmt.Sprintf("channel %d", i)))
+				}
+			})
+
 			DescribeTable("by creating both ends with a manager and sending a message", func(migrationConfig *v1.MigrationConfiguration) {
 				directMigrationPort := "49152"
 				virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
@@ -164,9 +224,9 @@ var _ = Describe("MigrationProxy", func() {
 					MigrationConfiguration: migrationConfig,
 				})
 				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
-				manager.StartTargetListener("mykey", []string{virtqemudSock, directSock})
+				manager.StartTargetListener("mykey", []string{virtqemudSock, directSock}, nil)
 				destSrcPortMap := manager.GetTargetListenerPorts("mykey")
-				manager.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir)
+				manager.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir, nil)
 
 				defer manager.StopTargetListener("myKey")
 				defer manager.StopSourceListener("myKey")
@@ -232,10 +292,10 @@ var _ = Describe("MigrationProxy", func() {
 					MigrationConfiguration: migrationConfig,
 				})
 				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
-				err = manager.StartTargetListener(key1, []string{virtqemudSock, directSock})
+				err = manager.StartTargetListener(key1, []string{virtqemudSock, directSock}, nil)
 				Expect(err).ShouldNot(HaveOccurred())
 				destSrcPortMap := manager.GetTargetListenerPorts(key1)
-				err = manager.StartSourceListener(key1, "127.0.0.1", destSrcPortMap, tmpDir)
+				err = manager.StartSourceListener(key1, "127.0.0.1", destSrcPortMap, tmpDir, nil)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				defer manager.StopTargetListener(key1)
@@ -246,11 +306,11 @@ var _ = Describe("MigrationProxy", func() {
 				count := manager.OpenListenerCount()
 				Expect(count).To(Equal(2))
 
-				err = manager.StartTargetListener(key2, []string{virtqemudSock, directSock})
+				err = manager.StartTargetListener(key2, []string{virtqemudSock, directSock}, nil)
 				Expect(err).Should(HaveOccurred())
 				Expect(err.Error()).To(Equal("unable to process new migration connections during virt-handler shutdown"))
 
-				err = manager.StartSourceListener(key2, "127.0.0.1", destSrcPortMap, tmpDir)
+				err = manager.StartSourceListener(key2, "127.0.0.1", destSrcPortMap, tmpDir, nil)
 				Expect(err).Should(HaveOccurred())
 				Expect(err.Error()).To(Equal("unable to process new migration connections during virt-handler shutdown"))
 
@@ -258,6 +318,124 @@ var _ = Describe("MigrationProxy", func() {
 				Entry("with TLS enabled", &v1.MigrationConfiguration{DisableTLS: pointer.BoolPtr(false)}),
 				Entry("with TLS disabled", &v1.MigrationConfiguration{DisableTLS: pointer.BoolPtr(true)}),
 			)
+
+			It("by sending the connections through the migration tunnel", func() {
+				virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
+				virtqemudListener, err := net.Listen("unix", virtqemudSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer virtqemudListener.Close()
+
+				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+				Expect(manager.StartTargetListener("mykey", []string{virtqemudSock}, nil)).To(Succeed())
+				defer manager.StopTargetListener("mykey")
+				destSrcPortMap := manager.GetTargetListenerPorts("mykey")
+
+				ca, err := triple.NewCA("kubevirt.io", time.Hour)
+				Expect(err).ShouldNot(HaveOccurred())
+				serverKeyPair, err := triple.NewServerKeyPair(ca, "virt-api.kubevirt.pod.cluster.local", "virt-api", "kubevirt", "cluster.local", nil, nil, time.Hour)
+				Expect(err).ShouldNot(HaveOccurred())
+				tunnelListener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+					MinVersion: tls.VersionTLS12,
+					Certificates: []tls.Certificate{{
+						Certificate: [][]byte{serverKeyPair.Cert.Raw},
+						PrivateKey:  serverKeyPair.Key,
+					}},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				defer tunnelListener.Close()
+
+				// A minimal migration tunnel which forwards the connection to the target proxy
+				requestedPaths := make(chan string, 1)
+				go func() {
+					defer GinkgoRecover()
+					conn, err := tunnelListener.Accept()
+					Expect(err).ShouldNot(HaveOccurred())
+					defer conn.Close()
+					req, err := http.ReadRequest(bufio.NewReader(conn))
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(req.Method).To(Equal(http.MethodConnect))
+					requestedPaths <- req.URL.Path
+					_, _, port, err := migrations.ParseMigrationTunnelPath(req.URL.Path)
+					Expect(err).ShouldNot(HaveOccurred())
+					targetConn, err := tls.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port), tlsConfig)
+					Expect(err).ShouldNot(HaveOccurred())
+					defer targetConn.Close()
+					_, err = conn.Write([]byte("HTTP/1.1 200 OK\r\n\r\n"))
+					Expect(err).ShouldNot(HaveOccurred())
+					go io.Copy(conn, targetConn)
+					io.Copy(targetConn, conn)
+				}()
+
+				tunnel := &v1.VirtualMachineInstanceMigrationTunnel{
+					Address:    tunnelListener.Addr().String(),
+					ServerName: "virt-api.kubevirt.svc",
+					CABundle:   cert.EncodeCertPEM(ca.Cert),
+				}
+				Expect(manager.StartSourceTunnelListener("mykey", tunnel, "default", "testvmi", destSrcPortMap, tmpDir)).To(Succeed())
+				defer manager.StopSourceListener("mykey")
+
+				sourceFiles := manager.GetSourceListenerFiles("mykey")
+				Expect(sourceFiles).To(HaveLen(1))
+				conn, err := net.Dial("unix", sourceFiles[0])
+				Expect(err).ShouldNot(HaveOccurred())
+				defer conn.Close()
+				message := []byte("some libvirt message")
+				_, err = conn.Write(message)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				fd, err := virtqemudListener.Accept()
+				Expect(err).ShouldNot(HaveOccurred())
+				defer fd.Close()
+				var bytes [1024]byte
+				n, err := fd.Read(bytes[0:])
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(bytes[:n]).To(Equal(message))
+
+				for destPort := range destSrcPortMap {
+					port, err := strconv.Atoi(destPort)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(<-requestedPaths).To(Equal(migrations.MigrationTunnelPath("default", "testvmi", port)))
+				}
+			})
+
+			It("by refusing a migration tunnel with a certificate of another CA", func() {
+				ca, err := triple.NewCA("kubevirt.io", time.Hour)
+				Expect(err).ShouldNot(HaveOccurred())
+				otherCA, err := triple.NewCA("other", time.Hour)
+				Expect(err).ShouldNot(HaveOccurred())
+				serverKeyPair, err := triple.NewServerKeyPair(otherCA, "virt-api.kubevirt.pod.cluster.local", "virt-api", "kubevirt", "cluster.local", nil, nil, time.Hour)
+				Expect(err).ShouldNot(HaveOccurred())
+				tunnelListener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+					MinVersion: tls.VersionTLS12,
+					Certificates: []tls.Certificate{{
+						Certificate: [][]byte{serverKeyPair.Cert.Raw},
+						PrivateKey:  serverKeyPair.Key,
+					}},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				defer tunnelListener.Close()
+				go func() {
+					conn, err := tunnelListener.Accept()
+					if err == nil {
+						conn.(*tls.Conn).Handshake()
+						conn.Close()
+					}
+				}()
+
+				_, err = dialMigrationTunnel(tunnelListener.Addr().String(), migrations.MigrationTunnelPath("default", "testvmi", 49152), &tls.Config{
+					MinVersion: tls.VersionTLS12,
+					RootCAs:    certPool(ca),
+					ServerName: "virt-api.kubevirt.svc",
+				})
+				Expect(err).Should(HaveOccurred())
+			})
 		})
 	})
 })
+
+func certPool(ca *triple.KeyPair) *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.Cert)
+	return pool
+}
@@ -0,0 +1,74 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2025 Red Hat, Inc.
+ *
+ */
+
+package migrationproxy
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+// tunnelConn is a connection through the migration tunnel. The reader holds the data the
+// tunnel sent right after its response to the CONNECT request.
+type tunnelConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *tunnelConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
+
+// dialMigrationTunnel connects to the migration tunnel at address and asks it with a CONNECT
+// request to forward the connection to the port of the target node in path
+func dialMigrationTunnel(address string, path string, tlsConfig *tls.Config) (net.Conn, error) {
+	conn, err := tls.Dial("tcp", address, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Path: path},
+		Host:   address,
+		Header: http.Header{},
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to send the CONNECT request to the migration tunnel %s: %v", address, err)
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to read the response of the migration tunnel %s: %v", address, err)
+	}
+	// The body of a successful response is the tunneled stream, it must not be read here
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("the migration tunnel %s refused the connection to %s: %s", address, path, resp.Status)
+	}
+
+	return &tunnelConn{Conn: conn, reader: reader}, nil
+}
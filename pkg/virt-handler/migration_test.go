@@ -35,5 +35,10 @@ var _ = Describe("virt-handler", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(newIp).To(Equal(originalIP))
 		})
+
+		It("Should fail to find the IP of a missing additional migration interface", func() {
+			_, err := FindInterfaceIP("migration1")
+			Expect(err).To(HaveOccurred())
+		})
 	})
 })
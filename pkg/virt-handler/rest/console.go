@@ -159,6 +159,24 @@ func (t *ConsoleHandler) VNCHandler(request *restful.Request, response *restful.
 	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopChn)
 }
 
+// NBDHandler streams a connection to the NBD server exporting the disks of a pull mode backup
+func (t *ConsoleHandler) NBDHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, t.vmiInformer)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedRetrieveVMI)
+		response.WriteError(code, err)
+		return
+	}
+	unixSocketPath, err := t.getUnixSocketPath(vmi, "virt-backup-nbd")
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for backup NBD server")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	// The NBD server accepts several connections, clients commonly read the disks in parallel
+	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), make(chan struct{}))
+}
+
 func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restful.Response) {
 	vmi, code, err := getVMI(request, t.vmiInformer)
 	if err != nil {
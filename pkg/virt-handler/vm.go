@@ -106,6 +106,8 @@ import (
 type netconf interface {
 	Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, launcherPid int, preSetup func() error) error
 	Teardown(vmi *v1.VirtualMachineInstance) error
+	SetupFirewall(vmi *v1.VirtualMachineInstance, launcherPid int) error
+	AppliedFirewall(vmi *v1.VirtualMachineInstance) (*v1.Firewall, bool)
 }
 
 type netstat interface {
@@ -264,7 +266,7 @@ func NewController(
 
 	c.launcherClients = virtcache.LauncherClientInfoByVMI{}
 
-	c.netConf = netsetup.NewNetConf()
+	c.netConf = netsetup.NewNetConf(clusterConfig.NftNetlinkEnabled)
 	c.netStat = netsetup.NewNetStat()
 
 	c.downwardMetricsManager = downwardMetricsManager
@@ -576,6 +578,20 @@ func (d *VirtualMachineController) setupNetwork(vmi *v1.VirtualMachineInstance,
 	})
 }
 
+func (d *VirtualMachineController) setupFirewall(vmi *v1.VirtualMachineInstance) error {
+	isolationRes, err := d.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return fmt.Errorf(failedDetectIsolationFmt, err)
+	}
+	return d.netConf.SetupFirewall(vmi, isolationRes.Pid())
+}
+
+func (d *VirtualMachineController) updateFirewallStatus(vmi *v1.VirtualMachineInstance) {
+	if applied, exists := d.netConf.AppliedFirewall(vmi); exists {
+		vmi.Status.Firewall = applied
+	}
+}
+
 func domainMigrated(domain *api.Domain) bool {
 	if domain != nil && domain.Status.Status == api.Shutoff && domain.Status.Reason == api.ReasonMigrated {
 		return true
@@ -621,6 +637,101 @@ func (d *VirtualMachineController) setMigrationProgressStatus(vmi *v1.VirtualMac
 	vmi.Status.MigrationState.Completed = migrationMetadata.Completed
 	vmi.Status.MigrationState.Failed = migrationMetadata.Failed
 	vmi.Status.MigrationState.Mode = migrationMetadata.Mode
+	vmi.Status.MigrationState.FailureReason = migrationMetadata.FailureReason
+	vmi.Status.MigrationState.DataTransferred = migrationMetadata.DataTransferred
+	if migrationMetadata.DowntimeMilliseconds > 0 {
+		vmi.Status.MigrationState.Downtime = millisecondsToDuration(migrationMetadata.DowntimeMilliseconds)
+	}
+	if migrationMetadata.SetupTimeMilliseconds > 0 {
+		vmi.Status.MigrationState.SetupTime = millisecondsToDuration(migrationMetadata.SetupTimeMilliseconds)
+	}
+	if migrationMetadata.TotalTimeMilliseconds > 0 {
+		vmi.Status.MigrationState.TotalTime = millisecondsToDuration(migrationMetadata.TotalTimeMilliseconds)
+	}
+	if migrationMetadata.DirtyRate != nil {
+		finished := migrationMetadata.Completed || migrationMetadata.Failed
+		vmi.Status.MigrationState.DirtyRate = migrationDirtyRate(migrationMetadata.DirtyRate.Samples, vmi.Status.MigrationState.DirtyRate, finished)
+	}
+	if migrationMetadata.Escalation != nil && len(migrationMetadata.Escalation.Transitions) > 0 {
+		vmi.Status.MigrationState.Escalation = migrationEscalationStatus(migrationMetadata.Escalation.Transitions)
+	}
+}
+
+func millisecondsToDuration(milliseconds int64) *metav1.Duration {
+	return &metav1.Duration{Duration: time.Duration(milliseconds) * time.Millisecond}
+}
+
+const (
+	// The dirty rate in the status is only updated once per minute, so that the VMI is not updated on every sample
+	migrationDirtyRateUpdateInterval = time.Minute
+	// The samples virt-launcher takes every 10 seconds during the last minute
+	migrationDirtyRateEstimateSamples = 6
+	// Changes of the average dirty rate by less than this are a stable trend
+	migrationDirtyRateStablePercent = 10
+)
+
+// migrationDirtyRate derives the estimate of the dirty rate from the samples virt-launcher keeps in the
+// domain metadata. The current estimate is kept until it is a minute old, unless the migration finished.
+func migrationDirtyRate(samples []api.MigrationDirtyRateSample, current *v1.MigrationDirtyRate, finished bool) *v1.MigrationDirtyRate {
+	if len(samples) == 0 || samples[len(samples)-1].Timestamp == nil {
+		return current
+	}
+	latest := *samples[len(samples)-1].Timestamp
+	if current != nil && !latest.After(current.Timestamp.Time) {
+		return current
+	}
+	if current != nil && !finished && latest.Sub(current.Timestamp.Time) < migrationDirtyRateUpdateInterval {
+		return current
+	}
+
+	recentStart := max(len(samples)-migrationDirtyRateEstimateSamples, 0)
+	recent := samples[recentStart:]
+	previous := samples[max(recentStart-migrationDirtyRateEstimateSamples, 0):recentStart]
+
+	estimate, transferRate := averageMigrationDirtyRate(recent)
+	dirtyRate := &v1.MigrationDirtyRate{
+		Timestamp:    latest,
+		Estimate:     estimate,
+		TransferRate: transferRate,
+	}
+	if len(previous) > 0 {
+		previousEstimate, _ := averageMigrationDirtyRate(previous)
+		switch tolerance := previousEstimate * migrationDirtyRateStablePercent / 100; {
+		case estimate > previousEstimate+tolerance:
+			dirtyRate.Trend = v1.MigrationDirtyRateIncreasing
+		case estimate < previousEstimate-tolerance:
+			dirtyRate.Trend = v1.MigrationDirtyRateDecreasing
+		default:
+			dirtyRate.Trend = v1.MigrationDirtyRateStable
+		}
+	}
+	return dirtyRate
+}
+
+func averageMigrationDirtyRate(samples []api.MigrationDirtyRateSample) (dirtyRate, transferRate int64) {
+	for _, sample := range samples {
+		dirtyRate += sample.DirtyRate
+		transferRate += sample.TransferRate
+	}
+	return dirtyRate / int64(len(samples)), transferRate / int64(len(samples))
+}
+
+func migrationEscalationStatus(transitions []api.MigrationEscalationTransition) *v1.MigrationEscalationStatus {
+	status := &v1.MigrationEscalationStatus{}
+	for _, transition := range transitions {
+		t := v1.MigrationEscalationTransition{
+			Stage:         transition.Stage,
+			DirtyRate:     transition.DirtyRate,
+			TransferRate:  transition.TransferRate,
+			DataRemaining: transition.DataRemaining,
+		}
+		if transition.Timestamp != nil {
+			t.Timestamp = *transition.Timestamp
+		}
+		status.Transitions = append(status.Transitions, t)
+		status.Stage = transition.Stage
+	}
+	return status
 }
 
 func (d *VirtualMachineController) migrationSourceUpdateVMIStatus(origVMI *v1.VirtualMachineInstance, domain *api.Domain) error {
@@ -775,20 +886,25 @@ func (d *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 			return fmt.Errorf(msg)
 		}
 
+		migrationIpAddress, err := d.findMigrationIpAddress(vmi)
+		if err != nil {
+			return err
+		}
+
 		hostAddress := ""
 		// advertise the listener address to the source node
 		if vmi.Status.MigrationState != nil {
 			hostAddress = vmi.Status.MigrationState.TargetNodeAddress
 		}
-		if hostAddress != d.migrationIpAddress {
+		if hostAddress != migrationIpAddress {
 			portsList := make([]string, 0, len(destSrcPortsMap))
 
 			for k := range destSrcPortsMap {
 				portsList = append(portsList, k)
 			}
 			portsStrList := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(portsList)), ","), "[]")
-			d.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.PreparingTarget.String(), fmt.Sprintf("Migration Target is listening at %s, on ports: %s", d.migrationIpAddress, portsStrList))
-			vmiCopy.Status.MigrationState.TargetNodeAddress = d.migrationIpAddress
+			d.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.PreparingTarget.String(), fmt.Sprintf("Migration Target is listening at %s, on ports: %s", migrationIpAddress, portsStrList))
+			vmiCopy.Status.MigrationState.TargetNodeAddress = migrationIpAddress
 			vmiCopy.Status.MigrationState.TargetDirectMigrationNodePorts = destSrcPortsMap
 		}
 
@@ -820,6 +936,26 @@ func (d *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 	return nil
 }
 
+// findMigrationIpAddress returns the address the target advertises to the source node. It is the address
+// of the network the migration policy of the VMI selects, and the default migration address otherwise.
+func (d *VirtualMachineController) findMigrationIpAddress(vmi *v1.VirtualMachineInstance) (string, error) {
+	if vmi.Status.MigrationState == nil ||
+		vmi.Status.MigrationState.MigrationConfiguration == nil ||
+		vmi.Status.MigrationState.MigrationConfiguration.Network == nil {
+		return d.migrationIpAddress, nil
+	}
+
+	network := *vmi.Status.MigrationState.MigrationConfiguration.Network
+	ifaceName, found := migrations.MigrationInterfaceName(d.clusterConfig.GetMigrationConfiguration(), network)
+	if !found {
+		return "", fmt.Errorf("network %s of the migration is not a migration network of virt-handler", network)
+	}
+	if ifaceName == v1.MigrationInterfaceName {
+		return d.migrationIpAddress, nil
+	}
+	return FindInterfaceIP(ifaceName)
+}
+
 func (d *VirtualMachineController) generateEventsForVolumeStatusChange(vmi *v1.VirtualMachineInstance, newStatusMap map[string]v1.VolumeStatus) {
 	newStatusMapCopy := make(map[string]v1.VolumeStatus)
 	for k, v := range newStatusMap {
@@ -1005,6 +1141,10 @@ func (d *VirtualMachineController) updateVolumeStatusesFromDomain(vmi *v1.Virtua
 				volumeStatus, tmpNeedsRefresh = d.updateMemoryDumpInfo(vmi, volumeStatus, domain)
 				needsRefresh = needsRefresh || tmpNeedsRefresh
 			}
+			if volumeStatus.BackupVolume != nil {
+				volumeStatus, tmpNeedsRefresh = d.updateBackupInfo(vmi, volumeStatus, domain)
+				needsRefresh = needsRefresh || tmpNeedsRefresh
+			}
 			newStatuses = append(newStatuses, volumeStatus)
 			newStatusMap[volumeStatus.Name] = volumeStatus
 		}
@@ -1093,6 +1233,10 @@ func (d *VirtualMachineController) updateLiveMigrationConditions(vmi *v1.Virtual
 			condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceIsMigratable)
 			vmi.Status.Conditions = append(vmi.Status.Conditions, *liveMigrationCondition)
 		}
+		// The volumes updated with the migration strategy are copied with a block migration
+		if isBlockMigration && len(vmi.Status.MigratedVolumes) > 0 && !migrations.IsMigrating(vmi) {
+			vmi.Status.MigrationMethod = v1.BlockMigration
+		}
 	}
 
 	evictable := migrations.VMIMigratableOnEviction(d.clusterConfig, vmi)
@@ -1192,6 +1336,50 @@ func (d *VirtualMachineController) updatePausedConditions(vmi *v1.VirtualMachine
 	}
 }
 
+// updateGuestCrashedConditions adds the guest crashed condition on a crash and removes it once the guest runs again,
+// every crash gets a condition with its own transition time.
+func (d *VirtualMachineController) updateGuestCrashedConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil {
+		return
+	}
+	if !isGuestCrashed(domain) {
+		if domain.Status.Status == api.Running && condManager.HasCondition(vmi, v1.VirtualMachineInstanceGuestCrashed) {
+			log.Log.Object(vmi).V(3).Info("Removing guest crashed condition")
+			condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestCrashed)
+		}
+		return
+	}
+	if condManager.HasCondition(vmi, v1.VirtualMachineInstanceGuestCrashed) {
+		return
+	}
+
+	log.Log.Object(vmi).V(3).Info("Adding guest crashed condition")
+	now := metav1.NewTime(time.Now())
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGuestCrashed,
+		Status:             k8sv1.ConditionTrue,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+		Reason:             "GuestPanicked",
+		Message:            "The guest operating system crashed",
+	})
+}
+
+func isGuestCrashed(domain *api.Domain) bool {
+	switch domain.Status.Status {
+	case api.Crashed:
+		return true
+	case api.Shutoff:
+		return domain.Status.Reason == api.ReasonCrashed || domain.Status.Reason == api.ReasonPanicked
+	}
+	return false
+}
+
+func isCrashPreserved(vmi *v1.VirtualMachineInstance) bool {
+	panicDevice := vmi.Spec.Domain.Devices.PanicDevice
+	return panicDevice != nil && panicDevice.OnCrash == v1.CrashActionPreserve
+}
+
 func dumpTargetFile(vmiName, volName string) string {
 	targetFileName := fmt.Sprintf("%s-%s-%s.memory.dump", vmiName, volName, time.Now().Format("20060102-150405"))
 	return targetFileName
@@ -1234,6 +1422,94 @@ func (d *VirtualMachineController) updateMemoryDumpInfo(vmi *v1.VirtualMachineIn
 	return volumeStatus, needsRefresh
 }
 
+func backupVolumeSource(vmi *v1.VirtualMachineInstance, volumeName string) *v1.BackupVolumeSource {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == volumeName {
+			return volume.Backup
+		}
+	}
+	return nil
+}
+
+// backupVolumeNames returns the writable disks of the VMI backed by a PVC or a DataVolume
+func backupVolumeNames(vmi *v1.VirtualMachineInstance) []string {
+	writableDisks := map[string]struct{}{}
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.Disk != nil && !disk.Disk.ReadOnly {
+			writableDisks[disk.Name] = struct{}{}
+		}
+	}
+
+	var volumes []string
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
+			continue
+		}
+		if _, ok := writableDisks[volume.Name]; ok {
+			volumes = append(volumes, volume.Name)
+		}
+	}
+	return volumes
+}
+
+func (d *VirtualMachineController) updateBackupInfo(vmi *v1.VirtualMachineInstance, volumeStatus v1.VolumeStatus, domain *api.Domain) (v1.VolumeStatus, bool) {
+	needsRefresh := false
+	switch volumeStatus.Phase {
+	case v1.HotplugVolumeMounted:
+		source := backupVolumeSource(vmi, volumeStatus.Name)
+		if source == nil {
+			return volumeStatus, needsRefresh
+		}
+		needsRefresh = true
+		log.Log.Object(vmi).V(3).Infof("Backup volume %s attached, marking it in progress", volumeStatus.Name)
+		volumeStatus.Phase = v1.BackupVolumeInProgress
+		volumeStatus.Message = fmt.Sprintf("Backup Volume %s is attached, running backup %s", volumeStatus.Name, source.BackupName)
+		volumeStatus.Reason = VolumeMountedToPodReason
+		volumeStatus.BackupVolume.TargetDirectory = source.BackupName
+		volumeStatus.BackupVolume.Volumes = backupVolumeNames(vmi)
+	case v1.BackupVolumeInProgress, v1.BackupVolumeExported:
+		backupMetadata := domain.Spec.Metadata.KubeVirt.Backup
+		if backupMetadata == nil || backupMetadata.Name != volumeStatus.BackupVolume.TargetDirectory {
+			// backup wasnt triggered yet
+			return volumeStatus, needsRefresh
+		}
+		// an exported backup lasts until the client finishes it, the domain metadata update wakes us up
+		needsRefresh = volumeStatus.Phase == v1.BackupVolumeInProgress
+		if backupMetadata.StartTimestamp != nil {
+			volumeStatus.BackupVolume.StartTimestamp = backupMetadata.StartTimestamp
+		}
+		if backupMetadata.EndTimestamp != nil && backupMetadata.Failed {
+			log.Log.Object(vmi).Errorf("Backup to pvc %s failed: %v", volumeStatus.Name, backupMetadata.FailureReason)
+			volumeStatus.Message = fmt.Sprintf("Backup to pvc %s failed: %v", volumeStatus.Name, backupMetadata.FailureReason)
+			volumeStatus.Phase = v1.BackupVolumeFailed
+			volumeStatus.BackupVolume.EndTimestamp = backupMetadata.EndTimestamp
+		} else if backupMetadata.Completed {
+			log.Log.Object(vmi).V(3).Infof("Marking backup to volume %s has completed", volumeStatus.Name)
+			volumeStatus.Phase = v1.BackupVolumeCompleted
+			volumeStatus.Message = fmt.Sprintf("Backup to Volume %s has completed successfully", volumeStatus.Name)
+			volumeStatus.Reason = VolumeReadyReason
+			volumeStatus.BackupVolume.EndTimestamp = backupMetadata.EndTimestamp
+			volumeStatus.BackupVolume.IncrementalVolumes = splitBackupVolumes(backupMetadata.IncrementalVolumes)
+			volumeStatus.BackupVolume.CheckpointVolumes = splitBackupVolumes(backupMetadata.CheckpointVolumes)
+		} else if backupMetadata.Exported && volumeStatus.Phase != v1.BackupVolumeExported {
+			log.Log.Object(vmi).V(3).Infof("Marking backup to volume %s as exported", volumeStatus.Name)
+			volumeStatus.Phase = v1.BackupVolumeExported
+			volumeStatus.Message = fmt.Sprintf("Backup %s is exported through the NBD server", backupMetadata.Name)
+			volumeStatus.BackupVolume.IncrementalVolumes = splitBackupVolumes(backupMetadata.IncrementalVolumes)
+			volumeStatus.BackupVolume.CheckpointVolumes = splitBackupVolumes(backupMetadata.CheckpointVolumes)
+		}
+	}
+
+	return volumeStatus, needsRefresh
+}
+
+func splitBackupVolumes(volumes string) []string {
+	if volumes == "" {
+		return nil
+	}
+	return strings.Split(volumes, ",")
+}
+
 func (d *VirtualMachineController) updateFSFreezeStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 
 	if domain == nil || domain.Status.FSFreezeStatus.Status == "" {
@@ -1371,6 +1647,7 @@ func (d *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 	if err = d.updateMemoryInfo(vmi, domain); err != nil {
 		return err
 	}
+	d.updateFirewallStatus(vmi)
 	err = d.netStat.UpdateStatus(vmi, domain)
 	return err
 }
@@ -1383,6 +1660,7 @@ func (d *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineIns
 		return err
 	}
 	d.updatePausedConditions(vmi, domain, condManager)
+	d.updateGuestCrashedConditions(vmi, domain, condManager)
 
 	return nil
 }
@@ -2476,6 +2754,11 @@ func (d *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 		volumeStatusMap[volumeStatus.Name] = volumeStatus
 	}
 
+	migratedVolumes := make(map[string]bool)
+	for _, v := range vmi.Status.MigratedVolumes {
+		migratedVolumes[v.VolumeName] = true
+	}
+
 	// Check if all VMI volumes can be shared between the source and the destination
 	// of a live migration. blockMigrate will be returned as false, only if all volumes
 	// are shared and the VMI has no local disks
@@ -2483,7 +2766,11 @@ func (d *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 	// A relevant error will be returned in this case.
 	for _, volume := range vmi.Spec.Volumes {
 		volSrc := volume.VolumeSource
-		if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil {
+		if migratedVolumes[volume.Name] {
+			// The content of the volume is copied to the destination volume
+			log.Log.Object(vmi).Infof("migration is block migration because of the migrated volume %s", volume.Name)
+			blockMigrate = true
+		} else if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil {
 
 			var claimName string
 			if volSrc.PersistentVolumeClaim != nil {
@@ -2563,7 +2850,7 @@ func (d *VirtualMachineController) handleTargetMigrationProxy(vmi *v1.VirtualMac
 		destSocketFile := migrationproxy.SourceUnixFile(baseDir, key)
 		migrationTargetSockets = append(migrationTargetSockets, destSocketFile)
 	}
-	err = d.migrationProxy.StartTargetListener(string(vmi.UID), migrationTargetSockets)
+	err = d.migrationProxy.StartTargetListener(string(vmi.UID), migrationTargetSockets, vmi.Status.MigrationState.MigrationConfiguration)
 	if err != nil {
 		return err
 	}
@@ -2591,12 +2878,25 @@ func (d *VirtualMachineController) handleSourceMigrationProxy(vmi *v1.VirtualMac
 		msg := "No migration proxy has been created for this vmi"
 		return fmt.Errorf("%s", msg)
 	}
-	err = d.migrationProxy.StartSourceListener(
-		string(vmi.UID),
-		vmi.Status.MigrationState.TargetNodeAddress,
-		vmi.Status.MigrationState.TargetDirectMigrationNodePorts,
-		baseDir,
-	)
+	if tunnel := vmi.Status.MigrationState.TargetTunnel; tunnel != nil {
+		// The target node of a cross-cluster migration is only reachable through the migration tunnel
+		err = d.migrationProxy.StartSourceTunnelListener(
+			string(vmi.UID),
+			tunnel,
+			vmi.Namespace,
+			vmi.Name,
+			vmi.Status.MigrationState.TargetDirectMigrationNodePorts,
+			baseDir,
+		)
+	} else {
+		err = d.migrationProxy.StartSourceListener(
+			string(vmi.UID),
+			vmi.Status.MigrationState.TargetNodeAddress,
+			vmi.Status.MigrationState.TargetDirectMigrationNodePorts,
+			baseDir,
+			vmi.Status.MigrationState.MigrationConfiguration,
+		)
+	}
 	if err != nil {
 		return err
 	}
@@ -2665,6 +2965,11 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 			UnsafeMigration:         *migrationConfiguration.UnsafeMigrationOverride,
 			AllowAutoConverge:       *migrationConfiguration.AllowAutoConverge,
 			AllowPostCopy:           *migrationConfiguration.AllowPostCopy,
+			Escalation:              migrationConfiguration.Escalation,
+		}
+
+		if migrationConfiguration.MultifdChannels != nil && *migrationConfiguration.MultifdChannels > 1 {
+			options.ParallelMigrationThreads = pointer.P(uint(*migrationConfiguration.MultifdChannels))
 		}
 
 		if threadCountStr, exists := origVMI.Annotations[cmdclient.MultiThreadedQemuMigrationAnnotation]; exists {
@@ -2677,6 +2982,18 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 			}
 		}
 
+		if migrationConfiguration.MaxDowntime != nil {
+			options.MaxDowntimeMilliseconds = uint64(migrationConfiguration.MaxDowntime.Milliseconds())
+		}
+
+		if migrationConfiguration.Compression != nil {
+			if options.ParallelMigrationThreads != nil {
+				options.Compression = migrationConfiguration.Compression
+			} else {
+				log.Log.Object(origVMI).Warning("migration compression requires multifd channels, the migration will not be compressed")
+			}
+		}
+
 		marshalledOptions, err := json.Marshal(options)
 		if err != nil {
 			log.Log.Object(origVMI).Warning("failed to marshall matched migration options")
@@ -2690,7 +3007,11 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 			return err
 		}
 
-		err = client.MigrateVirtualMachine(vmi, options)
+		if len(vmi.Status.MigratedVolumes) > 0 {
+			err = client.MigrateVirtualMachineVolumes(vmi, options)
+		} else {
+			err = client.MigrateVirtualMachine(vmi, options)
+		}
 		if err != nil {
 			return err
 		}
@@ -2772,6 +3093,9 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationTarget(origVMI *v1.Vir
 	if err := d.setupNetwork(vmi, vmi.Spec.Networks); err != nil {
 		return fmt.Errorf("failed to configure vmi network for migration target: %w", err)
 	}
+	if err := d.setupFirewall(vmi); err != nil {
+		return fmt.Errorf("failed to configure vmi firewall for migration target: %w", err)
+	}
 
 	isolationRes, err := d.podIsolationDetector.Detect(vmi)
 	if err != nil {
@@ -2993,6 +3317,9 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 		if err := d.setupNetwork(vmi, nonAbsentNets); err != nil {
 			return fmt.Errorf("failed to configure vmi network: %w", err)
 		}
+		if err := d.setupFirewall(vmi); err != nil {
+			return fmt.Errorf("failed to configure vmi firewall: %w", err)
+		}
 
 		isolationRes, err := d.podIsolationDetector.Detect(vmi)
 		if err != nil {
@@ -3074,6 +3401,10 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 			return err
 		}
 
+		if err := d.syncBackup(vmi); err != nil {
+			return err
+		}
+
 		isolationRes, err := d.podIsolationDetector.Detect(vmi)
 		if err != nil {
 			return fmt.Errorf(failedDetectIsolationFmt, err)
@@ -3102,6 +3433,12 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 				errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
 			}
 		}
+
+		if err := d.setupFirewall(vmi); err != nil {
+			log.Log.Object(vmi).Error(err.Error())
+			d.recorder.Event(vmi, k8sv1.EventTypeWarning, "Firewall", err.Error())
+			errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
+		}
 	}
 
 	smbios := d.clusterConfig.GetSMBIOS()
@@ -3225,6 +3562,51 @@ func (d *VirtualMachineController) getMemoryDump(vmi *v1.VirtualMachineInstance)
 	return nil
 }
 
+// syncBackup starts the backups whose volume is attached and finishes the pull mode backups the client is done with
+func (d *VirtualMachineController) syncBackup(vmi *v1.VirtualMachineInstance) error {
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.BackupVolume == nil {
+			continue
+		}
+		source := backupVolumeSource(vmi, volumeStatus.Name)
+		if source == nil {
+			continue
+		}
+
+		switch {
+		case volumeStatus.Phase == v1.BackupVolumeInProgress:
+			client, err := d.getVerifiedLauncherClient(vmi)
+			if err != nil {
+				return fmt.Errorf("failed to start backup: %v", err)
+			}
+
+			log.Log.V(3).Object(vmi).Info("sending backup command")
+			err = client.BackupVirtualMachine(vmi, &cmdclient.BackupOptions{
+				BackupName:      source.BackupName,
+				CheckpointName:  source.CheckpointName,
+				IncrementalFrom: source.IncrementalFrom,
+				TargetPath:      hotplugdisk.GetVolumeMountDir(volumeStatus.Name),
+				Pull:            source.Pull,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to start backup: %v", err)
+			}
+		case volumeStatus.Phase == v1.BackupVolumeExported && source.PullCompleted:
+			client, err := d.getVerifiedLauncherClient(vmi)
+			if err != nil {
+				return fmt.Errorf("failed to finish backup: %v", err)
+			}
+
+			log.Log.V(3).Object(vmi).Info("sending finish backup command")
+			if err := client.FinishBackup(vmi, source.BackupName); err != nil {
+				return fmt.Errorf("failed to finish backup: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (d *VirtualMachineController) processVmUpdate(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 
 	isUnresponsive, isInitialized, err := d.isLauncherClientUnresponsive(vmi)
@@ -3287,6 +3669,10 @@ func (d *VirtualMachineController) calculateVmPhaseForStatusReason(domain *api.D
 
 		switch domain.Status.Status {
 		case api.Shutoff, api.Crashed:
+			if domain.Status.Status == api.Crashed && isCrashPreserved(vmi) {
+				// The crashed guest is kept around, e.g. to capture a memory dump
+				return v1.Running, nil
+			}
 			switch domain.Status.Reason {
 			case api.ReasonCrashed, api.ReasonPanicked:
 				return v1.Failed, nil
@@ -3396,6 +3782,8 @@ func (d *VirtualMachineController) finalizeMigration(vmi *v1.VirtualMachineInsta
 		d.recorder.Event(vmi, k8sv1.EventTypeWarning, err.Error(), "failed to update guest memory")
 	}
 
+	finalizeVolumeMigration(vmi)
+
 	if err := client.FinalizeVirtualMachineMigration(vmi); err != nil {
 		log.Log.Object(vmi).Reason(err).Error(errorMessage)
 		return fmt.Errorf("%s: %v", errorMessage, err)
@@ -3404,6 +3792,24 @@ func (d *VirtualMachineController) finalizeMigration(vmi *v1.VirtualMachineInsta
 	return nil
 }
 
+// finalizeVolumeMigration clears the migrated volumes once the domain runs on the target with the destination volumes
+func finalizeVolumeMigration(vmi *v1.VirtualMachineInstance) {
+	if len(vmi.Status.MigratedVolumes) == 0 {
+		return
+	}
+	log.Log.Object(vmi).Infof("The volumes %s have been migrated", getMigratedVolumeNames(vmi))
+	vmi.Status.MigratedVolumes = nil
+	controller.NewVirtualMachineInstanceConditionManager().RemoveCondition(vmi, v1.VirtualMachineInstanceVolumesChange)
+}
+
+func getMigratedVolumeNames(vmi *v1.VirtualMachineInstance) []string {
+	var names []string
+	for _, v := range vmi.Status.MigratedVolumes {
+		names = append(names, v.VolumeName)
+	}
+	return names
+}
+
 func vmiHasTerminationGracePeriod(vmi *v1.VirtualMachineInstance) bool {
 	// if not set we use the default graceperiod
 	return vmi.Spec.TerminationGracePeriodSeconds == nil ||
@@ -1028,6 +1028,100 @@ var _ = Describe("VirtualMachineInstance", func() {
 			expectEvent(string(v1.AccessCredentialsSyncFailed), true)
 		})
 
+		DescribeTable("should add guest crashed condition", func(onCrash v1.CrashAction, status api.LifeCycle, reason api.StateChangeReason, expectedPhase v1.VirtualMachineInstancePhase) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Domain.Devices.PanicDevice = &v1.PanicDevice{OnCrash: onCrash}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			mockWatchdog.CreateFile(vmi)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = status
+			domain.Status.Reason = reason
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			if expectedPhase == v1.Running {
+				client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+				mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+				mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+			}
+			vmiInterface.EXPECT().Update(context.Background(), gomock.Any()).Do(func(ctx context.Context, vmi *v1.VirtualMachineInstance) {
+				Expect(vmi.Status.Phase).To(Equal(expectedPhase))
+				Expect(vmi.Status.Conditions).To(ContainElement(And(
+					HaveField("Type", v1.VirtualMachineInstanceGuestCrashed),
+					HaveField("Status", k8sv1.ConditionTrue),
+				)))
+			})
+
+			controller.Execute()
+			if expectedPhase == v1.Failed {
+				expectEvent(VMICrashed, true)
+			}
+		},
+			Entry("and keep a preserved crashed guest running", v1.CrashActionPreserve, api.Crashed, api.ReasonPanicked, v1.Running),
+			Entry("and fail a powered off crashed guest", v1.CrashActionPoweroff, api.Shutoff, api.ReasonCrashed, v1.Failed),
+		)
+
+		It("should report a new guest crashed condition for every crash", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Domain.Devices.PanicDevice = &v1.PanicDevice{OnCrash: v1.CrashActionPreserve}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			mockWatchdog.CreateFile(vmi)
+
+			firstCrash := metav1.NewTime(time.Now().Add(-time.Hour))
+			crashedVMI := vmi.DeepCopy()
+			crashedVMI.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:               v1.VirtualMachineInstanceGuestCrashed,
+				Status:             k8sv1.ConditionTrue,
+				LastTransitionTime: firstCrash,
+			}}
+
+			By("resetting the guest after the first crash")
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+
+			vmiFeeder.Add(crashedVMI)
+			domainFeeder.Add(domain)
+
+			client.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+			vmiInterface.EXPECT().Update(context.Background(), gomock.Any()).Do(func(ctx context.Context, vmi *v1.VirtualMachineInstance) {
+				Expect(vmi.Status.Conditions).ToNot(ContainElement(HaveField("Type", v1.VirtualMachineInstanceGuestCrashed)))
+			})
+
+			controller.Execute()
+
+			By("crashing the guest a second time")
+			domain.Status.Status = api.Crashed
+			domain.Status.Reason = api.ReasonPanicked
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			client.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+			vmiInterface.EXPECT().Update(context.Background(), gomock.Any()).Do(func(ctx context.Context, vmi *v1.VirtualMachineInstance) {
+				Expect(vmi.Status.Conditions).To(ContainElement(And(
+					HaveField("Type", v1.VirtualMachineInstanceGuestCrashed),
+					HaveField("Status", k8sv1.ConditionTrue),
+					HaveField("LastTransitionTime", WithTransform(func(t metav1.Time) bool { return firstCrash.Before(&t) }, BeTrue())),
+				)))
+			})
+
+			controller.Execute()
+		})
+
 		It("should add and remove paused condition", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1685,6 +1779,150 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 		})
 
+		Context("backup status events", func() {
+			newBackupVMI := func(phase v1.VolumePhase) *v1.VirtualMachineInstance {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+					Name:       "disk0",
+					DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}},
+				})
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: "disk0",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "disk0"},
+						},
+					},
+				}, v1.Volume{
+					Name: "test",
+					VolumeSource: v1.VolumeSource{
+						Backup: &v1.BackupVolumeSource{
+							PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "test"},
+							},
+							BackupName: "backup",
+						},
+					},
+				})
+				vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, v1.VolumeStatus{
+					Name:    "test",
+					Phase:   phase,
+					Reason:  "reason",
+					Message: "message",
+					HotplugVolume: &v1.HotplugVolumeStatus{
+						AttachPodName: "testpod",
+						AttachPodUID:  "1234",
+					},
+					BackupVolume: &v1.DomainBackupInfo{},
+				})
+				return vmi
+			}
+
+			It("Should trigger backup and generate InProgress event once mounted", func() {
+				vmi := newBackupVMI(v1.HotplugVolumeMounted)
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				vmiFeeder.Add(vmi)
+				domainFeeder.Add(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				hasHotplug := controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(hasHotplug).To(BeTrue())
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeInProgress))
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.TargetDirectory).To(Equal("backup"))
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.Volumes).To(ConsistOf("disk0"))
+				testutils.ExpectEvent(recorder, "Backup Volume test is attached, running backup backup")
+			})
+
+			It("Should generate backup completed event once backup completed", func() {
+				vmi := newBackupVMI(v1.BackupVolumeInProgress)
+				vmi.Status.VolumeStatus[0].BackupVolume.TargetDirectory = "backup"
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				now := metav1.Now()
+				domain.Spec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+					Name:               "backup",
+					StartTimestamp:     &now,
+					EndTimestamp:       &now,
+					Completed:          true,
+					IncrementalVolumes: "disk0",
+					CheckpointVolumes:  "disk0,disk1",
+				}
+				domain.Status.Status = api.Running
+				vmiFeeder.Add(vmi)
+				domainFeeder.Add(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeCompleted))
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.StartTimestamp).ToNot(BeNil())
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.EndTimestamp).ToNot(BeNil())
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.IncrementalVolumes).To(ConsistOf("disk0"))
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.CheckpointVolumes).To(ConsistOf("disk0", "disk1"))
+				testutils.ExpectEvent(recorder, "Backup to Volume test has completed successfully")
+			})
+
+			It("Should generate backup exported event once a pull mode backup is exported", func() {
+				vmi := newBackupVMI(v1.BackupVolumeInProgress)
+				vmi.Status.VolumeStatus[0].BackupVolume.TargetDirectory = "backup"
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				now := metav1.Now()
+				domain.Spec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+					Name:              "backup",
+					StartTimestamp:    &now,
+					Exported:          true,
+					CheckpointVolumes: "disk0",
+				}
+				domain.Status.Status = api.Running
+				vmiFeeder.Add(vmi)
+				domainFeeder.Add(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeExported))
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.EndTimestamp).To(BeNil())
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.CheckpointVolumes).To(ConsistOf("disk0"))
+				testutils.ExpectEvent(recorder, "Backup backup is exported through the NBD server")
+
+				By("Completing the backup once the client finished it")
+				domain.Spec.Metadata.KubeVirt.Backup.Exported = false
+				domain.Spec.Metadata.KubeVirt.Backup.Completed = true
+				domain.Spec.Metadata.KubeVirt.Backup.EndTimestamp = &now
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeCompleted))
+				testutils.ExpectEvent(recorder, "Backup to Volume test has completed successfully")
+			})
+
+			It("Should generate backup failed event if backup failed", func() {
+				vmi := newBackupVMI(v1.BackupVolumeInProgress)
+				vmi.Status.VolumeStatus[0].BackupVolume.TargetDirectory = "backup"
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				now := metav1.Now()
+				domain.Spec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+					Name:           "backup",
+					StartTimestamp: &now,
+					EndTimestamp:   &now,
+					Failed:         true,
+					FailureReason:  "backup failed",
+				}
+				domain.Status.Status = api.Running
+				vmiFeeder.Add(vmi)
+				domainFeeder.Add(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeFailed))
+				testutils.ExpectEvent(recorder, "Backup to pvc test failed: backup failed")
+			})
+		})
+
 		DescribeTable("should leave the VirtualMachineInstance alone if it is in the final phase", func(phase v1.VirtualMachineInstancePhase) {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.Status.Phase = phase
@@ -2421,6 +2659,49 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(blockMigrate).To(BeTrue())
 			Expect(err).To(Equal(fmt.Errorf("cannot migrate VMI: PVC testblock is not shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)")))
 		})
+		It("should block migrate the migrated volumes", func() {
+
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+				{
+					Name: "myvolume",
+					DiskDevice: v1.DiskDevice{
+						Disk: &v1.DiskTarget{
+							Bus: v1.DiskBusVirtio,
+						},
+					},
+				},
+			}
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "myvolume",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testblock-dst",
+						}},
+					},
+				},
+			}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name: "myvolume",
+					PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+						AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+					},
+				},
+			}
+			vmi.Status.MigratedVolumes = []v1.StorageMigratedVolumeInfo{
+				{
+					VolumeName:         "myvolume",
+					SourcePVCInfo:      &v1.PersistentVolumeClaimInfo{ClaimName: "testblock"},
+					DestinationPVCInfo: &v1.PersistentVolumeClaimInfo{ClaimName: "testblock-dst"},
+				},
+			}
+
+			blockMigrate, err := controller.checkVolumesForMigration(vmi)
+			Expect(blockMigrate).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+		})
 		It("should fail migration for non-shared data volume PVCs", func() {
 
 			vmi := api2.NewMinimalVMI("testvmi")
@@ -3541,6 +3822,67 @@ var _ = Describe("CurrentMemory in Libvirt Domain", func() {
 	)
 })
 
+var _ = Describe("Migration dirty rate", func() {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// newSamples returns a sample every 10 seconds, like virt-launcher takes them
+	newSamples := func(dirtyRates ...int64) []api.MigrationDirtyRateSample {
+		var samples []api.MigrationDirtyRateSample
+		for i, dirtyRate := range dirtyRates {
+			timestamp := metav1.NewTime(start.Add(time.Duration(i) * 10 * time.Second))
+			samples = append(samples, api.MigrationDirtyRateSample{Timestamp: &timestamp, DirtyRate: dirtyRate, TransferRate: 50})
+		}
+		return samples
+	}
+
+	It("should estimate the dirty rate of the last minute", func() {
+		dirtyRate := migrationDirtyRate(newSamples(100, 100, 100, 100, 100, 100, 100, 200, 200, 200, 200, 200, 200), nil, false)
+		Expect(dirtyRate).To(Equal(&v1.MigrationDirtyRate{
+			Timestamp:    metav1.NewTime(start.Add(120 * time.Second)),
+			Estimate:     200,
+			TransferRate: 50,
+			Trend:        v1.MigrationDirtyRateIncreasing,
+		}))
+	})
+
+	DescribeTable("should compare the dirty rate to the minute before", func(previous, recent int64, trend v1.MigrationDirtyRateTrend) {
+		samples := newSamples(previous, previous, previous, previous, previous, previous, recent, recent, recent, recent, recent, recent)
+		Expect(migrationDirtyRate(samples, nil, false).Trend).To(Equal(trend))
+	},
+		Entry("increasing", int64(100), int64(200), v1.MigrationDirtyRateIncreasing),
+		Entry("decreasing", int64(200), int64(100), v1.MigrationDirtyRateDecreasing),
+		Entry("stable", int64(100), int64(105), v1.MigrationDirtyRateStable),
+	)
+
+	It("should not have a trend without the samples of the minute before", func() {
+		dirtyRate := migrationDirtyRate(newSamples(100, 200, 300), nil, false)
+		Expect(dirtyRate.Estimate).To(Equal(int64(200)))
+		Expect(dirtyRate.Trend).To(BeEmpty())
+	})
+
+	It("should keep the estimate until it is a minute old", func() {
+		current := migrationDirtyRate(newSamples(100, 100), nil, false)
+		Expect(migrationDirtyRate(newSamples(100, 100, 500, 500), current, false)).To(BeIdenticalTo(current))
+
+		dirtyRate := migrationDirtyRate(newSamples(100, 100, 500, 500, 500, 500, 500, 500), current, false)
+		Expect(dirtyRate.Timestamp).To(Equal(metav1.NewTime(start.Add(70 * time.Second))))
+		Expect(dirtyRate.Estimate).To(Equal(int64(500)))
+	})
+
+	It("should update the estimate when the migration finished", func() {
+		current := migrationDirtyRate(newSamples(100, 100), nil, false)
+		dirtyRate := migrationDirtyRate(newSamples(100, 100, 400, 400), current, true)
+		Expect(dirtyRate.Timestamp).To(Equal(metav1.NewTime(start.Add(30 * time.Second))))
+		Expect(dirtyRate.Estimate).To(Equal(int64(250)))
+	})
+
+	It("should keep the estimate without new samples", func() {
+		current := migrationDirtyRate(newSamples(100, 100), nil, false)
+		Expect(migrationDirtyRate(newSamples(100, 100), current, true)).To(BeIdenticalTo(current))
+		Expect(migrationDirtyRate(nil, current, true)).To(BeIdenticalTo(current))
+	})
+})
+
 type MockWatchdog struct {
 	baseDir string
 }
@@ -3654,6 +3996,14 @@ func (nc *netConfStub) HotUnplugInterfaces(vmi *v1.VirtualMachineInstance) error
 	return nil
 }
 
+func (nc *netConfStub) SetupFirewall(vmi *v1.VirtualMachineInstance, launcherPid int) error {
+	return nil
+}
+
+func (nc *netConfStub) AppliedFirewall(vmi *v1.VirtualMachineInstance) (*v1.Firewall, bool) {
+	return nil, false
+}
+
 type netStatStub struct{}
 
 func (ns *netStatStub) UpdateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
@@ -340,6 +340,12 @@ func eventCallback(c cli.Connection, domain *api.Domain, libvirtEvent libvirtEve
 				client.SendDomainEvent(event)
 				updateEvents(event, domain, events)
 			}
+			if libvirtEvent.Event.Event == libvirt.DOMAIN_EVENT_CRASHED {
+				err := client.SendK8sEvent(vmi, "Warning", "GuestCrashed", "The guest operating system crashed")
+				if err != nil {
+					log.Log.Reason(err).Error("Could not send k8s event")
+				}
+			}
 		}
 		if interfaceStatus != nil {
 			domain.Status.Interfaces = interfaceStatus
@@ -195,6 +195,7 @@ type DomainSpec struct {
 	XmlNS          string          `xml:"xmlns:qemu,attr,omitempty"`
 	Name           string          `xml:"name"`
 	UUID           string          `xml:"uuid,omitempty"`
+	GenID          *GenID          `xml:"genid,omitempty"`
 	Memory         Memory          `xml:"memory"`
 	CurrentMemory  *Memory         `xml:"currentMemory,omitempty"`
 	MaxMemory      *MaxMemory      `xml:"maxMemory,omitempty"`
@@ -207,6 +208,7 @@ type DomainSpec struct {
 	QEMUCmd        *Commandline    `xml:"qemu:commandline,omitempty"`
 	Metadata       Metadata        `xml:"metadata,omitempty"`
 	Features       *Features       `xml:"features,omitempty"`
+	OnCrash        string          `xml:"on_crash,omitempty"`
 	CPU            CPU             `xml:"cpu"`
 	VCPU           *VCPU           `xml:"vcpu"`
 	VCPUs          *VCPUs          `xml:"vcpus"`
@@ -216,6 +218,12 @@ type DomainSpec struct {
 	LaunchSecurity *LaunchSecurity `xml:"launchSecurity,omitempty"`
 }
 
+// GenID represents the VM Generation ID, libvirt generates one when the value is empty
+// See: https://libvirt.org/formatdomain.html#general-metadata
+type GenID struct {
+	Value string `xml:",chardata"`
+}
+
 type CPUTune struct {
 	VCPUPin     []CPUTuneVCPUPin     `xml:"vcpupin"`
 	IOThreadPin []CPUTuneIOThreadPin `xml:"iothreadpin,omitempty"`
@@ -371,6 +379,7 @@ type KubeVirtMetadata struct {
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	Backup           *BackupMetadata           `xml:"backup,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -387,6 +396,23 @@ type MemoryDumpMetadata struct {
 	FailureReason  string       `xml:"failureReason,omitempty"`
 }
 
+type BackupMetadata struct {
+	Name           string       `xml:"name,omitempty"`
+	CheckpointName string       `xml:"checkpointName,omitempty"`
+	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time `xml:"endTimestamp,omitempty"`
+	Completed      bool         `xml:"completed,omitempty"`
+	Failed         bool         `xml:"failed,omitempty"`
+	FailureReason  string       `xml:"failureReason,omitempty"`
+	// Exported is set while the disks of a pull mode backup are served by the NBD server
+	Exported bool `xml:"exported,omitempty"`
+	// IncrementalVolumes is a comma separated list of the volumes of which only the blocks changed since the
+	// parent checkpoint were copied
+	IncrementalVolumes string `xml:"incrementalVolumes,omitempty"`
+	// CheckpointVolumes is a comma separated list of the volumes tracked by a dirty bitmap of the new checkpoint
+	CheckpointVolumes string `xml:"checkpointVolumes,omitempty"`
+}
+
 type MigrationMetadata struct {
 	UID            types.UID        `xml:"uid,omitempty"`
 	StartTimestamp *metav1.Time     `xml:"startTimestamp,omitempty"`
@@ -396,6 +422,40 @@ type MigrationMetadata struct {
 	FailureReason  string           `xml:"failureReason,omitempty"`
 	AbortStatus    string           `xml:"abortStatus,omitempty"`
 	Mode           v1.MigrationMode `xml:"mode,omitempty"`
+	// DataTransferred is the number of bytes sent to the target
+	DataTransferred int64 `xml:"dataTransferred,omitempty"`
+	// DowntimeMilliseconds is the time the domain was paused to switch over to the target
+	DowntimeMilliseconds int64 `xml:"downtimeMilliseconds,omitempty"`
+	// SetupTimeMilliseconds is the time the migration spent before it started to transfer data
+	SetupTimeMilliseconds int64 `xml:"setupTimeMilliseconds,omitempty"`
+	// TotalTimeMilliseconds is the time the migration took from its start to its completion
+	TotalTimeMilliseconds int64 `xml:"totalTimeMilliseconds,omitempty"`
+	// Escalation is replaced instead of modified, so that changes are detected by the metadata cache
+	Escalation *MigrationEscalationMetadata `xml:"escalation,omitempty"`
+	// DirtyRate is replaced instead of modified, so that changes are detected by the metadata cache
+	DirtyRate *MigrationDirtyRateMetadata `xml:"dirtyRate,omitempty"`
+}
+
+type MigrationDirtyRateMetadata struct {
+	Samples []MigrationDirtyRateSample `xml:"sample"`
+}
+
+type MigrationDirtyRateSample struct {
+	Timestamp    *metav1.Time `xml:"timestamp,omitempty"`
+	DirtyRate    int64        `xml:"dirtyRate"`
+	TransferRate int64        `xml:"transferRate,omitempty"`
+}
+
+type MigrationEscalationMetadata struct {
+	Transitions []MigrationEscalationTransition `xml:"transition"`
+}
+
+type MigrationEscalationTransition struct {
+	Stage         v1.MigrationEscalationStage `xml:"stage"`
+	Timestamp     *metav1.Time                `xml:"timestamp,omitempty"`
+	DirtyRate     int64                       `xml:"dirtyRate,omitempty"`
+	TransferRate  int64                       `xml:"transferRate,omitempty"`
+	DataRemaining int64                       `xml:"dataRemaining,omitempty"`
 }
 
 type GracePeriodMetadata struct {
@@ -505,6 +565,7 @@ type Devices struct {
 	Serials     []Serial           `xml:"serial"`
 	Consoles    []Console          `xml:"console"`
 	Watchdogs   []Watchdog         `xml:"watchdog,omitempty"`
+	Panics      []PanicDevice      `xml:"panic,omitempty"`
 	Rng         *Rng               `xml:"rng,omitempty"`
 	Filesystems []FilesystemDevice `xml:"filesystem,omitempty"`
 	Redirs      []RedirectedDevice `xml:"redirdev,omitempty"`
@@ -652,6 +713,7 @@ type Disk struct {
 	Capacity           *int64         `xml:"capacity,omitempty"`
 	ExpandDisksEnabled bool           `xml:"expandDisksEnabled,omitempty"`
 	Shareable          *Shareable     `xml:"shareable,omitempty"`
+	IOTune             *IOTune        `xml:"iotune,omitempty"`
 }
 
 type DiskAuth struct {
@@ -715,6 +777,28 @@ type BlockIO struct {
 	PhysicalBlockSize uint `xml:"physical_block_size,attr,omitempty"`
 }
 
+type IOTune struct {
+	TotalBytesSec          uint64 `xml:"total_bytes_sec,omitempty"`
+	ReadBytesSec           uint64 `xml:"read_bytes_sec,omitempty"`
+	WriteBytesSec          uint64 `xml:"write_bytes_sec,omitempty"`
+	TotalIopsSec           uint64 `xml:"total_iops_sec,omitempty"`
+	ReadIopsSec            uint64 `xml:"read_iops_sec,omitempty"`
+	WriteIopsSec           uint64 `xml:"write_iops_sec,omitempty"`
+	TotalBytesSecMax       uint64 `xml:"total_bytes_sec_max,omitempty"`
+	ReadBytesSecMax        uint64 `xml:"read_bytes_sec_max,omitempty"`
+	WriteBytesSecMax       uint64 `xml:"write_bytes_sec_max,omitempty"`
+	TotalIopsSecMax        uint64 `xml:"total_iops_sec_max,omitempty"`
+	ReadIopsSecMax         uint64 `xml:"read_iops_sec_max,omitempty"`
+	WriteIopsSecMax        uint64 `xml:"write_iops_sec_max,omitempty"`
+	TotalBytesSecMaxLength uint64 `xml:"total_bytes_sec_max_length,omitempty"`
+	ReadBytesSecMaxLength  uint64 `xml:"read_bytes_sec_max_length,omitempty"`
+	WriteBytesSecMaxLength uint64 `xml:"write_bytes_sec_max_length,omitempty"`
+	TotalIopsSecMaxLength  uint64 `xml:"total_iops_sec_max_length,omitempty"`
+	ReadIopsSecMaxLength   uint64 `xml:"read_iops_sec_max_length,omitempty"`
+	WriteIopsSecMaxLength  uint64 `xml:"write_iops_sec_max_length,omitempty"`
+	GroupName              string `xml:"group_name,omitempty"`
+}
+
 type Reservations struct {
 	Managed            string              `xml:"managed,attr,omitempty"`
 	SourceReservations *SourceReservations `xml:"source,omitempty"`
@@ -832,6 +916,14 @@ type LinkState struct {
 }
 
 type BandWidth struct {
+	Inbound  *BandWidthLimit `xml:"inbound,omitempty"`
+	Outbound *BandWidthLimit `xml:"outbound,omitempty"`
+}
+
+type BandWidthLimit struct {
+	Average uint64 `xml:"average,attr"`
+	Peak    uint64 `xml:"peak,attr,omitempty"`
+	Burst   uint64 `xml:"burst,attr,omitempty"`
 }
 
 type BootOrder struct {
@@ -1152,6 +1244,13 @@ type Watchdog struct {
 	Address *Address `xml:"address,omitempty"`
 }
 
+// PanicDevice represents a panic device the guest reports crashes through
+// See: https://libvirt.org/formatdomain.html#panic-device
+type PanicDevice struct {
+	Model   string   `xml:"model,attr,omitempty"`
+	Address *Address `xml:"address,omitempty"`
+}
+
 // Rng represents the source of entropy from host to VM
 type Rng struct {
 	// Model attribute specifies what type of RNG device is provided
@@ -1270,6 +1369,61 @@ func (dl *DomainList) GetListMeta() meta.List {
 	return &dl.ListMeta
 }
 
+// DomainBackup is the libvirt domainbackup definition used to start a backup job
+type DomainBackup struct {
+	XMLName     xml.Name            `xml:"domainbackup"`
+	Mode        string              `xml:"mode,attr,omitempty"`
+	Incremental string              `xml:"incremental,omitempty"`
+	Server      *DomainBackupServer `xml:"server,omitempty"`
+	Disks       *DomainBackupDisks  `xml:"disks,omitempty"`
+}
+
+// DomainBackupServer is the NBD server of a pull mode backup
+type DomainBackupServer struct {
+	Transport string `xml:"transport,attr"`
+	Socket    string `xml:"socket,attr"`
+}
+
+type DomainBackupDisks struct {
+	Disks []DomainBackupDisk `xml:"disk"`
+}
+
+type DomainBackupDisk struct {
+	Name         string              `xml:"name,attr"`
+	Backup       string              `xml:"backup,attr,omitempty"`
+	BackupMode   string              `xml:"backupmode,attr,omitempty"`
+	ExportName   string              `xml:"exportname,attr,omitempty"`
+	ExportBitmap string              `xml:"exportbitmap,attr,omitempty"`
+	Type         string              `xml:"type,attr,omitempty"`
+	Target       *DomainBackupTarget `xml:"target,omitempty"`
+	Scratch      *DomainBackupTarget `xml:"scratch,omitempty"`
+	Driver       *DomainBackupDriver `xml:"driver,omitempty"`
+}
+
+type DomainBackupTarget struct {
+	File string `xml:"file,attr"`
+}
+
+type DomainBackupDriver struct {
+	Type string `xml:"type,attr"`
+}
+
+// DomainCheckpoint is the libvirt domaincheckpoint definition created along with a backup job
+type DomainCheckpoint struct {
+	XMLName xml.Name               `xml:"domaincheckpoint"`
+	Name    string                 `xml:"name"`
+	Disks   *DomainCheckpointDisks `xml:"disks,omitempty"`
+}
+
+type DomainCheckpointDisks struct {
+	Disks []DomainCheckpointDisk `xml:"disk"`
+}
+
+type DomainCheckpointDisk struct {
+	Name       string `xml:"name,attr"`
+	Checkpoint string `xml:"checkpoint,attr,omitempty"`
+}
+
 // VMINamespaceKeyFunc constructs the domain name with a namespace prefix i.g.
 // namespace_name.
 func VMINamespaceKeyFunc(vmi *v1.VirtualMachineInstance) string {
@@ -406,3 +406,40 @@ var _ = ginkgo.Describe("JSON marshal of the alias of a domain device", func() {
 		Expect(newAlias.IsUserDefined()).To(BeTrue())
 	})
 })
+
+var _ = ginkgo.Describe("XML marshal of domain backup", func() {
+	ginkgo.It("should generate the expected backup and checkpoint xml", func() {
+		backup := DomainBackup{
+			Mode:        "push",
+			Incremental: "checkpoint-1",
+			Disks: &DomainBackupDisks{
+				Disks: []DomainBackupDisk{
+					{
+						Name:   "vda",
+						Backup: "yes",
+						Type:   "file",
+						Target: &DomainBackupTarget{File: "/backup/vda.qcow2"},
+						Driver: &DomainBackupDriver{Type: "qcow2"},
+					},
+					{Name: "vdb", Backup: "no"},
+				},
+			},
+		}
+		backupXML, err := xml.Marshal(backup)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(backupXML)).To(Equal(`<domainbackup mode="push"><incremental>checkpoint-1</incremental><disks>` +
+			`<disk name="vda" backup="yes" type="file"><target file="/backup/vda.qcow2"></target><driver type="qcow2"></driver></disk>` +
+			`<disk name="vdb" backup="no"></disk></disks></domainbackup>`))
+
+		checkpoint := DomainCheckpoint{
+			Name: "checkpoint-2",
+			Disks: &DomainCheckpointDisks{
+				Disks: []DomainCheckpointDisk{{Name: "vda", Checkpoint: "bitmap"}},
+			},
+		}
+		checkpointXML, err := xml.Marshal(checkpoint)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(checkpointXML)).To(Equal(`<domaincheckpoint><name>checkpoint-2</name><disks>` +
+			`<disk name="vda" checkpoint="bitmap"></disk></disks></domaincheckpoint>`))
+	})
+})
@@ -0,0 +1,457 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"libvirt.org/go/libvirt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+const (
+	failedDomainBackup = "Domain backup failed"
+
+	maxConcurrentBackups = 1
+
+	backupFileFormat = "qcow2"
+
+	backupNBDSocket = "virt-backup-nbd"
+)
+
+var backupJobPollInterval = 1 * time.Second
+
+type backupDisk struct {
+	volumeName string
+	target     string
+	// bitmap is set when the disk image can store the dirty bitmap of a checkpoint
+	bitmap bool
+	// incremental is set when the parent checkpoint has a dirty bitmap for the disk
+	incremental bool
+}
+
+// BackupVirtualMachine starts a backup of the persistent disks of the VMI in the background.
+// The progress and the result of the backup are reported through the domain metadata.
+func (l *LibvirtDomainManager) BackupVirtualMachine(vmi *v1.VirtualMachineInstance, options *cmdclient.BackupOptions) error {
+	select {
+	case l.backupInProgress <- struct{}{}:
+	default:
+		log.Log.Object(vmi).Infof("backup is in progress")
+		return nil
+	}
+
+	go func() {
+		defer func() { <-l.backupInProgress }()
+		if err := l.backup(vmi, options); err != nil {
+			log.Log.Object(vmi).Reason(err).Error(failedDomainBackup)
+		}
+	}()
+	return nil
+}
+
+func (l *LibvirtDomainManager) backup(vmi *v1.VirtualMachineInstance, options *cmdclient.BackupOptions) error {
+	if l.shouldSkipBackup(options.BackupName) {
+		return nil
+	}
+	l.initializeBackupMetadata(options)
+
+	disks, err := l.runBackup(vmi, options)
+	reason := ""
+	if err != nil {
+		reason = fmt.Sprintf("%s: %s", failedDomainBackup, err)
+	}
+	l.setBackupResult(disks, err != nil, reason)
+	return err
+}
+
+// FinishBackup stops the NBD server of a pull mode backup once the client read the disks.
+// Aborting the job is the normal termination of a pull mode backup, libvirt reports it as completed.
+func (l *LibvirtDomainManager) FinishBackup(vmi *v1.VirtualMachineInstance, backupName string) error {
+	backupMetadata, _ := l.metadataCache.Backup.Load()
+	if backupMetadata.Name != backupName || backupMetadata.Completed {
+		return nil
+	}
+	if !backupMetadata.Exported {
+		return fmt.Errorf("backup %s is not exported", backupName)
+	}
+
+	dom, err := l.virConn.LookupDomainByName(api.VMINamespaceKeyFunc(vmi))
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	log.Log.Object(vmi).Infof("Finishing backup %s", backupName)
+	return dom.AbortJob()
+}
+
+func (l *LibvirtDomainManager) runBackup(vmi *v1.VirtualMachineInstance, options *cmdclient.BackupOptions) ([]backupDisk, error) {
+	logger := log.Log.Object(vmi)
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		return nil, err
+	}
+	defer dom.Free()
+
+	domSpec, err := getDomainSpec(dom)
+	if err != nil {
+		return nil, err
+	}
+
+	disks := getBackupDisks(vmi, domSpec)
+	if len(disks) == 0 {
+		return nil, fmt.Errorf("no persistent volumes to back up")
+	}
+
+	targetDir := filepath.Join(options.TargetPath, options.BackupName)
+	if err := os.MkdirAll(targetDir, 0755); err != nil {
+		return nil, err
+	}
+
+	incrementalFrom := options.IncrementalFrom
+	if incrementalFrom != "" {
+		parentDisks, err := ensureCheckpoint(dom, incrementalFrom, disks)
+		if err != nil {
+			// The bitmaps are missing or inconsistent, for example after a crash of the VM
+			logger.Reason(err).Warningf("parent checkpoint %s is not available, taking a full backup", incrementalFrom)
+			incrementalFrom = ""
+		}
+		for i := range disks {
+			_, disks[i].incremental = parentDisks[disks[i].target]
+		}
+	}
+
+	var domainBackup *api.DomainBackup
+	if options.Pull {
+		domainBackup = newPullDomainBackup(disks, targetDir, incrementalFrom, backupNBDSocketPath(vmi))
+	} else {
+		domainBackup = newDomainBackup(disks, targetDir, incrementalFrom)
+	}
+	backupXML, err := xml.Marshal(domainBackup)
+	if err != nil {
+		return nil, err
+	}
+	checkpointXML := ""
+	if hasBitmapDisk(disks) {
+		// Libvirt rejects a checkpoint without any dirty bitmap
+		data, err := xml.Marshal(newDomainCheckpoint(disks, options.CheckpointName))
+		if err != nil {
+			return nil, err
+		}
+		checkpointXML = string(data)
+	}
+
+	logger.Infof("Starting backup %s", options.BackupName)
+	if err := dom.BackupBegin(string(backupXML), checkpointXML, 0); err != nil {
+		return nil, err
+	}
+
+	if options.Pull {
+		// The scratch images only hold the blocks overwritten by the VM while the disks are exported
+		defer os.RemoveAll(targetDir)
+		l.setBackupExported(disks)
+		logger.Infof("Exported backup %s through %s", options.BackupName, backupNBDSocketPath(vmi))
+	}
+
+	if err := waitForBackupJob(dom); err != nil {
+		return nil, err
+	}
+	logger.Infof("Completed backup %s successfully", options.BackupName)
+
+	if incrementalFrom != "" {
+		// The parent bitmap is merged into the new checkpoint, only the latest checkpoint is kept
+		if err := deleteCheckpoint(dom, incrementalFrom); err != nil {
+			logger.Reason(err).Warningf("failed to delete checkpoint %s", incrementalFrom)
+		}
+	}
+
+	return disks, nil
+}
+
+// getBackupDisks returns the writable disks of the domain which are backed by a PVC or a DataVolume
+func getBackupDisks(vmi *v1.VirtualMachineInstance, domSpec *api.DomainSpec) []backupDisk {
+	persistentVolumes := map[string]struct{}{}
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil || volume.DataVolume != nil {
+			persistentVolumes[volume.Name] = struct{}{}
+		}
+	}
+
+	var disks []backupDisk
+	for _, disk := range domSpec.Devices.Disks {
+		if disk.Device != "disk" || disk.ReadOnly != nil || disk.Alias == nil {
+			continue
+		}
+		if _, ok := persistentVolumes[disk.Alias.GetName()]; !ok {
+			continue
+		}
+		disks = append(disks, backupDisk{
+			volumeName: disk.Alias.GetName(),
+			target:     disk.Target.Device,
+			// Only qcow2 images can store the persistent dirty bitmaps of a checkpoint
+			bitmap: disk.Driver != nil && disk.Driver.Type == backupFileFormat,
+		})
+	}
+	return disks
+}
+
+func newDomainBackup(disks []backupDisk, targetDir, incrementalFrom string) *api.DomainBackup {
+	backup := &api.DomainBackup{
+		Mode:        "push",
+		Incremental: incrementalFrom,
+		Disks:       &api.DomainBackupDisks{},
+	}
+	for _, disk := range disks {
+		backupDisk := api.DomainBackupDisk{
+			Name:   disk.target,
+			Backup: "yes",
+			Type:   "file",
+			Target: &api.DomainBackupTarget{
+				File: filepath.Join(targetDir, disk.volumeName+"."+backupFileFormat),
+			},
+			Driver: &api.DomainBackupDriver{
+				Type: backupFileFormat,
+			},
+		}
+		if incrementalFrom != "" && !disk.incremental {
+			// Disks without a dirty bitmap in the parent checkpoint have to be copied entirely
+			backupDisk.BackupMode = "full"
+		}
+		backup.Disks.Disks = append(backup.Disks.Disks, backupDisk)
+	}
+	return backup
+}
+
+// newPullDomainBackup exports each disk under the name of its volume through an NBD server listening on nbdSocket.
+// The blocks changed since the parent checkpoint are exposed by a dirty bitmap named after the volume as well.
+func newPullDomainBackup(disks []backupDisk, targetDir, incrementalFrom, nbdSocket string) *api.DomainBackup {
+	backup := &api.DomainBackup{
+		Mode:        "pull",
+		Incremental: incrementalFrom,
+		Server: &api.DomainBackupServer{
+			Transport: "unix",
+			Socket:    nbdSocket,
+		},
+		Disks: &api.DomainBackupDisks{},
+	}
+	for _, disk := range disks {
+		backupDisk := api.DomainBackupDisk{
+			Name:       disk.target,
+			Backup:     "yes",
+			ExportName: disk.volumeName,
+			Type:       "file",
+			Scratch: &api.DomainBackupTarget{
+				File: filepath.Join(targetDir, disk.volumeName+".scratch."+backupFileFormat),
+			},
+			Driver: &api.DomainBackupDriver{
+				Type: backupFileFormat,
+			},
+		}
+		if incrementalFrom != "" {
+			if disk.incremental {
+				backupDisk.ExportBitmap = disk.volumeName
+			} else {
+				backupDisk.BackupMode = "full"
+			}
+		}
+		backup.Disks.Disks = append(backup.Disks.Disks, backupDisk)
+	}
+	return backup
+}
+
+func backupNBDSocketPath(vmi *v1.VirtualMachineInstance) string {
+	return fmt.Sprintf("/var/run/kubevirt-private/%s/%s", vmi.ObjectMeta.UID, backupNBDSocket)
+}
+
+func newDomainCheckpoint(disks []backupDisk, checkpointName string) *api.DomainCheckpoint {
+	checkpoint := &api.DomainCheckpoint{
+		Name:  checkpointName,
+		Disks: &api.DomainCheckpointDisks{},
+	}
+	for _, disk := range disks {
+		checkpointDisk := api.DomainCheckpointDisk{
+			Name:       disk.target,
+			Checkpoint: "no",
+		}
+		if disk.bitmap {
+			checkpointDisk.Checkpoint = "bitmap"
+		}
+		checkpoint.Disks.Disks = append(checkpoint.Disks.Disks, checkpointDisk)
+	}
+	return checkpoint
+}
+
+func hasBitmapDisk(disks []backupDisk) bool {
+	for _, disk := range disks {
+		if disk.bitmap {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureCheckpoint redefines the checkpoint metadata in case the domain was restarted or migrated since the checkpoint
+// was taken, the dirty bitmaps themselves are persisted in the disk images.
+// It returns the targets of the disks tracked by a dirty bitmap of the checkpoint.
+func ensureCheckpoint(dom cli.VirDomain, checkpointName string, disks []backupDisk) (map[string]struct{}, error) {
+	checkpoint, err := dom.CheckpointLookupByName(checkpointName, 0)
+	if err != nil {
+		checkpointXML, err := xml.Marshal(newDomainCheckpoint(disks, checkpointName))
+		if err != nil {
+			return nil, err
+		}
+		// The validation fails when a bitmap is missing or inconsistent in a disk image
+		checkpoint, err = dom.CreateCheckpointXML(string(checkpointXML), libvirt.DOMAIN_CHECKPOINT_CREATE_REDEFINE|libvirt.DOMAIN_CHECKPOINT_CREATE_REDEFINE_VALIDATE)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer checkpoint.Free()
+
+	checkpointXML, err := checkpoint.GetXMLDesc(0)
+	if err != nil {
+		return nil, err
+	}
+	var spec api.DomainCheckpoint
+	if err := xml.Unmarshal([]byte(checkpointXML), &spec); err != nil {
+		return nil, err
+	}
+
+	bitmapDisks := map[string]struct{}{}
+	if spec.Disks != nil {
+		for _, disk := range spec.Disks.Disks {
+			if disk.Checkpoint == "bitmap" {
+				bitmapDisks[disk.Name] = struct{}{}
+			}
+		}
+	}
+	return bitmapDisks, nil
+}
+
+func deleteCheckpoint(dom cli.VirDomain, checkpointName string) error {
+	checkpoint, err := dom.CheckpointLookupByName(checkpointName, 0)
+	if err != nil {
+		return err
+	}
+	defer checkpoint.Free()
+	return checkpoint.Delete(0)
+}
+
+func waitForBackupJob(dom cli.VirDomain) error {
+	for {
+		jobInfo, err := dom.GetJobInfo()
+		if err != nil {
+			return err
+		}
+		if jobInfo.Type == libvirt.DOMAIN_JOB_NONE {
+			break
+		}
+		time.Sleep(backupJobPollInterval)
+	}
+
+	stats, err := dom.GetJobStats(libvirt.DOMAIN_JOB_STATS_COMPLETED)
+	if err != nil {
+		return err
+	}
+	if stats.Type != libvirt.DOMAIN_JOB_COMPLETED {
+		if stats.ErrorMessageSet {
+			return fmt.Errorf("backup job failed: %s", stats.ErrorMessage)
+		}
+		return fmt.Errorf("backup job failed")
+	}
+	return nil
+}
+
+func (l *LibvirtDomainManager) shouldSkipBackup(backupName string) bool {
+	backupMetadata, _ := l.metadataCache.Backup.Load()
+	// backup still in progress or have just completed
+	return backupMetadata.Name == backupName
+}
+
+func (l *LibvirtDomainManager) initializeBackupMetadata(options *cmdclient.BackupOptions) {
+	l.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, initialized bool) {
+		now := metav1.Now()
+		*backupMetadata = api.BackupMetadata{
+			Name:           options.BackupName,
+			CheckpointName: options.CheckpointName,
+			StartTimestamp: &now,
+		}
+	})
+	log.Log.V(4).Infof("initialize backup metadata: %s", l.metadataCache.Backup.String())
+}
+
+func (l *LibvirtDomainManager) setBackupExported(disks []backupDisk) {
+	l.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, initialized bool) {
+		if !initialized {
+			return
+		}
+
+		backupMetadata.Exported = true
+		setBackupVolumes(backupMetadata, disks)
+	})
+	log.Log.V(4).Infof("set backup exported in metadata: %s", l.metadataCache.Backup.String())
+}
+
+func (l *LibvirtDomainManager) setBackupResult(disks []backupDisk, failed bool, reason string) {
+	l.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, initialized bool) {
+		if !initialized {
+			// nothing to report if backup metadata is empty
+			return
+		}
+
+		now := metav1.Now()
+		backupMetadata.Completed = true
+		backupMetadata.Exported = false
+		backupMetadata.EndTimestamp = &now
+		backupMetadata.Failed = failed
+		backupMetadata.FailureReason = reason
+		setBackupVolumes(backupMetadata, disks)
+	})
+	log.Log.V(4).Infof("set backup results in metadata: %s", l.metadataCache.Backup.String())
+}
+
+func setBackupVolumes(backupMetadata *api.BackupMetadata, disks []backupDisk) {
+	var incrementalVolumes, checkpointVolumes []string
+	for _, disk := range disks {
+		if disk.incremental {
+			incrementalVolumes = append(incrementalVolumes, disk.volumeName)
+		}
+		if disk.bitmap {
+			checkpointVolumes = append(checkpointVolumes, disk.volumeName)
+		}
+	}
+	backupMetadata.IncrementalVolumes = strings.Join(incrementalVolumes, ",")
+	backupMetadata.CheckpointVolumes = strings.Join(checkpointVolumes, ",")
+}
@@ -95,28 +95,44 @@ func getErrorMessage(err error) string {
 }
 
 func (l *Launcher) MigrateVirtualMachine(_ context.Context, request *cmdv1.MigrationRequest) (*cmdv1.Response, error) {
+	return l.migrateVirtualMachine(request, false), nil
+}
+
+// MigrateVirtualMachineVolumes migrates the VMI like MigrateVirtualMachine, the domain manager copies
+// the volumes listed in the migrated volumes of the VMI status to their destination volumes
+func (l *Launcher) MigrateVirtualMachineVolumes(_ context.Context, request *cmdv1.MigrationRequest) (*cmdv1.Response, error) {
+	return l.migrateVirtualMachine(request, true), nil
+}
+
+func (l *Launcher) migrateVirtualMachine(request *cmdv1.MigrationRequest, volumesRequired bool) *cmdv1.Response {
 
 	vmi, response := getVMIFromRequest(request.Vmi)
 	if !response.Success {
-		return response, nil
+		return response
+	}
+
+	if volumesRequired && len(vmi.Status.MigratedVolumes) == 0 {
+		response.Success = false
+		response.Message = "No migrated volumes present in the vmi status"
+		return response
 	}
 
 	options, err := getMigrationOptionsFromRequest(request)
 	if err != nil {
 		response.Success = false
 		response.Message = err.Error()
-		return response, nil
+		return response
 	}
 
 	if err := l.domainManager.MigrateVMI(vmi, options); err != nil {
 		log.Log.Object(vmi).Reason(err).Errorf("Failed to migrate vmi")
 		response.Success = false
 		response.Message = getErrorMessage(err)
-		return response, nil
+		return response
 	}
 
 	log.Log.Object(vmi).Info("Signaled vmi migration")
-	return response, nil
+	return response
 }
 
 func (l *Launcher) CancelVirtualMachineMigration(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
@@ -259,6 +275,45 @@ func (l *Launcher) VirtualMachineMemoryDump(_ context.Context, request *cmdv1.Me
 	return response, nil
 }
 
+func (l *Launcher) BackupVirtualMachine(_ context.Context, request *cmdv1.BackupRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	options := &cmdclient.BackupOptions{
+		BackupName:      request.BackupName,
+		CheckpointName:  request.CheckpointName,
+		IncrementalFrom: request.IncrementalFrom,
+		TargetPath:      request.TargetPath,
+		Pull:            request.Pull,
+	}
+	if err := l.domainManager.BackupVirtualMachine(vmi, options); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to backup vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	return response, nil
+}
+
+func (l *Launcher) FinishBackup(_ context.Context, request *cmdv1.BackupRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.FinishBackup(vmi, request.BackupName); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to finish the backup of vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	return response, nil
+}
+
 func (l *Launcher) FreezeVirtualMachine(_ context.Context, request *cmdv1.FreezeRequest) (*cmdv1.Response, error) {
 	vmi, response := getVMIFromRequest(request.Vmi)
 	if !response.Success {
@@ -128,6 +128,18 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should call backup", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			options := &cmdclient.BackupOptions{
+				BackupName:      "backup",
+				CheckpointName:  "checkpoint-2",
+				IncrementalFrom: "checkpoint-1",
+				TargetPath:      "path/to/backup/volBackup",
+			}
+			domainManager.EXPECT().BackupVirtualMachine(vmi, options)
+			Expect(client.BackupVirtualMachine(vmi, options)).To(Succeed())
+		})
+
 		It("should pause a vmi", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			domainManager.EXPECT().PauseVMI(vmi)
@@ -279,6 +291,36 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(client.FinalizeVirtualMachineMigration(vmi)).ToNot(Succeed())
 		})
 
+		It("should migrate a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().MigrateVMI(vmi, gomock.Any()).Return(nil)
+
+			Expect(client.MigrateVirtualMachine(vmi, &cmdclient.MigrationOptions{})).Should(Succeed())
+		})
+
+		It("should fail to migrate a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().MigrateVMI(vmi, gomock.Any()).Return(errors.New("error"))
+
+			Expect(client.MigrateVirtualMachine(vmi, &cmdclient.MigrationOptions{})).ToNot(Succeed())
+		})
+
+		It("should migrate the volumes of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			vmi.Status.MigratedVolumes = []v1.StorageMigratedVolumeInfo{{VolumeName: "disk0"}}
+			options := &cmdclient.MigrationOptions{}
+			// The zero Bandwidth quantity does not survive the JSON round trip unchanged
+			domainManager.EXPECT().MigrateVMI(vmi, gomock.Any()).Return(nil)
+
+			Expect(client.MigrateVirtualMachineVolumes(vmi, options)).Should(Succeed())
+		})
+
+		It("should fail to migrate the volumes of a vmi without migrated volumes", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+
+			Expect(client.MigrateVirtualMachineVolumes(vmi, &cmdclient.MigrationOptions{})).ToNot(Succeed())
+		})
+
 		It("should get the qemu version", func() {
 			server := &Launcher{
 				domainManager: domainManager,
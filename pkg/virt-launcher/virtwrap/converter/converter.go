@@ -252,6 +252,7 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 	if diskDevice.BootOrder != nil {
 		disk.BootOrder = &api.BootOrder{Order: *diskDevice.BootOrder}
 	}
+	disk.IOTune = Convert_v1_DiskIOTune_To_api_IOTune(diskDevice.IOTune)
 	if c.UseLaunchSecurity && disk.Target.Bus == v1.DiskBusVirtio {
 		disk.Driver.IOMMU = "on"
 	}
@@ -317,6 +318,39 @@ func setErrorPolicy(diskDevice *v1.Disk, disk *api.Disk) error {
 	return nil
 }
 
+func Convert_v1_DiskIOTune_To_api_IOTune(ioTune *v1.DiskIOTune) *api.IOTune {
+	if ioTune == nil {
+		return nil
+	}
+	value := func(v *uint64) uint64 {
+		if v == nil {
+			return 0
+		}
+		return *v
+	}
+	return &api.IOTune{
+		TotalBytesSec:          value(ioTune.TotalBytesSec),
+		ReadBytesSec:           value(ioTune.ReadBytesSec),
+		WriteBytesSec:          value(ioTune.WriteBytesSec),
+		TotalIopsSec:           value(ioTune.TotalIOPSSec),
+		ReadIopsSec:            value(ioTune.ReadIOPSSec),
+		WriteIopsSec:           value(ioTune.WriteIOPSSec),
+		TotalBytesSecMax:       value(ioTune.TotalBytesSecMax),
+		ReadBytesSecMax:        value(ioTune.ReadBytesSecMax),
+		WriteBytesSecMax:       value(ioTune.WriteBytesSecMax),
+		TotalIopsSecMax:        value(ioTune.TotalIOPSSecMax),
+		ReadIopsSecMax:         value(ioTune.ReadIOPSSecMax),
+		WriteIopsSecMax:        value(ioTune.WriteIOPSSecMax),
+		TotalBytesSecMaxLength: value(ioTune.TotalBytesSecMaxLength),
+		ReadBytesSecMaxLength:  value(ioTune.ReadBytesSecMaxLength),
+		WriteBytesSecMaxLength: value(ioTune.WriteBytesSecMaxLength),
+		TotalIopsSecMaxLength:  value(ioTune.TotalIOPSSecMaxLength),
+		ReadIopsSecMaxLength:   value(ioTune.ReadIOPSSecMaxLength),
+		WriteIopsSecMaxLength:  value(ioTune.WriteIOPSSecMaxLength),
+		GroupName:              ioTune.GroupName,
+	}
+}
+
 type DirectIOChecker interface {
 	CheckBlockDevice(path string) (bool, error)
 	CheckFile(path string) (bool, error)
@@ -945,6 +979,31 @@ func Convert_v1_Watchdog_To_api_Watchdog(source *v1.Watchdog, watchdog *api.Watc
 	return fmt.Errorf("watchdog %s can't be mapped, no watchdog type specified", source.Name)
 }
 
+func Convert_v1_PanicDevice_To_api_PanicDevice(source *v1.PanicDevice, panicDevice *api.PanicDevice, _ *ConverterContext) error {
+	switch source.Model {
+	case "", v1.PanicDeviceModelPVPanic:
+		panicDevice.Model = string(v1.PanicDeviceModelPVPanic)
+	case v1.PanicDeviceModelISA, v1.PanicDeviceModelHyperV:
+		panicDevice.Model = string(source.Model)
+	default:
+		return fmt.Errorf("panic device model %s is not supported", source.Model)
+	}
+	return nil
+}
+
+// ConvertCrashActionToOnCrash returns the libvirt on_crash action for a crash action
+func ConvertCrashActionToOnCrash(action v1.CrashAction) (string, error) {
+	switch action {
+	case "", v1.CrashActionPoweroff:
+		return "destroy", nil
+	case v1.CrashActionRestart:
+		return "restart", nil
+	case v1.CrashActionPreserve:
+		return "preserve", nil
+	}
+	return "", fmt.Errorf("crash action %s is not supported", action)
+}
+
 func Convert_v1_Rng_To_api_Rng(_ *v1.Rng, rng *api.Rng, c *ConverterContext) error {
 
 	// default rng model for KVM/QEMU virtualization
@@ -1369,6 +1428,12 @@ func Convert_v1_Firmware_To_related_apis(vmi *v1.VirtualMachineInstance, domain
 		domain.Spec.OS.KernelArgs = firmware.KernelBoot.KernelArgs
 	}
 
+	if firmware.GenerationID != nil {
+		domain.Spec.GenID = &api.GenID{
+			Value: string(firmware.GenerationID.UUID),
+		}
+	}
+
 	if firmware.ACPI != nil {
 		if slicNameRef := firmware.ACPI.SlicNameRef; slicNameRef != "" {
 			path := fmt.Sprintf("/var/run/kubevirt-private/secret/%s/slic.bin", slicNameRef)
@@ -1731,6 +1796,20 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		domain.Spec.Devices.Watchdogs = append(domain.Spec.Devices.Watchdogs, *newWatchdog)
 	}
 
+	if vmi.Spec.Domain.Devices.PanicDevice != nil {
+		newPanic := &api.PanicDevice{}
+		err := Convert_v1_PanicDevice_To_api_PanicDevice(vmi.Spec.Domain.Devices.PanicDevice, newPanic, c)
+		if err != nil {
+			return err
+		}
+		domain.Spec.Devices.Panics = append(domain.Spec.Devices.Panics, *newPanic)
+
+		domain.Spec.OnCrash, err = ConvertCrashActionToOnCrash(vmi.Spec.Domain.Devices.PanicDevice.OnCrash)
+		if err != nil {
+			return err
+		}
+	}
+
 	if vmi.Spec.Domain.Devices.Rng != nil {
 		newRng := &api.Rng{}
 		err := Convert_v1_Rng_To_api_Rng(vmi.Spec.Domain.Devices.Rng, newRng, c)
@@ -238,6 +238,35 @@ var _ = Describe("Converter", func() {
 			Expect(xml).To(Equal(expectedXML))
 		})
 
+		It("should set disk IO throttling if requested", func() {
+			v1Disk := &v1.Disk{
+				IOTune: &v1.DiskIOTune{
+					TotalBytesSec:        kubevirtpointer.P(uint64(1000)),
+					ReadIOPSSec:          kubevirtpointer.P(uint64(100)),
+					WriteIOPSSec:         kubevirtpointer.P(uint64(50)),
+					ReadIOPSSecMax:       kubevirtpointer.P(uint64(200)),
+					ReadIOPSSecMaxLength: kubevirtpointer.P(uint64(10)),
+					GroupName:            "group1",
+				},
+			}
+			xml := diskToDiskXML(v1Disk)
+			expectedXML := `<Disk device="" type="">
+  <source></source>
+  <target></target>
+  <driver name="qemu" type=""></driver>
+  <alias name="ua-"></alias>
+  <iotune>
+    <total_bytes_sec>1000</total_bytes_sec>
+    <read_iops_sec>100</read_iops_sec>
+    <write_iops_sec>50</write_iops_sec>
+    <read_iops_sec_max>200</read_iops_sec_max>
+    <read_iops_sec_max_length>10</read_iops_sec_max_length>
+    <group_name>group1</group_name>
+  </iotune>
+</Disk>`
+			Expect(xml).To(Equal(expectedXML))
+		})
+
 		It("Should omit boot order when not provided", func() {
 			kubevirtDisk := &v1.Disk{
 				Name: "mydisk",
@@ -1480,6 +1509,66 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces[0].BootOrder.Order).To(Equal(uint(bootOrder)))
 			Expect(domain.Spec.Devices.Interfaces[1].BootOrder).To(BeNil())
 		})
+		It("should set the bandwidth limits of the interface", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			iface1 := v1.DefaultBridgeNetworkInterface()
+			iface2 := v1.DefaultBridgeNetworkInterface()
+			net1 := v1.DefaultPodNetwork()
+			net2 := v1.DefaultPodNetwork()
+			iface1.Name = netName1
+			iface2.Name = netName2
+			iface1.Bandwidth = &v1.InterfaceBandwidth{
+				Inbound: &v1.InterfaceBandwidthLimit{
+					Average: 1000,
+					Peak:    kubevirtpointer.P(uint64(5000)),
+					Burst:   kubevirtpointer.P(uint64(1024)),
+				},
+				Outbound: &v1.InterfaceBandwidthLimit{Average: 128},
+			}
+			net1.Name = netName1
+			net2.Name = netName2
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*iface1, *iface2}
+			vmi.Spec.Networks = []v1.Network{*net1, *net2}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(2))
+			Expect(domain.Spec.Devices.Interfaces[0].BandWidth).To(Equal(&api.BandWidth{
+				Inbound:  &api.BandWidthLimit{Average: 1000, Peak: 5000, Burst: 1024},
+				Outbound: &api.BandWidthLimit{Average: 128},
+			}))
+			Expect(domain.Spec.Devices.Interfaces[1].BandWidth).To(BeNil())
+
+			bandwidthXML, err := xml.Marshal(domain.Spec.Devices.Interfaces[0].BandWidth)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(bandwidthXML)).To(Equal(`<BandWidth><inbound average="1000" peak="5000" burst="1024"></inbound><outbound average="128"></outbound></BandWidth>`))
+		})
+		It("should set the link state of the interface", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			iface1 := v1.DefaultBridgeNetworkInterface()
+			iface2 := v1.DefaultBridgeNetworkInterface()
+			iface3 := v1.DefaultBridgeNetworkInterface()
+			net1 := v1.DefaultPodNetwork()
+			net2 := v1.DefaultPodNetwork()
+			net3 := v1.DefaultPodNetwork()
+			iface1.Name = netName1
+			iface2.Name = netName2
+			iface3.Name = "red"
+			iface1.State = v1.InterfaceStateLinkDown
+			iface2.State = v1.InterfaceStateLinkUp
+			net1.Name = netName1
+			net2.Name = netName2
+			net3.Name = "red"
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*iface1, *iface2, *iface3}
+			vmi.Spec.Networks = []v1.Network{*net1, *net2, *net3}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(3))
+			Expect(domain.Spec.Devices.Interfaces[0].LinkState).To(Equal(&api.LinkState{State: "down"}))
+			Expect(domain.Spec.Devices.Interfaces[1].LinkState).To(Equal(&api.LinkState{State: "up"}))
+			Expect(domain.Spec.Devices.Interfaces[2].LinkState).To(BeNil())
+		})
 		It("Should create network configuration for masquerade interface", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 
@@ -2937,6 +3026,66 @@ var _ = Describe("Converter", func() {
 		)
 	})
 
+	Context("with a panic device", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = kvapi.NewMinimalVMI("testvmi")
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+		})
+
+		It("should not add a panic device or crash action by default", func() {
+			domain := vmiToDomain(vmi, &ConverterContext{AllowEmulation: true})
+			Expect(domain.Spec.Devices.Panics).To(BeEmpty())
+			Expect(domain.Spec.OnCrash).To(BeEmpty())
+		})
+
+		DescribeTable("should convert", func(panicDevice *v1.PanicDevice, expectedModel, expectedOnCrash string) {
+			vmi.Spec.Domain.Devices.PanicDevice = panicDevice
+			domain := vmiToDomain(vmi, &ConverterContext{AllowEmulation: true})
+			Expect(domain.Spec.Devices.Panics).To(ConsistOf(api.PanicDevice{Model: expectedModel}))
+			Expect(domain.Spec.OnCrash).To(Equal(expectedOnCrash))
+		},
+			Entry("defaults to pvpanic and destroy", &v1.PanicDevice{}, "pvpanic", "destroy"),
+			Entry("isa with restart", &v1.PanicDevice{Model: v1.PanicDeviceModelISA, OnCrash: v1.CrashActionRestart}, "isa", "restart"),
+			Entry("hyperv with preserve", &v1.PanicDevice{Model: v1.PanicDeviceModelHyperV, OnCrash: v1.CrashActionPreserve}, "hyperv", "preserve"),
+			Entry("pvpanic with poweroff", &v1.PanicDevice{Model: v1.PanicDeviceModelPVPanic, OnCrash: v1.CrashActionPoweroff}, "pvpanic", "destroy"),
+		)
+
+		It("should fail on an unknown crash action", func() {
+			vmi.Spec.Domain.Devices.PanicDevice = &v1.PanicDevice{OnCrash: "coredump"}
+			domain := &api.Domain{}
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, &ConverterContext{AllowEmulation: true})).To(MatchError(ContainSubstring("crash action coredump is not supported")))
+		})
+	})
+
+	Context("with a VM Generation ID", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = kvapi.NewMinimalVMI("testvmi")
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+		})
+
+		It("should not add a genid by default", func() {
+			domain := vmiToDomain(vmi, &ConverterContext{AllowEmulation: true})
+			Expect(domain.Spec.GenID).To(BeNil())
+		})
+
+		DescribeTable("should add a genid", func(generationID v1.GenerationID, expectedXML string) {
+			vmi.Spec.Domain.Firmware = &v1.Firmware{GenerationID: &generationID}
+			domain := vmiToDomain(vmi, &ConverterContext{AllowEmulation: true})
+			Expect(domain.Spec.GenID).To(Equal(&api.GenID{Value: string(generationID.UUID)}))
+
+			xmlBytes, err := xml.Marshal(domain.Spec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(xmlBytes)).To(ContainSubstring(expectedXML))
+		},
+			Entry("with the given UUID", v1.GenerationID{UUID: "fd1cc7b3-7f8e-4c4b-b0b6-0a0b8d1f8e22"}, "<genid>fd1cc7b3-7f8e-4c4b-b0b6-0a0b8d1f8e22</genid>"),
+			Entry("generated by libvirt without UUID", v1.GenerationID{}, "<genid></genid>"),
+		)
+	})
+
 	Context("with Paused strategy", func() {
 		var (
 			vmi *v1.VirtualMachineInstance
@@ -81,6 +81,12 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 			domainIface.ACPI = &api.ACPI{Index: uint(iface.ACPIIndex)}
 		}
 
+		domainIface.BandWidth = Convert_v1_InterfaceBandwidth_To_api_BandWidth(iface.Bandwidth)
+
+		if iface.State == v1.InterfaceStateLinkUp || iface.State == v1.InterfaceStateLinkDown {
+			domainIface.LinkState = &api.LinkState{State: string(iface.State)}
+		}
+
 		if c.DomainAttachmentByInterfaceName[iface.Name] == string(v1.Tap) {
 			// use "ethernet" interface type, since we're using pre-configured tap devices
 			// https://libvirt.org/formatdomain.html#elementsNICSEthernet
@@ -109,6 +115,33 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 	return domainInterfaces, nil
 }
 
+func Convert_v1_InterfaceBandwidth_To_api_BandWidth(bandwidth *v1.InterfaceBandwidth) *api.BandWidth {
+	if bandwidth == nil || (bandwidth.Inbound == nil && bandwidth.Outbound == nil) {
+		return nil
+	}
+	return &api.BandWidth{
+		Inbound:  convertInterfaceBandwidthLimit(bandwidth.Inbound),
+		Outbound: convertInterfaceBandwidthLimit(bandwidth.Outbound),
+	}
+}
+
+func convertInterfaceBandwidthLimit(limit *v1.InterfaceBandwidthLimit) *api.BandWidthLimit {
+	if limit == nil {
+		return nil
+	}
+	value := func(v *uint64) uint64 {
+		if v == nil {
+			return 0
+		}
+		return *v
+	}
+	return &api.BandWidthLimit{
+		Average: limit.Average,
+		Peak:    value(limit.Peak),
+		Burst:   value(limit.Burst),
+	}
+}
+
 func GetInterfaceType(iface *v1.Interface) string {
 	if iface.Model != "" {
 		return iface.Model
@@ -59,6 +59,15 @@ func PlacePCIDevicesOnRootComplex(spec *api.DomainSpec) (err error) {
 			return err
 		}
 	}
+	for i, panicDevice := range spec.Devices.Panics {
+		if panicDevice.Model != string(v1.PanicDeviceModelPVPanic) {
+			continue
+		}
+		spec.Devices.Panics[i].Address, err = assigner.PlacePCIDeviceAtNextSlot(panicDevice.Address)
+		if err != nil {
+			return err
+		}
+	}
 	if spec.Devices.Rng != nil {
 		spec.Devices.Rng.Address, err = assigner.PlacePCIDeviceAtNextSlot(spec.Devices.Rng.Address)
 		if err != nil {
@@ -286,6 +286,26 @@ func (_mr *_MockDomainManagerRecorder) MemoryDump(arg0, arg1 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "MemoryDump", arg0, arg1)
 }
 
+func (_m *MockDomainManager) BackupVirtualMachine(vmi *v1.VirtualMachineInstance, options *cmd_client.BackupOptions) error {
+	ret := _m.ctrl.Call(_m, "BackupVirtualMachine", vmi, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) BackupVirtualMachine(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BackupVirtualMachine", arg0, arg1)
+}
+
+func (_m *MockDomainManager) FinishBackup(vmi *v1.VirtualMachineInstance, backupName string) error {
+	ret := _m.ctrl.Call(_m, "FinishBackup", vmi, backupName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) FinishBackup(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "FinishBackup", arg0, arg1)
+}
+
 func (_m *MockDomainManager) GetQemuVersion() (string, error) {
 	ret := _m.ctrl.Call(_m, "GetQemuVersion")
 	ret0, _ := ret[0].(string)
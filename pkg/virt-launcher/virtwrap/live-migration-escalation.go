@@ -0,0 +1,243 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package virtwrap
+
+import (
+	"math"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
+)
+
+const (
+	defaultEscalationStageDuration             = int64(30)
+	defaultEscalationDirtyRateThresholdPercent = uint64(80)
+	defaultAutoConvergeInitial                 = int32(20)
+	defaultAutoConvergeIncrement               = int32(10)
+
+	// libvirt rejects bandwidths above this limit, it is used to lift the limit of a running migration
+	unlimitedMigrationBandwidthMiB = uint64(math.MaxInt64 >> 20)
+)
+
+var defaultEscalationRemainingDataThreshold = resource.MustParse("256Mi")
+
+// migrationEscalation walks a migration which does not converge through the
+// escalation stages allowed by its migration options
+type migrationEscalation struct {
+	stages  []v1.MigrationEscalationStage
+	current int
+
+	stageDuration             int64
+	dirtyRateThresholdPercent uint64
+	remainingDataThreshold    uint64
+	bandwidthMiB              uint64
+
+	stageStart          int64
+	stageStartRemaining uint64
+}
+
+// newMigrationEscalation returns nil if the escalation of migrations is not enabled
+func newMigrationEscalation(options *cmdclient.MigrationOptions) (*migrationEscalation, error) {
+	config := options.Escalation
+	if config == nil {
+		return nil, nil
+	}
+
+	e := &migrationEscalation{
+		stages:                    []v1.MigrationEscalationStage{v1.MigrationEscalationPreCopy},
+		stageDuration:             defaultEscalationStageDuration,
+		dirtyRateThresholdPercent: defaultEscalationDirtyRateThresholdPercent,
+		remainingDataThreshold:    uint64(defaultEscalationRemainingDataThreshold.Value()),
+		bandwidthMiB:              unlimitedMigrationBandwidthMiB,
+	}
+	if config.StageDuration != nil {
+		e.stageDuration = *config.StageDuration
+	}
+	if config.DirtyRateThresholdPercent != nil {
+		e.dirtyRateThresholdPercent = uint64(*config.DirtyRateThresholdPercent)
+	}
+	if config.RemainingDataThreshold != nil {
+		e.remainingDataThreshold = uint64(config.RemainingDataThreshold.Value())
+	}
+	if config.Bandwidth != nil {
+		bandwidth, err := vcpu.QuantityToMebiByte(*config.Bandwidth)
+		if err != nil {
+			return nil, err
+		}
+		if bandwidth > 0 {
+			e.bandwidthMiB = bandwidth
+		}
+	}
+
+	bandwidth, err := vcpu.QuantityToMebiByte(options.Bandwidth)
+	if err != nil {
+		return nil, err
+	}
+	if bandwidth > 0 && e.bandwidthMiB > bandwidth {
+		e.stages = append(e.stages, v1.MigrationEscalationBandwidth)
+	}
+	if options.AllowAutoConverge {
+		e.stages = append(e.stages, v1.MigrationEscalationAutoConverge)
+	}
+	if options.AllowPostCopy {
+		e.stages = append(e.stages, v1.MigrationEscalationPostCopy)
+	}
+
+	return e, nil
+}
+
+// autoConvergeThrottling returns the auto-converge parameters a migration with escalation starts with
+func autoConvergeThrottling(config *v1.MigrationEscalation) (initial, increment int32) {
+	initial, increment = defaultAutoConvergeInitial, defaultAutoConvergeIncrement
+	if config.AutoConvergeInitial != nil {
+		initial = *config.AutoConvergeInitial
+	}
+	if config.AutoConvergeIncrement != nil {
+		increment = *config.AutoConvergeIncrement
+	}
+	return initial, increment
+}
+
+func (e *migrationEscalation) stage() v1.MigrationEscalationStage {
+	return e.stages[e.current]
+}
+
+func (e *migrationEscalation) nextStage() v1.MigrationEscalationStage {
+	return e.stages[e.current+1]
+}
+
+// enterStage moves the escalation to the given stage, which may skip stages
+func (e *migrationEscalation) enterStage(stage v1.MigrationEscalationStage, now int64, stats *libvirt.DomainJobInfo) {
+	for i := e.current; i < len(e.stages); i++ {
+		if e.stages[i] == stage {
+			e.current = i
+			break
+		}
+	}
+	e.stageStart = now
+	e.stageStartRemaining = 0
+	if stats != nil && stats.DataRemainingSet {
+		e.stageStartRemaining = stats.DataRemaining
+	}
+}
+
+// shouldEscalate returns true if the migration spent the stage duration in its stage without converging
+func (e *migrationEscalation) shouldEscalate(now int64, stats *libvirt.DomainJobInfo) bool {
+	if e.current >= len(e.stages)-1 || !stats.DataRemainingSet {
+		return false
+	}
+	if e.stageStartRemaining == 0 {
+		// No data was measured yet when the stage was entered
+		e.stageStartRemaining = stats.DataRemaining
+	}
+	if (now-e.stageStart)/int64(time.Second) < e.stageDuration {
+		return false
+	}
+	// The migration is about to complete
+	if stats.DataRemaining < e.remainingDataThreshold {
+		return false
+	}
+	if stats.DataRemaining >= e.stageStartRemaining {
+		return true
+	}
+	if !stats.MemDirtyRateSet || !stats.MemPageSizeSet || !stats.MemBpsSet {
+		return false
+	}
+	return dirtyRate(stats)*100 >= stats.MemBps*e.dirtyRateThresholdPercent
+}
+
+func dirtyRate(stats *libvirt.DomainJobInfo) uint64 {
+	return stats.MemDirtyRate * stats.MemPageSize
+}
+
+func (m *migrationMonitor) shouldEscalate(now int64, stats *libvirt.DomainJobInfo) bool {
+	return m.escalation != nil && m.escalation.shouldEscalate(now, stats)
+}
+
+// escalate applies the next escalation stage to the migration
+func (m *migrationMonitor) escalate(dom cli.VirDomain, now int64, stats *libvirt.DomainJobInfo) {
+	logger := log.Log.Object(m.vmi)
+	stage := m.escalation.nextStage()
+	logger.Infof("Escalating migration from stage %s to %s", m.escalation.stage(), stage)
+
+	switch stage {
+	case v1.MigrationEscalationBandwidth:
+		if err := dom.MigrateSetMaxSpeed(m.escalation.bandwidthMiB, 0); err != nil {
+			logger.Reason(err).Error("failed to raise the migration bandwidth")
+			return
+		}
+	case v1.MigrationEscalationAutoConverge:
+		// libvirt cannot change the auto-converge parameters of a running migration. QEMU throttles the
+		// guest with the parameters the migration started with, the stage holds off post-copy meanwhile.
+	case v1.MigrationEscalationPostCopy:
+		if err := dom.MigrateStartPostCopy(uint32(0)); err != nil {
+			logger.Reason(err).Error("failed to start post migration")
+			return
+		}
+		m.l.updateVMIMigrationMode(v1.MigrationPostCopy)
+	}
+
+	m.enterEscalationStage(stage, now, stats)
+}
+
+func (m *migrationMonitor) enterEscalationStage(stage v1.MigrationEscalationStage, now int64, stats *libvirt.DomainJobInfo) {
+	if m.escalation == nil {
+		return
+	}
+	m.escalation.enterStage(stage, now, stats)
+	m.l.addMigrationEscalationTransition(stage, stats)
+}
+
+func (l *LibvirtDomainManager) addMigrationEscalationTransition(stage v1.MigrationEscalationStage, stats *libvirt.DomainJobInfo) {
+	now := metav1.Now()
+	transition := api.MigrationEscalationTransition{
+		Stage:     stage,
+		Timestamp: &now,
+	}
+	if stats != nil {
+		if stats.MemDirtyRateSet && stats.MemPageSizeSet {
+			transition.DirtyRate = int64(dirtyRate(stats))
+		}
+		if stats.MemBpsSet {
+			transition.TransferRate = int64(stats.MemBps)
+		}
+		if stats.DataRemainingSet {
+			transition.DataRemaining = int64(stats.DataRemaining)
+		}
+	}
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		escalation := &api.MigrationEscalationMetadata{}
+		if migrationMetadata.Escalation != nil {
+			escalation.Transitions = append(escalation.Transitions, migrationMetadata.Escalation.Transitions...)
+		}
+		escalation.Transitions = append(escalation.Transitions, transition)
+		migrationMetadata.Escalation = escalation
+	})
+}
@@ -0,0 +1,235 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package virtwrap
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Migration escalation", func() {
+	const mib = uint64(1024 * 1024)
+
+	newOptions := func() *cmdclient.MigrationOptions {
+		return &cmdclient.MigrationOptions{
+			Bandwidth:         resource.MustParse("64Mi"),
+			AllowAutoConverge: true,
+			AllowPostCopy:     true,
+			Escalation: &v1.MigrationEscalation{
+				StageDuration: pointer.Int64(10),
+			},
+		}
+	}
+
+	jobInfo := func(remaining, dirtyPages, bps uint64) *libvirt.DomainJobInfo {
+		return &libvirt.DomainJobInfo{
+			DataRemainingSet: true,
+			DataRemaining:    remaining,
+			MemDirtyRateSet:  true,
+			MemDirtyRate:     dirtyPages,
+			MemPageSizeSet:   true,
+			MemPageSize:      4096,
+			MemBpsSet:        true,
+			MemBps:           bps,
+		}
+	}
+
+	seconds := func(s int64) int64 {
+		return s * int64(time.Second)
+	}
+
+	Context("stages", func() {
+		It("should not escalate without an escalation configuration", func() {
+			options := newOptions()
+			options.Escalation = nil
+			escalation, err := newMigrationEscalation(options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(escalation).To(BeNil())
+		})
+
+		It("should include all stages allowed by the migration options", func() {
+			escalation, err := newMigrationEscalation(newOptions())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(escalation.stages).To(Equal([]v1.MigrationEscalationStage{
+				v1.MigrationEscalationPreCopy,
+				v1.MigrationEscalationBandwidth,
+				v1.MigrationEscalationAutoConverge,
+				v1.MigrationEscalationPostCopy,
+			}))
+			Expect(escalation.bandwidthMiB).To(Equal(unlimitedMigrationBandwidthMiB))
+		})
+
+		It("should skip the stages which are not allowed", func() {
+			options := newOptions()
+			options.AllowAutoConverge = false
+			options.AllowPostCopy = false
+			escalation, err := newMigrationEscalation(options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(escalation.stages).To(Equal([]v1.MigrationEscalationStage{
+				v1.MigrationEscalationPreCopy,
+				v1.MigrationEscalationBandwidth,
+			}))
+		})
+
+		It("should skip the bandwidth stage if the bandwidth is not limited", func() {
+			options := newOptions()
+			options.Bandwidth = resource.MustParse("0")
+			escalation, err := newMigrationEscalation(options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(escalation.stages).ToNot(ContainElement(v1.MigrationEscalationBandwidth))
+		})
+
+		It("should skip the bandwidth stage if the escalated bandwidth is not higher", func() {
+			options := newOptions()
+			bandwidth := resource.MustParse("32Mi")
+			options.Escalation.Bandwidth = &bandwidth
+			escalation, err := newMigrationEscalation(options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(escalation.stages).ToNot(ContainElement(v1.MigrationEscalationBandwidth))
+		})
+
+		It("should start auto-converge with the configured throttling", func() {
+			options := newOptions()
+			initial, increment := autoConvergeThrottling(options.Escalation)
+			Expect(initial).To(Equal(defaultAutoConvergeInitial))
+			Expect(increment).To(Equal(defaultAutoConvergeIncrement))
+
+			options.Escalation.AutoConvergeInitial = pointer.Int32(40)
+			options.Escalation.AutoConvergeIncrement = pointer.Int32(5)
+			initial, increment = autoConvergeThrottling(options.Escalation)
+			Expect(initial).To(Equal(int32(40)))
+			Expect(increment).To(Equal(int32(5)))
+		})
+	})
+
+	Context("progress", func() {
+		var escalation *migrationEscalation
+
+		BeforeEach(func() {
+			var err error
+			escalation, err = newMigrationEscalation(newOptions())
+			Expect(err).ToNot(HaveOccurred())
+			escalation.enterStage(v1.MigrationEscalationPreCopy, 0, jobInfo(1024*mib, 0, 0))
+		})
+
+		It("should not escalate before the stage duration passed", func() {
+			Expect(escalation.shouldEscalate(seconds(5), jobInfo(2048*mib, 0, 0))).To(BeFalse())
+		})
+
+		It("should escalate if the remaining data does not decrease", func() {
+			Expect(escalation.shouldEscalate(seconds(10), jobInfo(1024*mib, 0, 0))).To(BeTrue())
+		})
+
+		It("should escalate if the dirty rate is close to the transfer rate", func() {
+			// 9*4096 bytes are dirtied for every 10*4096 bytes transferred
+			Expect(escalation.shouldEscalate(seconds(10), jobInfo(512*mib, 9, 10*4096))).To(BeTrue())
+		})
+
+		It("should not escalate if the migration makes progress", func() {
+			Expect(escalation.shouldEscalate(seconds(10), jobInfo(512*mib, 1, 10*4096))).To(BeFalse())
+		})
+
+		It("should not escalate if the migration is about to complete", func() {
+			Expect(escalation.shouldEscalate(seconds(10), jobInfo(128*mib, 9, 10*4096))).To(BeFalse())
+		})
+
+		It("should not escalate beyond the last stage", func() {
+			escalation.enterStage(v1.MigrationEscalationPostCopy, seconds(10), jobInfo(1024*mib, 0, 0))
+			Expect(escalation.stage()).To(Equal(v1.MigrationEscalationPostCopy))
+			Expect(escalation.shouldEscalate(seconds(30), jobInfo(1024*mib, 0, 0))).To(BeFalse())
+		})
+	})
+
+	Context("monitor", func() {
+		var mockDomain *cli.MockVirDomain
+		var metadataCache *metadata.Cache
+		var monitor *migrationMonitor
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDomain = cli.NewMockVirDomain(ctrl)
+			metadataCache = metadata.NewCache()
+
+			escalation, err := newMigrationEscalation(newOptions())
+			Expect(err).ToNot(HaveOccurred())
+			monitor = &migrationMonitor{
+				vmi:        newVMI("testnamespace", "testvmi"),
+				l:          &LibvirtDomainManager{metadataCache: metadataCache},
+				escalation: escalation,
+			}
+			monitor.enterEscalationStage(v1.MigrationEscalationPreCopy, 0, jobInfo(1024*mib, 0, 0))
+		})
+
+		transitions := func() []api.MigrationEscalationTransition {
+			migrationMetadata, _ := metadataCache.Migration.Load()
+			Expect(migrationMetadata.Escalation).ToNot(BeNil())
+			return migrationMetadata.Escalation.Transitions
+		}
+
+		It("should lift the bandwidth limit, wait for auto-converge and start post copy when escalating", func() {
+			mockDomain.EXPECT().MigrateSetMaxSpeed(unlimitedMigrationBandwidthMiB, libvirt.DomainMigrateMaxSpeedFlags(0)).Return(nil)
+			monitor.escalate(mockDomain, seconds(10), jobInfo(1024*mib, 9, 10*4096))
+			Expect(monitor.escalation.stage()).To(Equal(v1.MigrationEscalationBandwidth))
+
+			// The auto-converge parameters are passed when the migration starts
+			monitor.escalate(mockDomain, seconds(20), jobInfo(1024*mib, 9, 10*4096))
+			Expect(monitor.escalation.stage()).To(Equal(v1.MigrationEscalationAutoConverge))
+
+			mockDomain.EXPECT().MigrateStartPostCopy(uint32(0)).Return(nil)
+			monitor.escalate(mockDomain, seconds(30), jobInfo(1024*mib, 9, 10*4096))
+			Expect(monitor.escalation.stage()).To(Equal(v1.MigrationEscalationPostCopy))
+
+			var stages []v1.MigrationEscalationStage
+			for _, transition := range transitions() {
+				stages = append(stages, transition.Stage)
+			}
+			Expect(stages).To(Equal([]v1.MigrationEscalationStage{
+				v1.MigrationEscalationPreCopy,
+				v1.MigrationEscalationBandwidth,
+				v1.MigrationEscalationAutoConverge,
+				v1.MigrationEscalationPostCopy,
+			}))
+			last := transitions()[3]
+			Expect(last.DirtyRate).To(Equal(int64(9 * 4096)))
+			Expect(last.TransferRate).To(Equal(int64(10 * 4096)))
+			Expect(last.DataRemaining).To(Equal(int64(1024 * mib)))
+		})
+
+		It("should stay in the stage if the escalation fails", func() {
+			mockDomain.EXPECT().MigrateSetMaxSpeed(gomock.Any(), gomock.Any()).Return(libvirt.Error{Code: libvirt.ERR_OPERATION_FAILED})
+			monitor.escalate(mockDomain, seconds(10), jobInfo(1024*mib, 9, 10*4096))
+			Expect(monitor.escalation.stage()).To(Equal(v1.MigrationEscalationPreCopy))
+			Expect(transitions()).To(HaveLen(1))
+		})
+	})
+})
@@ -61,6 +61,11 @@ const (
 	monitorSleepPeriodMS = 400
 	monitorLogPeriodMS   = 4000
 	monitorLogInterval   = monitorLogPeriodMS / monitorSleepPeriodMS
+
+	monitorDirtyRateSamplePeriodMS = 10000
+	monitorDirtyRateSampleInterval = monitorDirtyRateSamplePeriodMS / monitorSleepPeriodMS
+	// only the most recent samples are kept in the migration metadata
+	maxMigrationDirtyRateSamples = 30
 )
 
 type migrationDisks struct {
@@ -79,10 +84,13 @@ type migrationMonitor struct {
 	lastProgressUpdate int64
 	progressWatermark  uint64
 	remainingData      uint64
+	processedData      uint64
 
 	progressTimeout          int64
 	acceptableCompletionTime int64
 	migrationFailedWithError error
+
+	escalation *migrationEscalation
 }
 
 type inflightMigrationAborted struct {
@@ -110,6 +118,9 @@ func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdcl
 	}
 	if options.ParallelMigrationThreads != nil {
 		migrateFlags |= libvirt.MIGRATE_PARALLEL
+		if options.Compression != nil {
+			migrateFlags |= libvirt.MIGRATE_COMPRESSED
+		}
 	}
 
 	return migrateFlags
@@ -220,6 +231,14 @@ func migratableDomXML(dom cli.VirDomain, vmi *v1.VirtualMachineInstance, domSpec
 
 	}
 
+	if state := vmi.Status.MigrationState; state != nil && state.TargetVirtualMachineInstanceUID != "" && domcfg.Metadata != nil {
+		// The receiving VMI of a cross-cluster migration has a UID of its own,
+		// the target needs to recognize the domain as the one of its VMI
+		domcfg.Metadata.XML = strings.Replace(domcfg.Metadata.XML,
+			fmt.Sprintf("<uid>%s</uid>", vmi.UID),
+			fmt.Sprintf("<uid>%s</uid>", state.TargetVirtualMachineInstanceUID), 1)
+	}
+
 	return domcfg.Marshal()
 }
 
@@ -244,8 +263,16 @@ func classifyVolumesForMigration(vmi *v1.VirtualMachineInstance) *migrationDisks
 		shared:    make(map[string]bool),
 		generated: make(map[string]bool),
 	}
+	migratedVolumes := make(map[string]bool)
+	for _, v := range vmi.Status.MigratedVolumes {
+		migratedVolumes[v.VolumeName] = true
+	}
 	for _, volume := range vmi.Spec.Volumes {
 		volSrc := volume.VolumeSource
+		if migratedVolumes[volume.Name] {
+			// The content of the volume is copied to the destination volume
+			continue
+		}
 		if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil ||
 			(volSrc.HostDisk != nil && *volSrc.HostDisk.Shared) {
 			disks.shared[volume.Name] = true
@@ -391,6 +418,57 @@ func (l *LibvirtDomainManager) setMigrationAbortStatus(abortStatus v1.MigrationA
 	return l.setMigrationResultHelper(false, false, "", abortStatus)
 }
 
+// setMigrationStats records the data sent to the target. The stats of a completed
+// migration job additionally provide the downtime of the domain and the time the migration took.
+func (l *LibvirtDomainManager) setMigrationStats(processedData uint64, completedStats *libvirt.DomainJobInfo) {
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, initialized bool) {
+		if !initialized {
+			return
+		}
+		migrationMetadata.DataTransferred = int64(processedData)
+		if completedStats == nil {
+			return
+		}
+		if completedStats.DataProcessedSet {
+			migrationMetadata.DataTransferred = int64(completedStats.DataProcessed)
+		}
+		if completedStats.DowntimeSet {
+			migrationMetadata.DowntimeMilliseconds = int64(completedStats.Downtime)
+		}
+		if completedStats.SetupTimeSet {
+			migrationMetadata.SetupTimeMilliseconds = int64(completedStats.SetupTime)
+		}
+		if completedStats.TimeElapsedSet {
+			migrationMetadata.TotalTimeMilliseconds = int64(completedStats.TimeElapsed)
+		}
+	})
+}
+
+func (l *LibvirtDomainManager) addMigrationDirtyRateSample(stats *libvirt.DomainJobInfo) {
+	if !stats.MemDirtyRateSet || !stats.MemPageSizeSet {
+		return
+	}
+	now := metav1.Now()
+	sample := api.MigrationDirtyRateSample{
+		Timestamp: &now,
+		DirtyRate: int64(dirtyRate(stats)),
+	}
+	if stats.MemBpsSet {
+		sample.TransferRate = int64(stats.MemBps)
+	}
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		var samples []api.MigrationDirtyRateSample
+		if migrationMetadata.DirtyRate != nil {
+			samples = append(samples, migrationMetadata.DirtyRate.Samples...)
+		}
+		samples = append(samples, sample)
+		if len(samples) > maxMigrationDirtyRateSamples {
+			samples = samples[len(samples)-maxMigrationDirtyRateSamples:]
+		}
+		migrationMetadata.DirtyRate = &api.MigrationDirtyRateMetadata{Samples: samples}
+	})
+}
+
 func newMigrationMonitor(vmi *v1.VirtualMachineInstance, l *LibvirtDomainManager, options *cmdclient.MigrationOptions, migrationErr chan error) *migrationMonitor {
 	monitor := &migrationMonitor{
 		l:                        l,
@@ -403,6 +481,12 @@ func newMigrationMonitor(vmi *v1.VirtualMachineInstance, l *LibvirtDomainManager
 		acceptableCompletionTime: options.CompletionTimeoutPerGiB * getVMIMigrationDataSize(vmi, l.ephemeralDiskDir),
 	}
 
+	escalation, err := newMigrationEscalation(options)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to configure the migration escalation, the migration will not be escalated")
+	}
+	monitor.escalation = escalation
+
 	return monitor
 }
 
@@ -502,6 +586,11 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 		// If we were to abort the migration due to a timeout while in post copy,
 		// then it would result in that active state being lost.
 
+	case m.shouldEscalate(now, stats):
+		// a migration which doesn't converge is escalated
+		// step by step when the escalation is enabled
+		m.escalate(dom, now, stats)
+
 	case m.shouldTriggerPostCopy(elapsed):
 		logger.Info("Starting post copy mode for migration")
 		// if a migration has stalled too long, post copy will be
@@ -513,6 +602,7 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 		}
 
 		m.l.updateVMIMigrationMode(v1.MigrationPostCopy)
+		m.enterEscalationStage(v1.MigrationEscalationPostCopy, now, stats)
 
 	case !m.isMigrationProgressing():
 		// check if the migration is still progressing
@@ -565,6 +655,7 @@ func (m *migrationMonitor) startMonitor() {
 
 	m.start = time.Now().UTC().UnixNano()
 	m.lastProgressUpdate = m.start
+	m.enterEscalationStage(v1.MigrationEscalationPreCopy, m.start, nil)
 
 	logger := log.Log.Object(vmi)
 	defer func() {
@@ -596,6 +687,7 @@ func (m *migrationMonitor) startMonitor() {
 			if strings.Contains(m.migrationFailedWithError.Error(), "canceled by client") {
 				abortStatus = v1.MigrationAbortSucceeded
 			}
+			m.l.setMigrationStats(m.processedData, nil)
 			m.l.setMigrationResult(true, fmt.Sprintf("Live migration failed %v", m.migrationFailedWithError), abortStatus)
 			return
 		}
@@ -612,12 +704,16 @@ func (m *migrationMonitor) startMonitor() {
 		if stats.DataRemainingSet {
 			m.remainingData = stats.DataRemaining
 		}
+		if stats.DataProcessedSet {
+			m.processedData = stats.DataProcessed
+		}
 
 		switch stats.Type {
 		case libvirt.DOMAIN_JOB_UNBOUNDED:
 			aborted := m.processInflightMigration(dom, stats)
 			if aborted != nil {
 				logger.Errorf("Live migration abort detected with reason: %s", aborted.message)
+				m.l.setMigrationStats(m.processedData, nil)
 				m.l.setMigrationResult(true, aborted.message, aborted.abortStatus)
 				return
 			}
@@ -625,18 +721,24 @@ func (m *migrationMonitor) startMonitor() {
 			if logInterval%monitorLogInterval == 0 {
 				logMigrationInfo(logger, string(vmi.Status.MigrationState.MigrationUID), stats)
 			}
+			if logInterval%monitorDirtyRateSampleInterval == 0 {
+				m.l.addMigrationDirtyRateSample(stats)
+			}
 		case libvirt.DOMAIN_JOB_NONE:
 			completedJobInfo = m.determineNonRunningMigrationStatus(dom)
 		case libvirt.DOMAIN_JOB_COMPLETED:
 			logger.Info("Migration has been completed")
+			m.l.setMigrationStats(m.processedData, stats)
 			m.l.setMigrationResult(false, "", "")
 			return
 		case libvirt.DOMAIN_JOB_FAILED:
 			logger.Info("Migration job failed")
+			m.l.setMigrationStats(m.processedData, nil)
 			m.l.setMigrationResult(true, fmt.Sprintf("%v", m.migrationFailedWithError), "")
 			return
 		case libvirt.DOMAIN_JOB_CANCELLED:
 			logger.Info("Migration was canceled")
+			m.l.setMigrationStats(m.processedData, nil)
 			m.l.setMigrationResult(true, "Live migration aborted ", v1.MigrationAbortSucceeded)
 			return
 		}
@@ -693,7 +795,7 @@ func (l *LibvirtDomainManager) asyncMigrationAbort(vmi *v1.VirtualMachineInstanc
 }
 
 func isBlockMigration(vmi *v1.VirtualMachineInstance) bool {
-	return vmi.Status.MigrationMethod == v1.BlockMigration
+	return vmi.Status.MigrationMethod == v1.BlockMigration || len(vmi.Status.MigratedVolumes) > 0
 }
 
 func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions, virtShareDir string, domSpec *api.DomainSpec) (*libvirt.DomainMigrateParameters, error) {
@@ -729,6 +831,18 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 		ParallelConnections:    parallelMigrationThreads,
 	}
 
+	if options.AllowAutoConverge && options.Escalation != nil {
+		initial, increment := autoConvergeThrottling(options.Escalation)
+		params.AutoConvergeInitialSet = true
+		params.AutoConvergeInitial = int(initial)
+		params.AutoConvergeIncrementSet = true
+		params.AutoConvergeIncrement = int(increment)
+	}
+
+	if parallelMigrationSet && options.Compression != nil {
+		setMigrationCompression(params, options.Compression)
+	}
+
 	copyDisks := getDiskTargetsForMigration(dom, vmi)
 	if len(copyDisks) != 0 {
 		params.MigrateDisks = copyDisks
@@ -744,6 +858,23 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 	return params, nil
 }
 
+// setMigrationCompression configures the compression of the multifd channels
+func setMigrationCompression(params *libvirt.DomainMigrateParameters, compression *v1.MigrationCompression) {
+	params.CompressionSet = true
+	params.Compression = string(compression.Method)
+	if compression.Level == nil {
+		return
+	}
+	switch compression.Method {
+	case v1.MigrationCompressionZlib:
+		params.CompressionZlibLevelSet = true
+		params.CompressionZlibLevel = int(*compression.Level)
+	case v1.MigrationCompressionZstd:
+		params.CompressionZstdLevelSet = true
+		params.CompressionZstdLevel = int(*compression.Level)
+	}
+}
+
 func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) error {
 
 	var err error
@@ -788,6 +919,12 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 		return err
 	}
 
+	if options.MaxDowntimeMilliseconds > 0 {
+		if err := dom.MigrateSetMaxDowntime(options.MaxDowntimeMilliseconds, 0); err != nil {
+			return fmt.Errorf("failed to set the maximum downtime of the migration: %v", err)
+		}
+	}
+
 	// initiate the live migration
 	var dstURI string
 	if virtutil.IsNonRootVMI(vmi) {
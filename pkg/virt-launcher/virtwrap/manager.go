@@ -134,6 +134,8 @@ type DomainManager interface {
 	Exec(string, string, []string, int32) (string, error)
 	GuestPing(string) error
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
+	BackupVirtualMachine(vmi *v1.VirtualMachineInstance, options *cmdclient.BackupOptions) error
+	FinishBackup(vmi *v1.VirtualMachineInstance, backupName string) error
 	GetQemuVersion() (string, error)
 	UpdateVCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
@@ -154,6 +156,7 @@ type LibvirtDomainManager struct {
 
 	hotplugHostDevicesInProgress chan struct{}
 	memoryDumpInProgress         chan struct{}
+	backupInProgress             chan struct{}
 
 	virtShareDir             string
 	ephemeralDiskDir         string
@@ -220,6 +223,7 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 
 	manager.hotplugHostDevicesInProgress = make(chan struct{}, maxConcurrentHotplugHostDevices)
 	manager.memoryDumpInProgress = make(chan struct{}, maxConcurrentMemoryDumps)
+	manager.backupInProgress = make(chan struct{}, maxConcurrentBackups)
 	manager.credManager = accesscredentials.NewManager(connection, &manager.domainModifyLock, metadataCache)
 
 	return &manager, nil
@@ -1185,6 +1189,21 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 	}
 
 	if vmi.IsRunning() {
+		if err := syncDisksIOTune(dom, oldSpec.Devices.Disks, domain.Spec.Devices.Disks); err != nil {
+			logger.Reason(err).Error("updating the IO throttling of the disks failed")
+			return nil, err
+		}
+
+		if err := syncInterfacesBandwidth(dom, oldSpec.Devices.Interfaces, domain.Spec.Devices.Interfaces); err != nil {
+			logger.Reason(err).Error("updating the bandwidth limits of the interfaces failed")
+			return nil, err
+		}
+
+		if err := syncInterfacesLinkState(dom, oldSpec.Devices.Interfaces, domain.Spec.Devices.Interfaces); err != nil {
+			logger.Reason(err).Error("updating the link state of the interfaces failed")
+			return nil, err
+		}
+
 		var domainAttachments map[string]string
 		if options != nil {
 			domainAttachments = options.GetInterfaceDomainAttachment()
@@ -1291,6 +1310,189 @@ func getAttachedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
 	return res
 }
 
+// syncDisksIOTune applies the changed IO throttling of the disks to the running domain
+func syncDisksIOTune(dom cli.VirDomain, oldDisks, newDisks []api.Disk) error {
+	oldDiskMap := make(map[string]api.Disk)
+	for _, disk := range oldDisks {
+		if disk.Alias != nil {
+			oldDiskMap[disk.Alias.GetName()] = disk
+		}
+	}
+	for _, newDisk := range newDisks {
+		if newDisk.Alias == nil {
+			continue
+		}
+		oldDisk, ok := oldDiskMap[newDisk.Alias.GetName()]
+		if !ok || !ioTuneChanged(oldDisk.IOTune, newDisk.IOTune) {
+			continue
+		}
+		log.Log.V(1).Infof("Updating IO throttling of disk %s, target %s", newDisk.Alias.GetName(), newDisk.Target.Device)
+		if err := dom.SetBlockIoTune(newDisk.Target.Device, toBlockIoTuneParameters(newDisk.IOTune), libvirt.DOMAIN_AFFECT_LIVE); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ioTuneChanged(oldIOTune, newIOTune *api.IOTune) bool {
+	if oldIOTune == nil || newIOTune == nil {
+		return oldIOTune != newIOTune
+	}
+	old := *oldIOTune
+	// libvirt names the throttling group after the disk when no group is requested
+	if newIOTune.GroupName == "" {
+		old.GroupName = ""
+	}
+	return old != *newIOTune
+}
+
+// toBlockIoTuneParameters sets all the limits, so that removed limits are reset
+func toBlockIoTuneParameters(ioTune *api.IOTune) *libvirt.DomainBlockIoTuneParameters {
+	if ioTune == nil {
+		ioTune = &api.IOTune{}
+	}
+	return &libvirt.DomainBlockIoTuneParameters{
+		TotalBytesSecSet:          true,
+		TotalBytesSec:             ioTune.TotalBytesSec,
+		ReadBytesSecSet:           true,
+		ReadBytesSec:              ioTune.ReadBytesSec,
+		WriteBytesSecSet:          true,
+		WriteBytesSec:             ioTune.WriteBytesSec,
+		TotalIopsSecSet:           true,
+		TotalIopsSec:              ioTune.TotalIopsSec,
+		ReadIopsSecSet:            true,
+		ReadIopsSec:               ioTune.ReadIopsSec,
+		WriteIopsSecSet:           true,
+		WriteIopsSec:              ioTune.WriteIopsSec,
+		TotalBytesSecMaxSet:       true,
+		TotalBytesSecMax:          ioTune.TotalBytesSecMax,
+		ReadBytesSecMaxSet:        true,
+		ReadBytesSecMax:           ioTune.ReadBytesSecMax,
+		WriteBytesSecMaxSet:       true,
+		WriteBytesSecMax:          ioTune.WriteBytesSecMax,
+		TotalIopsSecMaxSet:        true,
+		TotalIopsSecMax:           ioTune.TotalIopsSecMax,
+		ReadIopsSecMaxSet:         true,
+		ReadIopsSecMax:            ioTune.ReadIopsSecMax,
+		WriteIopsSecMaxSet:        true,
+		WriteIopsSecMax:           ioTune.WriteIopsSecMax,
+		TotalBytesSecMaxLengthSet: true,
+		TotalBytesSecMaxLength:    ioTune.TotalBytesSecMaxLength,
+		ReadBytesSecMaxLengthSet:  true,
+		ReadBytesSecMaxLength:     ioTune.ReadBytesSecMaxLength,
+		WriteBytesSecMaxLengthSet: true,
+		WriteBytesSecMaxLength:    ioTune.WriteBytesSecMaxLength,
+		TotalIopsSecMaxLengthSet:  true,
+		TotalIopsSecMaxLength:     ioTune.TotalIopsSecMaxLength,
+		ReadIopsSecMaxLengthSet:   true,
+		ReadIopsSecMaxLength:      ioTune.ReadIopsSecMaxLength,
+		WriteIopsSecMaxLengthSet:  true,
+		WriteIopsSecMaxLength:     ioTune.WriteIopsSecMaxLength,
+		GroupNameSet:              ioTune.GroupName != "",
+		GroupName:                 ioTune.GroupName,
+	}
+}
+
+// syncInterfacesBandwidth applies the changed bandwidth limits of the interfaces to the running domain
+func syncInterfacesBandwidth(dom cli.VirDomain, oldInterfaces, newInterfaces []api.Interface) error {
+	oldInterfaceMap := make(map[string]api.Interface)
+	for _, iface := range oldInterfaces {
+		if iface.Alias != nil {
+			oldInterfaceMap[iface.Alias.GetName()] = iface
+		}
+	}
+	for _, newInterface := range newInterfaces {
+		if newInterface.Alias == nil {
+			continue
+		}
+		oldInterface, ok := oldInterfaceMap[newInterface.Alias.GetName()]
+		if !ok || oldInterface.MAC == nil || !bandwidthChanged(oldInterface.BandWidth, newInterface.BandWidth) {
+			continue
+		}
+		log.Log.V(1).Infof("Updating bandwidth limits of interface %s, mac %s", newInterface.Alias.GetName(), oldInterface.MAC.MAC)
+		if err := dom.SetInterfaceParameters(oldInterface.MAC.MAC, toInterfaceParameters(newInterface.BandWidth), libvirt.DOMAIN_AFFECT_LIVE); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bandwidthLimits returns the inbound and outbound limits, a zero limit means unlimited
+func bandwidthLimits(bandwidth *api.BandWidth) (inbound, outbound api.BandWidthLimit) {
+	if bandwidth == nil {
+		return
+	}
+	if bandwidth.Inbound != nil {
+		inbound = *bandwidth.Inbound
+	}
+	if bandwidth.Outbound != nil {
+		outbound = *bandwidth.Outbound
+	}
+	return
+}
+
+func bandwidthChanged(oldBandwidth, newBandwidth *api.BandWidth) bool {
+	oldInbound, oldOutbound := bandwidthLimits(oldBandwidth)
+	newInbound, newOutbound := bandwidthLimits(newBandwidth)
+	return oldInbound != newInbound || oldOutbound != newOutbound
+}
+
+// toInterfaceParameters sets all the limits, so that removed limits are reset
+func toInterfaceParameters(bandwidth *api.BandWidth) *libvirt.DomainInterfaceParameters {
+	inbound, outbound := bandwidthLimits(bandwidth)
+	return &libvirt.DomainInterfaceParameters{
+		BandwidthInAverageSet:  true,
+		BandwidthInAverage:     uint(inbound.Average),
+		BandwidthInPeakSet:     true,
+		BandwidthInPeak:        uint(inbound.Peak),
+		BandwidthInBurstSet:    true,
+		BandwidthInBurst:       uint(inbound.Burst),
+		BandwidthOutAverageSet: true,
+		BandwidthOutAverage:    uint(outbound.Average),
+		BandwidthOutPeakSet:    true,
+		BandwidthOutPeak:       uint(outbound.Peak),
+		BandwidthOutBurstSet:   true,
+		BandwidthOutBurst:      uint(outbound.Burst),
+	}
+}
+
+// syncInterfacesLinkState applies the changed link states of the interfaces to the running domain
+func syncInterfacesLinkState(dom cli.VirDomain, oldInterfaces, newInterfaces []api.Interface) error {
+	oldInterfaceMap := make(map[string]api.Interface)
+	for _, iface := range oldInterfaces {
+		if iface.Alias != nil {
+			oldInterfaceMap[iface.Alias.GetName()] = iface
+		}
+	}
+	for _, newInterface := range newInterfaces {
+		if newInterface.Alias == nil {
+			continue
+		}
+		oldInterface, ok := oldInterfaceMap[newInterface.Alias.GetName()]
+		if !ok || linkState(oldInterface.LinkState) == linkState(newInterface.LinkState) {
+			continue
+		}
+		log.Log.V(1).Infof("Setting link state of interface %s to %s", newInterface.Alias.GetName(), linkState(newInterface.LinkState))
+		oldInterface.LinkState = &api.LinkState{State: linkState(newInterface.LinkState)}
+		ifaceBytes, err := xml.Marshal(oldInterface)
+		if err != nil {
+			return err
+		}
+		if err := dom.UpdateDeviceFlags(strings.ToLower(string(ifaceBytes)), libvirt.DOMAIN_DEVICE_MODIFY_LIVE); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// linkState returns the link state of an interface, which is up unless set otherwise
+func linkState(state *api.LinkState) string {
+	if state == nil || state.State == "" {
+		return string(v1.InterfaceStateLinkUp)
+	}
+	return state.State
+}
+
 var isHotplugBlockDeviceVolume = isHotplugBlockDeviceVolumeFunc
 
 func isHotplugBlockDeviceVolumeFunc(volumeName string) bool {
@@ -688,6 +688,195 @@ var _ = Describe("Manager", func() {
 				return memoryDump.Failed
 			}, 5*time.Second).Should(BeTrue(), "failed memory dump result wasn't set")
 		})
+		Context("backup", func() {
+			var (
+				vmi        *v1.VirtualMachineInstance
+				targetDir  string
+				options    *cmdclient.BackupOptions
+				domainSpec *api.DomainSpec
+			)
+
+			expectDomainSpec := func() {
+				domainXML, err := xml.Marshal(domainSpec)
+				Expect(err).ToNot(HaveOccurred())
+				mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+				mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domainXML), nil)
+			}
+
+			expectBackupJobCompleted := func() {
+				mockDomain.EXPECT().GetJobInfo().Return(&libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_NONE}, nil)
+				mockDomain.EXPECT().GetJobStats(libvirt.DOMAIN_JOB_STATS_COMPLETED).Return(&libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_COMPLETED}, nil)
+			}
+
+			waitForBackupResult := func() api.BackupMetadata {
+				Eventually(func() bool {
+					backup, _ := metadataCache.Backup.Load()
+					return backup.Completed
+				}, 5*time.Second, 2).Should(BeTrue())
+				backup, _ := metadataCache.Backup.Load()
+				return backup
+			}
+
+			BeforeEach(func() {
+				vmi = newVMI(testNamespace, testVmName)
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: "disk0",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc"},
+						},
+					},
+				})
+				domainSpec = api.NewMinimalDomainSpec(testDomainName)
+				domainSpec.Devices.Disks = []api.Disk{{
+					Device: "disk",
+					Type:   "file",
+					Source: api.DiskSource{File: "/var/run/kubevirt-private/vmi-disks/disk0/disk.img"},
+					Target: api.DiskTarget{Device: "vda"},
+					Driver: &api.DiskDriver{Name: "qemu", Type: "raw"},
+					Alias:  api.NewUserDefinedAlias("disk0"),
+				}}
+
+				targetDir = GinkgoT().TempDir()
+				options = &cmdclient.BackupOptions{
+					BackupName:     "backup",
+					CheckpointName: "checkpoint-1",
+					TargetPath:     targetDir,
+				}
+			})
+
+			It("should update domain with backup info when completed successfully", func() {
+				domainSpec.Devices.Disks[0].Driver.Type = "qcow2"
+				expectDomainSpec()
+				mockDomain.EXPECT().BackupBegin(gomock.Any(), gomock.Any(), libvirt.DomainBackupBeginFlags(0)).DoAndReturn(
+					func(backupXML, checkpointXML string, _ libvirt.DomainBackupBeginFlags) error {
+						Expect(backupXML).To(ContainSubstring(filepath.Join(targetDir, "backup", "disk0.qcow2")))
+						Expect(checkpointXML).To(ContainSubstring("<name>checkpoint-1</name>"))
+						Expect(checkpointXML).To(ContainSubstring(`<disk name="vda" checkpoint="bitmap"></disk>`))
+						return nil
+					})
+				expectBackupJobCompleted()
+
+				manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+				Expect(manager.BackupVirtualMachine(vmi, options)).To(Succeed())
+
+				backup := waitForBackupResult()
+				Expect(backup.Failed).To(BeFalse())
+				Expect(backup.IncrementalVolumes).To(BeEmpty())
+				Expect(backup.CheckpointVolumes).To(Equal("disk0"))
+				Expect(filepath.Join(targetDir, "backup")).To(BeADirectory())
+
+				// Expect extra call to backup after completion not to start another backup job
+				Expect(manager.BackupVirtualMachine(vmi, options)).To(Succeed())
+			})
+
+			It("should not create a checkpoint without any disk able to store a dirty bitmap", func() {
+				expectDomainSpec()
+				mockDomain.EXPECT().BackupBegin(gomock.Any(), "", libvirt.DomainBackupBeginFlags(0)).Return(nil)
+				expectBackupJobCompleted()
+
+				manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+				Expect(manager.BackupVirtualMachine(vmi, options)).To(Succeed())
+
+				backup := waitForBackupResult()
+				Expect(backup.Failed).To(BeFalse())
+				Expect(backup.CheckpointVolumes).To(BeEmpty())
+			})
+
+			It("should take a full backup when the parent checkpoint cannot be redefined", func() {
+				domainSpec.Devices.Disks[0].Driver.Type = "qcow2"
+				options.IncrementalFrom = "checkpoint-0"
+				expectDomainSpec()
+				mockDomain.EXPECT().CheckpointLookupByName("checkpoint-0", uint32(0)).Return(nil, fmt.Errorf("not found"))
+				mockDomain.EXPECT().CreateCheckpointXML(gomock.Any(), libvirt.DOMAIN_CHECKPOINT_CREATE_REDEFINE|libvirt.DOMAIN_CHECKPOINT_CREATE_REDEFINE_VALIDATE).
+					Return(nil, fmt.Errorf("bitmap is inconsistent"))
+				mockDomain.EXPECT().BackupBegin(gomock.Any(), gomock.Any(), libvirt.DomainBackupBeginFlags(0)).DoAndReturn(
+					func(backupXML, checkpointXML string, _ libvirt.DomainBackupBeginFlags) error {
+						Expect(backupXML).ToNot(ContainSubstring("incremental"))
+						Expect(checkpointXML).To(ContainSubstring("<name>checkpoint-1</name>"))
+						return nil
+					})
+				expectBackupJobCompleted()
+
+				manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+				Expect(manager.BackupVirtualMachine(vmi, options)).To(Succeed())
+
+				backup := waitForBackupResult()
+				Expect(backup.Failed).To(BeFalse())
+				Expect(backup.IncrementalVolumes).To(BeEmpty())
+				Expect(backup.CheckpointVolumes).To(Equal("disk0"))
+			})
+
+			It("should export the disks of a pull mode backup until it is finished", func() {
+				domainSpec.Devices.Disks[0].Driver.Type = "qcow2"
+				options.Pull = true
+				expectDomainSpec()
+				mockDomain.EXPECT().BackupBegin(gomock.Any(), gomock.Any(), libvirt.DomainBackupBeginFlags(0)).DoAndReturn(
+					func(backupXML, checkpointXML string, _ libvirt.DomainBackupBeginFlags) error {
+						Expect(backupXML).To(ContainSubstring(`mode="pull"`))
+						Expect(backupXML).To(ContainSubstring(fmt.Sprintf(`<server transport="unix" socket="/var/run/kubevirt-private/%s/virt-backup-nbd"></server>`, vmi.UID)))
+						Expect(backupXML).To(ContainSubstring(`exportname="disk0"`))
+						Expect(backupXML).To(ContainSubstring(filepath.Join(targetDir, "backup", "disk0.scratch.qcow2")))
+						Expect(backupXML).ToNot(ContainSubstring("<target"))
+						return nil
+					})
+				aborted := make(chan struct{})
+				mockDomain.EXPECT().GetJobInfo().DoAndReturn(func() (*libvirt.DomainJobInfo, error) {
+					select {
+					case <-aborted:
+						return &libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_NONE}, nil
+					default:
+						return &libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_UNBOUNDED}, nil
+					}
+				}).MinTimes(1)
+				mockDomain.EXPECT().GetJobStats(libvirt.DOMAIN_JOB_STATS_COMPLETED).Return(&libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_COMPLETED}, nil)
+
+				manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+				Expect(manager.BackupVirtualMachine(vmi, options)).To(Succeed())
+
+				Eventually(func() bool {
+					backup, _ := metadataCache.Backup.Load()
+					return backup.Exported
+				}, 5*time.Second).Should(BeTrue(), "backup wasn't exported")
+				backup, _ := metadataCache.Backup.Load()
+				Expect(backup.Completed).To(BeFalse())
+				Expect(backup.CheckpointVolumes).To(Equal("disk0"))
+
+				mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+				mockDomain.EXPECT().AbortJob().DoAndReturn(func() error {
+					close(aborted)
+					return nil
+				})
+				Expect(manager.FinishBackup(vmi, "backup")).To(Succeed())
+
+				backup = waitForBackupResult()
+				Expect(backup.Failed).To(BeFalse())
+				Expect(backup.Exported).To(BeFalse())
+				Expect(filepath.Join(targetDir, "backup")).ToNot(BeAnExistingFile())
+			})
+
+			It("should not finish a backup which is not exported", func() {
+				metadataCache.Backup.Store(api.BackupMetadata{Name: "backup"})
+
+				manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+				Expect(manager.FinishBackup(vmi, "backup")).To(MatchError("backup backup is not exported"))
+			})
+
+			It("should update domain with backup info if backup failed", func() {
+				expectDomainSpec()
+				mockDomain.EXPECT().BackupBegin(gomock.Any(), gomock.Any(), libvirt.DomainBackupBeginFlags(0)).Return(fmt.Errorf("backup failed"))
+
+				manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+				Expect(manager.BackupVirtualMachine(vmi, options)).To(Succeed())
+
+				Eventually(func() bool {
+					backup, _ := metadataCache.Backup.Load()
+					return backup.Failed
+				}, 5*time.Second).Should(BeTrue(), "failed backup result wasn't set")
+				backup, _ := metadataCache.Backup.Load()
+				Expect(backup.FailureReason).To(ContainSubstring("backup failed"))
+			})
+		})
 		It("should pause a VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
 
@@ -1785,6 +1974,92 @@ var _ = Describe("Manager", func() {
 				return migration.Failed
 			}, 5*time.Second, 2).Should(BeTrue())
 		})
+		It("should record the transferred data and the downtime of a completed migration", func() {
+			migrationErrorChan := make(chan error)
+			defer close(migrationErrorChan)
+			fake_jobinfo_running := &libvirt.DomainJobInfo{
+				Type:             libvirt.DOMAIN_JOB_UNBOUNDED,
+				DataRemaining:    uint64(32479827777),
+				DataRemainingSet: true,
+				DataProcessed:    uint64(1024),
+				DataProcessedSet: true,
+			}
+			fake_jobinfo := &libvirt.DomainJobInfo{
+				Type:             libvirt.DOMAIN_JOB_COMPLETED,
+				DataProcessed:    uint64(4096),
+				DataProcessedSet: true,
+				Downtime:         uint64(42),
+				DowntimeSet:      true,
+				SetupTime:        uint64(7),
+				SetupTimeSet:     true,
+				TimeElapsed:      uint64(3000),
+				TimeElapsedSet:   true,
+			}
+
+			options := &cmdclient.MigrationOptions{
+				Bandwidth:               resource.MustParse("64Mi"),
+				ProgressTimeout:         3,
+				CompletionTimeoutPerGiB: 150,
+			}
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationUID: "111222333",
+			}
+
+			migrationMetadata, _ := metadataCache.Migration.Load()
+			migrationMetadata.UID = vmi.Status.MigrationState.MigrationUID
+			metadataCache.Migration.Store(migrationMetadata)
+
+			manager := &LibvirtDomainManager{
+				virConn:       mockConn,
+				virtShareDir:  testVirtShareDir,
+				metadataCache: metadataCache,
+			}
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			gomock.InOrder(
+				mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).Return(fake_jobinfo_running, nil),
+				mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).Return(fake_jobinfo, nil),
+			)
+
+			monitor := newMigrationMonitor(vmi, manager, options, migrationErrorChan)
+			monitor.startMonitor()
+
+			migration, _ := metadataCache.Migration.Load()
+			Expect(migration.Completed).To(BeTrue())
+			Expect(migration.DataTransferred).To(Equal(int64(4096)))
+			Expect(migration.DowntimeMilliseconds).To(Equal(int64(42)))
+			Expect(migration.SetupTimeMilliseconds).To(Equal(int64(7)))
+			Expect(migration.TotalTimeMilliseconds).To(Equal(int64(3000)))
+		})
+		It("should only keep the most recent dirty rate samples", func() {
+			manager := &LibvirtDomainManager{
+				metadataCache: metadataCache,
+			}
+			migrationMetadata, _ := metadataCache.Migration.Load()
+			migrationMetadata.UID = "111222333"
+			metadataCache.Migration.Store(migrationMetadata)
+
+			for i := 1; i <= maxMigrationDirtyRateSamples+1; i++ {
+				manager.addMigrationDirtyRateSample(&libvirt.DomainJobInfo{
+					MemDirtyRate:    uint64(i),
+					MemDirtyRateSet: true,
+					MemPageSize:     4096,
+					MemPageSizeSet:  true,
+					MemBps:          uint64(8192),
+					MemBpsSet:       true,
+				})
+			}
+
+			migration, _ := metadataCache.Migration.Load()
+			Expect(migration.DirtyRate).ToNot(BeNil())
+			samples := migration.DirtyRate.Samples
+			Expect(samples).To(HaveLen(maxMigrationDirtyRateSamples))
+			Expect(samples[0].DirtyRate).To(Equal(int64(2 * 4096)))
+			Expect(samples[maxMigrationDirtyRateSamples-1].DirtyRate).To(Equal(int64((maxMigrationDirtyRateSamples + 1) * 4096)))
+			Expect(samples[0].TransferRate).To(Equal(int64(8192)))
+			Expect(samples[0].Timestamp).ToNot(BeNil())
+		})
 
 	})
 
@@ -1858,6 +2133,48 @@ var _ = Describe("Manager", func() {
 				return migration.Failed
 			}, 5*time.Second, 2).Should(BeTrue(), fmt.Sprintf("failed migration result wasn't set [%+v]", migration))
 		})
+		It("should set the maximum downtime before the migration starts", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationUID: "111222333",
+			}
+			domainSpec := expectedDomainFor(vmi)
+			domainSpec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{}
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).AnyTimes().DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetState().AnyTimes().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+
+			domainXml, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).ToNot(HaveOccurred())
+			mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).AnyTimes().Return(&libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_NONE}, nil)
+			mockDomain.EXPECT().GetXMLDesc(gomock.Any()).AnyTimes().Return(string(domainXml), nil)
+
+			metadataXml, err := xml.MarshalIndent(domainSpec.Metadata.KubeVirt, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+			mockDomain.EXPECT().
+				GetMetadata(libvirt.DOMAIN_METADATA_ELEMENT, "http://kubevirt.io", libvirt.DOMAIN_AFFECT_CONFIG).
+				AnyTimes().
+				Return(string(metadataXml), nil)
+
+			gomock.InOrder(
+				mockDomain.EXPECT().MigrateSetMaxDowntime(uint64(100), uint32(0)).Return(nil),
+				mockDomain.EXPECT().MigrateToURI3(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("MigrationFailed")),
+			)
+			options := &cmdclient.MigrationOptions{
+				Bandwidth:               resource.MustParse("64Mi"),
+				ProgressTimeout:         150,
+				CompletionTimeoutPerGiB: 300,
+				MaxDowntimeMilliseconds: 100,
+			}
+			Expect(manager.MigrateVMI(vmi, options)).To(Succeed())
+
+			Eventually(func() bool {
+				migration, _ := metadataCache.Migration.Load()
+				return migration.Failed
+			}, 5*time.Second, 2).Should(BeTrue())
+		})
 
 		It("should detect inprogress migration job", func() {
 			vmi := newVMI(testNamespace, testVmName)
@@ -1924,6 +2241,32 @@ var _ = Describe("Manager", func() {
 			copyDisks := getDiskTargetsForMigration(mockDomain, vmi)
 			Expect(copyDisks).Should(ConsistOf("vdb", "vdd"))
 		})
+		It("should collect the migrated volumes in the list of disks for migration", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "myvolume",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testblock-dst",
+						}},
+					},
+				},
+			}
+			vmi.Status.MigratedVolumes = []v1.StorageMigratedVolumeInfo{
+				{
+					VolumeName:         "myvolume",
+					SourcePVCInfo:      &v1.PersistentVolumeClaimInfo{ClaimName: "testblock"},
+					DestinationPVCInfo: &v1.PersistentVolumeClaimInfo{ClaimName: "testblock-dst"},
+				},
+			}
+
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(embedMigrationDomain, nil)
+
+			copyDisks := getDiskTargetsForMigration(mockDomain, vmi)
+			Expect(copyDisks).Should(ContainElement("vda"))
+			Expect(isBlockMigration(vmi)).To(BeTrue())
+		})
 		AfterEach(func() {
 			ip.GetLoopbackAddress = funcPreviousValue
 		})
@@ -1959,7 +2302,7 @@ var _ = Describe("Manager", func() {
 			isVmiPaused := migrationType == "paused"
 
 			var parallelMigrationThreads *uint = nil
-			if migrationType == "parallel" {
+			if migrationType == "parallel" || migrationType == "compressed" {
 				var fakeNumberOfThreads uint = 123
 				parallelMigrationThreads = &fakeNumberOfThreads
 			}
@@ -1970,6 +2313,9 @@ var _ = Describe("Manager", func() {
 				AllowPostCopy:            migrationType == "postCopy",
 				ParallelMigrationThreads: parallelMigrationThreads,
 			}
+			if migrationType == "compressed" {
+				options.Compression = &v1.MigrationCompression{Method: v1.MigrationCompressionZstd}
+			}
 
 			flags := generateMigrationFlags(isBlockMigration, isVmiPaused, options)
 			expectedMigrateFlags := libvirt.MIGRATE_LIVE | libvirt.MIGRATE_PEER2PEER | libvirt.MIGRATE_PERSIST_DEST
@@ -1991,6 +2337,9 @@ var _ = Describe("Manager", func() {
 			if migrationType == "parallel" {
 				expectedMigrateFlags |= libvirt.MIGRATE_PARALLEL
 			}
+			if migrationType == "compressed" {
+				expectedMigrateFlags |= libvirt.MIGRATE_PARALLEL | libvirt.MIGRATE_COMPRESSED
+			}
 			Expect(flags).To(Equal(expectedMigrateFlags), "libvirt migration flags are not set as expected")
 		},
 		Entry("with block migration", "block"),
@@ -2000,6 +2349,21 @@ var _ = Describe("Manager", func() {
 		Entry("migration using postcopy", "postCopy"),
 		Entry("migration of paused vmi", "paused"),
 		Entry("migration with parallel threads", "parallel"),
+		Entry("compressed migration with parallel threads", "compressed"),
+	)
+
+	DescribeTable("should configure the compression of parallel migrations",
+		func(compression *v1.MigrationCompression, expected *libvirt.DomainMigrateParameters) {
+			params := &libvirt.DomainMigrateParameters{}
+			setMigrationCompression(params, compression)
+			Expect(params).To(Equal(expected))
+		},
+		Entry("with the default level", &v1.MigrationCompression{Method: v1.MigrationCompressionZstd},
+			&libvirt.DomainMigrateParameters{CompressionSet: true, Compression: "zstd"}),
+		Entry("with a zstd level", &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.Int32(3)},
+			&libvirt.DomainMigrateParameters{CompressionSet: true, Compression: "zstd", CompressionZstdLevelSet: true, CompressionZstdLevel: 3}),
+		Entry("with a zlib level", &v1.MigrationCompression{Method: v1.MigrationCompressionZlib, Level: pointer.Int32(9)},
+			&libvirt.DomainMigrateParameters{CompressionSet: true, Compression: "zlib", CompressionZlibLevelSet: true, CompressionZlibLevel: 9}),
 	)
 
 	DescribeTable("on successful list all domains",
@@ -2539,6 +2903,191 @@ var _ = Describe("getDetachedDisks", func() {
 			[]api.Disk{}),
 	)
 })
+var _ = Describe("syncDisksIOTune", func() {
+	var ctrl *gomock.Controller
+	var mockDomain *cli.MockVirDomain
+
+	newDisk := func(name, target string, ioTune *api.IOTune) api.Disk {
+		return api.Disk{
+			Alias:  api.NewUserDefinedAlias(name),
+			Target: api.DiskTarget{Device: target},
+			IOTune: ioTune,
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDomain = cli.NewMockVirDomain(ctrl)
+	})
+
+	It("should not update the IO throttling if nothing changed", func() {
+		disks := []api.Disk{newDisk("disk0", "vda", &api.IOTune{TotalIopsSec: 100})}
+		Expect(syncDisksIOTune(mockDomain, disks, disks)).To(Succeed())
+	})
+
+	It("should ignore the default group name assigned by libvirt", func() {
+		oldDisks := []api.Disk{newDisk("disk0", "vda", &api.IOTune{TotalIopsSec: 100, GroupName: "drive-ua-disk0"})}
+		newDisks := []api.Disk{newDisk("disk0", "vda", &api.IOTune{TotalIopsSec: 100})}
+		Expect(syncDisksIOTune(mockDomain, oldDisks, newDisks)).To(Succeed())
+	})
+
+	It("should update the IO throttling of changed disks", func() {
+		oldDisks := []api.Disk{
+			newDisk("disk0", "vda", &api.IOTune{TotalIopsSec: 100}),
+			newDisk("disk1", "vdb", nil),
+		}
+		newDisks := []api.Disk{
+			newDisk("disk0", "vda", &api.IOTune{ReadIopsSec: 200}),
+			newDisk("disk1", "vdb", nil),
+		}
+		mockDomain.EXPECT().SetBlockIoTune("vda", gomock.Any(), libvirt.DOMAIN_AFFECT_LIVE).DoAndReturn(
+			func(_ string, params *libvirt.DomainBlockIoTuneParameters, _ libvirt.DomainModificationImpact) error {
+				Expect(params.TotalIopsSecSet).To(BeTrue())
+				Expect(params.TotalIopsSec).To(BeZero())
+				Expect(params.ReadIopsSecSet).To(BeTrue())
+				Expect(params.ReadIopsSec).To(Equal(uint64(200)))
+				Expect(params.GroupNameSet).To(BeFalse())
+				return nil
+			})
+		Expect(syncDisksIOTune(mockDomain, oldDisks, newDisks)).To(Succeed())
+	})
+
+	It("should reset the IO throttling if it was removed", func() {
+		oldDisks := []api.Disk{newDisk("disk0", "vda", &api.IOTune{TotalBytesSec: 1024})}
+		newDisks := []api.Disk{newDisk("disk0", "vda", nil)}
+		mockDomain.EXPECT().SetBlockIoTune("vda", &libvirt.DomainBlockIoTuneParameters{
+			TotalBytesSecSet:          true,
+			ReadBytesSecSet:           true,
+			WriteBytesSecSet:          true,
+			TotalIopsSecSet:           true,
+			ReadIopsSecSet:            true,
+			WriteIopsSecSet:           true,
+			TotalBytesSecMaxSet:       true,
+			ReadBytesSecMaxSet:        true,
+			WriteBytesSecMaxSet:       true,
+			TotalIopsSecMaxSet:        true,
+			ReadIopsSecMaxSet:         true,
+			WriteIopsSecMaxSet:        true,
+			TotalBytesSecMaxLengthSet: true,
+			ReadBytesSecMaxLengthSet:  true,
+			WriteBytesSecMaxLengthSet: true,
+			TotalIopsSecMaxLengthSet:  true,
+			ReadIopsSecMaxLengthSet:   true,
+			WriteIopsSecMaxLengthSet:  true,
+		}, libvirt.DOMAIN_AFFECT_LIVE).Return(nil)
+		Expect(syncDisksIOTune(mockDomain, oldDisks, newDisks)).To(Succeed())
+	})
+
+	It("should not touch newly attached disks", func() {
+		newDisks := []api.Disk{newDisk("disk0", "vda", &api.IOTune{TotalIopsSec: 100})}
+		Expect(syncDisksIOTune(mockDomain, []api.Disk{}, newDisks)).To(Succeed())
+	})
+})
+
+var _ = Describe("syncInterfacesBandwidth", func() {
+	var ctrl *gomock.Controller
+	var mockDomain *cli.MockVirDomain
+
+	newInterface := func(name, mac string, bandwidth *api.BandWidth) api.Interface {
+		return api.Interface{
+			Alias:     api.NewUserDefinedAlias(name),
+			MAC:       &api.MAC{MAC: mac},
+			BandWidth: bandwidth,
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDomain = cli.NewMockVirDomain(ctrl)
+	})
+
+	It("should not update the bandwidth limits if nothing changed", func() {
+		interfaces := []api.Interface{newInterface("default", "02:00:00:00:00:01", &api.BandWidth{Inbound: &api.BandWidthLimit{Average: 1000}})}
+		Expect(syncInterfacesBandwidth(mockDomain, interfaces, interfaces)).To(Succeed())
+	})
+
+	It("should treat empty limits as unlimited", func() {
+		oldInterfaces := []api.Interface{newInterface("default", "02:00:00:00:00:01", &api.BandWidth{})}
+		newInterfaces := []api.Interface{newInterface("default", "02:00:00:00:00:01", nil)}
+		Expect(syncInterfacesBandwidth(mockDomain, oldInterfaces, newInterfaces)).To(Succeed())
+	})
+
+	It("should update the bandwidth limits of changed interfaces", func() {
+		oldInterfaces := []api.Interface{
+			newInterface("default", "02:00:00:00:00:01", &api.BandWidth{Inbound: &api.BandWidthLimit{Average: 1000}}),
+			newInterface("red", "02:00:00:00:00:02", nil),
+		}
+		newInterfaces := []api.Interface{
+			newInterface("default", "", &api.BandWidth{Outbound: &api.BandWidthLimit{Average: 128, Peak: 256, Burst: 64}}),
+			newInterface("red", "", nil),
+		}
+		mockDomain.EXPECT().SetInterfaceParameters("02:00:00:00:00:01", &libvirt.DomainInterfaceParameters{
+			BandwidthInAverageSet:  true,
+			BandwidthInPeakSet:     true,
+			BandwidthInBurstSet:    true,
+			BandwidthOutAverageSet: true,
+			BandwidthOutAverage:    128,
+			BandwidthOutPeakSet:    true,
+			BandwidthOutPeak:       256,
+			BandwidthOutBurstSet:   true,
+			BandwidthOutBurst:      64,
+		}, libvirt.DOMAIN_AFFECT_LIVE).Return(nil)
+		Expect(syncInterfacesBandwidth(mockDomain, oldInterfaces, newInterfaces)).To(Succeed())
+	})
+
+	It("should not touch newly attached interfaces", func() {
+		newInterfaces := []api.Interface{newInterface("default", "", &api.BandWidth{Inbound: &api.BandWidthLimit{Average: 1000}})}
+		Expect(syncInterfacesBandwidth(mockDomain, []api.Interface{}, newInterfaces)).To(Succeed())
+	})
+})
+
+var _ = Describe("syncInterfacesLinkState", func() {
+	var ctrl *gomock.Controller
+	var mockDomain *cli.MockVirDomain
+
+	newInterface := func(name string, linkState *api.LinkState) api.Interface {
+		return api.Interface{
+			Type:      "ethernet",
+			Alias:     api.NewUserDefinedAlias(name),
+			MAC:       &api.MAC{MAC: "02:00:00:00:00:01"},
+			LinkState: linkState,
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDomain = cli.NewMockVirDomain(ctrl)
+	})
+
+	It("should not update the link state if nothing changed", func() {
+		oldInterfaces := []api.Interface{newInterface("default", &api.LinkState{State: "up"})}
+		newInterfaces := []api.Interface{newInterface("default", nil)}
+		Expect(syncInterfacesLinkState(mockDomain, oldInterfaces, newInterfaces)).To(Succeed())
+	})
+
+	DescribeTable("should update the link state of changed interfaces", func(oldLinkState, newLinkState *api.LinkState, expectedState string) {
+		oldInterfaces := []api.Interface{newInterface("default", oldLinkState)}
+		newInterfaces := []api.Interface{newInterface("default", newLinkState)}
+
+		mockDomain.EXPECT().UpdateDeviceFlags(gomock.Any(), libvirt.DOMAIN_DEVICE_MODIFY_LIVE).DoAndReturn(func(ifaceXML string, _ libvirt.DomainDeviceModifyFlags) error {
+			Expect(ifaceXML).To(HavePrefix("<interface "))
+			Expect(ifaceXML).To(ContainSubstring(`<mac address="02:00:00:00:00:01"></mac>`))
+			Expect(ifaceXML).To(ContainSubstring(fmt.Sprintf(`<link state="%s"></link>`, expectedState)))
+			return nil
+		})
+		Expect(syncInterfacesLinkState(mockDomain, oldInterfaces, newInterfaces)).To(Succeed())
+	},
+		Entry("to down", nil, &api.LinkState{State: "down"}, "down"),
+		Entry("to up", &api.LinkState{State: "down"}, &api.LinkState{State: "up"}, "up"),
+		Entry("to the default", &api.LinkState{State: "down"}, nil, "up"),
+	)
+
+	It("should not touch newly attached interfaces", func() {
+		newInterfaces := []api.Interface{newInterface("default", &api.LinkState{State: "down"})}
+		Expect(syncInterfacesLinkState(mockDomain, []api.Interface{}, newInterfaces)).To(Succeed())
+	})
+})
+
 var _ = Describe("migratableDomXML", func() {
 	var ctrl *gomock.Controller
 	var mockDomain *cli.MockVirDomain
@@ -2569,6 +3118,28 @@ var _ = Describe("migratableDomXML", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(newXML).To(Equal(expectedXML))
 	})
+	It("should replace the UID of the VMI with the UID of the receiving VMI of a cross-cluster migration", func() {
+		domXML := `<domain type="kvm" id="1">
+  <name>kubevirt</name>
+  <metadata>
+    <kubevirt xmlns="http://kubevirt.io">
+      <uid>source-uid</uid>
+    </kubevirt>
+  </metadata>
+</domain>`
+		vmi := newVMI("testns", "kubevirt")
+		vmi.UID = "source-uid"
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			TargetVirtualMachineInstanceUID: "target-uid",
+		}
+		mockDomain.EXPECT().GetXMLDesc(libvirt.DOMAIN_XML_MIGRATABLE).MaxTimes(1).Return(domXML, nil)
+		domSpec := &api.DomainSpec{}
+		Expect(xml.Unmarshal([]byte(domXML), domSpec)).To(Succeed())
+		newXML, err := migratableDomXML(mockDomain, vmi, domSpec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(newXML).To(ContainSubstring("<uid>target-uid</uid>"))
+		Expect(newXML).ToNot(ContainSubstring("source-uid"))
+	})
 	It("should change CPU pinning according to migration metadata", func() {
 		domXML := `<domain type="kvm" id="1">
   <name>kubevirt</name>
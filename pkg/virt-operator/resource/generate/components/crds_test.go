@@ -87,4 +87,26 @@ var _ = Describe("CRDs", func() {
 			Expect(*metadata.XPreserveUnknownFields).To(BeTrue())
 		}
 	})
+
+	DescribeTable("Scale subresource should refer to fields of the schema", func(crdFunc func() (*extv1.CustomResourceDefinition, error)) {
+		crd, err := crdFunc()
+		Expect(err).NotTo(HaveOccurred())
+		for _, version := range crd.Spec.Versions {
+			Expect(version.Subresources).NotTo(BeNil())
+			scale := version.Subresources.Scale
+			Expect(scale).NotTo(BeNil())
+			Expect(scale.LabelSelectorPath).NotTo(BeNil())
+
+			schema := version.Schema.OpenAPIV3Schema
+			Expect(schema.Properties["spec"].Properties).To(HaveKey("replicas"))
+			Expect(schema.Properties["status"].Properties).To(HaveKey("replicas"))
+			Expect(schema.Properties["status"].Properties).To(HaveKey("labelSelector"))
+			Expect(scale.SpecReplicasPath).To(Equal(".spec.replicas"))
+			Expect(scale.StatusReplicasPath).To(Equal(".status.replicas"))
+			Expect(*scale.LabelSelectorPath).To(Equal(".status.labelSelector"))
+		}
+	},
+		Entry("for VMIRS", NewReplicaSetCrd),
+		Entry("for VMPOOL", NewVirtualMachinePoolCrd),
+	)
 })
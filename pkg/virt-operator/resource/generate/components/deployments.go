@@ -35,6 +35,7 @@ import (
 
 	virtv1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 	operatorutil "kubevirt.io/kubevirt/pkg/virt-operator/util"
 )
 
@@ -355,6 +356,11 @@ func NewApiServerDeployment(namespace, repository, imagePrefix, version, product
 			Protocol:      corev1.ProtocolTCP,
 			ContainerPort: 8443,
 		},
+		{
+			Name:          "migration-tunnel",
+			Protocol:      corev1.ProtocolTCP,
+			ContainerPort: migrations.MigrationTunnelPort,
+		},
 	}
 	container.ReadinessProbe = &corev1.Probe{
 		ProbeHandler: corev1.ProbeHandler{
@@ -1138,6 +1138,15 @@ var CRDsValidation map[string]string = map[string]string{
                 https://kubevirt.io/user-guide/operations/migration_policies/ for
                 more information.
               properties:
+                additionalNetworks:
+                  description: AdditionalNetworks are the names of further CNI
+                    networks virt-handler is attached to. Migration policies can
+                    select one of them as the network of the migrations they
+                    apply to.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
                 allowAutoConverge:
                   description: AllowAutoConverge allows the platform to compromise
                     performance/availability of VMIs to guarantee successful VMI live
@@ -1168,11 +1177,97 @@ var CRDsValidation map[string]string = map[string]string{
                     true. Defaults to 800
                   format: int64
                   type: integer
+                compression:
+                  description: Compression compresses the memory transferred over
+                    the multifd channels. It is only applied to migrations which use
+                    MultifdChannels. Defaults to no compression
+                  properties:
+                    level:
+                      description: Level is the compression level. zlib supports levels
+                        from 0 to 9, zstd from 0 to 20. Defaults to the default level
+                        of the method in QEMU
+                      format: int32
+                      type: integer
+                    method:
+                      description: Method is the compression algorithm, either zstd
+                        or zlib
+                      enum:
+                      - zstd
+                      - zlib
+                      type: string
+                  required:
+                  - method
+                  type: object
+                crossClusterTunnelAddress:
+                  description: CrossClusterTunnelAddress is the host and port other
+                    clusters reach the migration tunnel of virt-api at, which listens
+                    on the port 8444 of the virt-api pods. It has to be exposed outside
+                    of the cluster, e.g. by a service of the type LoadBalancer. Cross-cluster
+                    migrations are only received when it is set
+                  type: string
                 disableTLS:
                   description: When set to true, DisableTLS will disable the additional
                     layer of live migration encryption provided by KubeVirt. This
                     is usually a bad idea. Defaults to false
                   type: boolean
+                escalation:
+                  description: Escalation enables the staged escalation of live migrations
+                    which don't converge. Migrations start in pre-copy and, while
+                    the guest dirties memory faster than it is transferred, escalate
+                    to a raised bandwidth, to auto-converge throttling and finally
+                    to post-copy. The auto-converge and post-copy stages are only
+                    used if AllowAutoConverge and AllowPostCopy are true. Defaults
+                    to no escalation, post-copy is then only triggered by CompletionTimeoutPerGiB
+                  properties:
+                    autoConvergeIncrement:
+                      description: AutoConvergeIncrement is the percentage the throttling
+                        of the guest CPUs is increased by in every iteration which
+                        still does not converge. Must be between 1 and 99. Defaults
+                        to 10
+                      format: int32
+                      type: integer
+                    autoConvergeInitial:
+                      description: AutoConvergeInitial is the percentage the guest
+                        CPUs are throttled by once auto-converge starts throttling
+                        a migration which does not converge. libvirt only accepts
+                        it when the migration starts, so it applies to the whole migration.
+                        Must be between 1 and 99. Defaults to 20
+                      format: int32
+                      type: integer
+                    bandwidth:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: Bandwidth is the bandwidth limit of the raised
+                        bandwidth stage. The value is in quantity per second. Defaults
+                        to 0 (no limit). The stage is skipped if BandwidthPerMigration
+                        does not limit migrations.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    dirtyRateThresholdPercent:
+                      description: DirtyRateThresholdPercent escalates a migration
+                        when the guest dirties memory at more than this percentage
+                        of the transfer rate. Migrations whose remaining data did
+                        not decrease during a stage are escalated as well. Defaults
+                        to 80
+                      format: int32
+                      type: integer
+                    remainingDataThreshold:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: RemainingDataThreshold prevents the escalation
+                        of migrations with less data left to transfer, since they
+                        are about to complete. Defaults to 256Mi
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    stageDuration:
+                      description: StageDuration is the minimum number of seconds
+                        a migration stays in a stage before it escalates to the next
+                        one. Defaults to 30
+                      format: int64
+                      type: integer
+                  type: object
                 matchSELinuxLevelOnMigration:
                   description: By default, the SELinux level of target virt-launcher
                     pods is forced to the level of the source virt-launcher. When
@@ -1182,6 +1277,20 @@ var CRDsValidation map[string]string = map[string]string{
                     migrations will fail when using RWX volumes that don't automatically
                     deal with SELinux levels.
                   type: boolean
+                maxDowntime:
+                  description: MaxDowntime is the maximum time the guest may be paused
+                    to switch over to the target node. A migration does not complete
+                    before its remaining data can be transferred within this time.
+                    Defaults to the default of libvirt, 300ms
+                  type: string
+                multifdChannels:
+                  description: MultifdChannels is the number of parallel connections
+                    (QEMU multifd channels) live migrations transfer the guest memory
+                    over. Multiple channels allow migrations to exceed the throughput
+                    of a single TCP stream. The kubevirt.io/multiThreadedQemuMigration
+                    annotation of a VMI takes precedence. Defaults to a single connection
+                  format: int32
+                  type: integer
                 network:
                   description: Network is the name of the CNI network to use for live
                     migrations. By default, migrations go through the pod network.
@@ -1245,6 +1354,33 @@ var CRDsValidation map[string]string = map[string]string{
                   type: object
                 defaultNetworkInterface:
                   type: string
+                macAddressPool:
+                  description: MacAddressPool defines the MAC addresses assigned to
+                    the interfaces of the VMs which do not specify one. Requires the
+                    MacAddressPool feature gate.
+                  properties:
+                    ranges:
+                      description: Ranges of MAC addresses, each address is assigned
+                        to a single interface across the cluster.
+                      items:
+                        description: MacAddressRange is a range of MAC addresses,
+                          including both its start and end.
+                        properties:
+                          end:
+                            description: End is the last MAC address of the range.
+                            type: string
+                          start:
+                            description: Start is the first MAC address of the range.
+                            type: string
+                        required:
+                        - end
+                        - start
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - ranges
+                  type: object
                 permitBridgeInterfaceOnPodNetwork:
                   type: boolean
                 permitSlirpInterface:
@@ -2530,6 +2666,41 @@ var CRDsValidation map[string]string = map[string]string{
                 be forced updated per the BatchShutdownInteral interval \n Defaults
                 to 10"
               type: integer
+            maintenanceWindows:
+              description: "MaintenanceWindows restricts automated workload updates
+                to recurring time windows. Outside of all windows no migrations or
+                evictions are started, while the ones already started are allowed
+                to finish. \n An empty list allows automated workload updates at any
+                time"
+              items:
+                description: WorkloadUpdateMaintenanceWindow defines a recurring time
+                  window in which automated workload updates are allowed
+                properties:
+                  batchEvictionSize:
+                    description: BatchEvictionSize overrides the BatchEvictionSize
+                      of the strategy while the window is open
+                    type: integer
+                  batchMigrationSize:
+                    description: "BatchMigrationSize limits the number of workload
+                      update migrations which run in parallel while the window is
+                      open \n Defaults to the cluster wide parallelMigrationsPerCluster"
+                    type: integer
+                  duration:
+                    description: Duration defines how long the window stays open after
+                      each start
+                    type: string
+                  schedule:
+                    description: Schedule is a cron expression in the standard five
+                      field format (minute, hour, day of month, month, day of week)
+                      which defines when the window opens. The schedule is evaluated
+                      in UTC.
+                    type: string
+                required:
+                - duration
+                - schedule
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
             workloadUpdateMethods:
               description: "WorkloadUpdateMethods defines the methods that can be
                 used to disrupt workloads during automated workload updates. When
@@ -3537,6 +3708,47 @@ var CRDsValidation map[string]string = map[string]string{
           type: string
         targetKubeVirtVersion:
           type: string
+        workloadUpdateStatus:
+          description: KubeVirtWorkloadUpdateStatus reports the progress of automated
+            workload updates
+          properties:
+            estimatedCompletionTimestamp:
+              description: EstimatedCompletionTimestamp is the expected time when
+                all workloads are updated, based on the progress so far
+              format: date-time
+              type: string
+            inMaintenanceWindow:
+              description: InMaintenanceWindow indicates whether workload updates
+                are currently allowed by the maintenance windows
+              type: boolean
+            nextMaintenanceWindow:
+              description: NextMaintenanceWindow is the time when the next maintenance
+                window opens
+              format: date-time
+              type: string
+            optedOutWorkloads:
+              description: OptedOutWorkloads is the number of outdated workloads which
+                are excluded from automated updates by their namespace
+              type: integer
+            startTimestamp:
+              description: StartTimestamp is the time when outdated workloads were
+                detected for the current update
+              format: date-time
+              type: string
+            totalWorkloads:
+              description: TotalWorkloads is the number of workloads which were outdated
+                during the current update
+              type: integer
+            updatedWorkloads:
+              description: UpdatedWorkloads is the number of workloads which were
+                updated since the start of the current update
+              type: integer
+          required:
+          - inMaintenanceWindow
+          - optedOutWorkloads
+          - totalWorkloads
+          - updatedWorkloads
+          type: object
       type: object
   required:
   - spec
@@ -3573,12 +3785,205 @@ var CRDsValidation map[string]string = map[string]string{
         completionTimeoutPerGiB:
           format: int64
           type: integer
+        compression:
+          description: Compression compresses the multifd channels of the migrations
+            the policy applies to
+          properties:
+            level:
+              description: Level is the compression level. zlib supports levels from
+                0 to 9, zstd from 0 to 20. Defaults to the default level of the method
+                in QEMU
+              format: int32
+              type: integer
+            method:
+              description: Method is the compression algorithm, either zstd or zlib
+              enum:
+              - zstd
+              - zlib
+              type: string
+          required:
+          - method
+          type: object
+        disableTLS:
+          description: DisableTLS disables TLS for the migrations the policy applies
+            to. It can only be set if the migrations run on a dedicated migration
+            network, see Network and MigrationConfiguration.Network.
+          type: boolean
+        dryRun:
+          description: DryRun prevents the policy from being applied to migrations.
+            The status of the policy still reports the VMIs it would apply to, which
+            allows to test a policy before it is rolled out.
+          type: boolean
+        escalation:
+          description: Escalation configures the staged escalation of the migrations
+            the policy applies to
+          properties:
+            autoConvergeIncrement:
+              description: AutoConvergeIncrement is the percentage the throttling
+                of the guest CPUs is increased by in every iteration which still does
+                not converge. Must be between 1 and 99. Defaults to 10
+              format: int32
+              type: integer
+            autoConvergeInitial:
+              description: AutoConvergeInitial is the percentage the guest CPUs are
+                throttled by once auto-converge starts throttling a migration which
+                does not converge. libvirt only accepts it when the migration starts,
+                so it applies to the whole migration. Must be between 1 and 99. Defaults
+                to 20
+              format: int32
+              type: integer
+            bandwidth:
+              anyOf:
+              - type: integer
+              - type: string
+              description: Bandwidth is the bandwidth limit of the raised bandwidth
+                stage. The value is in quantity per second. Defaults to 0 (no limit).
+                The stage is skipped if BandwidthPerMigration does not limit migrations.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            dirtyRateThresholdPercent:
+              description: DirtyRateThresholdPercent escalates a migration when the
+                guest dirties memory at more than this percentage of the transfer
+                rate. Migrations whose remaining data did not decrease during a stage
+                are escalated as well. Defaults to 80
+              format: int32
+              type: integer
+            remainingDataThreshold:
+              anyOf:
+              - type: integer
+              - type: string
+              description: RemainingDataThreshold prevents the escalation of migrations
+                with less data left to transfer, since they are about to complete.
+                Defaults to 256Mi
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            stageDuration:
+              description: StageDuration is the minimum number of seconds a migration
+                stays in a stage before it escalates to the next one. Defaults to
+                30
+              format: int64
+              type: integer
+          type: object
+        maxDowntime:
+          description: MaxDowntime is the maximum time the guests the policy applies
+            to may be paused to switch over to the target node
+          type: string
+        multifdChannels:
+          description: MultifdChannels is the number of parallel connections the migrations
+            the policy applies to use
+          format: int32
+          type: integer
+        network:
+          description: Network is the name of the CNI network the migrations the
+            policy applies to run on. It must be MigrationConfiguration.Network or
+            one of MigrationConfiguration.AdditionalNetworks.
+          type: string
+        parallelMigrations:
+          description: ParallelMigrations limits the number of migrations running
+            at the same time for the VMIs the policy applies to
+          format: int32
+          type: integer
+        progressTimeout:
+          description: ProgressTimeout is the time in seconds a migration may not
+            make any progress before it is aborted
+          format: int64
+          type: integer
         selectors:
           properties:
+            namespaceLabelSelector:
+              description: NamespaceLabelSelector selects the namespaces of the VMIs
+                with set-based requirements. It has to match in addition to NamespaceSelector.
+              properties:
+                matchExpressions:
+                  description: matchExpressions is a list of label selector requirements.
+                    The requirements are ANDed.
+                  items:
+                    description: A label selector requirement is a selector that contains
+                      values, a key, and an operator that relates the key and values.
+                    properties:
+                      key:
+                        description: key is the label key that the selector applies
+                          to.
+                        type: string
+                      operator:
+                        description: operator represents a key's relationship to a
+                          set of values. Valid operators are In, NotIn, Exists and
+                          DoesNotExist.
+                        type: string
+                      values:
+                        description: values is an array of string values. If the operator
+                          is In or NotIn, the values array must be non-empty. If the
+                          operator is Exists or DoesNotExist, the values array must
+                          be empty. This array is replaced during a strategic merge
+                          patch.
+                        items:
+                          type: string
+                        type: array
+                    required:
+                    - key
+                    - operator
+                    type: object
+                  type: array
+                matchLabels:
+                  additionalProperties:
+                    type: string
+                  description: matchLabels is a map of {key,value} pairs. A single
+                    {key,value} in the matchLabels map is equivalent to an element
+                    of matchExpressions, whose key field is "key", the operator is
+                    "In", and the values array contains only "value". The requirements
+                    are ANDed.
+                  type: object
+              type: object
+              x-kubernetes-map-type: atomic
             namespaceSelector:
               additionalProperties:
                 type: string
               type: object
+            virtualMachineInstanceLabelSelector:
+              description: VirtualMachineInstanceLabelSelector selects the VMIs with
+                set-based requirements. It has to match in addition to VirtualMachineInstanceSelector.
+              properties:
+                matchExpressions:
+                  description: matchExpressions is a list of label selector requirements.
+                    The requirements are ANDed.
+                  items:
+                    description: A label selector requirement is a selector that contains
+                      values, a key, and an operator that relates the key and values.
+                    properties:
+                      key:
+                        description: key is the label key that the selector applies
+                          to.
+                        type: string
+                      operator:
+                        description: operator represents a key's relationship to a
+                          set of values. Valid operators are In, NotIn, Exists and
+                          DoesNotExist.
+                        type: string
+                      values:
+                        description: values is an array of string values. If the operator
+                          is In or NotIn, the values array must be non-empty. If the
+                          operator is Exists or DoesNotExist, the values array must
+                          be empty. This array is replaced during a strategic merge
+                          patch.
+                        items:
+                          type: string
+                        type: array
+                    required:
+                    - key
+                    - operator
+                    type: object
+                  type: array
+                matchLabels:
+                  additionalProperties:
+                    type: string
+                  description: matchLabels is a map of {key,value} pairs. A single
+                    {key,value} in the matchLabels map is equivalent to an element
+                    of matchExpressions, whose key field is "key", the operator is
+                    "In", and the values array contains only "value". The requirements
+                    are ANDed.
+                  type: object
+              type: object
+              x-kubernetes-map-type: atomic
             virtualMachineInstanceSelector:
               additionalProperties:
                 type: string
@@ -3589,6 +3994,56 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     status:
       nullable: true
+      properties:
+        conflictCount:
+          description: ConflictCount is the number of VMIs selected by the policy
+            to which another policy applies
+          format: int32
+          type: integer
+        conflicts:
+          description: Conflicts lists the VMIs selected by the policy to which another
+            policy applies, the list is truncated after 100 entries
+          items:
+            description: MigrationPolicyConflict references a VMI which is selected
+              by a migration policy, but to which another policy applies
+            properties:
+              appliedPolicy:
+                description: AppliedPolicy is the name of the policy which applies
+                  to the VMI
+                type: string
+              name:
+                type: string
+              namespace:
+                type: string
+            required:
+            - appliedPolicy
+            - name
+            - namespace
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        matchedVirtualMachineInstanceCount:
+          description: MatchedVirtualMachineInstanceCount is the number of VMIs the
+            policy applies to
+          format: int32
+          type: integer
+        matchedVirtualMachineInstances:
+          description: MatchedVirtualMachineInstances lists the VMIs the policy applies
+            to, the list is truncated after 100 entries
+          items:
+            description: MigrationPolicyMatch references a VMI a migration policy
+              applies to
+            properties:
+              name:
+                type: string
+              namespace:
+                type: string
+            required:
+            - name
+            - namespace
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
       type: object
   required:
   - spec
@@ -4346,6 +4801,14 @@ var CRDsValidation map[string]string = map[string]string{
                   format: int32
                   type: integer
               type: object
+            diskIOTune:
+              description: DiskIOTune allows live updating the IO throttling of the
+                virtual machine disks
+              type: object
+            interfaceBandwidth:
+              description: InterfaceBandwidth allows live updating the bandwidth limits
+                of the virtual machine interfaces
+              type: object
             memory:
               description: MemoryLiveUpdateConfiguration defines the live update memory
                 features for the VirtualMachine
@@ -5737,6 +6200,105 @@ var CRDsValidation map[string]string = map[string]string{
                                   should be used. Supported values are: native, default,
                                   threads.'
                                 type: string
+                              ioTune:
+                                description: If specified, the IO of the disk is throttled
+                                  to the given limits.
+                                properties:
+                                  groupName:
+                                    description: GroupName places the disk in a throttling
+                                      group, the disks of a group share the same limits
+                                    type: string
+                                  readBytesSec:
+                                    description: ReadBytesSec is the read throughput
+                                      limit in bytes per second
+                                    format: int64
+                                    type: integer
+                                  readBytesSecMax:
+                                    description: ReadBytesSecMax is the read throughput
+                                      allowed during bursts in bytes per second
+                                    format: int64
+                                    type: integer
+                                  readBytesSecMaxLength:
+                                    description: ReadBytesSecMaxLength is the duration
+                                      in seconds of a read throughput burst
+                                    format: int64
+                                    type: integer
+                                  readIOPSSec:
+                                    description: ReadIOPSSec is the read I/O operations
+                                      per second limit
+                                    format: int64
+                                    type: integer
+                                  readIOPSSecMax:
+                                    description: ReadIOPSSecMax is the read I/O operations
+                                      per second allowed during bursts
+                                    format: int64
+                                    type: integer
+                                  readIOPSSecMaxLength:
+                                    description: ReadIOPSSecMaxLength is the duration
+                                      in seconds of a read I/O operations burst
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    description: TotalBytesSec is the total throughput
+                                      limit in bytes per second
+                                    format: int64
+                                    type: integer
+                                  totalBytesSecMax:
+                                    description: TotalBytesSecMax is the total throughput
+                                      allowed during bursts in bytes per second
+                                    format: int64
+                                    type: integer
+                                  totalBytesSecMaxLength:
+                                    description: TotalBytesSecMaxLength is the duration
+                                      in seconds of a total throughput burst
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSec:
+                                    description: TotalIOPSSec is the total I/O operations
+                                      per second limit
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSecMax:
+                                    description: TotalIOPSSecMax is the total I/O
+                                      operations per second allowed during bursts
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSecMaxLength:
+                                    description: TotalIOPSSecMaxLength is the duration
+                                      in seconds of a total I/O operations burst
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    description: WriteBytesSec is the write throughput
+                                      limit in bytes per second
+                                    format: int64
+                                    type: integer
+                                  writeBytesSecMax:
+                                    description: WriteBytesSecMax is the write throughput
+                                      allowed during bursts in bytes per second
+                                    format: int64
+                                    type: integer
+                                  writeBytesSecMaxLength:
+                                    description: WriteBytesSecMaxLength is the duration
+                                      in seconds of a write throughput burst
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSec:
+                                    description: WriteIOPSSec is the write I/O operations
+                                      per second limit
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSecMax:
+                                    description: WriteIOPSSecMax is the write I/O
+                                      operations per second allowed during bursts
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSecMaxLength:
+                                    description: WriteIOPSSecMaxLength is the duration
+                                      in seconds of a write I/O operations burst
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -5890,6 +6452,58 @@ var CRDsValidation map[string]string = map[string]string{
                                   to the device. This value is required to be unique
                                   across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: If specified, the traffic of the interface
+                                  is shaped to the given limits. Not supported for
+                                  SR-IOV interfaces.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the virtual machine
+                                    properties:
+                                      average:
+                                        description: Average is the average rate in
+                                          kibibytes per second
+                                        format: int64
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of kibibytes
+                                          which can be sent in a single burst at the
+                                          peak rate
+                                        format: int64
+                                        type: integer
+                                      peak:
+                                        description: Peak is the maximum rate in kibibytes
+                                          per second at which bursts are sent
+                                        format: int64
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the virtual machine
+                                    properties:
+                                      average:
+                                        description: Average is the average rate in
+                                          kibibytes per second
+                                        format: int64
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of kibibytes
+                                          which can be sent in a single burst at the
+                                          peak rate
+                                        format: int64
+                                        type: integer
+                                      peak:
+                                        description: Peak is the maximum rate in kibibytes
+                                          per second at which bursts are sent
+                                        format: int64
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: 'Binding specifies the binding plugin
                                   that will be used to connect the interface to the
@@ -6023,9 +6637,11 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: object
                               state:
                                 description: State represents the requested operational
-                                  state of the interface. The (only) value supported
-                                  is 'absent', expressing a request to remove the
-                                  interface.
+                                  state of the interface. The values supported are
+                                  'absent', expressing a request to remove the interface,
+                                  and 'up' or 'down', setting the link state of the
+                                  interface seen by the guest. The link state is 'up'
+                                  if not specified.
                                 type: string
                               tag:
                                 description: If specified, the virtual network interface
@@ -6050,6 +6666,26 @@ var CRDsValidation map[string]string = map[string]string{
                             depends on additional factors of the VirtualMachineInstance,
                             like the number of guest CPUs.
                           type: boolean
+                        panicDevice:
+                          description: PanicDevice describes a panic device which
+                            the guest uses to report a crash.
+                          properties:
+                            memoryDumpClaimName:
+                              description: MemoryDumpClaimName is the name of a PVC
+                                the memory of a crashed guest is dumped to. The dump
+                                is done through the memory dump subresource of the
+                                VirtualMachine and requires the preserve action.
+                              type: string
+                            model:
+                              description: Model of the panic device. Valid values
+                                are pvpanic, isa, hyperv. Defaults to pvpanic.
+                              type: string
+                            onCrash:
+                              description: The action to take when the guest reports
+                                a crash. Valid values are restart, poweroff, preserve.
+                                Defaults to poweroff.
+                              type: string
+                          type: object
                         rng:
                           description: Whether to have random number generator from
                             host
@@ -6365,6 +7001,19 @@ var CRDsValidation map[string]string = map[string]string{
                                   type: boolean
                               type: object
                           type: object
+                        generationID:
+                          description: GenerationID exposes a VM Generation ID device
+                            to the guest. It allows the guest to detect a rollback,
+                            e.g. after a restore from a snapshot.
+                          properties:
+                            uuid:
+                              description: UUID reported to the guest as the VM Generation
+                                ID. Defaults to an ID derived from the VirtualMachine,
+                                which is stable across reboots and migrations. It
+                                is regenerated when the VirtualMachine is restored
+                                from a snapshot or cloned.
+                              type: string
+                          type: object
                         kernelBoot:
                           description: Settings to set the kernel for booting.
                           properties:
@@ -6539,6 +7188,93 @@ var CRDsValidation map[string]string = map[string]string{
                     capk controller can handle tearing the VMI down. Details can be
                     found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.'
                   type: string
+                firewall:
+                  description: Firewall defines the rules filtering the traffic of
+                    the pod network interface. Only supported with the masquerade
+                    and passt bindings. The rules can be updated while the vmi is
+                    running.
+                  properties:
+                    egress:
+                      description: Egress filters the traffic sent by the virtual
+                        machine.
+                      properties:
+                        defaultAction:
+                          description: DefaultAction is applied to the traffic not
+                            matching any rule. Defaults to Drop.
+                          type: string
+                        rules:
+                          description: Rules are evaluated in order, the action of
+                            the first matching rule is applied.
+                          items:
+                            description: FirewallRule matches traffic by the remote
+                              address, the protocol and the destination port.
+                            properties:
+                              action:
+                                description: Action applied to the matching traffic,
+                                  one of Accept or Drop.
+                                type: string
+                              cidr:
+                                description: CIDR of the remote addresses, the source
+                                  for ingress and the destination for egress traffic.
+                                  All addresses are matched if not specified.
+                                type: string
+                              port:
+                                description: Port is the destination port to match,
+                                  requires the TCP or UDP protocol. All ports are
+                                  matched if not specified.
+                                format: int32
+                                type: integer
+                              protocol:
+                                description: Protocol to match, one of TCP, UDP or
+                                  ICMP. All protocols are matched if not specified.
+                                type: string
+                            required:
+                            - action
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      type: object
+                    ingress:
+                      description: Ingress filters the traffic received by the virtual
+                        machine.
+                      properties:
+                        defaultAction:
+                          description: DefaultAction is applied to the traffic not
+                            matching any rule. Defaults to Drop.
+                          type: string
+                        rules:
+                          description: Rules are evaluated in order, the action of
+                            the first matching rule is applied.
+                          items:
+                            description: FirewallRule matches traffic by the remote
+                              address, the protocol and the destination port.
+                            properties:
+                              action:
+                                description: Action applied to the matching traffic,
+                                  one of Accept or Drop.
+                                type: string
+                              cidr:
+                                description: CIDR of the remote addresses, the source
+                                  for ingress and the destination for egress traffic.
+                                  All addresses are matched if not specified.
+                                type: string
+                              port:
+                                description: Port is the destination port to match,
+                                  requires the TCP or UDP protocol. All ports are
+                                  matched if not specified.
+                                format: int32
+                                type: integer
+                              protocol:
+                                description: Protocol to match, one of TCP, UDP or
+                                  ICMP. All protocols are matched if not specified.
+                                type: string
+                            required:
+                            - action
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      type: object
+                  type: object
                 hostname:
                   description: Specifies the hostname of the vmi If not specified,
                     the hostname will be set to the name of the vmi, if dhcp or cloud-init
@@ -7091,6 +7827,49 @@ var CRDsValidation map[string]string = map[string]string{
                   items:
                     description: Volume represents a named volume in a vmi.
                     properties:
+                      backup:
+                        description: Backup is attached to the virt launcher and is
+                          populated with the backup images of the vmi disks
+                        properties:
+                          backupName:
+                            description: BackupName is the name of the VirtualMachineBackup
+                              writing into the volume
+                            type: string
+                          checkpointName:
+                            description: CheckpointName is the name of the changed
+                              block tracking checkpoint created with the backup
+                            type: string
+                          claimName:
+                            description: 'claimName is the name of a PersistentVolumeClaim
+                              in the same namespace as the pod using this volume.
+                              More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                            type: string
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
+                          incrementalFrom:
+                            description: IncrementalFrom is the name of the checkpoint
+                              the backup is relative to. A full backup is taken when
+                              empty.
+                            type: string
+                          pull:
+                            description: Pull exposes the disks through the NBD server
+                              of the VMI instead of writing the backup images, the
+                              volume only holds the scratch images of the backup job.
+                            type: boolean
+                          pullCompleted:
+                            description: PullCompleted stops the NBD server of a pull
+                              mode backup and completes it
+                            type: boolean
+                          readOnly:
+                            description: readOnly Will force the ReadOnly setting
+                              in VolumeMounts. Default false.
+                            type: boolean
+                        required:
+                        - backupName
+                        - claimName
+                        type: object
                       cloudInitConfigDrive:
                         description: 'CloudInitConfigDrive represents a cloud-init
                           Config Drive user-data source. The Config Drive data will
@@ -7497,6 +8276,9 @@ var CRDsValidation map[string]string = map[string]string{
               - domain
               type: object
           type: object
+        updateVolumesStrategy:
+          description: UpdateVolumesStrategy is the strategy to apply on volumes updates
+          type: string
       required:
       - template
       type: object
@@ -7504,6 +8286,41 @@ var CRDsValidation map[string]string = map[string]string{
       description: Status holds the current state of the controller and brief information
         about its associated VirtualMachineInstance
       properties:
+        backupCheckpoint:
+          description: BackupCheckpoint is the latest changed block tracking checkpoint
+            created by a backup of the VirtualMachine
+          nullable: true
+          properties:
+            backupName:
+              description: BackupName is the name of the VirtualMachineBackup which
+                created the checkpoint
+              type: string
+            creationTimestamp:
+              description: CreationTimestamp is the time when the checkpoint was created
+              format: date-time
+              type: string
+            name:
+              description: Name is the name of the checkpoint in the domain
+              type: string
+            vmiUID:
+              description: VMIUID is the UID of the VirtualMachineInstance the checkpoint
+                was created in. The dirty bitmaps of the checkpoint are persisted
+                in the disk images, the checkpoint is redefined from them once the
+                VirtualMachine is restarted.
+              type: string
+            volumes:
+              description: Volumes is the list of volumes tracked by a dirty bitmap
+                of the checkpoint
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - backupName
+          - creationTimestamp
+          - name
+          - vmiUID
+          type: object
         conditions:
           description: Hold the state information of the VirtualMachine and its VirtualMachineInstance
           items:
@@ -7732,6 +8549,105 @@ var CRDsValidation map[string]string = map[string]string{
                         description: 'IO specifies which QEMU disk IO mode should
                           be used. Supported values are: native, default, threads.'
                         type: string
+                      ioTune:
+                        description: If specified, the IO of the disk is throttled
+                          to the given limits.
+                        properties:
+                          groupName:
+                            description: GroupName places the disk in a throttling
+                              group, the disks of a group share the same limits
+                            type: string
+                          readBytesSec:
+                            description: ReadBytesSec is the read throughput limit
+                              in bytes per second
+                            format: int64
+                            type: integer
+                          readBytesSecMax:
+                            description: ReadBytesSecMax is the read throughput allowed
+                              during bursts in bytes per second
+                            format: int64
+                            type: integer
+                          readBytesSecMaxLength:
+                            description: ReadBytesSecMaxLength is the duration in
+                              seconds of a read throughput burst
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec is the read I/O operations per
+                              second limit
+                            format: int64
+                            type: integer
+                          readIOPSSecMax:
+                            description: ReadIOPSSecMax is the read I/O operations
+                              per second allowed during bursts
+                            format: int64
+                            type: integer
+                          readIOPSSecMaxLength:
+                            description: ReadIOPSSecMaxLength is the duration in seconds
+                              of a read I/O operations burst
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec is the total throughput limit
+                              in bytes per second
+                            format: int64
+                            type: integer
+                          totalBytesSecMax:
+                            description: TotalBytesSecMax is the total throughput
+                              allowed during bursts in bytes per second
+                            format: int64
+                            type: integer
+                          totalBytesSecMaxLength:
+                            description: TotalBytesSecMaxLength is the duration in
+                              seconds of a total throughput burst
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec is the total I/O operations
+                              per second limit
+                            format: int64
+                            type: integer
+                          totalIOPSSecMax:
+                            description: TotalIOPSSecMax is the total I/O operations
+                              per second allowed during bursts
+                            format: int64
+                            type: integer
+                          totalIOPSSecMaxLength:
+                            description: TotalIOPSSecMaxLength is the duration in
+                              seconds of a total I/O operations burst
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec is the write throughput limit
+                              in bytes per second
+                            format: int64
+                            type: integer
+                          writeBytesSecMax:
+                            description: WriteBytesSecMax is the write throughput
+                              allowed during bursts in bytes per second
+                            format: int64
+                            type: integer
+                          writeBytesSecMaxLength:
+                            description: WriteBytesSecMaxLength is the duration in
+                              seconds of a write throughput burst
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec is the write I/O operations
+                              per second limit
+                            format: int64
+                            type: integer
+                          writeIOPSSecMax:
+                            description: WriteIOPSSecMax is the write I/O operations
+                              per second allowed during bursts
+                            format: int64
+                            type: integer
+                          writeIOPSSecMaxLength:
+                            description: WriteIOPSSecMaxLength is the duration in
+                              seconds of a write I/O operations burst
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -7872,13 +8788,163 @@ var CRDsValidation map[string]string = map[string]string{
             - name
             type: object
           type: array
+        volumeUpdateState:
+          description: VolumeUpdateState contains the information about the volumes
+            set updates related to the volumeUpdateStrategy
+          nullable: true
+          properties:
+            volumeMigrationState:
+              description: VolumeMigrationState tracks the progress and the result
+                of a volume migration
+              nullable: true
+              properties:
+                endTimestamp:
+                  description: EndTimestamp is the time the volume migration completed
+                  format: date-time
+                  nullable: true
+                  type: string
+                failureReason:
+                  description: FailureReason reports the reason of the last failure
+                    of the volume migration
+                  type: string
+                manualRecoveryRequired:
+                  description: ManualRecoveryRequired indicates if the VirtualMachine
+                    needs to be restarted or the volume set reverted to the source
+                    volumes by the user
+                  type: boolean
+                migratedVolumes:
+                  description: MigratedVolumes lists the source and destination volumes
+                    of the migration
+                  items:
+                    description: StorageMigratedVolumeInfo tracks the information
+                      about the source and destination volumes during the volume migration
+                    properties:
+                      destinationPVCInfo:
+                        description: DestinationPVCInfo contains the information about
+                          the destination PVC
+                        properties:
+                          accessModes:
+                            description: 'AccessModes contains the desired access
+                              modes the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1'
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          capacity:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: Capacity represents the capacity set on the
+                              corresponding PVC status
+                            type: object
+                          claimName:
+                            description: ClaimName is the name of the PVC
+                            type: string
+                          filesystemOverhead:
+                            description: Percentage of filesystem's size to be reserved
+                              when resizing the PVC
+                            pattern: ^(0(?:\.\d{1,3})?|1)$
+                            type: string
+                          preallocated:
+                            description: Preallocated indicates if the PVC's storage
+                              is preallocated or not
+                            type: boolean
+                          requests:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: Requests represents the resources requested
+                              by the corresponding PVC spec
+                            type: object
+                          volumeMode:
+                            description: VolumeMode defines what type of volume is
+                              required by the claim. Value of Filesystem is implied
+                              when not included in claim spec.
+                            type: string
+                        type: object
+                      sourcePVCInfo:
+                        description: SourcePVCInfo contains the information about
+                          the source PVC
+                        properties:
+                          accessModes:
+                            description: 'AccessModes contains the desired access
+                              modes the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1'
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          capacity:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: Capacity represents the capacity set on the
+                              corresponding PVC status
+                            type: object
+                          claimName:
+                            description: ClaimName is the name of the PVC
+                            type: string
+                          filesystemOverhead:
+                            description: Percentage of filesystem's size to be reserved
+                              when resizing the PVC
+                            pattern: ^(0(?:\.\d{1,3})?|1)$
+                            type: string
+                          preallocated:
+                            description: Preallocated indicates if the PVC's storage
+                              is preallocated or not
+                            type: boolean
+                          requests:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: Requests represents the resources requested
+                              by the corresponding PVC spec
+                            type: object
+                          volumeMode:
+                            description: VolumeMode defines what type of volume is
+                              required by the claim. Value of Filesystem is implied
+                              when not included in claim spec.
+                            type: string
+                        type: object
+                      volumeName:
+                        description: VolumeName is the name of the volume that is
+                          being migrated
+                        type: string
+                    required:
+                    - volumeName
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                phase:
+                  description: Phase is the current phase of the volume migration
+                  type: string
+                startTimestamp:
+                  description: StartTimestamp is the time the volume migration started
+                  format: date-time
+                  nullable: true
+                  type: string
+              type: object
+          type: object
       type: object
   required:
   - spec
   type: object
 `,
-	"virtualmachineclone": `openAPIV3Schema:
-  description: VirtualMachineClone is a CRD that clones one VM into another.
+	"virtualmachinebackup": `openAPIV3Schema:
+  description: VirtualMachineBackup defines the operation of backing up the disks
+    of a running VM. Changed block tracking checkpoints allow to only back up the
+    blocks written since a previous backup.
   properties:
     apiVersion:
       description: 'APIVersion defines the versioned schema of this representation
@@ -7893,37 +8959,33 @@ var CRDsValidation map[string]string = map[string]string{
     metadata:
       type: object
     spec:
+      description: VirtualMachineBackupSpec is the spec for a VirtualMachineBackup
+        resource
       properties:
-        annotationFilters:
-          description: 'Example use: "!some/key*". For a detailed description, please
-            refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.'
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: atomic
-        labelFilters:
-          description: 'Example use: "!some/key*". For a detailed description, please
-            refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.'
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: atomic
-        newMacAddresses:
-          additionalProperties:
-            type: string
-          description: NewMacAddresses manually sets that target interfaces' mac addresses.
-            The key is the interface name and the value is the new mac address. If
-            this field is not specified, a new MAC address will be generated automatically,
-            as for any interface that is not included in this map.
-          type: object
-        newSMBiosSerial:
-          description: NewSMBiosSerial manually sets that target's SMbios serial.
-            If this field is not specified, a new serial will be generated automatically.
+        failureDeadline:
+          description: This time represents the number of seconds we permit the vm
+            backup to take. In case we pass this deadline we mark this backup as failed.
+            Defaults to DefaultFailureDeadline - 5min
+          type: string
+        incremental:
+          description: 'Incremental requests to only back up the blocks changed since
+            the last checkpoint of the VM. The volumes without a dirty bitmap in that
+            checkpoint are backed up entirely: the raw disk images, the disks added
+            since the checkpoint, or all the disks when the bitmaps were lost, for
+            example after a crash of the VM. The type of the backup of each volume
+            is reported in the status.'
+          type: boolean
+        mode:
+          description: Mode defines how the backup is taken. Defaults to Push
           type: string
+        pullCompleted:
+          description: PullCompleted is set by the client of a Pull mode backup once
+            it read the disks, the NBD server is stopped and the backup succeeds.
+            It is the only field of the spec which can be updated.
+          type: boolean
         source:
-          description: 'Source is the object that would be cloned. Currently supported
-            source types are: VirtualMachine of kubevirt.io API group, VirtualMachineSnapshot
-            of snapshot.kubevirt.io API group'
+          description: Source is the VirtualMachine to back up, initially only VirtualMachine
+            type supported
           properties:
             apiGroup:
               description: APIGroup is the group for the resource being referenced.
@@ -7940,51 +9002,36 @@ var CRDsValidation map[string]string = map[string]string{
           - kind
           - name
           type: object
+          x-kubernetes-map-type: atomic
         target:
-          description: 'Target is the outcome of the cloning process. Currently supported
-            source types are: - VirtualMachine of kubevirt.io API group - Empty (nil).
-            If the target is not provided, the target type would default to VirtualMachine
-            and a random name would be generated for the target. The target''s name
-            can be viewed by inspecting status "TargetName" field below.'
+          description: Target is the volume receiving the backup images
           properties:
-            apiGroup:
-              description: APIGroup is the group for the resource being referenced.
-                If APIGroup is not specified, the specified Kind must be in the core
-                API group. For any other third-party types, APIGroup is required.
-              type: string
-            kind:
-              description: Kind is the type of resource being referenced
-              type: string
-            name:
-              description: Name is the name of resource being referenced
+            persistentVolumeClaimName:
+              description: PersistentVolumeClaimName is the name of a filesystem PVC
+                in the namespace of the VM. The backup images are written in a directory
+                named after the backup, in qcow2 format. In Pull mode the directory
+                holds the scratch images storing the blocks the VM overwrites while
+                the backup is exported, they are removed once the backup completes.
               type: string
           required:
-          - kind
-          - name
-          type: object
-        template:
-          description: For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
-          properties:
-            annotationFilters:
-              description: 'Example use: "!some/key*". For a detailed description,
-                please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.'
-              items:
-                type: string
-              type: array
-              x-kubernetes-list-type: atomic
-            labelFilters:
-              description: 'Example use: "!some/key*". For a detailed description,
-                please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.'
-              items:
-                type: string
-              type: array
-              x-kubernetes-list-type: atomic
+          - persistentVolumeClaimName
           type: object
       required:
       - source
+      - target
       type: object
     status:
+      description: VirtualMachineBackupStatus is the status for a VirtualMachineBackup
+        resource
       properties:
+        checkpointName:
+          description: CheckpointName is the changed block tracking checkpoint created
+            by the backup
+          type: string
+        completionTimestamp:
+          format: date-time
+          nullable: true
+          type: string
         conditions:
           items:
             description: Condition defines conditions
@@ -8012,9 +9059,203 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
-        creationTime:
-          format: date-time
-          nullable: true
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        parentCheckpointName:
+          description: ParentCheckpointName is the checkpoint the incremental backup
+            is relative to
+          type: string
+        phase:
+          description: VirtualMachineBackupPhase is the current phase of the VirtualMachineBackup
+          type: string
+        sourceUID:
+          description: UID is a type that holds unique ID values, including UUIDs.  Because
+            we don't ONLY use UUIDs, this is an alias to string.  Being a type captures
+            intent and helps make sure that UIDs and names do not get conflated.
+          type: string
+        startTimestamp:
+          format: date-time
+          nullable: true
+          type: string
+        type:
+          description: Type is Incremental when all the volumes were backed up incrementally,
+            Full otherwise. It is set once the backup completed.
+          type: string
+        volumes:
+          items:
+            description: BackupVolumeInfo describes the backup image of a volume
+            properties:
+              exportName:
+                description: ExportName is the name of the NBD export of the volume
+                  in Pull mode. The blocks changed since the parent checkpoint of
+                  an incremental backup are reported by the qemu:dirty-bitmap:<ExportName>
+                  metadata context.
+                type: string
+              fileName:
+                description: FileName is the path of the backup image relative to
+                  the root of the target volume. It is empty in Pull mode.
+                type: string
+              type:
+                description: Type tells whether the image contains all the blocks
+                  of the volume, or only the blocks changed since the parent checkpoint
+                type: string
+              volumeName:
+                type: string
+            required:
+            - volumeName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineclone": `openAPIV3Schema:
+  description: VirtualMachineClone is a CRD that clones one VM into another.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation
+        of an object. Servers should convert recognized schemas to the latest internal
+        value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object
+        represents. Servers may infer this from the endpoint the client submits requests
+        to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        annotationFilters:
+          description: 'Example use: "!some/key*". For a detailed description, please
+            refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.'
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        labelFilters:
+          description: 'Example use: "!some/key*". For a detailed description, please
+            refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.'
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        newMacAddresses:
+          additionalProperties:
+            type: string
+          description: NewMacAddresses manually sets that target interfaces' mac addresses.
+            The key is the interface name and the value is the new mac address. If
+            this field is not specified, a new MAC address will be generated automatically,
+            as for any interface that is not included in this map.
+          type: object
+        newSMBiosSerial:
+          description: NewSMBiosSerial manually sets that target's SMbios serial.
+            If this field is not specified, a new serial will be generated automatically.
+          type: string
+        source:
+          description: 'Source is the object that would be cloned. Currently supported
+            source types are: VirtualMachine of kubevirt.io API group, VirtualMachineSnapshot
+            of snapshot.kubevirt.io API group'
+          properties:
+            apiGroup:
+              description: APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core
+                API group. For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+        target:
+          description: 'Target is the outcome of the cloning process. Currently supported
+            source types are: - VirtualMachine of kubevirt.io API group - Empty (nil).
+            If the target is not provided, the target type would default to VirtualMachine
+            and a random name would be generated for the target. The target''s name
+            can be viewed by inspecting status "TargetName" field below.'
+          properties:
+            apiGroup:
+              description: APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core
+                API group. For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+        template:
+          description: For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
+          properties:
+            annotationFilters:
+              description: 'Example use: "!some/key*". For a detailed description,
+                please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.'
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            labelFilters:
+              description: 'Example use: "!some/key*". For a detailed description,
+                please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.'
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
+      required:
+      - source
+      type: object
+    status:
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        creationTime:
+          format: date-time
+          nullable: true
           type: string
         phase:
           type: string
@@ -8105,6 +9346,102 @@ var CRDsValidation map[string]string = map[string]string{
           required:
           - guest
           type: object
+        diskIOTune:
+          description: Optionally defines the IO throttling applied to the disks of
+            the VirtualMachineInstance. CD-ROMs are not throttled.
+          properties:
+            groupName:
+              description: GroupName places the disk in a throttling group, the disks
+                of a group share the same limits
+              type: string
+            readBytesSec:
+              description: ReadBytesSec is the read throughput limit in bytes per
+                second
+              format: int64
+              type: integer
+            readBytesSecMax:
+              description: ReadBytesSecMax is the read throughput allowed during bursts
+                in bytes per second
+              format: int64
+              type: integer
+            readBytesSecMaxLength:
+              description: ReadBytesSecMaxLength is the duration in seconds of a read
+                throughput burst
+              format: int64
+              type: integer
+            readIOPSSec:
+              description: ReadIOPSSec is the read I/O operations per second limit
+              format: int64
+              type: integer
+            readIOPSSecMax:
+              description: ReadIOPSSecMax is the read I/O operations per second allowed
+                during bursts
+              format: int64
+              type: integer
+            readIOPSSecMaxLength:
+              description: ReadIOPSSecMaxLength is the duration in seconds of a read
+                I/O operations burst
+              format: int64
+              type: integer
+            totalBytesSec:
+              description: TotalBytesSec is the total throughput limit in bytes per
+                second
+              format: int64
+              type: integer
+            totalBytesSecMax:
+              description: TotalBytesSecMax is the total throughput allowed during
+                bursts in bytes per second
+              format: int64
+              type: integer
+            totalBytesSecMaxLength:
+              description: TotalBytesSecMaxLength is the duration in seconds of a
+                total throughput burst
+              format: int64
+              type: integer
+            totalIOPSSec:
+              description: TotalIOPSSec is the total I/O operations per second limit
+              format: int64
+              type: integer
+            totalIOPSSecMax:
+              description: TotalIOPSSecMax is the total I/O operations per second
+                allowed during bursts
+              format: int64
+              type: integer
+            totalIOPSSecMaxLength:
+              description: TotalIOPSSecMaxLength is the duration in seconds of a total
+                I/O operations burst
+              format: int64
+              type: integer
+            writeBytesSec:
+              description: WriteBytesSec is the write throughput limit in bytes per
+                second
+              format: int64
+              type: integer
+            writeBytesSecMax:
+              description: WriteBytesSecMax is the write throughput allowed during
+                bursts in bytes per second
+              format: int64
+              type: integer
+            writeBytesSecMaxLength:
+              description: WriteBytesSecMaxLength is the duration in seconds of a
+                write throughput burst
+              format: int64
+              type: integer
+            writeIOPSSec:
+              description: WriteIOPSSec is the write I/O operations per second limit
+              format: int64
+              type: integer
+            writeIOPSSecMax:
+              description: WriteIOPSSecMax is the write I/O operations per second
+                allowed during bursts
+              format: int64
+              type: integer
+            writeIOPSSecMaxLength:
+              description: WriteIOPSSecMaxLength is the duration in seconds of a write
+                I/O operations burst
+              format: int64
+              type: integer
+          type: object
         gpus:
           description: Optionally defines any GPU devices associated with the instancetype.
           items:
@@ -8164,6 +9501,51 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        interfaceBandwidth:
+          description: Optionally defines the bandwidth limits applied to the network
+            interfaces of the VirtualMachineInstance. SR-IOV interfaces are not limited.
+          properties:
+            inbound:
+              description: Inbound limits the traffic received by the virtual machine
+              properties:
+                average:
+                  description: Average is the average rate in kibibytes per second
+                  format: int64
+                  type: integer
+                burst:
+                  description: Burst is the amount of kibibytes which can be sent
+                    in a single burst at the peak rate
+                  format: int64
+                  type: integer
+                peak:
+                  description: Peak is the maximum rate in kibibytes per second at
+                    which bursts are sent
+                  format: int64
+                  type: integer
+              required:
+              - average
+              type: object
+            outbound:
+              description: Outbound limits the traffic sent by the virtual machine
+              properties:
+                average:
+                  description: Average is the average rate in kibibytes per second
+                  format: int64
+                  type: integer
+                burst:
+                  description: Burst is the amount of kibibytes which can be sent
+                    in a single burst at the peak rate
+                  format: int64
+                  type: integer
+                peak:
+                  description: Peak is the maximum rate in kibibytes per second at
+                    which bursts are sent
+                  format: int64
+                  type: integer
+              required:
+              - average
+              type: object
+          type: object
         ioThreadsPolicy:
           description: Optionally defines the IOThreadsPolicy to be used by the instancetype.
           type: string
@@ -10328,6 +11710,105 @@ var CRDsValidation map[string]string = map[string]string{
                         description: 'IO specifies which QEMU disk IO mode should
                           be used. Supported values are: native, default, threads.'
                         type: string
+                      ioTune:
+                        description: If specified, the IO of the disk is throttled
+                          to the given limits.
+                        properties:
+                          groupName:
+                            description: GroupName places the disk in a throttling
+                              group, the disks of a group share the same limits
+                            type: string
+                          readBytesSec:
+                            description: ReadBytesSec is the read throughput limit
+                              in bytes per second
+                            format: int64
+                            type: integer
+                          readBytesSecMax:
+                            description: ReadBytesSecMax is the read throughput allowed
+                              during bursts in bytes per second
+                            format: int64
+                            type: integer
+                          readBytesSecMaxLength:
+                            description: ReadBytesSecMaxLength is the duration in
+                              seconds of a read throughput burst
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec is the read I/O operations per
+                              second limit
+                            format: int64
+                            type: integer
+                          readIOPSSecMax:
+                            description: ReadIOPSSecMax is the read I/O operations
+                              per second allowed during bursts
+                            format: int64
+                            type: integer
+                          readIOPSSecMaxLength:
+                            description: ReadIOPSSecMaxLength is the duration in seconds
+                              of a read I/O operations burst
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec is the total throughput limit
+                              in bytes per second
+                            format: int64
+                            type: integer
+                          totalBytesSecMax:
+                            description: TotalBytesSecMax is the total throughput
+                              allowed during bursts in bytes per second
+                            format: int64
+                            type: integer
+                          totalBytesSecMaxLength:
+                            description: TotalBytesSecMaxLength is the duration in
+                              seconds of a total throughput burst
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec is the total I/O operations
+                              per second limit
+                            format: int64
+                            type: integer
+                          totalIOPSSecMax:
+                            description: TotalIOPSSecMax is the total I/O operations
+                              per second allowed during bursts
+                            format: int64
+                            type: integer
+                          totalIOPSSecMaxLength:
+                            description: TotalIOPSSecMaxLength is the duration in
+                              seconds of a total I/O operations burst
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec is the write throughput limit
+                              in bytes per second
+                            format: int64
+                            type: integer
+                          writeBytesSecMax:
+                            description: WriteBytesSecMax is the write throughput
+                              allowed during bursts in bytes per second
+                            format: int64
+                            type: integer
+                          writeBytesSecMaxLength:
+                            description: WriteBytesSecMaxLength is the duration in
+                              seconds of a write throughput burst
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec is the write I/O operations
+                              per second limit
+                            format: int64
+                            type: integer
+                          writeIOPSSecMax:
+                            description: WriteIOPSSecMax is the write I/O operations
+                              per second allowed during bursts
+                            format: int64
+                            type: integer
+                          writeIOPSSecMaxLength:
+                            description: WriteIOPSSecMaxLength is the duration in
+                              seconds of a write I/O operations burst
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -10475,6 +11956,55 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device. This value is required
                           to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: If specified, the traffic of the interface is
+                          shaped to the given limits. Not supported for SR-IOV interfaces.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              virtual machine
+                            properties:
+                              average:
+                                description: Average is the average rate in kibibytes
+                                  per second
+                                format: int64
+                                type: integer
+                              burst:
+                                description: Burst is the amount of kibibytes which
+                                  can be sent in a single burst at the peak rate
+                                format: int64
+                                type: integer
+                              peak:
+                                description: Peak is the maximum rate in kibibytes
+                                  per second at which bursts are sent
+                                format: int64
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the virtual
+                              machine
+                            properties:
+                              average:
+                                description: Average is the average rate in kibibytes
+                                  per second
+                                format: int64
+                                type: integer
+                              burst:
+                                description: Burst is the amount of kibibytes which
+                                  can be sent in a single burst at the peak rate
+                                format: int64
+                                type: integer
+                              peak:
+                                description: Peak is the maximum rate in kibibytes
+                                  per second at which bursts are sent
+                                format: int64
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: 'Binding specifies the binding plugin that will
                           be used to connect the interface to the guest. It provides
@@ -10603,8 +12133,10 @@ var CRDsValidation map[string]string = map[string]string{
                         type: object
                       state:
                         description: State represents the requested operational state
-                          of the interface. The (only) value supported is 'absent',
-                          expressing a request to remove the interface.
+                          of the interface. The values supported are 'absent', expressing
+                          a request to remove the interface, and 'up' or 'down', setting
+                          the link state of the interface seen by the guest. The link
+                          state is 'up' if not specified.
                         type: string
                       tag:
                         description: If specified, the virtual network interface address
@@ -10627,11 +12159,31 @@ var CRDsValidation map[string]string = map[string]string{
                     factors of the VirtualMachineInstance, like the number of guest
                     CPUs.
                   type: boolean
-                rng:
-                  description: Whether to have random number generator from host
-                  type: object
-                sound:
-                  description: Whether to emulate a sound device.
+                panicDevice:
+                  description: PanicDevice describes a panic device which the guest
+                    uses to report a crash.
+                  properties:
+                    memoryDumpClaimName:
+                      description: MemoryDumpClaimName is the name of a PVC the memory
+                        of a crashed guest is dumped to. The dump is done through
+                        the memory dump subresource of the VirtualMachine and requires
+                        the preserve action.
+                      type: string
+                    model:
+                      description: Model of the panic device. Valid values are pvpanic,
+                        isa, hyperv. Defaults to pvpanic.
+                      type: string
+                    onCrash:
+                      description: The action to take when the guest reports a crash.
+                        Valid values are restart, poweroff, preserve. Defaults to
+                        poweroff.
+                      type: string
+                  type: object
+                rng:
+                  description: Whether to have random number generator from host
+                  type: object
+                sound:
+                  description: Whether to emulate a sound device.
                   properties:
                     model:
                       description: 'We only support ich9 or ac97. If SoundDevice is
@@ -10914,6 +12466,18 @@ var CRDsValidation map[string]string = map[string]string{
                           type: boolean
                       type: object
                   type: object
+                generationID:
+                  description: GenerationID exposes a VM Generation ID device to the
+                    guest. It allows the guest to detect a rollback, e.g. after a
+                    restore from a snapshot.
+                  properties:
+                    uuid:
+                      description: UUID reported to the guest as the VM Generation
+                        ID. Defaults to an ID derived from the VirtualMachine, which
+                        is stable across reboots and migrations. It is regenerated
+                        when the VirtualMachine is restored from a snapshot or cloned.
+                      type: string
+                  type: object
                 kernelBoot:
                   description: Settings to set the kernel for booting.
                   properties:
@@ -11077,6 +12641,88 @@ var CRDsValidation map[string]string = map[string]string{
             handle tearing the VMI down. Details can be found in the commit description
             https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.'
           type: string
+        firewall:
+          description: Firewall defines the rules filtering the traffic of the pod
+            network interface. Only supported with the masquerade and passt bindings.
+            The rules can be updated while the vmi is running.
+          properties:
+            egress:
+              description: Egress filters the traffic sent by the virtual machine.
+              properties:
+                defaultAction:
+                  description: DefaultAction is applied to the traffic not matching
+                    any rule. Defaults to Drop.
+                  type: string
+                rules:
+                  description: Rules are evaluated in order, the action of the first
+                    matching rule is applied.
+                  items:
+                    description: FirewallRule matches traffic by the remote address,
+                      the protocol and the destination port.
+                    properties:
+                      action:
+                        description: Action applied to the matching traffic, one of
+                          Accept or Drop.
+                        type: string
+                      cidr:
+                        description: CIDR of the remote addresses, the source for
+                          ingress and the destination for egress traffic. All addresses
+                          are matched if not specified.
+                        type: string
+                      port:
+                        description: Port is the destination port to match, requires
+                          the TCP or UDP protocol. All ports are matched if not specified.
+                        format: int32
+                        type: integer
+                      protocol:
+                        description: Protocol to match, one of TCP, UDP or ICMP. All
+                          protocols are matched if not specified.
+                        type: string
+                    required:
+                    - action
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+            ingress:
+              description: Ingress filters the traffic received by the virtual machine.
+              properties:
+                defaultAction:
+                  description: DefaultAction is applied to the traffic not matching
+                    any rule. Defaults to Drop.
+                  type: string
+                rules:
+                  description: Rules are evaluated in order, the action of the first
+                    matching rule is applied.
+                  items:
+                    description: FirewallRule matches traffic by the remote address,
+                      the protocol and the destination port.
+                    properties:
+                      action:
+                        description: Action applied to the matching traffic, one of
+                          Accept or Drop.
+                        type: string
+                      cidr:
+                        description: CIDR of the remote addresses, the source for
+                          ingress and the destination for egress traffic. All addresses
+                          are matched if not specified.
+                        type: string
+                      port:
+                        description: Port is the destination port to match, requires
+                          the TCP or UDP protocol. All ports are matched if not specified.
+                        format: int32
+                        type: integer
+                      protocol:
+                        description: Protocol to match, one of TCP, UDP or ICMP. All
+                          protocols are matched if not specified.
+                        type: string
+                    required:
+                    - action
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+          type: object
         hostname:
           description: Specifies the hostname of the vmi If not specified, the hostname
             will be set to the name of the vmi, if dhcp or cloud-init is configured
@@ -11599,6 +13245,48 @@ var CRDsValidation map[string]string = map[string]string{
           items:
             description: Volume represents a named volume in a vmi.
             properties:
+              backup:
+                description: Backup is attached to the virt launcher and is populated
+                  with the backup images of the vmi disks
+                properties:
+                  backupName:
+                    description: BackupName is the name of the VirtualMachineBackup
+                      writing into the volume
+                    type: string
+                  checkpointName:
+                    description: CheckpointName is the name of the changed block tracking
+                      checkpoint created with the backup
+                    type: string
+                  claimName:
+                    description: 'claimName is the name of a PersistentVolumeClaim
+                      in the same namespace as the pod using this volume. More info:
+                      https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                    type: string
+                  hotpluggable:
+                    description: Hotpluggable indicates whether the volume can be
+                      hotplugged and hotunplugged.
+                    type: boolean
+                  incrementalFrom:
+                    description: IncrementalFrom is the name of the checkpoint the
+                      backup is relative to. A full backup is taken when empty.
+                    type: string
+                  pull:
+                    description: Pull exposes the disks through the NBD server of
+                      the VMI instead of writing the backup images, the volume only
+                      holds the scratch images of the backup job.
+                    type: boolean
+                  pullCompleted:
+                    description: PullCompleted stops the NBD server of a pull mode
+                      backup and completes it
+                    type: boolean
+                  readOnly:
+                    description: readOnly Will force the ReadOnly setting in VolumeMounts.
+                      Default false.
+                    type: boolean
+                required:
+                - backupName
+                - claimName
+                type: object
               cloudInitConfigDrive:
                 description: 'CloudInitConfigDrive represents a cloud-init Config
                   Drive user-data source. The Config Drive data will be added as a
@@ -12052,6 +13740,87 @@ var CRDsValidation map[string]string = map[string]string{
             meant to be used by KubeVirt core components only and can't be set or
             modified by users.
           type: string
+        firewall:
+          description: Firewall represents the firewall rules currently enforced on
+            the pod network interface.
+          properties:
+            egress:
+              description: Egress filters the traffic sent by the virtual machine.
+              properties:
+                defaultAction:
+                  description: DefaultAction is applied to the traffic not matching
+                    any rule. Defaults to Drop.
+                  type: string
+                rules:
+                  description: Rules are evaluated in order, the action of the first
+                    matching rule is applied.
+                  items:
+                    description: FirewallRule matches traffic by the remote address,
+                      the protocol and the destination port.
+                    properties:
+                      action:
+                        description: Action applied to the matching traffic, one of
+                          Accept or Drop.
+                        type: string
+                      cidr:
+                        description: CIDR of the remote addresses, the source for
+                          ingress and the destination for egress traffic. All addresses
+                          are matched if not specified.
+                        type: string
+                      port:
+                        description: Port is the destination port to match, requires
+                          the TCP or UDP protocol. All ports are matched if not specified.
+                        format: int32
+                        type: integer
+                      protocol:
+                        description: Protocol to match, one of TCP, UDP or ICMP. All
+                          protocols are matched if not specified.
+                        type: string
+                    required:
+                    - action
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+            ingress:
+              description: Ingress filters the traffic received by the virtual machine.
+              properties:
+                defaultAction:
+                  description: DefaultAction is applied to the traffic not matching
+                    any rule. Defaults to Drop.
+                  type: string
+                rules:
+                  description: Rules are evaluated in order, the action of the first
+                    matching rule is applied.
+                  items:
+                    description: FirewallRule matches traffic by the remote address,
+                      the protocol and the destination port.
+                    properties:
+                      action:
+                        description: Action applied to the matching traffic, one of
+                          Accept or Drop.
+                        type: string
+                      cidr:
+                        description: CIDR of the remote addresses, the source for
+                          ingress and the destination for egress traffic. All addresses
+                          are matched if not specified.
+                        type: string
+                      port:
+                        description: Port is the destination port to match, requires
+                          the TCP or UDP protocol. All ports are matched if not specified.
+                        format: int32
+                        type: integer
+                      protocol:
+                        description: Protocol to match, one of TCP, UDP or ICMP. All
+                          protocols are matched if not specified.
+                        type: string
+                    required:
+                    - action
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+          type: object
         fsFreezeStatus:
           description: FSFreezeStatus is the state of the fs of the guest it can be
             either frozen or thawed
@@ -12104,6 +13873,10 @@ var CRDsValidation map[string]string = map[string]string{
                 items:
                   type: string
                 type: array
+              linkState:
+                description: 'The link state of the interface seen by the guest, values:
+                  up, down.'
+                type: string
               mac:
                 description: Hardware address of a Virtual Machine interface
                 type: string
@@ -12179,6 +13952,174 @@ var CRDsValidation map[string]string = map[string]string{
               pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
               x-kubernetes-int-or-string: true
           type: object
+        migratedVolumes:
+          description: MigratedVolumes lists the source and destination volumes during
+            the volume migration
+          items:
+            description: StorageMigratedVolumeInfo tracks the information about the
+              source and destination volumes during the volume migration
+            properties:
+              destinationPVCInfo:
+                description: DestinationPVCInfo contains the information about the
+                  destination PVC
+                properties:
+                  accessModes:
+                    description: 'AccessModes contains the desired access modes the
+                      volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1'
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  capacity:
+                    additionalProperties:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    description: Capacity represents the capacity set on the corresponding
+                      PVC status
+                    type: object
+                  claimName:
+                    description: ClaimName is the name of the PVC
+                    type: string
+                  filesystemOverhead:
+                    description: Percentage of filesystem's size to be reserved when
+                      resizing the PVC
+                    pattern: ^(0(?:\.\d{1,3})?|1)$
+                    type: string
+                  preallocated:
+                    description: Preallocated indicates if the PVC's storage is preallocated
+                      or not
+                    type: boolean
+                  requests:
+                    additionalProperties:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    description: Requests represents the resources requested by the
+                      corresponding PVC spec
+                    type: object
+                  volumeMode:
+                    description: VolumeMode defines what type of volume is required
+                      by the claim. Value of Filesystem is implied when not included
+                      in claim spec.
+                    type: string
+                type: object
+              sourcePVCInfo:
+                description: SourcePVCInfo contains the information about the source
+                  PVC
+                properties:
+                  accessModes:
+                    description: 'AccessModes contains the desired access modes the
+                      volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1'
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  capacity:
+                    additionalProperties:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    description: Capacity represents the capacity set on the corresponding
+                      PVC status
+                    type: object
+                  claimName:
+                    description: ClaimName is the name of the PVC
+                    type: string
+                  filesystemOverhead:
+                    description: Percentage of filesystem's size to be reserved when
+                      resizing the PVC
+                    pattern: ^(0(?:\.\d{1,3})?|1)$
+                    type: string
+                  preallocated:
+                    description: Preallocated indicates if the PVC's storage is preallocated
+                      or not
+                    type: boolean
+                  requests:
+                    additionalProperties:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    description: Requests represents the resources requested by the
+                      corresponding PVC spec
+                    type: object
+                  volumeMode:
+                    description: VolumeMode defines what type of volume is required
+                      by the claim. Value of Filesystem is implied when not included
+                      in claim spec.
+                    type: string
+                type: object
+              volumeName:
+                description: VolumeName is the name of the volume that is being migrated
+                type: string
+            required:
+            - volumeName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        migrationHistory:
+          description: MigrationHistory lists the most recent finished live migrations
+            of the vmi, the oldest first
+          items:
+            description: VirtualMachineInstanceMigrationHistoryEntry records the outcome
+              of a finished live migration
+            properties:
+              dataTransferred:
+                description: DataTransferred is the number of bytes sent to the target
+                  node
+                format: int64
+                type: integer
+              downtime:
+                description: Downtime is the time the guest was paused to switch over
+                  to the target node
+                type: string
+              duration:
+                description: Duration is the time between the start and the end of
+                  the migration
+                type: string
+              endTimestamp:
+                description: The time the migration ended
+                format: date-time
+                type: string
+              failed:
+                description: Indicates that the migration failed
+                type: boolean
+              failureReason:
+                description: The reason of a failed migration
+                type: string
+              migrationName:
+                description: The name of the VirtualMachineInstanceMigration object
+                type: string
+              migrationUid:
+                description: The UID of the VirtualMachineInstanceMigration object
+                type: string
+              mode:
+                description: Mode is the migration mode the migration ended in
+                type: string
+              sourceNode:
+                description: The node the vmi was migrated from
+                type: string
+              startTimestamp:
+                description: The time the migration began
+                format: date-time
+                type: string
+              targetNode:
+                description: The node the vmi was migrated to
+                type: string
+            required:
+            - migrationName
+            - migrationUid
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         migrationMethod:
           description: 'Represents the method using which the vmi can be migrated:
             live migration or block migration'
@@ -12195,17 +14136,111 @@ var CRDsValidation map[string]string = map[string]string{
             completed:
               description: Indicates the migration completed
               type: boolean
+            dataTransferred:
+              description: DataTransferred is the number of bytes sent to the target
+                node
+              format: int64
+              type: integer
+            dirtyRate:
+              description: DirtyRate is an estimate of the memory dirty rate of the
+                guest while the migration was running, updated once per minute
+              properties:
+                estimate:
+                  description: Estimate is the average rate in bytes per second at
+                    which the guest dirtied its memory
+                  format: int64
+                  type: integer
+                timestamp:
+                  description: Timestamp of the latest measurement the estimate is
+                    derived from
+                  format: date-time
+                  type: string
+                transferRate:
+                  description: TransferRate is the average rate in bytes per second
+                    at which memory was transferred
+                  format: int64
+                  type: integer
+                trend:
+                  description: Trend tells whether the dirty rate increased or decreased
+                    compared to the minute before
+                  type: string
+              required:
+              - estimate
+              - timestamp
+              type: object
+            downtime:
+              description: Downtime is the time the guest was paused to switch over
+                to the target node
+              type: string
             endTimestamp:
               description: The time the migration action ended
               format: date-time
               nullable: true
               type: string
+            escalation:
+              description: Escalation reports the escalation stages the migration
+                went through
+              properties:
+                stage:
+                  description: Stage is the current escalation stage of the migration
+                  type: string
+                transitions:
+                  description: Transitions lists the stages the migration entered,
+                    the first one being PreCopy
+                  items:
+                    description: MigrationEscalationTransition records when a live
+                      migration entered an escalation stage and the measurements which
+                      triggered it
+                    properties:
+                      dataRemaining:
+                        description: DataRemaining is the number of bytes which still
+                          had to be transferred
+                        format: int64
+                        type: integer
+                      dirtyRate:
+                        description: DirtyRate is the rate in bytes per second at
+                          which the guest dirtied its memory
+                        format: int64
+                        type: integer
+                      stage:
+                        description: Stage the migration entered
+                        type: string
+                      timestamp:
+                        description: Timestamp of the transition
+                        format: date-time
+                        type: string
+                      transferRate:
+                        description: TransferRate is the rate in bytes per second
+                          at which memory was transferred
+                        format: int64
+                        type: integer
+                    required:
+                    - stage
+                    - timestamp
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              required:
+              - stage
+              type: object
             failed:
               description: Indicates that the migration failed
               type: boolean
+            failureReason:
+              description: The reason reported by the source node for a failed migration
+              type: string
             migrationConfiguration:
               description: Migration configurations to apply
               properties:
+                additionalNetworks:
+                  description: AdditionalNetworks are the names of further CNI
+                    networks virt-handler is attached to. Migration policies can
+                    select one of them as the network of the migrations they
+                    apply to.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
                 allowAutoConverge:
                   description: AllowAutoConverge allows the platform to compromise
                     performance/availability of VMIs to guarantee successful VMI live
@@ -12236,11 +14271,97 @@ var CRDsValidation map[string]string = map[string]string{
                     true. Defaults to 800
                   format: int64
                   type: integer
-                disableTLS:
-                  description: When set to true, DisableTLS will disable the additional
-                    layer of live migration encryption provided by KubeVirt. This
+                compression:
+                  description: Compression compresses the memory transferred over
+                    the multifd channels. It is only applied to migrations which use
+                    MultifdChannels. Defaults to no compression
+                  properties:
+                    level:
+                      description: Level is the compression level. zlib supports levels
+                        from 0 to 9, zstd from 0 to 20. Defaults to the default level
+                        of the method in QEMU
+                      format: int32
+                      type: integer
+                    method:
+                      description: Method is the compression algorithm, either zstd
+                        or zlib
+                      enum:
+                      - zstd
+                      - zlib
+                      type: string
+                  required:
+                  - method
+                  type: object
+                crossClusterTunnelAddress:
+                  description: CrossClusterTunnelAddress is the host and port other
+                    clusters reach the migration tunnel of virt-api at, which listens
+                    on the port 8444 of the virt-api pods. It has to be exposed outside
+                    of the cluster, e.g. by a service of the type LoadBalancer. Cross-cluster
+                    migrations are only received when it is set
+                  type: string
+                disableTLS:
+                  description: When set to true, DisableTLS will disable the additional
+                    layer of live migration encryption provided by KubeVirt. This
                     is usually a bad idea. Defaults to false
                   type: boolean
+                escalation:
+                  description: Escalation enables the staged escalation of live migrations
+                    which don't converge. Migrations start in pre-copy and, while
+                    the guest dirties memory faster than it is transferred, escalate
+                    to a raised bandwidth, to auto-converge throttling and finally
+                    to post-copy. The auto-converge and post-copy stages are only
+                    used if AllowAutoConverge and AllowPostCopy are true. Defaults
+                    to no escalation, post-copy is then only triggered by CompletionTimeoutPerGiB
+                  properties:
+                    autoConvergeIncrement:
+                      description: AutoConvergeIncrement is the percentage the throttling
+                        of the guest CPUs is increased by in every iteration which
+                        still does not converge. Must be between 1 and 99. Defaults
+                        to 10
+                      format: int32
+                      type: integer
+                    autoConvergeInitial:
+                      description: AutoConvergeInitial is the percentage the guest
+                        CPUs are throttled by once auto-converge starts throttling
+                        a migration which does not converge. libvirt only accepts
+                        it when the migration starts, so it applies to the whole migration.
+                        Must be between 1 and 99. Defaults to 20
+                      format: int32
+                      type: integer
+                    bandwidth:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: Bandwidth is the bandwidth limit of the raised
+                        bandwidth stage. The value is in quantity per second. Defaults
+                        to 0 (no limit). The stage is skipped if BandwidthPerMigration
+                        does not limit migrations.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    dirtyRateThresholdPercent:
+                      description: DirtyRateThresholdPercent escalates a migration
+                        when the guest dirties memory at more than this percentage
+                        of the transfer rate. Migrations whose remaining data did
+                        not decrease during a stage are escalated as well. Defaults
+                        to 80
+                      format: int32
+                      type: integer
+                    remainingDataThreshold:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: RemainingDataThreshold prevents the escalation
+                        of migrations with less data left to transfer, since they
+                        are about to complete. Defaults to 256Mi
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    stageDuration:
+                      description: StageDuration is the minimum number of seconds
+                        a migration stays in a stage before it escalates to the next
+                        one. Defaults to 30
+                      format: int64
+                      type: integer
+                  type: object
                 matchSELinuxLevelOnMigration:
                   description: By default, the SELinux level of target virt-launcher
                     pods is forced to the level of the source virt-launcher. When
@@ -12250,6 +14371,20 @@ var CRDsValidation map[string]string = map[string]string{
                     migrations will fail when using RWX volumes that don't automatically
                     deal with SELinux levels.
                   type: boolean
+                maxDowntime:
+                  description: MaxDowntime is the maximum time the guest may be paused
+                    to switch over to the target node. A migration does not complete
+                    before its remaining data can be transferred within this time.
+                    Defaults to the default of libvirt, 300ms
+                  type: string
+                multifdChannels:
+                  description: MultifdChannels is the number of parallel connections
+                    (QEMU multifd channels) live migrations transfer the guest memory
+                    over. Multiple channels allow migrations to exceed the throughput
+                    of a single TCP stream. The kubevirt.io/multiThreadedQemuMigration
+                    annotation of a VMI takes precedence. Defaults to a single connection
+                  format: int32
+                  type: integer
                 network:
                   description: Network is the name of the CNI network to use for live
                     migrations. By default, migrations go through the pod network.
@@ -12296,9 +14431,17 @@ var CRDsValidation map[string]string = map[string]string{
               description: Lets us know if the vmi is currently running pre or post
                 copy migration
               type: string
+            setupTime:
+              description: SetupTime is the time the migration spent before it started
+                to transfer data
+              type: string
             sourceNode:
               description: The source node that the VMI originated on
               type: string
+            sourceVirtualMachineInstanceUID:
+              description: The UID of the migrated VMI in the source cluster of a
+                cross-cluster migration
+              type: string
             startTimestamp:
               description: The time the migration action began
               format: date-time
@@ -12341,6 +14484,36 @@ var CRDsValidation map[string]string = map[string]string{
             targetPod:
               description: The target pod that the VMI is moving to
               type: string
+            targetTunnel:
+              description: The migration tunnel of the target cluster of a cross-cluster
+                migration, the source node sends the migration stream through it
+              properties:
+                address:
+                  description: Address is the host and port the tunnel is reachable
+                    at from the source cluster
+                  type: string
+                caBundle:
+                  description: CABundle is the PEM encoded CA bundle which signed
+                    the certificate of the tunnel
+                  format: byte
+                  type: string
+                serverName:
+                  description: ServerName is the name the certificate of the tunnel
+                    is issued for
+                  type: string
+              required:
+              - address
+              - caBundle
+              - serverName
+              type: object
+            targetVirtualMachineInstanceUID:
+              description: The UID of the receiving VMI in the target cluster of a
+                cross-cluster migration
+              type: string
+            totalTime:
+              description: TotalTime is the time the migration took from its start
+                to its completion
+              type: string
           type: object
         migrationTransport:
           description: This represents the migration transport
@@ -12408,6 +14581,45 @@ var CRDsValidation map[string]string = map[string]string{
             description: VolumeStatus represents information about the status of volumes
               attached to the VirtualMachineInstance.
             properties:
+              backupVolume:
+                description: If the volume is a backup volume, this will contain the
+                  backup info.
+                properties:
+                  checkpointVolumes:
+                    description: CheckpointVolumes is the list of volumes tracked
+                      by a dirty bitmap of the checkpoint created with the backup.
+                      Only the qcow2 disk images can store a dirty bitmap.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  endTimestamp:
+                    description: EndTimestamp is the time when the backup completed
+                    format: date-time
+                    type: string
+                  incrementalVolumes:
+                    description: IncrementalVolumes is the list of volumes whose backup
+                      image only contains the blocks changed since the parent checkpoint,
+                      the other volumes were copied entirely
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  startTimestamp:
+                    description: StartTimestamp is the time when the backup started
+                    format: date-time
+                    type: string
+                  targetDirectory:
+                    description: TargetDirectory is the directory of the volume containing
+                      the backup images
+                    type: string
+                  volumes:
+                    description: Volumes is the list of volumes included in the backup
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                type: object
               containerDiskVolume:
                 description: ContainerDiskVolume shows info about the containerdisk,
                   if the volume is a containerdisk
@@ -12479,6 +14691,9 @@ var CRDsValidation map[string]string = map[string]string{
                     description: Capacity represents the capacity set on the corresponding
                       PVC status
                     type: object
+                  claimName:
+                    description: ClaimName is the name of the PVC
+                    type: string
                   filesystemOverhead:
                     description: Percentage of filesystem's size to be reserved when
                       resizing the PVC
@@ -12548,6 +14763,3601 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
+        priority:
+          description: 'Priority of the migration among the pending migrations, migrations
+            with a higher priority are started first. Defaults to a priority derived
+            from the origin of the migration: evacuations come first, followed by
+            migrations requested by users and finally workload updates. Only KubeVirt
+            admins may set a priority above the default of migrations requested by
+            users.'
+          format: int32
+          type: integer
+        receive:
+          description: Receive prepares the VMI to receive a migration from another
+            cluster. Receiving migrations are created by the source cluster of the
+            migration.
+          properties:
+            migrationID:
+              description: MigrationID identifies the migration in both clusters
+              type: string
+            sourceCABundle:
+              description: SourceCABundle is the PEM encoded CA bundle of the source
+                cluster. The migration tunnel only accepts the migration stream from
+                virt-handler client certificates signed by it.
+              format: byte
+              type: string
+            template:
+              description: Template of the receiving VMI, virt-controller creates
+                the VMI from it. The creator of the migration must be allowed to create
+                VMIs in its namespace.
+              properties:
+                metadata:
+                  nullable: true
+                  type: object
+                  x-kubernetes-preserve-unknown-fields: true
+                spec:
+                  description: VirtualMachineInstance Spec contains the VirtualMachineInstance
+                    specification.
+                  properties:
+                    accessCredentials:
+                      description: Specifies a set of public keys to inject into the
+                        vm guest
+                      items:
+                        description: AccessCredential represents a credential source
+                          that can be used to authorize remote access to the vm guest
+                          Only one of its members may be specified.
+                        properties:
+                          sshPublicKey:
+                            description: SSHPublicKey represents the source and method
+                              of applying a ssh public key into a guest virtual machine.
+                            properties:
+                              propagationMethod:
+                                description: PropagationMethod represents how the
+                                  public key is injected into the vm guest.
+                                properties:
+                                  configDrive:
+                                    description: ConfigDrivePropagation means that
+                                      the ssh public keys are injected into the VM
+                                      using metadata using the configDrive cloud-init
+                                      provider
+                                    type: object
+                                  noCloud:
+                                    description: NoCloudPropagation means that the
+                                      ssh public keys are injected into the VM using
+                                      metadata using the noCloud cloud-init provider
+                                    type: object
+                                  qemuGuestAgent:
+                                    description: QemuGuestAgentAccessCredentailPropagation
+                                      means ssh public keys are dynamically injected
+                                      into the vm at runtime via the qemu guest agent.
+                                      This feature requires the qemu guest agent to
+                                      be running within the guest.
+                                    properties:
+                                      users:
+                                        description: Users represents a list of guest
+                                          users that should have the ssh public keys
+                                          added to their authorized_keys file.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: set
+                                    required:
+                                    - users
+                                    type: object
+                                type: object
+                              source:
+                                description: Source represents where the public keys
+                                  are pulled from
+                                properties:
+                                  secret:
+                                    description: Secret means that the access credential
+                                      is pulled from a kubernetes secret
+                                    properties:
+                                      secretName:
+                                        description: SecretName represents the name
+                                          of the secret in the VMI's namespace
+                                        type: string
+                                    required:
+                                    - secretName
+                                    type: object
+                                type: object
+                            required:
+                            - propagationMethod
+                            - source
+                            type: object
+                          userPassword:
+                            description: UserPassword represents the source and method
+                              for applying a guest user's password
+                            properties:
+                              propagationMethod:
+                                description: propagationMethod represents how the
+                                  user passwords are injected into the vm guest.
+                                properties:
+                                  qemuGuestAgent:
+                                    description: QemuGuestAgentAccessCredentailPropagation
+                                      means passwords are dynamically injected into
+                                      the vm at runtime via the qemu guest agent.
+                                      This feature requires the qemu guest agent to
+                                      be running within the guest.
+                                    type: object
+                                type: object
+                              source:
+                                description: Source represents where the user passwords
+                                  are pulled from
+                                properties:
+                                  secret:
+                                    description: Secret means that the access credential
+                                      is pulled from a kubernetes secret
+                                    properties:
+                                      secretName:
+                                        description: SecretName represents the name
+                                          of the secret in the VMI's namespace
+                                        type: string
+                                    required:
+                                    - secretName
+                                    type: object
+                                type: object
+                            required:
+                            - propagationMethod
+                            - source
+                            type: object
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    affinity:
+                      description: If affinity is specifies, obey all the affinity
+                        rules
+                      properties:
+                        nodeAffinity:
+                          description: Describes node affinity scheduling rules for
+                            the pod.
+                          properties:
+                            preferredDuringSchedulingIgnoredDuringExecution:
+                              description: The scheduler will prefer to schedule pods
+                                to nodes that satisfy the affinity expressions specified
+                                by this field, but it may choose a node that violates
+                                one or more of the expressions. The node that is most
+                                preferred is the one with the greatest sum of weights,
+                                i.e. for each node that meets all of the scheduling
+                                requirements (resource request, requiredDuringScheduling
+                                affinity expressions, etc.), compute a sum by iterating
+                                through the elements of this field and adding "weight"
+                                to the sum if the node matches the corresponding matchExpressions;
+                                the node(s) with the highest sum are the most preferred.
+                              items:
+                                description: An empty preferred scheduling term matches
+                                  all objects with implicit weight 0 (i.e. it's a
+                                  no-op). A null preferred scheduling term matches
+                                  no objects (i.e. is also a no-op).
+                                properties:
+                                  preference:
+                                    description: A node selector term, associated
+                                      with the corresponding weight.
+                                    properties:
+                                      matchExpressions:
+                                        description: A list of node selector requirements
+                                          by node's labels.
+                                        items:
+                                          description: A node selector requirement
+                                            is a selector that contains values, a
+                                            key, and an operator that relates the
+                                            key and values.
+                                          properties:
+                                            key:
+                                              description: The label key that the
+                                                selector applies to.
+                                              type: string
+                                            operator:
+                                              description: Represents a key's relationship
+                                                to a set of values. Valid operators
+                                                are In, NotIn, Exists, DoesNotExist.
+                                                Gt, and Lt.
+                                              type: string
+                                            values:
+                                              description: An array of string values.
+                                                If the operator is In or NotIn, the
+                                                values array must be non-empty. If
+                                                the operator is Exists or DoesNotExist,
+                                                the values array must be empty. If
+                                                the operator is Gt or Lt, the values
+                                                array must have a single element,
+                                                which will be interpreted as an integer.
+                                                This array is replaced during a strategic
+                                                merge patch.
+                                              items:
+                                                type: string
+                                              type: array
+                                          required:
+                                          - key
+                                          - operator
+                                          type: object
+                                        type: array
+                                      matchFields:
+                                        description: A list of node selector requirements
+                                          by node's fields.
+                                        items:
+                                          description: A node selector requirement
+                                            is a selector that contains values, a
+                                            key, and an operator that relates the
+                                            key and values.
+                                          properties:
+                                            key:
+                                              description: The label key that the
+                                                selector applies to.
+                                              type: string
+                                            operator:
+                                              description: Represents a key's relationship
+                                                to a set of values. Valid operators
+                                                are In, NotIn, Exists, DoesNotExist.
+                                                Gt, and Lt.
+                                              type: string
+                                            values:
+                                              description: An array of string values.
+                                                If the operator is In or NotIn, the
+                                                values array must be non-empty. If
+                                                the operator is Exists or DoesNotExist,
+                                                the values array must be empty. If
+                                                the operator is Gt or Lt, the values
+                                                array must have a single element,
+                                                which will be interpreted as an integer.
+                                                This array is replaced during a strategic
+                                                merge patch.
+                                              items:
+                                                type: string
+                                              type: array
+                                          required:
+                                          - key
+                                          - operator
+                                          type: object
+                                        type: array
+                                    type: object
+                                  weight:
+                                    description: Weight associated with matching the
+                                      corresponding nodeSelectorTerm, in the range
+                                      1-100.
+                                    format: int32
+                                    type: integer
+                                required:
+                                - preference
+                                - weight
+                                type: object
+                              type: array
+                            requiredDuringSchedulingIgnoredDuringExecution:
+                              description: If the affinity requirements specified
+                                by this field are not met at scheduling time, the
+                                pod will not be scheduled onto the node. If the affinity
+                                requirements specified by this field cease to be met
+                                at some point during pod execution (e.g. due to an
+                                update), the system may or may not try to eventually
+                                evict the pod from its node.
+                              properties:
+                                nodeSelectorTerms:
+                                  description: Required. A list of node selector terms.
+                                    The terms are ORed.
+                                  items:
+                                    description: A null or empty node selector term
+                                      matches no objects. The requirements of them
+                                      are ANDed. The TopologySelectorTerm type implements
+                                      a subset of the NodeSelectorTerm.
+                                    properties:
+                                      matchExpressions:
+                                        description: A list of node selector requirements
+                                          by node's labels.
+                                        items:
+                                          description: A node selector requirement
+                                            is a selector that contains values, a
+                                            key, and an operator that relates the
+                                            key and values.
+                                          properties:
+                                            key:
+                                              description: The label key that the
+                                                selector applies to.
+                                              type: string
+                                            operator:
+                                              description: Represents a key's relationship
+                                                to a set of values. Valid operators
+                                                are In, NotIn, Exists, DoesNotExist.
+                                                Gt, and Lt.
+                                              type: string
+                                            values:
+                                              description: An array of string values.
+                                                If the operator is In or NotIn, the
+                                                values array must be non-empty. If
+                                                the operator is Exists or DoesNotExist,
+                                                the values array must be empty. If
+                                                the operator is Gt or Lt, the values
+                                                array must have a single element,
+                                                which will be interpreted as an integer.
+                                                This array is replaced during a strategic
+                                                merge patch.
+                                              items:
+                                                type: string
+                                              type: array
+                                          required:
+                                          - key
+                                          - operator
+                                          type: object
+                                        type: array
+                                      matchFields:
+                                        description: A list of node selector requirements
+                                          by node's fields.
+                                        items:
+                                          description: A node selector requirement
+                                            is a selector that contains values, a
+                                            key, and an operator that relates the
+                                            key and values.
+                                          properties:
+                                            key:
+                                              description: The label key that the
+                                                selector applies to.
+                                              type: string
+                                            operator:
+                                              description: Represents a key's relationship
+                                                to a set of values. Valid operators
+                                                are In, NotIn, Exists, DoesNotExist.
+                                                Gt, and Lt.
+                                              type: string
+                                            values:
+                                              description: An array of string values.
+                                                If the operator is In or NotIn, the
+                                                values array must be non-empty. If
+                                                the operator is Exists or DoesNotExist,
+                                                the values array must be empty. If
+                                                the operator is Gt or Lt, the values
+                                                array must have a single element,
+                                                which will be interpreted as an integer.
+                                                This array is replaced during a strategic
+                                                merge patch.
+                                              items:
+                                                type: string
+                                              type: array
+                                          required:
+                                          - key
+                                          - operator
+                                          type: object
+                                        type: array
+                                    type: object
+                                  type: array
+                              required:
+                              - nodeSelectorTerms
+                              type: object
+                          type: object
+                        podAffinity:
+                          description: Describes pod affinity scheduling rules (e.g.
+                            co-locate this pod in the same node, zone, etc. as some
+                            other pod(s)).
+                          properties:
+                            preferredDuringSchedulingIgnoredDuringExecution:
+                              description: The scheduler will prefer to schedule pods
+                                to nodes that satisfy the affinity expressions specified
+                                by this field, but it may choose a node that violates
+                                one or more of the expressions. The node that is most
+                                preferred is the one with the greatest sum of weights,
+                                i.e. for each node that meets all of the scheduling
+                                requirements (resource request, requiredDuringScheduling
+                                affinity expressions, etc.), compute a sum by iterating
+                                through the elements of this field and adding "weight"
+                                to the sum if the node has pods which matches the
+                                corresponding podAffinityTerm; the node(s) with the
+                                highest sum are the most preferred.
+                              items:
+                                description: The weights of all of the matched WeightedPodAffinityTerm
+                                  fields are added per-node to find the most preferred
+                                  node(s)
+                                properties:
+                                  podAffinityTerm:
+                                    description: Required. A pod affinity term, associated
+                                      with the corresponding weight.
+                                    properties:
+                                      labelSelector:
+                                        description: A label query over a set of resources,
+                                          in this case pods.
+                                        properties:
+                                          matchExpressions:
+                                            description: matchExpressions is a list
+                                              of label selector requirements. The
+                                              requirements are ANDed.
+                                            items:
+                                              description: A label selector requirement
+                                                is a selector that contains values,
+                                                a key, and an operator that relates
+                                                the key and values.
+                                              properties:
+                                                key:
+                                                  description: key is the label key
+                                                    that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: operator represents
+                                                    a key's relationship to a set
+                                                    of values. Valid operators are
+                                                    In, NotIn, Exists and DoesNotExist.
+                                                  type: string
+                                                values:
+                                                  description: values is an array
+                                                    of string values. If the operator
+                                                    is In or NotIn, the values array
+                                                    must be non-empty. If the operator
+                                                    is Exists or DoesNotExist, the
+                                                    values array must be empty. This
+                                                    array is replaced during a strategic
+                                                    merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                          matchLabels:
+                                            additionalProperties:
+                                              type: string
+                                            description: matchLabels is a map of {key,value}
+                                              pairs. A single {key,value} in the matchLabels
+                                              map is equivalent to an element of matchExpressions,
+                                              whose key field is "key", the operator
+                                              is "In", and the values array contains
+                                              only "value". The requirements are ANDed.
+                                            type: object
+                                        type: object
+                                      namespaceSelector:
+                                        description: A label query over the set of
+                                          namespaces that the term applies to. The
+                                          term is applied to the union of the namespaces
+                                          selected by this field and the ones listed
+                                          in the namespaces field. null selector and
+                                          null or empty namespaces list means "this
+                                          pod's namespace". An empty selector ({})
+                                          matches all namespaces.
+                                        properties:
+                                          matchExpressions:
+                                            description: matchExpressions is a list
+                                              of label selector requirements. The
+                                              requirements are ANDed.
+                                            items:
+                                              description: A label selector requirement
+                                                is a selector that contains values,
+                                                a key, and an operator that relates
+                                                the key and values.
+                                              properties:
+                                                key:
+                                                  description: key is the label key
+                                                    that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: operator represents
+                                                    a key's relationship to a set
+                                                    of values. Valid operators are
+                                                    In, NotIn, Exists and DoesNotExist.
+                                                  type: string
+                                                values:
+                                                  description: values is an array
+                                                    of string values. If the operator
+                                                    is In or NotIn, the values array
+                                                    must be non-empty. If the operator
+                                                    is Exists or DoesNotExist, the
+                                                    values array must be empty. This
+                                                    array is replaced during a strategic
+                                                    merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                          matchLabels:
+                                            additionalProperties:
+                                              type: string
+                                            description: matchLabels is a map of {key,value}
+                                              pairs. A single {key,value} in the matchLabels
+                                              map is equivalent to an element of matchExpressions,
+                                              whose key field is "key", the operator
+                                              is "In", and the values array contains
+                                              only "value". The requirements are ANDed.
+                                            type: object
+                                        type: object
+                                      namespaces:
+                                        description: namespaces specifies a static
+                                          list of namespace names that the term applies
+                                          to. The term is applied to the union of
+                                          the namespaces listed in this field and
+                                          the ones selected by namespaceSelector.
+                                          null or empty namespaces list and null namespaceSelector
+                                          means "this pod's namespace".
+                                        items:
+                                          type: string
+                                        type: array
+                                      topologyKey:
+                                        description: This pod should be co-located
+                                          (affinity) or not co-located (anti-affinity)
+                                          with the pods matching the labelSelector
+                                          in the specified namespaces, where co-located
+                                          is defined as running on a node whose value
+                                          of the label with key topologyKey matches
+                                          that of any node on which any of the selected
+                                          pods is running. Empty topologyKey is not
+                                          allowed.
+                                        type: string
+                                    required:
+                                    - topologyKey
+                                    type: object
+                                  weight:
+                                    description: weight associated with matching the
+                                      corresponding podAffinityTerm, in the range
+                                      1-100.
+                                    format: int32
+                                    type: integer
+                                required:
+                                - podAffinityTerm
+                                - weight
+                                type: object
+                              type: array
+                            requiredDuringSchedulingIgnoredDuringExecution:
+                              description: If the affinity requirements specified
+                                by this field are not met at scheduling time, the
+                                pod will not be scheduled onto the node. If the affinity
+                                requirements specified by this field cease to be met
+                                at some point during pod execution (e.g. due to a
+                                pod label update), the system may or may not try to
+                                eventually evict the pod from its node. When there
+                                are multiple elements, the lists of nodes corresponding
+                                to each podAffinityTerm are intersected, i.e. all
+                                terms must be satisfied.
+                              items:
+                                description: Defines a set of pods (namely those matching
+                                  the labelSelector relative to the given namespace(s))
+                                  that this pod should be co-located (affinity) or
+                                  not co-located (anti-affinity) with, where co-located
+                                  is defined as running on a node whose value of the
+                                  label with key <topologyKey> matches that of any
+                                  node on which a pod of the set of pods is running
+                                properties:
+                                  labelSelector:
+                                    description: A label query over a set of resources,
+                                      in this case pods.
+                                    properties:
+                                      matchExpressions:
+                                        description: matchExpressions is a list of
+                                          label selector requirements. The requirements
+                                          are ANDed.
+                                        items:
+                                          description: A label selector requirement
+                                            is a selector that contains values, a
+                                            key, and an operator that relates the
+                                            key and values.
+                                          properties:
+                                            key:
+                                              description: key is the label key that
+                                                the selector applies to.
+                                              type: string
+                                            operator:
+                                              description: operator represents a key's
+                                                relationship to a set of values. Valid
+                                                operators are In, NotIn, Exists and
+                                                DoesNotExist.
+                                              type: string
+                                            values:
+                                              description: values is an array of string
+                                                values. If the operator is In or NotIn,
+                                                the values array must be non-empty.
+                                                If the operator is Exists or DoesNotExist,
+                                                the values array must be empty. This
+                                                array is replaced during a strategic
+                                                merge patch.
+                                              items:
+                                                type: string
+                                              type: array
+                                          required:
+                                          - key
+                                          - operator
+                                          type: object
+                                        type: array
+                                      matchLabels:
+                                        additionalProperties:
+                                          type: string
+                                        description: matchLabels is a map of {key,value}
+                                          pairs. A single {key,value} in the matchLabels
+                                          map is equivalent to an element of matchExpressions,
+                                          whose key field is "key", the operator is
+                                          "In", and the values array contains only
+                                          "value". The requirements are ANDed.
+                                        type: object
+                                    type: object
+                                  namespaceSelector:
+                                    description: A label query over the set of namespaces
+                                      that the term applies to. The term is applied
+                                      to the union of the namespaces selected by this
+                                      field and the ones listed in the namespaces
+                                      field. null selector and null or empty namespaces
+                                      list means "this pod's namespace". An empty
+                                      selector ({}) matches all namespaces.
+                                    properties:
+                                      matchExpressions:
+                                        description: matchExpressions is a list of
+                                          label selector requirements. The requirements
+                                          are ANDed.
+                                        items:
+                                          description: A label selector requirement
+                                            is a selector that contains values, a
+                                            key, and an operator that relates the
+                                            key and values.
+                                          properties:
+                                            key:
+                                              description: key is the label key that
+                                                the selector applies to.
+                                              type: string
+                                            operator:
+                                              description: operator represents a key's
+                                                relationship to a set of values. Valid
+                                                operators are In, NotIn, Exists and
+                                                DoesNotExist.
+                                              type: string
+                                            values:
+                                              description: values is an array of string
+                                                values. If the operator is In or NotIn,
+                                                the values array must be non-empty.
+                                                If the operator is Exists or DoesNotExist,
+                                                the values array must be empty. This
+                                                array is replaced during a strategic
+                                                merge patch.
+                                              items:
+                                                type: string
+                                              type: array
+                                          required:
+                                          - key
+                                          - operator
+                                          type: object
+                                        type: array
+                                      matchLabels:
+                                        additionalProperties:
+                                          type: string
+                                        description: matchLabels is a map of {key,value}
+                                          pairs. A single {key,value} in the matchLabels
+                                          map is equivalent to an element of matchExpressions,
+                                          whose key field is "key", the operator is
+                                          "In", and the values array contains only
+                                          "value". The requirements are ANDed.
+                                        type: object
+                                    type: object
+                                  namespaces:
+                                    description: namespaces specifies a static list
+                                      of namespace names that the term applies to.
+                                      The term is applied to the union of the namespaces
+                                      listed in this field and the ones selected by
+                                      namespaceSelector. null or empty namespaces
+                                      list and null namespaceSelector means "this
+                                      pod's namespace".
+                                    items:
+                                      type: string
+                                    type: array
+                                  topologyKey:
+                                    description: This pod should be co-located (affinity)
+                                      or not co-located (anti-affinity) with the pods
+                                      matching the labelSelector in the specified
+                                      namespaces, where co-located is defined as running
+                                      on a node whose value of the label with key
+                                      topologyKey matches that of any node on which
+                                      any of the selected pods is running. Empty topologyKey
+                                      is not allowed.
+                                    type: string
+                                required:
+                                - topologyKey
+                                type: object
+                              type: array
+                          type: object
+                        podAntiAffinity:
+                          description: Describes pod anti-affinity scheduling rules
+                            (e.g. avoid putting this pod in the same node, zone, etc.
+                            as some other pod(s)).
+                          properties:
+                            preferredDuringSchedulingIgnoredDuringExecution:
+                              description: The scheduler will prefer to schedule pods
+                                to nodes that satisfy the anti-affinity expressions
+                                specified by this field, but it may choose a node
+                                that violates one or more of the expressions. The
+                                node that is most preferred is the one with the greatest
+                                sum of weights, i.e. for each node that meets all
+                                of the scheduling requirements (resource request,
+                                requiredDuringScheduling anti-affinity expressions,
+                                etc.), compute a sum by iterating through the elements
+                                of this field and adding "weight" to the sum if the
+                                node has pods which matches the corresponding podAffinityTerm;
+                                the node(s) with the highest sum are the most preferred.
+                              items:
+                                description: The weights of all of the matched WeightedPodAffinityTerm
+                                  fields are added per-node to find the most preferred
+                                  node(s)
+                                properties:
+                                  podAffinityTerm:
+                                    description: Required. A pod affinity term, associated
+                                      with the corresponding weight.
+                                    properties:
+                                      labelSelector:
+                                        description: A label query over a set of resources,
+                                          in this case pods.
+                                        properties:
+                                          matchExpressions:
+                                            description: matchExpressions is a list
+                                              of label selector requirements. The
+                                              requirements are ANDed.
+                                            items:
+                                              description: A label selector requirement
+                                                is a selector that contains values,
+                                                a key, and an operator that relates
+                                                the key and values.
+                                              properties:
+                                                key:
+                                                  description: key is the label key
+                                                    that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: operator represents
+                                                    a key's relationship to a set
+                                                    of values. Valid operators are
+                                                    In, NotIn, Exists and DoesNotExist.
+                                                  type: string
+                                                values:
+                                                  description: values is an array
+                                                    of string values. If the operator
+                                                    is In or NotIn, the values array
+                                                    must be non-empty. If the operator
+                                                    is Exists or DoesNotExist, the
+                                                    values array must be empty. This
+                                                    array is replaced during a strategic
+                                                    merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                          matchLabels:
+                                            additionalProperties:
+                                              type: string
+                                            description: matchLabels is a map of {key,value}
+                                              pairs. A single {key,value} in the matchLabels
+                                              map is equivalent to an element of matchExpressions,
+                                              whose key field is "key", the operator
+                                              is "In", and the values array contains
+                                              only "value". The requirements are ANDed.
+                                            type: object
+                                        type: object
+                                      namespaceSelector:
+                                        description: A label query over the set of
+                                          namespaces that the term applies to. The
+                                          term is applied to the union of the namespaces
+                                          selected by this field and the ones listed
+                                          in the namespaces field. null selector and
+                                          null or empty namespaces list means "this
+                                          pod's namespace". An empty selector ({})
+                                          matches all namespaces.
+                                        properties:
+                                          matchExpressions:
+                                            description: matchExpressions is a list
+                                              of label selector requirements. The
+                                              requirements are ANDed.
+                                            items:
+                                              description: A label selector requirement
+                                                is a selector that contains values,
+                                                a key, and an operator that relates
+                                                the key and values.
+                                              properties:
+                                                key:
+                                                  description: key is the label key
+                                                    that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: operator represents
+                                                    a key's relationship to a set
+                                                    of values. Valid operators are
+                                                    In, NotIn, Exists and DoesNotExist.
+                                                  type: string
+                                                values:
+                                                  description: values is an array
+                                                    of string values. If the operator
+                                                    is In or NotIn, the values array
+                                                    must be non-empty. If the operator
+                                                    is Exists or DoesNotExist, the
+                                                    values array must be empty. This
+                                                    array is replaced during a strategic
+                                                    merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                          matchLabels:
+                                            additionalProperties:
+                                              type: string
+                                            description: matchLabels is a map of {key,value}
+                                              pairs. A single {key,value} in the matchLabels
+                                              map is equivalent to an element of matchExpressions,
+                                              whose key field is "key", the operator
+                                              is "In", and the values array contains
+                                              only "value". The requirements are ANDed.
+                                            type: object
+                                        type: object
+                                      namespaces:
+                                        description: namespaces specifies a static
+                                          list of namespace names that the term applies
+                                          to. The term is applied to the union of
+                                          the namespaces listed in this field and
+                                          the ones selected by namespaceSelector.
+                                          null or empty namespaces list and null namespaceSelector
+                                          means "this pod's namespace".
+                                        items:
+                                          type: string
+                                        type: array
+                                      topologyKey:
+                                        description: This pod should be co-located
+                                          (affinity) or not co-located (anti-affinity)
+                                          with the pods matching the labelSelector
+                                          in the specified namespaces, where co-located
+                                          is defined as running on a node whose value
+                                          of the label with key topologyKey matches
+                                          that of any node on which any of the selected
+                                          pods is running. Empty topologyKey is not
+                                          allowed.
+                                        type: string
+                                    required:
+                                    - topologyKey
+                                    type: object
+                                  weight:
+                                    description: weight associated with matching the
+                                      corresponding podAffinityTerm, in the range
+                                      1-100.
+                                    format: int32
+                                    type: integer
+                                required:
+                                - podAffinityTerm
+                                - weight
+                                type: object
+                              type: array
+                            requiredDuringSchedulingIgnoredDuringExecution:
+                              description: If the anti-affinity requirements specified
+                                by this field are not met at scheduling time, the
+                                pod will not be scheduled onto the node. If the anti-affinity
+                                requirements specified by this field cease to be met
+                                at some point during pod execution (e.g. due to a
+                                pod label update), the system may or may not try to
+                                eventually evict the pod from its node. When there
+                                are multiple elements, the lists of nodes corresponding
+                                to each podAffinityTerm are intersected, i.e. all
+                                terms must be satisfied.
+                              items:
+                                description: Defines a set of pods (namely those matching
+                                  the labelSelector relative to the given namespace(s))
+                                  that this pod should be co-located (affinity) or
+                                  not co-located (anti-affinity) with, where co-located
+                                  is defined as running on a node whose value of the
+                                  label with key <topologyKey> matches that of any
+                                  node on which a pod of the set of pods is running
+                                properties:
+                                  labelSelector:
+                                    description: A label query over a set of resources,
+                                      in this case pods.
+                                    properties:
+                                      matchExpressions:
+                                        description: matchExpressions is a list of
+                                          label selector requirements. The requirements
+                                          are ANDed.
+                                        items:
+                                          description: A label selector requirement
+                                            is a selector that contains values, a
+                                            key, and an operator that relates the
+                                            key and values.
+                                          properties:
+                                            key:
+                                              description: key is the label key that
+                                                the selector applies to.
+                                              type: string
+                                            operator:
+                                              description: operator represents a key's
+                                                relationship to a set of values. Valid
+                                                operators are In, NotIn, Exists and
+                                                DoesNotExist.
+                                              type: string
+                                            values:
+                                              description: values is an array of string
+                                                values. If the operator is In or NotIn,
+                                                the values array must be non-empty.
+                                                If the operator is Exists or DoesNotExist,
+                                                the values array must be empty. This
+                                                array is replaced during a strategic
+                                                merge patch.
+                                              items:
+                                                type: string
+                                              type: array
+                                          required:
+                                          - key
+                                          - operator
+                                          type: object
+                                        type: array
+                                      matchLabels:
+                                        additionalProperties:
+                                          type: string
+                                        description: matchLabels is a map of {key,value}
+                                          pairs. A single {key,value} in the matchLabels
+                                          map is equivalent to an element of matchExpressions,
+                                          whose key field is "key", the operator is
+                                          "In", and the values array contains only
+                                          "value". The requirements are ANDed.
+                                        type: object
+                                    type: object
+                                  namespaceSelector:
+                                    description: A label query over the set of namespaces
+                                      that the term applies to. The term is applied
+                                      to the union of the namespaces selected by this
+                                      field and the ones listed in the namespaces
+                                      field. null selector and null or empty namespaces
+                                      list means "this pod's namespace". An empty
+                                      selector ({}) matches all namespaces.
+                                    properties:
+                                      matchExpressions:
+                                        description: matchExpressions is a list of
+                                          label selector requirements. The requirements
+                                          are ANDed.
+                                        items:
+                                          description: A label selector requirement
+                                            is a selector that contains values, a
+                                            key, and an operator that relates the
+                                            key and values.
+                                          properties:
+                                            key:
+                                              description: key is the label key that
+                                                the selector applies to.
+                                              type: string
+                                            operator:
+                                              description: operator represents a key's
+                                                relationship to a set of values. Valid
+                                                operators are In, NotIn, Exists and
+                                                DoesNotExist.
+                                              type: string
+                                            values:
+                                              description: values is an array of string
+                                                values. If the operator is In or NotIn,
+                                                the values array must be non-empty.
+                                                If the operator is Exists or DoesNotExist,
+                                                the values array must be empty. This
+                                                array is replaced during a strategic
+                                                merge patch.
+                                              items:
+                                                type: string
+                                              type: array
+                                          required:
+                                          - key
+                                          - operator
+                                          type: object
+                                        type: array
+                                      matchLabels:
+                                        additionalProperties:
+                                          type: string
+                                        description: matchLabels is a map of {key,value}
+                                          pairs. A single {key,value} in the matchLabels
+                                          map is equivalent to an element of matchExpressions,
+                                          whose key field is "key", the operator is
+                                          "In", and the values array contains only
+                                          "value". The requirements are ANDed.
+                                        type: object
+                                    type: object
+                                  namespaces:
+                                    description: namespaces specifies a static list
+                                      of namespace names that the term applies to.
+                                      The term is applied to the union of the namespaces
+                                      listed in this field and the ones selected by
+                                      namespaceSelector. null or empty namespaces
+                                      list and null namespaceSelector means "this
+                                      pod's namespace".
+                                    items:
+                                      type: string
+                                    type: array
+                                  topologyKey:
+                                    description: This pod should be co-located (affinity)
+                                      or not co-located (anti-affinity) with the pods
+                                      matching the labelSelector in the specified
+                                      namespaces, where co-located is defined as running
+                                      on a node whose value of the label with key
+                                      topologyKey matches that of any node on which
+                                      any of the selected pods is running. Empty topologyKey
+                                      is not allowed.
+                                    type: string
+                                required:
+                                - topologyKey
+                                type: object
+                              type: array
+                          type: object
+                      type: object
+                    architecture:
+                      description: Specifies the architecture of the vm guest you
+                        are attempting to run. Defaults to the compiled architecture
+                        of the KubeVirt components
+                      type: string
+                    dnsConfig:
+                      description: Specifies the DNS parameters of a pod. Parameters
+                        specified here will be merged to the generated DNS configuration
+                        based on DNSPolicy.
+                      properties:
+                        nameservers:
+                          description: A list of DNS name server IP addresses. This
+                            will be appended to the base nameservers generated from
+                            DNSPolicy. Duplicated nameservers will be removed.
+                          items:
+                            type: string
+                          type: array
+                        options:
+                          description: A list of DNS resolver options. This will be
+                            merged with the base options generated from DNSPolicy.
+                            Duplicated entries will be removed. Resolution options
+                            given in Options will override those that appear in the
+                            base DNSPolicy.
+                          items:
+                            description: PodDNSConfigOption defines DNS resolver options
+                              of a pod.
+                            properties:
+                              name:
+                                description: Required.
+                                type: string
+                              value:
+                                type: string
+                            type: object
+                          type: array
+                        searches:
+                          description: A list of DNS search domains for host-name
+                            lookup. This will be appended to the base search paths
+                            generated from DNSPolicy. Duplicated search paths will
+                            be removed.
+                          items:
+                            type: string
+                          type: array
+                      type: object
+                    dnsPolicy:
+                      description: Set DNS policy for the pod. Defaults to "ClusterFirst".
+                        Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst',
+                        'Default' or 'None'. DNS parameters given in DNSConfig will
+                        be merged with the policy selected with DNSPolicy. To have
+                        DNS options set along with hostNetwork, you have to specify
+                        DNS policy explicitly to 'ClusterFirstWithHostNet'.
+                      type: string
+                    domain:
+                      description: Specification of the desired behavior of the VirtualMachineInstance
+                        on the host.
+                      properties:
+                        chassis:
+                          description: Chassis specifies the chassis info passed to
+                            the domain.
+                          properties:
+                            asset:
+                              type: string
+                            manufacturer:
+                              type: string
+                            serial:
+                              type: string
+                            sku:
+                              type: string
+                            version:
+                              type: string
+                          type: object
+                        clock:
+                          description: Clock sets the clock and timers of the vmi.
+                          properties:
+                            timer:
+                              description: Timer specifies whih timers are attached
+                                to the vmi.
+                              properties:
+                                hpet:
+                                  description: HPET (High Precision Event Timer) -
+                                    multiple timers with periodic interrupts.
+                                  properties:
+                                    present:
+                                      description: Enabled set to false makes sure
+                                        that the machine type or a preset can't add
+                                        the timer. Defaults to true.
+                                      type: boolean
+                                    tickPolicy:
+                                      description: TickPolicy determines what happens
+                                        when QEMU misses a deadline for injecting
+                                        a tick to the guest. One of "delay", "catchup",
+                                        "merge", "discard".
+                                      type: string
+                                  type: object
+                                hyperv:
+                                  description: Hyperv (Hypervclock) - lets guests
+                                    read the host’s wall clock time (paravirtualized).
+                                    For windows guests.
+                                  properties:
+                                    present:
+                                      description: Enabled set to false makes sure
+                                        that the machine type or a preset can't add
+                                        the timer. Defaults to true.
+                                      type: boolean
+                                  type: object
+                                kvm:
+                                  description: "KVM \t(KVM clock) - lets guests read
+                                    the host’s wall clock time (paravirtualized).
+                                    For linux guests."
+                                  properties:
+                                    present:
+                                      description: Enabled set to false makes sure
+                                        that the machine type or a preset can't add
+                                        the timer. Defaults to true.
+                                      type: boolean
+                                  type: object
+                                pit:
+                                  description: PIT (Programmable Interval Timer) -
+                                    a timer with periodic interrupts.
+                                  properties:
+                                    present:
+                                      description: Enabled set to false makes sure
+                                        that the machine type or a preset can't add
+                                        the timer. Defaults to true.
+                                      type: boolean
+                                    tickPolicy:
+                                      description: TickPolicy determines what happens
+                                        when QEMU misses a deadline for injecting
+                                        a tick to the guest. One of "delay", "catchup",
+                                        "discard".
+                                      type: string
+                                  type: object
+                                rtc:
+                                  description: RTC (Real Time Clock) - a continuously
+                                    running timer with periodic interrupts.
+                                  properties:
+                                    present:
+                                      description: Enabled set to false makes sure
+                                        that the machine type or a preset can't add
+                                        the timer. Defaults to true.
+                                      type: boolean
+                                    tickPolicy:
+                                      description: TickPolicy determines what happens
+                                        when QEMU misses a deadline for injecting
+                                        a tick to the guest. One of "delay", "catchup".
+                                      type: string
+                                    track:
+                                      description: Track the guest or the wall clock.
+                                      type: string
+                                  type: object
+                              type: object
+                            timezone:
+                              description: Timezone sets the guest clock to the specified
+                                timezone. Zone name follows the TZ environment variable
+                                format (e.g. 'America/New_York').
+                              type: string
+                            utc:
+                              description: UTC sets the guest clock to UTC on each
+                                boot. If an offset is specified, guest changes to
+                                the clock will be kept during reboots and are not
+                                reset.
+                              properties:
+                                offsetSeconds:
+                                  description: OffsetSeconds specifies an offset in
+                                    seconds, relative to UTC. If set, guest changes
+                                    to the clock will be kept during reboots and not
+                                    reset.
+                                  type: integer
+                              type: object
+                          type: object
+                        cpu:
+                          description: CPU allow specified the detailed CPU topology
+                            inside the vmi.
+                          properties:
+                            cores:
+                              description: Cores specifies the number of cores inside
+                                the vmi. Must be a value greater or equal 1.
+                              format: int32
+                              type: integer
+                            dedicatedCpuPlacement:
+                              description: DedicatedCPUPlacement requests the scheduler
+                                to place the VirtualMachineInstance on a node with
+                                enough dedicated pCPUs and pin the vCPUs to it.
+                              type: boolean
+                            features:
+                              description: Features specifies the CPU features list
+                                inside the VMI.
+                              items:
+                                description: CPUFeature allows specifying a CPU feature.
+                                properties:
+                                  name:
+                                    description: Name of the CPU feature
+                                    type: string
+                                  policy:
+                                    description: 'Policy is the CPU feature attribute
+                                      which can have the following attributes: force    -
+                                      The virtual CPU will claim the feature is supported
+                                      regardless of it being supported by host CPU.
+                                      require  - Guest creation will fail unless the
+                                      feature is supported by the host CPU or the
+                                      hypervisor is able to emulate it. optional -
+                                      The feature will be supported by virtual CPU
+                                      if and only if it is supported by host CPU.
+                                      disable  - The feature will not be supported
+                                      by virtual CPU. forbid   - Guest creation will
+                                      fail if the feature is supported by host CPU.
+                                      Defaults to require'
+                                    type: string
+                                required:
+                                - name
+                                type: object
+                              type: array
+                            isolateEmulatorThread:
+                              description: IsolateEmulatorThread requests one more
+                                dedicated pCPU to be allocated for the VMI to place
+                                the emulator thread on it.
+                              type: boolean
+                            maxSockets:
+                              description: MaxSockets specifies the maximum amount
+                                of sockets that can be hotplugged
+                              format: int32
+                              type: integer
+                            model:
+                              description: Model specifies the CPU model inside the
+                                VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
+                                It is possible to specify special cases like "host-passthrough"
+                                to get the same CPU as the node and "host-model" to
+                                get CPU closest to the node one. Defaults to host-model.
+                              type: string
+                            numa:
+                              description: NUMA allows specifying settings for the
+                                guest NUMA topology
+                              properties:
+                                guestMappingPassthrough:
+                                  description: GuestMappingPassthrough will create
+                                    an efficient guest topology based on host CPUs
+                                    exclusively assigned to a pod. The created topology
+                                    ensures that memory and CPUs on the virtual numa
+                                    nodes never cross boundaries of host numa nodes.
+                                  type: object
+                              type: object
+                            realtime:
+                              description: Realtime instructs the virt-launcher to
+                                tune the VMI for lower latency, optional for real
+                                time workloads
+                              properties:
+                                mask:
+                                  description: 'Mask defines the vcpu mask expression
+                                    that defines which vcpus are used for realtime.
+                                    Format matches libvirt''s expressions. Example:
+                                    "0-3,^1","0,2,3","2-3"'
+                                  type: string
+                              type: object
+                            sockets:
+                              description: Sockets specifies the number of sockets
+                                inside the vmi. Must be a value greater or equal 1.
+                              format: int32
+                              type: integer
+                            threads:
+                              description: Threads specifies the number of threads
+                                inside the vmi. Must be a value greater or equal 1.
+                              format: int32
+                              type: integer
+                          type: object
+                        devices:
+                          description: Devices allows adding disks, network interfaces,
+                            and others
+                          properties:
+                            autoattachGraphicsDevice:
+                              description: Whether to attach the default graphics
+                                device or not. VNC will not be available if set to
+                                false. Defaults to true.
+                              type: boolean
+                            autoattachInputDevice:
+                              description: Whether to attach an Input Device. Defaults
+                                to false.
+                              type: boolean
+                            autoattachMemBalloon:
+                              description: Whether to attach the Memory balloon device
+                                with default period. Period can be adjusted in virt-config.
+                                Defaults to true.
+                              type: boolean
+                            autoattachPodInterface:
+                              description: Whether to attach a pod network interface.
+                                Defaults to true.
+                              type: boolean
+                            autoattachSerialConsole:
+                              description: Whether to attach the default virtio-serial
+                                console or not. Serial console access will not be
+                                available if set to false. Defaults to true.
+                              type: boolean
+                            autoattachVSOCK:
+                              description: Whether to attach the VSOCK CID to the
+                                VM or not. VSOCK access will be available if set to
+                                true. Defaults to false.
+                              type: boolean
+                            blockMultiQueue:
+                              description: Whether or not to enable virtio multi-queue
+                                for block devices. Defaults to false.
+                              type: boolean
+                            clientPassthrough:
+                              description: To configure and access client devices
+                                such as redirecting USB
+                              type: object
+                            disableHotplug:
+                              description: DisableHotplug disabled the ability to
+                                hotplug disks.
+                              type: boolean
+                            disks:
+                              description: Disks describes disks, cdroms and luns
+                                which are connected to the vmi.
+                              items:
+                                properties:
+                                  blockSize:
+                                    description: If specified, the virtual disk will
+                                      be presented with the given block sizes.
+                                    properties:
+                                      custom:
+                                        description: CustomBlockSize represents the
+                                          desired logical and physical block size
+                                          for a VM disk.
+                                        properties:
+                                          logical:
+                                            type: integer
+                                          physical:
+                                            type: integer
+                                        required:
+                                        - logical
+                                        - physical
+                                        type: object
+                                      matchVolume:
+                                        description: Represents if a feature is enabled
+                                          or disabled.
+                                        properties:
+                                          enabled:
+                                            description: Enabled determines if the
+                                              feature should be enabled or disabled
+                                              on the guest. Defaults to true.
+                                            type: boolean
+                                        type: object
+                                    type: object
+                                  bootOrder:
+                                    description: BootOrder is an integer value > 0,
+                                      used to determine ordering of boot devices.
+                                      Lower values take precedence. Each disk or interface
+                                      that has a boot order must have a unique value.
+                                      Disks without a boot order are not tried if
+                                      a disk with a boot order exists.
+                                    type: integer
+                                  cache:
+                                    description: 'Cache specifies which kvm disk cache
+                                      mode should be used. Supported values are: CacheNone,
+                                      CacheWriteThrough.'
+                                    type: string
+                                  cdrom:
+                                    description: Attach a volume as a cdrom to the
+                                      vmi.
+                                    properties:
+                                      bus:
+                                        description: 'Bus indicates the type of disk
+                                          device to emulate. supported values: virtio,
+                                          sata, scsi.'
+                                        type: string
+                                      readonly:
+                                        description: ReadOnly. Defaults to true.
+                                        type: boolean
+                                      tray:
+                                        description: Tray indicates if the tray of
+                                          the device is open or closed. Allowed values
+                                          are "open" and "closed". Defaults to closed.
+                                        type: string
+                                    type: object
+                                  dedicatedIOThread:
+                                    description: dedicatedIOThread indicates this
+                                      disk should have an exclusive IO Thread. Enabling
+                                      this implies useIOThreads = true. Defaults to
+                                      false.
+                                    type: boolean
+                                  disk:
+                                    description: Attach a volume as a disk to the
+                                      vmi.
+                                    properties:
+                                      bus:
+                                        description: 'Bus indicates the type of disk
+                                          device to emulate. supported values: virtio,
+                                          sata, scsi, usb.'
+                                        type: string
+                                      pciAddress:
+                                        description: 'If specified, the virtual disk
+                                          will be placed on the guests pci address
+                                          with the specified PCI address. For example:
+                                          0000:81:01.10'
+                                        type: string
+                                      readonly:
+                                        description: ReadOnly. Defaults to false.
+                                        type: boolean
+                                    type: object
+                                  errorPolicy:
+                                    description: If specified, it can change the default
+                                      error policy (stop) for the disk
+                                    type: string
+                                  io:
+                                    description: 'IO specifies which QEMU disk IO
+                                      mode should be used. Supported values are: native,
+                                      default, threads.'
+                                    type: string
+                                  ioTune:
+                                    description: If specified, the IO of the disk
+                                      is throttled to the given limits.
+                                    properties:
+                                      groupName:
+                                        description: GroupName places the disk in
+                                          a throttling group, the disks of a group
+                                          share the same limits
+                                        type: string
+                                      readBytesSec:
+                                        description: ReadBytesSec is the read throughput
+                                          limit in bytes per second
+                                        format: int64
+                                        type: integer
+                                      readBytesSecMax:
+                                        description: ReadBytesSecMax is the read throughput
+                                          allowed during bursts in bytes per second
+                                        format: int64
+                                        type: integer
+                                      readBytesSecMaxLength:
+                                        description: ReadBytesSecMaxLength is the
+                                          duration in seconds of a read throughput
+                                          burst
+                                        format: int64
+                                        type: integer
+                                      readIOPSSec:
+                                        description: ReadIOPSSec is the read I/O operations
+                                          per second limit
+                                        format: int64
+                                        type: integer
+                                      readIOPSSecMax:
+                                        description: ReadIOPSSecMax is the read I/O
+                                          operations per second allowed during bursts
+                                        format: int64
+                                        type: integer
+                                      readIOPSSecMaxLength:
+                                        description: ReadIOPSSecMaxLength is the duration
+                                          in seconds of a read I/O operations burst
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        description: TotalBytesSec is the total throughput
+                                          limit in bytes per second
+                                        format: int64
+                                        type: integer
+                                      totalBytesSecMax:
+                                        description: TotalBytesSecMax is the total
+                                          throughput allowed during bursts in bytes
+                                          per second
+                                        format: int64
+                                        type: integer
+                                      totalBytesSecMaxLength:
+                                        description: TotalBytesSecMaxLength is the
+                                          duration in seconds of a total throughput
+                                          burst
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSec:
+                                        description: TotalIOPSSec is the total I/O
+                                          operations per second limit
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSecMax:
+                                        description: TotalIOPSSecMax is the total
+                                          I/O operations per second allowed during
+                                          bursts
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSecMaxLength:
+                                        description: TotalIOPSSecMaxLength is the
+                                          duration in seconds of a total I/O operations
+                                          burst
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        description: WriteBytesSec is the write throughput
+                                          limit in bytes per second
+                                        format: int64
+                                        type: integer
+                                      writeBytesSecMax:
+                                        description: WriteBytesSecMax is the write
+                                          throughput allowed during bursts in bytes
+                                          per second
+                                        format: int64
+                                        type: integer
+                                      writeBytesSecMaxLength:
+                                        description: WriteBytesSecMaxLength is the
+                                          duration in seconds of a write throughput
+                                          burst
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSec:
+                                        description: WriteIOPSSec is the write I/O
+                                          operations per second limit
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSecMax:
+                                        description: WriteIOPSSecMax is the write
+                                          I/O operations per second allowed during
+                                          bursts
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSecMaxLength:
+                                        description: WriteIOPSSecMaxLength is the
+                                          duration in seconds of a write I/O operations
+                                          burst
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  lun:
+                                    description: Attach a volume as a LUN to the vmi.
+                                    properties:
+                                      bus:
+                                        description: 'Bus indicates the type of disk
+                                          device to emulate. supported values: virtio,
+                                          sata, scsi.'
+                                        type: string
+                                      readonly:
+                                        description: ReadOnly. Defaults to false.
+                                        type: boolean
+                                      reservation:
+                                        description: Reservation indicates if the
+                                          disk needs to support the persistent reservation
+                                          for the SCSI disk
+                                        type: boolean
+                                    type: object
+                                  name:
+                                    description: Name is the device name
+                                    type: string
+                                  serial:
+                                    description: Serial provides the ability to specify
+                                      a serial number for the disk device.
+                                    type: string
+                                  shareable:
+                                    description: If specified the disk is made sharable
+                                      and multiple write from different VMs are permitted
+                                    type: boolean
+                                  tag:
+                                    description: If specified, disk address and its
+                                      tag will be provided to the guest via config
+                                      drive metadata
+                                    type: string
+                                required:
+                                - name
+                                type: object
+                              type: array
+                            downwardMetrics:
+                              description: DownwardMetrics creates a virtio serials
+                                for exposing the downward metrics to the vmi.
+                              type: object
+                            filesystems:
+                              description: Filesystems describes filesystem which
+                                is connected to the vmi.
+                              items:
+                                properties:
+                                  name:
+                                    description: Name is the device name
+                                    type: string
+                                  virtiofs:
+                                    description: Virtiofs is supported
+                                    type: object
+                                required:
+                                - name
+                                - virtiofs
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            gpus:
+                              description: Whether to attach a GPU device to the vmi.
+                              items:
+                                properties:
+                                  deviceName:
+                                    type: string
+                                  name:
+                                    description: Name of the GPU device as exposed
+                                      by a device plugin
+                                    type: string
+                                  tag:
+                                    description: If specified, the virtual network
+                                      interface address and its tag will be provided
+                                      to the guest via config drive
+                                    type: string
+                                  virtualGPUOptions:
+                                    properties:
+                                      display:
+                                        properties:
+                                          enabled:
+                                            description: Enabled determines if a display
+                                              addapter backed by a vGPU should be
+                                              enabled or disabled on the guest. Defaults
+                                              to true.
+                                            type: boolean
+                                          ramFB:
+                                            description: Enables a boot framebuffer,
+                                              until the guest OS loads a real GPU
+                                              driver Defaults to true.
+                                            properties:
+                                              enabled:
+                                                description: Enabled determines if
+                                                  the feature should be enabled or
+                                                  disabled on the guest. Defaults
+                                                  to true.
+                                                type: boolean
+                                            type: object
+                                        type: object
+                                    type: object
+                                required:
+                                - deviceName
+                                - name
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            hostDevices:
+                              description: Whether to attach a host device to the
+                                vmi.
+                              items:
+                                properties:
+                                  deviceName:
+                                    description: DeviceName is the resource name of
+                                      the host device exposed by a device plugin
+                                    type: string
+                                  name:
+                                    type: string
+                                  tag:
+                                    description: If specified, the virtual network
+                                      interface address and its tag will be provided
+                                      to the guest via config drive
+                                    type: string
+                                required:
+                                - deviceName
+                                - name
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            inputs:
+                              description: Inputs describe input devices
+                              items:
+                                properties:
+                                  bus:
+                                    description: 'Bus indicates the bus of input device
+                                      to emulate. Supported values: virtio, usb.'
+                                    type: string
+                                  name:
+                                    description: Name is the device name
+                                    type: string
+                                  type:
+                                    description: 'Type indicated the type of input
+                                      device. Supported values: tablet.'
+                                    type: string
+                                required:
+                                - name
+                                - type
+                                type: object
+                              type: array
+                            interfaces:
+                              description: Interfaces describe network interfaces
+                                which are added to the vmi.
+                              items:
+                                properties:
+                                  acpiIndex:
+                                    description: If specified, the ACPI index is used
+                                      to provide network interface device naming,
+                                      that is stable across changes in PCI addresses
+                                      assigned to the device. This value is required
+                                      to be unique across all devices and be between
+                                      1 and (16*1024-1).
+                                    type: integer
+                                  bandwidth:
+                                    description: If specified, the traffic of the
+                                      interface is shaped to the given limits. Not
+                                      supported for SR-IOV interfaces.
+                                    properties:
+                                      inbound:
+                                        description: Inbound limits the traffic received
+                                          by the virtual machine
+                                        properties:
+                                          average:
+                                            description: Average is the average rate
+                                              in kibibytes per second
+                                            format: int64
+                                            type: integer
+                                          burst:
+                                            description: Burst is the amount of kibibytes
+                                              which can be sent in a single burst
+                                              at the peak rate
+                                            format: int64
+                                            type: integer
+                                          peak:
+                                            description: Peak is the maximum rate
+                                              in kibibytes per second at which bursts
+                                              are sent
+                                            format: int64
+                                            type: integer
+                                        required:
+                                        - average
+                                        type: object
+                                      outbound:
+                                        description: Outbound limits the traffic sent
+                                          by the virtual machine
+                                        properties:
+                                          average:
+                                            description: Average is the average rate
+                                              in kibibytes per second
+                                            format: int64
+                                            type: integer
+                                          burst:
+                                            description: Burst is the amount of kibibytes
+                                              which can be sent in a single burst
+                                              at the peak rate
+                                            format: int64
+                                            type: integer
+                                          peak:
+                                            description: Peak is the maximum rate
+                                              in kibibytes per second at which bursts
+                                              are sent
+                                            format: int64
+                                            type: integer
+                                        required:
+                                        - average
+                                        type: object
+                                    type: object
+                                  binding:
+                                    description: 'Binding specifies the binding plugin
+                                      that will be used to connect the interface to
+                                      the guest. It provides an alternative to InterfaceBindingMethod.
+                                      version: 1alphav1'
+                                    properties:
+                                      name:
+                                        description: 'Name references to the binding
+                                          name as denined in the kubevirt CR. version:
+                                          1alphav1'
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  bootOrder:
+                                    description: BootOrder is an integer value > 0,
+                                      used to determine ordering of boot devices.
+                                      Lower values take precedence. Each interface
+                                      or disk that has a boot order must have a unique
+                                      value. Interfaces without a boot order are not
+                                      tried.
+                                    type: integer
+                                  bridge:
+                                    description: InterfaceBridge connects to a given
+                                      network via a linux bridge.
+                                    type: object
+                                  dhcpOptions:
+                                    description: If specified the network interface
+                                      will pass additional DHCP options to the VMI
+                                    properties:
+                                      bootFileName:
+                                        description: If specified will pass option
+                                          67 to interface's DHCP server
+                                        type: string
+                                      ntpServers:
+                                        description: If specified will pass the configured
+                                          NTP server to the VM via DHCP option 042.
+                                        items:
+                                          type: string
+                                        type: array
+                                      privateOptions:
+                                        description: 'If specified will pass extra
+                                          DHCP options for private use, range: 224-254'
+                                        items:
+                                          description: DHCPExtraOptions defines Extra
+                                            DHCP options for a VM.
+                                          properties:
+                                            option:
+                                              description: Option is an Integer value
+                                                from 224-254 Required.
+                                              type: integer
+                                            value:
+                                              description: Value is a String value
+                                                for the Option provided Required.
+                                              type: string
+                                          required:
+                                          - option
+                                          - value
+                                          type: object
+                                        type: array
+                                      tftpServerName:
+                                        description: If specified will pass option
+                                          66 to interface's DHCP server
+                                        type: string
+                                    type: object
+                                  macAddress:
+                                    description: 'Interface MAC address. For example:
+                                      de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
+                                    type: string
+                                  macvtap:
+                                    description: Deprecated, please refer to Kubevirt
+                                      user guide for alternatives.
+                                    type: object
+                                  masquerade:
+                                    description: InterfaceMasquerade connects to a
+                                      given network using netfilter rules to nat the
+                                      traffic.
+                                    type: object
+                                  model:
+                                    description: 'Interface model. One of: e1000,
+                                      e1000e, ne2k_pci, pcnet, rtl8139, virtio. Defaults
+                                      to virtio. TODO:(ihar) switch to enums once
+                                      opengen-api supports them. See: https://github.com/kubernetes/kube-openapi/issues/51'
+                                    type: string
+                                  name:
+                                    description: Logical name of the interface as
+                                      well as a reference to the associated networks.
+                                      Must match the Name of a Network.
+                                    type: string
+                                  passt:
+                                    description: Deprecated, please refer to Kubevirt
+                                      user guide for alternatives.
+                                    type: object
+                                  pciAddress:
+                                    description: 'If specified, the virtual network
+                                      interface will be placed on the guests pci address
+                                      with the specified PCI address. For example:
+                                      0000:81:01.10'
+                                    type: string
+                                  ports:
+                                    description: List of ports to be forwarded to
+                                      the virtual machine.
+                                    items:
+                                      description: Port represents a port to expose
+                                        from the virtual machine. Default protocol
+                                        TCP. The port field is mandatory
+                                      properties:
+                                        name:
+                                          description: If specified, this must be
+                                            an IANA_SVC_NAME and unique within the
+                                            pod. Each named port in a pod must have
+                                            a unique name. Name for the port that
+                                            can be referred to by services.
+                                          type: string
+                                        port:
+                                          description: Number of port to expose for
+                                            the virtual machine. This must be a valid
+                                            port number, 0 < x < 65536.
+                                          format: int32
+                                          type: integer
+                                        protocol:
+                                          description: Protocol for port. Must be
+                                            UDP or TCP. Defaults to "TCP".
+                                          type: string
+                                      required:
+                                      - port
+                                      type: object
+                                    type: array
+                                  slirp:
+                                    description: InterfaceSlirp connects to a given
+                                      network using QEMU user networking mode.
+                                    type: object
+                                  sriov:
+                                    description: InterfaceSRIOV connects to a given
+                                      network by passing-through an SR-IOV PCI device
+                                      via vfio.
+                                    type: object
+                                  state:
+                                    description: State represents the requested operational
+                                      state of the interface. The values supported
+                                      are 'absent', expressing a request to remove
+                                      the interface, and 'up' or 'down', setting the
+                                      link state of the interface seen by the guest.
+                                      The link state is 'up' if not specified.
+                                    type: string
+                                  tag:
+                                    description: If specified, the virtual network
+                                      interface address and its tag will be provided
+                                      to the guest via config drive
+                                    type: string
+                                required:
+                                - name
+                                type: object
+                              type: array
+                            logSerialConsole:
+                              description: Whether to log the auto-attached default
+                                serial console or not. Serial console logs will be
+                                collect to a file and then streamed from a named 'guest-console-log'.
+                                Not relevant if autoattachSerialConsole is disabled.
+                                Defaults to cluster wide setting on VirtualMachineOptions.
+                              type: boolean
+                            networkInterfaceMultiqueue:
+                              description: If specified, virtual network interfaces
+                                configured with a virtio bus will also enable the
+                                vhost multiqueue feature for network devices. The
+                                number of queues created depends on additional factors
+                                of the VirtualMachineInstance, like the number of
+                                guest CPUs.
+                              type: boolean
+                            panicDevice:
+                              description: PanicDevice describes a panic device which
+                                the guest uses to report a crash.
+                              properties:
+                                memoryDumpClaimName:
+                                  description: MemoryDumpClaimName is the name of
+                                    a PVC the memory of a crashed guest is dumped
+                                    to. The dump is done through the memory dump subresource
+                                    of the VirtualMachine and requires the preserve
+                                    action.
+                                  type: string
+                                model:
+                                  description: Model of the panic device. Valid values
+                                    are pvpanic, isa, hyperv. Defaults to pvpanic.
+                                  type: string
+                                onCrash:
+                                  description: The action to take when the guest reports
+                                    a crash. Valid values are restart, poweroff, preserve.
+                                    Defaults to poweroff.
+                                  type: string
+                              type: object
+                            rng:
+                              description: Whether to have random number generator
+                                from host
+                              type: object
+                            sound:
+                              description: Whether to emulate a sound device.
+                              properties:
+                                model:
+                                  description: 'We only support ich9 or ac97. If SoundDevice
+                                    is not set: No sound card is emulated. If SoundDevice
+                                    is set but Model is not: ich9'
+                                  type: string
+                                name:
+                                  description: User's defined name for this sound
+                                    device
+                                  type: string
+                              required:
+                              - name
+                              type: object
+                            tpm:
+                              description: Whether to emulate a TPM device.
+                              properties:
+                                persistent:
+                                  description: Persistent indicates the state of the
+                                    TPM device should be kept accross reboots Defaults
+                                    to false
+                                  type: boolean
+                              type: object
+                            useVirtioTransitional:
+                              description: Fall back to legacy virtio 0.9 support
+                                if virtio bus is selected on devices. This is helpful
+                                for old machines like CentOS6 or RHEL6 which do not
+                                understand virtio_non_transitional (virtio 1.0).
+                              type: boolean
+                            watchdog:
+                              description: Watchdog describes a watchdog device which
+                                can be added to the vmi.
+                              properties:
+                                i6300esb:
+                                  description: i6300esb watchdog device.
+                                  properties:
+                                    action:
+                                      description: The action to take. Valid values
+                                        are poweroff, reset, shutdown. Defaults to
+                                        reset.
+                                      type: string
+                                  type: object
+                                name:
+                                  description: Name of the watchdog.
+                                  type: string
+                              required:
+                              - name
+                              type: object
+                          type: object
+                        features:
+                          description: Features like acpi, apic, hyperv, smm.
+                          properties:
+                            acpi:
+                              description: ACPI enables/disables ACPI inside the guest.
+                                Defaults to enabled.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should
+                                    be enabled or disabled on the guest. Defaults
+                                    to true.
+                                  type: boolean
+                              type: object
+                            apic:
+                              description: Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should
+                                    be enabled or disabled on the guest. Defaults
+                                    to true.
+                                  type: boolean
+                                endOfInterrupt:
+                                  description: EndOfInterrupt enables the end of interrupt
+                                    notification in the guest. Defaults to false.
+                                  type: boolean
+                              type: object
+                            hyperv:
+                              description: Defaults to the machine type setting.
+                              properties:
+                                evmcs:
+                                  description: EVMCS Speeds up L2 vmexits, but disables
+                                    other virtualization features. Requires vapic.
+                                    Defaults to the machine type setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature
+                                        should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
+                                frequencies:
+                                  description: Frequencies improves the TSC clock
+                                    source handling for Hyper-V on KVM. Defaults to
+                                    the machine type setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature
+                                        should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
+                                ipi:
+                                  description: IPI improves performances in overcommited
+                                    environments. Requires vpindex. Defaults to the
+                                    machine type setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature
+                                        should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
+                                reenlightenment:
+                                  description: Reenlightenment enables the notifications
+                                    on TSC frequency changes. Defaults to the machine
+                                    type setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature
+                                        should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
+                                relaxed:
+                                  description: Relaxed instructs the guest OS to disable
+                                    watchdog timeouts. Defaults to the machine type
+                                    setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature
+                                        should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
+                                reset:
+                                  description: Reset enables Hyperv reboot/reset for
+                                    the vmi. Requires synic. Defaults to the machine
+                                    type setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature
+                                        should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
+                                runtime:
+                                  description: Runtime improves the time accounting
+                                    to improve scheduling in the guest. Defaults to
+                                    the machine type setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature
+                                        should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
+                                spinlocks:
+                                  description: Spinlocks allows to configure the spinlock
+                                    retry attempts.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature
+                                        should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                    spinlocks:
+                                      description: Retries indicates the number of
+                                        retries. Must be a value greater or equal
+                                        4096. Defaults to 4096.
+                                      format: int32
+                                      type: integer
+                                  type: object
+                                synic:
+                                  description: SyNIC enables the Synthetic Interrupt
+                                    Controller. Defaults to the machine type setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature
+                                        should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
+                                synictimer:
+                                  description: SyNICTimer enables Synthetic Interrupt
+                                    Controller Timers, reducing CPU load. Defaults
+                                    to the machine type setting.
+                                  properties:
+                                    direct:
+                                      description: Represents if a feature is enabled
+                                        or disabled.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature
+                                            should be enabled or disabled on the guest.
+                                            Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    enabled:
+                                      type: boolean
+                                  type: object
+                                tlbflush:
+                                  description: TLBFlush improves performances in overcommited
+                                    environments. Requires vpindex. Defaults to the
+                                    machine type setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature
+                                        should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
+                                vapic:
+                                  description: VAPIC improves the paravirtualized
+                                    handling of interrupts. Defaults to the machine
+                                    type setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature
+                                        should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
+                                vendorid:
+                                  description: VendorID allows setting the hypervisor
+                                    vendor id. Defaults to the machine type setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature
+                                        should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                    vendorid:
+                                      description: VendorID sets the hypervisor vendor
+                                        id, visible to the vmi. String up to twelve
+                                        characters.
+                                      type: string
+                                  type: object
+                                vpindex:
+                                  description: VPIndex enables the Virtual Processor
+                                    Index to help windows identifying virtual processors.
+                                    Defaults to the machine type setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature
+                                        should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
+                              type: object
+                            kvm:
+                              description: Configure how KVM presence is exposed to
+                                the guest.
+                              properties:
+                                hidden:
+                                  description: Hide the KVM hypervisor from standard
+                                    MSR based discovery. Defaults to false
+                                  type: boolean
+                              type: object
+                            pvspinlock:
+                              description: Notify the guest that the host supports
+                                paravirtual spinlocks. For older kernels this feature
+                                should be explicitly disabled.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should
+                                    be enabled or disabled on the guest. Defaults
+                                    to true.
+                                  type: boolean
+                              type: object
+                            smm:
+                              description: SMM enables/disables System Management
+                                Mode. TSEG not yet implemented.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should
+                                    be enabled or disabled on the guest. Defaults
+                                    to true.
+                                  type: boolean
+                              type: object
+                          type: object
+                        firmware:
+                          description: Firmware.
+                          properties:
+                            acpi:
+                              description: Information that can be set in the ACPI
+                                table
+                              properties:
+                                slicNameRef:
+                                  description: 'SlicNameRef should match the volume
+                                    name of a secret object. The data in the secret
+                                    should be a binary blob that follows the ACPI
+                                    SLIC standard, see: https://learn.microsoft.com/en-us/previous-versions/windows/hardware/design/dn653305(v=vs.85)'
+                                  type: string
+                              type: object
+                            bootloader:
+                              description: Settings to control the bootloader that
+                                is used.
+                              properties:
+                                bios:
+                                  description: If set (default), BIOS will be used.
+                                  properties:
+                                    useSerial:
+                                      description: If set, the BIOS output will be
+                                        transmitted over serial
+                                      type: boolean
+                                  type: object
+                                efi:
+                                  description: If set, EFI will be used instead of
+                                    BIOS.
+                                  properties:
+                                    persistent:
+                                      description: If set to true, Persistent will
+                                        persist the EFI NVRAM across reboots. Defaults
+                                        to false
+                                      type: boolean
+                                    secureBoot:
+                                      description: If set, SecureBoot will be enabled
+                                        and the OVMF roms will be swapped for SecureBoot-enabled
+                                        ones. Requires SMM to be enabled. Defaults
+                                        to true
+                                      type: boolean
+                                  type: object
+                              type: object
+                            generationID:
+                              description: GenerationID exposes a VM Generation ID
+                                device to the guest. It allows the guest to detect
+                                a rollback, e.g. after a restore from a snapshot.
+                              properties:
+                                uuid:
+                                  description: UUID reported to the guest as the VM
+                                    Generation ID. Defaults to an ID derived from
+                                    the VirtualMachine, which is stable across reboots
+                                    and migrations. It is regenerated when the VirtualMachine
+                                    is restored from a snapshot or cloned.
+                                  type: string
+                              type: object
+                            kernelBoot:
+                              description: Settings to set the kernel for booting.
+                              properties:
+                                container:
+                                  description: Container defines the container that
+                                    containes kernel artifacts
+                                  properties:
+                                    image:
+                                      description: Image that contains initrd / kernel
+                                        files.
+                                      type: string
+                                    imagePullPolicy:
+                                      description: 'Image pull policy. One of Always,
+                                        Never, IfNotPresent. Defaults to Always if
+                                        :latest tag is specified, or IfNotPresent
+                                        otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images'
+                                      type: string
+                                    imagePullSecret:
+                                      description: ImagePullSecret is the name of
+                                        the Docker registry secret required to pull
+                                        the image. The secret must already exist.
+                                      type: string
+                                    initrdPath:
+                                      description: the fully-qualified path to the
+                                        ramdisk image in the host OS
+                                      type: string
+                                    kernelPath:
+                                      description: The fully-qualified path to the
+                                        kernel image in the host OS
+                                      type: string
+                                  required:
+                                  - image
+                                  type: object
+                                kernelArgs:
+                                  description: Arguments to be passed to the kernel
+                                    at boot time
+                                  type: string
+                              type: object
+                            serial:
+                              description: The system-serial-number in SMBIOS
+                              type: string
+                            uuid:
+                              description: UUID reported by the vmi bios. Defaults
+                                to a random generated uid.
+                              type: string
+                          type: object
+                        ioThreadsPolicy:
+                          description: 'Controls whether or not disks will share IOThreads.
+                            Omitting IOThreadsPolicy disables use of IOThreads. One
+                            of: shared, auto'
+                          type: string
+                        launchSecurity:
+                          description: Launch Security setting of the vmi.
+                          properties:
+                            sev:
+                              description: AMD Secure Encrypted Virtualization (SEV).
+                              properties:
+                                attestation:
+                                  description: If specified, run the attestation process
+                                    for a vmi.
+                                  type: object
+                                dhCert:
+                                  description: Base64 encoded guest owner's Diffie-Hellman
+                                    key.
+                                  type: string
+                                policy:
+                                  description: 'Guest policy flags as defined in AMD
+                                    SEV API specification. Note: due to security reasons
+                                    it is not allowed to enable guest debugging. Therefore
+                                    NoDebug flag is not exposed to users and is always
+                                    true.'
+                                  properties:
+                                    encryptedState:
+                                      description: SEV-ES is required. Defaults to
+                                        false.
+                                      type: boolean
+                                  type: object
+                                session:
+                                  description: Base64 encoded session blob.
+                                  type: string
+                              type: object
+                          type: object
+                        machine:
+                          description: Machine type.
+                          properties:
+                            type:
+                              description: QEMU machine type is the actual chipset
+                                of the VirtualMachineInstance.
+                              type: string
+                          type: object
+                        memory:
+                          description: Memory allow specifying the VMI memory features.
+                          properties:
+                            guest:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: Guest allows to specifying the amount of
+                                memory which is visible inside the Guest OS. The Guest
+                                must lie between Requests and Limits from the resources
+                                section. Defaults to the requested memory in the resources
+                                section if not specified.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            hugepages:
+                              description: Hugepages allow to use hugepages for the
+                                VirtualMachineInstance instead of regular memory.
+                              properties:
+                                pageSize:
+                                  description: PageSize specifies the hugepage size,
+                                    for x86_64 architecture valid values are 1Gi and
+                                    2Mi.
+                                  type: string
+                              type: object
+                            maxGuest:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: MaxGuest allows to specify the maximum
+                                amount of memory which is visible inside the Guest
+                                OS. The delta between MaxGuest and Guest is the amount
+                                of memory that can be hot(un)plugged.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                          type: object
+                        resources:
+                          description: Resources describes the Compute Resources required
+                            by this vmi.
+                          properties:
+                            limits:
+                              additionalProperties:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              description: Limits describes the maximum amount of
+                                compute resources allowed. Valid resource keys are
+                                "memory" and "cpu".
+                              type: object
+                            overcommitGuestOverhead:
+                              description: Don't ask the scheduler to take the guest-management
+                                overhead into account. Instead put the overhead only
+                                into the container's memory limit. This can lead to
+                                crashes if all memory is in use on a node. Defaults
+                                to false.
+                              type: boolean
+                            requests:
+                              additionalProperties:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              description: Requests is a description of the initial
+                                vmi resources. Valid resource keys are "memory" and
+                                "cpu".
+                              type: object
+                          type: object
+                      required:
+                      - devices
+                      type: object
+                    evictionStrategy:
+                      description: 'EvictionStrategy describes the strategy to follow
+                        when a node drain occurs. The possible options are: - "None":
+                        No action will be taken, according to the specified ''RunStrategy''
+                        the VirtualMachine will be restarted or shutdown. - "LiveMigrate":
+                        the VirtualMachineInstance will be migrated instead of being
+                        shutdown. - "LiveMigrateIfPossible": the same as "LiveMigrate"
+                        but only if the VirtualMachine is Live-Migratable, otherwise
+                        it will behave as "None". - "External": the VirtualMachineInstance
+                        will be protected by a PDB and ''vmi.Status.EvacuationNodeName''
+                        will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt
+                        (capk) which needs a way for VMI''s to be blocked from eviction,
+                        yet signal capk that eviction has been called on the VMI so
+                        the capk controller can handle tearing the VMI down. Details
+                        can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.'
+                      type: string
+                    firewall:
+                      description: Firewall defines the rules filtering the traffic
+                        of the pod network interface. Only supported with the masquerade
+                        and passt bindings. The rules can be updated while the vmi
+                        is running.
+                      properties:
+                        egress:
+                          description: Egress filters the traffic sent by the virtual
+                            machine.
+                          properties:
+                            defaultAction:
+                              description: DefaultAction is applied to the traffic
+                                not matching any rule. Defaults to Drop.
+                              type: string
+                            rules:
+                              description: Rules are evaluated in order, the action
+                                of the first matching rule is applied.
+                              items:
+                                description: FirewallRule matches traffic by the remote
+                                  address, the protocol and the destination port.
+                                properties:
+                                  action:
+                                    description: Action applied to the matching traffic,
+                                      one of Accept or Drop.
+                                    type: string
+                                  cidr:
+                                    description: CIDR of the remote addresses, the
+                                      source for ingress and the destination for egress
+                                      traffic. All addresses are matched if not specified.
+                                    type: string
+                                  port:
+                                    description: Port is the destination port to match,
+                                      requires the TCP or UDP protocol. All ports
+                                      are matched if not specified.
+                                    format: int32
+                                    type: integer
+                                  protocol:
+                                    description: Protocol to match, one of TCP, UDP
+                                      or ICMP. All protocols are matched if not specified.
+                                    type: string
+                                required:
+                                - action
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
+                          type: object
+                        ingress:
+                          description: Ingress filters the traffic received by the
+                            virtual machine.
+                          properties:
+                            defaultAction:
+                              description: DefaultAction is applied to the traffic
+                                not matching any rule. Defaults to Drop.
+                              type: string
+                            rules:
+                              description: Rules are evaluated in order, the action
+                                of the first matching rule is applied.
+                              items:
+                                description: FirewallRule matches traffic by the remote
+                                  address, the protocol and the destination port.
+                                properties:
+                                  action:
+                                    description: Action applied to the matching traffic,
+                                      one of Accept or Drop.
+                                    type: string
+                                  cidr:
+                                    description: CIDR of the remote addresses, the
+                                      source for ingress and the destination for egress
+                                      traffic. All addresses are matched if not specified.
+                                    type: string
+                                  port:
+                                    description: Port is the destination port to match,
+                                      requires the TCP or UDP protocol. All ports
+                                      are matched if not specified.
+                                    format: int32
+                                    type: integer
+                                  protocol:
+                                    description: Protocol to match, one of TCP, UDP
+                                      or ICMP. All protocols are matched if not specified.
+                                    type: string
+                                required:
+                                - action
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
+                          type: object
+                      type: object
+                    hostname:
+                      description: Specifies the hostname of the vmi If not specified,
+                        the hostname will be set to the name of the vmi, if dhcp or
+                        cloud-init is configured properly.
+                      type: string
+                    livenessProbe:
+                      description: 'Periodic probe of VirtualMachineInstance liveness.
+                        VirtualmachineInstances will be stopped if the probe fails.
+                        Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                      properties:
+                        exec:
+                          description: One and only one of the following should be
+                            specified. Exec specifies the action to take, it will
+                            be executed on the guest through the qemu-guest-agent.
+                            If the guest agent is not available, this probe will fail.
+                          properties:
+                            command:
+                              description: Command is the command line to execute
+                                inside the container, the working directory for the
+                                command  is root ('/') in the container's filesystem.
+                                The command is simply exec'd, it is not run inside
+                                a shell, so traditional shell instructions ('|', etc)
+                                won't work. To use a shell, you need to explicitly
+                                call out to that shell. Exit status of 0 is treated
+                                as live/healthy and non-zero is unhealthy.
+                              items:
+                                type: string
+                              type: array
+                          type: object
+                        failureThreshold:
+                          description: Minimum consecutive failures for the probe
+                            to be considered failed after having succeeded. Defaults
+                            to 3. Minimum value is 1.
+                          format: int32
+                          type: integer
+                        guestAgentPing:
+                          description: GuestAgentPing contacts the qemu-guest-agent
+                            for availability checks.
+                          type: object
+                        httpGet:
+                          description: HTTPGet specifies the http request to perform.
+                          properties:
+                            host:
+                              description: Host name to connect to, defaults to the
+                                pod IP. You probably want to set "Host" in httpHeaders
+                                instead.
+                              type: string
+                            httpHeaders:
+                              description: Custom headers to set in the request. HTTP
+                                allows repeated headers.
+                              items:
+                                description: HTTPHeader describes a custom header
+                                  to be used in HTTP probes
+                                properties:
+                                  name:
+                                    description: The header field name
+                                    type: string
+                                  value:
+                                    description: The header field value
+                                    type: string
+                                required:
+                                - name
+                                - value
+                                type: object
+                              type: array
+                            path:
+                              description: Path to access on the HTTP server.
+                              type: string
+                            port:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: Name or number of the port to access on
+                                the container. Number must be in the range 1 to 65535.
+                                Name must be an IANA_SVC_NAME.
+                              x-kubernetes-int-or-string: true
+                            scheme:
+                              description: Scheme to use for connecting to the host.
+                                Defaults to HTTP.
+                              type: string
+                          required:
+                          - port
+                          type: object
+                        initialDelaySeconds:
+                          description: 'Number of seconds after the VirtualMachineInstance
+                            has started before liveness probes are initiated. More
+                            info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                          format: int32
+                          type: integer
+                        periodSeconds:
+                          description: How often (in seconds) to perform the probe.
+                            Default to 10 seconds. Minimum value is 1.
+                          format: int32
+                          type: integer
+                        successThreshold:
+                          description: Minimum consecutive successes for the probe
+                            to be considered successful after having failed. Defaults
+                            to 1. Must be 1 for liveness. Minimum value is 1.
+                          format: int32
+                          type: integer
+                        tcpSocket:
+                          description: 'TCPSocket specifies an action involving a
+                            TCP port. TCP hooks not yet supported TODO: implement
+                            a realistic TCP lifecycle hook'
+                          properties:
+                            host:
+                              description: 'Optional: Host name to connect to, defaults
+                                to the pod IP.'
+                              type: string
+                            port:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: Number or name of the port to access on
+                                the container. Number must be in the range 1 to 65535.
+                                Name must be an IANA_SVC_NAME.
+                              x-kubernetes-int-or-string: true
+                          required:
+                          - port
+                          type: object
+                        timeoutSeconds:
+                          description: 'Number of seconds after which the probe times
+                            out. For exec probes the timeout fails the probe but does
+                            not terminate the command running on the guest. This means
+                            a blocking command can result in an increasing load on
+                            the guest. A small buffer will be added to the resulting
+                            workload exec probe to compensate for delays caused by
+                            the qemu guest exec mechanism. Defaults to 1 second. Minimum
+                            value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                          format: int32
+                          type: integer
+                      type: object
+                    networks:
+                      description: List of networks that can be attached to a vm's
+                        virtual interface.
+                      items:
+                        description: Network represents a network type and a resource
+                          that should be connected to the vm.
+                        properties:
+                          multus:
+                            description: Represents the multus cni network.
+                            properties:
+                              default:
+                                description: Select the default network and add it
+                                  to the multus-cni.io/default-network annotation.
+                                type: boolean
+                              networkName:
+                                description: 'References to a NetworkAttachmentDefinition
+                                  CRD object. Format: <networkName>, <namespace>/<networkName>.
+                                  If namespace is not specified, VMI namespace is
+                                  assumed.'
+                                type: string
+                            required:
+                            - networkName
+                            type: object
+                          name:
+                            description: 'Network name. Must be a DNS_LABEL and unique
+                              within the vm. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
+                            type: string
+                          pod:
+                            description: Represents the stock pod network interface.
+                            properties:
+                              vmIPv6NetworkCIDR:
+                                description: IPv6 CIDR for the vm network. Defaults
+                                  to fd10:0:2::/120 if not specified.
+                                type: string
+                              vmNetworkCIDR:
+                                description: CIDR for vm network. Default 10.0.2.0/24
+                                  if not specified.
+                                type: string
+                            type: object
+                        required:
+                        - name
+                        type: object
+                      type: array
+                    nodeSelector:
+                      additionalProperties:
+                        type: string
+                      description: 'NodeSelector is a selector which must be true
+                        for the vmi to fit on a node. Selector which must match a
+                        node''s labels for the vmi to be scheduled on that node. More
+                        info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/'
+                      type: object
+                    priorityClassName:
+                      description: If specified, indicates the pod's priority. If
+                        not specified, the pod priority will be default or zero if
+                        there is no default.
+                      type: string
+                    readinessProbe:
+                      description: 'Periodic probe of VirtualMachineInstance service
+                        readiness. VirtualmachineInstances will be removed from service
+                        endpoints if the probe fails. Cannot be updated. More info:
+                        https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                      properties:
+                        exec:
+                          description: One and only one of the following should be
+                            specified. Exec specifies the action to take, it will
+                            be executed on the guest through the qemu-guest-agent.
+                            If the guest agent is not available, this probe will fail.
+                          properties:
+                            command:
+                              description: Command is the command line to execute
+                                inside the container, the working directory for the
+                                command  is root ('/') in the container's filesystem.
+                                The command is simply exec'd, it is not run inside
+                                a shell, so traditional shell instructions ('|', etc)
+                                won't work. To use a shell, you need to explicitly
+                                call out to that shell. Exit status of 0 is treated
+                                as live/healthy and non-zero is unhealthy.
+                              items:
+                                type: string
+                              type: array
+                          type: object
+                        failureThreshold:
+                          description: Minimum consecutive failures for the probe
+                            to be considered failed after having succeeded. Defaults
+                            to 3. Minimum value is 1.
+                          format: int32
+                          type: integer
+                        guestAgentPing:
+                          description: GuestAgentPing contacts the qemu-guest-agent
+                            for availability checks.
+                          type: object
+                        httpGet:
+                          description: HTTPGet specifies the http request to perform.
+                          properties:
+                            host:
+                              description: Host name to connect to, defaults to the
+                                pod IP. You probably want to set "Host" in httpHeaders
+                                instead.
+                              type: string
+                            httpHeaders:
+                              description: Custom headers to set in the request. HTTP
+                                allows repeated headers.
+                              items:
+                                description: HTTPHeader describes a custom header
+                                  to be used in HTTP probes
+                                properties:
+                                  name:
+                                    description: The header field name
+                                    type: string
+                                  value:
+                                    description: The header field value
+                                    type: string
+                                required:
+                                - name
+                                - value
+                                type: object
+                              type: array
+                            path:
+                              description: Path to access on the HTTP server.
+                              type: string
+                            port:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: Name or number of the port to access on
+                                the container. Number must be in the range 1 to 65535.
+                                Name must be an IANA_SVC_NAME.
+                              x-kubernetes-int-or-string: true
+                            scheme:
+                              description: Scheme to use for connecting to the host.
+                                Defaults to HTTP.
+                              type: string
+                          required:
+                          - port
+                          type: object
+                        initialDelaySeconds:
+                          description: 'Number of seconds after the VirtualMachineInstance
+                            has started before liveness probes are initiated. More
+                            info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                          format: int32
+                          type: integer
+                        periodSeconds:
+                          description: How often (in seconds) to perform the probe.
+                            Default to 10 seconds. Minimum value is 1.
+                          format: int32
+                          type: integer
+                        successThreshold:
+                          description: Minimum consecutive successes for the probe
+                            to be considered successful after having failed. Defaults
+                            to 1. Must be 1 for liveness. Minimum value is 1.
+                          format: int32
+                          type: integer
+                        tcpSocket:
+                          description: 'TCPSocket specifies an action involving a
+                            TCP port. TCP hooks not yet supported TODO: implement
+                            a realistic TCP lifecycle hook'
+                          properties:
+                            host:
+                              description: 'Optional: Host name to connect to, defaults
+                                to the pod IP.'
+                              type: string
+                            port:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: Number or name of the port to access on
+                                the container. Number must be in the range 1 to 65535.
+                                Name must be an IANA_SVC_NAME.
+                              x-kubernetes-int-or-string: true
+                          required:
+                          - port
+                          type: object
+                        timeoutSeconds:
+                          description: 'Number of seconds after which the probe times
+                            out. For exec probes the timeout fails the probe but does
+                            not terminate the command running on the guest. This means
+                            a blocking command can result in an increasing load on
+                            the guest. A small buffer will be added to the resulting
+                            workload exec probe to compensate for delays caused by
+                            the qemu guest exec mechanism. Defaults to 1 second. Minimum
+                            value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                          format: int32
+                          type: integer
+                      type: object
+                    schedulerName:
+                      description: If specified, the VMI will be dispatched by specified
+                        scheduler. If not specified, the VMI will be dispatched by
+                        default scheduler.
+                      type: string
+                    startStrategy:
+                      description: StartStrategy can be set to "Paused" if Virtual
+                        Machine should be started in paused state.
+                      type: string
+                    subdomain:
+                      description: If specified, the fully qualified vmi hostname
+                        will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster
+                        domain>". If not specified, the vmi will not have a domainname
+                        at all. The DNS entry will resolve to the vmi, no matter if
+                        the vmi itself can pick up a hostname.
+                      type: string
+                    terminationGracePeriodSeconds:
+                      description: Grace period observed after signalling a VirtualMachineInstance
+                        to stop after which the VirtualMachineInstance is force terminated.
+                      format: int64
+                      type: integer
+                    tolerations:
+                      description: If toleration is specified, obey all the toleration
+                        rules.
+                      items:
+                        description: The pod this Toleration is attached to tolerates
+                          any taint that matches the triple <key,value,effect> using
+                          the matching operator <operator>.
+                        properties:
+                          effect:
+                            description: Effect indicates the taint effect to match.
+                              Empty means match all taint effects. When specified,
+                              allowed values are NoSchedule, PreferNoSchedule and
+                              NoExecute.
+                            type: string
+                          key:
+                            description: Key is the taint key that the toleration
+                              applies to. Empty means match all taint keys. If the
+                              key is empty, operator must be Exists; this combination
+                              means to match all values and all keys.
+                            type: string
+                          operator:
+                            description: Operator represents a key's relationship
+                              to the value. Valid operators are Exists and Equal.
+                              Defaults to Equal. Exists is equivalent to wildcard
+                              for value, so that a pod can tolerate all taints of
+                              a particular category.
+                            type: string
+                          tolerationSeconds:
+                            description: TolerationSeconds represents the period of
+                              time the toleration (which must be of effect NoExecute,
+                              otherwise this field is ignored) tolerates the taint.
+                              By default, it is not set, which means tolerate the
+                              taint forever (do not evict). Zero and negative values
+                              will be treated as 0 (evict immediately) by the system.
+                            format: int64
+                            type: integer
+                          value:
+                            description: Value is the taint value the toleration matches
+                              to. If the operator is Exists, the value should be empty,
+                              otherwise just a regular string.
+                            type: string
+                        type: object
+                      type: array
+                    topologySpreadConstraints:
+                      description: TopologySpreadConstraints describes how a group
+                        of VMIs will be spread across a given topology domains. K8s
+                        scheduler will schedule VMI pods in a way which abides by
+                        the constraints.
+                      items:
+                        description: TopologySpreadConstraint specifies how to spread
+                          matching pods among the given topology.
+                        properties:
+                          labelSelector:
+                            description: LabelSelector is used to find matching pods.
+                              Pods that match this label selector are counted to determine
+                              the number of pods in their corresponding topology domain.
+                            properties:
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: A label selector requirement is a selector
+                                    that contains values, a key, and an operator that
+                                    relates the key and values.
+                                  properties:
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
+                                      type: string
+                                    operator:
+                                      description: operator represents a key's relationship
+                                        to a set of values. Valid operators are In,
+                                        NotIn, Exists and DoesNotExist.
+                                      type: string
+                                    values:
+                                      description: values is an array of string values.
+                                        If the operator is In or NotIn, the values
+                                        array must be non-empty. If the operator is
+                                        Exists or DoesNotExist, the values array must
+                                        be empty. This array is replaced during a
+                                        strategic merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                  required:
+                                  - key
+                                  - operator
+                                  type: object
+                                type: array
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
+                                description: matchLabels is a map of {key,value} pairs.
+                                  A single {key,value} in the matchLabels map is equivalent
+                                  to an element of matchExpressions, whose key field
+                                  is "key", the operator is "In", and the values array
+                                  contains only "value". The requirements are ANDed.
+                                type: object
+                            type: object
+                          matchLabelKeys:
+                            description: MatchLabelKeys is a set of pod label keys
+                              to select the pods over which spreading will be calculated.
+                              The keys are used to lookup values from the incoming
+                              pod labels, those key-value labels are ANDed with labelSelector
+                              to select the group of existing pods over which spreading
+                              will be calculated for the incoming pod. Keys that don't
+                              exist in the incoming pod labels will be ignored. A
+                              null or empty list means only match against labelSelector.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          maxSkew:
+                            description: 'MaxSkew describes the degree to which pods
+                              may be unevenly distributed. When ''whenUnsatisfiable=DoNotSchedule'',
+                              it is the maximum permitted difference between the number
+                              of matching pods in the target topology and the global
+                              minimum. The global minimum is the minimum number of
+                              matching pods in an eligible domain or zero if the number
+                              of eligible domains is less than MinDomains. For example,
+                              in a 3-zone cluster, MaxSkew is set to 1, and pods with
+                              the same labelSelector spread as 2/2/1: In this case,
+                              the global minimum is 1. | zone1 | zone2 | zone3 | |  P
+                              P  |  P P  |   P   | - if MaxSkew is 1, incoming pod
+                              can only be scheduled to zone3 to become 2/2/2; scheduling
+                              it onto zone1(zone2) would make the ActualSkew(3-1)
+                              on zone1(zone2) violate MaxSkew(1). - if MaxSkew is
+                              2, incoming pod can be scheduled onto any zone. When
+                              ''whenUnsatisfiable=ScheduleAnyway'', it is used to
+                              give higher precedence to topologies that satisfy it.
+                              It''s a required field. Default value is 1 and 0 is
+                              not allowed.'
+                            format: int32
+                            type: integer
+                          minDomains:
+                            description: "MinDomains indicates a minimum number of
+                              eligible domains. When the number of eligible domains
+                              with matching topology keys is less than minDomains,
+                              Pod Topology Spread treats \"global minimum\" as 0,
+                              and then the calculation of Skew is performed. And when
+                              the number of eligible domains with matching topology
+                              keys equals or greater than minDomains, this value has
+                              no effect on scheduling. As a result, when the number
+                              of eligible domains is less than minDomains, scheduler
+                              won't schedule more than maxSkew Pods to those domains.
+                              If value is nil, the constraint behaves as if MinDomains
+                              is equal to 1. Valid values are integers greater than
+                              0. When value is not nil, WhenUnsatisfiable must be
+                              DoNotSchedule. \n For example, in a 3-zone cluster,
+                              MaxSkew is set to 2, MinDomains is set to 5 and pods
+                              with the same labelSelector spread as 2/2/2: | zone1
+                              | zone2 | zone3 | |  P P  |  P P  |  P P  | The number
+                              of domains is less than 5(MinDomains), so \"global minimum\"
+                              is treated as 0. In this situation, new pod with the
+                              same labelSelector cannot be scheduled, because computed
+                              skew will be 3(3 - 0) if new Pod is scheduled to any
+                              of the three zones, it will violate MaxSkew. \n This
+                              is a beta field and requires the MinDomainsInPodTopologySpread
+                              feature gate to be enabled (enabled by default)."
+                            format: int32
+                            type: integer
+                          nodeAffinityPolicy:
+                            description: "NodeAffinityPolicy indicates how we will
+                              treat Pod's nodeAffinity/nodeSelector when calculating
+                              pod topology spread skew. Options are: - Honor: only
+                              nodes matching nodeAffinity/nodeSelector are included
+                              in the calculations. - Ignore: nodeAffinity/nodeSelector
+                              are ignored. All nodes are included in the calculations.
+                              \n If this value is nil, the behavior is equivalent
+                              to the Honor policy. This is a beta-level feature default
+                              enabled by the NodeInclusionPolicyInPodTopologySpread
+                              feature flag."
+                            type: string
+                          nodeTaintsPolicy:
+                            description: "NodeTaintsPolicy indicates how we will treat
+                              node taints when calculating pod topology spread skew.
+                              Options are: - Honor: nodes without taints, along with
+                              tainted nodes for which the incoming pod has a toleration,
+                              are included. - Ignore: node taints are ignored. All
+                              nodes are included. \n If this value is nil, the behavior
+                              is equivalent to the Ignore policy. This is a beta-level
+                              feature default enabled by the NodeInclusionPolicyInPodTopologySpread
+                              feature flag."
+                            type: string
+                          topologyKey:
+                            description: TopologyKey is the key of node labels. Nodes
+                              that have a label with this key and identical values
+                              are considered to be in the same topology. We consider
+                              each <key, value> as a "bucket", and try to put balanced
+                              number of pods into each bucket. We define a domain
+                              as a particular instance of a topology. Also, we define
+                              an eligible domain as a domain whose nodes meet the
+                              requirements of nodeAffinityPolicy and nodeTaintsPolicy.
+                              e.g. If TopologyKey is "kubernetes.io/hostname", each
+                              Node is a domain of that topology. And, if TopologyKey
+                              is "topology.kubernetes.io/zone", each zone is a domain
+                              of that topology. It's a required field.
+                            type: string
+                          whenUnsatisfiable:
+                            description: 'WhenUnsatisfiable indicates how to deal
+                              with a pod if it doesn''t satisfy the spread constraint.
+                              - DoNotSchedule (default) tells the scheduler not to
+                              schedule it. - ScheduleAnyway tells the scheduler to
+                              schedule the pod in any location,   but giving higher
+                              precedence to topologies that would help reduce the   skew.
+                              A constraint is considered "Unsatisfiable" for an incoming
+                              pod if and only if every possible node assignment for
+                              that pod would violate "MaxSkew" on some topology. For
+                              example, in a 3-zone cluster, MaxSkew is set to 1, and
+                              pods with the same labelSelector spread as 3/1/1: |
+                              zone1 | zone2 | zone3 | | P P P |   P   |   P   | If
+                              WhenUnsatisfiable is set to DoNotSchedule, incoming
+                              pod can only be scheduled to zone2(zone3) to become
+                              3/2/1(3/1/2) as ActualSkew(2-1) on zone2(zone3) satisfies
+                              MaxSkew(1). In other words, the cluster can still be
+                              imbalanced, but scheduler won''t make it *more* imbalanced.
+                              It''s a required field.'
+                            type: string
+                        required:
+                        - maxSkew
+                        - topologyKey
+                        - whenUnsatisfiable
+                        type: object
+                      type: array
+                      x-kubernetes-list-map-keys:
+                      - topologyKey
+                      - whenUnsatisfiable
+                      x-kubernetes-list-type: map
+                    volumes:
+                      description: List of volumes that can be mounted by disks belonging
+                        to the vmi.
+                      items:
+                        description: Volume represents a named volume in a vmi.
+                        properties:
+                          backup:
+                            description: Backup is attached to the virt launcher and
+                              is populated with the backup images of the vmi disks
+                            properties:
+                              backupName:
+                                description: BackupName is the name of the VirtualMachineBackup
+                                  writing into the volume
+                                type: string
+                              checkpointName:
+                                description: CheckpointName is the name of the changed
+                                  block tracking checkpoint created with the backup
+                                type: string
+                              claimName:
+                                description: 'claimName is the name of a PersistentVolumeClaim
+                                  in the same namespace as the pod using this volume.
+                                  More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                type: string
+                              hotpluggable:
+                                description: Hotpluggable indicates whether the volume
+                                  can be hotplugged and hotunplugged.
+                                type: boolean
+                              incrementalFrom:
+                                description: IncrementalFrom is the name of the checkpoint
+                                  the backup is relative to. A full backup is taken
+                                  when empty.
+                                type: string
+                              pull:
+                                description: Pull exposes the disks through the NBD
+                                  server of the VMI instead of writing the backup
+                                  images, the volume only holds the scratch images
+                                  of the backup job.
+                                type: boolean
+                              pullCompleted:
+                                description: PullCompleted stops the NBD server of
+                                  a pull mode backup and completes it
+                                type: boolean
+                              readOnly:
+                                description: readOnly Will force the ReadOnly setting
+                                  in VolumeMounts. Default false.
+                                type: boolean
+                            required:
+                            - backupName
+                            - claimName
+                            type: object
+                          cloudInitConfigDrive:
+                            description: 'CloudInitConfigDrive represents a cloud-init
+                              Config Drive user-data source. The Config Drive data
+                              will be added as a disk to the vmi. A proper cloud-init
+                              installation is required inside the guest. More info:
+                              https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html'
+                            properties:
+                              networkData:
+                                description: NetworkData contains config drive inline
+                                  cloud-init networkdata.
+                                type: string
+                              networkDataBase64:
+                                description: NetworkDataBase64 contains config drive
+                                  cloud-init networkdata as a base64 encoded string.
+                                type: string
+                              networkDataSecretRef:
+                                description: NetworkDataSecretRef references a k8s
+                                  secret that contains config drive networkdata.
+                                properties:
+                                  name:
+                                    description: 'Name of the referent. More info:
+                                      https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      TODO: Add other useful fields. apiVersion, kind,
+                                      uid?'
+                                    type: string
+                                type: object
+                              secretRef:
+                                description: UserDataSecretRef references a k8s secret
+                                  that contains config drive userdata.
+                                properties:
+                                  name:
+                                    description: 'Name of the referent. More info:
+                                      https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      TODO: Add other useful fields. apiVersion, kind,
+                                      uid?'
+                                    type: string
+                                type: object
+                              userData:
+                                description: UserData contains config drive inline
+                                  cloud-init userdata.
+                                type: string
+                              userDataBase64:
+                                description: UserDataBase64 contains config drive
+                                  cloud-init userdata as a base64 encoded string.
+                                type: string
+                            type: object
+                          cloudInitNoCloud:
+                            description: 'CloudInitNoCloud represents a cloud-init
+                              NoCloud user-data source. The NoCloud data will be added
+                              as a disk to the vmi. A proper cloud-init installation
+                              is required inside the guest. More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html'
+                            properties:
+                              networkData:
+                                description: NetworkData contains NoCloud inline cloud-init
+                                  networkdata.
+                                type: string
+                              networkDataBase64:
+                                description: NetworkDataBase64 contains NoCloud cloud-init
+                                  networkdata as a base64 encoded string.
+                                type: string
+                              networkDataSecretRef:
+                                description: NetworkDataSecretRef references a k8s
+                                  secret that contains NoCloud networkdata.
+                                properties:
+                                  name:
+                                    description: 'Name of the referent. More info:
+                                      https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      TODO: Add other useful fields. apiVersion, kind,
+                                      uid?'
+                                    type: string
+                                type: object
+                              secretRef:
+                                description: UserDataSecretRef references a k8s secret
+                                  that contains NoCloud userdata.
+                                properties:
+                                  name:
+                                    description: 'Name of the referent. More info:
+                                      https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      TODO: Add other useful fields. apiVersion, kind,
+                                      uid?'
+                                    type: string
+                                type: object
+                              userData:
+                                description: UserData contains NoCloud inline cloud-init
+                                  userdata.
+                                type: string
+                              userDataBase64:
+                                description: UserDataBase64 contains NoCloud cloud-init
+                                  userdata as a base64 encoded string.
+                                type: string
+                            type: object
+                          configMap:
+                            description: 'ConfigMapSource represents a reference to
+                              a ConfigMap in the same namespace. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/'
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  TODO: Add other useful fields. apiVersion, kind,
+                                  uid?'
+                                type: string
+                              optional:
+                                description: Specify whether the ConfigMap or it's
+                                  keys must be defined
+                                type: boolean
+                              volumeLabel:
+                                description: The volume label of the resulting disk
+                                  inside the VMI. Different bootstrapping mechanisms
+                                  require different values. Typical values are "cidata"
+                                  (cloud-init), "config-2" (cloud-init) or "OEMDRV"
+                                  (kickstart).
+                                type: string
+                            type: object
+                          containerDisk:
+                            description: 'ContainerDisk references a docker image,
+                              embedding a qcow or raw disk. More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html'
+                            properties:
+                              image:
+                                description: Image is the name of the image with the
+                                  embedded disk.
+                                type: string
+                              imagePullPolicy:
+                                description: 'Image pull policy. One of Always, Never,
+                                  IfNotPresent. Defaults to Always if :latest tag
+                                  is specified, or IfNotPresent otherwise. Cannot
+                                  be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images'
+                                type: string
+                              imagePullSecret:
+                                description: ImagePullSecret is the name of the Docker
+                                  registry secret required to pull the image. The
+                                  secret must already exist.
+                                type: string
+                              path:
+                                description: Path defines the path to disk file in
+                                  the container
+                                type: string
+                            required:
+                            - image
+                            type: object
+                          dataVolume:
+                            description: DataVolume represents the dynamic creation
+                              a PVC for this volume as well as the process of populating
+                              that PVC with a disk image.
+                            properties:
+                              hotpluggable:
+                                description: Hotpluggable indicates whether the volume
+                                  can be hotplugged and hotunplugged.
+                                type: boolean
+                              name:
+                                description: Name of both the DataVolume and the PVC
+                                  in the same namespace. After PVC population the
+                                  DataVolume is garbage collected by default.
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          downwardAPI:
+                            description: DownwardAPI represents downward API about
+                              the pod that should populate this volume
+                            properties:
+                              fields:
+                                description: Fields is a list of downward API volume
+                                  file
+                                items:
+                                  description: DownwardAPIVolumeFile represents information
+                                    to create the file containing the pod field
+                                  properties:
+                                    fieldRef:
+                                      description: 'Required: Selects a field of the
+                                        pod: only annotations, labels, name and namespace
+                                        are supported.'
+                                      properties:
+                                        apiVersion:
+                                          description: Version of the schema the FieldPath
+                                            is written in terms of, defaults to "v1".
+                                          type: string
+                                        fieldPath:
+                                          description: Path of the field to select
+                                            in the specified API version.
+                                          type: string
+                                      required:
+                                      - fieldPath
+                                      type: object
+                                    mode:
+                                      description: 'Optional: mode bits used to set
+                                        permissions on this file, must be an octal
+                                        value between 0000 and 0777 or a decimal value
+                                        between 0 and 511. YAML accepts both octal
+                                        and decimal values, JSON requires decimal
+                                        values for mode bits. If not specified, the
+                                        volume defaultMode will be used. This might
+                                        be in conflict with other options that affect
+                                        the file mode, like fsGroup, and the result
+                                        can be other mode bits set.'
+                                      format: int32
+                                      type: integer
+                                    path:
+                                      description: 'Required: Path is  the relative
+                                        path name of the file to be created. Must
+                                        not be absolute or contain the ''..'' path.
+                                        Must be utf-8 encoded. The first item of the
+                                        relative path must not start with ''..'''
+                                      type: string
+                                    resourceFieldRef:
+                                      description: 'Selects a resource of the container:
+                                        only resources limits and requests (limits.cpu,
+                                        limits.memory, requests.cpu and requests.memory)
+                                        are currently supported.'
+                                      properties:
+                                        containerName:
+                                          description: 'Container name: required for
+                                            volumes, optional for env vars'
+                                          type: string
+                                        divisor:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          description: Specifies the output format
+                                            of the exposed resources, defaults to
+                                            "1"
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                        resource:
+                                          description: 'Required: resource to select'
+                                          type: string
+                                      required:
+                                      - resource
+                                      type: object
+                                  required:
+                                  - path
+                                  type: object
+                                type: array
+                              volumeLabel:
+                                description: The volume label of the resulting disk
+                                  inside the VMI. Different bootstrapping mechanisms
+                                  require different values. Typical values are "cidata"
+                                  (cloud-init), "config-2" (cloud-init) or "OEMDRV"
+                                  (kickstart).
+                                type: string
+                            type: object
+                          downwardMetrics:
+                            description: DownwardMetrics adds a very small disk to
+                              VMIs which contains a limited view of host and guest
+                              metrics. The disk content is compatible with vhostmd
+                              (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+                            type: object
+                          emptyDisk:
+                            description: 'EmptyDisk represents a temporary disk which
+                              shares the vmis lifecycle. More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html'
+                            properties:
+                              capacity:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Capacity of the sparse disk.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - capacity
+                            type: object
+                          ephemeral:
+                            description: Ephemeral is a special volume source that
+                              "wraps" specified source and provides copy-on-write
+                              image on top of it.
+                            properties:
+                              persistentVolumeClaim:
+                                description: 'PersistentVolumeClaimVolumeSource represents
+                                  a reference to a PersistentVolumeClaim in the same
+                                  namespace. Directly attached to the vmi via qemu.
+                                  More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                properties:
+                                  claimName:
+                                    description: 'claimName is the name of a PersistentVolumeClaim
+                                      in the same namespace as the pod using this
+                                      volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                    type: string
+                                  readOnly:
+                                    description: readOnly Will force the ReadOnly
+                                      setting in VolumeMounts. Default false.
+                                    type: boolean
+                                required:
+                                - claimName
+                                type: object
+                            type: object
+                          hostDisk:
+                            description: HostDisk represents a disk created on the
+                              cluster level
+                            properties:
+                              capacity:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Capacity of the sparse disk
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              path:
+                                description: The path to HostDisk image located on
+                                  the cluster
+                                type: string
+                              shared:
+                                description: Shared indicate whether the path is shared
+                                  between nodes
+                                type: boolean
+                              type:
+                                description: Contains information if disk.img exists
+                                  or should be created allowed options are 'Disk'
+                                  and 'DiskOrCreate'
+                                type: string
+                            required:
+                            - path
+                            - type
+                            type: object
+                          memoryDump:
+                            description: MemoryDump is attached to the virt launcher
+                              and is populated with a memory dump of the vmi
+                            properties:
+                              claimName:
+                                description: 'claimName is the name of a PersistentVolumeClaim
+                                  in the same namespace as the pod using this volume.
+                                  More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                type: string
+                              hotpluggable:
+                                description: Hotpluggable indicates whether the volume
+                                  can be hotplugged and hotunplugged.
+                                type: boolean
+                              readOnly:
+                                description: readOnly Will force the ReadOnly setting
+                                  in VolumeMounts. Default false.
+                                type: boolean
+                            required:
+                            - claimName
+                            type: object
+                          name:
+                            description: 'Volume''s name. Must be a DNS_LABEL and
+                              unique within the vmi. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
+                            type: string
+                          persistentVolumeClaim:
+                            description: 'PersistentVolumeClaimVolumeSource represents
+                              a reference to a PersistentVolumeClaim in the same namespace.
+                              Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                            properties:
+                              claimName:
+                                description: 'claimName is the name of a PersistentVolumeClaim
+                                  in the same namespace as the pod using this volume.
+                                  More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                type: string
+                              hotpluggable:
+                                description: Hotpluggable indicates whether the volume
+                                  can be hotplugged and hotunplugged.
+                                type: boolean
+                              readOnly:
+                                description: readOnly Will force the ReadOnly setting
+                                  in VolumeMounts. Default false.
+                                type: boolean
+                            required:
+                            - claimName
+                            type: object
+                          secret:
+                            description: 'SecretVolumeSource represents a reference
+                              to a secret data in the same namespace. More info: https://kubernetes.io/docs/concepts/configuration/secret/'
+                            properties:
+                              optional:
+                                description: Specify whether the Secret or it's keys
+                                  must be defined
+                                type: boolean
+                              secretName:
+                                description: 'Name of the secret in the pod''s namespace
+                                  to use. More info: https://kubernetes.io/docs/concepts/storage/volumes#secret'
+                                type: string
+                              volumeLabel:
+                                description: The volume label of the resulting disk
+                                  inside the VMI. Different bootstrapping mechanisms
+                                  require different values. Typical values are "cidata"
+                                  (cloud-init), "config-2" (cloud-init) or "OEMDRV"
+                                  (kickstart).
+                                type: string
+                            type: object
+                          serviceAccount:
+                            description: 'ServiceAccountVolumeSource represents a
+                              reference to a service account. There can only be one
+                              volume of this type! More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
+                            properties:
+                              serviceAccountName:
+                                description: 'Name of the service account in the pod''s
+                                  namespace to use. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
+                                type: string
+                            type: object
+                          sysprep:
+                            description: Represents a Sysprep volume source.
+                            properties:
+                              configMap:
+                                description: ConfigMap references a ConfigMap that
+                                  contains Sysprep answer file named autounattend.xml
+                                  that should be attached as disk of CDROM type.
+                                properties:
+                                  name:
+                                    description: 'Name of the referent. More info:
+                                      https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      TODO: Add other useful fields. apiVersion, kind,
+                                      uid?'
+                                    type: string
+                                type: object
+                              secret:
+                                description: Secret references a k8s Secret that contains
+                                  Sysprep answer file named autounattend.xml that
+                                  should be attached as disk of CDROM type.
+                                properties:
+                                  name:
+                                    description: 'Name of the referent. More info:
+                                      https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      TODO: Add other useful fields. apiVersion, kind,
+                                      uid?'
+                                    type: string
+                                type: object
+                            type: object
+                        required:
+                        - name
+                        type: object
+                      type: array
+                  required:
+                  - domain
+                  type: object
+              type: object
+          required:
+          - migrationID
+          - sourceCABundle
+          - template
+          type: object
+        sendTo:
+          description: SendTo migrates the VMI to another cluster. A receiving migration
+            is created in the target cluster, the migration stream is sent through
+            the migration tunnel of the target cluster.
+          properties:
+            kubeconfigSecretName:
+              description: KubeconfigSecretName is the name of a secret of the type
+                "kubevirt.io/cross-cluster-kubeconfig" in the namespace of the migration,
+                which the creator of the migration must be allowed to read. The kubeconfig
+                it holds under the key "kubeconfig" is used to access the target cluster,
+                it needs to be allowed to read the persistent volume claims and persistent
+                volumes there.
+              type: string
+            migrationID:
+              description: MigrationID identifies the migration in both clusters
+              type: string
+          required:
+          - kubeconfigSecretName
+          - migrationID
+          type: object
         vmiName:
           description: The name of the VMI to perform the migration on. VMI must exist
             in the migration objects namespace
@@ -12593,17 +18403,111 @@ var CRDsValidation map[string]string = map[string]string{
             completed:
               description: Indicates the migration completed
               type: boolean
+            dataTransferred:
+              description: DataTransferred is the number of bytes sent to the target
+                node
+              format: int64
+              type: integer
+            dirtyRate:
+              description: DirtyRate is an estimate of the memory dirty rate of the
+                guest while the migration was running, updated once per minute
+              properties:
+                estimate:
+                  description: Estimate is the average rate in bytes per second at
+                    which the guest dirtied its memory
+                  format: int64
+                  type: integer
+                timestamp:
+                  description: Timestamp of the latest measurement the estimate is
+                    derived from
+                  format: date-time
+                  type: string
+                transferRate:
+                  description: TransferRate is the average rate in bytes per second
+                    at which memory was transferred
+                  format: int64
+                  type: integer
+                trend:
+                  description: Trend tells whether the dirty rate increased or decreased
+                    compared to the minute before
+                  type: string
+              required:
+              - estimate
+              - timestamp
+              type: object
+            downtime:
+              description: Downtime is the time the guest was paused to switch over
+                to the target node
+              type: string
             endTimestamp:
               description: The time the migration action ended
               format: date-time
               nullable: true
               type: string
+            escalation:
+              description: Escalation reports the escalation stages the migration
+                went through
+              properties:
+                stage:
+                  description: Stage is the current escalation stage of the migration
+                  type: string
+                transitions:
+                  description: Transitions lists the stages the migration entered,
+                    the first one being PreCopy
+                  items:
+                    description: MigrationEscalationTransition records when a live
+                      migration entered an escalation stage and the measurements which
+                      triggered it
+                    properties:
+                      dataRemaining:
+                        description: DataRemaining is the number of bytes which still
+                          had to be transferred
+                        format: int64
+                        type: integer
+                      dirtyRate:
+                        description: DirtyRate is the rate in bytes per second at
+                          which the guest dirtied its memory
+                        format: int64
+                        type: integer
+                      stage:
+                        description: Stage the migration entered
+                        type: string
+                      timestamp:
+                        description: Timestamp of the transition
+                        format: date-time
+                        type: string
+                      transferRate:
+                        description: TransferRate is the rate in bytes per second
+                          at which memory was transferred
+                        format: int64
+                        type: integer
+                    required:
+                    - stage
+                    - timestamp
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              required:
+              - stage
+              type: object
             failed:
               description: Indicates that the migration failed
               type: boolean
+            failureReason:
+              description: The reason reported by the source node for a failed migration
+              type: string
             migrationConfiguration:
               description: Migration configurations to apply
               properties:
+                additionalNetworks:
+                  description: AdditionalNetworks are the names of further CNI
+                    networks virt-handler is attached to. Migration policies can
+                    select one of them as the network of the migrations they
+                    apply to.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
                 allowAutoConverge:
                   description: AllowAutoConverge allows the platform to compromise
                     performance/availability of VMIs to guarantee successful VMI live
@@ -12634,11 +18538,97 @@ var CRDsValidation map[string]string = map[string]string{
                     true. Defaults to 800
                   format: int64
                   type: integer
+                compression:
+                  description: Compression compresses the memory transferred over
+                    the multifd channels. It is only applied to migrations which use
+                    MultifdChannels. Defaults to no compression
+                  properties:
+                    level:
+                      description: Level is the compression level. zlib supports levels
+                        from 0 to 9, zstd from 0 to 20. Defaults to the default level
+                        of the method in QEMU
+                      format: int32
+                      type: integer
+                    method:
+                      description: Method is the compression algorithm, either zstd
+                        or zlib
+                      enum:
+                      - zstd
+                      - zlib
+                      type: string
+                  required:
+                  - method
+                  type: object
+                crossClusterTunnelAddress:
+                  description: CrossClusterTunnelAddress is the host and port other
+                    clusters reach the migration tunnel of virt-api at, which listens
+                    on the port 8444 of the virt-api pods. It has to be exposed outside
+                    of the cluster, e.g. by a service of the type LoadBalancer. Cross-cluster
+                    migrations are only received when it is set
+                  type: string
                 disableTLS:
                   description: When set to true, DisableTLS will disable the additional
                     layer of live migration encryption provided by KubeVirt. This
                     is usually a bad idea. Defaults to false
                   type: boolean
+                escalation:
+                  description: Escalation enables the staged escalation of live migrations
+                    which don't converge. Migrations start in pre-copy and, while
+                    the guest dirties memory faster than it is transferred, escalate
+                    to a raised bandwidth, to auto-converge throttling and finally
+                    to post-copy. The auto-converge and post-copy stages are only
+                    used if AllowAutoConverge and AllowPostCopy are true. Defaults
+                    to no escalation, post-copy is then only triggered by CompletionTimeoutPerGiB
+                  properties:
+                    autoConvergeIncrement:
+                      description: AutoConvergeIncrement is the percentage the throttling
+                        of the guest CPUs is increased by in every iteration which
+                        still does not converge. Must be between 1 and 99. Defaults
+                        to 10
+                      format: int32
+                      type: integer
+                    autoConvergeInitial:
+                      description: AutoConvergeInitial is the percentage the guest
+                        CPUs are throttled by once auto-converge starts throttling
+                        a migration which does not converge. libvirt only accepts
+                        it when the migration starts, so it applies to the whole migration.
+                        Must be between 1 and 99. Defaults to 20
+                      format: int32
+                      type: integer
+                    bandwidth:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: Bandwidth is the bandwidth limit of the raised
+                        bandwidth stage. The value is in quantity per second. Defaults
+                        to 0 (no limit). The stage is skipped if BandwidthPerMigration
+                        does not limit migrations.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    dirtyRateThresholdPercent:
+                      description: DirtyRateThresholdPercent escalates a migration
+                        when the guest dirties memory at more than this percentage
+                        of the transfer rate. Migrations whose remaining data did
+                        not decrease during a stage are escalated as well. Defaults
+                        to 80
+                      format: int32
+                      type: integer
+                    remainingDataThreshold:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: RemainingDataThreshold prevents the escalation
+                        of migrations with less data left to transfer, since they
+                        are about to complete. Defaults to 256Mi
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    stageDuration:
+                      description: StageDuration is the minimum number of seconds
+                        a migration stays in a stage before it escalates to the next
+                        one. Defaults to 30
+                      format: int64
+                      type: integer
+                  type: object
                 matchSELinuxLevelOnMigration:
                   description: By default, the SELinux level of target virt-launcher
                     pods is forced to the level of the source virt-launcher. When
@@ -12648,6 +18638,20 @@ var CRDsValidation map[string]string = map[string]string{
                     migrations will fail when using RWX volumes that don't automatically
                     deal with SELinux levels.
                   type: boolean
+                maxDowntime:
+                  description: MaxDowntime is the maximum time the guest may be paused
+                    to switch over to the target node. A migration does not complete
+                    before its remaining data can be transferred within this time.
+                    Defaults to the default of libvirt, 300ms
+                  type: string
+                multifdChannels:
+                  description: MultifdChannels is the number of parallel connections
+                    (QEMU multifd channels) live migrations transfer the guest memory
+                    over. Multiple channels allow migrations to exceed the throughput
+                    of a single TCP stream. The kubevirt.io/multiThreadedQemuMigration
+                    annotation of a VMI takes precedence. Defaults to a single connection
+                  format: int32
+                  type: integer
                 network:
                   description: Network is the name of the CNI network to use for live
                     migrations. By default, migrations go through the pod network.
@@ -12694,9 +18698,17 @@ var CRDsValidation map[string]string = map[string]string{
               description: Lets us know if the vmi is currently running pre or post
                 copy migration
               type: string
+            setupTime:
+              description: SetupTime is the time the migration spent before it started
+                to transfer data
+              type: string
             sourceNode:
               description: The source node that the VMI originated on
               type: string
+            sourceVirtualMachineInstanceUID:
+              description: The UID of the migrated VMI in the source cluster of a
+                cross-cluster migration
+              type: string
             startTimestamp:
               description: The time the migration action began
               format: date-time
@@ -12739,6 +18751,36 @@ var CRDsValidation map[string]string = map[string]string{
             targetPod:
               description: The target pod that the VMI is moving to
               type: string
+            targetTunnel:
+              description: The migration tunnel of the target cluster of a cross-cluster
+                migration, the source node sends the migration stream through it
+              properties:
+                address:
+                  description: Address is the host and port the tunnel is reachable
+                    at from the source cluster
+                  type: string
+                caBundle:
+                  description: CABundle is the PEM encoded CA bundle which signed
+                    the certificate of the tunnel
+                  format: byte
+                  type: string
+                serverName:
+                  description: ServerName is the name the certificate of the tunnel
+                    is issued for
+                  type: string
+              required:
+              - address
+              - caBundle
+              - serverName
+              type: object
+            targetVirtualMachineInstanceUID:
+              description: The UID of the receiving VMI in the target cluster of a
+                cross-cluster migration
+              type: string
+            totalTime:
+              description: TotalTime is the time the migration took from its start
+                to its completion
+              type: string
           type: object
         phase:
           description: VirtualMachineInstanceMigrationPhase is a label for the condition
@@ -12764,6 +18806,12 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        queuePosition:
+          description: QueuePosition is the position of the pending migration in the
+            cluster wide migration queue, starting at 1. It is removed once the target
+            pod of the migration is created.
+          format: int32
+          type: integer
       type: object
   required:
   - spec
@@ -13107,6 +19155,105 @@ var CRDsValidation map[string]string = map[string]string{
                         description: 'IO specifies which QEMU disk IO mode should
                           be used. Supported values are: native, default, threads.'
                         type: string
+                      ioTune:
+                        description: If specified, the IO of the disk is throttled
+                          to the given limits.
+                        properties:
+                          groupName:
+                            description: GroupName places the disk in a throttling
+                              group, the disks of a group share the same limits
+                            type: string
+                          readBytesSec:
+                            description: ReadBytesSec is the read throughput limit
+                              in bytes per second
+                            format: int64
+                            type: integer
+                          readBytesSecMax:
+                            description: ReadBytesSecMax is the read throughput allowed
+                              during bursts in bytes per second
+                            format: int64
+                            type: integer
+                          readBytesSecMaxLength:
+                            description: ReadBytesSecMaxLength is the duration in
+                              seconds of a read throughput burst
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec is the read I/O operations per
+                              second limit
+                            format: int64
+                            type: integer
+                          readIOPSSecMax:
+                            description: ReadIOPSSecMax is the read I/O operations
+                              per second allowed during bursts
+                            format: int64
+                            type: integer
+                          readIOPSSecMaxLength:
+                            description: ReadIOPSSecMaxLength is the duration in seconds
+                              of a read I/O operations burst
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec is the total throughput limit
+                              in bytes per second
+                            format: int64
+                            type: integer
+                          totalBytesSecMax:
+                            description: TotalBytesSecMax is the total throughput
+                              allowed during bursts in bytes per second
+                            format: int64
+                            type: integer
+                          totalBytesSecMaxLength:
+                            description: TotalBytesSecMaxLength is the duration in
+                              seconds of a total throughput burst
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec is the total I/O operations
+                              per second limit
+                            format: int64
+                            type: integer
+                          totalIOPSSecMax:
+                            description: TotalIOPSSecMax is the total I/O operations
+                              per second allowed during bursts
+                            format: int64
+                            type: integer
+                          totalIOPSSecMaxLength:
+                            description: TotalIOPSSecMaxLength is the duration in
+                              seconds of a total I/O operations burst
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec is the write throughput limit
+                              in bytes per second
+                            format: int64
+                            type: integer
+                          writeBytesSecMax:
+                            description: WriteBytesSecMax is the write throughput
+                              allowed during bursts in bytes per second
+                            format: int64
+                            type: integer
+                          writeBytesSecMaxLength:
+                            description: WriteBytesSecMaxLength is the duration in
+                              seconds of a write throughput burst
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec is the write I/O operations
+                              per second limit
+                            format: int64
+                            type: integer
+                          writeIOPSSecMax:
+                            description: WriteIOPSSecMax is the write I/O operations
+                              per second allowed during bursts
+                            format: int64
+                            type: integer
+                          writeIOPSSecMaxLength:
+                            description: WriteIOPSSecMaxLength is the duration in
+                              seconds of a write I/O operations burst
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -13254,6 +19401,55 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device. This value is required
                           to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: If specified, the traffic of the interface is
+                          shaped to the given limits. Not supported for SR-IOV interfaces.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              virtual machine
+                            properties:
+                              average:
+                                description: Average is the average rate in kibibytes
+                                  per second
+                                format: int64
+                                type: integer
+                              burst:
+                                description: Burst is the amount of kibibytes which
+                                  can be sent in a single burst at the peak rate
+                                format: int64
+                                type: integer
+                              peak:
+                                description: Peak is the maximum rate in kibibytes
+                                  per second at which bursts are sent
+                                format: int64
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the virtual
+                              machine
+                            properties:
+                              average:
+                                description: Average is the average rate in kibibytes
+                                  per second
+                                format: int64
+                                type: integer
+                              burst:
+                                description: Burst is the amount of kibibytes which
+                                  can be sent in a single burst at the peak rate
+                                format: int64
+                                type: integer
+                              peak:
+                                description: Peak is the maximum rate in kibibytes
+                                  per second at which bursts are sent
+                                format: int64
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: 'Binding specifies the binding plugin that will
                           be used to connect the interface to the guest. It provides
@@ -13382,8 +19578,10 @@ var CRDsValidation map[string]string = map[string]string{
                         type: object
                       state:
                         description: State represents the requested operational state
-                          of the interface. The (only) value supported is 'absent',
-                          expressing a request to remove the interface.
+                          of the interface. The values supported are 'absent', expressing
+                          a request to remove the interface, and 'up' or 'down', setting
+                          the link state of the interface seen by the guest. The link
+                          state is 'up' if not specified.
                         type: string
                       tag:
                         description: If specified, the virtual network interface address
@@ -13406,6 +19604,26 @@ var CRDsValidation map[string]string = map[string]string{
                     factors of the VirtualMachineInstance, like the number of guest
                     CPUs.
                   type: boolean
+                panicDevice:
+                  description: PanicDevice describes a panic device which the guest
+                    uses to report a crash.
+                  properties:
+                    memoryDumpClaimName:
+                      description: MemoryDumpClaimName is the name of a PVC the memory
+                        of a crashed guest is dumped to. The dump is done through
+                        the memory dump subresource of the VirtualMachine and requires
+                        the preserve action.
+                      type: string
+                    model:
+                      description: Model of the panic device. Valid values are pvpanic,
+                        isa, hyperv. Defaults to pvpanic.
+                      type: string
+                    onCrash:
+                      description: The action to take when the guest reports a crash.
+                        Valid values are restart, poweroff, preserve. Defaults to
+                        poweroff.
+                      type: string
+                  type: object
                 rng:
                   description: Whether to have random number generator from host
                   type: object
@@ -13693,6 +19911,18 @@ var CRDsValidation map[string]string = map[string]string{
                           type: boolean
                       type: object
                   type: object
+                generationID:
+                  description: GenerationID exposes a VM Generation ID device to the
+                    guest. It allows the guest to detect a rollback, e.g. after a
+                    restore from a snapshot.
+                  properties:
+                    uuid:
+                      description: UUID reported to the guest as the VM Generation
+                        ID. Defaults to an ID derived from the VirtualMachine, which
+                        is stable across reboots and migrations. It is regenerated
+                        when the VirtualMachine is restored from a snapshot or cloned.
+                      type: string
+                  type: object
                 kernelBoot:
                   description: Settings to set the kernel for booting.
                   properties:
@@ -15282,15 +21512,114 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: ReadOnly. Defaults to false.
                                     type: boolean
                                 type: object
-                              errorPolicy:
-                                description: If specified, it can change the default
-                                  error policy (stop) for the disk
-                                type: string
-                              io:
-                                description: 'IO specifies which QEMU disk IO mode
-                                  should be used. Supported values are: native, default,
-                                  threads.'
-                                type: string
+                              errorPolicy:
+                                description: If specified, it can change the default
+                                  error policy (stop) for the disk
+                                type: string
+                              io:
+                                description: 'IO specifies which QEMU disk IO mode
+                                  should be used. Supported values are: native, default,
+                                  threads.'
+                                type: string
+                              ioTune:
+                                description: If specified, the IO of the disk is throttled
+                                  to the given limits.
+                                properties:
+                                  groupName:
+                                    description: GroupName places the disk in a throttling
+                                      group, the disks of a group share the same limits
+                                    type: string
+                                  readBytesSec:
+                                    description: ReadBytesSec is the read throughput
+                                      limit in bytes per second
+                                    format: int64
+                                    type: integer
+                                  readBytesSecMax:
+                                    description: ReadBytesSecMax is the read throughput
+                                      allowed during bursts in bytes per second
+                                    format: int64
+                                    type: integer
+                                  readBytesSecMaxLength:
+                                    description: ReadBytesSecMaxLength is the duration
+                                      in seconds of a read throughput burst
+                                    format: int64
+                                    type: integer
+                                  readIOPSSec:
+                                    description: ReadIOPSSec is the read I/O operations
+                                      per second limit
+                                    format: int64
+                                    type: integer
+                                  readIOPSSecMax:
+                                    description: ReadIOPSSecMax is the read I/O operations
+                                      per second allowed during bursts
+                                    format: int64
+                                    type: integer
+                                  readIOPSSecMaxLength:
+                                    description: ReadIOPSSecMaxLength is the duration
+                                      in seconds of a read I/O operations burst
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    description: TotalBytesSec is the total throughput
+                                      limit in bytes per second
+                                    format: int64
+                                    type: integer
+                                  totalBytesSecMax:
+                                    description: TotalBytesSecMax is the total throughput
+                                      allowed during bursts in bytes per second
+                                    format: int64
+                                    type: integer
+                                  totalBytesSecMaxLength:
+                                    description: TotalBytesSecMaxLength is the duration
+                                      in seconds of a total throughput burst
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSec:
+                                    description: TotalIOPSSec is the total I/O operations
+                                      per second limit
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSecMax:
+                                    description: TotalIOPSSecMax is the total I/O
+                                      operations per second allowed during bursts
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSecMaxLength:
+                                    description: TotalIOPSSecMaxLength is the duration
+                                      in seconds of a total I/O operations burst
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    description: WriteBytesSec is the write throughput
+                                      limit in bytes per second
+                                    format: int64
+                                    type: integer
+                                  writeBytesSecMax:
+                                    description: WriteBytesSecMax is the write throughput
+                                      allowed during bursts in bytes per second
+                                    format: int64
+                                    type: integer
+                                  writeBytesSecMaxLength:
+                                    description: WriteBytesSecMaxLength is the duration
+                                      in seconds of a write throughput burst
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSec:
+                                    description: WriteIOPSSec is the write I/O operations
+                                      per second limit
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSecMax:
+                                    description: WriteIOPSSecMax is the write I/O
+                                      operations per second allowed during bursts
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSecMaxLength:
+                                    description: WriteIOPSSecMaxLength is the duration
+                                      in seconds of a write I/O operations burst
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -15444,6 +21773,58 @@ var CRDsValidation map[string]string = map[string]string{
                                   to the device. This value is required to be unique
                                   across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: If specified, the traffic of the interface
+                                  is shaped to the given limits. Not supported for
+                                  SR-IOV interfaces.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the virtual machine
+                                    properties:
+                                      average:
+                                        description: Average is the average rate in
+                                          kibibytes per second
+                                        format: int64
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of kibibytes
+                                          which can be sent in a single burst at the
+                                          peak rate
+                                        format: int64
+                                        type: integer
+                                      peak:
+                                        description: Peak is the maximum rate in kibibytes
+                                          per second at which bursts are sent
+                                        format: int64
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the virtual machine
+                                    properties:
+                                      average:
+                                        description: Average is the average rate in
+                                          kibibytes per second
+                                        format: int64
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of kibibytes
+                                          which can be sent in a single burst at the
+                                          peak rate
+                                        format: int64
+                                        type: integer
+                                      peak:
+                                        description: Peak is the maximum rate in kibibytes
+                                          per second at which bursts are sent
+                                        format: int64
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: 'Binding specifies the binding plugin
                                   that will be used to connect the interface to the
@@ -15577,9 +21958,11 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: object
                               state:
                                 description: State represents the requested operational
-                                  state of the interface. The (only) value supported
-                                  is 'absent', expressing a request to remove the
-                                  interface.
+                                  state of the interface. The values supported are
+                                  'absent', expressing a request to remove the interface,
+                                  and 'up' or 'down', setting the link state of the
+                                  interface seen by the guest. The link state is 'up'
+                                  if not specified.
                                 type: string
                               tag:
                                 description: If specified, the virtual network interface
@@ -15604,6 +21987,26 @@ var CRDsValidation map[string]string = map[string]string{
                             depends on additional factors of the VirtualMachineInstance,
                             like the number of guest CPUs.
                           type: boolean
+                        panicDevice:
+                          description: PanicDevice describes a panic device which
+                            the guest uses to report a crash.
+                          properties:
+                            memoryDumpClaimName:
+                              description: MemoryDumpClaimName is the name of a PVC
+                                the memory of a crashed guest is dumped to. The dump
+                                is done through the memory dump subresource of the
+                                VirtualMachine and requires the preserve action.
+                              type: string
+                            model:
+                              description: Model of the panic device. Valid values
+                                are pvpanic, isa, hyperv. Defaults to pvpanic.
+                              type: string
+                            onCrash:
+                              description: The action to take when the guest reports
+                                a crash. Valid values are restart, poweroff, preserve.
+                                Defaults to poweroff.
+                              type: string
+                          type: object
                         rng:
                           description: Whether to have random number generator from
                             host
@@ -15919,6 +22322,19 @@ var CRDsValidation map[string]string = map[string]string{
                                   type: boolean
                               type: object
                           type: object
+                        generationID:
+                          description: GenerationID exposes a VM Generation ID device
+                            to the guest. It allows the guest to detect a rollback,
+                            e.g. after a restore from a snapshot.
+                          properties:
+                            uuid:
+                              description: UUID reported to the guest as the VM Generation
+                                ID. Defaults to an ID derived from the VirtualMachine,
+                                which is stable across reboots and migrations. It
+                                is regenerated when the VirtualMachine is restored
+                                from a snapshot or cloned.
+                              type: string
+                          type: object
                         kernelBoot:
                           description: Settings to set the kernel for booting.
                           properties:
@@ -16093,6 +22509,93 @@ var CRDsValidation map[string]string = map[string]string{
                     capk controller can handle tearing the VMI down. Details can be
                     found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.'
                   type: string
+                firewall:
+                  description: Firewall defines the rules filtering the traffic of
+                    the pod network interface. Only supported with the masquerade
+                    and passt bindings. The rules can be updated while the vmi is
+                    running.
+                  properties:
+                    egress:
+                      description: Egress filters the traffic sent by the virtual
+                        machine.
+                      properties:
+                        defaultAction:
+                          description: DefaultAction is applied to the traffic not
+                            matching any rule. Defaults to Drop.
+                          type: string
+                        rules:
+                          description: Rules are evaluated in order, the action of
+                            the first matching rule is applied.
+                          items:
+                            description: FirewallRule matches traffic by the remote
+                              address, the protocol and the destination port.
+                            properties:
+                              action:
+                                description: Action applied to the matching traffic,
+                                  one of Accept or Drop.
+                                type: string
+                              cidr:
+                                description: CIDR of the remote addresses, the source
+                                  for ingress and the destination for egress traffic.
+                                  All addresses are matched if not specified.
+                                type: string
+                              port:
+                                description: Port is the destination port to match,
+                                  requires the TCP or UDP protocol. All ports are
+                                  matched if not specified.
+                                format: int32
+                                type: integer
+                              protocol:
+                                description: Protocol to match, one of TCP, UDP or
+                                  ICMP. All protocols are matched if not specified.
+                                type: string
+                            required:
+                            - action
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      type: object
+                    ingress:
+                      description: Ingress filters the traffic received by the virtual
+                        machine.
+                      properties:
+                        defaultAction:
+                          description: DefaultAction is applied to the traffic not
+                            matching any rule. Defaults to Drop.
+                          type: string
+                        rules:
+                          description: Rules are evaluated in order, the action of
+                            the first matching rule is applied.
+                          items:
+                            description: FirewallRule matches traffic by the remote
+                              address, the protocol and the destination port.
+                            properties:
+                              action:
+                                description: Action applied to the matching traffic,
+                                  one of Accept or Drop.
+                                type: string
+                              cidr:
+                                description: CIDR of the remote addresses, the source
+                                  for ingress and the destination for egress traffic.
+                                  All addresses are matched if not specified.
+                                type: string
+                              port:
+                                description: Port is the destination port to match,
+                                  requires the TCP or UDP protocol. All ports are
+                                  matched if not specified.
+                                format: int32
+                                type: integer
+                              protocol:
+                                description: Protocol to match, one of TCP, UDP or
+                                  ICMP. All protocols are matched if not specified.
+                                type: string
+                            required:
+                            - action
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      type: object
+                  type: object
                 hostname:
                   description: Specifies the hostname of the vmi If not specified,
                     the hostname will be set to the name of the vmi, if dhcp or cloud-init
@@ -16645,6 +23148,49 @@ var CRDsValidation map[string]string = map[string]string{
                   items:
                     description: Volume represents a named volume in a vmi.
                     properties:
+                      backup:
+                        description: Backup is attached to the virt launcher and is
+                          populated with the backup images of the vmi disks
+                        properties:
+                          backupName:
+                            description: BackupName is the name of the VirtualMachineBackup
+                              writing into the volume
+                            type: string
+                          checkpointName:
+                            description: CheckpointName is the name of the changed
+                              block tracking checkpoint created with the backup
+                            type: string
+                          claimName:
+                            description: 'claimName is the name of a PersistentVolumeClaim
+                              in the same namespace as the pod using this volume.
+                              More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                            type: string
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
+                          incrementalFrom:
+                            description: IncrementalFrom is the name of the checkpoint
+                              the backup is relative to. A full backup is taken when
+                              empty.
+                            type: string
+                          pull:
+                            description: Pull exposes the disks through the NBD server
+                              of the VMI instead of writing the backup images, the
+                              volume only holds the scratch images of the backup job.
+                            type: boolean
+                          pullCompleted:
+                            description: PullCompleted stops the NBD server of a pull
+                              mode backup and completes it
+                            type: boolean
+                          readOnly:
+                            description: readOnly Will force the ReadOnly setting
+                              in VolumeMounts. Default false.
+                            type: boolean
+                        required:
+                        - backupName
+                        - claimName
+                        type: object
                       cloudInitConfigDrive:
                         description: 'CloudInitConfigDrive represents a cloud-init
                           Config Drive user-data source. The Config Drive data will
@@ -17176,6 +23722,102 @@ var CRDsValidation map[string]string = map[string]string{
           required:
           - guest
           type: object
+        diskIOTune:
+          description: Optionally defines the IO throttling applied to the disks of
+            the VirtualMachineInstance. CD-ROMs are not throttled.
+          properties:
+            groupName:
+              description: GroupName places the disk in a throttling group, the disks
+                of a group share the same limits
+              type: string
+            readBytesSec:
+              description: ReadBytesSec is the read throughput limit in bytes per
+                second
+              format: int64
+              type: integer
+            readBytesSecMax:
+              description: ReadBytesSecMax is the read throughput allowed during bursts
+                in bytes per second
+              format: int64
+              type: integer
+            readBytesSecMaxLength:
+              description: ReadBytesSecMaxLength is the duration in seconds of a read
+                throughput burst
+              format: int64
+              type: integer
+            readIOPSSec:
+              description: ReadIOPSSec is the read I/O operations per second limit
+              format: int64
+              type: integer
+            readIOPSSecMax:
+              description: ReadIOPSSecMax is the read I/O operations per second allowed
+                during bursts
+              format: int64
+              type: integer
+            readIOPSSecMaxLength:
+              description: ReadIOPSSecMaxLength is the duration in seconds of a read
+                I/O operations burst
+              format: int64
+              type: integer
+            totalBytesSec:
+              description: TotalBytesSec is the total throughput limit in bytes per
+                second
+              format: int64
+              type: integer
+            totalBytesSecMax:
+              description: TotalBytesSecMax is the total throughput allowed during
+                bursts in bytes per second
+              format: int64
+              type: integer
+            totalBytesSecMaxLength:
+              description: TotalBytesSecMaxLength is the duration in seconds of a
+                total throughput burst
+              format: int64
+              type: integer
+            totalIOPSSec:
+              description: TotalIOPSSec is the total I/O operations per second limit
+              format: int64
+              type: integer
+            totalIOPSSecMax:
+              description: TotalIOPSSecMax is the total I/O operations per second
+                allowed during bursts
+              format: int64
+              type: integer
+            totalIOPSSecMaxLength:
+              description: TotalIOPSSecMaxLength is the duration in seconds of a total
+                I/O operations burst
+              format: int64
+              type: integer
+            writeBytesSec:
+              description: WriteBytesSec is the write throughput limit in bytes per
+                second
+              format: int64
+              type: integer
+            writeBytesSecMax:
+              description: WriteBytesSecMax is the write throughput allowed during
+                bursts in bytes per second
+              format: int64
+              type: integer
+            writeBytesSecMaxLength:
+              description: WriteBytesSecMaxLength is the duration in seconds of a
+                write throughput burst
+              format: int64
+              type: integer
+            writeIOPSSec:
+              description: WriteIOPSSec is the write I/O operations per second limit
+              format: int64
+              type: integer
+            writeIOPSSecMax:
+              description: WriteIOPSSecMax is the write I/O operations per second
+                allowed during bursts
+              format: int64
+              type: integer
+            writeIOPSSecMaxLength:
+              description: WriteIOPSSecMaxLength is the duration in seconds of a write
+                I/O operations burst
+              format: int64
+              type: integer
+          type: object
         gpus:
           description: Optionally defines any GPU devices associated with the instancetype.
           items:
@@ -17235,6 +23877,51 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        interfaceBandwidth:
+          description: Optionally defines the bandwidth limits applied to the network
+            interfaces of the VirtualMachineInstance. SR-IOV interfaces are not limited.
+          properties:
+            inbound:
+              description: Inbound limits the traffic received by the virtual machine
+              properties:
+                average:
+                  description: Average is the average rate in kibibytes per second
+                  format: int64
+                  type: integer
+                burst:
+                  description: Burst is the amount of kibibytes which can be sent
+                    in a single burst at the peak rate
+                  format: int64
+                  type: integer
+                peak:
+                  description: Peak is the maximum rate in kibibytes per second at
+                    which bursts are sent
+                  format: int64
+                  type: integer
+              required:
+              - average
+              type: object
+            outbound:
+              description: Outbound limits the traffic sent by the virtual machine
+              properties:
+                average:
+                  description: Average is the average rate in kibibytes per second
+                  format: int64
+                  type: integer
+                burst:
+                  description: Burst is the amount of kibibytes which can be sent
+                    in a single burst at the peak rate
+                  format: int64
+                  type: integer
+                peak:
+                  description: Peak is the maximum rate in kibibytes per second at
+                    which bursts are sent
+                  format: int64
+                  type: integer
+              required:
+              - average
+              type: object
+          type: object
         ioThreadsPolicy:
           description: Optionally defines the IOThreadsPolicy to be used by the instancetype.
           type: string
@@ -17344,6 +24031,11 @@ var CRDsValidation map[string]string = map[string]string{
             explicit zero and not specified. Defaults to 1.
           format: int32
           type: integer
+        scaleInPolicy:
+          description: ScaleInPolicy defines which VirtualMachines are removed first
+            when the pool is scaled in. The pool.kubevirt.io/deletion-cost annotation
+            of the VirtualMachines takes precedence over the policy. Defaults to Random.
+          type: string
         selector:
           description: Label selector for pods. Existing Poolss whose pods are selected
             by this will be the ones affected by this deployment.
@@ -17384,6 +24076,56 @@ var CRDsValidation map[string]string = map[string]string{
                 contains only "value". The requirements are ANDed.
               type: object
           type: object
+        updateStrategy:
+          description: UpdateStrategy defines how outdated VirtualMachineInstances
+            are updated.
+          properties:
+            rollingUpdate:
+              description: RollingUpdate controls the pace of the update of outdated
+                VirtualMachineInstances. When not set, all outdated VirtualMachineInstances
+                are restarted at once.
+              properties:
+                maxSurge:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: 'The maximum number of VirtualMachines that can be
+                    created over the desired replicas during the update. Value can
+                    be an absolute number (ex: 5) or a percentage of the desired replicas
+                    (ex: 10%). Absolute number is calculated from percentage by rounding
+                    up. Defaults to 0.'
+                  x-kubernetes-int-or-string: true
+                maxUnavailable:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: 'The maximum number of VirtualMachineInstances that
+                    can be unavailable during the update. Value can be an absolute
+                    number (ex: 5) or a percentage of the desired replicas (ex: 10%).
+                    Absolute number is calculated from percentage by rounding down.
+                    Defaults to 1.'
+                  x-kubernetes-int-or-string: true
+                metadataChangeMethod:
+                  description: MetadataChangeMethod is used when only the labels or
+                    annotations of the VirtualMachineInstance template changed. Defaults
+                    to Restart.
+                  type: string
+                partition:
+                  description: Partition indicates the ordinal at which the pool is
+                    partitioned for updates. VirtualMachines with an ordinal lower
+                    than the partition keep their revision. Defaults to 0.
+                  format: int32
+                  type: integer
+                specChangeMethod:
+                  description: SpecChangeMethod is used when the VirtualMachineInstance
+                    template spec changed. Defaults to Restart. LiveMigrate is only
+                    used when all the changes are propagated to a running VirtualMachineInstance,
+                    see the liveUpdateFeatures of the VirtualMachine, any other change
+                    restarts the VirtualMachineInstance. The VirtualMachineInstance
+                    is reported as updated once the migration succeeded.
+                  type: string
+              type: object
+          type: object
         virtualMachineTemplate:
           description: Template describes the VM that will be created.
           properties:
@@ -18171,6 +24913,14 @@ var CRDsValidation map[string]string = map[string]string{
                           format: int32
                           type: integer
                       type: object
+                    diskIOTune:
+                      description: DiskIOTune allows live updating the IO throttling
+                        of the virtual machine disks
+                      type: object
+                    interfaceBandwidth:
+                      description: InterfaceBandwidth allows live updating the bandwidth
+                        limits of the virtual machine interfaces
+                      type: object
                     memory:
                       description: MemoryLiveUpdateConfiguration defines the live
                         update memory features for the VirtualMachine
@@ -19682,6 +26432,118 @@ var CRDsValidation map[string]string = map[string]string{
                                           IO mode should be used. Supported values
                                           are: native, default, threads.'
                                         type: string
+                                      ioTune:
+                                        description: If specified, the IO of the disk
+                                          is throttled to the given limits.
+                                        properties:
+                                          groupName:
+                                            description: GroupName places the disk
+                                              in a throttling group, the disks of
+                                              a group share the same limits
+                                            type: string
+                                          readBytesSec:
+                                            description: ReadBytesSec is the read
+                                              throughput limit in bytes per second
+                                            format: int64
+                                            type: integer
+                                          readBytesSecMax:
+                                            description: ReadBytesSecMax is the read
+                                              throughput allowed during bursts in
+                                              bytes per second
+                                            format: int64
+                                            type: integer
+                                          readBytesSecMaxLength:
+                                            description: ReadBytesSecMaxLength is
+                                              the duration in seconds of a read throughput
+                                              burst
+                                            format: int64
+                                            type: integer
+                                          readIOPSSec:
+                                            description: ReadIOPSSec is the read I/O
+                                              operations per second limit
+                                            format: int64
+                                            type: integer
+                                          readIOPSSecMax:
+                                            description: ReadIOPSSecMax is the read
+                                              I/O operations per second allowed during
+                                              bursts
+                                            format: int64
+                                            type: integer
+                                          readIOPSSecMaxLength:
+                                            description: ReadIOPSSecMaxLength is the
+                                              duration in seconds of a read I/O operations
+                                              burst
+                                            format: int64
+                                            type: integer
+                                          totalBytesSec:
+                                            description: TotalBytesSec is the total
+                                              throughput limit in bytes per second
+                                            format: int64
+                                            type: integer
+                                          totalBytesSecMax:
+                                            description: TotalBytesSecMax is the total
+                                              throughput allowed during bursts in
+                                              bytes per second
+                                            format: int64
+                                            type: integer
+                                          totalBytesSecMaxLength:
+                                            description: TotalBytesSecMaxLength is
+                                              the duration in seconds of a total throughput
+                                              burst
+                                            format: int64
+                                            type: integer
+                                          totalIOPSSec:
+                                            description: TotalIOPSSec is the total
+                                              I/O operations per second limit
+                                            format: int64
+                                            type: integer
+                                          totalIOPSSecMax:
+                                            description: TotalIOPSSecMax is the total
+                                              I/O operations per second allowed during
+                                              bursts
+                                            format: int64
+                                            type: integer
+                                          totalIOPSSecMaxLength:
+                                            description: TotalIOPSSecMaxLength is
+                                              the duration in seconds of a total I/O
+                                              operations burst
+                                            format: int64
+                                            type: integer
+                                          writeBytesSec:
+                                            description: WriteBytesSec is the write
+                                              throughput limit in bytes per second
+                                            format: int64
+                                            type: integer
+                                          writeBytesSecMax:
+                                            description: WriteBytesSecMax is the write
+                                              throughput allowed during bursts in
+                                              bytes per second
+                                            format: int64
+                                            type: integer
+                                          writeBytesSecMaxLength:
+                                            description: WriteBytesSecMaxLength is
+                                              the duration in seconds of a write throughput
+                                              burst
+                                            format: int64
+                                            type: integer
+                                          writeIOPSSec:
+                                            description: WriteIOPSSec is the write
+                                              I/O operations per second limit
+                                            format: int64
+                                            type: integer
+                                          writeIOPSSecMax:
+                                            description: WriteIOPSSecMax is the write
+                                              I/O operations per second allowed during
+                                              bursts
+                                            format: int64
+                                            type: integer
+                                          writeIOPSSecMaxLength:
+                                            description: WriteIOPSSecMaxLength is
+                                              the duration in seconds of a write I/O
+                                              operations burst
+                                            format: int64
+                                            type: integer
+                                        type: object
                                       lun:
                                         description: Attach a volume as a LUN to the
                                           vmi.
@@ -19844,6 +26706,60 @@ var CRDsValidation map[string]string = map[string]string{
                                           value is required to be unique across all
                                           devices and be between 1 and (16*1024-1).
                                         type: integer
+                                      bandwidth:
+                                        description: If specified, the traffic of
+                                          the interface is shaped to the given limits.
+                                          Not supported for SR-IOV interfaces.
+                                        properties:
+                                          inbound:
+                                            description: Inbound limits the traffic
+                                              received by the virtual machine
+                                            properties:
+                                              average:
+                                                description: Average is the average
+                                                  rate in kibibytes per second
+                                                format: int64
+                                                type: integer
+                                              burst:
+                                                description: Burst is the amount of
+                                                  kibibytes which can be sent in a
+                                                  single burst at the peak rate
+                                                format: int64
+                                                type: integer
+                                              peak:
+                                                description: Peak is the maximum rate
+                                                  in kibibytes per second at which
+                                                  bursts are sent
+                                                format: int64
+                                                type: integer
+                                            required:
+                                            - average
+                                            type: object
+                                          outbound:
+                                            description: Outbound limits the traffic
+                                              sent by the virtual machine
+                                            properties:
+                                              average:
+                                                description: Average is the average
+                                                  rate in kibibytes per second
+                                                format: int64
+                                                type: integer
+                                              burst:
+                                                description: Burst is the amount of
+                                                  kibibytes which can be sent in a
+                                                  single burst at the peak rate
+                                                format: int64
+                                                type: integer
+                                              peak:
+                                                description: Peak is the maximum rate
+                                                  in kibibytes per second at which
+                                                  bursts are sent
+                                                format: int64
+                                                type: integer
+                                            required:
+                                            - average
+                                            type: object
+                                        type: object
                                       binding:
                                         description: 'Binding specifies the binding
                                           plugin that will be used to connect the
@@ -19990,8 +26906,11 @@ var CRDsValidation map[string]string = map[string]string{
                                       state:
                                         description: State represents the requested
                                           operational state of the interface. The
-                                          (only) value supported is 'absent', expressing
-                                          a request to remove the interface.
+                                          values supported are 'absent', expressing
+                                          a request to remove the interface, and 'up'
+                                          or 'down', setting the link state of the
+                                          interface seen by the guest. The link state
+                                          is 'up' if not specified.
                                         type: string
                                       tag:
                                         description: If specified, the virtual network
@@ -20018,6 +26937,28 @@ var CRDsValidation map[string]string = map[string]string{
                                     factors of the VirtualMachineInstance, like the
                                     number of guest CPUs.
                                   type: boolean
+                                panicDevice:
+                                  description: PanicDevice describes a panic device
+                                    which the guest uses to report a crash.
+                                  properties:
+                                    memoryDumpClaimName:
+                                      description: MemoryDumpClaimName is the name
+                                        of a PVC the memory of a crashed guest is
+                                        dumped to. The dump is done through the memory
+                                        dump subresource of the VirtualMachine and
+                                        requires the preserve action.
+                                      type: string
+                                    model:
+                                      description: Model of the panic device. Valid
+                                        values are pvpanic, isa, hyperv. Defaults
+                                        to pvpanic.
+                                      type: string
+                                    onCrash:
+                                      description: The action to take when the guest
+                                        reports a crash. Valid values are restart,
+                                        poweroff, preserve. Defaults to poweroff.
+                                      type: string
+                                  type: object
                                 rng:
                                   description: Whether to have random number generator
                                     from host
@@ -20349,6 +27290,21 @@ var CRDsValidation map[string]string = map[string]string{
                                           type: boolean
                                       type: object
                                   type: object
+                                generationID:
+                                  description: GenerationID exposes a VM Generation
+                                    ID device to the guest. It allows the guest to
+                                    detect a rollback, e.g. after a restore from a
+                                    snapshot.
+                                  properties:
+                                    uuid:
+                                      description: UUID reported to the guest as the
+                                        VM Generation ID. Defaults to an ID derived
+                                        from the VirtualMachine, which is stable across
+                                        reboots and migrations. It is regenerated
+                                        when the VirtualMachine is restored from a
+                                        snapshot or cloned.
+                                      type: string
+                                  type: object
                                 kernelBoot:
                                   description: Settings to set the kernel for booting.
                                   properties:
@@ -20513,27 +27469,120 @@ var CRDsValidation map[string]string = map[string]string{
                                     and "cpu".
                                   type: object
                               type: object
-                          required:
-                          - devices
+                          required:
+                          - devices
+                          type: object
+                        evictionStrategy:
+                          description: 'EvictionStrategy describes the strategy to
+                            follow when a node drain occurs. The possible options
+                            are: - "None": No action will be taken, according to the
+                            specified ''RunStrategy'' the VirtualMachine will be restarted
+                            or shutdown. - "LiveMigrate": the VirtualMachineInstance
+                            will be migrated instead of being shutdown. - "LiveMigrateIfPossible":
+                            the same as "LiveMigrate" but only if the VirtualMachine
+                            is Live-Migratable, otherwise it will behave as "None".
+                            - "External": the VirtualMachineInstance will be protected
+                            by a PDB and ''vmi.Status.EvacuationNodeName'' will be
+                            set on eviction. This is mainly useful for cluster-api-provider-kubevirt
+                            (capk) which needs a way for VMI''s to be blocked from
+                            eviction, yet signal capk that eviction has been called
+                            on the VMI so the capk controller can handle tearing the
+                            VMI down. Details can be found in the commit description
+                            https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.'
+                          type: string
+                        firewall:
+                          description: Firewall defines the rules filtering the traffic
+                            of the pod network interface. Only supported with the
+                            masquerade and passt bindings. The rules can be updated
+                            while the vmi is running.
+                          properties:
+                            egress:
+                              description: Egress filters the traffic sent by the
+                                virtual machine.
+                              properties:
+                                defaultAction:
+                                  description: DefaultAction is applied to the traffic
+                                    not matching any rule. Defaults to Drop.
+                                  type: string
+                                rules:
+                                  description: Rules are evaluated in order, the action
+                                    of the first matching rule is applied.
+                                  items:
+                                    description: FirewallRule matches traffic by the
+                                      remote address, the protocol and the destination
+                                      port.
+                                    properties:
+                                      action:
+                                        description: Action applied to the matching
+                                          traffic, one of Accept or Drop.
+                                        type: string
+                                      cidr:
+                                        description: CIDR of the remote addresses,
+                                          the source for ingress and the destination
+                                          for egress traffic. All addresses are matched
+                                          if not specified.
+                                        type: string
+                                      port:
+                                        description: Port is the destination port
+                                          to match, requires the TCP or UDP protocol.
+                                          All ports are matched if not specified.
+                                        format: int32
+                                        type: integer
+                                      protocol:
+                                        description: Protocol to match, one of TCP,
+                                          UDP or ICMP. All protocols are matched if
+                                          not specified.
+                                        type: string
+                                    required:
+                                    - action
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              type: object
+                            ingress:
+                              description: Ingress filters the traffic received by
+                                the virtual machine.
+                              properties:
+                                defaultAction:
+                                  description: DefaultAction is applied to the traffic
+                                    not matching any rule. Defaults to Drop.
+                                  type: string
+                                rules:
+                                  description: Rules are evaluated in order, the action
+                                    of the first matching rule is applied.
+                                  items:
+                                    description: FirewallRule matches traffic by the
+                                      remote address, the protocol and the destination
+                                      port.
+                                    properties:
+                                      action:
+                                        description: Action applied to the matching
+                                          traffic, one of Accept or Drop.
+                                        type: string
+                                      cidr:
+                                        description: CIDR of the remote addresses,
+                                          the source for ingress and the destination
+                                          for egress traffic. All addresses are matched
+                                          if not specified.
+                                        type: string
+                                      port:
+                                        description: Port is the destination port
+                                          to match, requires the TCP or UDP protocol.
+                                          All ports are matched if not specified.
+                                        format: int32
+                                        type: integer
+                                      protocol:
+                                        description: Protocol to match, one of TCP,
+                                          UDP or ICMP. All protocols are matched if
+                                          not specified.
+                                        type: string
+                                    required:
+                                    - action
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              type: object
                           type: object
-                        evictionStrategy:
-                          description: 'EvictionStrategy describes the strategy to
-                            follow when a node drain occurs. The possible options
-                            are: - "None": No action will be taken, according to the
-                            specified ''RunStrategy'' the VirtualMachine will be restarted
-                            or shutdown. - "LiveMigrate": the VirtualMachineInstance
-                            will be migrated instead of being shutdown. - "LiveMigrateIfPossible":
-                            the same as "LiveMigrate" but only if the VirtualMachine
-                            is Live-Migratable, otherwise it will behave as "None".
-                            - "External": the VirtualMachineInstance will be protected
-                            by a PDB and ''vmi.Status.EvacuationNodeName'' will be
-                            set on eviction. This is mainly useful for cluster-api-provider-kubevirt
-                            (capk) which needs a way for VMI''s to be blocked from
-                            eviction, yet signal capk that eviction has been called
-                            on the VMI so the capk controller can handle tearing the
-                            VMI down. Details can be found in the commit description
-                            https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.'
-                          type: string
                         hostname:
                           description: Specifies the hostname of the vmi If not specified,
                             the hostname will be set to the name of the vmi, if dhcp
@@ -21122,6 +28171,52 @@ var CRDsValidation map[string]string = map[string]string{
                           items:
                             description: Volume represents a named volume in a vmi.
                             properties:
+                              backup:
+                                description: Backup is attached to the virt launcher
+                                  and is populated with the backup images of the vmi
+                                  disks
+                                properties:
+                                  backupName:
+                                    description: BackupName is the name of the VirtualMachineBackup
+                                      writing into the volume
+                                    type: string
+                                  checkpointName:
+                                    description: CheckpointName is the name of the
+                                      changed block tracking checkpoint created with
+                                      the backup
+                                    type: string
+                                  claimName:
+                                    description: 'claimName is the name of a PersistentVolumeClaim
+                                      in the same namespace as the pod using this
+                                      volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                    type: string
+                                  hotpluggable:
+                                    description: Hotpluggable indicates whether the
+                                      volume can be hotplugged and hotunplugged.
+                                    type: boolean
+                                  incrementalFrom:
+                                    description: IncrementalFrom is the name of the
+                                      checkpoint the backup is relative to. A full
+                                      backup is taken when empty.
+                                    type: string
+                                  pull:
+                                    description: Pull exposes the disks through the
+                                      NBD server of the VMI instead of writing the
+                                      backup images, the volume only holds the scratch
+                                      images of the backup job.
+                                    type: boolean
+                                  pullCompleted:
+                                    description: PullCompleted stops the NBD server
+                                      of a pull mode backup and completes it
+                                    type: boolean
+                                  readOnly:
+                                    description: readOnly Will force the ReadOnly
+                                      setting in VolumeMounts. Default false.
+                                    type: boolean
+                                required:
+                                - backupName
+                                - claimName
+                                type: object
                               cloudInitConfigDrive:
                                 description: 'CloudInitConfigDrive represents a cloud-init
                                   Config Drive user-data source. The Config Drive
@@ -21556,6 +28651,10 @@ var CRDsValidation map[string]string = map[string]string{
                       - domain
                       type: object
                   type: object
+                updateVolumesStrategy:
+                  description: UpdateVolumesStrategy is the strategy to apply on volumes
+                    updates
+                  type: string
               required:
               - template
               type: object
@@ -21591,6 +28690,10 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        currentRevision:
+          description: CurrentRevision is the revision which all VirtualMachines matched
+            when the last update completed.
+          type: string
         labelSelector:
           description: Canonical form of the label selector for HPA which consumes
             it through the scale subresource.
@@ -21601,6 +28704,15 @@ var CRDsValidation map[string]string = map[string]string{
         replicas:
           format: int32
           type: integer
+        updateRevision:
+          description: UpdateRevision is the revision of the pool spec the VirtualMachines
+            are updated to.
+          type: string
+        updatedReplicas:
+          description: UpdatedReplicas is the number of VirtualMachines, and their
+            VirtualMachineInstances, which match the updateRevision.
+          format: int32
+          type: integer
       type: object
   required:
   - spec
@@ -23273,6 +30385,14 @@ var CRDsValidation map[string]string = map[string]string{
                               format: int32
                               type: integer
                           type: object
+                        diskIOTune:
+                          description: DiskIOTune allows live updating the IO throttling
+                            of the virtual machine disks
+                          type: object
+                        interfaceBandwidth:
+                          description: InterfaceBandwidth allows live updating the
+                            bandwidth limits of the virtual machine interfaces
+                          type: object
                         memory:
                           description: MemoryLiveUpdateConfiguration defines the live
                             update memory features for the VirtualMachine
@@ -24866,6 +31986,121 @@ var CRDsValidation map[string]string = map[string]string{
                                               disk IO mode should be used. Supported
                                               values are: native, default, threads.'
                                             type: string
+                                          ioTune:
+                                            description: If specified, the IO of the
+                                              disk is throttled to the given limits.
+                                            properties:
+                                              groupName:
+                                                description: GroupName places the
+                                                  disk in a throttling group, the
+                                                  disks of a group share the same
+                                                  limits
+                                                type: string
+                                              readBytesSec:
+                                                description: ReadBytesSec is the read
+                                                  throughput limit in bytes per second
+                                                format: int64
+                                                type: integer
+                                              readBytesSecMax:
+                                                description: ReadBytesSecMax is the
+                                                  read throughput allowed during bursts
+                                                  in bytes per second
+                                                format: int64
+                                                type: integer
+                                              readBytesSecMaxLength:
+                                                description: ReadBytesSecMaxLength
+                                                  is the duration in seconds of a
+                                                  read throughput burst
+                                                format: int64
+                                                type: integer
+                                              readIOPSSec:
+                                                description: ReadIOPSSec is the read
+                                                  I/O operations per second limit
+                                                format: int64
+                                                type: integer
+                                              readIOPSSecMax:
+                                                description: ReadIOPSSecMax is the
+                                                  read I/O operations per second allowed
+                                                  during bursts
+                                                format: int64
+                                                type: integer
+                                              readIOPSSecMaxLength:
+                                                description: ReadIOPSSecMaxLength
+                                                  is the duration in seconds of a
+                                                  read I/O operations burst
+                                                format: int64
+                                                type: integer
+                                              totalBytesSec:
+                                                description: TotalBytesSec is the
+                                                  total throughput limit in bytes
+                                                  per second
+                                                format: int64
+                                                type: integer
+                                              totalBytesSecMax:
+                                                description: TotalBytesSecMax is the
+                                                  total throughput allowed during
+                                                  bursts in bytes per second
+                                                format: int64
+                                                type: integer
+                                              totalBytesSecMaxLength:
+                                                description: TotalBytesSecMaxLength
+                                                  is the duration in seconds of a
+                                                  total throughput burst
+                                                format: int64
+                                                type: integer
+                                              totalIOPSSec:
+                                                description: TotalIOPSSec is the total
+                                                  I/O operations per second limit
+                                                format: int64
+                                                type: integer
+                                              totalIOPSSecMax:
+                                                description: TotalIOPSSecMax is the
+                                                  total I/O operations per second
+                                                  allowed during bursts
+                                                format: int64
+                                                type: integer
+                                              totalIOPSSecMaxLength:
+                                                description: TotalIOPSSecMaxLength
+                                                  is the duration in seconds of a
+                                                  total I/O operations burst
+                                                format: int64
+                                                type: integer
+                                              writeBytesSec:
+                                                description: WriteBytesSec is the
+                                                  write throughput limit in bytes
+                                                  per second
+                                                format: int64
+                                                type: integer
+                                              writeBytesSecMax:
+                                                description: WriteBytesSecMax is the
+                                                  write throughput allowed during
+                                                  bursts in bytes per second
+                                                format: int64
+                                                type: integer
+                                              writeBytesSecMaxLength:
+                                                description: WriteBytesSecMaxLength
+                                                  is the duration in seconds of a
+                                                  write throughput burst
+                                                format: int64
+                                                type: integer
+                                              writeIOPSSec:
+                                                description: WriteIOPSSec is the write
+                                                  I/O operations per second limit
+                                                format: int64
+                                                type: integer
+                                              writeIOPSSecMax:
+                                                description: WriteIOPSSecMax is the
+                                                  write I/O operations per second
+                                                  allowed during bursts
+                                                format: int64
+                                                type: integer
+                                              writeIOPSSecMaxLength:
+                                                description: WriteIOPSSecMaxLength
+                                                  is the duration in seconds of a
+                                                  write I/O operations burst
+                                                format: int64
+                                                type: integer
+                                            type: object
                                           lun:
                                             description: Attach a volume as a LUN
                                               to the vmi.
@@ -25037,6 +32272,62 @@ var CRDsValidation map[string]string = map[string]string{
                                               be unique across all devices and be
                                               between 1 and (16*1024-1).
                                             type: integer
+                                          bandwidth:
+                                            description: If specified, the traffic
+                                              of the interface is shaped to the given
+                                              limits. Not supported for SR-IOV interfaces.
+                                            properties:
+                                              inbound:
+                                                description: Inbound limits the traffic
+                                                  received by the virtual machine
+                                                properties:
+                                                  average:
+                                                    description: Average is the average
+                                                      rate in kibibytes per second
+                                                    format: int64
+                                                    type: integer
+                                                  burst:
+                                                    description: Burst is the amount
+                                                      of kibibytes which can be sent
+                                                      in a single burst at the peak
+                                                      rate
+                                                    format: int64
+                                                    type: integer
+                                                  peak:
+                                                    description: Peak is the maximum
+                                                      rate in kibibytes per second
+                                                      at which bursts are sent
+                                                    format: int64
+                                                    type: integer
+                                                required:
+                                                - average
+                                                type: object
+                                              outbound:
+                                                description: Outbound limits the traffic
+                                                  sent by the virtual machine
+                                                properties:
+                                                  average:
+                                                    description: Average is the average
+                                                      rate in kibibytes per second
+                                                    format: int64
+                                                    type: integer
+                                                  burst:
+                                                    description: Burst is the amount
+                                                      of kibibytes which can be sent
+                                                      in a single burst at the peak
+                                                      rate
+                                                    format: int64
+                                                    type: integer
+                                                  peak:
+                                                    description: Peak is the maximum
+                                                      rate in kibibytes per second
+                                                      at which bursts are sent
+                                                    format: int64
+                                                    type: integer
+                                                required:
+                                                - average
+                                                type: object
+                                            type: object
                                           binding:
                                             description: 'Binding specifies the binding
                                               plugin that will be used to connect
@@ -25188,8 +32479,11 @@ var CRDsValidation map[string]string = map[string]string{
                                           state:
                                             description: State represents the requested
                                               operational state of the interface.
-                                              The (only) value supported is 'absent',
-                                              expressing a request to remove the interface.
+                                              The values supported are 'absent', expressing
+                                              a request to remove the interface, and
+                                              'up' or 'down', setting the link state
+                                              of the interface seen by the guest.
+                                              The link state is 'up' if not specified.
                                             type: string
                                           tag:
                                             description: If specified, the virtual
@@ -25217,6 +32511,29 @@ var CRDsValidation map[string]string = map[string]string{
                                         factors of the VirtualMachineInstance, like
                                         the number of guest CPUs.
                                       type: boolean
+                                    panicDevice:
+                                      description: PanicDevice describes a panic device
+                                        which the guest uses to report a crash.
+                                      properties:
+                                        memoryDumpClaimName:
+                                          description: MemoryDumpClaimName is the
+                                            name of a PVC the memory of a crashed
+                                            guest is dumped to. The dump is done through
+                                            the memory dump subresource of the VirtualMachine
+                                            and requires the preserve action.
+                                          type: string
+                                        model:
+                                          description: Model of the panic device.
+                                            Valid values are pvpanic, isa, hyperv.
+                                            Defaults to pvpanic.
+                                          type: string
+                                        onCrash:
+                                          description: The action to take when the
+                                            guest reports a crash. Valid values are
+                                            restart, poweroff, preserve. Defaults
+                                            to poweroff.
+                                          type: string
+                                      type: object
                                     rng:
                                       description: Whether to have random number generator
                                         from host
@@ -25559,6 +32876,21 @@ var CRDsValidation map[string]string = map[string]string{
                                               type: boolean
                                           type: object
                                       type: object
+                                    generationID:
+                                      description: GenerationID exposes a VM Generation
+                                        ID device to the guest. It allows the guest
+                                        to detect a rollback, e.g. after a restore
+                                        from a snapshot.
+                                      properties:
+                                        uuid:
+                                          description: UUID reported to the guest
+                                            as the VM Generation ID. Defaults to an
+                                            ID derived from the VirtualMachine, which
+                                            is stable across reboots and migrations.
+                                            It is regenerated when the VirtualMachine
+                                            is restored from a snapshot or cloned.
+                                          type: string
+                                      type: object
                                     kernelBoot:
                                       description: Settings to set the kernel for
                                         booting.
@@ -25750,6 +33082,101 @@ var CRDsValidation map[string]string = map[string]string{
                                 tearing the VMI down. Details can be found in the
                                 commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.'
                               type: string
+                            firewall:
+                              description: Firewall defines the rules filtering the
+                                traffic of the pod network interface. Only supported
+                                with the masquerade and passt bindings. The rules
+                                can be updated while the vmi is running.
+                              properties:
+                                egress:
+                                  description: Egress filters the traffic sent by
+                                    the virtual machine.
+                                  properties:
+                                    defaultAction:
+                                      description: DefaultAction is applied to the
+                                        traffic not matching any rule. Defaults to
+                                        Drop.
+                                      type: string
+                                    rules:
+                                      description: Rules are evaluated in order, the
+                                        action of the first matching rule is applied.
+                                      items:
+                                        description: FirewallRule matches traffic
+                                          by the remote address, the protocol and
+                                          the destination port.
+                                        properties:
+                                          action:
+                                            description: Action applied to the matching
+                                              traffic, one of Accept or Drop.
+                                            type: string
+                                          cidr:
+                                            description: CIDR of the remote addresses,
+                                              the source for ingress and the destination
+                                              for egress traffic. All addresses are
+                                              matched if not specified.
+                                            type: string
+                                          port:
+                                            description: Port is the destination port
+                                              to match, requires the TCP or UDP protocol.
+                                              All ports are matched if not specified.
+                                            format: int32
+                                            type: integer
+                                          protocol:
+                                            description: Protocol to match, one of
+                                              TCP, UDP or ICMP. All protocols are
+                                              matched if not specified.
+                                            type: string
+                                        required:
+                                        - action
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  type: object
+                                ingress:
+                                  description: Ingress filters the traffic received
+                                    by the virtual machine.
+                                  properties:
+                                    defaultAction:
+                                      description: DefaultAction is applied to the
+                                        traffic not matching any rule. Defaults to
+                                        Drop.
+                                      type: string
+                                    rules:
+                                      description: Rules are evaluated in order, the
+                                        action of the first matching rule is applied.
+                                      items:
+                                        description: FirewallRule matches traffic
+                                          by the remote address, the protocol and
+                                          the destination port.
+                                        properties:
+                                          action:
+                                            description: Action applied to the matching
+                                              traffic, one of Accept or Drop.
+                                            type: string
+                                          cidr:
+                                            description: CIDR of the remote addresses,
+                                              the source for ingress and the destination
+                                              for egress traffic. All addresses are
+                                              matched if not specified.
+                                            type: string
+                                          port:
+                                            description: Port is the destination port
+                                              to match, requires the TCP or UDP protocol.
+                                              All ports are matched if not specified.
+                                            format: int32
+                                            type: integer
+                                          protocol:
+                                            description: Protocol to match, one of
+                                              TCP, UDP or ICMP. All protocols are
+                                              matched if not specified.
+                                            type: string
+                                        required:
+                                        - action
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  type: object
+                              type: object
                             hostname:
                               description: Specifies the hostname of the vmi If not
                                 specified, the hostname will be set to the name of
@@ -26362,6 +33789,52 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: Volume represents a named volume in a
                                   vmi.
                                 properties:
+                                  backup:
+                                    description: Backup is attached to the virt launcher
+                                      and is populated with the backup images of the
+                                      vmi disks
+                                    properties:
+                                      backupName:
+                                        description: BackupName is the name of the
+                                          VirtualMachineBackup writing into the volume
+                                        type: string
+                                      checkpointName:
+                                        description: CheckpointName is the name of
+                                          the changed block tracking checkpoint created
+                                          with the backup
+                                        type: string
+                                      claimName:
+                                        description: 'claimName is the name of a PersistentVolumeClaim
+                                          in the same namespace as the pod using this
+                                          volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                        type: string
+                                      hotpluggable:
+                                        description: Hotpluggable indicates whether
+                                          the volume can be hotplugged and hotunplugged.
+                                        type: boolean
+                                      incrementalFrom:
+                                        description: IncrementalFrom is the name of
+                                          the checkpoint the backup is relative to.
+                                          A full backup is taken when empty.
+                                        type: string
+                                      pull:
+                                        description: Pull exposes the disks through
+                                          the NBD server of the VMI instead of writing
+                                          the backup images, the volume only holds
+                                          the scratch images of the backup job.
+                                        type: boolean
+                                      pullCompleted:
+                                        description: PullCompleted stops the NBD server
+                                          of a pull mode backup and completes it
+                                        type: boolean
+                                      readOnly:
+                                        description: readOnly Will force the ReadOnly
+                                          setting in VolumeMounts. Default false.
+                                        type: boolean
+                                    required:
+                                    - backupName
+                                    - claimName
+                                    type: object
                                   cloudInitConfigDrive:
                                     description: 'CloudInitConfigDrive represents
                                       a cloud-init Config Drive user-data source.
@@ -26814,6 +34287,10 @@ var CRDsValidation map[string]string = map[string]string{
                           - domain
                           type: object
                       type: object
+                    updateVolumesStrategy:
+                      description: UpdateVolumesStrategy is the strategy to apply
+                        on volumes updates
+                      type: string
                   required:
                   - template
                   type: object
@@ -26821,6 +34298,42 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Status holds the current state of the controller and
                     brief information about its associated VirtualMachineInstance
                   properties:
+                    backupCheckpoint:
+                      description: BackupCheckpoint is the latest changed block tracking
+                        checkpoint created by a backup of the VirtualMachine
+                      nullable: true
+                      properties:
+                        backupName:
+                          description: BackupName is the name of the VirtualMachineBackup
+                            which created the checkpoint
+                          type: string
+                        creationTimestamp:
+                          description: CreationTimestamp is the time when the checkpoint
+                            was created
+                          format: date-time
+                          type: string
+                        name:
+                          description: Name is the name of the checkpoint in the domain
+                          type: string
+                        vmiUID:
+                          description: VMIUID is the UID of the VirtualMachineInstance
+                            the checkpoint was created in. The dirty bitmaps of the
+                            checkpoint are persisted in the disk images, the checkpoint
+                            is redefined from them once the VirtualMachine is restarted.
+                          type: string
+                        volumes:
+                          description: Volumes is the list of volumes tracked by a
+                            dirty bitmap of the checkpoint
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - backupName
+                      - creationTimestamp
+                      - name
+                      - vmiUID
+                      type: object
                     conditions:
                       description: Hold the state information of the VirtualMachine
                         and its VirtualMachineInstance
@@ -27072,6 +34585,115 @@ var CRDsValidation map[string]string = map[string]string{
                                       mode should be used. Supported values are: native,
                                       default, threads.'
                                     type: string
+                                  ioTune:
+                                    description: If specified, the IO of the disk
+                                      is throttled to the given limits.
+                                    properties:
+                                      groupName:
+                                        description: GroupName places the disk in
+                                          a throttling group, the disks of a group
+                                          share the same limits
+                                        type: string
+                                      readBytesSec:
+                                        description: ReadBytesSec is the read throughput
+                                          limit in bytes per second
+                                        format: int64
+                                        type: integer
+                                      readBytesSecMax:
+                                        description: ReadBytesSecMax is the read throughput
+                                          allowed during bursts in bytes per second
+                                        format: int64
+                                        type: integer
+                                      readBytesSecMaxLength:
+                                        description: ReadBytesSecMaxLength is the
+                                          duration in seconds of a read throughput
+                                          burst
+                                        format: int64
+                                        type: integer
+                                      readIOPSSec:
+                                        description: ReadIOPSSec is the read I/O operations
+                                          per second limit
+                                        format: int64
+                                        type: integer
+                                      readIOPSSecMax:
+                                        description: ReadIOPSSecMax is the read I/O
+                                          operations per second allowed during bursts
+                                        format: int64
+                                        type: integer
+                                      readIOPSSecMaxLength:
+                                        description: ReadIOPSSecMaxLength is the duration
+                                          in seconds of a read I/O operations burst
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        description: TotalBytesSec is the total throughput
+                                          limit in bytes per second
+                                        format: int64
+                                        type: integer
+                                      totalBytesSecMax:
+                                        description: TotalBytesSecMax is the total
+                                          throughput allowed during bursts in bytes
+                                          per second
+                                        format: int64
+                                        type: integer
+                                      totalBytesSecMaxLength:
+                                        description: TotalBytesSecMaxLength is the
+                                          duration in seconds of a total throughput
+                                          burst
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSec:
+                                        description: TotalIOPSSec is the total I/O
+                                          operations per second limit
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSecMax:
+                                        description: TotalIOPSSecMax is the total
+                                          I/O operations per second allowed during
+                                          bursts
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSecMaxLength:
+                                        description: TotalIOPSSecMaxLength is the
+                                          duration in seconds of a total I/O operations
+                                          burst
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        description: WriteBytesSec is the write throughput
+                                          limit in bytes per second
+                                        format: int64
+                                        type: integer
+                                      writeBytesSecMax:
+                                        description: WriteBytesSecMax is the write
+                                          throughput allowed during bursts in bytes
+                                          per second
+                                        format: int64
+                                        type: integer
+                                      writeBytesSecMaxLength:
+                                        description: WriteBytesSecMaxLength is the
+                                          duration in seconds of a write throughput
+                                          burst
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSec:
+                                        description: WriteIOPSSec is the write I/O
+                                          operations per second limit
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSecMax:
+                                        description: WriteIOPSSecMax is the write
+                                          I/O operations per second allowed during
+                                          bursts
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSecMaxLength:
+                                        description: WriteIOPSSecMaxLength is the
+                                          duration in seconds of a write I/O operations
+                                          burst
+                                        format: int64
+                                        type: integer
+                                    type: object
                                   lun:
                                     description: Attach a volume as a LUN to the vmi.
                                     properties:
@@ -27219,6 +34841,164 @@ var CRDsValidation map[string]string = map[string]string{
                         - name
                         type: object
                       type: array
+                    volumeUpdateState:
+                      description: VolumeUpdateState contains the information about
+                        the volumes set updates related to the volumeUpdateStrategy
+                      nullable: true
+                      properties:
+                        volumeMigrationState:
+                          description: VolumeMigrationState tracks the progress and
+                            the result of a volume migration
+                          nullable: true
+                          properties:
+                            endTimestamp:
+                              description: EndTimestamp is the time the volume migration
+                                completed
+                              format: date-time
+                              nullable: true
+                              type: string
+                            failureReason:
+                              description: FailureReason reports the reason of the
+                                last failure of the volume migration
+                              type: string
+                            manualRecoveryRequired:
+                              description: ManualRecoveryRequired indicates if the
+                                VirtualMachine needs to be restarted or the volume
+                                set reverted to the source volumes by the user
+                              type: boolean
+                            migratedVolumes:
+                              description: MigratedVolumes lists the source and destination
+                                volumes of the migration
+                              items:
+                                description: StorageMigratedVolumeInfo tracks the
+                                  information about the source and destination volumes
+                                  during the volume migration
+                                properties:
+                                  destinationPVCInfo:
+                                    description: DestinationPVCInfo contains the information
+                                      about the destination PVC
+                                    properties:
+                                      accessModes:
+                                        description: 'AccessModes contains the desired
+                                          access modes the volume should have. More
+                                          info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1'
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      capacity:
+                                        additionalProperties:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                        description: Capacity represents the capacity
+                                          set on the corresponding PVC status
+                                        type: object
+                                      claimName:
+                                        description: ClaimName is the name of the
+                                          PVC
+                                        type: string
+                                      filesystemOverhead:
+                                        description: Percentage of filesystem's size
+                                          to be reserved when resizing the PVC
+                                        pattern: ^(0(?:\.\d{1,3})?|1)$
+                                        type: string
+                                      preallocated:
+                                        description: Preallocated indicates if the
+                                          PVC's storage is preallocated or not
+                                        type: boolean
+                                      requests:
+                                        additionalProperties:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                        description: Requests represents the resources
+                                          requested by the corresponding PVC spec
+                                        type: object
+                                      volumeMode:
+                                        description: VolumeMode defines what type
+                                          of volume is required by the claim. Value
+                                          of Filesystem is implied when not included
+                                          in claim spec.
+                                        type: string
+                                    type: object
+                                  sourcePVCInfo:
+                                    description: SourcePVCInfo contains the information
+                                      about the source PVC
+                                    properties:
+                                      accessModes:
+                                        description: 'AccessModes contains the desired
+                                          access modes the volume should have. More
+                                          info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1'
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      capacity:
+                                        additionalProperties:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                        description: Capacity represents the capacity
+                                          set on the corresponding PVC status
+                                        type: object
+                                      claimName:
+                                        description: ClaimName is the name of the
+                                          PVC
+                                        type: string
+                                      filesystemOverhead:
+                                        description: Percentage of filesystem's size
+                                          to be reserved when resizing the PVC
+                                        pattern: ^(0(?:\.\d{1,3})?|1)$
+                                        type: string
+                                      preallocated:
+                                        description: Preallocated indicates if the
+                                          PVC's storage is preallocated or not
+                                        type: boolean
+                                      requests:
+                                        additionalProperties:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                        description: Requests represents the resources
+                                          requested by the corresponding PVC spec
+                                        type: object
+                                      volumeMode:
+                                        description: VolumeMode defines what type
+                                          of volume is required by the claim. Value
+                                          of Filesystem is implied when not included
+                                          in claim spec.
+                                        type: string
+                                    type: object
+                                  volumeName:
+                                    description: VolumeName is the name of the volume
+                                      that is being migrated
+                                    type: string
+                                required:
+                                - volumeName
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            phase:
+                              description: Phase is the current phase of the volume
+                                migration
+                              type: string
+                            startTimestamp:
+                              description: StartTimestamp is the time the volume migration
+                                started
+                              format: date-time
+                              nullable: true
+                              type: string
+                          type: object
+                      type: object
                   type: object
               type: object
           type: object
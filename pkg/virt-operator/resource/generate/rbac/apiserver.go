@@ -89,6 +89,28 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "delete", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"nodes",
+				},
+				Verbs: []string{
+					"get", "list",
+				},
+			},
+			{
+				APIGroups: []string{
+					"policy",
+				},
+				Resources: []string{
+					"poddisruptionbudgets",
+				},
+				Verbs: []string{
+					"list",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -201,6 +223,7 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshots",
 					"virtualmachinerestores",
 					"virtualmachinesnapshotcontents",
+					"virtualmachinebackups",
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -24,7 +24,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
+	"kubevirt.io/kubevirt/pkg/network/macpool"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/util/schedule"
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -111,6 +115,27 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ar *admissionv1.AdmissionReview) *
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
 
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.MigrationConfiguration, newKV.Spec.Configuration.MigrationConfiguration) {
+		results = append(results,
+			validateMigrationConfiguration(field.NewPath("spec").Child("configuration", "migrations"), newKV.Spec.Configuration.MigrationConfiguration)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.WorkloadUpdateStrategy.MaintenanceWindows, newKV.Spec.WorkloadUpdateStrategy.MaintenanceWindows) {
+		results = append(results,
+			validateMaintenanceWindows(field.NewPath("spec").Child("workloadUpdateStrategy", "maintenanceWindows"), newKV.Spec.WorkloadUpdateStrategy.MaintenanceWindows)...)
+	}
+
+	if newNetworkConfig := newKV.Spec.Configuration.NetworkConfiguration; newNetworkConfig != nil {
+		var currMacAddressPool *v1.MacAddressPool
+		if currKV.Spec.Configuration.NetworkConfiguration != nil {
+			currMacAddressPool = currKV.Spec.Configuration.NetworkConfiguration.MacAddressPool
+		}
+		if !equality.Semantic.DeepEqual(currMacAddressPool, newNetworkConfig.MacAddressPool) {
+			results = append(results,
+				validateMacAddressPool(field.NewPath("spec").Child("configuration", "network", "macAddressPool"), newNetworkConfig.MacAddressPool)...)
+		}
+	}
+
 	response := validating_webhooks.NewAdmissionResponse(results)
 
 	if featureGatesChanged(&currKV.Spec, &newKV.Spec) {
@@ -485,3 +510,78 @@ func validateGuestToRequestHeadroom(ratioStrPtr *string) (causes []metav1.Status
 
 	return
 }
+
+func validateMigrationConfiguration(field *field.Path, migrationConfig *v1.MigrationConfiguration) (causes []metav1.StatusCause) {
+	if migrationConfig == nil {
+		return
+	}
+
+	causes = append(causes, migrationutils.ValidateMultifdChannels(field.Child("multifdChannels"), migrationConfig.MultifdChannels)...)
+
+	if migrationConfig.MaxDowntime != nil && migrationConfig.MaxDowntime.Duration < time.Millisecond {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be at least 1ms",
+			Field:   field.Child("maxDowntime").String(),
+		})
+	}
+
+	causes = append(causes, migrationutils.ValidateCompression(field.Child("compression"), migrationConfig.Compression)...)
+
+	return
+}
+
+func validateMaintenanceWindows(field *field.Path, windows []v1.WorkloadUpdateMaintenanceWindow) (causes []metav1.StatusCause) {
+	for i, window := range windows {
+		if _, err := schedule.Parse(window.Schedule); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   field.Index(i).Child("schedule").String(),
+			})
+		}
+
+		if window.Duration.Duration < time.Minute {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must be at least 1m",
+				Field:   field.Index(i).Child("duration").String(),
+			})
+		}
+
+		if window.BatchMigrationSize != nil && *window.BatchMigrationSize < 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must be at least 1",
+				Field:   field.Index(i).Child("batchMigrationSize").String(),
+			})
+		}
+
+		if window.BatchEvictionSize != nil && *window.BatchEvictionSize < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must not be negative",
+				Field:   field.Index(i).Child("batchEvictionSize").String(),
+			})
+		}
+	}
+
+	return
+}
+
+func validateMacAddressPool(field *field.Path, pool *v1.MacAddressPool) (causes []metav1.StatusCause) {
+	if pool == nil {
+		return nil
+	}
+	for i, r := range pool.Ranges {
+		if _, err := macpool.ParseRange(r); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   field.Child("ranges").Index(i).String(),
+			})
+		}
+	}
+
+	return
+}
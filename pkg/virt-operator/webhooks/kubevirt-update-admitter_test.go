@@ -23,6 +23,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"kubevirt.io/kubevirt/pkg/virt-config/deprecation"
 
@@ -68,6 +69,85 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		}, []string{vmProfileField.Child("customProfile", "runtimeDefaultProfile").String(), vmProfileField.Child("customProfile", "localhostProfile").String()}),
 	)
 
+	DescribeTable("validateMigrationConfiguration", func(migrationConfig *v1.MigrationConfiguration, expectedFields []string) {
+		causes := validateMigrationConfiguration(test, migrationConfig)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("without migration configuration", nil, nil),
+		Entry("with multifd channels and compression", &v1.MigrationConfiguration{
+			MultifdChannels: pointer.Uint32(8),
+			Compression:     &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.Int32(20)},
+		}, nil),
+		Entry("with zero multifd channels", &v1.MigrationConfiguration{
+			MultifdChannels: pointer.Uint32(0),
+		}, []string{test.Child("multifdChannels").String()}),
+		Entry("with too many multifd channels", &v1.MigrationConfiguration{
+			MultifdChannels: pointer.Uint32(256),
+		}, []string{test.Child("multifdChannels").String()}),
+		Entry("with an out of range zlib level", &v1.MigrationConfiguration{
+			Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZlib, Level: pointer.Int32(10)},
+		}, []string{test.Child("compression", "level").String()}),
+		Entry("with an unknown compression method", &v1.MigrationConfiguration{
+			Compression: &v1.MigrationCompression{Method: "lz4"},
+		}, []string{test.Child("compression", "method").String()}),
+		Entry("with a max downtime", &v1.MigrationConfiguration{
+			MaxDowntime: &metav1.Duration{Duration: 100 * time.Millisecond},
+		}, nil),
+		Entry("with a zero max downtime", &v1.MigrationConfiguration{
+			MaxDowntime: &metav1.Duration{},
+		}, []string{test.Child("maxDowntime").String()}),
+	)
+
+	DescribeTable("validateMaintenanceWindows", func(window v1.WorkloadUpdateMaintenanceWindow, expectedFields []string) {
+		causes := validateMaintenanceWindows(test, []v1.WorkloadUpdateMaintenanceWindow{window})
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("with a valid window", v1.WorkloadUpdateMaintenanceWindow{
+			Schedule:           "0 2 * * 6",
+			Duration:           metav1.Duration{Duration: 4 * time.Hour},
+			BatchMigrationSize: pointer.Int(5),
+			BatchEvictionSize:  pointer.Int(0),
+		}, nil),
+		Entry("with an invalid schedule", v1.WorkloadUpdateMaintenanceWindow{
+			Schedule: "0 2 * *",
+			Duration: metav1.Duration{Duration: time.Hour},
+		}, []string{test.Index(0).Child("schedule").String()}),
+		Entry("without a duration", v1.WorkloadUpdateMaintenanceWindow{
+			Schedule: "0 2 * * *",
+		}, []string{test.Index(0).Child("duration").String()}),
+		Entry("with invalid batch sizes", v1.WorkloadUpdateMaintenanceWindow{
+			Schedule:           "0 2 * * *",
+			Duration:           metav1.Duration{Duration: time.Hour},
+			BatchMigrationSize: pointer.Int(0),
+			BatchEvictionSize:  pointer.Int(-1),
+		}, []string{test.Index(0).Child("batchMigrationSize").String(), test.Index(0).Child("batchEvictionSize").String()}),
+	)
+
+	DescribeTable("validateMacAddressPool", func(pool *v1.MacAddressPool, expectedFields []string) {
+		causes := validateMacAddressPool(test, pool)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("without a pool", nil, nil),
+		Entry("with valid ranges", &v1.MacAddressPool{Ranges: []v1.MacAddressRange{
+			{Start: "02:00:00:00:00:00", End: "02:00:00:00:ff:ff"},
+			{Start: "0a:00:00:00:00:00", End: "0a:00:00:00:00:00"},
+		}}, nil),
+		Entry("with invalid ranges", &v1.MacAddressPool{Ranges: []v1.MacAddressRange{
+			{Start: "02:00:00:00:00:00", End: "02:00:00:00:ff:ff"},
+			{Start: "02:00:00:00:00", End: "02:00:00:00:00:01"},
+			{Start: "02:00:00:00:00:01", End: "02:00:00:00:00:00"},
+		}}, []string{test.Child("ranges").Index(1).String(), test.Child("ranges").Index(2).String()}),
+	)
+
 	DescribeTable("test validateCustomizeComponents", func(cc v1.CustomizeComponents, expectedCauses int) {
 		causes := validateCustomizeComponents(cc)
 		Expect(causes).To(HaveLen(expectedCauses))
@@ -5,6 +5,7 @@ import (
 
 	"k8s.io/client-go/tools/clientcmd"
 
+	"kubevirt.io/kubevirt/pkg/virtctl/adm/drainstatus"
 	"kubevirt.io/kubevirt/pkg/virtctl/adm/logverbosity"
 
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
@@ -24,6 +25,7 @@ func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	}
 
 	cmd.AddCommand(logverbosity.NewCommand(clientConfig))
+	cmd.AddCommand(drainstatus.NewCommand(clientConfig))
 
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package drainstatus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const COMMAND_DRAIN_STATUS = "drain-status"
+
+type command struct {
+	clientConfig clientcmd.ClientConfig
+}
+
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "drain-status (NODE)",
+		Short:   "Show the evacuation plan and progress of the VirtualMachineInstances on a drained node.",
+		Example: usage(),
+		Args:    templates.ExactArgs(COMMAND_DRAIN_STATUS, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := command{clientConfig: clientConfig}
+			return c.run(cmd, args[0])
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Show which VirtualMachineInstances are still running on node 'node01' and how they are evacuated:
+  {{ProgramName}} adm drain-status node01`
+}
+
+func (c *command) run(cmd *cobra.Command, nodeName string) error {
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	node, err := virtClient.CoreV1().Nodes().Get(context.Background(), nodeName, k8smetav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting node %s: %v", nodeName, err)
+	}
+
+	data, exists := node.Annotations[v1.NodeEvacuationStatusAnnotation]
+	if !exists {
+		cmd.Printf("Node %s is not being drained\n", nodeName)
+		return nil
+	}
+	status := &v1.NodeEvacuationStatus{}
+	if err := json.Unmarshal([]byte(data), status); err != nil {
+		return fmt.Errorf("error decoding the evacuation status of node %s: %v", nodeName, err)
+	}
+
+	return printStatus(cmd.OutOrStdout(), nodeName, status)
+}
+
+func printStatus(out io.Writer, nodeName string, status *v1.NodeEvacuationStatus) error {
+	fmt.Fprintf(out, "Node %s: evacuation %s\n", nodeName, status.Phase)
+	if len(status.VirtualMachineInstances) == 0 {
+		return nil
+	}
+
+	counts := map[v1.EvacuationAction]int{}
+	for _, vmi := range status.VirtualMachineInstances {
+		counts[vmi.Action]++
+	}
+	fmt.Fprintf(out, "%d VirtualMachineInstances left: %d live migrate, %d shutdown, %d blocked, %d external\n\n",
+		len(status.VirtualMachineInstances),
+		counts[v1.EvacuationActionLiveMigrate],
+		counts[v1.EvacuationActionShutdown],
+		counts[v1.EvacuationActionBlocked],
+		counts[v1.EvacuationActionExternal],
+	)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tNAME\tACTION\tPROGRESS\tMIGRATION\tREASON")
+	for _, vmi := range status.VirtualMachineInstances {
+		migration := "-"
+		if vmi.MigrationName != "" {
+			migration = fmt.Sprintf("%s (%s)", vmi.MigrationName, vmi.MigrationPhase)
+		}
+		reason := vmi.Reason
+		if reason == "" {
+			reason = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", vmi.Namespace, vmi.Name, vmi.Action, vmi.Progress, migration, reason)
+	}
+	return w.Flush()
+}
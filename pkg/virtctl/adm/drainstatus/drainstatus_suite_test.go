@@ -0,0 +1,11 @@
+package drainstatus_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestDrainStatus(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,97 @@
+package drainstatus_test
+
+import (
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Drain status", func() {
+	const nodeName = "node01"
+
+	var kubeClient *fake.Clientset
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		kubeClient = fake.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+	})
+
+	createNode := func(status *v1.NodeEvacuationStatus) {
+		node := &k8sv1.Node{ObjectMeta: k8smetav1.ObjectMeta{Name: nodeName}}
+		if status != nil {
+			data, err := json.Marshal(status)
+			Expect(err).ToNot(HaveOccurred())
+			node.Annotations = map[string]string{v1.NodeEvacuationStatusAnnotation: string(data)}
+		}
+		Expect(kubeClient.Tracker().Add(node)).To(Succeed())
+	}
+
+	It("should fail without a node", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand("adm", "drain-status")
+		Expect(cmd()).To(MatchError(ContainSubstring("argument validation failed")))
+	})
+
+	It("should fail if the node does not exist", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand("adm", "drain-status", nodeName)
+		Expect(cmd()).To(MatchError(ContainSubstring("error getting node node01")))
+	})
+
+	It("should report a node which is not drained", func() {
+		createNode(nil)
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("adm", "drain-status", nodeName)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal("Node node01 is not being drained\n"))
+	})
+
+	It("should report a completed evacuation", func() {
+		createNode(&v1.NodeEvacuationStatus{Phase: v1.NodeEvacuationCompleted})
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("adm", "drain-status", nodeName)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal("Node node01: evacuation Completed\n"))
+	})
+
+	It("should render the planned action and progress of every VMI", func() {
+		createNode(&v1.NodeEvacuationStatus{
+			Phase: v1.NodeEvacuationInProgress,
+			VirtualMachineInstances: []v1.VirtualMachineInstanceEvacuation{
+				{
+					Namespace: "default",
+					Name:      "blocked",
+					Action:    v1.EvacuationActionBlocked,
+					Reason:    "DisksNotLiveMigratable",
+					Progress:  v1.EvacuationPending,
+				},
+				{
+					Namespace:      "default",
+					Name:           "migrating",
+					Action:         v1.EvacuationActionLiveMigrate,
+					Progress:       v1.EvacuationMigrating,
+					MigrationName:  "kubevirt-evacuation-abcde",
+					MigrationPhase: v1.MigrationRunning,
+				},
+			},
+		})
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("adm", "drain-status", nodeName)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal(`Node node01: evacuation InProgress
+2 VirtualMachineInstances left: 1 live migrate, 0 shutdown, 1 blocked, 0 external
+
+NAMESPACE  NAME       ACTION       PROGRESS   MIGRATION                            REASON
+default    blocked    Blocked      Pending    -                                    DisksNotLiveMigratable
+default    migrating  LiveMigrate  Migrating  kubevirt-evacuation-abcde (Running)  -
+`))
+	})
+})
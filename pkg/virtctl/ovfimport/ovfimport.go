@@ -0,0 +1,348 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package ovfimport
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/ovf"
+	"kubevirt.io/kubevirt/pkg/virtctl/imageupload"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	ImportOVF = "import-ovf"
+
+	FileFlag           = "file"
+	NameFlag           = "name"
+	DiskBaseURLFlag    = "disk-base-url"
+	StorageClassFlag   = "storage-class"
+	ExtractDirFlag     = "extract-dir"
+	DryRunFlag         = "dry-run"
+	UploadProxyURLFlag = "uploadproxy-url"
+	InsecureFlag       = "insecure"
+
+	ovaExtension = ".ova"
+)
+
+// UploadFunc uploads the image to an existing upload DataVolume
+type UploadFunc func(clientConfig clientcmd.ClientConfig, dvName, imagePath, uploadProxyURL string, insecure bool) error
+
+// Upload streams the disk images to the DataVolumes, it can be replaced by the unit tests
+var Upload UploadFunc = uploadWithImageUpload
+
+type importOVF struct {
+	clientConfig   clientcmd.ClientConfig
+	file           string
+	name           string
+	diskBaseURL    string
+	storageClass   string
+	extractDir     string
+	dryRun         bool
+	uploadProxyURL string
+	insecure       bool
+}
+
+// NewImportOVFCommand returns a command creating a VirtualMachine and its DataVolumes from an OVF descriptor or OVA bundle
+func NewImportOVFCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := importOVF{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:     ImportOVF,
+		Short:   "Create a VirtualMachine from an OVF descriptor or an OVA bundle, and upload its disk images.",
+		Example: usage(),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return c.run(cmd)
+		},
+	}
+	cmd.Flags().StringVar(&c.file, FileFlag, "", "Path to the OVF descriptor (.ovf) or OVA bundle (.ova).")
+	cmd.Flags().StringVar(&c.name, NameFlag, "", "Name of the VirtualMachine. Defaults to the name of the virtual system in the descriptor.")
+	cmd.Flags().StringVar(&c.diskBaseURL, DiskBaseURLFlag, "", "Base URL the disk files of the descriptor are served from. If not set, the DataVolumes wait for an upload.")
+	cmd.Flags().StringVar(&c.storageClass, StorageClassFlag, "", "The storage class of the DataVolumes.")
+	cmd.Flags().StringVar(&c.extractDir, ExtractDirFlag, "", "Directory the disk images of an OVA bundle are extracted to for the upload. Defaults to the directory of the bundle.")
+	cmd.Flags().BoolVar(&c.dryRun, DryRunFlag, false, "Only print the VirtualMachine manifest, without creating the VirtualMachine and the DataVolumes or uploading the disk images.")
+	cmd.Flags().StringVar(&c.uploadProxyURL, UploadProxyURLFlag, "", "The URL of the cdi-upload proxy service.")
+	cmd.Flags().BoolVar(&c.insecure, InsecureFlag, false, "Allow insecure server connections to the cdi-upload proxy when using HTTPS.")
+	if err := cmd.MarkFlagRequired(FileFlag); err != nil {
+		panic(err)
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Create a VirtualMachine from an OVA bundle, the disk images are extracted next to the bundle
+  # and uploaded to the DataVolumes of the VirtualMachine:
+  {{ProgramName}} import-ovf --file my-vm.ova
+
+  # Extract the disk images of an OVA bundle to another directory:
+  {{ProgramName}} import-ovf --file my-vm.ova --extract-dir /var/tmp/my-vm
+
+  # Create a VirtualMachine from an OVF descriptor whose disk files are imported from a web server:
+  {{ProgramName}} import-ovf --file my-vm.ovf --disk-base-url https://images.example.com/my-vm/
+
+  # Only print the VirtualMachine manifest:
+  {{ProgramName}} import-ovf --file my-vm.ova --name my-vm --storage-class fast --dry-run`
+}
+
+func (c *importOVF) run(cmd *cobra.Command) error {
+	envelope, err := readEnvelope(c.file)
+	if err != nil {
+		return err
+	}
+
+	vm, disks, err := ovf.ToVirtualMachine(envelope, c.name)
+	if err != nil {
+		return err
+	}
+
+	imagePaths := map[string]string{}
+	for _, disk := range disks {
+		imagePaths[disk.FileName] = filepath.Join(filepath.Dir(c.file), disk.FileName)
+	}
+	if c.diskBaseURL == "" && isOVA(c.file) {
+		extractDir := c.extractDir
+		if extractDir == "" {
+			extractDir = filepath.Dir(c.file)
+		}
+		if imagePaths, err = extractDisks(c.file, extractDir, disks); err != nil {
+			return err
+		}
+	}
+
+	for _, disk := range disks {
+		source, err := c.dataVolumeSource(disk)
+		if err != nil {
+			return err
+		}
+		dvt := v1.DataVolumeTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: disk.DataVolumeName,
+			},
+			Spec: cdiv1.DataVolumeSpec{
+				Source: source,
+				Storage: &cdiv1.StorageSpec{
+					Resources: k8sv1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{
+							k8sv1.ResourceStorage: disk.Capacity,
+						},
+					},
+				},
+			},
+		}
+		if c.storageClass != "" {
+			dvt.Spec.Storage.StorageClassName = &c.storageClass
+		}
+		vm.Spec.DataVolumeTemplates = append(vm.Spec.DataVolumeTemplates, dvt)
+
+		if c.dryRun && c.diskBaseURL == "" {
+			cmd.PrintErrf("Upload %s with: virtctl image-upload dv %s --no-create --image-path=%s\n",
+				disk.FileName, disk.DataVolumeName, imagePaths[disk.FileName])
+		}
+	}
+
+	if c.dryRun {
+		vmBytes, err := yaml.Marshal(vm)
+		if err != nil {
+			return err
+		}
+		cmd.Print(string(vmBytes))
+		return nil
+	}
+
+	if err := c.create(cmd, vm); err != nil {
+		return err
+	}
+	if c.diskBaseURL != "" {
+		return nil
+	}
+	for _, disk := range disks {
+		if err := Upload(c.clientConfig, disk.DataVolumeName, imagePaths[disk.FileName], c.uploadProxyURL, c.insecure); err != nil {
+			return fmt.Errorf("failed to upload %s: %v", disk.FileName, err)
+		}
+	}
+	return nil
+}
+
+// create creates the DataVolumes of the VirtualMachine, so that the disk images can be uploaded
+// before the VirtualMachine is started, and then the VirtualMachine, which adopts them
+func (c *importOVF) create(cmd *cobra.Command, vm *v1.VirtualMachine) error {
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	for _, dvt := range vm.Spec.DataVolumeTemplates {
+		dv := &cdiv1.DataVolume{
+			ObjectMeta: *dvt.ObjectMeta.DeepCopy(),
+			Spec:       *dvt.Spec.DeepCopy(),
+		}
+		dv.Namespace = namespace
+		if _, err := virtClient.CdiClient().CdiV1beta1().DataVolumes(namespace).Create(context.Background(), dv, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create DataVolume %s: %v", dv.Name, err)
+		}
+		cmd.Printf("DataVolume %s/%s created\n", namespace, dv.Name)
+	}
+
+	vm.Namespace = namespace
+	if _, err := virtClient.VirtualMachine(namespace).Create(context.Background(), vm); err != nil {
+		return fmt.Errorf("failed to create VirtualMachine %s: %v", vm.Name, err)
+	}
+	cmd.Printf("VirtualMachine %s/%s created\n", namespace, vm.Name)
+	return nil
+}
+
+// uploadWithImageUpload runs the image-upload command against the existing DataVolume
+func uploadWithImageUpload(clientConfig clientcmd.ClientConfig, dvName, imagePath, uploadProxyURL string, insecure bool) error {
+	args := []string{"dv", dvName, "--no-create", "--image-path=" + imagePath}
+	if uploadProxyURL != "" {
+		args = append(args, "--uploadproxy-url="+uploadProxyURL)
+	}
+	if insecure {
+		args = append(args, "--insecure")
+	}
+	uploadCmd := imageupload.NewImageUploadCommand(clientConfig)
+	uploadCmd.SetArgs(args)
+	uploadCmd.SilenceUsage = true
+	return uploadCmd.Execute()
+}
+
+func (c *importOVF) dataVolumeSource(disk ovf.ImportedDisk) (*cdiv1.DataVolumeSource, error) {
+	if c.diskBaseURL == "" {
+		return &cdiv1.DataVolumeSource{Upload: &cdiv1.DataVolumeSourceUpload{}}, nil
+	}
+	base, err := url.Parse(c.diskBaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", DiskBaseURLFlag, err)
+	}
+	base.Path = path.Join(base.Path, disk.FileName)
+	return &cdiv1.DataVolumeSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: base.String()}}, nil
+}
+
+// readEnvelope reads the OVF descriptor from the given file, for OVA bundles
+// the first .ovf entry of the tar archive is used
+func readEnvelope(file string) (*ovf.Envelope, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if !isOVA(file) {
+		return ovf.Decode(f)
+	}
+
+	tr := tar.NewReader(f)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("%s does not contain an OVF descriptor", file)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(path.Ext(header.Name), ovf.Extension) {
+			return ovf.Decode(tr)
+		}
+	}
+}
+
+// extractDisks extracts the disk images of the OVA bundle to the given directory and returns their paths.
+// Existing files are not overwritten.
+func extractDisks(file, dir string, disks []ovf.ImportedDisk) (map[string]string, error) {
+	imagePaths := map[string]string{}
+	for _, disk := range disks {
+		if disk.FileName != filepath.Base(disk.FileName) || disk.FileName == ".." {
+			return nil, fmt.Errorf("invalid disk file name %s in the OVF descriptor", disk.FileName)
+		}
+		imagePaths[disk.FileName] = ""
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	tr := tar.NewReader(f)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		extracted, exists := imagePaths[header.Name]
+		if !exists || extracted != "" || header.Typeflag != tar.TypeReg {
+			continue
+		}
+		imagePath := filepath.Join(dir, header.Name)
+		if err := extractFile(tr, imagePath); err != nil {
+			return nil, err
+		}
+		imagePaths[header.Name] = imagePath
+	}
+
+	for name, imagePath := range imagePaths {
+		if imagePath == "" {
+			return nil, fmt.Errorf("%s does not contain the disk image %s", file, name)
+		}
+	}
+	return imagePaths, nil
+}
+
+func extractFile(r io.Reader, target string) error {
+	f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to extract the disk image: %v", err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to extract the disk image %s: %v", target, err)
+	}
+	return f.Close()
+}
+
+func isOVA(file string) bool {
+	return strings.EqualFold(path.Ext(file), ovaExtension)
+}
@@ -0,0 +1,308 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package ovfimport_test
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	k8sclientcmd "k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	fakecdiclient "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/ovf"
+	"kubevirt.io/kubevirt/pkg/virtctl/ovfimport"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("import-ovf", func() {
+	var descriptor []byte
+
+	BeforeEach(func() {
+		guest := resource.MustParse("2Gi")
+		vm := &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "source-vm"},
+			Spec: v1.VirtualMachineSpec{
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							CPU:    &v1.CPU{Sockets: 2},
+							Memory: &v1.Memory{Guest: &guest},
+							Devices: v1.Devices{
+								Disks: []v1.Disk{
+									{Name: "rootdisk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI}}},
+								},
+								Interfaces: []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()},
+							},
+						},
+						Networks: []v1.Network{*v1.DefaultPodNetwork()},
+					},
+				},
+			},
+		}
+		envelope, err := ovf.NewEnvelope(vm, []ovf.DiskImage{{VolumeName: "rootdisk", FileName: "root.img", Size: 5 << 30}})
+		Expect(err).ToNot(HaveOccurred())
+		descriptor, err = ovf.Marshal(envelope)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	writeOVF := func() string {
+		file := filepath.Join(GinkgoT().TempDir(), "source-vm.ovf")
+		Expect(os.WriteFile(file, descriptor, 0644)).To(Succeed())
+		return file
+	}
+
+	writeOVAWithFiles := func(files map[string][]byte) string {
+		file := filepath.Join(GinkgoT().TempDir(), "source-vm.ova")
+		f, err := os.Create(file)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		tw := tar.NewWriter(f)
+		Expect(tw.WriteHeader(&tar.Header{Name: "source-vm.ovf", Mode: 0644, Size: int64(len(descriptor))})).To(Succeed())
+		_, err = tw.Write(descriptor)
+		Expect(err).ToNot(HaveOccurred())
+		for name, content := range files {
+			Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))})).To(Succeed())
+			_, err = tw.Write(content)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(tw.Close()).To(Succeed())
+		return file
+	}
+
+	writeOVA := func() string {
+		return writeOVAWithFiles(map[string][]byte{"root.img": []byte("data")})
+	}
+
+	runCommand := func(args ...string) (*v1.VirtualMachine, error) {
+		args = append([]string{ovfimport.ImportOVF, "--" + ovfimport.DryRunFlag}, args...)
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(args...)()
+		if err != nil {
+			return nil, err
+		}
+		vm := &v1.VirtualMachine{}
+		Expect(yaml.Unmarshal(out, vm)).To(Succeed())
+		return vm, nil
+	}
+
+	It("should require a file", func() {
+		_, err := runCommand()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail on a missing file", func() {
+		_, err := runCommand(setFlag(ovfimport.FileFlag, "/does/not/exist.ovf")...)
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("should create a VirtualMachine with upload DataVolumes", func(writeFile func() string) {
+		vm, err := runCommand(setFlag(ovfimport.FileFlag, writeFile())...)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(vm.Kind).To(Equal("VirtualMachine"))
+		Expect(vm.Name).To(Equal("source-vm"))
+		Expect(vm.Spec.Template.Spec.Domain.CPU.Sockets).To(BeEquivalentTo(2))
+		Expect(vm.Spec.Template.Spec.Domain.Memory.Guest.Value()).To(BeEquivalentTo(2 << 30))
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Disks).To(ConsistOf(HaveField("DiskDevice.Disk.Bus", v1.DiskBusSCSI)))
+		Expect(vm.Spec.Template.Spec.Volumes).To(ConsistOf(HaveField("DataVolume.Name", "source-vm-rootdisk")))
+
+		Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(1))
+		dvt := vm.Spec.DataVolumeTemplates[0]
+		Expect(dvt.Name).To(Equal("source-vm-rootdisk"))
+		Expect(dvt.Spec.Source.Upload).ToNot(BeNil())
+		Expect(dvt.Spec.Storage.Resources.Requests[k8sv1.ResourceStorage]).To(Equal(resource.MustParse("5Gi")))
+		Expect(dvt.Spec.Storage.StorageClassName).To(BeNil())
+	},
+		Entry("from an OVF descriptor", writeOVF),
+		Entry("from an OVA bundle", writeOVA),
+	)
+
+	It("should use the given name, storage class and disk base URL", func() {
+		flags := setFlag(ovfimport.FileFlag, writeOVF())
+		flags = append(flags, setFlag(ovfimport.NameFlag, "imported")...)
+		flags = append(flags, setFlag(ovfimport.StorageClassFlag, "fast")...)
+		flags = append(flags, setFlag(ovfimport.DiskBaseURLFlag, "https://images.example.com/vms/")...)
+		vm, err := runCommand(flags...)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(vm.Name).To(Equal("imported"))
+		Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(1))
+		dvt := vm.Spec.DataVolumeTemplates[0]
+		Expect(dvt.Name).To(Equal("imported-rootdisk"))
+		Expect(dvt.Spec.Source.HTTP.URL).To(Equal("https://images.example.com/vms/root.img"))
+		Expect(*dvt.Spec.Storage.StorageClassName).To(Equal("fast"))
+	})
+
+	It("should extract the disk images of an OVA bundle", func() {
+		file := writeOVA()
+		_, err := runCommand(setFlag(ovfimport.FileFlag, file)...)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.ReadFile(filepath.Join(filepath.Dir(file), "root.img"))).To(Equal([]byte("data")))
+	})
+
+	It("should extract the disk images of an OVA bundle to the given directory", func() {
+		extractDir := GinkgoT().TempDir()
+		flags := setFlag(ovfimport.FileFlag, writeOVA())
+		flags = append(flags, setFlag(ovfimport.ExtractDirFlag, extractDir)...)
+		_, err := runCommand(flags...)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.ReadFile(filepath.Join(extractDir, "root.img"))).To(Equal([]byte("data")))
+	})
+
+	It("should not extract the disk images of an OVA bundle when they are served", func() {
+		file := writeOVA()
+		flags := setFlag(ovfimport.FileFlag, file)
+		flags = append(flags, setFlag(ovfimport.DiskBaseURLFlag, "https://images.example.com/vms/")...)
+		_, err := runCommand(flags...)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(filepath.Join(filepath.Dir(file), "root.img")).ToNot(BeAnExistingFile())
+	})
+
+	It("should not overwrite an existing disk image", func() {
+		file := writeOVA()
+		Expect(os.WriteFile(filepath.Join(filepath.Dir(file), "root.img"), []byte("existing"), 0644)).To(Succeed())
+
+		_, err := runCommand(setFlag(ovfimport.FileFlag, file)...)
+		Expect(err).To(MatchError(ContainSubstring("failed to extract the disk image")))
+		Expect(os.ReadFile(filepath.Join(filepath.Dir(file), "root.img"))).To(Equal([]byte("existing")))
+	})
+
+	It("should fail on an OVA without the disk images of the descriptor", func() {
+		_, err := runCommand(setFlag(ovfimport.FileFlag, writeOVAWithFiles(nil))...)
+		Expect(err).To(MatchError(ContainSubstring("does not contain the disk image root.img")))
+	})
+
+	It("should fail on an OVA without descriptor", func() {
+		file := filepath.Join(GinkgoT().TempDir(), "empty.ova")
+		f, err := os.Create(file)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tar.NewWriter(f).Close()).To(Succeed())
+		Expect(f.Close()).To(Succeed())
+
+		_, err = runCommand(setFlag(ovfimport.FileFlag, file)...)
+		Expect(err).To(MatchError(ContainSubstring("does not contain an OVF descriptor")))
+	})
+
+	Context("without dry run", func() {
+		type upload struct {
+			dvName    string
+			imagePath string
+		}
+
+		var (
+			cdiClient   *fakecdiclient.Clientset
+			vmInterface *kubecli.MockVirtualMachineInterface
+			createdVM   *v1.VirtualMachine
+			uploads     []upload
+		)
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+			kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+			vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+			cdiClient = fakecdiclient.NewSimpleClientset()
+
+			kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+
+			createdVM = nil
+			uploads = nil
+			originalUpload := ovfimport.Upload
+			DeferCleanup(func() {
+				ovfimport.Upload = originalUpload
+			})
+			ovfimport.Upload = func(_ k8sclientcmd.ClientConfig, dvName, imagePath, _ string, _ bool) error {
+				Expect(createdVM).ToNot(BeNil(), "the disk images should be uploaded after the VirtualMachine was created")
+				uploads = append(uploads, upload{dvName: dvName, imagePath: imagePath})
+				return nil
+			}
+		})
+
+		expectVMCreate := func() {
+			vmInterface.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+				createdVM = vm
+				return vm, nil
+			})
+		}
+
+		runCreateCommand := func(args ...string) error {
+			return clientcmd.NewRepeatableVirtctlCommand(append([]string{ovfimport.ImportOVF}, args...)...)()
+		}
+
+		It("should create the DataVolumes and the VirtualMachine and upload the disk images of an OVA bundle", func() {
+			expectVMCreate()
+			file := writeOVA()
+			Expect(runCreateCommand(setFlag(ovfimport.FileFlag, file)...)).To(Succeed())
+
+			dv, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Get(context.Background(), "source-vm-rootdisk", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dv.Spec.Source.Upload).ToNot(BeNil())
+
+			Expect(createdVM.Name).To(Equal("source-vm"))
+			Expect(createdVM.Namespace).To(Equal(metav1.NamespaceDefault))
+			Expect(createdVM.Spec.DataVolumeTemplates).To(ConsistOf(HaveField("Name", "source-vm-rootdisk")))
+
+			Expect(uploads).To(ConsistOf(upload{dvName: "source-vm-rootdisk", imagePath: filepath.Join(filepath.Dir(file), "root.img")}))
+		})
+
+		It("should not upload the disk images when they are imported from the disk base URL", func() {
+			expectVMCreate()
+			flags := setFlag(ovfimport.FileFlag, writeOVF())
+			flags = append(flags, setFlag(ovfimport.DiskBaseURLFlag, "https://images.example.com/vms/")...)
+			Expect(runCreateCommand(flags...)).To(Succeed())
+
+			dv, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Get(context.Background(), "source-vm-rootdisk", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dv.Spec.Source.HTTP.URL).To(Equal("https://images.example.com/vms/root.img"))
+			Expect(createdVM).ToNot(BeNil())
+			Expect(uploads).To(BeEmpty())
+		})
+
+		It("should not create the VirtualMachine when a DataVolume cannot be created", func() {
+			_, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Create(context.Background(), &cdiv1.DataVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "source-vm-rootdisk", Namespace: metav1.NamespaceDefault},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = runCreateCommand(setFlag(ovfimport.FileFlag, writeOVA())...)
+			Expect(err).To(MatchError(ContainSubstring("failed to create DataVolume source-vm-rootdisk")))
+			Expect(uploads).To(BeEmpty())
+		})
+	})
+})
+
+func setFlag(flag, value string) []string {
+	return []string{fmt.Sprintf("--%s", flag), value}
+}
@@ -22,32 +22,61 @@ package vm
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
-const COMMAND_MIGRATE = "migrate"
+const (
+	COMMAND_MIGRATE = "migrate"
+
+	nodeArg = "node"
+)
+
+var migrateNode string
 
 func NewMigrateCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "migrate (VM)",
 		Short:   "Migrate a virtual machine.",
-		Example: usage(COMMAND_MIGRATE),
-		Args:    templates.ExactArgs("migrate", 1),
+		Example: migrateUsage(),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if migrateNode != "" {
+				return templates.ExactArgs("migrate", 0)(cmd, args)
+			}
+			return templates.ExactArgs("migrate", 1)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c := Command{command: COMMAND_MIGRATE, clientConfig: clientConfig}
+			if migrateNode != "" {
+				return c.migrateCheckNodeRun()
+			}
 			return c.migrateRun(args)
 		},
 	}
-	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage+" The pre-flight checks of the live migration are reported as well.")
+	cmd.Flags().StringVar(&migrateNode, nodeArg, "", "Report for all virtual machine instances of the given node whether they can be live migrated. Requires --dry-run.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
+func migrateUsage() string {
+	return usage(COMMAND_MIGRATE) + `
+
+  # Check whether a virtual machine called 'myvm' can be migrated:
+  {{ProgramName}} migrate --dry-run myvm
+
+  # Check whether all virtual machine instances running on node 'node01' can be migrated:
+  {{ProgramName}} migrate --dry-run --node node01`
+}
+
 func (o *Command) migrateRun(args []string) error {
 	vmiName := args[0]
 
@@ -58,6 +87,17 @@ func (o *Command) migrateRun(args []string) error {
 
 	dryRunOption := setDryRunOption(dryRun)
 
+	if dryRun {
+		report, err := virtClient.VirtualMachineInstance(namespace).MigrateCheck(context.Background(), vmiName)
+		if err != nil {
+			return fmt.Errorf("Error checking VirtualMachine %s for migration: %v", vmiName, err)
+		}
+		printMigrateCheck(report)
+		if !report.Migratable {
+			return fmt.Errorf("VirtualMachine %s can not be migrated", vmiName)
+		}
+	}
+
 	err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmiName, &v1.MigrateOptions{DryRun: dryRunOption})
 	if err != nil {
 		return fmt.Errorf("Error migrating VirtualMachine %v", err)
@@ -67,3 +107,57 @@ func (o *Command) migrateRun(args []string) error {
 
 	return nil
 }
+
+func (o *Command) migrateCheckNodeRun() error {
+	if !dryRun {
+		return fmt.Errorf("--%s can only be used together with --%s", nodeArg, dryRunArg)
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(o.clientConfig)
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	vmis, err := virtClient.VirtualMachineInstance(k8sv1.NamespaceAll).List(context.Background(), &metav1.ListOptions{
+		LabelSelector: v1.NodeNameLabel + "=" + migrateNode,
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing VirtualMachineInstances of node %s: %v", migrateNode, err)
+	}
+
+	notMigratable := 0
+	for _, vmi := range vmis.Items {
+		report, err := virtClient.VirtualMachineInstance(vmi.Namespace).MigrateCheck(context.Background(), vmi.Name)
+		if err != nil {
+			return fmt.Errorf("Error checking VirtualMachineInstance %s/%s for migration: %v", vmi.Namespace, vmi.Name, err)
+		}
+		printMigrateCheck(report)
+		if !report.Migratable {
+			notMigratable++
+		}
+	}
+
+	if notMigratable > 0 {
+		return fmt.Errorf("%d of %d VirtualMachineInstances on node %s can not be migrated", notMigratable, len(vmis.Items), migrateNode)
+	}
+	fmt.Printf("All %d VirtualMachineInstances on node %s can be migrated\n", len(vmis.Items), migrateNode)
+	return nil
+}
+
+func printMigrateCheck(report *v1.VirtualMachineInstanceMigrateCheck) {
+	result := "migratable"
+	if !report.Migratable {
+		result = "not migratable"
+	}
+	fmt.Printf("VirtualMachineInstance %s/%s on node %s: %s\n", report.Namespace, report.Name, report.NodeName, result)
+	for _, check := range report.Checks {
+		if check.Passed {
+			fmt.Printf("  %-20s passed\n", check.Type)
+		} else {
+			fmt.Printf("  %-20s failed: %s\n", check.Type, check.Message)
+		}
+	}
+	if len(report.TargetNodes) > 0 {
+		fmt.Printf("  Target nodes: %s\n", strings.Join(report.TargetNodes, ", "))
+	}
+}
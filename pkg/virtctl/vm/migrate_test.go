@@ -28,6 +28,7 @@ import (
 
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/tests/clientcmd"
@@ -35,6 +36,7 @@ import (
 
 var _ = Describe("Migrate command", func() {
 	var vmInterface *kubecli.MockVirtualMachineInterface
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
 	var ctrl *gomock.Controller
 	const vmName = "testvm"
 
@@ -43,8 +45,21 @@ var _ = Describe("Migrate command", func() {
 		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
 		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
 		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
 	})
 
+	newMigrateCheck := func(namespace, name string, migratable bool) *v1.VirtualMachineInstanceMigrateCheck {
+		return &v1.VirtualMachineInstanceMigrateCheck{
+			Namespace:  namespace,
+			Name:       name,
+			NodeName:   "node01",
+			Migratable: migratable,
+			Checks: []v1.MigrateCheck{
+				{Type: v1.MigrateCheckLiveMigratable, Passed: migratable},
+			},
+		}
+	}
+
 	It("should fail with missing input parameters", func() {
 		cmd := clientcmd.NewRepeatableVirtctlCommand("migrate")
 		err := cmd()
@@ -62,6 +77,8 @@ var _ = Describe("Migrate command", func() {
 		if len(migrateOptions.DryRun) == 0 {
 			cmd = clientcmd.NewRepeatableVirtctlCommand("migrate", vmName)
 		} else {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+			vmiInterface.EXPECT().MigrateCheck(context.Background(), vm.Name).Return(newMigrateCheck(k8smetav1.NamespaceDefault, vm.Name, true), nil).Times(1)
 			cmd = clientcmd.NewRepeatableVirtctlCommand("migrate", "--dry-run", vmName)
 		}
 
@@ -70,4 +87,38 @@ var _ = Describe("Migrate command", func() {
 		Entry("with default", &v1.MigrateOptions{}),
 		Entry("with dry-run option", &v1.MigrateOptions{DryRun: []string{k8smetav1.DryRunAll}}),
 	)
+
+	It("should not migrate a vm in dry-run mode if the pre-flight checks fail", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().MigrateCheck(context.Background(), vmName).Return(newMigrateCheck(k8smetav1.NamespaceDefault, vmName, false), nil).Times(1)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand("migrate", "--dry-run", vmName)
+		Expect(cmd()).To(MatchError("VirtualMachine testvm can not be migrated"))
+	})
+
+	Context("with node", func() {
+		It("should require dry-run", func() {
+			cmd := clientcmd.NewRepeatableVirtctlCommand("migrate", "--node", "node01")
+			Expect(cmd()).To(MatchError("--node can only be used together with --dry-run"))
+		})
+
+		It("should not accept a vm", func() {
+			cmd := clientcmd.NewRepeatableVirtctlCommand("migrate", "--dry-run", "--node", "node01", vmName)
+			Expect(cmd()).To(MatchError("argument validation failed"))
+		})
+
+		It("should check all vmis of the node", func() {
+			vmi1 := api.NewMinimalVMIWithNS("ns1", "vmi1")
+			vmi2 := api.NewMinimalVMIWithNS("ns2", "vmi2")
+			vmiList := &v1.VirtualMachineInstanceList{Items: []v1.VirtualMachineInstance{*vmi1, *vmi2}}
+
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(gomock.Any()).Return(vmiInterface).AnyTimes()
+			vmiInterface.EXPECT().List(context.Background(), &k8smetav1.ListOptions{LabelSelector: v1.NodeNameLabel + "=node01"}).Return(vmiList, nil).Times(1)
+			vmiInterface.EXPECT().MigrateCheck(context.Background(), vmi1.Name).Return(newMigrateCheck(vmi1.Namespace, vmi1.Name, true), nil).Times(1)
+			vmiInterface.EXPECT().MigrateCheck(context.Background(), vmi2.Name).Return(newMigrateCheck(vmi2.Namespace, vmi2.Name, false), nil).Times(1)
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand("migrate", "--dry-run", "--node", "node01")
+			Expect(cmd()).To(MatchError("1 of 2 VirtualMachineInstances on node node01 can not be migrated"))
+		})
+	})
 })
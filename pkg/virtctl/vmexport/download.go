@@ -0,0 +1,400 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2024 Red Hat, Inc.
+ *
+ */
+
+package vmexport
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/cheggaaa/pb/v3"
+	"golang.org/x/sync/errgroup"
+
+	exportv1 "kubevirt.io/api/export/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+const (
+	// DownloadStateSuffix is appended to the output file name to store the progress of a ranged download
+	DownloadStateSuffix = ".vmexport-state"
+
+	// defaultDownloadChunkSize is the size of the ranges requested when resuming or downloading in parallel
+	defaultDownloadChunkSize int64 = 64 * 1024 * 1024
+	// maxChunkAttempts is the number of times a chunk is requested before the download fails
+	maxChunkAttempts = 3
+
+	rangeHeader        = "Range"
+	ifRangeHeader      = "If-Range"
+	contentRangeHeader = "Content-Range"
+	etagHeader         = "ETag"
+)
+
+var downloadChunkSize = defaultDownloadChunkSize
+
+// SetDownloadChunkSize allows overriding the size of the requested ranges (useful for unit testing)
+func SetDownloadChunkSize(size int64) {
+	downloadChunkSize = size
+}
+
+// SetDefaultDownloadChunkSize sets the size of the requested ranges back to default
+func SetDefaultDownloadChunkSize() {
+	downloadChunkSize = defaultDownloadChunkSize
+}
+
+// downloadState records the downloaded chunks of a volume, so an interrupted download can be resumed
+type downloadState struct {
+	Size      int64  `json:"size"`
+	ETag      string `json:"etag"`
+	ChunkSize int64  `json:"chunkSize"`
+	Completed []bool `json:"completed"`
+}
+
+func newDownloadState(size int64, etag string) *downloadState {
+	return &downloadState{
+		Size:      size,
+		ETag:      etag,
+		ChunkSize: downloadChunkSize,
+		Completed: make([]bool, (size+downloadChunkSize-1)/downloadChunkSize),
+	}
+}
+
+// matches returns true if the state belongs to a download of the same volume content
+func (s *downloadState) matches(other *downloadState) bool {
+	return s.Size == other.Size && s.ETag == other.ETag && s.ChunkSize == other.ChunkSize &&
+		len(s.Completed) == len(other.Completed)
+}
+
+func (s *downloadState) completedBytes() int64 {
+	var total int64
+	for i, completed := range s.Completed {
+		if completed {
+			start, end := s.chunkRange(i)
+			total += end - start + 1
+		}
+	}
+	return total
+}
+
+// chunkRange returns the first and the last byte of the chunk
+func (s *downloadState) chunkRange(chunk int) (int64, int64) {
+	start := int64(chunk) * s.ChunkSize
+	end := start + s.ChunkSize - 1
+	if end >= s.Size {
+		end = s.Size - 1
+	}
+	return start, end
+}
+
+func readDownloadState(statePath string) (*downloadState, error) {
+	data, err := os.ReadFile(statePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := &downloadState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("unable to parse download state %s: %v", statePath, err)
+	}
+	return state, nil
+}
+
+// writeDownloadState replaces the state file atomically, so it is never left half written
+func writeDownloadState(statePath string, state *downloadState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tmpPath := statePath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, statePath)
+}
+
+func isRangedDownload(vmeInfo *VMExportInfo) bool {
+	return vmeInfo.Resume || vmeInfo.Parallel > 1
+}
+
+// getRangedDownloadInfo requests the first byte of the volume to get its size and ETag,
+// failing if the server does not support ranged requests
+func getRangedDownloadInfo(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, downloadUrl string) (int64, string, error) {
+	resp, err := HandleHTTPRequest(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, map[string]string{rangeHeader: "bytes=0-0"})
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return 0, "", fmt.Errorf("the export server does not support ranged requests")
+	}
+	if resp.StatusCode != http.StatusPartialContent {
+		return 0, "", fmt.Errorf("bad status: %s", resp.Status)
+	}
+	contentRange := resp.Header.Get(contentRangeHeader)
+	separator := strings.LastIndex(contentRange, "/")
+	if separator < 0 {
+		return 0, "", fmt.Errorf("invalid %s header %q", contentRangeHeader, contentRange)
+	}
+	size, err := strconv.ParseInt(contentRange[separator+1:], 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid %s header %q", contentRangeHeader, contentRange)
+	}
+	return size, resp.Header.Get(etagHeader), nil
+}
+
+// downloadVolumeInChunks downloads the volume in chunks, requesting up to vmeInfo.Parallel chunks at once.
+// The completed chunks are recorded next to the output file, so the download can be resumed with --resume.
+func downloadVolumeInChunks(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, downloadUrl string) error {
+	output, ok := vmeInfo.OutputWriter.(*os.File)
+	if !ok || vmeInfo.OutputFile == "" {
+		return fmt.Errorf("resumable and parallel downloads require an output file")
+	}
+
+	size, etag, err := getRangedDownloadInfo(client, vmexport, vmeInfo, downloadUrl)
+	if err != nil {
+		return err
+	}
+
+	statePath := vmeInfo.OutputFile + DownloadStateSuffix
+	state := newDownloadState(size, etag)
+	if vmeInfo.Resume {
+		previous, err := readDownloadState(statePath)
+		if err != nil {
+			return err
+		}
+		if previous != nil && previous.matches(state) && etag != "" {
+			state = previous
+			fmt.Printf("Resuming download, %d of %d bytes already downloaded\n", state.completedBytes(), size)
+		} else if previous != nil {
+			fmt.Println("The exported volume changed since the previous download, starting over")
+		}
+	}
+	if err := output.Truncate(size); err != nil {
+		return err
+	}
+	if err := writeDownloadState(statePath, state); err != nil {
+		return err
+	}
+
+	barTemplate := `{{ "Downloading file:" }} {{counters . }} {{ bar . }} {{ percent . }} {{speed . }}`
+	bar := pb.ProgressBarTemplate(barTemplate).Start64(size)
+	defer bar.Finish()
+	bar.SetCurrent(state.completedBytes())
+
+	parallel := vmeInfo.Parallel
+	if parallel < 1 {
+		parallel = 1
+	}
+	group, ctx := errgroup.WithContext(context.Background())
+	group.SetLimit(parallel)
+	var stateLock sync.Mutex
+	for i := range state.Completed {
+		if state.Completed[i] {
+			continue
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		chunk := i
+		group.Go(func() error {
+			start, end := state.chunkRange(chunk)
+			if err := downloadChunk(client, vmexport, vmeInfo, downloadUrl, etag, output, start, end, bar); err != nil {
+				return err
+			}
+			stateLock.Lock()
+			defer stateLock.Unlock()
+			state.Completed[chunk] = true
+			return writeDownloadState(statePath, state)
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return fmt.Errorf("%v, run the command again with %s to resume the download", err, RESUME_FLAG)
+	}
+
+	return os.Remove(statePath)
+}
+
+// downloadChunk writes the bytes from start to end of the volume to the output, retrying on errors
+func downloadChunk(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, downloadUrl, etag string, output io.WriterAt, start, end int64, bar *pb.ProgressBar) error {
+	headers := map[string]string{
+		rangeHeader: fmt.Sprintf("bytes=%d-%d", start, end),
+	}
+	if etag != "" {
+		// Without a matching ETag the server sends the whole volume, which is detected below
+		headers[ifRangeHeader] = etag
+	}
+
+	var err error
+	for attempt := 0; attempt < maxChunkAttempts; attempt++ {
+		var written int64
+		written, err = requestChunk(client, vmexport, vmeInfo, downloadUrl, headers, output, start, end, bar)
+		if err == nil {
+			return nil
+		}
+		bar.Add64(-written)
+		if errors.Is(err, errVolumeChanged) {
+			return err
+		}
+	}
+	return err
+}
+
+var errVolumeChanged = errors.New("the exported volume changed during the download")
+
+func requestChunk(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, downloadUrl string, headers map[string]string, output io.WriterAt, start, end int64, bar *pb.ProgressBar) (int64, error) {
+	resp, err := HandleHTTPRequest(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, headers)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		return 0, errVolumeChanged
+	default:
+		return 0, fmt.Errorf("bad status: %s", resp.Status)
+	}
+
+	length := end - start + 1
+	written, err := io.Copy(io.NewOffsetWriter(output, start), bar.NewProxyReader(io.LimitReader(resp.Body, length)))
+	if err != nil {
+		return written, err
+	}
+	if written != length {
+		return written, fmt.Errorf("received %d of %d bytes starting at %d", written, length, start)
+	}
+	return written, nil
+}
+
+// getChecksums requests the checksums, waiting as long as the export server is still computing them
+func getChecksums(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, checksumsUrl string, vmeInfo *VMExportInfo) (*http.Response, error) {
+	deadline := time.Now().Add(checksumsWaitTotal)
+	for {
+		resp, err := HandleHTTPRequest(client, vmexport, checksumsUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusServiceUnavailable || time.Now().After(deadline) {
+			return resp, nil
+		}
+		resp.Body.Close()
+		retryAfter := processingWaitInterval
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			retryAfter = time.Duration(seconds) * time.Second
+		}
+		fmt.Println("Waiting for the checksums to be computed...")
+		time.Sleep(retryAfter)
+	}
+}
+
+// verifyDownloadChecksum compares the SHA-256 checksum of the output file with the one published by the export server
+func verifyDownloadChecksum(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) error {
+	manifests, err := GetManifestUrlsFromVirtualMachineExport(vmexport, vmeInfo)
+	if err != nil {
+		return err
+	}
+	checksumsUrl, ok := manifests[exportv1.Checksums]
+	if !ok {
+		return fmt.Errorf("'%s/%s' VirtualMachineExport does not provide checksums", vmexport.Namespace, vmexport.Name)
+	}
+	links, err := getExportLinks(vmexport, vmeInfo)
+	if err != nil {
+		return err
+	}
+	rawUrl, err := getFormatUrlFromLinks(vmexport, links, vmeInfo, exportv1.KubeVirtRaw)
+	if err != nil {
+		return err
+	}
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return err
+	}
+
+	resp, err := getChecksums(client, vmexport, checksumsUrl, vmeInfo)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("bad status: %s", resp.Status)
+	}
+	expected, err := findChecksum(resp.Body, parsedUrl.Path)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Verifying checksum:")
+	actual, err := sha256File(vmeInfo.OutputFile)
+	if err != nil {
+		return err
+	}
+	if actual != expected {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", vmeInfo.OutputFile, expected, actual)
+	}
+	fmt.Println("Checksum verified succesfully")
+	return nil
+}
+
+// findChecksum returns the checksum of the entry whose path is a suffix of the volume path.
+// The entries use the paths of the export server, without the proxy prefix of the links.
+func findChecksum(checksums io.Reader, volumePath string) (string, error) {
+	scanner := bufio.NewScanner(checksums)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		if strings.HasSuffix(volumePath, fields[1]) {
+			return fields[0], nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no checksum found for %s", volumePath)
+}
+
+func sha256File(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
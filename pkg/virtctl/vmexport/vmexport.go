@@ -80,14 +80,21 @@ const (
 	INCLUDE_SECRET_FLAG = "--include-secret"
 	PORT_FORWARD_FLAG   = "--port-forward"
 	LOCAL_PORT_FLAG     = "--local-port"
+	RESUME_FLAG         = "--resume"
+	PARALLEL_FLAG       = "--parallel"
+	VERIFY_FLAG         = "--verify-checksum"
 
 	// Possible output format for manifests
 	OUTPUT_FORMAT_JSON = "json"
 	OUTPUT_FORMAT_YAML = "yaml"
 
 	// Possible output format for volumes
-	GZIP_FORMAT = "gzip"
-	RAW_FORMAT  = "raw"
+	GZIP_FORMAT             = "gzip"
+	RAW_FORMAT              = "raw"
+	QCOW2_FORMAT            = "qcow2"
+	QCOW2_COMPRESSED_FORMAT = "qcow2-compressed"
+
+	qcow2CompressedQuery = "compressed=true"
 
 	ACCEPT           = "Accept"
 	APPLICATION_YAML = "application/yaml"
@@ -97,6 +104,8 @@ const (
 	processingWaitInterval = 2 * time.Second
 	// processingWaitTotal is the maximum time used to wait for a virtualMachineExport to be ready
 	processingWaitTotal = 2 * time.Minute
+	// checksumsWaitTotal is the maximum time used to wait for the export server to compute the checksums
+	checksumsWaitTotal = 30 * time.Minute
 
 	// exportTokenHeader is the http header used to download the exported volume using the secret token
 	exportTokenHeader = "x-kubevirt-export-token"
@@ -140,6 +149,9 @@ var (
 	volumeName           string
 	ttl                  string
 	manifestOutputFormat string
+	resume               bool
+	parallel             int
+	verifyChecksum       bool
 )
 
 type exportFunc func(client kubecli.KubevirtClient, vmeInfo *VMExportInfo) error
@@ -161,6 +173,11 @@ type VMExportInfo struct {
 	IncludeSecret  bool
 	ExportManifest bool
 	Decompress     bool
+	Qcow2          bool
+	Compressed     bool
+	Resume         bool
+	VerifyChecksum bool
+	Parallel       int
 	PortForward    bool
 	LocalPort      string
 	OutputFile     string
@@ -229,6 +246,9 @@ func usage() string {
 
 	# Download a volume as before but through local port 5410
 	{{ProgramName}} vmexport download vm1-export --volume=volume1 --output=disk.img.gz --port-forward --local-port=5410
+
+	# Download the raw volume with 4 parallel requests and verify its checksum, resuming a previously interrupted download
+	{{ProgramName}} vmexport download vm1-export --volume=volume1 --output=disk.img --format=raw --parallel=4 --resume --verify-checksum
   
 	# Create a VirtualMachineExport and download the requested volume from it
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --volume=volume1 --output=disk.img.gz
@@ -260,7 +280,7 @@ func NewVirtualMachineExportCommand(clientConfig clientcmd.ClientConfig) *cobra.
 	cmd.MarkFlagsMutuallyExclusive("vm", "snapshot", "pvc")
 	cmd.Flags().StringVar(&outputFile, "output", "", "Specifies the output path of the volume to be downloaded.")
 	cmd.Flags().StringVar(&volumeName, "volume", "", "Specifies the volume to be downloaded.")
-	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. There's two options: gzip (default) and raw.")
+	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. Valid options are gzip (default), raw, qcow2 and qcow2-compressed. Only the first 2GiB of data of a qcow2 image are checked for zeroes and compressed.")
 	cmd.Flags().BoolVar(&insecure, "insecure", false, "When used with the 'download' option, specifies that the http request should be insecure.")
 	cmd.Flags().BoolVar(&keepVme, "keep-vme", false, "When used with the 'download' option, specifies that the vmexport object should not be deleted after the download finishes.")
 	cmd.Flags().StringVar(&ttl, "ttl", "", "The time after the export was created that it is eligible to be automatically deleted, defaults to 2 hours by the server side if not specified")
@@ -268,6 +288,9 @@ func NewVirtualMachineExportCommand(clientConfig clientcmd.ClientConfig) *cobra.
 	cmd.Flags().StringVar(&serviceUrl, "service-url", "", "Specify service url to use in the returned manifest, instead of the external URL in the Virtual Machine export status. This is useful for NodePorts or if you don't have an external URL configured")
 	cmd.Flags().BoolVar(&portForward, "port-forward", false, "Configures port-forwarding on a random port. Useful to download without proper ingress/route configuration")
 	cmd.Flags().StringVar(&localPort, "local-port", "0", "Defines the specific port to be used in port-forward.")
+	cmd.Flags().BoolVar(&resume, "resume", false, "Resumes an interrupted download of the raw volume to the output file.")
+	cmd.Flags().IntVar(&parallel, "parallel", 1, "Downloads the raw volume with the given number of parallel requests.")
+	cmd.Flags().BoolVar(&verifyChecksum, "verify-checksum", false, "Verifies the downloaded raw volume against the checksum published by the export server.")
 	cmd.Flags().BoolVar(&includeSecret, "include-secret", false, "When used with manifest and set to true include a secret that contains proper headers for CDI to import using the manifest")
 	cmd.Flags().BoolVar(&exportManifest, "manifest", false, "Instead of downloading a volume, retrieve the VM manifest")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
@@ -346,8 +369,14 @@ func (c *command) parseExportArguments(args []string, vmeInfo *VMExportInfo) err
 func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	vmeInfo.ExportSource = getExportSource()
 	vmeInfo.OutputFile = outputFile
-	// User wants the output in a file, create
-	if outputFile != "" && outputFile != "-" {
+	// User wants the output in a file, create it, unless an interrupted download is resumed
+	if outputFile != "" && outputFile != "-" && resume {
+		output, err := os.OpenFile(vmeInfo.OutputFile, os.O_RDWR|os.O_CREATE, 0666)
+		if err != nil {
+			return err
+		}
+		vmeInfo.OutputWriter = output
+	} else if outputFile != "" && outputFile != "-" {
 		output, err := os.Create(vmeInfo.OutputFile)
 		if err != nil {
 			return err
@@ -360,6 +389,14 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	if format == RAW_FORMAT {
 		vmeInfo.Decompress = true
 	}
+	// qcow2 images are generated by the export server, optionally with compressed clusters
+	if format == QCOW2_FORMAT || format == QCOW2_COMPRESSED_FORMAT {
+		vmeInfo.Qcow2 = true
+		vmeInfo.Compressed = format == QCOW2_COMPRESSED_FORMAT
+	}
+	vmeInfo.Resume = resume
+	vmeInfo.Parallel = parallel
+	vmeInfo.VerifyChecksum = verifyChecksum
 	vmeInfo.ShouldCreate = shouldCreate
 	vmeInfo.Insecure = insecure
 	vmeInfo.KeepVme = keepVme
@@ -552,6 +589,29 @@ func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMac
 		return err
 	}
 
+	if isRangedDownload(vmeInfo) {
+		if err := downloadVolumeInChunks(client, vmexport, vmeInfo, downloadUrl); err != nil {
+			return err
+		}
+	} else if err := downloadVolumeStream(client, vmexport, vmeInfo, downloadUrl); err != nil {
+		return err
+	}
+
+	if vmeInfo.VerifyChecksum {
+		if err := verifyDownloadChecksum(client, vmexport, vmeInfo); err != nil {
+			return err
+		}
+	}
+
+	// Prevent this output ending up in the stdout
+	if vmeInfo.OutputFile != "" {
+		fmt.Println("Download finished succesfully")
+	}
+	return nil
+}
+
+// downloadVolumeStream downloads the volume with a single request
+func downloadVolumeStream(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, downloadUrl string) error {
 	resp, err := HandleHTTPRequest(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
 	if err != nil {
 		return err
@@ -564,15 +624,7 @@ func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMac
 	}
 
 	// Lastly, copy the file to the expected output
-	if err := copyFileWithProgressBar(vmeInfo.OutputWriter, resp, vmeInfo.Decompress); err != nil {
-		return err
-	}
-
-	// Prevent this output ending up in the stdout
-	if vmeInfo.OutputFile != "" {
-		fmt.Println("Download finished succesfully")
-	}
-	return nil
+	return copyFileWithProgressBar(vmeInfo.OutputWriter, resp, vmeInfo.Decompress)
 }
 
 func replaceUrlWithServiceUrl(manifestUrl string, vmeInfo *VMExportInfo) (string, error) {
@@ -591,23 +643,31 @@ func replaceUrlWithServiceUrl(manifestUrl string, vmeInfo *VMExportInfo) (string
 func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (string, error) {
 	var (
 		downloadUrl string
-		err         error
 		format      exportv1.VirtualMachineExportVolumeFormat
-		links       *exportv1.VirtualMachineExportLink
 	)
 
-	if vmeInfo.ServiceURL == "" && vmexport.Status.Links != nil && vmexport.Status.Links.External != nil {
-		links = vmexport.Status.Links.External
-	} else if vmexport.Status.Links != nil && vmexport.Status.Links.Internal != nil {
-		links = vmexport.Status.Links.Internal
+	links, err := getExportLinks(vmexport, vmeInfo)
+	if err != nil {
+		return "", err
 	}
-	if links == nil || len(links.Volumes) <= 0 {
-		return "", fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+	if vmeInfo.Qcow2 {
+		// The qcow2 image is streamed as is
+		vmeInfo.Decompress = false
+		downloadUrl, err = getFormatUrlFromLinks(vmexport, links, vmeInfo, exportv1.KubeVirtQcow2)
+		if err != nil {
+			return "", err
+		}
+		if vmeInfo.Compressed {
+			downloadUrl = appendQuery(downloadUrl, qcow2CompressedQuery)
+		}
+		return downloadUrl, nil
 	}
-	volumeNumber := len(links.Volumes)
-	if volumeNumber > 1 && vmeInfo.VolumeName == "" {
-		return "", fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
+	if isRangedDownload(vmeInfo) {
+		// Only the uncompressed raw image can be requested in ranges
+		vmeInfo.Decompress = false
+		return getFormatUrlFromLinks(vmexport, links, vmeInfo, exportv1.KubeVirtRaw)
 	}
+	volumeNumber := len(links.Volumes)
 	for _, exportVolume := range links.Volumes {
 		// Access the requested volume
 		if volumeNumber == 1 || exportVolume.Name == vmeInfo.VolumeName {
@@ -639,6 +699,45 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 	return downloadUrl, nil
 }
 
+// getExportLinks returns the links used to download volumes, making sure the volume to download is unambiguous
+func getExportLinks(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (*exportv1.VirtualMachineExportLink, error) {
+	var links *exportv1.VirtualMachineExportLink
+	if vmeInfo.ServiceURL == "" && vmexport.Status.Links != nil && vmexport.Status.Links.External != nil {
+		links = vmexport.Status.Links.External
+	} else if vmexport.Status.Links != nil && vmexport.Status.Links.Internal != nil {
+		links = vmexport.Status.Links.Internal
+	}
+	if links == nil || len(links.Volumes) <= 0 {
+		return nil, fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+	}
+	if len(links.Volumes) > 1 && vmeInfo.VolumeName == "" {
+		return nil, fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
+	}
+	return links, nil
+}
+
+// getFormatUrlFromLinks returns the URL of the given format of the requested volume
+func getFormatUrlFromLinks(vmexport *exportv1.VirtualMachineExport, links *exportv1.VirtualMachineExportLink, vmeInfo *VMExportInfo, volumeFormat exportv1.ExportVolumeFormat) (string, error) {
+	for _, exportVolume := range links.Volumes {
+		if len(links.Volumes) != 1 && exportVolume.Name != vmeInfo.VolumeName {
+			continue
+		}
+		for _, format := range exportVolume.Formats {
+			if format.Format == volumeFormat {
+				return replaceUrlWithServiceUrl(format.Url, vmeInfo)
+			}
+		}
+	}
+	return "", fmt.Errorf("unable to get a %s URL from '%s/%s' VirtualMachineExport", volumeFormat, vmexport.Namespace, vmexport.Name)
+}
+
+func appendQuery(rawUrl, query string) string {
+	if strings.Contains(rawUrl, "?") {
+		return rawUrl + "&" + query
+	}
+	return rawUrl + "?" + query
+}
+
 // GetManifestUrlsFromVirtualMachineExport retrieves the manifest URLs from VirtualMachineExport status
 func GetManifestUrlsFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (map[exportv1.ExportManifestType]string, error) {
 	res := make(map[exportv1.ExportManifestType]string, 0)
@@ -893,6 +992,15 @@ func handleCreateFlags() error {
 	if serviceUrl != "" {
 		return fmt.Errorf(ErrIncompatibleFlag, SERVICE_URL_FLAG, CREATE)
 	}
+	if resume {
+		return fmt.Errorf(ErrIncompatibleFlag, RESUME_FLAG, CREATE)
+	}
+	if parallel != 1 {
+		return fmt.Errorf(ErrIncompatibleFlag, PARALLEL_FLAG, CREATE)
+	}
+	if verifyChecksum {
+		return fmt.Errorf(ErrIncompatibleFlag, VERIFY_FLAG, CREATE)
+	}
 
 	return nil
 }
@@ -927,6 +1035,15 @@ func handleDeleteFlags() error {
 	if serviceUrl != "" {
 		return fmt.Errorf(ErrIncompatibleFlag, SERVICE_URL_FLAG, DELETE)
 	}
+	if resume {
+		return fmt.Errorf(ErrIncompatibleFlag, RESUME_FLAG, DELETE)
+	}
+	if parallel != 1 {
+		return fmt.Errorf(ErrIncompatibleFlag, PARALLEL_FLAG, DELETE)
+	}
+	if verifyChecksum {
+		return fmt.Errorf(ErrIncompatibleFlag, VERIFY_FLAG, DELETE)
+	}
 
 	return nil
 }
@@ -945,8 +1062,8 @@ func handleDownloadFlags() error {
 		}
 	}
 
-	if format != "" && format != GZIP_FORMAT && format != RAW_FORMAT {
-		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw")
+	if format != "" && format != GZIP_FORMAT && format != RAW_FORMAT && format != QCOW2_FORMAT && format != QCOW2_COMPRESSED_FORMAT {
+		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw/qcow2/qcow2-compressed")
 	}
 
 	if exportManifest {
@@ -963,6 +1080,9 @@ func handleDownloadFlags() error {
 			return fmt.Errorf(ErrIncompatibleFlag, PVC_FLAG, MANIFEST_FLAG)
 		}
 	}
+	if err := handleRangedDownloadFlags(); err != nil {
+		return err
+	}
 	if !exportManifest && outputFile == "" {
 		return fmt.Errorf("Warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file.", OUTPUT_FLAG, OUTPUT_FLAG)
 	}
@@ -970,6 +1090,40 @@ func handleDownloadFlags() error {
 	return nil
 }
 
+// handleRangedDownloadFlags ensures that resumable, parallel and verified downloads write the raw volume to a file
+func handleRangedDownloadFlags() error {
+	if parallel < 1 {
+		return fmt.Errorf(ErrInvalidValue, PARALLEL_FLAG, "positive numbers")
+	}
+	ranged := resume || parallel > 1
+	var flags []string
+	if resume {
+		flags = append(flags, RESUME_FLAG)
+	}
+	if parallel > 1 {
+		flags = append(flags, PARALLEL_FLAG)
+	}
+	if verifyChecksum {
+		flags = append(flags, VERIFY_FLAG)
+	}
+	for _, flag := range flags {
+		if exportManifest {
+			return fmt.Errorf(ErrIncompatibleFlag, flag, MANIFEST_FLAG)
+		}
+		if outputFile == "" || outputFile == "-" {
+			return fmt.Errorf(ErrRequiredFlag, OUTPUT_FLAG, flag)
+		}
+		if format != "" && format != RAW_FORMAT {
+			return fmt.Errorf(ErrIncompatibleFlag, FORMAT_FLAG+"="+format, flag)
+		}
+	}
+	// The checksum covers the raw volume, which is only written when decompressing or using ranges
+	if verifyChecksum && !ranged && format != RAW_FORMAT {
+		return fmt.Errorf(ErrRequiredFlag, FORMAT_FLAG+"="+RAW_FORMAT, VERIFY_FLAG)
+	}
+	return nil
+}
+
 // getExportSecretName builds the name of the token secret based on the virtualMachineExport object
 func getExportSecretName(vmexportName string) string {
 	return fmt.Sprintf("secret-%s", vmexportName)
@@ -2,11 +2,16 @@ package vmexport_test
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang/mock/gomock"
@@ -291,7 +296,14 @@ var _ = Describe("vmexport", func() {
 			Entry("Using 'manifest' with volume type", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.VOLUME_FLAG, virtctlvmexport.MANIFEST_FLAG), virtctlvmexport.DOWNLOAD, vmexportName, virtctlvmexport.MANIFEST_FLAG, setflag(virtctlvmexport.VM_FLAG, "test"), setflag(virtctlvmexport.VOLUME_FLAG, "volume")),
 			Entry("Using 'manifest' with invalid output_format_flag", fmt.Sprintf(virtctlvmexport.ErrInvalidValue, virtctlvmexport.OUTPUT_FORMAT_FLAG, "json/yaml"), virtctlvmexport.DOWNLOAD, vmexportName, virtctlvmexport.MANIFEST_FLAG, setflag(virtctlvmexport.OUTPUT_FORMAT_FLAG, "invalid")),
 			Entry("Using 'port-forward' with invalid port", fmt.Sprintf(virtctlvmexport.ErrInvalidValue, virtctlvmexport.LOCAL_PORT_FLAG, "valid port numbers"), virtctlvmexport.DOWNLOAD, vmexportName, virtctlvmexport.PORT_FORWARD_FLAG, setflag(virtctlvmexport.LOCAL_PORT_FLAG, "test")),
-			Entry("Using 'format' with invalid download format", fmt.Sprintf(virtctlvmexport.ErrInvalidValue, virtctlvmexport.FORMAT_FLAG, "gzip/raw"), virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'format' with invalid download format", fmt.Sprintf(virtctlvmexport.ErrInvalidValue, virtctlvmexport.FORMAT_FLAG, "gzip/raw/qcow2/qcow2-compressed"), virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'create' with resume flag", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.RESUME_FLAG, virtctlvmexport.CREATE), virtctlvmexport.CREATE, vmexportName, setflag(virtctlvmexport.PVC_FLAG, "test"), virtctlvmexport.RESUME_FLAG),
+			Entry("Using 'delete' with parallel flag", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.PARALLEL_FLAG, virtctlvmexport.DELETE), virtctlvmexport.DELETE, vmexportName, setflag(virtctlvmexport.PARALLEL_FLAG, "2")),
+			Entry("Using 'parallel' with invalid value", fmt.Sprintf(virtctlvmexport.ErrInvalidValue, virtctlvmexport.PARALLEL_FLAG, "positive numbers"), virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.OUTPUT_FLAG, "disk.img"), setflag(virtctlvmexport.PARALLEL_FLAG, "0")),
+			Entry("Using 'resume' without output file", fmt.Sprintf(virtctlvmexport.ErrRequiredFlag, virtctlvmexport.OUTPUT_FLAG, virtctlvmexport.RESUME_FLAG), virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.OUTPUT_FLAG, "-"), virtctlvmexport.RESUME_FLAG),
+			Entry("Using 'parallel' with gzip format", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.FORMAT_FLAG+"=gzip", virtctlvmexport.PARALLEL_FLAG), virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.OUTPUT_FLAG, "disk.img"), setflag(virtctlvmexport.FORMAT_FLAG, virtctlvmexport.GZIP_FORMAT), setflag(virtctlvmexport.PARALLEL_FLAG, "2")),
+			Entry("Using 'verify-checksum' with 'manifest'", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.VERIFY_FLAG, virtctlvmexport.MANIFEST_FLAG), virtctlvmexport.DOWNLOAD, vmexportName, virtctlvmexport.MANIFEST_FLAG, virtctlvmexport.VERIFY_FLAG),
+			Entry("Using 'verify-checksum' without raw format", fmt.Sprintf(virtctlvmexport.ErrRequiredFlag, virtctlvmexport.FORMAT_FLAG+"=raw", virtctlvmexport.VERIFY_FLAG), virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.OUTPUT_FLAG, "disk.img"), virtctlvmexport.VERIFY_FLAG),
 			Entry("Downloading volume without specifying output", fmt.Sprintf("Warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file.", virtctlvmexport.OUTPUT_FLAG, virtctlvmexport.OUTPUT_FLAG), virtctlvmexport.DOWNLOAD, vmexportName),
 		)
 
@@ -362,6 +374,10 @@ var _ = Describe("vmexport", func() {
 		})
 
 		It("Succesfully create and download a VirtualMachineExport with raw format", func() {
+			orgHttpFunc := virtctlvmexport.HandleHTTPRequest
+			DeferCleanup(func() {
+				virtctlvmexport.HandleHTTPRequest = orgHttpFunc
+			})
 			virtctlvmexport.HandleHTTPRequest = func(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, downloadUrl string, insecure bool, exportURL string, headers map[string]string) (*http.Response, error) {
 				resp := http.Response{
 					StatusCode: http.StatusOK,
@@ -413,6 +429,22 @@ var _ = Describe("vmexport", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("Succesfully download a VirtualMachineExport in qcow2 format", func() {
+			vmexport := utils.VMExportSpecPVC(vmexportName, metav1.NamespaceDefault, "test-pvc", secretName)
+			vmexport.Status = utils.GetVMEStatus([]exportv1.VirtualMachineExportVolume{
+				{
+					Name:    volumeName,
+					Formats: append(utils.GetExportVolumeFormat(server.URL, exportv1.KubeVirtGz), utils.GetExportVolumeFormat(server.URL, exportv1.KubeVirtQcow2)...),
+				},
+			}, secretName)
+			utils.HandleSecretGet(kubeClient, secretName)
+			utils.HandleVMExportGet(vmExportClient, vmexport, vmexportName)
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand(commandName, virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.FORMAT_FLAG, virtctlvmexport.QCOW2_COMPRESSED_FORMAT), setflag(virtctlvmexport.VOLUME_FLAG, volumeName), setflag(virtctlvmexport.OUTPUT_FLAG, "test-pvc"), virtctlvmexport.INSECURE_FLAG)
+			err := cmd()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("Succesfully download a VirtualMachineExport with just 'raw' links", func() {
 			vmexport := utils.VMExportSpecPVC(vmexportName, metav1.NamespaceDefault, "test-pvc", secretName)
 			vmexport.Status = utils.GetVMEStatus([]exportv1.VirtualMachineExportVolume{
@@ -492,6 +524,155 @@ var _ = Describe("vmexport", func() {
 		})
 	})
 
+	Context("Ranged download", func() {
+		const diskPath = "/volumes/test-pvc/disk.img"
+		var (
+			content        = []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+			etag           = `"test-etag"`
+			checksum       string
+			outputFile     string
+			rangesLock     sync.Mutex
+			requestedRange []string
+			checksumsBusy  int
+		)
+
+		startServer := func(fileHandler http.HandlerFunc) {
+			mux := http.NewServeMux()
+			mux.HandleFunc(diskPath, fileHandler)
+			mux.HandleFunc("/manifests/checksums", func(w http.ResponseWriter, r *http.Request) {
+				if checksumsBusy > 0 {
+					checksumsBusy--
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				fmt.Fprintf(w, "%s  %s\n", checksum, diskPath)
+			})
+			server.Close()
+			server = httptest.NewTLSServer(mux)
+
+			vmexport := utils.VMExportSpecPVC(vmexportName, metav1.NamespaceDefault, "test-pvc", secretName)
+			vmexport.Status = utils.GetVMEStatus([]exportv1.VirtualMachineExportVolume{
+				{
+					Name: volumeName,
+					Formats: []exportv1.VirtualMachineExportVolumeFormat{
+						{Format: exportv1.KubeVirtRaw, Url: server.URL + diskPath},
+						{Format: exportv1.KubeVirtGz, Url: server.URL + diskPath + ".gz"},
+					},
+				},
+			}, secretName)
+			vmexport.Status.Links.External.Manifests = []exportv1.VirtualMachineExportManifest{
+				{Type: exportv1.Checksums, Url: server.URL + "/manifests/checksums"},
+			}
+			utils.HandleSecretGet(kubeClient, secretName)
+			utils.HandleVMExportGet(vmExportClient, vmexport, vmexportName)
+		}
+
+		rangeFileHandler := func(w http.ResponseWriter, r *http.Request) {
+			rangesLock.Lock()
+			requestedRange = append(requestedRange, r.Header.Get("Range"))
+			rangesLock.Unlock()
+			w.Header().Set("ETag", etag)
+			http.ServeContent(w, r, "disk.img", time.Time{}, bytes.NewReader(content))
+		}
+
+		download := func(extraArgs ...string) error {
+			args := []string{commandName, virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.OUTPUT_FLAG, outputFile), setflag(virtctlvmexport.FORMAT_FLAG, virtctlvmexport.RAW_FORMAT), virtctlvmexport.INSECURE_FLAG, virtctlvmexport.KEEP_FLAG}
+			return clientcmd.NewRepeatableVirtctlCommand(append(args, extraArgs...)...)()
+		}
+
+		writeState := func(etag string, completed ...bool) {
+			state, err := json.Marshal(map[string]interface{}{
+				"size":      len(content),
+				"etag":      etag,
+				"chunkSize": 5,
+				"completed": completed,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.WriteFile(outputFile+virtctlvmexport.DownloadStateSuffix, state, 0600)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			testInit(http.StatusOK)
+			virtctlvmexport.SetDownloadChunkSize(5)
+			outputFile = filepath.Join(GinkgoT().TempDir(), "disk.img")
+			checksum = fmt.Sprintf("%x", sha256.Sum256(content))
+			requestedRange = nil
+			checksumsBusy = 0
+		})
+
+		AfterEach(func() {
+			virtctlvmexport.SetDefaultDownloadChunkSize()
+			testDone()
+		})
+
+		It("should download the volume with parallel requests and verify the checksum", func() {
+			startServer(rangeFileHandler)
+			Expect(download(setflag(virtctlvmexport.PARALLEL_FLAG, "3"), virtctlvmexport.VERIFY_FLAG)).To(Succeed())
+			Expect(os.ReadFile(outputFile)).To(Equal(content))
+			Expect(outputFile + virtctlvmexport.DownloadStateSuffix).ToNot(BeAnExistingFile())
+			// One request for the size and one per chunk
+			Expect(requestedRange).To(HaveLen(9))
+		})
+
+		It("should only download the missing chunks when resuming", func() {
+			startServer(rangeFileHandler)
+			Expect(os.WriteFile(outputFile, append(content[:10:10], make([]byte, len(content)-10)...), 0644)).To(Succeed())
+			writeState(etag, true, true, false, false, false, false, false, false)
+
+			Expect(download(virtctlvmexport.RESUME_FLAG)).To(Succeed())
+			Expect(os.ReadFile(outputFile)).To(Equal(content))
+			Expect(requestedRange).To(HaveLen(7))
+			Expect(requestedRange).ToNot(ContainElements("bytes=0-4", "bytes=5-9"))
+			Expect(outputFile + virtctlvmexport.DownloadStateSuffix).ToNot(BeAnExistingFile())
+		})
+
+		It("should start over when the volume changed since the interrupted download", func() {
+			startServer(rangeFileHandler)
+			Expect(os.WriteFile(outputFile, make([]byte, len(content)), 0644)).To(Succeed())
+			writeState(`"old-etag"`, true, true, true, true, true, true, true, false)
+
+			Expect(download(virtctlvmexport.RESUME_FLAG)).To(Succeed())
+			Expect(os.ReadFile(outputFile)).To(Equal(content))
+			Expect(requestedRange).To(HaveLen(9))
+		})
+
+		It("should wait for the checksums to be computed", func() {
+			startServer(rangeFileHandler)
+			checksumsBusy = 2
+			Expect(download(setflag(virtctlvmexport.PARALLEL_FLAG, "2"), virtctlvmexport.VERIFY_FLAG)).To(Succeed())
+			Expect(checksumsBusy).To(BeZero())
+		})
+
+		It("should fail when the checksum does not match", func() {
+			startServer(rangeFileHandler)
+			checksum = fmt.Sprintf("%x", sha256.Sum256([]byte("something else")))
+			err := download(setflag(virtctlvmexport.PARALLEL_FLAG, "2"), virtctlvmexport.VERIFY_FLAG)
+			Expect(err).To(MatchError(ContainSubstring("checksum mismatch")))
+		})
+
+		It("should fail when the server does not support ranged requests", func() {
+			startServer(func(w http.ResponseWriter, r *http.Request) {
+				w.Write(content)
+			})
+			err := download(setflag(virtctlvmexport.PARALLEL_FLAG, "2"))
+			Expect(err).To(MatchError(ContainSubstring("does not support ranged requests")))
+		})
+
+		It("should keep the progress when a chunk cannot be downloaded", func() {
+			startServer(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Range") == "bytes=10-14" {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				rangeFileHandler(w, r)
+			})
+			err := download(virtctlvmexport.RESUME_FLAG)
+			Expect(err).To(MatchError(ContainSubstring(virtctlvmexport.RESUME_FLAG)))
+			Expect(outputFile + virtctlvmexport.DownloadStateSuffix).To(BeAnExistingFile())
+		})
+	})
+
 	Context("getUrlFromVirtualMachineExport", func() {
 		// Mocking the minimum viable VMExportInfo struct
 		var vmeinfo *virtctlvmexport.VMExportInfo
@@ -545,6 +726,60 @@ var _ = Describe("vmexport", func() {
 			Expect(url).Should(Equal("raw"))
 		})
 
+		It("Should get qcow2 URL when qcow2 is requested", func() {
+			vmeinfo.Qcow2 = true
+			vmeinfo.Decompress = true
+			vmExport := utils.VMExportSpecPVC(vmexportName, metav1.NamespaceDefault, "test-pvc", secretName)
+			vmExport.Status = utils.GetVMEStatus([]exportv1.VirtualMachineExportVolume{
+				{
+					Name: volumeName,
+					Formats: []exportv1.VirtualMachineExportVolumeFormat{
+						{
+							Format: exportv1.KubeVirtGz,
+							Url:    "compressed",
+						},
+						{
+							Format: exportv1.KubeVirtQcow2,
+							Url:    "qcow2",
+						},
+					},
+				},
+			}, secretName)
+			url, err := virtctlvmexport.GetUrlFromVirtualMachineExport(vmExport, vmeinfo)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).Should(Equal("qcow2"))
+			Expect(vmeinfo.Decompress).To(BeFalse())
+		})
+
+		It("Should request compressed clusters when qcow2-compressed is requested", func() {
+			vmeinfo.Qcow2 = true
+			vmeinfo.Compressed = true
+			vmExport := utils.VMExportSpecPVC(vmexportName, metav1.NamespaceDefault, "test-pvc", secretName)
+			vmExport.Status = utils.GetVMEStatus([]exportv1.VirtualMachineExportVolume{
+				{
+					Name:    volumeName,
+					Formats: utils.GetExportVolumeFormat("https://example.com/disk.qcow2", exportv1.KubeVirtQcow2),
+				},
+			}, secretName)
+			url, err := virtctlvmexport.GetUrlFromVirtualMachineExport(vmExport, vmeinfo)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).Should(Equal("https://example.com/disk.qcow2?compressed=true"))
+		})
+
+		It("Should fail to get qcow2 URL when the export does not provide one", func() {
+			vmeinfo.Qcow2 = true
+			vmExport := utils.VMExportSpecPVC(vmexportName, metav1.NamespaceDefault, "test-pvc", secretName)
+			vmExport.Status = utils.GetVMEStatus([]exportv1.VirtualMachineExportVolume{
+				{
+					Name:    volumeName,
+					Formats: utils.GetExportVolumeFormat("raw", exportv1.KubeVirtRaw),
+				},
+			}, secretName)
+			url, err := virtctlvmexport.GetUrlFromVirtualMachineExport(vmExport, vmeinfo)
+			Expect(err).To(MatchError(ContainSubstring("unable to get a qcow2 URL")))
+			Expect(url).To(Equal(""))
+		})
+
 		It("Should not get any URL when there's no valid options", func() {
 			vmExport := utils.VMExportSpecPVC(vmexportName, metav1.NamespaceDefault, "test-pvc", secretName)
 			vmExport.Status = utils.GetVMEStatus([]exportv1.VirtualMachineExportVolume{
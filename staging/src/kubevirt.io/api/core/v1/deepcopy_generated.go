@@ -207,6 +207,23 @@ func (in *BIOS) DeepCopy() *BIOS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVolumeSource) DeepCopyInto(out *BackupVolumeSource) {
+	*out = *in
+	out.PersistentVolumeClaimVolumeSource = in.PersistentVolumeClaimVolumeSource
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVolumeSource.
+func (in *BackupVolumeSource) DeepCopy() *BackupVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockSize) DeepCopyInto(out *BlockSize) {
 	*out = *in
@@ -897,6 +914,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(Watchdog)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PanicDevice != nil {
+		in, out := &in.PanicDevice, &out.PanicDevice
+		*out = new(PanicDevice)
+		**out = **in
+	}
 	if in.Interfaces != nil {
 		in, out := &in.Interfaces, &out.Interfaces
 		*out = make([]Interface, len(*in))
@@ -1072,6 +1094,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(DiskErrorPolicy)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1116,6 +1143,112 @@ func (in *DiskDevice) DeepCopy() *DiskDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	if in.TotalBytesSec != nil {
+		in, out := &in.TotalBytesSec, &out.TotalBytesSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadBytesSec != nil {
+		in, out := &in.ReadBytesSec, &out.ReadBytesSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteBytesSec != nil {
+		in, out := &in.WriteBytesSec, &out.WriteBytesSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TotalIOPSSec != nil {
+		in, out := &in.TotalIOPSSec, &out.TotalIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadIOPSSec != nil {
+		in, out := &in.ReadIOPSSec, &out.ReadIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteIOPSSec != nil {
+		in, out := &in.WriteIOPSSec, &out.WriteIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TotalBytesSecMax != nil {
+		in, out := &in.TotalBytesSecMax, &out.TotalBytesSecMax
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadBytesSecMax != nil {
+		in, out := &in.ReadBytesSecMax, &out.ReadBytesSecMax
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteBytesSecMax != nil {
+		in, out := &in.WriteBytesSecMax, &out.WriteBytesSecMax
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TotalIOPSSecMax != nil {
+		in, out := &in.TotalIOPSSecMax, &out.TotalIOPSSecMax
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadIOPSSecMax != nil {
+		in, out := &in.ReadIOPSSecMax, &out.ReadIOPSSecMax
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteIOPSSecMax != nil {
+		in, out := &in.WriteIOPSSecMax, &out.WriteIOPSSecMax
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TotalBytesSecMaxLength != nil {
+		in, out := &in.TotalBytesSecMaxLength, &out.TotalBytesSecMaxLength
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadBytesSecMaxLength != nil {
+		in, out := &in.ReadBytesSecMaxLength, &out.ReadBytesSecMaxLength
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteBytesSecMaxLength != nil {
+		in, out := &in.WriteBytesSecMaxLength, &out.WriteBytesSecMaxLength
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TotalIOPSSecMaxLength != nil {
+		in, out := &in.TotalIOPSSecMaxLength, &out.TotalIOPSSecMaxLength
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadIOPSSecMaxLength != nil {
+		in, out := &in.ReadIOPSSecMaxLength, &out.ReadIOPSSecMaxLength
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteIOPSSecMaxLength != nil {
+		in, out := &in.WriteIOPSSecMaxLength, &out.WriteIOPSSecMaxLength
+		*out = new(uint64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
@@ -1153,6 +1286,45 @@ func (in *DiskVerification) DeepCopy() *DiskVerification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackupInfo) DeepCopyInto(out *DomainBackupInfo) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncrementalVolumes != nil {
+		in, out := &in.IncrementalVolumes, &out.IncrementalVolumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CheckpointVolumes != nil {
+		in, out := &in.CheckpointVolumes, &out.CheckpointVolumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackupInfo.
+func (in *DomainBackupInfo) DeepCopy() *DomainBackupInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackupInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainMemoryDumpInfo) DeepCopyInto(out *DomainMemoryDumpInfo) {
 	*out = *in
@@ -1629,6 +1801,69 @@ func (in *FilesystemVirtiofs) DeepCopy() *FilesystemVirtiofs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Firewall) DeepCopyInto(out *Firewall) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(FirewallRuleSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(FirewallRuleSet)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Firewall.
+func (in *Firewall) DeepCopy() *Firewall {
+	if in == nil {
+		return nil
+	}
+	out := new(Firewall)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRule) DeepCopyInto(out *FirewallRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRule.
+func (in *FirewallRule) DeepCopy() *FirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleSet) DeepCopyInto(out *FirewallRuleSet) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]FirewallRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleSet.
+func (in *FirewallRuleSet) DeepCopy() *FirewallRuleSet {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Firmware) DeepCopyInto(out *Firmware) {
 	*out = *in
@@ -1647,6 +1882,11 @@ func (in *Firmware) DeepCopyInto(out *Firmware) {
 		*out = new(ACPI)
 		**out = **in
 	}
+	if in.GenerationID != nil {
+		in, out := &in.GenerationID, &out.GenerationID
+		*out = new(GenerationID)
+		**out = **in
+	}
 	return
 }
 
@@ -1739,6 +1979,22 @@ func (in *GPU) DeepCopy() *GPU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GenerationID) DeepCopyInto(out *GenerationID) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenerationID.
+func (in *GenerationID) DeepCopy() *GenerationID {
+	if in == nil {
+		return nil
+	}
+	out := new(GenerationID)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenerationStatus) DeepCopyInto(out *GenerationStatus) {
 	*out = *in
@@ -2054,6 +2310,11 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2067,6 +2328,58 @@ func (in *Interface) DeepCopy() *Interface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidth) DeepCopyInto(out *InterfaceBandwidth) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(InterfaceBandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(InterfaceBandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidth.
+func (in *InterfaceBandwidth) DeepCopy() *InterfaceBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidthLimit) DeepCopyInto(out *InterfaceBandwidthLimit) {
+	*out = *in
+	if in.Peak != nil {
+		in, out := &in.Peak, &out.Peak
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(uint64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidthLimit.
+func (in *InterfaceBandwidthLimit) DeepCopy() *InterfaceBandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	*out = *in
@@ -2687,6 +3000,11 @@ func (in *KubeVirtStatus) DeepCopyInto(out *KubeVirtStatus) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.WorkloadUpdateStatus != nil {
+		in, out := &in.WorkloadUpdateStatus, &out.WorkloadUpdateStatus
+		*out = new(KubeVirtWorkloadUpdateStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ObservedGeneration != nil {
 		in, out := &in.ObservedGeneration, &out.ObservedGeneration
 		*out = new(int64)
@@ -2710,6 +3028,34 @@ func (in *KubeVirtStatus) DeepCopy() *KubeVirtStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtWorkloadUpdateStatus) DeepCopyInto(out *KubeVirtWorkloadUpdateStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.NextMaintenanceWindow != nil {
+		in, out := &in.NextMaintenanceWindow, &out.NextMaintenanceWindow
+		*out = (*in).DeepCopy()
+	}
+	if in.EstimatedCompletionTimestamp != nil {
+		in, out := &in.EstimatedCompletionTimestamp, &out.EstimatedCompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtWorkloadUpdateStatus.
+func (in *KubeVirtWorkloadUpdateStatus) DeepCopy() *KubeVirtWorkloadUpdateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtWorkloadUpdateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtWorkloadUpdateStrategy) DeepCopyInto(out *KubeVirtWorkloadUpdateStrategy) {
 	*out = *in
@@ -2728,6 +3074,13 @@ func (in *KubeVirtWorkloadUpdateStrategy) DeepCopyInto(out *KubeVirtWorkloadUpda
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]WorkloadUpdateMaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2825,6 +3178,22 @@ func (in *LiveUpdateConfiguration) DeepCopy() *LiveUpdateConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiveUpdateDiskIOTune) DeepCopyInto(out *LiveUpdateDiskIOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiveUpdateDiskIOTune.
+func (in *LiveUpdateDiskIOTune) DeepCopy() *LiveUpdateDiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(LiveUpdateDiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LiveUpdateFeatures) DeepCopyInto(out *LiveUpdateFeatures) {
 	*out = *in
@@ -2843,6 +3212,16 @@ func (in *LiveUpdateFeatures) DeepCopyInto(out *LiveUpdateFeatures) {
 		*out = new(LiveUpdateMemory)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DiskIOTune != nil {
+		in, out := &in.DiskIOTune, &out.DiskIOTune
+		*out = new(LiveUpdateDiskIOTune)
+		**out = **in
+	}
+	if in.InterfaceBandwidth != nil {
+		in, out := &in.InterfaceBandwidth, &out.InterfaceBandwidth
+		*out = new(LiveUpdateInterfaceBandwidth)
+		**out = **in
+	}
 	return
 }
 
@@ -2856,6 +3235,22 @@ func (in *LiveUpdateFeatures) DeepCopy() *LiveUpdateFeatures {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiveUpdateInterfaceBandwidth) DeepCopyInto(out *LiveUpdateInterfaceBandwidth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiveUpdateInterfaceBandwidth.
+func (in *LiveUpdateInterfaceBandwidth) DeepCopy() *LiveUpdateInterfaceBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(LiveUpdateInterfaceBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LiveUpdateMemory) DeepCopyInto(out *LiveUpdateMemory) {
 	*out = *in
@@ -2917,28 +3312,65 @@ func (in *LunTarget) DeepCopy() *LunTarget {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Machine) DeepCopyInto(out *Machine) {
+func (in *MacAddressPool) DeepCopyInto(out *MacAddressPool) {
 	*out = *in
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]MacAddressRange, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Machine.
-func (in *Machine) DeepCopy() *Machine {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MacAddressPool.
+func (in *MacAddressPool) DeepCopy() *MacAddressPool {
 	if in == nil {
 		return nil
 	}
-	out := new(Machine)
+	out := new(MacAddressPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
+func (in *MacAddressRange) DeepCopyInto(out *MacAddressRange) {
 	*out = *in
-	if in.MediatedDevicesTypes != nil {
-		in, out := &in.MediatedDevicesTypes, &out.MediatedDevicesTypes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MacAddressRange.
+func (in *MacAddressRange) DeepCopy() *MacAddressRange {
+	if in == nil {
+		return nil
+	}
+	out := new(MacAddressRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Machine) DeepCopyInto(out *Machine) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Machine.
+func (in *Machine) DeepCopy() *Machine {
+	if in == nil {
+		return nil
+	}
+	out := new(Machine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
+	*out = *in
+	if in.MediatedDevicesTypes != nil {
+		in, out := &in.MediatedDevicesTypes, &out.MediatedDevicesTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	if in.MediatedDeviceTypes != nil {
 		in, out := &in.MediatedDeviceTypes, &out.MediatedDeviceTypes
@@ -3060,6 +3492,22 @@ func (in *MemoryStatus) DeepCopy() *MemoryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrateCheck) DeepCopyInto(out *MigrateCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrateCheck.
+func (in *MigrateCheck) DeepCopy() *MigrateCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrateCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrateOptions) DeepCopyInto(out *MigrateOptions) {
 	*out = *in
@@ -3082,6 +3530,27 @@ func (in *MigrateOptions) DeepCopy() *MigrateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationCompression) DeepCopyInto(out *MigrationCompression) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationCompression.
+func (in *MigrationCompression) DeepCopy() *MigrationCompression {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationCompression)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 	*out = *in
@@ -3140,11 +3609,41 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AdditionalNetworks != nil {
+		in, out := &in.AdditionalNetworks, &out.AdditionalNetworks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.MatchSELinuxLevelOnMigration != nil {
 		in, out := &in.MatchSELinuxLevelOnMigration, &out.MatchSELinuxLevelOnMigration
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Escalation != nil {
+		in, out := &in.Escalation, &out.Escalation
+		*out = new(MigrationEscalation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MultifdChannels != nil {
+		in, out := &in.MultifdChannels, &out.MultifdChannels
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(MigrationCompression)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxDowntime != nil {
+		in, out := &in.MaxDowntime, &out.MaxDowntime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CrossClusterTunnelAddress != nil {
+		in, out := &in.CrossClusterTunnelAddress, &out.CrossClusterTunnelAddress
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -3158,6 +3657,109 @@ func (in *MigrationConfiguration) DeepCopy() *MigrationConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationDirtyRate) DeepCopyInto(out *MigrationDirtyRate) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationDirtyRate.
+func (in *MigrationDirtyRate) DeepCopy() *MigrationDirtyRate {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationDirtyRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationEscalation) DeepCopyInto(out *MigrationEscalation) {
+	*out = *in
+	if in.StageDuration != nil {
+		in, out := &in.StageDuration, &out.StageDuration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DirtyRateThresholdPercent != nil {
+		in, out := &in.DirtyRateThresholdPercent, &out.DirtyRateThresholdPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RemainingDataThreshold != nil {
+		in, out := &in.RemainingDataThreshold, &out.RemainingDataThreshold
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.AutoConvergeInitial != nil {
+		in, out := &in.AutoConvergeInitial, &out.AutoConvergeInitial
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AutoConvergeIncrement != nil {
+		in, out := &in.AutoConvergeIncrement, &out.AutoConvergeIncrement
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationEscalation.
+func (in *MigrationEscalation) DeepCopy() *MigrationEscalation {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationEscalation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationEscalationStatus) DeepCopyInto(out *MigrationEscalationStatus) {
+	*out = *in
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]MigrationEscalationTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationEscalationStatus.
+func (in *MigrationEscalationStatus) DeepCopy() *MigrationEscalationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationEscalationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationEscalationTransition) DeepCopyInto(out *MigrationEscalationTransition) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationEscalationTransition.
+func (in *MigrationEscalationTransition) DeepCopy() *MigrationEscalationTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationEscalationTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultusNetwork) DeepCopyInto(out *MultusNetwork) {
 	*out = *in
@@ -3248,6 +3850,11 @@ func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 			(*out)[key] = val
 		}
 	}
+	if in.MacAddressPool != nil {
+		in, out := &in.MacAddressPool, &out.MacAddressPool
+		*out = new(MacAddressPool)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3303,6 +3910,27 @@ func (in *NoCloudSSHPublicKeyAccessCredentialPropagation) DeepCopy() *NoCloudSSH
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeEvacuationStatus) DeepCopyInto(out *NodeEvacuationStatus) {
+	*out = *in
+	if in.VirtualMachineInstances != nil {
+		in, out := &in.VirtualMachineInstances, &out.VirtualMachineInstances
+		*out = make([]VirtualMachineInstanceEvacuation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeEvacuationStatus.
+func (in *NodeEvacuationStatus) DeepCopy() *NodeEvacuationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeEvacuationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeMediatedDeviceTypesConfig) DeepCopyInto(out *NodeMediatedDeviceTypesConfig) {
 	*out = *in
@@ -3392,6 +4020,22 @@ func (in *PITTimer) DeepCopy() *PITTimer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PanicDevice) DeepCopyInto(out *PanicDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PanicDevice.
+func (in *PanicDevice) DeepCopy() *PanicDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(PanicDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PauseOptions) DeepCopyInto(out *PauseOptions) {
 	*out = *in
@@ -4243,6 +4887,32 @@ func (in *StopOptions) DeepCopy() *StopOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageMigratedVolumeInfo) DeepCopyInto(out *StorageMigratedVolumeInfo) {
+	*out = *in
+	if in.SourcePVCInfo != nil {
+		in, out := &in.SourcePVCInfo, &out.SourcePVCInfo
+		*out = new(PersistentVolumeClaimInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationPVCInfo != nil {
+		in, out := &in.DestinationPVCInfo, &out.DestinationPVCInfo
+		*out = new(PersistentVolumeClaimInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageMigratedVolumeInfo.
+func (in *StorageMigratedVolumeInfo) DeepCopy() *StorageMigratedVolumeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageMigratedVolumeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SupportContainerResources) DeepCopyInto(out *SupportContainerResources) {
 	*out = *in
@@ -4663,6 +5333,28 @@ func (in *VirtualMachine) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupCheckpoint) DeepCopyInto(out *VirtualMachineBackupCheckpoint) {
+	*out = *in
+	in.CreationTimestamp.DeepCopyInto(&out.CreationTimestamp)
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupCheckpoint.
+func (in *VirtualMachineBackupCheckpoint) DeepCopy() *VirtualMachineBackupCheckpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupCheckpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineCondition) DeepCopyInto(out *VirtualMachineCondition) {
 	*out = *in
@@ -4727,6 +5419,22 @@ func (in *VirtualMachineInstanceCondition) DeepCopy() *VirtualMachineInstanceCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceEvacuation) DeepCopyInto(out *VirtualMachineInstanceEvacuation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceEvacuation.
+func (in *VirtualMachineInstanceEvacuation) DeepCopy() *VirtualMachineInstanceEvacuation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceEvacuation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceFileSystem) DeepCopyInto(out *VirtualMachineInstanceFileSystem) {
 	*out = *in
@@ -4953,12 +5661,47 @@ func (in *VirtualMachineInstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrateCheck) DeepCopyInto(out *VirtualMachineInstanceMigrateCheck) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]MigrateCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetNodes != nil {
+		in, out := &in.TargetNodes, &out.TargetNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrateCheck.
+func (in *VirtualMachineInstanceMigrateCheck) DeepCopy() *VirtualMachineInstanceMigrateCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrateCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceMigrateCheck) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigration) DeepCopyInto(out *VirtualMachineInstanceMigration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -4999,6 +5742,40 @@ func (in *VirtualMachineInstanceMigrationCondition) DeepCopy() *VirtualMachineIn
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationHistoryEntry) DeepCopyInto(out *VirtualMachineInstanceMigrationHistoryEntry) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Downtime != nil {
+		in, out := &in.Downtime, &out.Downtime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationHistoryEntry.
+func (in *VirtualMachineInstanceMigrationHistoryEntry) DeepCopy() *VirtualMachineInstanceMigrationHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationList) DeepCopyInto(out *VirtualMachineInstanceMigrationList) {
 	*out = *in
@@ -5049,9 +5826,66 @@ func (in *VirtualMachineInstanceMigrationPhaseTransitionTimestamp) DeepCopy() *V
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationReceive) DeepCopyInto(out *VirtualMachineInstanceMigrationReceive) {
+	*out = *in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(VirtualMachineInstanceTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceCABundle != nil {
+		in, out := &in.SourceCABundle, &out.SourceCABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationReceive.
+func (in *VirtualMachineInstanceMigrationReceive) DeepCopy() *VirtualMachineInstanceMigrationReceive {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationReceive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationSendTo) DeepCopyInto(out *VirtualMachineInstanceMigrationSendTo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationSendTo.
+func (in *VirtualMachineInstanceMigrationSendTo) DeepCopy() *VirtualMachineInstanceMigrationSendTo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationSendTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationSpec) DeepCopyInto(out *VirtualMachineInstanceMigrationSpec) {
 	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SendTo != nil {
+		in, out := &in.SendTo, &out.SendTo
+		*out = new(VirtualMachineInstanceMigrationSendTo)
+		**out = **in
+	}
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
+		*out = new(VirtualMachineInstanceMigrationReceive)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5087,6 +5921,36 @@ func (in *VirtualMachineInstanceMigrationState) DeepCopyInto(out *VirtualMachine
 			(*out)[key] = val
 		}
 	}
+	if in.Escalation != nil {
+		in, out := &in.Escalation, &out.Escalation
+		*out = new(MigrationEscalationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Downtime != nil {
+		in, out := &in.Downtime, &out.Downtime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SetupTime != nil {
+		in, out := &in.SetupTime, &out.SetupTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.TotalTime != nil {
+		in, out := &in.TotalTime, &out.TotalTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DirtyRate != nil {
+		in, out := &in.DirtyRate, &out.DirtyRate
+		*out = new(MigrationDirtyRate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetTunnel != nil {
+		in, out := &in.TargetTunnel, &out.TargetTunnel
+		*out = new(VirtualMachineInstanceMigrationTunnel)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MigrationPolicyName != nil {
 		in, out := &in.MigrationPolicyName, &out.MigrationPolicyName
 		*out = new(string)
@@ -5137,6 +6001,11 @@ func (in *VirtualMachineInstanceMigrationStatus) DeepCopyInto(out *VirtualMachin
 		*out = new(VirtualMachineInstanceMigrationState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QueuePosition != nil {
+		in, out := &in.QueuePosition, &out.QueuePosition
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -5150,6 +6019,27 @@ func (in *VirtualMachineInstanceMigrationStatus) DeepCopy() *VirtualMachineInsta
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationTunnel) DeepCopyInto(out *VirtualMachineInstanceMigrationTunnel) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationTunnel.
+func (in *VirtualMachineInstanceMigrationTunnel) DeepCopy() *VirtualMachineInstanceMigrationTunnel {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationTunnel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceNetworkInterface) DeepCopyInto(out *VirtualMachineInstanceNetworkInterface) {
 	*out = *in
@@ -5493,6 +6383,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Firewall != nil {
+		in, out := &in.Firewall, &out.Firewall
+		*out = new(Firewall)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DNSConfig != nil {
 		in, out := &in.DNSConfig, &out.DNSConfig
 		*out = new(corev1.PodDNSConfig)
@@ -5542,12 +6437,24 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Firewall != nil {
+		in, out := &in.Firewall, &out.Firewall
+		*out = new(Firewall)
+		(*in).DeepCopyInto(*out)
+	}
 	out.GuestOSInfo = in.GuestOSInfo
 	if in.MigrationState != nil {
 		in, out := &in.MigrationState, &out.MigrationState
 		*out = new(VirtualMachineInstanceMigrationState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MigrationHistory != nil {
+		in, out := &in.MigrationHistory, &out.MigrationHistory
+		*out = make([]VirtualMachineInstanceMigrationHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.QOSClass != nil {
 		in, out := &in.QOSClass, &out.QOSClass
 		*out = new(corev1.PodQOSClass)
@@ -5597,6 +6504,13 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(MemoryStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MigratedVolumes != nil {
+		in, out := &in.MigratedVolumes, &out.MigratedVolumes
+		*out = make([]StorageMigratedVolumeInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -5756,6 +6670,11 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(LiveUpdateFeatures)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UpdateVolumesStrategy != nil {
+		in, out := &in.UpdateVolumesStrategy, &out.UpdateVolumesStrategy
+		*out = new(UpdateVolumesStrategy)
+		**out = **in
+	}
 	return
 }
 
@@ -5866,6 +6785,16 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = new(VirtualMachineMemoryDumpRequest)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VolumeUpdateState != nil {
+		in, out := &in.VolumeUpdateState, &out.VolumeUpdateState
+		*out = new(VolumeUpdateState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupCheckpoint != nil {
+		in, out := &in.BackupCheckpoint, &out.BackupCheckpoint
+		*out = new(VirtualMachineBackupCheckpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5922,6 +6851,37 @@ func (in *Volume) DeepCopy() *Volume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeMigrationState) DeepCopyInto(out *VolumeMigrationState) {
+	*out = *in
+	if in.MigratedVolumes != nil {
+		in, out := &in.MigratedVolumes, &out.MigratedVolumes
+		*out = make([]StorageMigratedVolumeInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMigrationState.
+func (in *VolumeMigrationState) DeepCopy() *VolumeMigrationState {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeMigrationState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshotStatus) DeepCopyInto(out *VolumeSnapshotStatus) {
 	*out = *in
@@ -6016,6 +6976,11 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 		*out = new(MemoryDumpVolumeSource)
 		**out = **in
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupVolumeSource)
+		**out = **in
+	}
 	return
 }
 
@@ -6047,6 +7012,11 @@ func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 		*out = new(DomainMemoryDumpInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BackupVolume != nil {
+		in, out := &in.BackupVolume, &out.BackupVolume
+		*out = new(DomainBackupInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ContainerDiskVolume != nil {
 		in, out := &in.ContainerDiskVolume, &out.ContainerDiskVolume
 		*out = new(ContainerDiskInfo)
@@ -6065,6 +7035,27 @@ func (in *VolumeStatus) DeepCopy() *VolumeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeUpdateState) DeepCopyInto(out *VolumeUpdateState) {
+	*out = *in
+	if in.VolumeMigrationState != nil {
+		in, out := &in.VolumeMigrationState, &out.VolumeMigrationState
+		*out = new(VolumeMigrationState)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeUpdateState.
+func (in *VolumeUpdateState) DeepCopy() *VolumeUpdateState {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeUpdateState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Watchdog) DeepCopyInto(out *Watchdog) {
 	*out = *in
@@ -6102,3 +7093,30 @@ func (in *WatchdogDevice) DeepCopy() *WatchdogDevice {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadUpdateMaintenanceWindow) DeepCopyInto(out *WorkloadUpdateMaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.BatchMigrationSize != nil {
+		in, out := &in.BatchMigrationSize, &out.BatchMigrationSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.BatchEvictionSize != nil {
+		in, out := &in.BatchEvictionSize, &out.BatchEvictionSize
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadUpdateMaintenanceWindow.
+func (in *WorkloadUpdateMaintenanceWindow) DeepCopy() *WorkloadUpdateMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadUpdateMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
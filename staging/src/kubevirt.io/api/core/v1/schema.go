@@ -429,6 +429,18 @@ type Firmware struct {
 	KernelBoot *KernelBoot `json:"kernelBoot,omitempty"`
 	// Information that can be set in the ACPI table
 	ACPI *ACPI `json:"acpi,omitempty"`
+	// GenerationID exposes a VM Generation ID device to the guest.
+	// It allows the guest to detect a rollback, e.g. after a restore from a snapshot.
+	// +optional
+	GenerationID *GenerationID `json:"generationID,omitempty"`
+}
+
+type GenerationID struct {
+	// UUID reported to the guest as the VM Generation ID.
+	// Defaults to an ID derived from the VirtualMachine, which is stable across reboots and migrations.
+	// It is regenerated when the VirtualMachine is restored from a snapshot or cloned.
+	// +optional
+	UUID types.UID `json:"uuid,omitempty"`
 }
 
 type ACPI struct {
@@ -449,6 +461,9 @@ type Devices struct {
 	Disks []Disk `json:"disks,omitempty"`
 	// Watchdog describes a watchdog device which can be added to the vmi.
 	Watchdog *Watchdog `json:"watchdog,omitempty"`
+	// PanicDevice describes a panic device which the guest uses to report a crash.
+	// +optional
+	PanicDevice *PanicDevice `json:"panicDevice,omitempty"`
 	// Interfaces describe network interfaces which are added to the vmi.
 	Interfaces []Interface `json:"interfaces,omitempty"`
 	// Inputs describe input devices
@@ -656,6 +671,9 @@ type Disk struct {
 	// If specified, it can change the default error policy (stop) for the disk
 	// +optional
 	ErrorPolicy *DiskErrorPolicy `json:"errorPolicy,omitempty"`
+	// If specified, the IO of the disk is throttled to the given limits.
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
 }
 
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
@@ -671,6 +689,68 @@ type BlockSize struct {
 	MatchVolume *FeatureState    `json:"matchVolume,omitempty"`
 }
 
+// DiskIOTune defines the IO throttling of a disk.
+// The total limits can not be combined with the corresponding read or write limits.
+type DiskIOTune struct {
+	// TotalBytesSec is the total throughput limit in bytes per second
+	// +optional
+	TotalBytesSec *uint64 `json:"totalBytesSec,omitempty"`
+	// ReadBytesSec is the read throughput limit in bytes per second
+	// +optional
+	ReadBytesSec *uint64 `json:"readBytesSec,omitempty"`
+	// WriteBytesSec is the write throughput limit in bytes per second
+	// +optional
+	WriteBytesSec *uint64 `json:"writeBytesSec,omitempty"`
+	// TotalIOPSSec is the total I/O operations per second limit
+	// +optional
+	TotalIOPSSec *uint64 `json:"totalIOPSSec,omitempty"`
+	// ReadIOPSSec is the read I/O operations per second limit
+	// +optional
+	ReadIOPSSec *uint64 `json:"readIOPSSec,omitempty"`
+	// WriteIOPSSec is the write I/O operations per second limit
+	// +optional
+	WriteIOPSSec *uint64 `json:"writeIOPSSec,omitempty"`
+	// TotalBytesSecMax is the total throughput allowed during bursts in bytes per second
+	// +optional
+	TotalBytesSecMax *uint64 `json:"totalBytesSecMax,omitempty"`
+	// ReadBytesSecMax is the read throughput allowed during bursts in bytes per second
+	// +optional
+	ReadBytesSecMax *uint64 `json:"readBytesSecMax,omitempty"`
+	// WriteBytesSecMax is the write throughput allowed during bursts in bytes per second
+	// +optional
+	WriteBytesSecMax *uint64 `json:"writeBytesSecMax,omitempty"`
+	// TotalIOPSSecMax is the total I/O operations per second allowed during bursts
+	// +optional
+	TotalIOPSSecMax *uint64 `json:"totalIOPSSecMax,omitempty"`
+	// ReadIOPSSecMax is the read I/O operations per second allowed during bursts
+	// +optional
+	ReadIOPSSecMax *uint64 `json:"readIOPSSecMax,omitempty"`
+	// WriteIOPSSecMax is the write I/O operations per second allowed during bursts
+	// +optional
+	WriteIOPSSecMax *uint64 `json:"writeIOPSSecMax,omitempty"`
+	// TotalBytesSecMaxLength is the duration in seconds of a total throughput burst
+	// +optional
+	TotalBytesSecMaxLength *uint64 `json:"totalBytesSecMaxLength,omitempty"`
+	// ReadBytesSecMaxLength is the duration in seconds of a read throughput burst
+	// +optional
+	ReadBytesSecMaxLength *uint64 `json:"readBytesSecMaxLength,omitempty"`
+	// WriteBytesSecMaxLength is the duration in seconds of a write throughput burst
+	// +optional
+	WriteBytesSecMaxLength *uint64 `json:"writeBytesSecMaxLength,omitempty"`
+	// TotalIOPSSecMaxLength is the duration in seconds of a total I/O operations burst
+	// +optional
+	TotalIOPSSecMaxLength *uint64 `json:"totalIOPSSecMaxLength,omitempty"`
+	// ReadIOPSSecMaxLength is the duration in seconds of a read I/O operations burst
+	// +optional
+	ReadIOPSSecMaxLength *uint64 `json:"readIOPSSecMaxLength,omitempty"`
+	// WriteIOPSSecMaxLength is the duration in seconds of a write I/O operations burst
+	// +optional
+	WriteIOPSSecMaxLength *uint64 `json:"writeIOPSSecMaxLength,omitempty"`
+	// GroupName places the disk in a throttling group, the disks of a group share the same limits
+	// +optional
+	GroupName string `json:"groupName,omitempty"`
+}
+
 // Represents the target of a volume to mount.
 // Only one of its members may be specified.
 type DiskDevice struct {
@@ -837,6 +917,8 @@ type VolumeSource struct {
 	DownwardMetrics *DownwardMetricsVolumeSource `json:"downwardMetrics,omitempty"`
 	// MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi
 	MemoryDump *MemoryDumpVolumeSource `json:"memoryDump,omitempty"`
+	// Backup is attached to the virt launcher and is populated with the backup images of the vmi disks
+	Backup *BackupVolumeSource `json:"backup,omitempty"`
 }
 
 // HotplugVolumeSource Represents the source of a volume to mount which are capable
@@ -880,6 +962,29 @@ type MemoryDumpVolumeSource struct {
 	PersistentVolumeClaimVolumeSource `json:",inline"`
 }
 
+type BackupVolumeSource struct {
+	// PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
+	// Directly attached to the virt launcher
+	// +optional
+	PersistentVolumeClaimVolumeSource `json:",inline"`
+	// BackupName is the name of the VirtualMachineBackup writing into the volume
+	BackupName string `json:"backupName"`
+	// CheckpointName is the name of the changed block tracking checkpoint created with the backup
+	// +optional
+	CheckpointName string `json:"checkpointName,omitempty"`
+	// IncrementalFrom is the name of the checkpoint the backup is relative to.
+	// A full backup is taken when empty.
+	// +optional
+	IncrementalFrom string `json:"incrementalFrom,omitempty"`
+	// Pull exposes the disks through the NBD server of the VMI instead of writing the backup images,
+	// the volume only holds the scratch images of the backup job.
+	// +optional
+	Pull bool `json:"pull,omitempty"`
+	// PullCompleted stops the NBD server of a pull mode backup and completes it
+	// +optional
+	PullCompleted bool `json:"pullCompleted,omitempty"`
+}
+
 type EphemeralVolumeSource struct {
 	// PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
 	// Directly attached to the vmi via qemu.
@@ -1223,6 +1328,47 @@ type I6300ESBWatchdog struct {
 	Action WatchdogAction `json:"action,omitempty"`
 }
 
+// PanicDeviceModel defines the model of a panic device.
+type PanicDeviceModel string
+
+const (
+	// PanicDeviceModelPVPanic is the PCI pvpanic device.
+	PanicDeviceModelPVPanic PanicDeviceModel = "pvpanic"
+	// PanicDeviceModelISA is the ISA pvpanic device. Only supported on amd64.
+	PanicDeviceModelISA PanicDeviceModel = "isa"
+	// PanicDeviceModelHyperV reports crashes through the Hyper-V crash MSRs. Only supported on amd64.
+	PanicDeviceModelHyperV PanicDeviceModel = "hyperv"
+)
+
+// CrashAction defines the action taken when the guest reports a crash.
+type CrashAction string
+
+const (
+	// CrashActionRestart will restart the guest if it crashes.
+	CrashActionRestart CrashAction = "restart"
+	// CrashActionPoweroff will poweroff the vmi if the guest crashes.
+	CrashActionPoweroff CrashAction = "poweroff"
+	// CrashActionPreserve will keep the crashed guest and its memory until the vmi is stopped.
+	CrashActionPreserve CrashAction = "preserve"
+)
+
+// Panic device, which the guest uses to report a crash.
+type PanicDevice struct {
+	// Model of the panic device. Valid values are pvpanic, isa, hyperv.
+	// Defaults to pvpanic.
+	// +optional
+	Model PanicDeviceModel `json:"model,omitempty"`
+	// The action to take when the guest reports a crash. Valid values are restart, poweroff, preserve.
+	// Defaults to poweroff.
+	// +optional
+	OnCrash CrashAction `json:"onCrash,omitempty"`
+	// MemoryDumpClaimName is the name of a PVC the memory of a crashed guest is dumped to.
+	// The dump is done through the memory dump subresource of the VirtualMachine and requires
+	// the preserve action.
+	// +optional
+	MemoryDumpClaimName string `json:"memoryDumpClaimName,omitempty"`
+}
+
 type Interface struct {
 	// Logical name of the interface as well as a reference to the associated networks.
 	// Must match the Name of a Network.
@@ -1263,16 +1409,46 @@ type Interface struct {
 	// This value is required to be unique across all devices and be between 1 and (16*1024-1).
 	// +optional
 	ACPIIndex int `json:"acpiIndex,omitempty"`
+	// If specified, the traffic of the interface is shaped to the given limits.
+	// Not supported for SR-IOV interfaces.
+	// +optional
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
 	// State represents the requested operational state of the interface.
-	// The (only) value supported is `absent`, expressing a request to remove the interface.
+	// The values supported are `absent`, expressing a request to remove the interface,
+	// and `up` or `down`, setting the link state of the interface seen by the guest.
+	// The link state is `up` if not specified.
 	// +optional
 	State InterfaceState `json:"state,omitempty"`
 }
 
+// InterfaceBandwidth defines the bandwidth limits of a network interface.
+type InterfaceBandwidth struct {
+	// Inbound limits the traffic received by the virtual machine
+	// +optional
+	Inbound *InterfaceBandwidthLimit `json:"inbound,omitempty"`
+	// Outbound limits the traffic sent by the virtual machine
+	// +optional
+	Outbound *InterfaceBandwidthLimit `json:"outbound,omitempty"`
+}
+
+// InterfaceBandwidthLimit defines the rate limits of one traffic direction.
+type InterfaceBandwidthLimit struct {
+	// Average is the average rate in kibibytes per second
+	Average uint64 `json:"average"`
+	// Peak is the maximum rate in kibibytes per second at which bursts are sent
+	// +optional
+	Peak *uint64 `json:"peak,omitempty"`
+	// Burst is the amount of kibibytes which can be sent in a single burst at the peak rate
+	// +optional
+	Burst *uint64 `json:"burst,omitempty"`
+}
+
 type InterfaceState string
 
 const (
-	InterfaceStateAbsent InterfaceState = "absent"
+	InterfaceStateAbsent   InterfaceState = "absent"
+	InterfaceStateLinkUp   InterfaceState = "up"
+	InterfaceStateLinkDown InterfaceState = "down"
 )
 
 // Extra DHCP options to use in the interface.
@@ -1549,6 +1725,62 @@ type MultusNetwork struct {
 	Default bool `json:"default,omitempty"`
 }
 
+// Firewall defines the rules filtering the traffic of the pod network interface.
+type Firewall struct {
+	// Ingress filters the traffic received by the virtual machine.
+	// +optional
+	Ingress *FirewallRuleSet `json:"ingress,omitempty"`
+	// Egress filters the traffic sent by the virtual machine.
+	// +optional
+	Egress *FirewallRuleSet `json:"egress,omitempty"`
+}
+
+// FirewallRuleSet defines the rules of one traffic direction.
+// Replies to accepted connections are always allowed.
+type FirewallRuleSet struct {
+	// Rules are evaluated in order, the action of the first matching rule is applied.
+	// +optional
+	// +listType=atomic
+	Rules []FirewallRule `json:"rules,omitempty"`
+	// DefaultAction is applied to the traffic not matching any rule.
+	// Defaults to Drop.
+	// +optional
+	DefaultAction FirewallAction `json:"defaultAction,omitempty"`
+}
+
+// FirewallRule matches traffic by the remote address, the protocol and the destination port.
+type FirewallRule struct {
+	// CIDR of the remote addresses, the source for ingress and the destination for egress traffic.
+	// All addresses are matched if not specified.
+	// +optional
+	CIDR string `json:"cidr,omitempty"`
+	// Protocol to match, one of TCP, UDP or ICMP.
+	// All protocols are matched if not specified.
+	// +optional
+	Protocol FirewallProtocol `json:"protocol,omitempty"`
+	// Port is the destination port to match, requires the TCP or UDP protocol.
+	// All ports are matched if not specified.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// Action applied to the matching traffic, one of Accept or Drop.
+	Action FirewallAction `json:"action"`
+}
+
+type FirewallProtocol string
+
+const (
+	FirewallProtocolTCP  FirewallProtocol = "TCP"
+	FirewallProtocolUDP  FirewallProtocol = "UDP"
+	FirewallProtocolICMP FirewallProtocol = "ICMP"
+)
+
+type FirewallAction string
+
+const (
+	FirewallActionAccept FirewallAction = "Accept"
+	FirewallActionDrop   FirewallAction = "Drop"
+)
+
 // CPUTopology allows specifying the amount of cores, sockets
 // and threads.
 type CPUTopology struct {
@@ -229,11 +229,18 @@ func (Machine) SwaggerDoc() map[string]string {
 
 func (Firmware) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"uuid":       "UUID reported by the vmi bios.\nDefaults to a random generated uid.",
-		"bootloader": "Settings to control the bootloader that is used.\n+optional",
-		"serial":     "The system-serial-number in SMBIOS",
-		"kernelBoot": "Settings to set the kernel for booting.\n+optional",
-		"acpi":       "Information that can be set in the ACPI table",
+		"uuid":         "UUID reported by the vmi bios.\nDefaults to a random generated uid.",
+		"bootloader":   "Settings to control the bootloader that is used.\n+optional",
+		"serial":       "The system-serial-number in SMBIOS",
+		"kernelBoot":   "Settings to set the kernel for booting.\n+optional",
+		"acpi":         "Information that can be set in the ACPI table",
+		"generationID": "GenerationID exposes a VM Generation ID device to the guest.\nIt allows the guest to detect a rollback, e.g. after a restore from a snapshot.\n+optional",
+	}
+}
+
+func (GenerationID) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"uuid": "UUID reported to the guest as the VM Generation ID.\nDefaults to an ID derived from the VirtualMachine, which is stable across reboots and migrations.\nIt is regenerated when the VirtualMachine is restored from a snapshot or cloned.\n+optional",
 	}
 }
 
@@ -249,6 +256,7 @@ func (Devices) SwaggerDoc() map[string]string {
 		"disableHotplug":             "DisableHotplug disabled the ability to hotplug disks.",
 		"disks":                      "Disks describes disks, cdroms and luns which are connected to the vmi.",
 		"watchdog":                   "Watchdog describes a watchdog device which can be added to the vmi.",
+		"panicDevice":                "PanicDevice describes a panic device which the guest uses to report a crash.\n+optional",
 		"interfaces":                 "Interfaces describe network interfaces which are added to the vmi.",
 		"inputs":                     "Inputs describe input devices",
 		"autoattachPodInterface":     "Whether to attach a pod network interface. Defaults to true.",
@@ -351,6 +359,7 @@ func (Disk) SwaggerDoc() map[string]string {
 		"blockSize":         "If specified, the virtual disk will be presented with the given block sizes.\n+optional",
 		"shareable":         "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
 		"errorPolicy":       "If specified, it can change the default error policy (stop) for the disk\n+optional",
+		"ioTune":            "If specified, the IO of the disk is throttled to the given limits.\n+optional",
 	}
 }
 
@@ -366,6 +375,31 @@ func (BlockSize) SwaggerDoc() map[string]string {
 	}
 }
 
+func (DiskIOTune) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "DiskIOTune defines the IO throttling of a disk.\nThe total limits can not be combined with the corresponding read or write limits.",
+		"totalBytesSec":          "TotalBytesSec is the total throughput limit in bytes per second\n+optional",
+		"readBytesSec":           "ReadBytesSec is the read throughput limit in bytes per second\n+optional",
+		"writeBytesSec":          "WriteBytesSec is the write throughput limit in bytes per second\n+optional",
+		"totalIOPSSec":           "TotalIOPSSec is the total I/O operations per second limit\n+optional",
+		"readIOPSSec":            "ReadIOPSSec is the read I/O operations per second limit\n+optional",
+		"writeIOPSSec":           "WriteIOPSSec is the write I/O operations per second limit\n+optional",
+		"totalBytesSecMax":       "TotalBytesSecMax is the total throughput allowed during bursts in bytes per second\n+optional",
+		"readBytesSecMax":        "ReadBytesSecMax is the read throughput allowed during bursts in bytes per second\n+optional",
+		"writeBytesSecMax":       "WriteBytesSecMax is the write throughput allowed during bursts in bytes per second\n+optional",
+		"totalIOPSSecMax":        "TotalIOPSSecMax is the total I/O operations per second allowed during bursts\n+optional",
+		"readIOPSSecMax":         "ReadIOPSSecMax is the read I/O operations per second allowed during bursts\n+optional",
+		"writeIOPSSecMax":        "WriteIOPSSecMax is the write I/O operations per second allowed during bursts\n+optional",
+		"totalBytesSecMaxLength": "TotalBytesSecMaxLength is the duration in seconds of a total throughput burst\n+optional",
+		"readBytesSecMaxLength":  "ReadBytesSecMaxLength is the duration in seconds of a read throughput burst\n+optional",
+		"writeBytesSecMaxLength": "WriteBytesSecMaxLength is the duration in seconds of a write throughput burst\n+optional",
+		"totalIOPSSecMaxLength":  "TotalIOPSSecMaxLength is the duration in seconds of a total I/O operations burst\n+optional",
+		"readIOPSSecMaxLength":   "ReadIOPSSecMaxLength is the duration in seconds of a read I/O operations burst\n+optional",
+		"writeIOPSSecMaxLength":  "WriteIOPSSecMaxLength is the duration in seconds of a write I/O operations burst\n+optional",
+		"groupName":              "GroupName places the disk in a throttling group, the disks of a group share the same limits\n+optional",
+	}
+}
+
 func (DiskDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":      "Represents the target of a volume to mount.\nOnly one of its members may be specified.",
@@ -449,6 +483,7 @@ func (VolumeSource) SwaggerDoc() map[string]string {
 		"serviceAccount":        "ServiceAccountVolumeSource represents a reference to a service account.\nThere can only be one volume of this type!\nMore info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/\n+optional",
 		"downwardMetrics":       "DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest\nmetrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.",
 		"memoryDump":            "MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi",
+		"backup":                "Backup is attached to the virt launcher and is populated with the backup images of the vmi disks",
 	}
 }
 
@@ -478,6 +513,16 @@ func (MemoryDumpVolumeSource) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (BackupVolumeSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"backupName":      "BackupName is the name of the VirtualMachineBackup writing into the volume",
+		"checkpointName":  "CheckpointName is the name of the changed block tracking checkpoint created with the backup\n+optional",
+		"incrementalFrom": "IncrementalFrom is the name of the checkpoint the backup is relative to.\nA full backup is taken when empty.\n+optional",
+		"pull":            "Pull exposes the disks through the NBD server of the VMI instead of writing the backup images,\nthe volume only holds the scratch images of the backup job.\n+optional",
+		"pullCompleted":   "PullCompleted stops the NBD server of a pull mode backup and completes it\n+optional",
+	}
+}
+
 func (EphemeralVolumeSource) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"persistentVolumeClaim": "PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.\nDirectly attached to the vmi via qemu.\nMore info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims\n+optional",
@@ -658,6 +703,15 @@ func (I6300ESBWatchdog) SwaggerDoc() map[string]string {
 	}
 }
 
+func (PanicDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "Panic device, which the guest uses to report a crash.",
+		"model":               "Model of the panic device. Valid values are pvpanic, isa, hyperv.\nDefaults to pvpanic.\n+optional",
+		"onCrash":             "The action to take when the guest reports a crash. Valid values are restart, poweroff, preserve.\nDefaults to poweroff.\n+optional",
+		"memoryDumpClaimName": "MemoryDumpClaimName is the name of a PVC the memory of a crashed guest is dumped to.\nThe dump is done through the memory dump subresource of the VirtualMachine and requires\nthe preserve action.\n+optional",
+	}
+}
+
 func (Interface) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"name":        "Logical name of the interface as well as a reference to the associated networks.\nMust match the Name of a Network.",
@@ -670,7 +724,25 @@ func (Interface) SwaggerDoc() map[string]string {
 		"dhcpOptions": "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
-		"state":       "State represents the requested operational state of the interface.\nThe (only) value supported is `absent`, expressing a request to remove the interface.\n+optional",
+		"bandwidth":   "If specified, the traffic of the interface is shaped to the given limits.\nNot supported for SR-IOV interfaces.\n+optional",
+		"state":       "State represents the requested operational state of the interface.\nThe values supported are `absent`, expressing a request to remove the interface,\nand `up` or `down`, setting the link state of the interface seen by the guest.\nThe link state is `up` if not specified.\n+optional",
+	}
+}
+
+func (InterfaceBandwidth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceBandwidth defines the bandwidth limits of a network interface.",
+		"inbound":  "Inbound limits the traffic received by the virtual machine\n+optional",
+		"outbound": "Outbound limits the traffic sent by the virtual machine\n+optional",
+	}
+}
+
+func (InterfaceBandwidthLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "InterfaceBandwidthLimit defines the rate limits of one traffic direction.",
+		"average": "Average is the average rate in kibibytes per second",
+		"peak":    "Peak is the maximum rate in kibibytes per second at which bursts are sent\n+optional",
+		"burst":   "Burst is the amount of kibibytes which can be sent in a single burst at the peak rate\n+optional",
 	}
 }
 
@@ -872,6 +944,32 @@ func (MultusNetwork) SwaggerDoc() map[string]string {
 	}
 }
 
+func (Firewall) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "Firewall defines the rules filtering the traffic of the pod network interface.",
+		"ingress": "Ingress filters the traffic received by the virtual machine.\n+optional",
+		"egress":  "Egress filters the traffic sent by the virtual machine.\n+optional",
+	}
+}
+
+func (FirewallRuleSet) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "FirewallRuleSet defines the rules of one traffic direction.\nReplies to accepted connections are always allowed.",
+		"rules":         "Rules are evaluated in order, the action of the first matching rule is applied.\n+optional\n+listType=atomic",
+		"defaultAction": "DefaultAction is applied to the traffic not matching any rule.\nDefaults to Drop.\n+optional",
+	}
+}
+
+func (FirewallRule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "FirewallRule matches traffic by the remote address, the protocol and the destination port.",
+		"cidr":     "CIDR of the remote addresses, the source for ingress and the destination for egress traffic.\nAll addresses are matched if not specified.\n+optional",
+		"protocol": "Protocol to match, one of TCP, UDP or ICMP.\nAll protocols are matched if not specified.\n+optional",
+		"port":     "Port is the destination port to match, requires the TCP or UDP protocol.\nAll ports are matched if not specified.\n+optional",
+		"action":   "Action applied to the matching traffic, one of Accept or Drop.",
+	}
+}
+
 func (CPUTopology) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":        "CPUTopology allows specifying the amount of cores, sockets\nand threads.",
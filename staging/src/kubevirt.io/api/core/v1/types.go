@@ -148,6 +148,11 @@ type VirtualMachineInstanceSpec struct {
 	Subdomain string `json:"subdomain,omitempty"`
 	// List of networks that can be attached to a vm's virtual interface.
 	Networks []Network `json:"networks,omitempty"`
+	// Firewall defines the rules filtering the traffic of the pod network interface.
+	// Only supported with the masquerade and passt bindings.
+	// The rules can be updated while the vmi is running.
+	// +optional
+	Firewall *Firewall `json:"firewall,omitempty"`
 	// Set DNS policy for the pod.
 	// Defaults to "ClusterFirst".
 	// Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.
@@ -219,10 +224,17 @@ type VirtualMachineInstanceStatus struct {
 	PhaseTransitionTimestamps []VirtualMachineInstancePhaseTransitionTimestamp `json:"phaseTransitionTimestamps,omitempty"`
 	// Interfaces represent the details of available network interfaces.
 	Interfaces []VirtualMachineInstanceNetworkInterface `json:"interfaces,omitempty"`
+	// Firewall represents the firewall rules currently enforced on the pod network interface.
+	// +optional
+	Firewall *Firewall `json:"firewall,omitempty"`
 	// Guest OS Information
 	GuestOSInfo VirtualMachineInstanceGuestOSInfo `json:"guestOSInfo,omitempty"`
 	// Represents the status of a live migration
 	MigrationState *VirtualMachineInstanceMigrationState `json:"migrationState,omitempty"`
+	// MigrationHistory lists the most recent finished live migrations of the vmi, the oldest first
+	// +optional
+	// +listType=atomic
+	MigrationHistory []VirtualMachineInstanceMigrationHistoryEntry `json:"migrationHistory,omitempty"`
 	// Represents the method using which the vmi can be migrated: live migration or block migration
 	MigrationMethod VirtualMachineInstanceMigrationMethod `json:"migrationMethod,omitempty"`
 	// This represents the migration transport
@@ -291,10 +303,28 @@ type VirtualMachineInstanceStatus struct {
 	// Memory shows various informations about the VirtualMachine memory.
 	// +optional
 	Memory *MemoryStatus `json:"memory,omitempty"`
+
+	// MigratedVolumes lists the source and destination volumes during the volume migration
+	// +listType=atomic
+	// +optional
+	MigratedVolumes []StorageMigratedVolumeInfo `json:"migratedVolumes,omitempty"`
+}
+
+// StorageMigratedVolumeInfo tracks the information about the source and destination volumes during the volume migration
+type StorageMigratedVolumeInfo struct {
+	// VolumeName is the name of the volume that is being migrated
+	VolumeName string `json:"volumeName"`
+	// SourcePVCInfo contains the information about the source PVC
+	SourcePVCInfo *PersistentVolumeClaimInfo `json:"sourcePVCInfo,omitempty" valid:"required"`
+	// DestinationPVCInfo contains the information about the destination PVC
+	DestinationPVCInfo *PersistentVolumeClaimInfo `json:"destinationPVCInfo,omitempty" valid:"required"`
 }
 
 // PersistentVolumeClaimInfo contains the relavant information virt-handler needs cached about a PVC
 type PersistentVolumeClaimInfo struct {
+	// ClaimName is the name of the PVC
+	ClaimName string `json:"claimName,omitempty"`
+
 	// AccessModes contains the desired access modes the volume should have.
 	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1
 	// +listType=atomic
@@ -343,6 +373,8 @@ type VolumeStatus struct {
 	Size int64 `json:"size,omitempty"`
 	// If the volume is memorydump volume, this will contain the memorydump info.
 	MemoryDumpVolume *DomainMemoryDumpInfo `json:"memoryDumpVolume,omitempty"`
+	// If the volume is a backup volume, this will contain the backup info.
+	BackupVolume *DomainBackupInfo `json:"backupVolume,omitempty"`
 	// ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk
 	ContainerDiskVolume *ContainerDiskInfo `json:"containerDiskVolume,omitempty"`
 }
@@ -379,6 +411,29 @@ type DomainMemoryDumpInfo struct {
 	TargetFileName string `json:"targetFileName,omitempty"`
 }
 
+// DomainBackupInfo represents the backup information
+type DomainBackupInfo struct {
+	// StartTimestamp is the time when the backup started
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// EndTimestamp is the time when the backup completed
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// TargetDirectory is the directory of the volume containing the backup images
+	TargetDirectory string `json:"targetDirectory,omitempty"`
+	// Volumes is the list of volumes included in the backup
+	// +listType=atomic
+	Volumes []string `json:"volumes,omitempty"`
+	// IncrementalVolumes is the list of volumes whose backup image only contains
+	// the blocks changed since the parent checkpoint, the other volumes were copied entirely
+	// +listType=atomic
+	// +optional
+	IncrementalVolumes []string `json:"incrementalVolumes,omitempty"`
+	// CheckpointVolumes is the list of volumes tracked by a dirty bitmap of the checkpoint created with the backup.
+	// Only the qcow2 disk images can store a dirty bitmap.
+	// +listType=atomic
+	// +optional
+	CheckpointVolumes []string `json:"checkpointVolumes,omitempty"`
+}
+
 // HotplugVolumeStatus represents the hotplug status of the volume
 type HotplugVolumeStatus struct {
 	// AttachPodName is the name of the pod used to attach the volume to the node.
@@ -417,6 +472,14 @@ const (
 	MemoryDumpVolumeInProgress VolumePhase = "MemoryDumpInProgress"
 	// MemoryDumpVolumeInProgress means that the volume for the memory dump was attached, and now the command is being triggered
 	MemoryDumpVolumeFailed VolumePhase = "MemoryDumpFailed"
+	// BackupVolumeInProgress means that the volume for the backup was attached, and now the backup is being triggered
+	BackupVolumeInProgress VolumePhase = "BackupInProgress"
+	// BackupVolumeExported means that the disks of a pull mode backup can be read through the NBD server of the VMI
+	BackupVolumeExported VolumePhase = "BackupExported"
+	// BackupVolumeCompleted means that the requested backup was completed and the images are ready in the volume
+	BackupVolumeCompleted VolumePhase = "BackupCompleted"
+	// BackupVolumeFailed means that the requested backup failed
+	BackupVolumeFailed VolumePhase = "BackupFailed"
 )
 
 func (v *VirtualMachineInstance) IsScheduling() bool {
@@ -525,6 +588,9 @@ const (
 	// If the VMI was paused by the user, this is reported as true.
 	VirtualMachineInstancePaused VirtualMachineInstanceConditionType = "Paused"
 
+	// Indicates that the guest reported a crash through its panic device, it is removed once the guest runs again
+	VirtualMachineInstanceGuestCrashed VirtualMachineInstanceConditionType = "GuestCrashed"
+
 	// Reflects whether the QEMU guest agent is connected through the channel
 	VirtualMachineInstanceAgentConnected VirtualMachineInstanceConditionType = "AgentConnected"
 
@@ -558,6 +624,8 @@ const (
 	VirtualMachineInstanceVCPUChange = "HotVCPUChange"
 	// Indicates that the VMI is hot(un)plugging memory
 	VirtualMachineInstanceMemoryChange = "HotMemoryChange"
+	// Indicates that the VMI volumes are being migrated to new volumes
+	VirtualMachineInstanceVolumesChange VirtualMachineInstanceConditionType = "VolumesChange"
 
 	// Summarizes that all the DataVolumes attached to the VMI are Ready or not
 	VirtualMachineInstanceDataVolumesReady = "DataVolumesReady"
@@ -656,6 +724,8 @@ type VirtualMachineInstanceNetworkInterface struct {
 	InfoSource string `json:"infoSource,omitempty"`
 	// Specifies how many queues are allocated by MultiQueue
 	QueueCount int32 `json:"queueCount,omitempty"`
+	// The link state of the interface seen by the guest, values: up, down.
+	LinkState InterfaceState `json:"linkState,omitempty"`
 }
 
 type VirtualMachineInstanceGuestOSInfo struct {
@@ -719,6 +789,38 @@ type VirtualMachineInstanceMigrationState struct {
 	MigrationUID types.UID `json:"migrationUid,omitempty"`
 	// Lets us know if the vmi is currently running pre or post copy migration
 	Mode MigrationMode `json:"mode,omitempty"`
+	// Escalation reports the escalation stages the migration went through
+	// +optional
+	Escalation *MigrationEscalationStatus `json:"escalation,omitempty"`
+	// The reason reported by the source node for a failed migration
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+	// DataTransferred is the number of bytes sent to the target node
+	// +optional
+	DataTransferred int64 `json:"dataTransferred,omitempty"`
+	// Downtime is the time the guest was paused to switch over to the target node
+	// +optional
+	Downtime *metav1.Duration `json:"downtime,omitempty"`
+	// SetupTime is the time the migration spent before it started to transfer data
+	// +optional
+	SetupTime *metav1.Duration `json:"setupTime,omitempty"`
+	// TotalTime is the time the migration took from its start to its completion
+	// +optional
+	TotalTime *metav1.Duration `json:"totalTime,omitempty"`
+	// DirtyRate is an estimate of the memory dirty rate of the guest while the migration was running,
+	// updated once per minute
+	// +optional
+	DirtyRate *MigrationDirtyRate `json:"dirtyRate,omitempty"`
+	// The UID of the migrated VMI in the source cluster of a cross-cluster migration
+	// +optional
+	SourceVirtualMachineInstanceUID types.UID `json:"sourceVirtualMachineInstanceUID,omitempty"`
+	// The UID of the receiving VMI in the target cluster of a cross-cluster migration
+	// +optional
+	TargetVirtualMachineInstanceUID types.UID `json:"targetVirtualMachineInstanceUID,omitempty"`
+	// The migration tunnel of the target cluster of a cross-cluster migration,
+	// the source node sends the migration stream through it
+	// +optional
+	TargetTunnel *VirtualMachineInstanceMigrationTunnel `json:"targetTunnel,omitempty"`
 	// Name of the migration policy. If string is empty, no policy is matched
 	MigrationPolicyName *string `json:"migrationPolicyName,omitempty"`
 	// Migration configurations to apply
@@ -732,6 +834,68 @@ type VirtualMachineInstanceMigrationState struct {
 	TargetNodeTopology string `json:"targetNodeTopology,omitempty"`
 }
 
+// MigrationDirtyRate is an estimate of the memory dirty rate of a migrating guest,
+// derived from the measurements of the last minute
+type MigrationDirtyRate struct {
+	// Timestamp of the latest measurement the estimate is derived from
+	Timestamp metav1.Time `json:"timestamp"`
+	// Estimate is the average rate in bytes per second at which the guest dirtied its memory
+	Estimate int64 `json:"estimate"`
+	// TransferRate is the average rate in bytes per second at which memory was transferred
+	// +optional
+	TransferRate int64 `json:"transferRate,omitempty"`
+	// Trend tells whether the dirty rate increased or decreased compared to the minute before
+	// +optional
+	Trend MigrationDirtyRateTrend `json:"trend,omitempty"`
+}
+
+// MigrationDirtyRateTrend is the direction the memory dirty rate of a migrating guest develops in
+type MigrationDirtyRateTrend string
+
+const (
+	MigrationDirtyRateIncreasing MigrationDirtyRateTrend = "Increasing"
+	MigrationDirtyRateDecreasing MigrationDirtyRateTrend = "Decreasing"
+	MigrationDirtyRateStable     MigrationDirtyRateTrend = "Stable"
+)
+
+// VirtualMachineInstanceMigrationHistoryEntry records the outcome of a finished live migration
+type VirtualMachineInstanceMigrationHistoryEntry struct {
+	// The name of the VirtualMachineInstanceMigration object
+	MigrationName string `json:"migrationName"`
+	// The UID of the VirtualMachineInstanceMigration object
+	MigrationUID types.UID `json:"migrationUid"`
+	// The time the migration began
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// The time the migration ended
+	// +optional
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// Duration is the time between the start and the end of the migration
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// The node the vmi was migrated from
+	// +optional
+	SourceNode string `json:"sourceNode,omitempty"`
+	// The node the vmi was migrated to
+	// +optional
+	TargetNode string `json:"targetNode,omitempty"`
+	// Mode is the migration mode the migration ended in
+	// +optional
+	Mode MigrationMode `json:"mode,omitempty"`
+	// Downtime is the time the guest was paused to switch over to the target node
+	// +optional
+	Downtime *metav1.Duration `json:"downtime,omitempty"`
+	// DataTransferred is the number of bytes sent to the target node
+	// +optional
+	DataTransferred int64 `json:"dataTransferred,omitempty"`
+	// Indicates that the migration failed
+	// +optional
+	Failed bool `json:"failed,omitempty"`
+	// The reason of a failed migration
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
 type MigrationAbortStatus string
 
 const (
@@ -752,6 +916,47 @@ const (
 	MigrationPostCopy MigrationMode = "PostCopy"
 )
 
+// MigrationEscalationStatus reports the escalation of a live migration
+type MigrationEscalationStatus struct {
+	// Stage is the current escalation stage of the migration
+	Stage MigrationEscalationStage `json:"stage"`
+	// Transitions lists the stages the migration entered, the first one being PreCopy
+	// +optional
+	// +listType=atomic
+	Transitions []MigrationEscalationTransition `json:"transitions,omitempty"`
+}
+
+// MigrationEscalationTransition records when a live migration entered an escalation stage
+// and the measurements which triggered it
+type MigrationEscalationTransition struct {
+	// Stage the migration entered
+	Stage MigrationEscalationStage `json:"stage"`
+	// Timestamp of the transition
+	Timestamp metav1.Time `json:"timestamp"`
+	// DirtyRate is the rate in bytes per second at which the guest dirtied its memory
+	// +optional
+	DirtyRate int64 `json:"dirtyRate,omitempty"`
+	// TransferRate is the rate in bytes per second at which memory was transferred
+	// +optional
+	TransferRate int64 `json:"transferRate,omitempty"`
+	// DataRemaining is the number of bytes which still had to be transferred
+	// +optional
+	DataRemaining int64 `json:"dataRemaining,omitempty"`
+}
+
+type MigrationEscalationStage string
+
+const (
+	// MigrationEscalationPreCopy is the initial stage, the migration runs in pre-copy with the configured bandwidth
+	MigrationEscalationPreCopy MigrationEscalationStage = "PreCopy"
+	// MigrationEscalationBandwidth raises the bandwidth limit of the migration
+	MigrationEscalationBandwidth MigrationEscalationStage = "Bandwidth"
+	// MigrationEscalationAutoConverge waits for the auto-converge throttling of the guest CPUs to converge the migration
+	MigrationEscalationAutoConverge MigrationEscalationStage = "AutoConverge"
+	// MigrationEscalationPostCopy switches the migration to post-copy
+	MigrationEscalationPostCopy MigrationEscalationStage = "PostCopy"
+)
+
 type VirtualMachineInstanceMigrationTransport string
 
 const (
@@ -840,9 +1045,24 @@ const (
 	// This annotation indicates that a migration is the result of an
 	// automated evacuation
 	EvacuationMigrationAnnotation string = "kubevirt.io/evacuationMigration"
+	// This annotation holds the evacuation plan and progress of the virtual
+	// machine instances on a node while the node is drained. It contains a
+	// JSON encoded NodeEvacuationStatus. Used on Node.
+	NodeEvacuationStatusAnnotation string = "kubevirt.io/evacuation-status"
 	// This annotation indicates that a migration is the result of an
 	// automated workload update
 	WorkloadUpdateMigrationAnnotation string = "kubevirt.io/workloadUpdateMigration"
+	// This label excludes the virtual machine instances of a namespace from
+	// automated workload updates when set to "true". Used on Namespace.
+	WorkloadUpdateOptOutLabel string = "kubevirt.io/workload-update-opt-out"
+	// This annotation marks a virtual machine instance which waits to receive
+	// a cross-cluster migration. It holds the ID of the migration. Used on
+	// VirtualMachineInstance.
+	MigrationReceiverAnnotation string = "kubevirt.io/migration-receiver"
+	// This annotation holds the UID of the migrated virtual machine instance
+	// in the source cluster of a cross-cluster migration. Used on
+	// VirtualMachineInstance.
+	MigrationSourceUIDAnnotation string = "kubevirt.io/migration-source-uid"
 	// This label declares whether a particular node is available for
 	// scheduling virtual machine instances on it. Used on Node.
 	NodeSchedulable string = "kubevirt.io/schedulable"
@@ -1302,8 +1522,79 @@ type VirtualMachineInstanceMigrationList struct {
 type VirtualMachineInstanceMigrationSpec struct {
 	// The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace
 	VMIName string `json:"vmiName,omitempty" valid:"required"`
+
+	// Priority of the migration among the pending migrations, migrations with a higher priority are started first.
+	// Defaults to a priority derived from the origin of the migration: evacuations come first,
+	// followed by migrations requested by users and finally workload updates.
+	// Only KubeVirt admins may set a priority above the default of migrations requested by users.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+
+	// SendTo migrates the VMI to another cluster. A receiving migration is created in the target
+	// cluster, the migration stream is sent through the migration tunnel of the target cluster.
+	// +optional
+	SendTo *VirtualMachineInstanceMigrationSendTo `json:"sendTo,omitempty"`
+
+	// Receive prepares the VMI to receive a migration from another cluster.
+	// Receiving migrations are created by the source cluster of the migration.
+	// +optional
+	Receive *VirtualMachineInstanceMigrationReceive `json:"receive,omitempty"`
 }
 
+// CrossClusterMigrationKubeconfigKey is the key of the kubeconfig in the secret referenced by
+// cross-cluster migrations
+const CrossClusterMigrationKubeconfigKey = "kubeconfig"
+
+// CrossClusterMigrationKubeconfigSecretType is the type of the secrets which may be referenced by
+// cross-cluster migrations
+const CrossClusterMigrationKubeconfigSecretType k8sv1.SecretType = "kubevirt.io/cross-cluster-kubeconfig"
+
+// VirtualMachineInstanceMigrationSendTo describes the target cluster of a cross-cluster migration.
+// The disks of the VMI are not copied, its persistent volume claims have to exist in the target
+// cluster under the same names and have to be bound to the same CSI volumes or NFS exports.
+// Host disks can't be migrated to another cluster.
+type VirtualMachineInstanceMigrationSendTo struct {
+	// MigrationID identifies the migration in both clusters
+	MigrationID string `json:"migrationID"`
+	// KubeconfigSecretName is the name of a secret of the type "kubevirt.io/cross-cluster-kubeconfig"
+	// in the namespace of the migration, which the creator of the migration must be allowed to read.
+	// The kubeconfig it holds under the key "kubeconfig" is used to access the target cluster,
+	// it needs to be allowed to read the persistent volume claims and persistent volumes there.
+	KubeconfigSecretName string `json:"kubeconfigSecretName"`
+}
+
+// VirtualMachineInstanceMigrationReceive describes the receiving side of a cross-cluster migration
+type VirtualMachineInstanceMigrationReceive struct {
+	// MigrationID identifies the migration in both clusters
+	MigrationID string `json:"migrationID"`
+	// Template of the receiving VMI, virt-controller creates the VMI from it.
+	// The creator of the migration must be allowed to create VMIs in its namespace.
+	Template *VirtualMachineInstanceTemplateSpec `json:"template"`
+	// SourceCABundle is the PEM encoded CA bundle of the source cluster. The migration tunnel only
+	// accepts the migration stream from virt-handler client certificates signed by it.
+	SourceCABundle []byte `json:"sourceCABundle"`
+}
+
+// VirtualMachineInstanceMigrationTunnel is the TLS endpoint of virt-api in the target cluster of a
+// cross-cluster migration, which forwards the migration stream to the target node
+type VirtualMachineInstanceMigrationTunnel struct {
+	// Address is the host and port the tunnel is reachable at from the source cluster
+	Address string `json:"address"`
+	// ServerName is the name the certificate of the tunnel is issued for
+	ServerName string `json:"serverName"`
+	// CABundle is the PEM encoded CA bundle which signed the certificate of the tunnel
+	CABundle []byte `json:"caBundle"`
+}
+
+const (
+	// MigrationPriorityEvacuation is the default priority of migrations created to evacuate a node
+	MigrationPriorityEvacuation int32 = 3000
+	// MigrationPriorityUser is the default priority of migrations requested by users
+	MigrationPriorityUser int32 = 2000
+	// MigrationPriorityWorkloadUpdate is the default priority of migrations created by the workload updater
+	MigrationPriorityWorkloadUpdate int32 = 1000
+)
+
 // VirtualMachineInstanceMigrationPhaseTransitionTimestamp gives a timestamp in relation to when a phase is set on a vmi
 type VirtualMachineInstanceMigrationPhaseTransitionTimestamp struct {
 	// Phase is the status of the VirtualMachineInstanceMigrationPhase in kubernetes world. It is not the VirtualMachineInstanceMigrationPhase status, but partially correlates to it.
@@ -1322,6 +1613,10 @@ type VirtualMachineInstanceMigrationStatus struct {
 	PhaseTransitionTimestamps []VirtualMachineInstanceMigrationPhaseTransitionTimestamp `json:"phaseTransitionTimestamps,omitempty"`
 	// Represents the status of a live migration
 	MigrationState *VirtualMachineInstanceMigrationState `json:"migrationState,omitempty"`
+	// QueuePosition is the position of the pending migration in the cluster wide migration queue, starting at 1.
+	// It is removed once the target pod of the migration is created.
+	// +optional
+	QueuePosition *int32 `json:"queuePosition,omitempty"`
 }
 
 // VirtualMachineInstanceMigrationPhase is a label for the condition of a VirtualMachineInstanceMigration at the current time.
@@ -1490,8 +1785,21 @@ type VirtualMachineSpec struct {
 
 	// LiveUpdateFeatures references a configuration of hotpluggable resources
 	LiveUpdateFeatures *LiveUpdateFeatures `json:"liveUpdateFeatures,omitempty" optional:"true"`
+
+	// UpdateVolumesStrategy is the strategy to apply on volumes updates
+	// +optional
+	UpdateVolumesStrategy *UpdateVolumesStrategy `json:"updateVolumesStrategy,omitempty"`
 }
 
+type UpdateVolumesStrategy string
+
+const (
+	// UpdateVolumesStrategyReplacement applies the volume changes on the next restart of the VirtualMachine
+	UpdateVolumesStrategyReplacement UpdateVolumesStrategy = "Replacement"
+	// UpdateVolumesStrategyMigration copies the content of the old volumes to the new ones with a live migration
+	UpdateVolumesStrategyMigration UpdateVolumesStrategy = "Migration"
+)
+
 // StateChangeRequestType represents the existing state change requests that are possible
 type StateChangeRequestAction string
 
@@ -1608,6 +1916,84 @@ type VirtualMachineStatus struct {
 	// updated through an Update() before ObservedGeneration in Status.
 	// +optional
 	DesiredGeneration int64 `json:"desiredGeneration,omitempty" optional:"true"`
+
+	// VolumeUpdateState contains the information about the volumes set
+	// updates related to the volumeUpdateStrategy
+	// +nullable
+	// +optional
+	VolumeUpdateState *VolumeUpdateState `json:"volumeUpdateState,omitempty" optional:"true"`
+
+	// BackupCheckpoint is the latest changed block tracking checkpoint created by a
+	// backup of the VirtualMachine
+	// +nullable
+	// +optional
+	BackupCheckpoint *VirtualMachineBackupCheckpoint `json:"backupCheckpoint,omitempty" optional:"true"`
+}
+
+// VirtualMachineBackupCheckpoint represents a changed block tracking checkpoint of the VirtualMachine disks
+type VirtualMachineBackupCheckpoint struct {
+	// Name is the name of the checkpoint in the domain
+	Name string `json:"name"`
+	// BackupName is the name of the VirtualMachineBackup which created the checkpoint
+	BackupName string `json:"backupName"`
+	// VMIUID is the UID of the VirtualMachineInstance the checkpoint was created in.
+	// The dirty bitmaps of the checkpoint are persisted in the disk images, the checkpoint
+	// is redefined from them once the VirtualMachine is restarted.
+	VMIUID types.UID `json:"vmiUID"`
+	// CreationTimestamp is the time when the checkpoint was created
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+	// Volumes is the list of volumes tracked by a dirty bitmap of the checkpoint
+	// +listType=atomic
+	// +optional
+	Volumes []string `json:"volumes,omitempty"`
+}
+
+// VolumeUpdateState contains the information about the volume updates of the VirtualMachine
+type VolumeUpdateState struct {
+	// VolumeMigrationState tracks the progress and the result of a volume migration
+	// +nullable
+	// +optional
+	VolumeMigrationState *VolumeMigrationState `json:"volumeMigrationState,omitempty"`
+}
+
+// VolumeMigrationPhase is the phase of a volume migration
+type VolumeMigrationPhase string
+
+const (
+	// VolumeMigrationPending means the volume migration has been requested but not started yet
+	VolumeMigrationPending VolumeMigrationPhase = "Pending"
+	// VolumeMigrationRunning means the volumes are being copied to the destination volumes
+	VolumeMigrationRunning VolumeMigrationPhase = "Running"
+	// VolumeMigrationSucceeded means the VirtualMachineInstance is running with the destination volumes
+	VolumeMigrationSucceeded VolumeMigrationPhase = "Succeeded"
+	// VolumeMigrationFailed means the volume migration failed
+	VolumeMigrationFailed VolumeMigrationPhase = "Failed"
+)
+
+// VolumeMigrationState tracks the progress and the result of a volume migration
+type VolumeMigrationState struct {
+	// MigratedVolumes lists the source and destination volumes of the migration
+	// +listType=atomic
+	// +optional
+	MigratedVolumes []StorageMigratedVolumeInfo `json:"migratedVolumes,omitempty"`
+	// Phase is the current phase of the volume migration
+	// +optional
+	Phase VolumeMigrationPhase `json:"phase,omitempty"`
+	// StartTimestamp is the time the volume migration started
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// EndTimestamp is the time the volume migration completed
+	// +optional
+	// +nullable
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// FailureReason reports the reason of the last failure of the volume migration
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+	// ManualRecoveryRequired indicates if the VirtualMachine needs to be restarted
+	// or the volume set reverted to the source volumes by the user
+	// +optional
+	ManualRecoveryRequired bool `json:"manualRecoveryRequired,omitempty"`
 }
 
 type VolumeSnapshotStatus struct {
@@ -1859,6 +2245,42 @@ type KubeVirtWorkloadUpdateStrategy struct {
 	//
 	// +optional
 	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
+
+	// MaintenanceWindows restricts automated workload updates to recurring
+	// time windows. Outside of all windows no migrations or evictions are
+	// started, while the ones already started are allowed to finish.
+	//
+	// An empty list allows automated workload updates at any time
+	//
+	// +listType=atomic
+	// +optional
+	MaintenanceWindows []WorkloadUpdateMaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+// WorkloadUpdateMaintenanceWindow defines a recurring time window in which
+// automated workload updates are allowed
+type WorkloadUpdateMaintenanceWindow struct {
+	// Schedule is a cron expression in the standard five field format
+	// (minute, hour, day of month, month, day of week) which defines when
+	// the window opens. The schedule is evaluated in UTC.
+	Schedule string `json:"schedule"`
+
+	// Duration defines how long the window stays open after each start
+	Duration metav1.Duration `json:"duration"`
+
+	// BatchMigrationSize limits the number of workload update migrations
+	// which run in parallel while the window is open
+	//
+	// Defaults to the cluster wide parallelMigrationsPerCluster
+	//
+	// +optional
+	BatchMigrationSize *int `json:"batchMigrationSize,omitempty"`
+
+	// BatchEvictionSize overrides the BatchEvictionSize of the strategy
+	// while the window is open
+	//
+	// +optional
+	BatchEvictionSize *int `json:"batchEvictionSize,omitempty"`
 }
 
 type KubeVirtSpec struct {
@@ -1992,24 +2414,46 @@ type GenerationStatus struct {
 
 // KubeVirtStatus represents information pertaining to a KubeVirt deployment.
 type KubeVirtStatus struct {
-	Phase                                   KubeVirtPhase       `json:"phase,omitempty"`
-	Conditions                              []KubeVirtCondition `json:"conditions,omitempty" optional:"true"`
-	OperatorVersion                         string              `json:"operatorVersion,omitempty" optional:"true"`
-	TargetKubeVirtRegistry                  string              `json:"targetKubeVirtRegistry,omitempty" optional:"true"`
-	TargetKubeVirtVersion                   string              `json:"targetKubeVirtVersion,omitempty" optional:"true"`
-	TargetDeploymentConfig                  string              `json:"targetDeploymentConfig,omitempty" optional:"true"`
-	TargetDeploymentID                      string              `json:"targetDeploymentID,omitempty" optional:"true"`
-	ObservedKubeVirtRegistry                string              `json:"observedKubeVirtRegistry,omitempty" optional:"true"`
-	ObservedKubeVirtVersion                 string              `json:"observedKubeVirtVersion,omitempty" optional:"true"`
-	ObservedDeploymentConfig                string              `json:"observedDeploymentConfig,omitempty" optional:"true"`
-	ObservedDeploymentID                    string              `json:"observedDeploymentID,omitempty" optional:"true"`
-	OutdatedVirtualMachineInstanceWorkloads *int                `json:"outdatedVirtualMachineInstanceWorkloads,omitempty" optional:"true"`
-	ObservedGeneration                      *int64              `json:"observedGeneration,omitempty"`
-	DefaultArchitecture                     string              `json:"defaultArchitecture,omitempty"`
+	Phase                                   KubeVirtPhase                 `json:"phase,omitempty"`
+	Conditions                              []KubeVirtCondition           `json:"conditions,omitempty" optional:"true"`
+	OperatorVersion                         string                        `json:"operatorVersion,omitempty" optional:"true"`
+	TargetKubeVirtRegistry                  string                        `json:"targetKubeVirtRegistry,omitempty" optional:"true"`
+	TargetKubeVirtVersion                   string                        `json:"targetKubeVirtVersion,omitempty" optional:"true"`
+	TargetDeploymentConfig                  string                        `json:"targetDeploymentConfig,omitempty" optional:"true"`
+	TargetDeploymentID                      string                        `json:"targetDeploymentID,omitempty" optional:"true"`
+	ObservedKubeVirtRegistry                string                        `json:"observedKubeVirtRegistry,omitempty" optional:"true"`
+	ObservedKubeVirtVersion                 string                        `json:"observedKubeVirtVersion,omitempty" optional:"true"`
+	ObservedDeploymentConfig                string                        `json:"observedDeploymentConfig,omitempty" optional:"true"`
+	ObservedDeploymentID                    string                        `json:"observedDeploymentID,omitempty" optional:"true"`
+	OutdatedVirtualMachineInstanceWorkloads *int                          `json:"outdatedVirtualMachineInstanceWorkloads,omitempty" optional:"true"`
+	WorkloadUpdateStatus                    *KubeVirtWorkloadUpdateStatus `json:"workloadUpdateStatus,omitempty" optional:"true"`
+	ObservedGeneration                      *int64                        `json:"observedGeneration,omitempty"`
+	DefaultArchitecture                     string                        `json:"defaultArchitecture,omitempty"`
 	// +listType=atomic
 	Generations []GenerationStatus `json:"generations,omitempty" optional:"true"`
 }
 
+// KubeVirtWorkloadUpdateStatus reports the progress of automated workload updates
+type KubeVirtWorkloadUpdateStatus struct {
+	// StartTimestamp is the time when outdated workloads were detected for the current update
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// TotalWorkloads is the number of workloads which were outdated during the current update
+	TotalWorkloads int `json:"totalWorkloads"`
+	// UpdatedWorkloads is the number of workloads which were updated since the start of the current update
+	UpdatedWorkloads int `json:"updatedWorkloads"`
+	// OptedOutWorkloads is the number of outdated workloads which are excluded from automated updates by their namespace
+	OptedOutWorkloads int `json:"optedOutWorkloads"`
+	// InMaintenanceWindow indicates whether workload updates are currently allowed by the maintenance windows
+	InMaintenanceWindow bool `json:"inMaintenanceWindow"`
+	// NextMaintenanceWindow is the time when the next maintenance window opens
+	// +optional
+	NextMaintenanceWindow *metav1.Time `json:"nextMaintenanceWindow,omitempty"`
+	// EstimatedCompletionTimestamp is the expected time when all workloads are updated, based on the progress so far
+	// +optional
+	EstimatedCompletionTimestamp *metav1.Time `json:"estimatedCompletionTimestamp,omitempty"`
+}
+
 // KubeVirtPhase is a label for the phase of a KubeVirt deployment at the current time.
 type KubeVirtPhase string
 
@@ -2243,6 +2687,121 @@ type VirtualMachineInstanceFileSystem struct {
 	Disk           []VirtualMachineInstanceFileSystemDisk `json:"disk,omitempty"`
 }
 
+// VirtualMachineInstanceMigrateCheck reports whether a VirtualMachineInstance can be live migrated
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceMigrateCheck struct {
+	metav1.TypeMeta `json:",inline"`
+	// Namespace of the VirtualMachineInstance
+	Namespace string `json:"namespace"`
+	// Name of the VirtualMachineInstance
+	Name string `json:"name"`
+	// NodeName is the node the VirtualMachineInstance is running on
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+	// Migratable is true if all checks passed
+	Migratable bool `json:"migratable"`
+	// Checks contains the result of every pre-flight check
+	// +listType=atomic
+	Checks []MigrateCheck `json:"checks"`
+	// TargetNodes lists the nodes which can host the migration target
+	// +optional
+	// +listType=atomic
+	TargetNodes []string `json:"targetNodes,omitempty"`
+}
+
+// MigrateCheck is the result of a single live migration pre-flight check
+type MigrateCheck struct {
+	// Type of the check
+	Type MigrateCheckType `json:"type"`
+	// Passed is true if the check did not find anything preventing the migration
+	Passed bool `json:"passed"`
+	// Message explains why the check failed
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type MigrateCheckType string
+
+const (
+	// MigrateCheckLiveMigratable checks the LiveMigratable condition of the VirtualMachineInstance
+	MigrateCheckLiveMigratable MigrateCheckType = "LiveMigratable"
+	// MigrateCheckHostModelCPU checks that another node provides the host-model CPU of the VirtualMachineInstance
+	MigrateCheckHostModelCPU MigrateCheckType = "HostModelCPU"
+	// MigrateCheckVolumeAccessModes checks that all persistent volume claims can be shared between nodes
+	MigrateCheckVolumeAccessModes MigrateCheckType = "VolumeAccessModes"
+	// MigrateCheckDisruptionBudget checks that the PodDisruptionBudgets of the virt-launcher pod allow a disruption
+	MigrateCheckDisruptionBudget MigrateCheckType = "DisruptionBudget"
+	// MigrateCheckTargetNodeCapacity checks that another node has the capacity to run the migration target
+	MigrateCheckTargetNodeCapacity MigrateCheckType = "TargetNodeCapacity"
+)
+
+// NodeEvacuationStatus is the evacuation plan and progress of the VirtualMachineInstances on a node
+type NodeEvacuationStatus struct {
+	// Phase of the evacuation
+	Phase NodeEvacuationPhase `json:"phase"`
+	// VirtualMachineInstances lists the VirtualMachineInstances on the node
+	// +optional
+	// +listType=atomic
+	VirtualMachineInstances []VirtualMachineInstanceEvacuation `json:"virtualMachineInstances,omitempty"`
+}
+
+type NodeEvacuationPhase string
+
+const (
+	// NodeEvacuationInProgress means that VirtualMachineInstances are still running on the node
+	NodeEvacuationInProgress NodeEvacuationPhase = "InProgress"
+	// NodeEvacuationCompleted means that no VirtualMachineInstance is left on the node
+	NodeEvacuationCompleted NodeEvacuationPhase = "Completed"
+)
+
+// VirtualMachineInstanceEvacuation is the planned evacuation action of a VirtualMachineInstance and its progress
+type VirtualMachineInstanceEvacuation struct {
+	// Namespace of the VirtualMachineInstance
+	Namespace string `json:"namespace"`
+	// Name of the VirtualMachineInstance
+	Name string `json:"name"`
+	// Action which is taken to evacuate the VirtualMachineInstance
+	Action EvacuationAction `json:"action"`
+	// Reason explains the planned action
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Progress of the evacuation of the VirtualMachineInstance
+	Progress EvacuationProgress `json:"progress"`
+	// MigrationName is the name of the migration which evacuates the VirtualMachineInstance
+	// +optional
+	MigrationName string `json:"migrationName,omitempty"`
+	// MigrationPhase is the phase of the migration which evacuates the VirtualMachineInstance
+	// +optional
+	MigrationPhase VirtualMachineInstanceMigrationPhase `json:"migrationPhase,omitempty"`
+}
+
+type EvacuationAction string
+
+const (
+	// EvacuationActionLiveMigrate means that the VirtualMachineInstance is live migrated to another node
+	EvacuationActionLiveMigrate EvacuationAction = "LiveMigrate"
+	// EvacuationActionShutdown means that the VirtualMachineInstance is shut down when its pod is evicted
+	EvacuationActionShutdown EvacuationAction = "Shutdown"
+	// EvacuationActionBlocked means that the VirtualMachineInstance blocks the drain of the node
+	EvacuationActionBlocked EvacuationAction = "Blocked"
+	// EvacuationActionExternal means that the evacuation is left to an external controller
+	EvacuationActionExternal EvacuationAction = "External"
+)
+
+type EvacuationProgress string
+
+const (
+	// EvacuationPending means that the evacuation of the VirtualMachineInstance did not start yet
+	EvacuationPending EvacuationProgress = "Pending"
+	// EvacuationMigrating means that the VirtualMachineInstance is being migrated
+	EvacuationMigrating EvacuationProgress = "Migrating"
+	// EvacuationFailed means that the last migration of the VirtualMachineInstance failed, it will be retried
+	EvacuationFailed EvacuationProgress = "Failed"
+	// EvacuationShuttingDown means that the VirtualMachineInstance is shutting down
+	EvacuationShuttingDown EvacuationProgress = "ShuttingDown"
+)
+
 // FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command
 type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
@@ -2573,11 +3132,93 @@ type MigrationConfiguration struct {
 	// Network is the name of the CNI network to use for live migrations. By default, migrations go
 	// through the pod network.
 	Network *string `json:"network,omitempty"`
+	// AdditionalNetworks are the names of further CNI networks virt-handler is attached to.
+	// Migration policies can select one of them as the network of the migrations they apply to.
+	// +listType=atomic
+	// +optional
+	AdditionalNetworks []string `json:"additionalNetworks,omitempty"`
 	// By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
 	// When set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.
 	// That will ensure the target virt-launcher doesn't share categories with another pod on the node.
 	// However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
 	MatchSELinuxLevelOnMigration *bool `json:"matchSELinuxLevelOnMigration,omitempty"`
+	// Escalation enables the staged escalation of live migrations which don't converge.
+	// Migrations start in pre-copy and, while the guest dirties memory faster than it is transferred,
+	// escalate to a raised bandwidth, to auto-converge throttling and finally to post-copy.
+	// The auto-converge and post-copy stages are only used if AllowAutoConverge and AllowPostCopy are true.
+	// Defaults to no escalation, post-copy is then only triggered by CompletionTimeoutPerGiB
+	// +optional
+	Escalation *MigrationEscalation `json:"escalation,omitempty"`
+	// MultifdChannels is the number of parallel connections (QEMU multifd channels) live migrations
+	// transfer the guest memory over. Multiple channels allow migrations to exceed the throughput of a
+	// single TCP stream. The kubevirt.io/multiThreadedQemuMigration annotation of a VMI takes precedence.
+	// Defaults to a single connection
+	// +optional
+	MultifdChannels *uint32 `json:"multifdChannels,omitempty"`
+	// Compression compresses the memory transferred over the multifd channels.
+	// It is only applied to migrations which use MultifdChannels. Defaults to no compression
+	// +optional
+	Compression *MigrationCompression `json:"compression,omitempty"`
+	// MaxDowntime is the maximum time the guest may be paused to switch over to the target node.
+	// A migration does not complete before its remaining data can be transferred within this time.
+	// Defaults to the default of libvirt, 300ms
+	// +optional
+	MaxDowntime *metav1.Duration `json:"maxDowntime,omitempty"`
+	// CrossClusterTunnelAddress is the host and port other clusters reach the migration tunnel of
+	// virt-api at, which listens on the port 8444 of the virt-api pods. It has to be exposed
+	// outside of the cluster, e.g. by a service of the type LoadBalancer.
+	// Cross-cluster migrations are only received when it is set
+	// +optional
+	CrossClusterTunnelAddress *string `json:"crossClusterTunnelAddress,omitempty"`
+}
+
+// MigrationCompressionMethod is the algorithm the migration streams are compressed with
+type MigrationCompressionMethod string
+
+const (
+	MigrationCompressionZstd MigrationCompressionMethod = "zstd"
+	MigrationCompressionZlib MigrationCompressionMethod = "zlib"
+)
+
+// MigrationCompression configures the compression of multifd migration streams
+type MigrationCompression struct {
+	// Method is the compression algorithm, either zstd or zlib
+	// +kubebuilder:validation:Enum=zstd;zlib
+	Method MigrationCompressionMethod `json:"method"`
+	// Level is the compression level. zlib supports levels from 0 to 9, zstd from 0 to 20.
+	// Defaults to the default level of the method in QEMU
+	// +optional
+	Level *int32 `json:"level,omitempty"`
+}
+
+// MigrationEscalation configures when a live migration escalates to the next stage
+type MigrationEscalation struct {
+	// StageDuration is the minimum number of seconds a migration stays in a stage before it escalates
+	// to the next one. Defaults to 30
+	// +optional
+	StageDuration *int64 `json:"stageDuration,omitempty"`
+	// DirtyRateThresholdPercent escalates a migration when the guest dirties memory at more than this
+	// percentage of the transfer rate. Migrations whose remaining data did not decrease during a stage
+	// are escalated as well. Defaults to 80
+	// +optional
+	DirtyRateThresholdPercent *uint32 `json:"dirtyRateThresholdPercent,omitempty"`
+	// RemainingDataThreshold prevents the escalation of migrations with less data left to transfer,
+	// since they are about to complete. Defaults to 256Mi
+	// +optional
+	RemainingDataThreshold *resource.Quantity `json:"remainingDataThreshold,omitempty"`
+	// Bandwidth is the bandwidth limit of the raised bandwidth stage. The value is in quantity per second.
+	// Defaults to 0 (no limit). The stage is skipped if BandwidthPerMigration does not limit migrations.
+	// +optional
+	Bandwidth *resource.Quantity `json:"bandwidth,omitempty"`
+	// AutoConvergeInitial is the percentage the guest CPUs are throttled by once auto-converge starts throttling
+	// a migration which does not converge. libvirt only accepts it when the migration starts, so it applies
+	// to the whole migration. Must be between 1 and 99. Defaults to 20
+	// +optional
+	AutoConvergeInitial *int32 `json:"autoConvergeInitial,omitempty"`
+	// AutoConvergeIncrement is the percentage the throttling of the guest CPUs is increased by in every
+	// iteration which still does not converge. Must be between 1 and 99. Defaults to 10
+	// +optional
+	AutoConvergeIncrement *int32 `json:"autoConvergeIncrement,omitempty"`
 }
 
 // DiskVerification holds container disks verification limits
@@ -2731,6 +3372,26 @@ type NetworkConfiguration struct {
 	PermitSlirpInterface              *bool                             `json:"permitSlirpInterface,omitempty"`
 	PermitBridgeInterfaceOnPodNetwork *bool                             `json:"permitBridgeInterfaceOnPodNetwork,omitempty"`
 	Binding                           map[string]InterfaceBindingPlugin `json:"binding,omitempty"`
+	// MacAddressPool defines the MAC addresses assigned to the interfaces of the VMs
+	// which do not specify one.
+	// Requires the MacAddressPool feature gate.
+	// +optional
+	MacAddressPool *MacAddressPool `json:"macAddressPool,omitempty"`
+}
+
+// MacAddressPool defines the ranges of MAC addresses the cluster assigns from.
+type MacAddressPool struct {
+	// Ranges of MAC addresses, each address is assigned to a single interface across the cluster.
+	// +listType=atomic
+	Ranges []MacAddressRange `json:"ranges"`
+}
+
+// MacAddressRange is a range of MAC addresses, including both its start and end.
+type MacAddressRange struct {
+	// Start is the first MAC address of the range.
+	Start string `json:"start"`
+	// End is the last MAC address of the range.
+	End string `json:"end"`
 }
 
 type InterfaceBindingPlugin struct {
@@ -2888,10 +3549,20 @@ type LiveUpdateFeatures struct {
 	// MemoryLiveUpdateConfiguration defines the live update memory features for the VirtualMachine
 	// +optional
 	Memory *LiveUpdateMemory `json:"memory,omitempty"`
+	// DiskIOTune allows live updating the IO throttling of the virtual machine disks
+	// +optional
+	DiskIOTune *LiveUpdateDiskIOTune `json:"diskIOTune,omitempty"`
+	// InterfaceBandwidth allows live updating the bandwidth limits of the virtual machine interfaces
+	// +optional
+	InterfaceBandwidth *LiveUpdateInterfaceBandwidth `json:"interfaceBandwidth,omitempty"`
 }
 
 type LiveUpdateAffinity struct{}
 
+type LiveUpdateDiskIOTune struct{}
+
+type LiveUpdateInterfaceBandwidth struct{}
+
 type LiveUpdateCPU struct {
 	// The maximum amount of sockets that can be hot-plugged to the Virtual Machine
 	MaxSockets *uint32 `json:"maxSockets,omitempty" optional:"true"`
@@ -35,6 +35,7 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"hostname":                      "Specifies the hostname of the vmi\nIf not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.\n+optional",
 		"subdomain":                     "If specified, the fully qualified vmi hostname will be \"<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>\".\nIf not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi,\nno matter if the vmi itself can pick up a hostname.\n+optional",
 		"networks":                      "List of networks that can be attached to a vm's virtual interface.",
+		"firewall":                      "Firewall defines the rules filtering the traffic of the pod network interface.\nOnly supported with the masquerade and passt bindings.\nThe rules can be updated while the vmi is running.\n+optional",
 		"dnsPolicy":                     "Set DNS policy for the pod.\nDefaults to \"ClusterFirst\".\nValid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.\nDNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy.\nTo have DNS options set along with hostNetwork, you have to specify DNS policy\nexplicitly to 'ClusterFirstWithHostNet'.\n+optional",
 		"dnsConfig":                     "Specifies the DNS parameters of a pod.\nParameters specified here will be merged to the generated DNS\nconfiguration based on DNSPolicy.\n+optional",
 		"accessCredentials":             "Specifies a set of public keys to inject into the vm guest\n+listType=atomic\n+optional",
@@ -63,8 +64,10 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"phase":                         "Phase is the status of the VirtualMachineInstance in kubernetes world. It is not the VirtualMachineInstance status, but partially correlates to it.",
 		"phaseTransitionTimestamps":     "PhaseTransitionTimestamp is the timestamp of when the last phase change occurred\n+listType=atomic\n+optional",
 		"interfaces":                    "Interfaces represent the details of available network interfaces.",
+		"firewall":                      "Firewall represents the firewall rules currently enforced on the pod network interface.\n+optional",
 		"guestOSInfo":                   "Guest OS Information",
 		"migrationState":                "Represents the status of a live migration",
+		"migrationHistory":              "MigrationHistory lists the most recent finished live migrations of the vmi, the oldest first\n+optional\n+listType=atomic",
 		"migrationMethod":               "Represents the method using which the vmi can be migrated: live migration or block migration",
 		"migrationTransport":            "This represents the migration transport",
 		"qosClass":                      "The Quality of Service (QOS) classification assigned to the virtual machine instance based on resource requirements\nSee PodQOSClass type for available QOS classes\nMore info: https://git.k8s.io/community/contributors/design-proposals/node/resource-qos.md\n+optional",
@@ -82,12 +85,23 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"machine":                       "Machine shows the final resulting qemu machine type. This can be different\nthan the machine type selected in the spec, due to qemus machine type alias mechanism.\n+optional",
 		"currentCPUTopology":            "CurrentCPUTopology specifies the current CPU topology used by the VM workload.\nCurrent topology may differ from the desired topology in the spec while CPU hotplug\ntakes place.",
 		"memory":                        "Memory shows various informations about the VirtualMachine memory.\n+optional",
+		"migratedVolumes":               "MigratedVolumes lists the source and destination volumes during the volume migration\n+listType=atomic\n+optional",
+	}
+}
+
+func (StorageMigratedVolumeInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "StorageMigratedVolumeInfo tracks the information about the source and destination volumes during the volume migration",
+		"volumeName":         "VolumeName is the name of the volume that is being migrated",
+		"sourcePVCInfo":      "SourcePVCInfo contains the information about the source PVC",
+		"destinationPVCInfo": "DestinationPVCInfo contains the information about the destination PVC",
 	}
 }
 
 func (PersistentVolumeClaimInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                   "PersistentVolumeClaimInfo contains the relavant information virt-handler needs cached about a PVC",
+		"claimName":          "ClaimName is the name of the PVC",
 		"accessModes":        "AccessModes contains the desired access modes the volume should have.\nMore info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1\n+listType=atomic\n+optional",
 		"volumeMode":         "VolumeMode defines what type of volume is required by the claim.\nValue of Filesystem is implied when not included in claim spec.\n+optional",
 		"capacity":           "Capacity represents the capacity set on the corresponding PVC status\n+optional",
@@ -109,6 +123,7 @@ func (VolumeStatus) SwaggerDoc() map[string]string {
 		"hotplugVolume":             "If the volume is hotplug, this will contain the hotplug status.",
 		"size":                      "Represents the size of the volume",
 		"memoryDumpVolume":          "If the volume is memorydump volume, this will contain the memorydump info.",
+		"backupVolume":              "If the volume is a backup volume, this will contain the backup info.",
 		"containerDiskVolume":       "ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk",
 	}
 }
@@ -145,6 +160,18 @@ func (DomainMemoryDumpInfo) SwaggerDoc() map[string]string {
 	}
 }
 
+func (DomainBackupInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "DomainBackupInfo represents the backup information",
+		"startTimestamp":     "StartTimestamp is the time when the backup started",
+		"endTimestamp":       "EndTimestamp is the time when the backup completed",
+		"targetDirectory":    "TargetDirectory is the directory of the volume containing the backup images",
+		"volumes":            "Volumes is the list of volumes included in the backup\n+listType=atomic",
+		"incrementalVolumes": "IncrementalVolumes is the list of volumes whose backup image only contains\nthe blocks changed since the parent checkpoint, the other volumes were copied entirely\n+listType=atomic\n+optional",
+		"checkpointVolumes":  "CheckpointVolumes is the list of volumes tracked by a dirty bitmap of the checkpoint created with the backup.\nOnly the qcow2 disk images can store a dirty bitmap.\n+listType=atomic\n+optional",
+	}
+}
+
 func (HotplugVolumeStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":              "HotplugVolumeStatus represents the hotplug status of the volume",
@@ -183,6 +210,7 @@ func (VirtualMachineInstanceNetworkInterface) SwaggerDoc() map[string]string {
 		"interfaceName": "The interface name inside the Virtual Machine",
 		"infoSource":    "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
 		"queueCount":    "Specifies how many queues are allocated by MultiQueue",
+		"linkState":     "The link state of the interface seen by the guest, values: up, down.",
 	}
 }
 
@@ -201,27 +229,84 @@ func (VirtualMachineInstanceGuestOSInfo) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                               "+k8s:openapi-gen=true",
-		"startTimestamp":                 "The time the migration action began\n+nullable",
-		"endTimestamp":                   "The time the migration action ended\n+nullable",
-		"targetNodeDomainReadyTimestamp": "The timestamp at which the target node detects the domain is active",
-		"targetNodeDomainDetected":       "The Target Node has seen the Domain Start Event",
-		"targetNodeAddress":              "The address of the target node to use for the migration",
-		"targetDirectMigrationNodePorts": "The list of ports opened for live migration on the destination node",
-		"targetNode":                     "The target node that the VMI is moving to",
-		"targetPod":                      "The target pod that the VMI is moving to",
-		"targetAttachmentPodUID":         "The UID of the target attachment pod for hotplug volumes",
-		"sourceNode":                     "The source node that the VMI originated on",
-		"completed":                      "Indicates the migration completed",
-		"failed":                         "Indicates that the migration failed",
-		"abortRequested":                 "Indicates that the migration has been requested to abort",
-		"abortStatus":                    "Indicates the final status of the live migration abortion",
-		"migrationUid":                   "The VirtualMachineInstanceMigration object associated with this migration",
-		"mode":                           "Lets us know if the vmi is currently running pre or post copy migration",
-		"migrationPolicyName":            "Name of the migration policy. If string is empty, no policy is matched",
-		"migrationConfiguration":         "Migration configurations to apply",
-		"targetCPUSet":                   "If the VMI requires dedicated CPUs, this field will\nhold the dedicated CPU set on the target node\n+listType=atomic",
-		"targetNodeTopology":             "If the VMI requires dedicated CPUs, this field will\nhold the numa topology on the target node",
+		"":                                "+k8s:openapi-gen=true",
+		"startTimestamp":                  "The time the migration action began\n+nullable",
+		"endTimestamp":                    "The time the migration action ended\n+nullable",
+		"targetNodeDomainReadyTimestamp":  "The timestamp at which the target node detects the domain is active",
+		"targetNodeDomainDetected":        "The Target Node has seen the Domain Start Event",
+		"targetNodeAddress":               "The address of the target node to use for the migration",
+		"targetDirectMigrationNodePorts":  "The list of ports opened for live migration on the destination node",
+		"targetNode":                      "The target node that the VMI is moving to",
+		"targetPod":                       "The target pod that the VMI is moving to",
+		"targetAttachmentPodUID":          "The UID of the target attachment pod for hotplug volumes",
+		"sourceNode":                      "The source node that the VMI originated on",
+		"completed":                       "Indicates the migration completed",
+		"failed":                          "Indicates that the migration failed",
+		"abortRequested":                  "Indicates that the migration has been requested to abort",
+		"abortStatus":                     "Indicates the final status of the live migration abortion",
+		"migrationUid":                    "The VirtualMachineInstanceMigration object associated with this migration",
+		"mode":                            "Lets us know if the vmi is currently running pre or post copy migration",
+		"escalation":                      "Escalation reports the escalation stages the migration went through\n+optional",
+		"failureReason":                   "The reason reported by the source node for a failed migration\n+optional",
+		"dataTransferred":                 "DataTransferred is the number of bytes sent to the target node\n+optional",
+		"downtime":                        "Downtime is the time the guest was paused to switch over to the target node\n+optional",
+		"setupTime":                       "SetupTime is the time the migration spent before it started to transfer data\n+optional",
+		"totalTime":                       "TotalTime is the time the migration took from its start to its completion\n+optional",
+		"dirtyRate":                       "DirtyRate is an estimate of the memory dirty rate of the guest while the migration was running,\nupdated once per minute\n+optional",
+		"sourceVirtualMachineInstanceUID": "The UID of the migrated VMI in the source cluster of a cross-cluster migration\n+optional",
+		"targetVirtualMachineInstanceUID": "The UID of the receiving VMI in the target cluster of a cross-cluster migration\n+optional",
+		"targetTunnel":                    "The migration tunnel of the target cluster of a cross-cluster migration,\nthe source node sends the migration stream through it\n+optional",
+		"migrationPolicyName":             "Name of the migration policy. If string is empty, no policy is matched",
+		"migrationConfiguration":          "Migration configurations to apply",
+		"targetCPUSet":                    "If the VMI requires dedicated CPUs, this field will\nhold the dedicated CPU set on the target node\n+listType=atomic",
+		"targetNodeTopology":              "If the VMI requires dedicated CPUs, this field will\nhold the numa topology on the target node",
+	}
+}
+
+func (MigrationDirtyRate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "MigrationDirtyRate is an estimate of the memory dirty rate of a migrating guest,\nderived from the measurements of the last minute",
+		"timestamp":    "Timestamp of the latest measurement the estimate is derived from",
+		"estimate":     "Estimate is the average rate in bytes per second at which the guest dirtied its memory",
+		"transferRate": "TransferRate is the average rate in bytes per second at which memory was transferred\n+optional",
+		"trend":        "Trend tells whether the dirty rate increased or decreased compared to the minute before\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceMigrationHistoryEntry) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineInstanceMigrationHistoryEntry records the outcome of a finished live migration",
+		"migrationName":   "The name of the VirtualMachineInstanceMigration object",
+		"migrationUid":    "The UID of the VirtualMachineInstanceMigration object",
+		"startTimestamp":  "The time the migration began\n+optional",
+		"endTimestamp":    "The time the migration ended\n+optional",
+		"duration":        "Duration is the time between the start and the end of the migration\n+optional",
+		"sourceNode":      "The node the vmi was migrated from\n+optional",
+		"targetNode":      "The node the vmi was migrated to\n+optional",
+		"mode":            "Mode is the migration mode the migration ended in\n+optional",
+		"downtime":        "Downtime is the time the guest was paused to switch over to the target node\n+optional",
+		"dataTransferred": "DataTransferred is the number of bytes sent to the target node\n+optional",
+		"failed":          "Indicates that the migration failed\n+optional",
+		"failureReason":   "The reason of a failed migration\n+optional",
+	}
+}
+
+func (MigrationEscalationStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "MigrationEscalationStatus reports the escalation of a live migration",
+		"stage":       "Stage is the current escalation stage of the migration",
+		"transitions": "Transitions lists the stages the migration entered, the first one being PreCopy\n+optional\n+listType=atomic",
+	}
+}
+
+func (MigrationEscalationTransition) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "MigrationEscalationTransition records when a live migration entered an escalation stage\nand the measurements which triggered it",
+		"stage":         "Stage the migration entered",
+		"timestamp":     "Timestamp of the transition",
+		"dirtyRate":     "DirtyRate is the rate in bytes per second at which the guest dirtied its memory\n+optional",
+		"transferRate":  "TransferRate is the rate in bytes per second at which memory was transferred\n+optional",
+		"dataRemaining": "DataRemaining is the number of bytes which still had to be transferred\n+optional",
 	}
 }
 
@@ -301,7 +386,36 @@ func (VirtualMachineInstanceMigrationList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceMigrationSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"vmiName": "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"vmiName":  "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"priority": "Priority of the migration among the pending migrations, migrations with a higher priority are started first.\nDefaults to a priority derived from the origin of the migration: evacuations come first,\nfollowed by migrations requested by users and finally workload updates.\nOnly KubeVirt admins may set a priority above the default of migrations requested by users.\n+optional",
+		"sendTo":   "SendTo migrates the VMI to another cluster. A receiving migration is created in the target\ncluster, the migration stream is sent through the migration tunnel of the target cluster.\n+optional",
+		"receive":  "Receive prepares the VMI to receive a migration from another cluster.\nReceiving migrations are created by the source cluster of the migration.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceMigrationSendTo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "VirtualMachineInstanceMigrationSendTo describes the target cluster of a cross-cluster migration.\nThe disks of the VMI are not copied, its persistent volume claims have to exist in the target\ncluster under the same names and have to be bound to the same CSI volumes or NFS exports.\nHost disks can't be migrated to another cluster.",
+		"migrationID":          "MigrationID identifies the migration in both clusters",
+		"kubeconfigSecretName": "KubeconfigSecretName is the name of a secret of the type \"kubevirt.io/cross-cluster-kubeconfig\"\nin the namespace of the migration, which the creator of the migration must be allowed to read.\nThe kubeconfig it holds under the key \"kubeconfig\" is used to access the target cluster,\nit needs to be allowed to read the persistent volume claims and persistent volumes there.",
+	}
+}
+
+func (VirtualMachineInstanceMigrationReceive) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceMigrationReceive describes the receiving side of a cross-cluster migration",
+		"migrationID":    "MigrationID identifies the migration in both clusters",
+		"template":       "Template of the receiving VMI, virt-controller creates the VMI from it.\nThe creator of the migration must be allowed to create VMIs in its namespace.",
+		"sourceCABundle": "SourceCABundle is the PEM encoded CA bundle of the source cluster. The migration tunnel only\naccepts the migration stream from virt-handler client certificates signed by it.",
+	}
+}
+
+func (VirtualMachineInstanceMigrationTunnel) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachineInstanceMigrationTunnel is the TLS endpoint of virt-api in the target cluster of a\ncross-cluster migration, which forwards the migration stream to the target node",
+		"address":    "Address is the host and port the tunnel is reachable at from the source cluster",
+		"serverName": "ServerName is the name the certificate of the tunnel is issued for",
+		"caBundle":   "CABundle is the PEM encoded CA bundle which signed the certificate of the tunnel",
 	}
 }
 
@@ -318,6 +432,7 @@ func (VirtualMachineInstanceMigrationStatus) SwaggerDoc() map[string]string {
 		"":                          "VirtualMachineInstanceMigration reprents information pertaining to a VMI's migration.",
 		"phaseTransitionTimestamps": "PhaseTransitionTimestamp is the timestamp of when the last phase change occurred\n+listType=atomic\n+optional",
 		"migrationState":            "Represents the status of a live migration",
+		"queuePosition":             "QueuePosition is the position of the pending migration in the cluster wide migration queue, starting at 1.\nIt is removed once the target pod of the migration is created.\n+optional",
 	}
 }
 
@@ -357,14 +472,15 @@ func (VirtualMachineList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                    "VirtualMachineSpec describes how the proper VirtualMachine\nshould look like",
-		"running":             "Running controls whether the associatied VirtualMachineInstance is created or not\nMutually exclusive with RunStrategy",
-		"runStrategy":         "Running state indicates the requested running state of the VirtualMachineInstance\nmutually exclusive with Running",
-		"instancetype":        "InstancetypeMatcher references a instancetype that is used to fill fields in Template",
-		"preference":          "PreferenceMatcher references a set of preference that is used to fill fields in Template",
-		"template":            "Template is the direct specification of VirtualMachineInstance",
-		"dataVolumeTemplates": "dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.\nDataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.",
-		"liveUpdateFeatures":  "LiveUpdateFeatures references a configuration of hotpluggable resources",
+		"":                      "VirtualMachineSpec describes how the proper VirtualMachine\nshould look like",
+		"running":               "Running controls whether the associatied VirtualMachineInstance is created or not\nMutually exclusive with RunStrategy",
+		"runStrategy":           "Running state indicates the requested running state of the VirtualMachineInstance\nmutually exclusive with Running",
+		"instancetype":          "InstancetypeMatcher references a instancetype that is used to fill fields in Template",
+		"preference":            "PreferenceMatcher references a set of preference that is used to fill fields in Template",
+		"template":              "Template is the direct specification of VirtualMachineInstance",
+		"dataVolumeTemplates":   "dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.\nDataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.",
+		"liveUpdateFeatures":    "LiveUpdateFeatures references a configuration of hotpluggable resources",
+		"updateVolumesStrategy": "UpdateVolumesStrategy is the strategy to apply on volumes updates\n+optional",
 	}
 }
 
@@ -390,6 +506,38 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"memoryDumpRequest":      "MemoryDumpRequest tracks memory dump request phase and info of getting a memory\ndump to the given pvc\n+nullable\n+optional",
 		"observedGeneration":     "ObservedGeneration is the generation observed by the vmi when started.\n+optional",
 		"desiredGeneration":      "DesiredGeneration is the generation which is desired for the VMI.\nThis will be used in comparisons with ObservedGeneration to understand when\nthe VMI is out of sync. This will be changed at the same time as\nObservedGeneration to remove errors which could occur if Generation is\nupdated through an Update() before ObservedGeneration in Status.\n+optional",
+		"volumeUpdateState":      "VolumeUpdateState contains the information about the volumes set\nupdates related to the volumeUpdateStrategy\n+nullable\n+optional",
+		"backupCheckpoint":       "BackupCheckpoint is the latest changed block tracking checkpoint created by a\nbackup of the VirtualMachine\n+nullable\n+optional",
+	}
+}
+
+func (VirtualMachineBackupCheckpoint) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "VirtualMachineBackupCheckpoint represents a changed block tracking checkpoint of the VirtualMachine disks",
+		"name":              "Name is the name of the checkpoint in the domain",
+		"backupName":        "BackupName is the name of the VirtualMachineBackup which created the checkpoint",
+		"vmiUID":            "VMIUID is the UID of the VirtualMachineInstance the checkpoint was created in.\nThe dirty bitmaps of the checkpoint are persisted in the disk images, the checkpoint\nis redefined from them once the VirtualMachine is restarted.",
+		"creationTimestamp": "CreationTimestamp is the time when the checkpoint was created",
+		"volumes":           "Volumes is the list of volumes tracked by a dirty bitmap of the checkpoint\n+listType=atomic\n+optional",
+	}
+}
+
+func (VolumeUpdateState) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "VolumeUpdateState contains the information about the volume updates of the VirtualMachine",
+		"volumeMigrationState": "VolumeMigrationState tracks the progress and the result of a volume migration\n+nullable\n+optional",
+	}
+}
+
+func (VolumeMigrationState) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "VolumeMigrationState tracks the progress and the result of a volume migration",
+		"migratedVolumes":        "MigratedVolumes lists the source and destination volumes of the migration\n+listType=atomic\n+optional",
+		"phase":                  "Phase is the current phase of the volume migration\n+optional",
+		"startTimestamp":         "StartTimestamp is the time the volume migration started\n+optional\n+nullable",
+		"endTimestamp":           "EndTimestamp is the time the volume migration completed\n+optional\n+nullable",
+		"failureReason":          "FailureReason reports the reason of the last failure of the volume migration\n+optional",
+		"manualRecoveryRequired": "ManualRecoveryRequired indicates if the VirtualMachine needs to be restarted\nor the volume set reverted to the source volumes by the user\n+optional",
 	}
 }
 
@@ -485,6 +633,17 @@ func (KubeVirtWorkloadUpdateStrategy) SwaggerDoc() map[string]string {
 		"workloadUpdateMethods": "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads\nduring automated workload updates.\nWhen multiple methods are present, the least disruptive method takes\nprecedence over more disruptive methods. For example if both LiveMigrate and Shutdown\nmethods are listed, only VMs which are not live migratable will be restarted/shutdown\n\nAn empty list defaults to no automated workload updating\n\n+listType=atomic\n+optional",
 		"batchEvictionSize":     "BatchEvictionSize Represents the number of VMIs that can be forced updated per\nthe BatchShutdownInteral interval\n\nDefaults to 10\n\n+optional",
 		"batchEvictionInterval": "BatchEvictionInterval Represents the interval to wait before issuing the next\nbatch of shutdowns\n\nDefaults to 1 minute\n\n+optional",
+		"maintenanceWindows":    "MaintenanceWindows restricts automated workload updates to recurring\ntime windows. Outside of all windows no migrations or evictions are\nstarted, while the ones already started are allowed to finish.\n\nAn empty list allows automated workload updates at any time\n\n+listType=atomic\n+optional",
+	}
+}
+
+func (WorkloadUpdateMaintenanceWindow) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "WorkloadUpdateMaintenanceWindow defines a recurring time window in which\nautomated workload updates are allowed",
+		"schedule":           "Schedule is a cron expression in the standard five field format\n(minute, hour, day of month, month, day of week) which defines when\nthe window opens. The schedule is evaluated in UTC.",
+		"duration":           "Duration defines how long the window stays open after each start",
+		"batchMigrationSize": "BatchMigrationSize limits the number of workload update migrations\nwhich run in parallel while the window is open\n\nDefaults to the cluster wide parallelMigrationsPerCluster\n\n+optional",
+		"batchEvictionSize":  "BatchEvictionSize overrides the BatchEvictionSize of the strategy\nwhile the window is open\n\n+optional",
 	}
 }
 
@@ -547,6 +706,19 @@ func (KubeVirtStatus) SwaggerDoc() map[string]string {
 	}
 }
 
+func (KubeVirtWorkloadUpdateStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                             "KubeVirtWorkloadUpdateStatus reports the progress of automated workload updates",
+		"startTimestamp":               "StartTimestamp is the time when outdated workloads were detected for the current update\n+optional",
+		"totalWorkloads":               "TotalWorkloads is the number of workloads which were outdated during the current update",
+		"updatedWorkloads":             "UpdatedWorkloads is the number of workloads which were updated since the start of the current update",
+		"optedOutWorkloads":            "OptedOutWorkloads is the number of outdated workloads which are excluded from automated updates by their namespace",
+		"inMaintenanceWindow":          "InMaintenanceWindow indicates whether workload updates are currently allowed by the maintenance windows",
+		"nextMaintenanceWindow":        "NextMaintenanceWindow is the time when the next maintenance window opens\n+optional",
+		"estimatedCompletionTimestamp": "EstimatedCompletionTimestamp is the expected time when all workloads are updated, based on the progress so far\n+optional",
+	}
+}
+
 func (KubeVirtCondition) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                   "KubeVirtCondition represents a condition of a KubeVirt deployment",
@@ -656,6 +828,48 @@ func (VirtualMachineInstanceFileSystem) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceMigrateCheck) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceMigrateCheck reports whether a VirtualMachineInstance can be live migrated\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"namespace":   "Namespace of the VirtualMachineInstance",
+		"name":        "Name of the VirtualMachineInstance",
+		"nodeName":    "NodeName is the node the VirtualMachineInstance is running on\n+optional",
+		"migratable":  "Migratable is true if all checks passed",
+		"checks":      "Checks contains the result of every pre-flight check\n+listType=atomic",
+		"targetNodes": "TargetNodes lists the nodes which can host the migration target\n+optional\n+listType=atomic",
+	}
+}
+
+func (MigrateCheck) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "MigrateCheck is the result of a single live migration pre-flight check",
+		"type":    "Type of the check",
+		"passed":  "Passed is true if the check did not find anything preventing the migration",
+		"message": "Message explains why the check failed\n+optional",
+	}
+}
+
+func (NodeEvacuationStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                        "NodeEvacuationStatus is the evacuation plan and progress of the VirtualMachineInstances on a node",
+		"phase":                   "Phase of the evacuation",
+		"virtualMachineInstances": "VirtualMachineInstances lists the VirtualMachineInstances on the node\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineInstanceEvacuation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceEvacuation is the planned evacuation action of a VirtualMachineInstance and its progress",
+		"namespace":      "Namespace of the VirtualMachineInstance",
+		"name":           "Name of the VirtualMachineInstance",
+		"action":         "Action which is taken to evacuate the VirtualMachineInstance",
+		"reason":         "Reason explains the planned action\n+optional",
+		"progress":       "Progress of the evacuation of the VirtualMachineInstance",
+		"migrationName":  "MigrationName is the name of the migration which evacuates the VirtualMachineInstance\n+optional",
+		"migrationPhase": "MigrationPhase is the phase of the migration which evacuates the VirtualMachineInstance\n+optional",
+	}
+}
+
 func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command",
@@ -816,7 +1030,33 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"allowPostCopy":                     "AllowPostCopy enables post-copy live migrations. Such migrations allow even the busiest VMIs\nto successfully live-migrate. However, events like a network failure can cause a VMI crash.\nIf set to true, migrations will still start in pre-copy, but switch to post-copy when\nCompletionTimeoutPerGiB triggers. Defaults to false",
 		"disableTLS":                        "When set to true, DisableTLS will disable the additional layer of live migration encryption\nprovided by KubeVirt. This is usually a bad idea. Defaults to false",
 		"network":                           "Network is the name of the CNI network to use for live migrations. By default, migrations go\nthrough the pod network.",
+		"additionalNetworks":                "AdditionalNetworks are the names of further CNI networks virt-handler is attached to.\nMigration policies can select one of them as the network of the migrations they apply to.\n+listType=atomic\n+optional",
 		"matchSELinuxLevelOnMigration":      "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.\nWhen set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.\nThat will ensure the target virt-launcher doesn't share categories with another pod on the node.\nHowever, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
+		"escalation":                        "Escalation enables the staged escalation of live migrations which don't converge.\nMigrations start in pre-copy and, while the guest dirties memory faster than it is transferred,\nescalate to a raised bandwidth, to auto-converge throttling and finally to post-copy.\nThe auto-converge and post-copy stages are only used if AllowAutoConverge and AllowPostCopy are true.\nDefaults to no escalation, post-copy is then only triggered by CompletionTimeoutPerGiB\n+optional",
+		"multifdChannels":                   "MultifdChannels is the number of parallel connections (QEMU multifd channels) live migrations\ntransfer the guest memory over. Multiple channels allow migrations to exceed the throughput of a\nsingle TCP stream. The kubevirt.io/multiThreadedQemuMigration annotation of a VMI takes precedence.\nDefaults to a single connection\n+optional",
+		"compression":                       "Compression compresses the memory transferred over the multifd channels.\nIt is only applied to migrations which use MultifdChannels. Defaults to no compression\n+optional",
+		"maxDowntime":                       "MaxDowntime is the maximum time the guest may be paused to switch over to the target node.\nA migration does not complete before its remaining data can be transferred within this time.\nDefaults to the default of libvirt, 300ms\n+optional",
+		"crossClusterTunnelAddress":         "CrossClusterTunnelAddress is the host and port other clusters reach the migration tunnel of\nvirt-api at, which listens on the port 8444 of the virt-api pods. It has to be exposed\noutside of the cluster, e.g. by a service of the type LoadBalancer.\nCross-cluster migrations are only received when it is set\n+optional",
+	}
+}
+
+func (MigrationCompression) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "MigrationCompression configures the compression of multifd migration streams",
+		"method": "Method is the compression algorithm, either zstd or zlib\n+kubebuilder:validation:Enum=zstd;zlib",
+		"level":  "Level is the compression level. zlib supports levels from 0 to 9, zstd from 0 to 20.\nDefaults to the default level of the method in QEMU\n+optional",
+	}
+}
+
+func (MigrationEscalation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "MigrationEscalation configures when a live migration escalates to the next stage",
+		"stageDuration":             "StageDuration is the minimum number of seconds a migration stays in a stage before it escalates\nto the next one. Defaults to 30\n+optional",
+		"dirtyRateThresholdPercent": "DirtyRateThresholdPercent escalates a migration when the guest dirties memory at more than this\npercentage of the transfer rate. Migrations whose remaining data did not decrease during a stage\nare escalated as well. Defaults to 80\n+optional",
+		"remainingDataThreshold":    "RemainingDataThreshold prevents the escalation of migrations with less data left to transfer,\nsince they are about to complete. Defaults to 256Mi\n+optional",
+		"bandwidth":                 "Bandwidth is the bandwidth limit of the raised bandwidth stage. The value is in quantity per second.\nDefaults to 0 (no limit). The stage is skipped if BandwidthPerMigration does not limit migrations.\n+optional",
+		"autoConvergeInitial":       "AutoConvergeInitial is the percentage the guest CPUs are throttled by once auto-converge starts throttling\na migration which does not converge. libvirt only accepts it when the migration starts, so it applies\nto the whole migration. Must be between 1 and 99. Defaults to 20\n+optional",
+		"autoConvergeIncrement":     "AutoConvergeIncrement is the percentage the throttling of the guest CPUs is increased by in every\niteration which still does not converge. Must be between 1 and 99. Defaults to 10\n+optional",
 	}
 }
 
@@ -910,7 +1150,23 @@ func (KSMConfiguration) SwaggerDoc() map[string]string {
 
 func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "NetworkConfiguration holds network options",
+		"":               "NetworkConfiguration holds network options",
+		"macAddressPool": "MacAddressPool defines the MAC addresses assigned to the interfaces of the VMs\nwhich do not specify one.\nRequires the MacAddressPool feature gate.\n+optional",
+	}
+}
+
+func (MacAddressPool) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "MacAddressPool defines the ranges of MAC addresses the cluster assigns from.",
+		"ranges": "Ranges of MAC addresses, each address is assigned to a single interface across the cluster.\n+listType=atomic",
+	}
+}
+
+func (MacAddressRange) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "MacAddressRange is a range of MAC addresses, including both its start and end.",
+		"start": "Start is the first MAC address of the range.",
+		"end":   "End is the last MAC address of the range.",
 	}
 }
 
@@ -964,9 +1220,11 @@ func (PreferenceMatcher) SwaggerDoc() map[string]string {
 
 func (LiveUpdateFeatures) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"cpu":      "LiveUpdateCPU holds hotplug configuration for the CPU resource.\nEmpty struct indicates that default will be used for maxSockets.\nDefault is specified on cluster level.\nAbsence of the struct means opt-out from CPU hotplug functionality.",
-		"affinity": "Affinity allows live updating the virtual machines node affinity",
-		"memory":   "MemoryLiveUpdateConfiguration defines the live update memory features for the VirtualMachine\n+optional",
+		"cpu":                "LiveUpdateCPU holds hotplug configuration for the CPU resource.\nEmpty struct indicates that default will be used for maxSockets.\nDefault is specified on cluster level.\nAbsence of the struct means opt-out from CPU hotplug functionality.",
+		"affinity":           "Affinity allows live updating the virtual machines node affinity",
+		"memory":             "MemoryLiveUpdateConfiguration defines the live update memory features for the VirtualMachine\n+optional",
+		"diskIOTune":         "DiskIOTune allows live updating the IO throttling of the virtual machine disks\n+optional",
+		"interfaceBandwidth": "InterfaceBandwidth allows live updating the bandwidth limits of the virtual machine interfaces\n+optional",
 	}
 }
 
@@ -974,6 +1232,14 @@ func (LiveUpdateAffinity) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (LiveUpdateDiskIOTune) SwaggerDoc() map[string]string {
+	return map[string]string{}
+}
+
+func (LiveUpdateInterfaceBandwidth) SwaggerDoc() map[string]string {
+	return map[string]string{}
+}
+
 func (LiveUpdateCPU) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"maxSockets": "The maximum amount of sockets that can be hot-plugged to the Virtual Machine",
@@ -157,6 +157,12 @@ const (
 	AllManifests ExportManifestType = "all"
 	// AuthHeader returns a CDI compatible secret containing the token as an Auth header
 	AuthHeader ExportManifestType = "auth-header-secret"
+	// OVF returns an OVF descriptor generated from the exported VirtualMachine
+	OVF ExportManifestType = "ovf"
+	// OVA returns an OVA bundle containing the OVF descriptor and the raw disk images
+	OVA ExportManifestType = "ova"
+	// Checksums returns the SHA-256 checksums of the raw volumes in the format of sha256sum
+	Checksums ExportManifestType = "checksums"
 )
 
 // VirtualMachineExportVolume contains the name and available formats for the exported volume
@@ -176,10 +182,18 @@ const (
 	KubeVirtRaw ExportVolumeFormat = "raw"
 	// KubeVirtGZ is the volume in gzipped RAW format.
 	KubeVirtGz ExportVolumeFormat = "gzip"
+	// KubeVirtQcow2 is the volume as a sparse qcow2 image generated on the fly.
+	// Adding the query parameter compressed=true to the URL compresses the data clusters. Zero clusters
+	// are only left out and clusters only compressed within the first 2GiB of data, so that the image
+	// starts streaming quickly, the data regions after that are stored as is.
+	KubeVirtQcow2 ExportVolumeFormat = "qcow2"
 	// Dir is an uncompressed directory, which points to the root of a PersistentVolumeClaim, exposed using a FileServer https://pkg.go.dev/net/http#FileServer
 	Dir ExportVolumeFormat = "dir"
 	// ArchiveGz is a tarred and gzipped version of the root of a PersistentVolumeClaim
 	ArchiveGz ExportVolumeFormat = "tar.gz"
+	// BackupQcow2 is the qcow2 image of a volume written by a VirtualMachineBackup, served as is.
+	// The image of an incremental backup only contains the clusters changed since the parent checkpoint.
+	BackupQcow2 ExportVolumeFormat = "backup-qcow2"
 )
 
 // VirtualMachineExportVolumeFormat contains the format type and URL to get the volume in that format
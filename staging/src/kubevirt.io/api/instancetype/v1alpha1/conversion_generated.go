@@ -667,6 +667,8 @@ func autoConvert_v1beta1_VirtualMachineInstancetypeSpec_To_v1alpha1_VirtualMachi
 	out.HostDevices = *(*[]corev1.HostDevice)(unsafe.Pointer(&in.HostDevices))
 	out.IOThreadsPolicy = (*corev1.IOThreadsPolicy)(unsafe.Pointer(in.IOThreadsPolicy))
 	out.LaunchSecurity = (*corev1.LaunchSecurity)(unsafe.Pointer(in.LaunchSecurity))
+	// WARNING: in.DiskIOTune requires manual conversion: does not exist in peer-type
+	// WARNING: in.InterfaceBandwidth requires manual conversion: does not exist in peer-type
 	// WARNING: in.Annotations requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -603,6 +603,16 @@ func (in *VirtualMachineInstancetypeSpec) DeepCopyInto(out *VirtualMachineInstan
 		*out = new(v1.LaunchSecurity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DiskIOTune != nil {
+		in, out := &in.DiskIOTune, &out.DiskIOTune
+		*out = new(v1.DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InterfaceBandwidth != nil {
+		in, out := &in.InterfaceBandwidth, &out.InterfaceBandwidth
+		*out = new(v1.InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Annotations != nil {
 		in, out := &in.Annotations, &out.Annotations
 		*out = make(map[string]string, len(*in))
@@ -117,6 +117,18 @@ type VirtualMachineInstancetypeSpec struct {
 	// +optional
 	LaunchSecurity *v1.LaunchSecurity `json:"launchSecurity,omitempty"`
 
+	// Optionally defines the IO throttling applied to the disks of the VirtualMachineInstance.
+	// CD-ROMs are not throttled.
+	//
+	// +optional
+	DiskIOTune *v1.DiskIOTune `json:"diskIOTune,omitempty"`
+
+	// Optionally defines the bandwidth limits applied to the network interfaces of the VirtualMachineInstance.
+	// SR-IOV interfaces are not limited.
+	//
+	// +optional
+	InterfaceBandwidth *v1.InterfaceBandwidth `json:"interfaceBandwidth,omitempty"`
+
 	// Optionally defines the required Annotations to be used by the instance type and applied to the VirtualMachineInstance
 	//
 	// +optional
@@ -22,7 +22,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -53,7 +55,7 @@ func (in *MigrationPolicy) DeepCopyInto(out *MigrationPolicy) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -75,6 +77,22 @@ func (in *MigrationPolicy) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationPolicyConflict) DeepCopyInto(out *MigrationPolicyConflict) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationPolicyConflict.
+func (in *MigrationPolicyConflict) DeepCopy() *MigrationPolicyConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationPolicyConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationPolicyList) DeepCopyInto(out *MigrationPolicyList) {
 	*out = *in
@@ -108,6 +126,22 @@ func (in *MigrationPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationPolicyMatch) DeepCopyInto(out *MigrationPolicyMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationPolicyMatch.
+func (in *MigrationPolicyMatch) DeepCopy() *MigrationPolicyMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationPolicyMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationPolicySpec) DeepCopyInto(out *MigrationPolicySpec) {
 	*out = *in
@@ -136,6 +170,51 @@ func (in *MigrationPolicySpec) DeepCopyInto(out *MigrationPolicySpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ProgressTimeout != nil {
+		in, out := &in.ProgressTimeout, &out.ProgressTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ParallelMigrations != nil {
+		in, out := &in.ParallelMigrations, &out.ParallelMigrations
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Escalation != nil {
+		in, out := &in.Escalation, &out.Escalation
+		*out = new(v1.MigrationEscalation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MultifdChannels != nil {
+		in, out := &in.MultifdChannels, &out.MultifdChannels
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(v1.MigrationCompression)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxDowntime != nil {
+		in, out := &in.MaxDowntime, &out.MaxDowntime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisableTLS != nil {
+		in, out := &in.DisableTLS, &out.DisableTLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -152,6 +231,16 @@ func (in *MigrationPolicySpec) DeepCopy() *MigrationPolicySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationPolicyStatus) DeepCopyInto(out *MigrationPolicyStatus) {
 	*out = *in
+	if in.MatchedVirtualMachineInstances != nil {
+		in, out := &in.MatchedVirtualMachineInstances, &out.MatchedVirtualMachineInstances
+		*out = make([]MigrationPolicyMatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]MigrationPolicyConflict, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -182,6 +271,16 @@ func (in *Selectors) DeepCopyInto(out *Selectors) {
 			(*out)[key] = val
 		}
 	}
+	if in.NamespaceLabelSelector != nil {
+		in, out := &in.NamespaceLabelSelector, &out.NamespaceLabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VirtualMachineInstanceLabelSelector != nil {
+		in, out := &in.VirtualMachineInstanceLabelSelector, &out.VirtualMachineInstanceLabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
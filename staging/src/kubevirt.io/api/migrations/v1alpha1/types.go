@@ -51,6 +51,36 @@ type MigrationPolicySpec struct {
 	CompletionTimeoutPerGiB *int64 `json:"completionTimeoutPerGiB,omitempty"`
 	//+optional
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
+	// ProgressTimeout is the time in seconds a migration may not make any progress before it is aborted
+	//+optional
+	ProgressTimeout *int64 `json:"progressTimeout,omitempty"`
+	// ParallelMigrations limits the number of migrations running at the same time for the VMIs the policy applies to
+	//+optional
+	ParallelMigrations *uint32 `json:"parallelMigrations,omitempty"`
+	// Escalation configures the staged escalation of the migrations the policy applies to
+	//+optional
+	Escalation *k6tv1.MigrationEscalation `json:"escalation,omitempty"`
+	// MultifdChannels is the number of parallel connections the migrations the policy applies to use
+	//+optional
+	MultifdChannels *uint32 `json:"multifdChannels,omitempty"`
+	// Compression compresses the multifd channels of the migrations the policy applies to
+	//+optional
+	Compression *k6tv1.MigrationCompression `json:"compression,omitempty"`
+	// MaxDowntime is the maximum time the guests the policy applies to may be paused to switch over to the target node
+	//+optional
+	MaxDowntime *metav1.Duration `json:"maxDowntime,omitempty"`
+	// Network is the name of the CNI network the migrations the policy applies to run on.
+	// It must be MigrationConfiguration.Network or one of MigrationConfiguration.AdditionalNetworks.
+	//+optional
+	Network *string `json:"network,omitempty"`
+	// DisableTLS disables TLS for the migrations the policy applies to.
+	// It can only be set if the migrations run on a dedicated migration network, see Network and MigrationConfiguration.Network.
+	//+optional
+	DisableTLS *bool `json:"disableTLS,omitempty"`
+	// DryRun prevents the policy from being applied to migrations. The status of the policy still reports
+	// the VMIs it would apply to, which allows to test a policy before it is rolled out.
+	//+optional
+	DryRun *bool `json:"dryRun,omitempty"`
 }
 
 type LabelSelector map[string]string
@@ -60,9 +90,47 @@ type Selectors struct {
 	NamespaceSelector LabelSelector `json:"namespaceSelector,omitempty"`
 	//+optional
 	VirtualMachineInstanceSelector LabelSelector `json:"virtualMachineInstanceSelector,omitempty"`
+	// NamespaceLabelSelector selects the namespaces of the VMIs with set-based requirements.
+	// It has to match in addition to NamespaceSelector.
+	//+optional
+	NamespaceLabelSelector *metav1.LabelSelector `json:"namespaceLabelSelector,omitempty"`
+	// VirtualMachineInstanceLabelSelector selects the VMIs with set-based requirements.
+	// It has to match in addition to VirtualMachineInstanceSelector.
+	//+optional
+	VirtualMachineInstanceLabelSelector *metav1.LabelSelector `json:"virtualMachineInstanceLabelSelector,omitempty"`
 }
 
 type MigrationPolicyStatus struct {
+	// MatchedVirtualMachineInstanceCount is the number of VMIs the policy applies to
+	//+optional
+	MatchedVirtualMachineInstanceCount int32 `json:"matchedVirtualMachineInstanceCount,omitempty"`
+	// MatchedVirtualMachineInstances lists the VMIs the policy applies to, the list is truncated after 100 entries
+	//+optional
+	//+listType=atomic
+	MatchedVirtualMachineInstances []MigrationPolicyMatch `json:"matchedVirtualMachineInstances,omitempty"`
+	// ConflictCount is the number of VMIs selected by the policy to which another policy applies
+	//+optional
+	ConflictCount int32 `json:"conflictCount,omitempty"`
+	// Conflicts lists the VMIs selected by the policy to which another policy applies,
+	// the list is truncated after 100 entries
+	//+optional
+	//+listType=atomic
+	Conflicts []MigrationPolicyConflict `json:"conflicts,omitempty"`
+}
+
+// MigrationPolicyMatch references a VMI a migration policy applies to
+type MigrationPolicyMatch struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// MigrationPolicyConflict references a VMI which is selected by a migration policy,
+// but to which another policy applies
+type MigrationPolicyConflict struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// AppliedPolicy is the name of the policy which applies to the VMI
+	AppliedPolicy string `json:"appliedPolicy"`
 }
 
 // MigrationPolicyList is a list of MigrationPolicy
@@ -99,6 +167,39 @@ func (m *MigrationPolicy) GetMigrationConfByPolicy(clusterMigrationConfiguration
 		changed = true
 		*clusterMigrationConfigurations.AllowPostCopy = *policySpec.AllowPostCopy
 	}
+	if policySpec.ProgressTimeout != nil {
+		changed = true
+		progressTimeout := *policySpec.ProgressTimeout
+		clusterMigrationConfigurations.ProgressTimeout = &progressTimeout
+	}
+	if policySpec.Escalation != nil {
+		changed = true
+		clusterMigrationConfigurations.Escalation = policySpec.Escalation.DeepCopy()
+	}
+	if policySpec.MultifdChannels != nil {
+		changed = true
+		multifdChannels := *policySpec.MultifdChannels
+		clusterMigrationConfigurations.MultifdChannels = &multifdChannels
+	}
+	if policySpec.Compression != nil {
+		changed = true
+		clusterMigrationConfigurations.Compression = policySpec.Compression.DeepCopy()
+	}
+	if policySpec.MaxDowntime != nil {
+		changed = true
+		maxDowntime := *policySpec.MaxDowntime
+		clusterMigrationConfigurations.MaxDowntime = &maxDowntime
+	}
+	if policySpec.Network != nil {
+		changed = true
+		network := *policySpec.Network
+		clusterMigrationConfigurations.Network = &network
+	}
+	if policySpec.DisableTLS != nil {
+		changed = true
+		disableTLS := *policySpec.DisableTLS
+		clusterMigrationConfigurations.DisableTLS = &disableTLS
+	}
 
 	return changed, nil
 }
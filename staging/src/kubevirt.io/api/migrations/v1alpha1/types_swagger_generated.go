@@ -15,18 +15,47 @@ func (MigrationPolicySpec) SwaggerDoc() map[string]string {
 		"bandwidthPerMigration":   "+optional",
 		"completionTimeoutPerGiB": "+optional",
 		"allowPostCopy":           "+optional",
+		"progressTimeout":         "ProgressTimeout is the time in seconds a migration may not make any progress before it is aborted\n+optional",
+		"parallelMigrations":      "ParallelMigrations limits the number of migrations running at the same time for the VMIs the policy applies to\n+optional",
+		"escalation":              "Escalation configures the staged escalation of the migrations the policy applies to\n+optional",
+		"multifdChannels":         "MultifdChannels is the number of parallel connections the migrations the policy applies to use\n+optional",
+		"compression":             "Compression compresses the multifd channels of the migrations the policy applies to\n+optional",
+		"maxDowntime":             "MaxDowntime is the maximum time the guests the policy applies to may be paused to switch over to the target node\n+optional",
+		"network":                 "Network is the name of the CNI network the migrations the policy applies to run on.\nIt must be MigrationConfiguration.Network or one of MigrationConfiguration.AdditionalNetworks.\n+optional",
+		"disableTLS":              "DisableTLS disables TLS for the migrations the policy applies to.\nIt can only be set if the migrations run on a dedicated migration network, see Network and MigrationConfiguration.Network.\n+optional",
+		"dryRun":                  "DryRun prevents the policy from being applied to migrations. The status of the policy still reports\nthe VMIs it would apply to, which allows to test a policy before it is rolled out.\n+optional",
 	}
 }
 
 func (Selectors) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"namespaceSelector":              "+optional",
-		"virtualMachineInstanceSelector": "+optional",
+		"namespaceSelector":                   "+optional",
+		"virtualMachineInstanceSelector":      "+optional",
+		"namespaceLabelSelector":              "NamespaceLabelSelector selects the namespaces of the VMIs with set-based requirements.\nIt has to match in addition to NamespaceSelector.\n+optional",
+		"virtualMachineInstanceLabelSelector": "VirtualMachineInstanceLabelSelector selects the VMIs with set-based requirements.\nIt has to match in addition to VirtualMachineInstanceSelector.\n+optional",
 	}
 }
 
 func (MigrationPolicyStatus) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"matchedVirtualMachineInstanceCount": "MatchedVirtualMachineInstanceCount is the number of VMIs the policy applies to\n+optional",
+		"matchedVirtualMachineInstances":     "MatchedVirtualMachineInstances lists the VMIs the policy applies to, the list is truncated after 100 entries\n+optional\n+listType=atomic",
+		"conflictCount":                      "ConflictCount is the number of VMIs selected by the policy to which another policy applies\n+optional",
+		"conflicts":                          "Conflicts lists the VMIs selected by the policy to which another policy applies,\nthe list is truncated after 100 entries\n+optional\n+listType=atomic",
+	}
+}
+
+func (MigrationPolicyMatch) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "MigrationPolicyMatch references a VMI a migration policy applies to",
+	}
+}
+
+func (MigrationPolicyConflict) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "MigrationPolicyConflict references a VMI which is selected by a migration policy,\nbut to which another policy applies",
+		"appliedPolicy": "AppliedPolicy is the name of the policy which applies to the VMI",
+	}
 }
 
 func (MigrationPolicyList) SwaggerDoc() map[string]string {
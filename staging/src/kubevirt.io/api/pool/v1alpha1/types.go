@@ -22,12 +22,18 @@ package v1alpha1
 import (
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	virtv1 "kubevirt.io/api/core/v1"
 )
 
 const (
 	VirtualMachinePoolKind = "VirtualMachinePool"
+
+	// VirtualMachinePoolDeletionCostAnnotation can be set on a VirtualMachine of a pool to influence
+	// which VirtualMachines are removed first on scale in. VirtualMachines with a lower cost are removed
+	// first, the value has to be an integer. VirtualMachines without the annotation have a cost of 0.
+	VirtualMachinePoolDeletionCostAnnotation = "pool.kubevirt.io/deletion-cost"
 )
 
 // VirtualMachinePool resource contains a VirtualMachine configuration
@@ -89,6 +95,87 @@ type VirtualMachinePoolStatus struct {
 
 	// Canonical form of the label selector for HPA which consumes it through the scale subresource.
 	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// UpdatedReplicas is the number of VirtualMachines, and their VirtualMachineInstances, which match the updateRevision.
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty" optional:"true"`
+
+	// CurrentRevision is the revision which all VirtualMachines matched when the last update completed.
+	CurrentRevision string `json:"currentRevision,omitempty" optional:"true"`
+
+	// UpdateRevision is the revision of the pool spec the VirtualMachines are updated to.
+	UpdateRevision string `json:"updateRevision,omitempty" optional:"true"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateMethod string
+
+const (
+	// VirtualMachinePoolUpdateMethodRestart updates an outdated VirtualMachineInstance by restarting it.
+	VirtualMachinePoolUpdateMethodRestart VirtualMachinePoolUpdateMethod = "Restart"
+
+	// VirtualMachinePoolUpdateMethodLiveMigrate updates an outdated VirtualMachineInstance by live migrating it.
+	// VirtualMachineInstances which are not live migratable are restarted instead.
+	VirtualMachinePoolUpdateMethodLiveMigrate VirtualMachinePoolUpdateMethod = "LiveMigrate"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolScaleInPolicy string
+
+const (
+	// VirtualMachinePoolScaleInPolicyRandom removes random VirtualMachines.
+	VirtualMachinePoolScaleInPolicyRandom VirtualMachinePoolScaleInPolicy = "Random"
+
+	// VirtualMachinePoolScaleInPolicyOldestFirst removes the VirtualMachines which were created first.
+	VirtualMachinePoolScaleInPolicyOldestFirst VirtualMachinePoolScaleInPolicy = "OldestFirst"
+
+	// VirtualMachinePoolScaleInPolicyNotReadyFirst removes the VirtualMachines which are not ready first.
+	VirtualMachinePoolScaleInPolicyNotReadyFirst VirtualMachinePoolScaleInPolicy = "NotReadyFirst"
+
+	// VirtualMachinePoolScaleInPolicyLowestIndexFirst removes the VirtualMachines with the lowest ordinal first.
+	VirtualMachinePoolScaleInPolicyLowestIndexFirst VirtualMachinePoolScaleInPolicy = "LowestIndexFirst"
+
+	// VirtualMachinePoolScaleInPolicyHighestIndexFirst removes the VirtualMachines with the highest ordinal first.
+	VirtualMachinePoolScaleInPolicyHighestIndexFirst VirtualMachinePoolScaleInPolicy = "HighestIndexFirst"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategy struct {
+	// RollingUpdate controls the pace of the update of outdated VirtualMachineInstances.
+	// When not set, all outdated VirtualMachineInstances are restarted at once.
+	// +optional
+	RollingUpdate *VirtualMachinePoolRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolRollingUpdate struct {
+	// The maximum number of VirtualMachineInstances that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of the desired replicas (ex: 10%).
+	// Absolute number is calculated from percentage by rounding down. Defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// The maximum number of VirtualMachines that can be created over the desired replicas during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of the desired replicas (ex: 10%).
+	// Absolute number is calculated from percentage by rounding up. Defaults to 0.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// Partition indicates the ordinal at which the pool is partitioned for updates.
+	// VirtualMachines with an ordinal lower than the partition keep their revision. Defaults to 0.
+	// +optional
+	Partition *int32 `json:"partition,omitempty"`
+
+	// SpecChangeMethod is used when the VirtualMachineInstance template spec changed. Defaults to Restart.
+	// LiveMigrate is only used when all the changes are propagated to a running VirtualMachineInstance,
+	// see the liveUpdateFeatures of the VirtualMachine, any other change restarts the VirtualMachineInstance.
+	// The VirtualMachineInstance is reported as updated once the migration succeeded.
+	// +optional
+	SpecChangeMethod *VirtualMachinePoolUpdateMethod `json:"specChangeMethod,omitempty"`
+
+	// MetadataChangeMethod is used when only the labels or annotations of the VirtualMachineInstance
+	// template changed. Defaults to Restart.
+	// +optional
+	MetadataChangeMethod *VirtualMachinePoolUpdateMethod `json:"metadataChangeMethod,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -108,6 +195,16 @@ type VirtualMachinePoolSpec struct {
 	// Indicates that the pool is paused.
 	// +optional
 	Paused bool `json:"paused,omitempty" protobuf:"varint,7,opt,name=paused"`
+
+	// UpdateStrategy defines how outdated VirtualMachineInstances are updated.
+	// +optional
+	UpdateStrategy *VirtualMachinePoolUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// ScaleInPolicy defines which VirtualMachines are removed first when the pool is scaled in.
+	// The pool.kubevirt.io/deletion-cost annotation of the VirtualMachines takes precedence over the policy.
+	// Defaults to Random.
+	// +optional
+	ScaleInPolicy *VirtualMachinePoolScaleInPolicy `json:"scaleInPolicy,omitempty"`
 }
 
 // VirtualMachinePoolList is a list of VirtualMachinePool resources.
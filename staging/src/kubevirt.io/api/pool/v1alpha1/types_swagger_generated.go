@@ -25,10 +25,31 @@ func (VirtualMachinePoolCondition) SwaggerDoc() map[string]string {
 }
 
 func (VirtualMachinePoolStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "+k8s:openapi-gen=true",
+		"conditions":      "+listType=atomic",
+		"labelSelector":   "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"updatedReplicas": "UpdatedReplicas is the number of VirtualMachines, and their VirtualMachineInstances, which match the updateRevision.",
+		"currentRevision": "CurrentRevision is the revision which all VirtualMachines matched when the last update completed.",
+		"updateRevision":  "UpdateRevision is the revision of the pool spec the VirtualMachines are updated to.",
+	}
+}
+
+func (VirtualMachinePoolUpdateStrategy) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":              "+k8s:openapi-gen=true",
-		"conditions":    "+listType=atomic",
-		"labelSelector": "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"rollingUpdate": "RollingUpdate controls the pace of the update of outdated VirtualMachineInstances.\nWhen not set, all outdated VirtualMachineInstances are restarted at once.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolRollingUpdate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "+k8s:openapi-gen=true",
+		"maxUnavailable":       "The maximum number of VirtualMachineInstances that can be unavailable during the update.\nValue can be an absolute number (ex: 5) or a percentage of the desired replicas (ex: 10%).\nAbsolute number is calculated from percentage by rounding down. Defaults to 1.\n+optional",
+		"maxSurge":             "The maximum number of VirtualMachines that can be created over the desired replicas during the update.\nValue can be an absolute number (ex: 5) or a percentage of the desired replicas (ex: 10%).\nAbsolute number is calculated from percentage by rounding up. Defaults to 0.\n+optional",
+		"partition":            "Partition indicates the ordinal at which the pool is partitioned for updates.\nVirtualMachines with an ordinal lower than the partition keep their revision. Defaults to 0.\n+optional",
+		"specChangeMethod":     "SpecChangeMethod is used when the VirtualMachineInstance template spec changed. Defaults to Restart.\nLiveMigrate is only used when all the changes are propagated to a running VirtualMachineInstance,\nsee the liveUpdateFeatures of the VirtualMachine, any other change restarts the VirtualMachineInstance.\nThe VirtualMachineInstance is reported as updated once the migration succeeded.\n+optional",
+		"metadataChangeMethod": "MetadataChangeMethod is used when only the labels or annotations of the VirtualMachineInstance\ntemplate changed. Defaults to Restart.\n+optional",
 	}
 }
 
@@ -39,6 +60,8 @@ func (VirtualMachinePoolSpec) SwaggerDoc() map[string]string {
 		"selector":               "Label selector for pods. Existing Poolss whose pods are\nselected by this will be the ones affected by this deployment.",
 		"virtualMachineTemplate": "Template describes the VM that will be created.",
 		"paused":                 "Indicates that the pool is paused.\n+optional",
+		"updateStrategy":         "UpdateStrategy defines how outdated VirtualMachineInstances are updated.\n+optional",
+		"scaleInPolicy":          "ScaleInPolicy defines which VirtualMachines are removed first when the pool is scaled in.\nThe pool.kubevirt.io/deletion-cost annotation of the VirtualMachines takes precedence over the policy.\nDefaults to Random.\n+optional",
 	}
 }
 
@@ -361,3 +361,162 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+// VirtualMachineBackup defines the operation of backing up the disks of a running VM.
+// Changed block tracking checkpoints allow to only back up the blocks written since a previous backup.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineBackupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineBackupStatus `json:"status,omitempty"`
+}
+
+// BackupMode defines how the backup data leaves the VM
+type BackupMode string
+
+const (
+	// BackupModePush causes QEMU to write the backup images into the target volume
+	BackupModePush BackupMode = "Push"
+	// BackupModePull exposes the disks as of the start of the backup through an NBD server,
+	// which is reached with the nbd subresource of the VirtualMachineInstance
+	BackupModePull BackupMode = "Pull"
+)
+
+// VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource
+type VirtualMachineBackupSpec struct {
+	// Source is the VirtualMachine to back up, initially only VirtualMachine type supported
+	Source corev1.TypedLocalObjectReference `json:"source"`
+
+	// Mode defines how the backup is taken. Defaults to Push
+	// +optional
+	Mode *BackupMode `json:"mode,omitempty"`
+
+	// Incremental requests to only back up the blocks changed since the last checkpoint of the VM.
+	// The volumes without a dirty bitmap in that checkpoint are backed up entirely: the raw disk images,
+	// the disks added since the checkpoint, or all the disks when the bitmaps were lost, for example
+	// after a crash of the VM. The type of the backup of each volume is reported in the status.
+	// +optional
+	Incremental *bool `json:"incremental,omitempty"`
+
+	// Target is the volume receiving the backup images
+	Target BackupTarget `json:"target"`
+
+	// PullCompleted is set by the client of a Pull mode backup once it read the disks,
+	// the NBD server is stopped and the backup succeeds.
+	// It is the only field of the spec which can be updated.
+	// +optional
+	PullCompleted *bool `json:"pullCompleted,omitempty"`
+
+	// This time represents the number of seconds we permit the vm backup
+	// to take. In case we pass this deadline we mark this backup
+	// as failed.
+	// Defaults to DefaultFailureDeadline - 5min
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// BackupTarget defines where the backup images are stored
+type BackupTarget struct {
+	// PersistentVolumeClaimName is the name of a filesystem PVC in the namespace of the VM.
+	// The backup images are written in a directory named after the backup, in qcow2 format.
+	// In Pull mode the directory holds the scratch images storing the blocks the VM overwrites
+	// while the backup is exported, they are removed once the backup completes.
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName"`
+}
+
+// VirtualMachineBackupPhase is the current phase of the VirtualMachineBackup
+type VirtualMachineBackupPhase string
+
+const (
+	BackupPhaseUnset VirtualMachineBackupPhase = ""
+	BackupPending    VirtualMachineBackupPhase = "Pending"
+	BackupInProgress VirtualMachineBackupPhase = "InProgress"
+	BackupSucceeded  VirtualMachineBackupPhase = "Succeeded"
+	BackupFailed     VirtualMachineBackupPhase = "Failed"
+)
+
+// BackupType is the type of backup that was taken
+type BackupType string
+
+const (
+	// BackupTypeFull contains all the blocks of the disks
+	BackupTypeFull BackupType = "Full"
+	// BackupTypeIncremental contains the blocks changed since the parent checkpoint
+	BackupTypeIncremental BackupType = "Incremental"
+)
+
+// VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource
+type VirtualMachineBackupStatus struct {
+	// +optional
+	SourceUID *types.UID `json:"sourceUID,omitempty"`
+
+	// +optional
+	Phase VirtualMachineBackupPhase `json:"phase,omitempty"`
+
+	// Type is Incremental when all the volumes were backed up incrementally, Full otherwise.
+	// It is set once the backup completed.
+	// +optional
+	Type BackupType `json:"type,omitempty"`
+
+	// CheckpointName is the changed block tracking checkpoint created by the backup
+	// +optional
+	CheckpointName *string `json:"checkpointName,omitempty"`
+
+	// ParentCheckpointName is the checkpoint the incremental backup is relative to
+	// +optional
+	ParentCheckpointName *string `json:"parentCheckpointName,omitempty"`
+
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Volumes []BackupVolumeInfo `json:"volumes,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// BackupVolumeInfo describes the backup image of a volume
+type BackupVolumeInfo struct {
+	VolumeName string `json:"volumeName"`
+
+	// FileName is the path of the backup image relative to the root of the target volume.
+	// It is empty in Pull mode.
+	// +optional
+	FileName string `json:"fileName,omitempty"`
+
+	// ExportName is the name of the NBD export of the volume in Pull mode.
+	// The blocks changed since the parent checkpoint of an incremental backup are reported
+	// by the qemu:dirty-bitmap:<ExportName> metadata context.
+	// +optional
+	ExportName string `json:"exportName,omitempty"`
+
+	// Type tells whether the image contains all the blocks of the volume,
+	// or only the blocks changed since the parent checkpoint
+	// +optional
+	Type BackupType `json:"type,omitempty"`
+}
+
+// VirtualMachineBackupList is a list of VirtualMachineBackup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineBackup `json:"items"`
+}
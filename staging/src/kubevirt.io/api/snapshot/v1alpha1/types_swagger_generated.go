@@ -168,3 +168,60 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineBackup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineBackup defines the operation of backing up the disks of a running VM.\nChanged block tracking checkpoints allow to only back up the blocks written since a previous backup.\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineBackupSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource",
+		"source":          "Source is the VirtualMachine to back up, initially only VirtualMachine type supported",
+		"mode":            "Mode defines how the backup is taken. Defaults to Push\n+optional",
+		"incremental":     "Incremental requests to only back up the blocks changed since the last checkpoint of the VM.\nThe volumes without a dirty bitmap in that checkpoint are backed up entirely: the raw disk images,\nthe disks added since the checkpoint, or all the disks when the bitmaps were lost, for example\nafter a crash of the VM. The type of the backup of each volume is reported in the status.\n+optional",
+		"target":          "Target is the volume receiving the backup images",
+		"pullCompleted":   "PullCompleted is set by the client of a Pull mode backup once it read the disks,\nthe NBD server is stopped and the backup succeeds.\nIt is the only field of the spec which can be updated.\n+optional",
+		"failureDeadline": "This time represents the number of seconds we permit the vm backup\nto take. In case we pass this deadline we mark this backup\nas failed.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+	}
+}
+
+func (BackupTarget) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "BackupTarget defines where the backup images are stored",
+		"persistentVolumeClaimName": "PersistentVolumeClaimName is the name of a filesystem PVC in the namespace of the VM.\nThe backup images are written in a directory named after the backup, in qcow2 format.\nIn Pull mode the directory holds the scratch images storing the blocks the VM overwrites\nwhile the backup is exported, they are removed once the backup completes.",
+	}
+}
+
+func (VirtualMachineBackupStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource",
+		"sourceUID":            "+optional",
+		"phase":                "+optional",
+		"type":                 "Type is Incremental when all the volumes were backed up incrementally, Full otherwise.\nIt is set once the backup completed.\n+optional",
+		"checkpointName":       "CheckpointName is the changed block tracking checkpoint created by the backup\n+optional",
+		"parentCheckpointName": "ParentCheckpointName is the checkpoint the incremental backup is relative to\n+optional",
+		"startTimestamp":       "+optional\n+nullable",
+		"completionTimestamp":  "+optional\n+nullable",
+		"volumes":              "+optional\n+listType=atomic",
+		"error":                "+optional",
+		"conditions":           "+optional\n+listType=atomic",
+	}
+}
+
+func (BackupVolumeInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "BackupVolumeInfo describes the backup image of a volume",
+		"fileName":   "FileName is the path of the backup image relative to the root of the target volume.\nIt is empty in Pull mode.\n+optional",
+		"exportName": "ExportName is the name of the NBD export of the volume in Pull mode.\nThe blocks changed since the parent checkpoint of an incremental backup are reported\nby the qemu:dirty-bitmap:<ExportName> metadata context.\n+optional",
+		"type":       "Type tells whether the image contains all the blocks of the volume,\nor only the blocks changed since the parent checkpoint\n+optional",
+	}
+}
+
+func (VirtualMachineBackupList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineBackupList is a list of VirtualMachineBackup resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
@@ -18,6 +18,8 @@ limitations under the License.
 
 package v1alpha1
 
+type VirtualMachineBackupExpansion interface{}
+
 type VirtualMachineRestoreExpansion interface{}
 
 type VirtualMachineSnapshotExpansion interface{}
@@ -26,6 +26,7 @@ import (
 
 type SnapshotV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	VirtualMachineBackupsGetter
 	VirtualMachineRestoresGetter
 	VirtualMachineSnapshotsGetter
 	VirtualMachineSnapshotContentsGetter
@@ -36,6 +37,10 @@ type SnapshotV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *SnapshotV1alpha1Client) VirtualMachineBackups(namespace string) VirtualMachineBackupInterface {
+	return newVirtualMachineBackups(c, namespace)
+}
+
 func (c *SnapshotV1alpha1Client) VirtualMachineRestores(namespace string) VirtualMachineRestoreInterface {
 	return newVirtualMachineRestores(c, namespace)
 }
@@ -454,6 +454,13 @@ func (v *vmis) FilesystemList(ctx context.Context, name string) (v1.VirtualMachi
 	return fsList, err
 }
 
+func (v *vmis) MigrateCheck(ctx context.Context, name string) (*v1.VirtualMachineInstanceMigrateCheck, error) {
+	report := &v1.VirtualMachineInstanceMigrateCheck{}
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "migrate-check")
+	err := v.restClient.Get().AbsPath(uri).Do(ctx).Into(report)
+	return report, err
+}
+
 func (v *vmis) Screenshot(ctx context.Context, name string, screenshotOptions *v1.ScreenshotOptions) ([]byte, error) {
 	moveCursor := "false"
 	if screenshotOptions.MoveCursor == true {
@@ -507,6 +514,10 @@ func (v *vmis) VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, er
 	return asyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vsock", queryParams)
 }
 
+func (v *vmis) NBD(name string) (StreamInterface, error) {
+	return asyncSubresourceHelper(v.config, v.resource, v.namespace, name, "nbd", url.Values{})
+}
+
 func (v *vmis) SEVFetchCertChain(name string) (v1.SEVPlatformInfo, error) {
 	sevPlatformInfo := v1.SEVPlatformInfo{}
 	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "sev/fetchcertchain")
@@ -431,6 +431,33 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should fetch the migrate check report of a VirtualMachineInstance via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		report := &v1.VirtualMachineInstanceMigrateCheck{
+			Namespace:  k8sv1.NamespaceDefault,
+			Name:       "testvm",
+			Migratable: true,
+			Checks: []v1.MigrateCheck{
+				{Type: v1.MigrateCheckLiveMigratable, Passed: true},
+			},
+			TargetNodes: []string{"node02"},
+		}
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMIPath, "migrate-check")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, report),
+		))
+		fetchedReport, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).MigrateCheck(context.Background(), "testvm")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetchedReport).To(Equal(report))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	It("should fetch SEV platform info via subresource", func() {
 		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
 		Expect(err).ToNot(HaveOccurred())
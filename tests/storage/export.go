@@ -1250,9 +1250,9 @@ var _ = SIGDescribe("Export", func() {
 		Expect(vmExport.Status.Links).ToNot(BeNil())
 		Expect(vmExport.Status.Links.Internal).NotTo(BeNil())
 		Expect(vmExport.Status.Links.Internal.Cert).NotTo(BeEmpty())
-		Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(len(expectedVolumeFormats) / 2))
+		Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(len(expectedVolumeFormats) / 3))
 		for _, volume := range vmExport.Status.Links.Internal.Volumes {
-			Expect(volume.Formats).To(HaveLen(2))
+			Expect(volume.Formats).To(HaveLen(3))
 			Expect(expectedVolumeFormats).To(ContainElements(volume.Formats))
 		}
 	}
@@ -1267,6 +1267,10 @@ var _ = SIGDescribe("Export", func() {
 				Format: exportv1.KubeVirtGz,
 				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName1),
 			},
+			exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName1),
+			},
 			exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.KubeVirtRaw,
 				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName2),
@@ -1274,6 +1278,10 @@ var _ = SIGDescribe("Export", func() {
 			exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.KubeVirtGz,
 				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName2),
+			},
+			exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName2),
 			})
 	}
 
@@ -1286,6 +1294,10 @@ var _ = SIGDescribe("Export", func() {
 			exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.KubeVirtGz,
 				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
+			},
+			exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
 			})
 	}
 
@@ -1784,6 +1796,9 @@ var _ = SIGDescribe("Export", func() {
 		Expect(export.Status.Links.Internal).ToNot(BeNil())
 		Expect(getManifestUrl(export.Status.Links.Internal.Manifests, exportv1.AllManifests)).To(Equal(fmt.Sprintf("https://%s.%s.svc/internal/manifests/all", fmt.Sprintf("virt-export-%s", export.Name), export.Namespace)))
 		Expect(getManifestUrl(export.Status.Links.Internal.Manifests, exportv1.AuthHeader)).To(Equal(fmt.Sprintf("https://%s.%s.svc/internal/manifests/secret", fmt.Sprintf("virt-export-%s", export.Name), export.Namespace)))
+		Expect(getManifestUrl(export.Status.Links.Internal.Manifests, exportv1.OVF)).To(Equal(fmt.Sprintf("https://%s.%s.svc/internal/manifests/ovf", fmt.Sprintf("virt-export-%s", export.Name), export.Namespace)))
+		Expect(getManifestUrl(export.Status.Links.Internal.Manifests, exportv1.OVA)).To(Equal(fmt.Sprintf("https://%s.%s.svc/internal/manifests/ova", fmt.Sprintf("virt-export-%s", export.Name), export.Namespace)))
+		Expect(getManifestUrl(export.Status.Links.Internal.Manifests, exportv1.Checksums)).To(Equal(fmt.Sprintf("https://%s.%s.svc/internal/manifests/checksums", fmt.Sprintf("virt-export-%s", export.Name), export.Namespace)))
 		Expect(err).ToNot(HaveOccurred())
 		caConfigMap := createCaConfigMapInternal("export-cacerts", vm.Namespace, export)
 		Expect(caConfigMap).ToNot(BeNil())